@@ -23,6 +23,10 @@
 
 package riverboat
 
+import (
+	. "github.com/alexclewontin/riverboat/eval"
+)
+
 // Action is the generic type of all state machine transitions, formalized to better allow external agents to interact with the game.
 // For all Actions, g is the game in which it is performed and pn is the player number performing the action.
 // data represents different things for different Actions.
@@ -48,17 +52,13 @@ type Action func(g *Game, pn uint, data uint) error
 func Bet(g *Game, pn uint, data uint) error {
 	g.mtx.Lock()
 	defer g.mtx.Unlock()
-	return bet(g, pn, data)
+	return g.logAction(LoggedAction{Type: BetAction, PlayerNum: pn, Data: data}, bet(g, pn, data))
 }
 
 func bet(g *Game, pn uint, data uint) error {
 
-	if !g.getBetting() {
-		return ErrIllegalAction
-	}
-
-	if g.actionNum != pn {
-		return ErrIllegalAction
+	if err := g.canAct(pn); err != nil {
+		return err
 	}
 
 	p := g.getPlayer(pn)
@@ -66,8 +66,13 @@ func bet(g *Game, pn uint, data uint) error {
 	//rename this for readability
 	betVal := data
 
+	// Betting more than you have is just going all-in
+	if betVal > p.Stack {
+		betVal = p.Stack
+	}
+
 	var minBet uint = g.toCall()
-	var maxBet uint = g.getLimit()
+	var maxBet uint = g.getLimit(pn)
 
 	var betLegalError error = nil
 
@@ -75,27 +80,37 @@ func bet(g *Game, pn uint, data uint) error {
 	// a lambda with multiple returns as a control flow structure (which
 	// really just avoided using the elses?), which definitely
 	//hurts readability. Refactor to better express
-	if !g.canOpen(pn) {
-		//Won't hit now, reserved for future implementations
-		betLegalError = ErrIllegalAction
-	} else if betVal >= maxBet {
-		//You can always go all-in
-		betLegalError = nil
+	if betVal > maxBet {
+		//More than the betting structure allows
+		betLegalError = g.betError(pn, ErrAboveLimit)
 	} else if betVal < (minBet - p.Bet) {
-		//Not calling the minimum needed
-		betLegalError = ErrIllegalAction
+		//Not calling the minimum needed. You can always go all-in, though
+		if betVal != p.Stack {
+			betLegalError = g.betError(pn, ErrBelowCall)
+		}
 	} else if betVal == (minBet - p.Bet) {
 		//Calling exactly
 		betLegalError = nil
 	} else if betVal < (minBet + g.minRaise - p.Bet) {
-		// More than calling, but less than minimum raise
-		betLegalError = ErrIllegalAction
+		// More than calling, but less than minimum raise. This is only legal as an all-in,
+		// and it does not count as a full raise, so the minimum raise stays the same. Everyone must
+		// respond to it, but it does not reopen the raising for players who have already acted
+		if betVal != p.Stack {
+			betLegalError = g.betError(pn, ErrBelowMinRaise)
+		} else {
+			for i := range g.players {
+				g.players[i].Called = false
+				g.calledNum = pn
+			}
+		}
 	} else {
 		// More than calling, and at least the minimum raise
 		betLegalError = nil
 		g.minRaise = betVal + p.Bet - minBet
+		g.raiseCount++
 		for i := range g.players {
 			g.players[i].Called = false
+			g.players[i].Acted = false
 			g.calledNum = pn
 		}
 	}
@@ -106,8 +121,24 @@ func bet(g *Game, pn uint, data uint) error {
 		return betLegalError
 	}
 
+	g.endTurn(pn)
+
+	evt := Event{PlayerNum: pn, Amount: betVal, AllIn: betVal > 0 && betVal == p.Stack}
+	if betVal == 0 {
+		evt.Type = PlayerChecked
+	} else if betVal <= (minBet - p.Bet) {
+		evt.Type = PlayerCalled
+	} else if minBet == 0 {
+		evt.Type = PlayerBet
+	} else {
+		evt.Type = PlayerRaised
+	}
+
 	g.players[pn].putInChips(betVal)
 	g.players[pn].Called = true
+	g.players[pn].Acted = true
+
+	g.emit(evt)
 
 	g.updateRoundInfo()
 
@@ -120,20 +151,25 @@ func bet(g *Game, pn uint, data uint) error {
 func BuyIn(g *Game, pn uint, data uint) error {
 	g.mtx.Lock()
 	defer g.mtx.Unlock()
-	return buyIn(g, pn, data)
+	return g.logAction(LoggedAction{Type: BuyInAction, PlayerNum: pn, Data: data}, buyIn(g, pn, data))
 }
 
 func buyIn(g *Game, pn uint, data uint) error {
 	p := g.getPlayer(pn)
 
 	//Can't buy in while playing
-	if p.In {
-		return ErrIllegalAction
+	if g.inHand(pn) {
+		return ErrPlayerInHand
+	}
+
+	//Or once knocked out of a tournament
+	if p.Eliminated {
+		return ErrEliminated
 	}
 
 	//Can't buy more than the maximum buy, if it's configured
 	if g.config.MaxBuy != 0 && p.Stack+data > g.config.MaxBuy {
-		return ErrIllegalAction
+		return ErrBuyTooBig
 	}
 
 	//Otherwise, add it to the stack
@@ -146,40 +182,33 @@ func buyIn(g *Game, pn uint, data uint) error {
 }
 
 // Deal deals the next set of cards, as appropriate per g's internal state. If g is currently betting,
-// or pn is not the dealer, Deal will return an error. Otherwise, if g is stage PreDeal when Deal is called,
-// Deal shuffles the deck and deals each player who is ready 2 cards. If g is stage PreFlop, Deal deals the flop; if g
+// or pn is not the dealer, Deal will return an error. The dealer is the player on the button, or if the button is
+// dead (see DeadButton), the first player after it who is ready. Otherwise, if g is stage PreDeal when Deal is called,
+// Deal shuffles the deck and deals each player who is ready their hole cards (2 for Hold'em, 4 or 5 for Omaha). If g is stage PreFlop, Deal deals the flop; if g
 // is stage Flop, Deal deals the turn, and if g is stage Turn, Deal deals the river. g is never stage River and not betting,
 // so calling Deal during stage River will result in an error.
 // Deal ignores the value passed in as data.
 func Deal(g *Game, pn uint, data uint) error {
 	g.mtx.Lock()
 	defer g.mtx.Unlock()
-	return deal(g, pn, data)
+	return g.logAction(LoggedAction{Type: DealAction, PlayerNum: pn, Data: data}, deal(g, pn, data))
 }
 
 func deal(g *Game, pn uint, data uint) error {
-	if pn != g.dealerNum {
-		return ErrIllegalAction
-	}
-
-	stage, betting := g.getStageAndBetting()
-
-	if betting {
-		return ErrIllegalAction
+	if err := g.canDeal(pn); err != nil {
+		return err
 	}
 
-	if g.readyCount() < 2 {
-		return ErrIllegalAction
-	}
+	stage := g.getStage()
+	var posted []Event
 
 	for i := range g.players {
 		g.players[i].Bet = 0
 		g.players[i].Called = false
+		g.players[i].Acted = false
 	}
 
-	g.minRaise = g.config.BigBlind
-
-	//TODO: if all or all but one are all-in and its not the end, don't set betting to true on the next deal
+	g.raiseCount = 0
 
 	switch stage {
 	case PreDeal:
@@ -188,39 +217,39 @@ func deal(g *Game, pn uint, data uint) error {
 		for i := range g.communityCards {
 			g.communityCards[i] = 0
 		}
+		g.runs = nil
+		g.handRake = 0
 
-		g.pots = []Pot{}
+		g.admitWaitingForBB()
 
-		g.updateBlindNums()
+		g.lastSBNum, g.lastBBNum, g.lastDealt = g.sbNum, g.bbNum, g.readyCount()
 
 		g.actionNum = g.utgNum
 
-		for i := 0; i < 3; i++ {
-			g.deck.Shuffle()
-		}
+		g.shuffleDeck()
 
 		for i, p := range g.players {
 			if p.Ready {
-				g.players[i].Cards[0] = g.deck.Pop()
-				g.players[i].Cards[1] = g.deck.Pop()
+				g.players[i].Cards = make([]Card, holeCardCount(g.config.Variant))
+				for j := range g.players[i].Cards {
+					g.players[i].Cards[j] = g.deck.Pop()
+				}
 				g.players[i].In = true
 			} else {
-				g.players[i].Cards[0] = 0
-				g.players[i].Cards[1] = 0
+				g.players[i].Cards = nil
+				g.players[i].In = false
 			}
 
 			g.players[i].Called = false
 		}
 
-		g.players[g.sbNum].putInChips(g.config.SmallBlind)
-		g.players[g.bbNum].putInChips(g.config.BigBlind)
+		posted = g.postBlinds()
+
+		g.updatePots()
 
 	case PreFlop:
 
-		g.actionNum = (g.dealerNum + 1) % uint(len(g.players))
-		for !g.players[g.actionNum].In {
-			g.actionNum = (g.actionNum + 1) % uint(len(g.players))
-		}
+		g.actionNum = g.firstToAct()
 		g.calledNum = g.actionNum
 
 		g.communityCards[0] = g.deck.Pop()
@@ -228,19 +257,13 @@ func deal(g *Game, pn uint, data uint) error {
 		g.communityCards[2] = g.deck.Pop()
 
 	case Flop:
-		g.actionNum = (g.dealerNum + 1) % uint(len(g.players))
-		for !g.players[g.actionNum].In {
-			g.actionNum = (g.actionNum + 1) % uint(len(g.players))
-		}
+		g.actionNum = g.firstToAct()
 		g.calledNum = g.actionNum
 
 		g.communityCards[3] = g.deck.Pop()
 
 	case Turn:
-		g.actionNum = (g.dealerNum + 1) % uint(len(g.players))
-		for !g.players[g.actionNum].In {
-			g.actionNum = (g.actionNum + 1) % uint(len(g.players))
-		}
+		g.actionNum = g.firstToAct()
 		g.calledNum = g.actionNum
 
 		g.communityCards[4] = g.deck.Pop()
@@ -251,6 +274,36 @@ func deal(g *Game, pn uint, data uint) error {
 
 	g.setStageAndBetting(stage+1, true)
 
+	// The pre-flop minimum raise depends on the blinds, so it was set when they were posted
+	if stage != PreDeal {
+		g.minRaise = g.minOpen()
+	}
+
+	if stage == PreDeal {
+		g.emit(Event{Type: HandStarted, PlayerNum: g.dealerNum})
+
+		for i, p := range g.players {
+			if p.In {
+				g.emit(Event{Type: HoleCardsDealt, PlayerNum: uint(i), Cards: append([]Card{}, p.Cards...)})
+			}
+		}
+
+		for _, e := range posted {
+			g.emit(e)
+		}
+
+		// Players put all-in by the blinds cannot act, and if no one else can either, the hand runs itself out
+		if g.players[g.actionNum].allIn() {
+			g.startTurn()
+			g.updateRoundInfo()
+			return nil
+		}
+	} else {
+		g.emit(Event{Type: StreetDealt, Cards: g.streetCards(stage + 1)})
+	}
+
+	g.startTurn()
+
 	return nil
 }
 
@@ -262,19 +315,23 @@ func deal(g *Game, pn uint, data uint) error {
 func Fold(g *Game, pn uint, data uint) error {
 	g.mtx.Lock()
 	defer g.mtx.Unlock()
-	return fold(g, pn, data)
+	return g.logAction(LoggedAction{Type: FoldAction, PlayerNum: pn, Data: data}, fold(g, pn, data))
 }
 
 func fold(g *Game, pn uint, data uint) error {
 
 	p := g.getPlayer(pn)
 
-	if g.actionNum != pn {
-		return ErrIllegalAction
+	if err := g.canAct(pn); err != nil {
+		return err
 	}
 
+	g.endTurn(pn)
+
 	p.In = false
 
+	g.emit(Event{Type: PlayerFolded, PlayerNum: pn})
+
 	g.updateRoundInfo()
 
 	return nil
@@ -287,7 +344,7 @@ func fold(g *Game, pn uint, data uint) error {
 func Leave(g *Game, pn uint, data uint) error {
 	g.mtx.Lock()
 	defer g.mtx.Unlock()
-	return toggleReady(g, pn, data)
+	return g.logAction(LoggedAction{Type: LeaveAction, PlayerNum: pn, Data: data}, leave(g, pn, data))
 }
 
 func leave(g *Game, pn uint, data uint) error {
@@ -302,6 +359,7 @@ func leave(g *Game, pn uint, data uint) error {
 	}
 
 	p.Left = true
+	g.emit(Event{Type: PlayerLeft, PlayerNum: pn})
 
 	return nil
 }
@@ -313,30 +371,30 @@ func leave(g *Game, pn uint, data uint) error {
 func ToggleReady(g *Game, pn uint, data uint) error {
 	g.mtx.Lock()
 	defer g.mtx.Unlock()
-	return toggleReady(g, pn, data)
+	return g.logAction(LoggedAction{Type: ToggleReadyAction, PlayerNum: pn, Data: data}, toggleReady(g, pn, data))
 }
 
 func toggleReady(g *Game, pn uint, data uint) error {
 	p := g.getPlayer(pn)
 
-	if p.In {
-		return ErrIllegalAction
+	if err := g.canToggleReady(pn); err != nil {
+		return err
 	}
 
 	if p.Ready {
 		p.Ready = false
-		p.Cards[0] = 0
-		p.Cards[1] = 0
+		p.Cards = nil
+		g.admitShortHanded()
 	} else {
-		if p.Stack == 0 {
-			return ErrIllegalAction
-		}
 		p.Ready = true
+		p.SittingOut = false
+		p.WaitForBB = false
 	}
 
-	if pn == g.dealerNum {
+	// The button must be in front of a player who is ready, whenever there is one
+	if !g.players[g.dealerNum].Ready && g.readyCount() > 0 {
 		for !(g.players[g.dealerNum].Ready) {
-			g.dealerNum = g.dealerNum + 1
+			g.dealerNum = (g.dealerNum + 1) % uint(len(g.players))
 		}
 	}
 
@@ -348,3 +406,117 @@ func toggleReady(g *Game, pn uint, data uint) error {
 
 	return nil
 }
+
+// WaitForBigBlind is passed as the data of SitIn by a player who would rather wait for the big blind to reach them
+// than post the blinds they owe.
+const WaitForBigBlind uint = 1
+
+// SitOut marks a player as sitting out. The player keeps their seat, but is not dealt in until they sit back in
+// with SitIn. If the player is in the current hand, they play it out, and sit out from the next one. While the
+// player is sitting out, the blinds they miss are tracked so that, if GameConfig.PostMissedBlinds is set, they
+// make them up when they come back. SitOut returns an error if the player is already sitting out.
+// SitOut ignores the value passed in as data.
+func SitOut(g *Game, pn uint, data uint) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.logAction(LoggedAction{Type: SitOutAction, PlayerNum: pn, Data: data}, sitOut(g, pn, data))
+}
+
+func sitOut(g *Game, pn uint, data uint) error {
+	p := g.getPlayer(pn)
+
+	if err := g.canSitOut(pn); err != nil {
+		return err
+	}
+
+	// A player in the hand is marked not ready once it is over
+	if p.Ready && !g.inHand(pn) {
+		if err := toggleReady(g, pn, data); err != nil {
+			return err
+		}
+	}
+
+	p.SittingOut = true
+	p.WaitForBB = false
+
+	return nil
+}
+
+// SitIn brings a player who is sitting out (or has not yet been dealt in) back into the game. For SitIn, data is 0
+// to be dealt in from the next hand, posting any blinds the player owes, or WaitForBigBlind to wait until the big
+// blind reaches them, and be dealt in then without owing anything. A player who is waiting may call SitIn again with
+// 0 to stop waiting, and if fewer than two players are left ready, they stop waiting on their own. If the player sat
+// out during a hand they are still playing, SitIn simply cancels it. SitIn returns an error if the player is not
+// sitting out, or has no chips.
+func SitIn(g *Game, pn uint, data uint) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.logAction(LoggedAction{Type: SitInAction, PlayerNum: pn, Data: data}, sitIn(g, pn, data))
+}
+
+func sitIn(g *Game, pn uint, data uint) error {
+	p := g.getPlayer(pn)
+
+	if err := g.canSitIn(pn); err != nil {
+		return err
+	}
+
+	// There is no big blind to wait for without at least two other players
+	if p.Ready || data != WaitForBigBlind || g.readyCount() < 2 {
+		p.SittingOut = false
+		p.WaitForBB = false
+
+		if p.Ready {
+			return nil
+		}
+
+		return toggleReady(g, pn, 0)
+	}
+
+	p.SittingOut = false
+	p.WaitForBB = true
+
+	return nil
+}
+
+// RunItTimes sets the number of times a player agrees to run the rest of the board, if the betting is over with
+// players all-in before the river. For RunItTimes, data is the number of times, from 1 to GameConfig.MaxRuns. The
+// board is run as many times as every player still in the hand agrees to, and each pot is split evenly between
+// the boards. The player's choice stands for every hand until they change it. RunItTimes returns an error if
+// data is 0, or more than the game allows.
+func RunItTimes(g *Game, pn uint, data uint) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.logAction(LoggedAction{Type: RunItTimesAction, PlayerNum: pn, Data: data}, runItTimes(g, pn, data))
+}
+
+func runItTimes(g *Game, pn uint, data uint) error {
+	if data == 0 || data > g.config.MaxRuns {
+		return ErrTooManyRuns
+	}
+
+	g.getPlayer(pn).Runs = data
+
+	return nil
+}
+
+// ToggleStraddle marks a player as wanting to straddle if they currently do not, or as not wanting to if they
+// currently do. Whenever the player is in the position GameConfig.Straddle allows to straddle, they post a live
+// straddle of twice the big blind as the hand is dealt. If the game does not allow straddles, ToggleStraddle
+// returns an error. ToggleStraddle ignores the value passed in as data.
+func ToggleStraddle(g *Game, pn uint, data uint) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.logAction(LoggedAction{Type: ToggleStraddleAction, PlayerNum: pn, Data: data}, toggleStraddle(g, pn, data))
+}
+
+func toggleStraddle(g *Game, pn uint, data uint) error {
+	if g.config.Straddle == NoStraddle {
+		return ErrNoStraddle
+	}
+
+	p := g.getPlayer(pn)
+	p.Straddle = !p.Straddle
+
+	return nil
+}
@@ -24,7 +24,13 @@
 package riverboat
 
 import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
 	"testing"
+
+	. "github.com/alexclewontin/riverboat/eval"
 )
 
 func TestIntegration_Scenarios(t *testing.T) {
@@ -32,13 +38,13 @@ func TestIntegration_Scenarios(t *testing.T) {
 	t.Run("Scenario 1", func(t *testing.T) {
 		g := NewGame()
 
-		pn_a := g.AddPlayer()
+		pn_a, _ := g.AddPlayer()
 		g.AddPlayer()
 		g.AddPlayer()
 
 		err := Deal(g, pn_a, 0)
 
-		if err != ErrIllegalAction {
+		if !errors.Is(err, ErrIllegalAction) {
 			t.Error("Test failed - Deal must return ErrIllegalAction as 0 players are marked ready.")
 		}
 	})
@@ -46,13 +52,13 @@ func TestIntegration_Scenarios(t *testing.T) {
 	t.Run("Scenario 2", func(t *testing.T) {
 		g := NewGame()
 
-		pn_a := g.AddPlayer()
+		pn_a, _ := g.AddPlayer()
 		g.AddPlayer()
 		g.AddPlayer()
 
 		err := ToggleReady(g, pn_a, 0)
 
-		if err != ErrIllegalAction {
+		if !errors.Is(err, ErrIllegalAction) {
 			t.Error("Test failed - ToggleReady must return ErrIllegalAction as player 0 has not bought in.")
 		}
 	})
@@ -61,9 +67,9 @@ func TestIntegration_Scenarios(t *testing.T) {
 		var err error
 		g := NewGame()
 
-		pn_a := g.AddPlayer()
-		pn_b := g.AddPlayer()
-		pn_c := g.AddPlayer()
+		pn_a, _ := g.AddPlayer()
+		pn_b, _ := g.AddPlayer()
+		pn_c, _ := g.AddPlayer()
 
 		err = BuyIn(g, pn_a, 100)
 
@@ -112,9 +118,9 @@ func TestIntegration_Scenarios(t *testing.T) {
 		var err error
 		g := NewGame()
 
-		pn_a := g.AddPlayer()
-		pn_b := g.AddPlayer()
-		pn_c := g.AddPlayer()
+		pn_a, _ := g.AddPlayer()
+		pn_b, _ := g.AddPlayer()
+		pn_c, _ := g.AddPlayer()
 
 		err = BuyIn(g, pn_a, 100)
 
@@ -154,7 +160,7 @@ func TestIntegration_Scenarios(t *testing.T) {
 
 		err = Deal(g, pn_b, 0)
 
-		if err != ErrIllegalAction {
+		if !errors.Is(err, ErrIllegalAction) {
 			t.Errorf("Test failed - must return ErrIllegalAction as pn_b is not the dealer")
 		}
 	})
@@ -163,9 +169,9 @@ func TestIntegration_Scenarios(t *testing.T) {
 		var err error
 		g := NewGame()
 
-		pn_a := g.AddPlayer()
-		pn_b := g.AddPlayer()
-		pn_c := g.AddPlayer()
+		pn_a, _ := g.AddPlayer()
+		pn_b, _ := g.AddPlayer()
+		pn_c, _ := g.AddPlayer()
 
 		err = BuyIn(g, pn_a, 100)
 
@@ -225,9 +231,9 @@ func TestIntegration_Scenarios(t *testing.T) {
 		var err error
 		g := NewGame()
 
-		pn_a := g.AddPlayer()
-		pn_b := g.AddPlayer()
-		pn_c := g.AddPlayer()
+		pn_a, _ := g.AddPlayer()
+		pn_b, _ := g.AddPlayer()
+		pn_c, _ := g.AddPlayer()
 
 		err = BuyIn(g, pn_a, 100)
 
@@ -356,9 +362,9 @@ func TestIntegration_Scenarios(t *testing.T) {
 		var err error
 		g := NewGame()
 
-		pn_a := g.AddPlayer()
-		pn_b := g.AddPlayer()
-		pn_c := g.AddPlayer()
+		pn_a, _ := g.AddPlayer()
+		pn_b, _ := g.AddPlayer()
+		pn_c, _ := g.AddPlayer()
 
 		err = BuyIn(g, pn_a, 100)
 
@@ -475,9 +481,9 @@ func TestIntegration_Scenarios(t *testing.T) {
 		var err error
 		g := NewGame()
 
-		pn_a := g.AddPlayer()
-		pn_b := g.AddPlayer()
-		pn_c := g.AddPlayer()
+		pn_a, _ := g.AddPlayer()
+		pn_b, _ := g.AddPlayer()
+		pn_c, _ := g.AddPlayer()
 
 		err = BuyIn(g, pn_a, 100)
 
@@ -598,3 +604,1299 @@ func TestIntegration_Scenarios(t *testing.T) {
 	})
 
 }
+
+func setupReadyGame(t *testing.T, config GameConfig, stacks ...uint) *Game {
+	t.Helper()
+
+	g := NewGame()
+
+	err := g.SetConfig(config)
+	if err != nil {
+		t.Fatalf("Test failed - Error setting config: %s", err)
+	}
+
+	for _, stack := range stacks {
+		pn, _ := g.AddPlayer()
+
+		err = BuyIn(g, pn, stack)
+		if err != nil {
+			t.Fatalf("Test failed - Error buying in: %s", err)
+		}
+
+		err = ToggleReady(g, pn, 0)
+		if err != nil {
+			t.Fatalf("Test failed - Error marking ready: %s", err)
+		}
+	}
+
+	return g
+}
+
+func TestBettingStructures(t *testing.T) {
+
+	t.Run("Pot limit maximum raise", func(t *testing.T) {
+		var err error
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Limit: PotLimit}, 1000, 1000, 1000)
+
+		err = Deal(g, 0, 0)
+
+		if err != nil {
+			t.Errorf("Test failed - error dealing: %s", err)
+		}
+
+		// Call 25, then raise the 60 that would be in the pot after calling
+		err = Bet(g, 0, 86)
+
+		if !errors.Is(err, ErrAboveLimit) {
+			t.Errorf("Test failed - must return ErrAboveLimit as 86 is more than the pot")
+		}
+
+		err = Bet(g, 0, 85)
+
+		if err != nil {
+			t.Errorf("Test failed - error betting: %s", err)
+		}
+
+		// Call 75, then raise 10 + 25 + 85 + 75
+		err = Bet(g, 1, 271)
+
+		if !errors.Is(err, ErrAboveLimit) {
+			t.Errorf("Test failed - must return ErrAboveLimit as 271 is more than the pot")
+		}
+
+		err = Bet(g, 1, 270)
+
+		if err != nil {
+			t.Errorf("Test failed - error betting: %s", err)
+		}
+	})
+
+	t.Run("Fixed limit bet sizes and raise cap", func(t *testing.T) {
+		var err error
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Limit: FixedLimit, RaiseCap: 4}, 1000, 1000, 1000)
+
+		err = Deal(g, 0, 0)
+
+		if err != nil {
+			t.Errorf("Test failed - error dealing: %s", err)
+		}
+
+		err = Bet(g, 0, 60)
+
+		if !errors.Is(err, ErrAboveLimit) {
+			t.Errorf("Test failed - must return ErrAboveLimit as 60 is not a fixed raise")
+		}
+
+		err = Bet(g, 0, 40)
+
+		if !errors.Is(err, ErrBelowMinRaise) {
+			t.Errorf("Test failed - must return ErrBelowMinRaise as 40 is less than a full raise")
+		}
+
+		err = Bet(g, 0, 50)
+
+		if err != nil {
+			t.Errorf("Test failed - error betting: %s", err)
+		}
+
+		err = Bet(g, 1, 65)
+
+		if err != nil {
+			t.Errorf("Test failed - error betting: %s", err)
+		}
+
+		err = Bet(g, 2, 75)
+
+		if err != nil {
+			t.Errorf("Test failed - error betting: %s", err)
+		}
+
+		// That was the fourth bet, so the betting is capped
+		err = Bet(g, 0, 75)
+
+		if !errors.Is(err, ErrAboveLimit) {
+			t.Errorf("Test failed - must return ErrAboveLimit as the betting is capped")
+		}
+
+		err = Bet(g, 0, 50)
+
+		if err != nil {
+			t.Errorf("Test failed - error betting: %s", err)
+		}
+
+		err = Bet(g, 1, 25)
+
+		if err != nil {
+			t.Errorf("Test failed - error betting: %s", err)
+		}
+
+		// Turn and river bets are twice as big
+
+		for _, pn := range []uint{1, 2, 0} {
+			err = Bet(g, pn, 0)
+
+			if err != nil {
+				t.Errorf("Test failed - error betting: %s", err)
+			}
+		}
+
+		err = Bet(g, 1, 25)
+
+		if !errors.Is(err, ErrBelowMinRaise) {
+			t.Errorf("Test failed - must return ErrBelowMinRaise as the turn bet is 50")
+		}
+
+		err = Bet(g, 1, 50)
+
+		if err != nil {
+			t.Errorf("Test failed - error betting: %s", err)
+		}
+	})
+
+	t.Run("No limit all-in for less than a call", func(t *testing.T) {
+		var err error
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 1000, 1000)
+
+		err = Deal(g, 0, 0)
+
+		if err != nil {
+			t.Errorf("Test failed - error dealing: %s", err)
+		}
+
+		err = Bet(g, 0, 500)
+
+		if err != nil {
+			t.Errorf("Test failed - error betting: %s", err)
+		}
+
+		g.players[1].Stack = 100
+
+		err = Bet(g, 1, 100)
+
+		if err != nil {
+			t.Errorf("Test failed - error going all-in: %s", err)
+		}
+
+		if !g.players[1].allIn() {
+			t.Errorf("Test failed - player must be all-in")
+		}
+	})
+
+	t.Run("Incomplete all-in raise does not reopen the betting", func(t *testing.T) {
+		var err error
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 150, 1000)
+
+		err = Deal(g, 0, 0)
+
+		if err != nil {
+			t.Errorf("Test failed - error dealing: %s", err)
+		}
+
+		err = Bet(g, 0, 100)
+
+		if err != nil {
+			t.Errorf("Test failed - error betting: %s", err)
+		}
+
+		// All-in for 150, which is only a raise of 50
+		err = Bet(g, 1, 140)
+
+		if err != nil {
+			t.Errorf("Test failed - error going all-in: %s", err)
+		}
+
+		// The big blind has not acted since the full raise, so they may still raise
+		if la := g.LegalActions(2); !la.Raise {
+			t.Errorf("Test failed - player 2 must be able to raise")
+		}
+
+		err = Bet(g, 2, 125)
+
+		if err != nil {
+			t.Errorf("Test failed - error betting: %s", err)
+		}
+
+		// Player 0 must call the extra 50 or fold, but may not raise again
+		la := g.LegalActions(0)
+		if !la.Call || la.CallAmt != 50 || la.Raise {
+			t.Errorf("Test failed - player 0 must be able to call 50, but not raise. Got: %+v", la)
+		}
+
+		err = Bet(g, 0, 200)
+
+		if !errors.Is(err, ErrAboveLimit) {
+			t.Errorf("Test failed - must return ErrAboveLimit as the betting was not reopened")
+		}
+
+		err = Bet(g, 0, 50)
+
+		if err != nil {
+			t.Errorf("Test failed - error calling: %s", err)
+		}
+
+		if g.getStage() != Flop {
+			t.Errorf("Test failed - the flop must be dealt once the all-in is called")
+		}
+
+		// The raising is open again on the next street
+		if la := g.LegalActions(2); !la.Bet {
+			t.Errorf("Test failed - player 2 must be able to bet on the flop")
+		}
+	})
+}
+
+func TestVariants(t *testing.T) {
+
+	t.Run("Omaha showdown", func(t *testing.T) {
+		var err error
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Variant: Omaha}, 1000, 1000, 1000)
+
+		err = Deal(g, 0, 0)
+
+		if err != nil {
+			t.Errorf("Test failed - error dealing: %s", err)
+		}
+
+		for i := range g.players {
+			if len(g.players[i].Cards) != 4 {
+				t.Errorf("Test failed - player %d was dealt %d cards, want 4", i, len(g.players[i].Cards))
+			}
+		}
+
+		// Preflop
+		for _, bet := range []struct{ pn, amt uint }{{0, 25}, {1, 15}, {2, 0}} {
+			err = Bet(g, bet.pn, bet.amt)
+
+			if err != nil {
+				t.Errorf("Test failed - error betting: %s", err)
+			}
+		}
+
+		// Flop, turn, and river
+		for i := 0; i < 3; i++ {
+			for _, pn := range []uint{1, 2, 0} {
+				err = Bet(g, pn, 0)
+
+				if err != nil {
+					t.Errorf("Test failed - error betting: %s", err)
+				}
+			}
+		}
+
+		if g.getStage() != PreDeal {
+			t.Fatalf("Test failed - hand must be over, stage is %v", g.getStage())
+		}
+
+		for _, pot := range g.pots {
+			if len(pot.WinningPlayerNums) == 0 {
+				t.Errorf("Test failed - no winner for pot %+v", pot)
+			}
+
+			fromHand := 0
+			for _, c := range pot.WinningHand {
+				for _, hc := range g.players[pot.WinningPlayerNums[0]].Cards {
+					if c == hc {
+						fromHand++
+					}
+				}
+			}
+
+			if fromHand != 2 {
+				t.Errorf("Test failed - winning hand %v uses %d hole cards, want 2", pot.WinningHand, fromHand)
+			}
+		}
+	})
+	hiLoCases := []struct {
+		name       string
+		hands      [][]string
+		board      []string
+		wantStacks []uint
+	}{
+		{
+			name: "Omaha hi/lo split",
+			hands: [][]string{
+				{"AH", "2H", "9C", "9D"},
+				{"KH", "KC", "QS", "QD"},
+				{"7C", "7S", "JC", "JD"},
+			},
+			board:      []string{"3C", "4D", "8H", "KS", "KD"},
+			wantStacks: []uint{1012, 1013, 975},
+		},
+		{
+			name: "Omaha hi/lo no qualifying low",
+			hands: [][]string{
+				{"AH", "2H", "9C", "9D"},
+				{"KH", "KC", "QS", "QD"},
+				{"7C", "7S", "JC", "JD"},
+			},
+			board:      []string{"3C", "TD", "8H", "KS", "KD"},
+			wantStacks: []uint{975, 1050, 975},
+		},
+	}
+
+	for _, tt := range hiLoCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Variant: Omaha, HiLo: true}, 1000, 1000, 1000)
+
+			err = Deal(g, 0, 0)
+
+			if err != nil {
+				t.Errorf("Test failed - error dealing: %s", err)
+			}
+
+			for i, hand := range tt.hands {
+				for j, c := range hand {
+					g.players[i].Cards[j] = MustParseCardString(c)
+				}
+			}
+
+			for _, bet := range []struct{ pn, amt uint }{{0, 25}, {1, 15}, {2, 0}} {
+				err = Bet(g, bet.pn, bet.amt)
+
+				if err != nil {
+					t.Errorf("Test failed - error betting: %s", err)
+				}
+			}
+
+			for i := 0; i < 3; i++ {
+				for _, pn := range []uint{1, 2, 0} {
+					// The board is only read at showdown, so it can be replaced any time before then
+					for j, c := range tt.board {
+						g.communityCards[j] = MustParseCardString(c)
+					}
+
+					err = Bet(g, pn, 0)
+
+					if err != nil {
+						t.Errorf("Test failed - error betting: %s", err)
+					}
+				}
+			}
+
+			for i, want := range tt.wantStacks {
+				if g.players[i].Stack != want {
+					t.Errorf("Test failed - player %d has stack %d, want %d", i, g.players[i].Stack, want)
+				}
+			}
+		})
+	}
+}
+
+func TestOddChips(t *testing.T) {
+	tests := []struct {
+		name       string
+		rule       OddChipRule
+		wantStacks []uint
+		wantCarry  uint
+	}{
+		{
+			name:       "Left of the button",
+			rule:       OddChipLeftOfButton,
+			wantStacks: []uint{1012, 975, 1013},
+		},
+		{
+			name:       "High card",
+			rule:       OddChipHighCard,
+			wantStacks: []uint{1013, 975, 1012},
+		},
+		{
+			name:       "Carried to the next hand",
+			rule:       OddChipCarry,
+			wantStacks: []uint{1012, 975, 1012},
+			wantCarry:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, OddChip: tt.rule}, 1000, 1000, 1000)
+
+			err = Deal(g, 0, 0)
+
+			if err != nil {
+				t.Fatalf("Test failed - error dealing: %s", err)
+			}
+
+			// Players 0 and 2 both make aces and kings with a seven kicker, and split the 75 chip pot
+			for i, hand := range [][]string{{"KS", "3C"}, {"QC", "JC"}, {"KH", "4C"}} {
+				for j, c := range hand {
+					g.players[i].Cards[j] = MustParseCardString(c)
+				}
+			}
+
+			for _, bet := range []struct{ pn, amt uint }{{0, 25}, {1, 15}, {2, 0}} {
+				err = Bet(g, bet.pn, bet.amt)
+
+				if err != nil {
+					t.Errorf("Test failed - error betting: %s", err)
+				}
+			}
+
+			for i := 0; i < 3; i++ {
+				for _, pn := range []uint{1, 2, 0} {
+					for j, c := range []string{"AH", "AD", "KC", "7S", "2D"} {
+						g.communityCards[j] = MustParseCardString(c)
+					}
+
+					err = Bet(g, pn, 0)
+
+					if err != nil {
+						t.Errorf("Test failed - error betting: %s", err)
+					}
+				}
+			}
+
+			for i, want := range tt.wantStacks {
+				if g.players[i].Stack != want {
+					t.Errorf("Test failed - player %d has stack %d, want %d", i, g.players[i].Stack, want)
+				}
+			}
+
+			if g.carry != tt.wantCarry {
+				t.Errorf("Test failed - carried %d chips, want %d", g.carry, tt.wantCarry)
+			}
+
+			if tt.wantCarry == 0 {
+				return
+			}
+
+			// The carried chip goes to the winner of the next hand
+			err = Deal(g, 1, 0)
+
+			if err != nil {
+				t.Fatalf("Test failed - error dealing: %s", err)
+			}
+
+			if g.pots[0].Amt != 35+tt.wantCarry {
+				t.Errorf("Test failed - main pot is %d, want %d", g.pots[0].Amt, 35+tt.wantCarry)
+			}
+
+			for _, pn := range []uint{1, 2} {
+				err = Fold(g, pn, 0)
+
+				if err != nil {
+					t.Errorf("Test failed - error folding: %s", err)
+				}
+			}
+
+			if g.players[0].Stack != 1012+10+tt.wantCarry || g.carry != 0 {
+				t.Errorf("Test failed - player 0 has stack %d with %d carried, want %d with 0", g.players[0].Stack, g.carry, 1012+10+tt.wantCarry)
+			}
+		})
+	}
+}
+
+func TestChipConservation(t *testing.T) {
+	configs := []GameConfig{
+		{BigBlind: 25, SmallBlind: 10},
+		{BigBlind: 25, SmallBlind: 10, Limit: PotLimit, OddChip: OddChipHighCard},
+		{BigBlind: 25, SmallBlind: 10, Limit: FixedLimit, OddChip: OddChipCarry},
+		{BigBlind: 25, SmallBlind: 10, Variant: Omaha, HiLo: true, OddChip: OddChipCarry},
+		{BigBlind: 25, SmallBlind: 10, Ante: 5, Straddle: UTGStraddle},
+		{BigBlind: 25, SmallBlind: 10, Ante: 25, AnteType: BigBlindAnte, Limit: PotLimit},
+		{BigBlind: 25, SmallBlind: 10, Ante: 5, MaxRuns: 3, OddChip: OddChipHighCard},
+	}
+
+	for seed := int64(0); seed < 20*int64(len(configs)); seed++ {
+		config := configs[seed%int64(len(configs))]
+		r := rand.New(rand.NewSource(seed))
+		g := setupReadyGame(t, config, 333, 1000, 517, 2000, 91, 750)
+		g.SetRNG(r)
+
+		if config.Straddle != NoStraddle {
+			for pn := range g.players {
+				if err := ToggleStraddle(g, uint(pn), 0); err != nil {
+					t.Fatalf("Test failed - error toggling straddle: %s", err)
+				}
+			}
+		}
+
+		if config.MaxRuns > 1 {
+			for pn := range g.players {
+				if err := RunItTimes(g, uint(pn), 2+uint(pn)%2); err != nil {
+					t.Fatalf("Test failed - error choosing runs: %s", err)
+				}
+			}
+		}
+
+		var total uint = 0
+		for _, p := range g.players {
+			total += p.TotalBuyIn
+		}
+
+		for step := 0; step < 20000; step++ {
+			var err error
+
+			if !g.getBetting() {
+				if g.readyCount() < 2 {
+					break
+				}
+				err = Deal(g, g.dealerNum, 0)
+			} else {
+				pn := g.actionNum
+				legal := g.LegalActions(pn)
+
+				switch choice := r.Intn(20); {
+				case choice == 0 && legal.Fold:
+					err = Fold(g, pn, 0)
+				case choice == 1 && (legal.Bet || legal.Raise):
+					err = Bet(g, pn, legal.MaxBet)
+				case choice < 4 && (legal.Bet || legal.Raise):
+					err = Bet(g, pn, legal.MinRaise+uint(r.Intn(int(min(legal.MaxBet, 2*legal.MinRaise)-legal.MinRaise+1))))
+				default:
+					err = Bet(g, pn, legal.CallAmt)
+				}
+			}
+
+			if err != nil {
+				t.Fatalf("Test failed - %+v: error on step %d: %s", config, step, err)
+			}
+
+			got := g.carry
+			for _, p := range g.players {
+				got += p.Stack + p.TotalBet
+			}
+
+			if got != total {
+				t.Fatalf("Test failed - %+v: %d chips on the table after step %d, want %d", config, got, step, total)
+			}
+		}
+	}
+}
+
+func TestForcedBets(t *testing.T) {
+	anteCases := []struct {
+		name         string
+		config       GameConfig
+		wantBets     []uint
+		wantTotalBet []uint
+	}{
+		{
+			name:         "Antes",
+			config:       GameConfig{BigBlind: 25, SmallBlind: 10, Ante: 5},
+			wantBets:     []uint{0, 10, 25},
+			wantTotalBet: []uint{5, 15, 30},
+		},
+		{
+			name:         "Big blind ante",
+			config:       GameConfig{BigBlind: 25, SmallBlind: 10, Ante: 25, AnteType: BigBlindAnte},
+			wantBets:     []uint{0, 10, 25},
+			wantTotalBet: []uint{0, 10, 50},
+		},
+		{
+			name:         "Button ante",
+			config:       GameConfig{BigBlind: 25, SmallBlind: 10, Ante: 25, AnteType: ButtonAnte},
+			wantBets:     []uint{0, 10, 25},
+			wantTotalBet: []uint{25, 10, 25},
+		},
+	}
+
+	for _, tt := range anteCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			g := setupReadyGame(t, tt.config, 1000, 1000, 1000)
+
+			err = Deal(g, 0, 0)
+
+			if err != nil {
+				t.Fatalf("Test failed - error dealing: %s", err)
+			}
+
+			var total uint = 0
+			for i := range g.players {
+				if g.players[i].Bet != tt.wantBets[i] || g.players[i].TotalBet != tt.wantTotalBet[i] {
+					t.Errorf("Test failed - player %d has bet %d of %d, want %d of %d", i, g.players[i].Bet, g.players[i].TotalBet, tt.wantBets[i], tt.wantTotalBet[i])
+				}
+				total += tt.wantTotalBet[i]
+			}
+
+			if g.pots[0].Amt != total {
+				t.Errorf("Test failed - pot is %d, want %d", g.pots[0].Amt, total)
+			}
+
+			// Antes are dead, so they do not count towards calling
+			if got := g.LegalActions(0).CallAmt; got != 25 {
+				t.Errorf("Test failed - call amount is %d, want 25", got)
+			}
+
+			for _, pn := range []uint{0, 1} {
+				err = Fold(g, pn, 0)
+
+				if err != nil {
+					t.Errorf("Test failed - error folding: %s", err)
+				}
+			}
+
+			if want := 1000 - tt.wantTotalBet[2] + total; g.players[2].Stack != want {
+				t.Errorf("Test failed - winner has stack %d, want %d", g.players[2].Stack, want)
+			}
+		})
+	}
+
+	t.Run("Short-stacked big blind ante", func(t *testing.T) {
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Ante: 25, AnteType: BigBlindAnte}, 1000, 1000, 40)
+
+		var posted []EventType
+		g.Subscribe(func(e Event) {
+			if e.Type == BlindPosted || e.Type == AntePosted {
+				posted = append(posted, e.Type)
+			}
+		})
+
+		if err := Deal(g, 0, 0); err != nil {
+			t.Fatalf("Test failed - error dealing: %s", err)
+		}
+
+		// The big blind posts the whole blind first, and only the 15 chips left go to the ante
+		if p := g.players[2]; p.Bet != 25 || p.TotalBet != 40 || p.Stack != 0 {
+			t.Errorf("Test failed - big blind has bet %d of %d with %d left, want 25 of 40 with 0 left", p.Bet, p.TotalBet, p.Stack)
+		}
+
+		if want := []EventType{BlindPosted, BlindPosted, AntePosted}; !reflect.DeepEqual(posted, want) {
+			t.Errorf("Test failed - got %v posted, want %v", posted, want)
+		}
+
+		if got := g.LegalActions(0).CallAmt; got != 25 {
+			t.Errorf("Test failed - call amount is %d, want 25", got)
+		}
+	})
+
+	straddleCases := []struct {
+		name      string
+		straddle  StraddleType
+		straddler uint
+		order     []uint
+		calls     []uint
+	}{
+		{
+			name:      "UTG straddle",
+			straddle:  UTGStraddle,
+			straddler: 3,
+			order:     []uint{0, 1, 2, 3},
+			calls:     []uint{50, 40, 25, 0},
+		},
+		{
+			name:      "Mississippi straddle",
+			straddle:  MississippiStraddle,
+			straddler: 0,
+			order:     []uint{1, 2, 3, 0},
+			calls:     []uint{40, 25, 50, 0},
+		},
+	}
+
+	for _, tt := range straddleCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Straddle: tt.straddle}, 1000, 1000, 1000, 1000)
+
+			err = ToggleStraddle(g, tt.straddler, 0)
+
+			if err != nil {
+				t.Fatalf("Test failed - error toggling straddle: %s", err)
+			}
+
+			err = Deal(g, 0, 0)
+
+			if err != nil {
+				t.Fatalf("Test failed - error dealing: %s", err)
+			}
+
+			if g.players[tt.straddler].Bet != 50 {
+				t.Errorf("Test failed - straddler bet %d, want 50", g.players[tt.straddler].Bet)
+			}
+
+			// The minimum raise is to twice the straddle
+			first := tt.order[0]
+			if got := g.LegalActions(first); got.CallAmt != tt.calls[0] || got.MinRaise != 100-g.players[first].Bet {
+				t.Errorf("Test failed - first to act got %+v, want to call %d and raise to at least 100", got, tt.calls[0])
+			}
+
+			for i, pn := range tt.order {
+				if g.getStage() != PreFlop || g.actionNum != pn {
+					t.Fatalf("Test failed - got player %d to act at stage %v, want player %d pre-flop", g.actionNum, g.getStage(), pn)
+				}
+
+				err = Bet(g, pn, tt.calls[i])
+
+				if err != nil {
+					t.Errorf("Test failed - error betting: %s", err)
+				}
+			}
+
+			if g.getStage() != Flop {
+				t.Errorf("Test failed - stage is %v after the straddler checked their option, want %v", g.getStage(), Flop)
+			}
+		})
+	}
+
+	t.Run("Straddles not allowed", func(t *testing.T) {
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 1000, 1000)
+
+		if err := ToggleStraddle(g, 0, 0); !errors.Is(err, ErrNoStraddle) {
+			t.Errorf("Test failed - got %v, want %v", err, ErrNoStraddle)
+		}
+	})
+
+	t.Run("Missed blinds", func(t *testing.T) {
+		var err error
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, PostMissedBlinds: true}, 1000, 1000, 1000, 1000)
+
+		err = ToggleReady(g, 2, 0)
+
+		if err != nil {
+			t.Fatalf("Test failed - error marking not ready: %s", err)
+		}
+
+		// Player 2 sits between the blinds, then between the button and the small blind
+		hands := []struct{ dealer, sb, bb uint }{{0, 1, 3}, {1, 3, 0}}
+
+		for _, h := range hands {
+			err = Deal(g, h.dealer, 0)
+
+			if err != nil {
+				t.Fatalf("Test failed - error dealing: %s", err)
+			}
+
+			if g.sbNum != h.sb || g.bbNum != h.bb {
+				t.Fatalf("Test failed - blinds are %d and %d, want %d and %d", g.sbNum, g.bbNum, h.sb, h.bb)
+			}
+
+			for g.getStage() != PreDeal {
+				err = Fold(g, g.actionNum, 0)
+
+				if err != nil {
+					t.Fatalf("Test failed - error folding: %s", err)
+				}
+			}
+		}
+
+		if p := g.players[2]; !p.MissedSB || !p.MissedBB {
+			t.Errorf("Test failed - player 2 must have missed both blinds, got %+v", p)
+		}
+
+		err = ToggleReady(g, 2, 0)
+
+		if err != nil {
+			t.Fatalf("Test failed - error marking ready: %s", err)
+		}
+
+		err = Deal(g, 3, 0)
+
+		if err != nil {
+			t.Fatalf("Test failed - error dealing: %s", err)
+		}
+
+		if p := g.players[2]; p.Bet != 25 || p.TotalBet != 35 || p.MissedSB || p.MissedBB {
+			t.Errorf("Test failed - player 2 must post 25 live and 10 dead, got %+v", p)
+		}
+
+		// The live big blind gives the returning player the option
+		if got := g.LegalActions(2); !got.Check {
+			t.Errorf("Test failed - returning player must be able to check, got %+v", got)
+		}
+	})
+}
+
+func TestSitOut(t *testing.T) {
+	config := GameConfig{BigBlind: 25, SmallBlind: 10, PostMissedBlinds: true}
+
+	// foldAround folds every player in turn until the hand is over
+	foldAround := func(t *testing.T, g *Game) {
+		t.Helper()
+
+		for g.getBetting() {
+			if err := Fold(g, g.actionNum, 0); err != nil {
+				t.Fatalf("Test failed - error folding: %s", err)
+			}
+		}
+	}
+
+	deal := func(t *testing.T, g *Game) {
+		t.Helper()
+
+		if err := Deal(g, g.dealerNum, 0); err != nil {
+			t.Fatalf("Test failed - error dealing: %s", err)
+		}
+	}
+
+	// sitOutTwoHands deals the first hand, during which player 3 sits out, and the second, so that player 3 misses
+	// the big blind
+	sitOutTwoHands := func(t *testing.T) *Game {
+		g := setupReadyGame(t, config, 1000, 1000, 1000, 1000)
+
+		deal(t, g)
+
+		if err := SitOut(g, 3, 0); err != nil {
+			t.Fatalf("Test failed - error sitting out: %s", err)
+		}
+
+		if err := SitOut(g, 3, 0); !errors.Is(err, ErrSittingOut) {
+			t.Errorf("Test failed - expected ErrSittingOut, got %v", err)
+		}
+
+		if !g.players[3].In || !g.players[3].Ready {
+			t.Errorf("Test failed - a player who sits out must play out the hand they are in")
+		}
+
+		foldAround(t, g)
+
+		if g.players[3].Ready || !g.players[3].Seated {
+			t.Errorf("Test failed - expected player 3 to be sitting out in their seat, got %+v", g.players[3])
+		}
+
+		deal(t, g)
+
+		if g.players[3].In || g.bbNum != 0 || !g.players[3].MissedBB {
+			t.Errorf("Test failed - expected player 3 to be skipped and miss the big blind, got %+v", g.players[3])
+		}
+
+		foldAround(t, g)
+
+		return g
+	}
+
+	t.Run("Posting missed blinds", func(t *testing.T) {
+		g := sitOutTwoHands(t)
+
+		// Sitting out during the third hand misses the small blind as well
+		deal(t, g)
+		foldAround(t, g)
+
+		if !g.players[3].MissedSB {
+			t.Errorf("Test failed - expected player 3 to miss the small blind, got %+v", g.players[3])
+		}
+
+		if err := SitIn(g, 3, 0); err != nil {
+			t.Fatalf("Test failed - error sitting in: %s", err)
+		}
+
+		if err := SitIn(g, 3, 0); !errors.Is(err, ErrNotSittingOut) {
+			t.Errorf("Test failed - expected ErrNotSittingOut, got %v", err)
+		}
+
+		deal(t, g)
+
+		// The big blind is live, and the small blind is dead
+		p := g.players[3]
+		if !p.In || p.Bet != 25 || p.TotalBet != 35 || p.MissedSB || p.MissedBB {
+			t.Errorf("Test failed - expected player 3 to post 25 live and 10 dead, got %+v", p)
+		}
+
+		if got := g.LegalActions(3); !got.Check {
+			t.Errorf("Test failed - player 3 posted the big blind, so they must be able to check, got %+v", got)
+		}
+	})
+
+	t.Run("Waiting for the big blind", func(t *testing.T) {
+		g := sitOutTwoHands(t)
+
+		if err := SitIn(g, 3, WaitForBigBlind); err != nil {
+			t.Fatalf("Test failed - error sitting in: %s", err)
+		}
+
+		// The big blind reaches player 3 two hands later
+		for hand := 0; hand < 2; hand++ {
+			deal(t, g)
+
+			if g.players[3].In {
+				t.Errorf("Test failed - player 3 must wait for the big blind, but was dealt in on hand %d", hand)
+			}
+
+			foldAround(t, g)
+		}
+
+		deal(t, g)
+
+		p := g.players[3]
+		if !p.In || g.bbNum != 3 || p.TotalBet != 25 || p.MissedSB || p.MissedBB {
+			t.Errorf("Test failed - expected player 3 to be dealt in as the big blind, owing nothing else, got %+v", p)
+		}
+	})
+
+	t.Run("Waiting for the big blind heads up", func(t *testing.T) {
+		g := sitOutTwoHands(t)
+
+		if err := SitIn(g, 3, WaitForBigBlind); err != nil {
+			t.Fatalf("Test failed - error sitting in: %s", err)
+		}
+
+		// Once player 0 is the only one left ready, there is no big blind to wait for
+		for _, pn := range []uint{1, 2} {
+			if err := SitOut(g, pn, 0); err != nil {
+				t.Fatalf("Test failed - error sitting out: %s", err)
+			}
+		}
+
+		if p := g.players[3]; !p.Ready || p.WaitForBB {
+			t.Errorf("Test failed - expected player 3 to stop waiting, got %+v", p)
+		}
+
+		deal(t, g)
+
+		if !g.players[3].In {
+			t.Errorf("Test failed - expected player 3 to be dealt in heads up")
+		}
+	})
+
+	t.Run("Sitting in instead of waiting", func(t *testing.T) {
+		g := sitOutTwoHands(t)
+
+		if err := SitIn(g, 3, WaitForBigBlind); err != nil {
+			t.Fatalf("Test failed - error sitting in: %s", err)
+		}
+
+		if got := g.LegalActions(3); !got.SitIn {
+			t.Errorf("Test failed - a player waiting for the big blind must be able to sit in, got %+v", got)
+		}
+
+		if err := SitIn(g, 3, 0); err != nil {
+			t.Fatalf("Test failed - error sitting in: %s", err)
+		}
+
+		deal(t, g)
+
+		if !g.players[3].In {
+			t.Errorf("Test failed - expected player 3 to be dealt in")
+		}
+	})
+
+	t.Run("Joining a game in progress", func(t *testing.T) {
+		g := sitOutTwoHands(t)
+
+		pn, err := g.AddPlayer()
+		if err != nil {
+			t.Fatalf("Test failed - error adding player: %s", err)
+		}
+
+		if !g.players[pn].MissedBB {
+			t.Errorf("Test failed - a player joining a game in progress must owe a big blind")
+		}
+	})
+}
+
+func TestLeave(t *testing.T) {
+	g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 1000, 1000)
+
+	if err := Leave(g, 2, 0); err != nil {
+		t.Fatalf("Test failed - error leaving: %s", err)
+	}
+
+	if p := g.players[2]; p.Ready || !p.Left {
+		t.Errorf("Test failed - expected player 2 to be not ready and left, got ready %t, left %t", p.Ready, p.Left)
+	}
+
+	// A player who is already not ready stays that way when they leave
+	if err := ToggleReady(g, 1, 0); err != nil {
+		t.Fatalf("Test failed - error marking not ready: %s", err)
+	}
+
+	if err := Leave(g, 1, 0); err != nil {
+		t.Fatalf("Test failed - error leaving: %s", err)
+	}
+
+	if p := g.players[1]; p.Ready || !p.Left {
+		t.Errorf("Test failed - expected player 1 to be not ready and left, got ready %t, left %t", p.Ready, p.Left)
+	}
+
+	// Coming back clears it
+	if err := ToggleReady(g, 1, 0); err != nil {
+		t.Fatalf("Test failed - error marking ready: %s", err)
+	}
+
+	if p := g.players[1]; !p.Ready || p.Left {
+		t.Errorf("Test failed - expected player 1 to be ready and not left, got ready %t, left %t", p.Ready, p.Left)
+	}
+}
+
+func TestButtonRules(t *testing.T) {
+	tests := []struct {
+		name        string
+		rule        ButtonRule
+		wantDealers []uint
+		wantSBs     []uint
+		wantBBs     []uint
+		wantBlinds  []uint
+	}{
+		{
+			name:        "Moving button",
+			rule:        MovingButton,
+			wantDealers: []uint{0, 1, 3, 4, 0, 1},
+			wantSBs:     []uint{1, 3, 4, 0, 1, 3},
+			wantBBs:     []uint{2, 4, 0, 1, 3, 4},
+			wantBlinds:  []uint{35, 35, 35, 35, 35, 35},
+		},
+		{
+			// Player 3 still pays the big blind after player 2 leaves, and the button passes over the empty seat
+			name:        "Dead button",
+			rule:        DeadButton,
+			wantDealers: []uint{0, 1, 2, 3, 4, 0},
+			wantSBs:     []uint{1, 2, 3, 4, 0, 1},
+			wantBBs:     []uint{2, 3, 4, 0, 1, 3},
+			wantBlinds:  []uint{35, 25, 35, 35, 35, 35},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Button: tt.rule}, 1000, 1000, 1000, 1000, 1000)
+
+			for hand := range tt.wantDealers {
+				dealer := g.dealingNum()
+				if g.players[g.dealerNum].Seated && dealer != g.dealerNum {
+					t.Errorf("Test failed - hand %d: the player on the button must deal", hand)
+				}
+
+				if err := Deal(g, dealer, 0); err != nil {
+					t.Fatalf("Test failed - hand %d: error dealing: %s", hand, err)
+				}
+
+				if g.dealerNum != tt.wantDealers[hand] || g.sbNum != tt.wantSBs[hand] || g.bbNum != tt.wantBBs[hand] {
+					t.Errorf("Test failed - hand %d: got button %d, small blind %d, big blind %d, want %d, %d, %d",
+						hand, g.dealerNum, g.sbNum, g.bbNum, tt.wantDealers[hand], tt.wantSBs[hand], tt.wantBBs[hand])
+				}
+
+				var blinds uint = 0
+				for _, p := range g.players {
+					blinds += p.Bet
+				}
+
+				if blinds != tt.wantBlinds[hand] {
+					t.Errorf("Test failed - hand %d: got %d in blinds, want %d", hand, blinds, tt.wantBlinds[hand])
+				}
+
+				for g.getBetting() {
+					if err := Fold(g, g.actionNum, 0); err != nil {
+						t.Fatalf("Test failed - hand %d: error folding: %s", hand, err)
+					}
+				}
+
+				if hand == 0 {
+					if err := StandUp(g, 2, 0); err != nil {
+						t.Fatalf("Test failed - error standing up: %s", err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRunItTimes(t *testing.T) {
+	cards := func(strs ...string) []Card {
+		ret := make([]Card, len(strs))
+		for i, str := range strs {
+			ret[i] = MustParseCardString(str)
+		}
+		return ret
+	}
+
+	// The deck is dealt from the end
+	deck := cards("5C", "4S", "9H", "7C", "2D", "3H", "KD", "9S", "7D", "2C")
+
+	tests := []struct {
+		name       string
+		runs       []uint
+		wantStacks []uint
+		wantRuns   [][]Card
+	}{
+		{
+			name:       "Run it twice",
+			runs:       []uint{2, 2},
+			wantStacks: []uint{1000, 1000},
+			wantRuns:   [][]Card{cards("2D", "7C", "9H", "4S", "5C")},
+		},
+		{
+			name:       "One player refuses",
+			runs:       []uint{2, 1},
+			wantStacks: []uint{0, 2000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, MaxRuns: 2}, 1000, 1000)
+
+			for pn, runs := range tt.runs {
+				if err := RunItTimes(g, uint(pn), runs); err != nil {
+					t.Fatalf("Test failed - error choosing runs: %s", err)
+				}
+			}
+
+			var streets []Event
+			g.Subscribe(func(e Event) {
+				if e.Type == StreetDealt {
+					streets = append(streets, e)
+				}
+			})
+
+			if err := Deal(g, 0, 0); err != nil {
+				t.Fatalf("Test failed - error dealing: %s", err)
+			}
+
+			copy(g.players[0].Cards, cards("AS", "AH"))
+			copy(g.players[1].Cards, cards("KS", "KH"))
+			g.deck = append(Deck{}, deck...)
+
+			if err := Bet(g, 0, 1000); err != nil {
+				t.Fatalf("Test failed - error going all-in: %s", err)
+			}
+
+			if err := Bet(g, 1, g.LegalActions(1).CallAmt); err != nil {
+				t.Fatalf("Test failed - error calling: %s", err)
+			}
+
+			view := g.GenerateOmniView()
+
+			for pn, want := range tt.wantStacks {
+				if view.Players[pn].Stack != want {
+					t.Errorf("Test failed - expected player %d to have %d, got %d", pn, want, view.Players[pn].Stack)
+				}
+			}
+
+			if !reflect.DeepEqual(view.CommunityCards, cards("2C", "7D", "9S", "KD", "3H")) {
+				t.Errorf("Test failed - got first board %v", view.CommunityCards)
+			}
+
+			if !reflect.DeepEqual(view.Runs, tt.wantRuns) {
+				t.Errorf("Test failed - got extra boards %v, want %v", view.Runs, tt.wantRuns)
+			}
+
+			if want := 3 * (len(tt.wantRuns) + 1); len(streets) != want {
+				t.Errorf("Test failed - got %d streets dealt, want %d", len(streets), want)
+			}
+
+			var text strings.Builder
+			if err := g.LastHandHistory().WriteText(&text, nil); err != nil {
+				t.Fatalf("Test failed - error writing history: %s", err)
+			}
+
+			if got := strings.Contains(text.String(), "SECOND Board [2d 7c 9h 4s 5c]"); got != (tt.wantRuns != nil) {
+				t.Errorf("Test failed - unexpected board summary in history:\n%s", text.String())
+			}
+		})
+	}
+
+	t.Run("Illegal choices", func(t *testing.T) {
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, MaxRuns: 2}, 1000, 1000)
+
+		for _, runs := range []uint{0, 3} {
+			if err := RunItTimes(g, 0, runs); !errors.Is(err, ErrTooManyRuns) {
+				t.Errorf("Test failed - running %d times: expected ErrTooManyRuns, got %v", runs, err)
+			}
+		}
+	})
+
+	t.Run("Players still betting", func(t *testing.T) {
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, MaxRuns: 2}, 1000, 500, 1000)
+
+		for pn := range g.players {
+			if err := RunItTimes(g, uint(pn), 2); err != nil {
+				t.Fatalf("Test failed - error choosing runs: %s", err)
+			}
+		}
+
+		if err := Deal(g, 0, 0); err != nil {
+			t.Fatalf("Test failed - error dealing: %s", err)
+		}
+
+		// Player 0 calls under the gun, the small blind shoves, and both other players call
+		steps := []struct {
+			pn  uint
+			amt uint
+		}{{0, 25}, {1, 490}, {2, 475}, {0, 475}}
+
+		for _, s := range steps {
+			if err := Bet(g, s.pn, s.amt); err != nil {
+				t.Fatalf("Test failed - error betting %d for player %d: %s", s.amt, s.pn, err)
+			}
+		}
+
+		// Players 0 and 2 are still covered, so the board can't be run more than once yet
+		if stage := g.getStage(); stage != Flop || !g.getBetting() {
+			t.Fatalf("Test failed - expected betting on the flop, got stage %v", stage)
+		}
+
+		if runs := g.GenerateOmniView().Runs; len(runs) != 0 {
+			t.Errorf("Test failed - expected no extra boards, got %v", runs)
+		}
+	})
+}
+
+func TestAutoRunout(t *testing.T) {
+	t.Run("Heads up all-in", func(t *testing.T) {
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 1000)
+
+		var streets []GameStage
+		g.Subscribe(func(e Event) {
+			if e.Type == StreetDealt {
+				streets = append(streets, e.Stage)
+			}
+		})
+
+		if err := Deal(g, 0, 0); err != nil {
+			t.Fatalf("Test failed - error dealing: %s", err)
+		}
+
+		if err := Bet(g, 0, 1000); err != nil {
+			t.Fatalf("Test failed - error going all-in: %s", err)
+		}
+
+		if err := Bet(g, 1, g.LegalActions(1).CallAmt); err != nil {
+			t.Fatalf("Test failed - error calling: %s", err)
+		}
+
+		if stage := g.getStage(); stage != PreDeal {
+			t.Errorf("Test failed - expected the hand to be over, got stage %v", stage)
+		}
+
+		if want := []GameStage{Flop, Turn, River}; !reflect.DeepEqual(streets, want) {
+			t.Errorf("Test failed - got streets %v, want %v", streets, want)
+		}
+
+		if total := g.players[0].Stack + g.players[1].Stack; total != 2000 {
+			t.Errorf("Test failed - expected 2000 chips after the hand, got %d", total)
+		}
+	})
+
+	t.Run("All-in player skipped", func(t *testing.T) {
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 500, 1000)
+
+		if err := Deal(g, 0, 0); err != nil {
+			t.Fatalf("Test failed - error dealing: %s", err)
+		}
+
+		// Player 0 calls under the gun, the small blind shoves, and the big blind calls
+		steps := []struct {
+			pn  uint
+			amt uint
+		}{{0, 25}, {1, 490}, {2, 475}, {0, 475}}
+
+		for _, s := range steps {
+			if err := Bet(g, s.pn, s.amt); err != nil {
+				t.Fatalf("Test failed - error betting %d for player %d: %s", s.amt, s.pn, err)
+			}
+		}
+
+		// Two players can still bet, so the flop is played out, starting after the all-in small blind
+		if stage := g.getStage(); stage != Flop || !g.getBetting() {
+			t.Fatalf("Test failed - expected betting on the flop, got stage %v", stage)
+		}
+
+		if g.actionNum != 2 {
+			t.Errorf("Test failed - expected player 2 to act first, got player %d", g.actionNum)
+		}
+
+		if err := Bet(g, 2, 500); err != nil {
+			t.Fatalf("Test failed - error going all-in: %s", err)
+		}
+
+		if err := Fold(g, 0, 0); err != nil {
+			t.Fatalf("Test failed - error folding: %s", err)
+		}
+
+		if stage := g.getStage(); stage != PreDeal {
+			t.Errorf("Test failed - expected the hand to be over, got stage %v", stage)
+		}
+	})
+}
@@ -25,18 +25,168 @@ package riverboat
 
 import (
 	"errors"
+	"fmt"
 )
 
-//TODO: wrap more specific messages inside ErrIllegalAction
-
 // ErrIllegalAction is returned when an Action is valid (all parameters are well-formed), but
 // it is illegal per the laws of poker given the state of the game at that time. This could include
 // illegal bet amounts, out-of-turn plays, or other violations of the rules.
+//
+// Actions never return ErrIllegalAction itself, but rather one of the more specific errors below,
+// all of which satisfy errors.Is(err, ErrIllegalAction).
 var ErrIllegalAction = errors.New("this action cannot be performed at this time")
 
-/*
-var ErrBuyTooBig = errors.New("this would exceed the maximum configured purchased stack size")
-var ErrNotEnoughPlayers = errors.New("need more players to start the round")
-*/
+// ErrNotYourTurn is returned when a player attempts to bet or fold out of turn.
+var ErrNotYourTurn = newIllegalAction("it is not this player's turn to act")
+
+// ErrNotBetting is returned when a player attempts to bet or fold when no betting round is in progress.
+var ErrNotBetting = newIllegalAction("there is no betting round in progress")
+
+// ErrStillBetting is returned when the dealer attempts to deal before the current betting round is over.
+var ErrStillBetting = newIllegalAction("the current betting round is not over")
+
+// ErrNotDealer is returned when a player who is not the dealer attempts to deal.
+var ErrNotDealer = newIllegalAction("only the dealer can deal")
+
+// ErrNotEnoughPlayers is returned when the dealer attempts to deal with fewer than 2 players ready.
+var ErrNotEnoughPlayers = newIllegalAction("need more players to start the round")
+
+// ErrPlayerInHand is returned when a player attempts an action that is only allowed between hands
+// (like buying in, or marking themselves not ready) while they are in the current hand.
+var ErrPlayerInHand = newIllegalAction("this player is in the current hand")
+
+// ErrHandInProgress is returned when attempting to change the game while a hand is being played.
+var ErrHandInProgress = newIllegalAction("a hand is in progress")
+
+// ErrBuyTooBig is returned when a buy in would exceed the maximum configured purchased stack size.
+var ErrBuyTooBig = newIllegalAction("this would exceed the maximum configured purchased stack size")
+
+// ErrNoChips is returned when a player with no chips attempts to mark themselves ready.
+var ErrNoChips = newIllegalAction("this player has no chips")
+
+// ErrNoStraddle is returned when a player attempts to toggle straddling in a game that does not allow straddles.
+var ErrNoStraddle = newIllegalAction("straddles are not allowed in this game")
+
+// ErrSittingOut is returned when a player who is already sitting out attempts to sit out.
+var ErrSittingOut = newIllegalAction("this player is already sitting out")
+
+// ErrNotSittingOut is returned when a player who is not sitting out attempts to sit in.
+var ErrNotSittingOut = newIllegalAction("this player is not sitting out")
+
+// ErrTooManyRuns is returned when a player attempts to run the board more times than the game allows, or 0 times.
+var ErrTooManyRuns = newIllegalAction("the board cannot be run this many times")
+
+// ErrRakeAndTimeFee is returned when a game is configured to both rake pots and charge for time.
+var ErrRakeAndTimeFee = newIllegalAction("a game cannot both rake pots and charge for time")
+
+// ErrTournamentStarted is returned when a player attempts to register for, or start, a tournament that has already started.
+var ErrTournamentStarted = newIllegalAction("the tournament has already started")
+
+// ErrRebuyClosed is returned when a player attempts to rebuy or add on outside of the rebuy period.
+var ErrRebuyClosed = newIllegalAction("rebuys and add-ons are closed")
+
+// ErrRebuyTooBig is returned when a player attempts to rebuy while they have more than the starting stack.
+var ErrRebuyTooBig = newIllegalAction("rebuys are only allowed with no more than the starting stack")
+
+// ErrAddOnTaken is returned when a player who has already taken an add-on attempts to take another.
+var ErrAddOnTaken = newIllegalAction("this player has already taken an add-on")
+
+// ErrEliminated is returned when a player who has been eliminated from a tournament attempts to rebuy, add on,
+// buy in, or be marked ready.
+var ErrEliminated = newIllegalAction("this player has been eliminated")
+
+// ErrPayoutsTooHigh is returned when a tournament is configured to pay out more than its whole prize pool.
+var ErrPayoutsTooHigh = newIllegalAction("the payouts add up to more than the prize pool")
+
+// ErrTournamentNotStarted is returned when a hand is dealt in a tournament that has not started yet.
+var ErrTournamentNotStarted = newIllegalAction("the tournament has not started yet")
+
+// ErrDealtByMTT is returned when a hand at a table of a multi-table tournament is dealt with the Deal Action,
+// rather than the Deal method of the MTT.
+var ErrDealtByMTT = newIllegalAction("hands at this table are dealt by the tournament")
+
+// ErrTableBroken is returned when a hand is dealt at a table that has been broken up.
+var ErrTableBroken = newIllegalAction("this table has been broken up")
+
+// ErrTableWaiting is returned when a hand is dealt at a table that must wait for the other tables, because play is
+// hand-for-hand, or because the table is being broken up.
+var ErrTableWaiting = newIllegalAction("this table must wait for the other tables")
+
+// ErrTableFull is returned when a player attempts to sit down at a table with no empty seats.
+var ErrTableFull = newIllegalAction("every seat at this table is taken")
+
+// ErrSeatTaken is returned when a player attempts to sit down in a seat that is already taken, or when the number
+// of seats is reduced below a seat that is taken.
+var ErrSeatTaken = newIllegalAction("this seat is taken")
+
+// ErrNoSuchSeat is returned when a player attempts to sit down in a seat that the table does not have.
+var ErrNoSuchSeat = newIllegalAction("this table does not have this seat")
+
+// ErrNotSeated is returned when a player attempts to stand up from a seat that is empty.
+var ErrNotSeated = newIllegalAction("this seat is empty")
+
+// ErrTooManySeats is returned when a game is configured with more seats than there are cards to deal to.
+var ErrTooManySeats = newIllegalAction("a table cannot have this many seats")
+
+// ErrAlreadyWaiting is returned when a player attempts to join a waiting list they are already on.
+var ErrAlreadyWaiting = newIllegalAction("this player is already on the waiting list")
+
+// ErrWaitingListEmpty is returned when attempting to seat a player from an empty waiting list.
+var ErrWaitingListEmpty = newIllegalAction("the waiting list is empty")
+
+// ErrBelowCall is wrapped by the BetError returned when a bet is less than the amount needed to call,
+// and the player is not going all-in.
+var ErrBelowCall = newIllegalAction("bet is less than the amount needed to call")
+
+// ErrBelowMinRaise is wrapped by the BetError returned when a bet is more than a call, but less than the
+// minimum raise, and the player is not going all-in.
+var ErrBelowMinRaise = newIllegalAction("raise is less than the minimum raise")
+
+// ErrAboveLimit is wrapped by the BetError returned when a bet is more than the betting structure allows.
+// This includes any raise once the betting has been capped.
+var ErrAboveLimit = newIllegalAction("bet is more than the betting limit")
+
+// BetError is returned by Bet when the amount bet is illegal. It wraps one of ErrBelowCall,
+// ErrBelowMinRaise, or ErrAboveLimit (so it satisfies errors.Is(err, ErrIllegalAction) as well), and
+// carries the bounds on legal bets at the time, in the same terms as the data passed to Bet.
+type BetError struct {
+	Err error
+	// Call is the amount needed to call (0 if the player can check)
+	Call uint
+	// MinRaise is the smallest amount that is a legal raise. It is only meaningful if CanRaise is true.
+	MinRaise uint
+	// MaxBet is the largest amount that is a legal bet, not taking the size of the player's stack into account.
+	MaxBet uint
+	// CanRaise is false if the player may only call (or check) or fold.
+	CanRaise bool
+}
+
+func (e *BetError) Error() string {
+	if !e.CanRaise {
+		return fmt.Sprintf("%s (call: %d, raising is not allowed)", e.Err, e.Call)
+	}
+	return fmt.Sprintf("%s (call: %d, min raise: %d, max bet: %d)", e.Err, e.Call, e.MinRaise, e.MaxBet)
+}
+
+// Unwrap returns the underlying error, for use with errors.Is and errors.As.
+func (e *BetError) Unwrap() error {
+	return e.Err
+}
+
+type illegalActionError struct {
+	msg string
+}
+
+func newIllegalAction(msg string) error {
+	return &illegalActionError{msg: msg}
+}
+
+func (e *illegalActionError) Error() string {
+	return e.msg
+}
+
+func (e *illegalActionError) Is(target error) bool {
+	return target == ErrIllegalAction
+}
 
 var errInternalBadGameStage = errors.New("internal error: bad game stage")
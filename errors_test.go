@@ -0,0 +1,87 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrors(t *testing.T) {
+
+	t.Run("Specific errors are illegal actions", func(t *testing.T) {
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, MaxBuy: 1000}, 1000, 1000, 1000)
+
+		tests := []struct {
+			name string
+			err  error
+			want error
+		}{
+			{"Not dealer", Deal(g, 1, 0), ErrNotDealer},
+			{"Not betting", Bet(g, 0, 0), ErrNotBetting},
+			{"Buy too big", BuyIn(g, 0, 1), ErrBuyTooBig},
+			{"Dealt", Deal(g, 0, 0), nil},
+			{"Still betting", Deal(g, 0, 0), ErrStillBetting},
+			{"Not your turn", Bet(g, 1, 25), ErrNotYourTurn},
+			{"Player in hand", ToggleReady(g, 1, 0), ErrPlayerInHand},
+			{"Hand in progress", g.SetConfig(GameConfig{}), ErrHandInProgress},
+		}
+
+		for _, tt := range tests {
+			if !errors.Is(tt.err, tt.want) {
+				t.Errorf("%s: got %v, want %v", tt.name, tt.err, tt.want)
+			}
+
+			if tt.want != nil && !errors.Is(tt.err, ErrIllegalAction) {
+				t.Errorf("%s: %v must satisfy errors.Is(err, ErrIllegalAction)", tt.name, tt.err)
+			}
+		}
+	})
+
+	t.Run("BetError carries the legal bounds", func(t *testing.T) {
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Limit: PotLimit}, 1000, 1000, 1000)
+
+		err := Deal(g, 0, 0)
+
+		if err != nil {
+			t.Errorf("Test failed - error dealing: %s", err)
+		}
+
+		err = Bet(g, 0, 30)
+
+		var betErr *BetError
+		if !errors.As(err, &betErr) {
+			t.Fatalf("Test failed - got %v, want a *BetError", err)
+		}
+
+		if !errors.Is(err, ErrBelowMinRaise) || !errors.Is(err, ErrIllegalAction) {
+			t.Errorf("Test failed - got %v, want ErrBelowMinRaise", err)
+		}
+
+		want := BetError{Err: ErrBelowMinRaise, Call: 25, MinRaise: 50, MaxBet: 85, CanRaise: true}
+		if *betErr != want {
+			t.Errorf("Test failed - got %+v, want %+v", *betErr, want)
+		}
+	})
+}
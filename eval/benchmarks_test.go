@@ -272,6 +272,46 @@ func BenchmarkSixRiverboat(b *testing.B) {
 	}
 }
 
+func BenchmarkSixRiverboatArray(b *testing.B) {
+	var cardsRiverboat [][]Card
+	for _, s := range dataRiverboat6 {
+		var cards []Card
+		for _, ss := range s {
+			c, _ := ParseCardBytes(ss)
+			cards = append(cards, c)
+		}
+		cardsRiverboat = append(cardsRiverboat, cards)
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, cards := range cardsRiverboat {
+			BestFiveOfSixArray(cards[0], cards[1], cards[2], cards[3], cards[4], cards[5])
+		}
+	}
+}
+
+func BenchmarkSixRiverboatValue(b *testing.B) {
+	var cardsRiverboat [][]Card
+	for _, s := range dataRiverboat6 {
+		var cards []Card
+		for _, ss := range s {
+			c, _ := ParseCardBytes(ss)
+			cards = append(cards, c)
+		}
+		cardsRiverboat = append(cardsRiverboat, cards)
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, cards := range cardsRiverboat {
+			HandValue6(cards[0], cards[1], cards[2], cards[3], cards[4], cards[5])
+		}
+	}
+}
+
 func BenchmarkSevenJoker(b *testing.B) {
 	var cardsJoker7 [][]hand.Card
 	for score := range dataJoker7 {
@@ -325,3 +365,63 @@ func BenchmarkSevenRiverboat(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkSevenRiverboatArray(b *testing.B) {
+	var cardsRiverboat [][]Card
+	for _, s := range dataRiverboat7 {
+		var cards []Card
+		for _, ss := range s {
+			c, _ := ParseCardBytes(ss)
+			cards = append(cards, c)
+		}
+		cardsRiverboat = append(cardsRiverboat, cards)
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, cards := range cardsRiverboat {
+			BestFiveOfSevenArray(cards[0], cards[1], cards[2], cards[3], cards[4], cards[5], cards[6])
+		}
+	}
+}
+
+func BenchmarkSevenRiverboatValue(b *testing.B) {
+	var cardsRiverboat [][]Card
+	for _, s := range dataRiverboat7 {
+		var cards []Card
+		for _, ss := range s {
+			c, _ := ParseCardBytes(ss)
+			cards = append(cards, c)
+		}
+		cardsRiverboat = append(cardsRiverboat, cards)
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, cards := range cardsRiverboat {
+			HandValue7(cards[0], cards[1], cards[2], cards[3], cards[4], cards[5], cards[6])
+		}
+	}
+}
+
+func BenchmarkSevenRiverboatLookup(b *testing.B) {
+	var cardsRiverboat [][]Card
+	for _, s := range dataRiverboat7 {
+		var cards []Card
+		for _, ss := range s {
+			c, _ := ParseCardBytes(ss)
+			cards = append(cards, c)
+		}
+		cardsRiverboat = append(cardsRiverboat, cards)
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, cards := range cardsRiverboat {
+			LookupHandValue7(cards[0], cards[1], cards[2], cards[3], cards[4], cards[5], cards[6])
+		}
+	}
+}
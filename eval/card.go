@@ -25,10 +25,12 @@ package eval
 
 import (
 	"bytes"
+	crand "crypto/rand"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
 	"regexp"
 	"time"
@@ -203,9 +205,52 @@ func (d *Deck) IsEmpty() bool { return (len(*d) == 0) }
 func (d *Deck) Push(card Card) { *d = append(*d, card) }
 
 // Shuffle resets the contents of d and performs a Fisher-Yates shuffle. Post-condition: d contains all 52 unique cards, in a normally distributed random order.
+// Shuffle draws from crypto/rand (see CryptoRNG), so the order cannot be predicted. To shuffle reproducibly, for
+// example in tests, use ShuffleWith and a seeded RNG instead.
 func (d *Deck) Shuffle() {
+	d.ShuffleWith(CryptoRNG{})
+}
+
+// RNG is a source of randomness for shuffling. Intn must return a uniformly distributed random number in [0, n).
+// *math/rand.Rand satisfies RNG, so a deterministic RNG can be created from a seed with rand.New(rand.NewSource(seed)).
+type RNG interface {
+	Intn(n int) int
+}
+
+// ShuffleWith is the same as Shuffle, except it draws its randomness from r rather than crypto/rand.
+// Given an RNG in the same state, ShuffleWith always produces the same order.
+func (d *Deck) ShuffleWith(r RNG) {
 	*d = append([]Card{}, DefaultDeck...)
-	rand.Shuffle(len(*d), func(i, j int) { (*d)[i], (*d)[j] = (*d)[j], (*d)[i] })
+	for i := len(*d) - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		(*d)[i], (*d)[j] = (*d)[j], (*d)[i]
+	}
+}
+
+// CryptoRNG is an RNG backed by crypto/rand. Intn uses rejection sampling, so every result in [0, n) is exactly
+// equally likely. Intn panics if n <= 0, or if the operating system's source of randomness fails.
+type CryptoRNG struct{}
+
+// Intn returns a uniformly distributed, cryptographically secure random number in [0, n).
+func (CryptoRNG) Intn(n int) int {
+	if n <= 0 {
+		panic("eval: invalid argument to Intn")
+	}
+
+	max := uint64(n)
+	// rem is 2^64 mod max. Discarding the top rem values leaves a range that is an exact multiple of max.
+	rem := (math.MaxUint64%max + 1) % max
+
+	var b [8]byte
+	for {
+		if _, err := io.ReadFull(crand.Reader, b[:]); err != nil {
+			panic(fmt.Sprintf("eval: reading from crypto/rand: %s", err))
+		}
+
+		if v := binary.BigEndian.Uint64(b[:]); v <= math.MaxUint64-rem {
+			return int(v % max)
+		}
+	}
 }
 
 var cardRE *regexp.Regexp
@@ -25,6 +25,8 @@ package eval
 
 import (
 	"fmt"
+	"math/rand"
+	"reflect"
 	"testing"
 )
 
@@ -249,6 +251,82 @@ func TestShuffledDeck(t *testing.T) {
 	})
 }
 
+func TestShuffleWith(t *testing.T) {
+	var testDeck Deck
+	var testDeckTwo Deck
+	var testDeckThree Deck
+
+	testDeck.ShuffleWith(rand.New(rand.NewSource(42)))
+	testDeckTwo.ShuffleWith(rand.New(rand.NewSource(42)))
+	testDeckThree.ShuffleWith(rand.New(rand.NewSource(43)))
+
+	t.Run("Len", func(t *testing.T) {
+		result := len(testDeck)
+		if result != 52 {
+			t.Errorf("\nFAIL: \nWant: %d \nGot: %d \n", 52, result)
+		}
+	})
+
+	t.Run("AllCards", func(t *testing.T) {
+		seen := map[Card]bool{}
+		for _, card := range testDeck {
+			seen[card] = true
+		}
+		for _, card := range DefaultDeck {
+			if !seen[card] {
+				t.Errorf("\nFAIL: \n Card %s missing from shuffled deck", card)
+			}
+		}
+	})
+
+	t.Run("Repeatable", func(t *testing.T) {
+		if !reflect.DeepEqual(testDeck, testDeckTwo) {
+			t.Errorf("\nFAIL: \n Two decks shuffled with the same seed differ.\n%v\n%v\n", testDeck, testDeckTwo)
+		}
+	})
+
+	t.Run("SeedDependent", func(t *testing.T) {
+		if reflect.DeepEqual(testDeck, testDeckThree) {
+			t.Errorf("\nFAIL: \n Two decks shuffled with different seeds are identical.\n%v\n%v\n", testDeck, testDeckThree)
+		}
+	})
+}
+
+func TestCryptoRNG(t *testing.T) {
+	r := CryptoRNG{}
+
+	t.Run("Range", func(t *testing.T) {
+		counts := make([]int, 6)
+		for i := 0; i < 6000; i++ {
+			n := r.Intn(6)
+			if n < 0 || n >= 6 {
+				t.Fatalf("\nFAIL: \nWant: [0, 6) \nGot: %d \n", n)
+			}
+			counts[n]++
+		}
+
+		// Each count has a standard deviation of ~29, so this only fails if something is badly wrong
+		for n, c := range counts {
+			if c < 800 || c > 1200 {
+				t.Errorf("\nFAIL: \n %d was drawn %d times out of 6000", n, c)
+			}
+		}
+	})
+
+	t.Run("Shuffle", func(t *testing.T) {
+		var testDeck Deck
+		testDeck.ShuffleWith(r)
+
+		seen := map[Card]bool{}
+		for _, card := range testDeck {
+			seen[card] = true
+		}
+		if len(testDeck) != 52 || len(seen) != 52 {
+			t.Errorf("\nFAIL: \n Shuffled deck does not contain 52 unique cards: %v", testDeck)
+		}
+	})
+}
+
 func TestPop(t *testing.T) {
 	var testDeckLen Deck
 	var testDeckEmpty Deck
@@ -62,76 +62,255 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/hex"
+	"flag"
 	"fmt"
 	"io"
 	"math/rand"
 	"os"
 	"sort"
+	"strings"
 	"text/template"
 	"time"
 )
 
 func main() {
-	var best *CHDPoker
-	var h *CHDPoker
-	var err error
-	for i := 0; i < 100; i++ {
-		b := BuilderPoker()
-		for j := range keys {
-			b.Add(keys[j], values[j])
-		}
+	table := flag.String("table", "five", "the table to build: five, for 5-card hands, or seven, for the rank multisets of 7-card hands")
+	flag.Parse()
+
+	switch *table {
+	case "five":
+		buildFive()
+	case "seven":
+		buildSeven()
+	default:
+		fmt.Printf("unknown table %q\n", *table)
+	}
+}
+
+func buildFive() {
+	best, err := buildBest(keys, values, 100)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 
-		h, err = b.Build()
+	fmt.Printf("Created minimal perfect hashtable (r = %d)\n", len(best.r))
 
-		if err != nil {
-			fmt.Println(err)
+	lit := serialize(best)
+
+	f, err := os.Create("matched_card_hashes.go")
+	if err != nil {
+		fmt.Println(err)
+	}
+	defer f.Close()
+
+	t.Execute(f, struct {
+		Timestamp time.Time
+		R         int
+		Contents  string
+	}{
+		Timestamp: time.Now(),
+		R:         len(best.r),
+		Contents:  lit,
+	})
+
+}
+
+// buildSeven builds the hash table of every multiset of 7 ranks that can be dealt, keyed by how many of each rank
+// there are, in base 5, to the score of the best 5-card hand they make without a flush. 7 cards that make a flush
+// cannot make a full house or quads, so the flush and straight flush scores are left to the evaluator.
+func buildSeven() {
+	var keys7 []uint32
+	var values7 []uint16
+
+	var counts [13]int
+	var walk func(rank int, left int)
+	walk = func(rank int, left int) {
+		if left == 0 {
+			keys7 = append(keys7, sevenKey(counts))
+			values7 = append(values7, sevenValue(counts))
 			return
 		}
 
-		if best == nil || len(h.r) < len(best.r) {
-			best = h
+		if rank == 13 {
+			return
 		}
-	}
-
-	fmt.Printf("Created minimal perfect hashtable (r = %d)\n", len(best.r))
 
-	//w, err := os.Create("matched_card_hashes.idx")
-	buf := bytes.Buffer{}
-	w := bufio.NewWriterSize(&buf, (4888*2)+(2444*2)+(len(best.r)*8))
+		for n := 0; n <= 4 && n <= left; n++ {
+			counts[rank] = n
+			walk(rank+1, left-n)
+		}
+		counts[rank] = 0
+	}
+	walk(0, 7)
 
+	best, err := buildBest(keys7, values7, 5)
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
 
-	_ = best.Write(w)
+	fmt.Printf("Created minimal perfect hashtable of %d rank multisets (r = %d)\n", len(keys7), len(best.r))
 
-	fmt.Printf("Serialized hashtable is %d bytes (%d bytes of total space in buffer).\n", w.Buffered(), w.Size())
-	lit := ""
-	w.Flush()
-	for i, b := range buf.Bytes() {
-		if i%12 == 0 {
-			lit = lit + "\n"
-		}
-		lit = lit + fmt.Sprintf("0x%s, ", hex.EncodeToString([]byte{b}))
-	}
+	lit := serialize(best)
 
-	f, err := os.Create("matched_card_hashes.go")
+	f, err := os.Create("seven_card_hashes.go")
 	if err != nil {
 		fmt.Println(err)
+		return
 	}
 	defer f.Close()
 
-	t.Execute(f, struct {
+	tSeven.Execute(f, struct {
 		Timestamp time.Time
 		R         int
+		Indices   int
+		Values    int
 		Contents  string
 	}{
 		Timestamp: time.Now(),
 		R:         len(best.r),
+		Indices:   len(best.indices),
+		Values:    len(best.values),
 		Contents:  lit,
 	})
+}
+
+// sevenKey returns the key of a multiset of ranks, counts[r] being the number of cards of rank r: the counts, as the
+// digits of a base 5 number, from the deuce up
+func sevenKey(counts [13]int) uint32 {
+	var key uint32 = 0
+	for r := 12; r >= 0; r-- {
+		key = key*5 + uint32(counts[r])
+	}
+	return key
+}
+
+// sevenValue returns the score of the best 5-card hand, that is not a flush, made from the 7 ranks in counts
+func sevenValue(counts [13]int) uint16 {
+	var ranks []int
+	for r, n := range counts {
+		for i := 0; i < n; i++ {
+			ranks = append(ranks, r)
+		}
+	}
+
+	var best uint16 = 8000
+	for i := 0; i < 7; i++ {
+		for j := i + 1; j < 7; j++ {
+			var product uint32 = 1
+			for ndx, r := range ranks {
+				if ndx != i && ndx != j {
+					product *= primes[r]
+				}
+			}
+
+			if v := nonFlushValues[product]; v < best {
+				best = v
+			}
+		}
+	}
+
+	return best
+}
+
+// primes are the primes the evaluator gives each rank, from the deuce up. The product of the primes of a hand's
+// ranks is the same for every hand of those ranks, and different for every other.
+var primes = [13]uint32{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41}
+
+// nonFlushValues maps the product of the primes of every 5-card hand that is not a flush to its score. The eval
+// package cannot score hands here, since it does not build without the tables made by this generator.
+var nonFlushValues = func() map[uint32]uint16 {
+	ret := map[uint32]uint16{}
+
+	// Hands with a pair or better
+	for i := range keys {
+		ret[keys[i]] = values[i]
+	}
+
+	// Hands of 5 different ranks are straights, from 1600 for the ace-high straight to 1609 for the wheel, or high
+	// card hands, from 6186 for the best to 7462 for the worst
+	var highCards [][]int
+	for bits := 0; bits < 1<<13; bits++ {
+		var ranks []int
+		for r := 12; r >= 0; r-- {
+			if bits&(1<<r) != 0 {
+				ranks = append(ranks, r)
+			}
+		}
+
+		if len(ranks) != 5 {
+			continue
+		}
+
+		product := primes[ranks[0]] * primes[ranks[1]] * primes[ranks[2]] * primes[ranks[3]] * primes[ranks[4]]
+
+		switch {
+		case ranks[0]-ranks[4] == 4:
+			ret[product] = uint16(1600 + 12 - ranks[0])
+		case bits == 0x100F:
+			ret[product] = 1609
+		default:
+			highCards = append(highCards, ranks)
+		}
+	}
+
+	sort.Slice(highCards, func(a, b int) bool {
+		for k := range highCards[a] {
+			if highCards[a][k] != highCards[b][k] {
+				return highCards[a][k] > highCards[b][k]
+			}
+		}
+		return false
+	})
+
+	for k, ranks := range highCards {
+		ret[primes[ranks[0]]*primes[ranks[1]]*primes[ranks[2]]*primes[ranks[3]]*primes[ranks[4]]] = uint16(6186 + k)
+	}
 
+	return ret
+}()
+
+// serialize returns the serialized hash table as the contents of a Go byte slice literal
+func serialize(best *CHDPoker) string {
+	buf := bytes.Buffer{}
+	w := bufio.NewWriterSize(&buf, (len(best.values)*2)+(len(best.indices)*2)+(len(best.r)*8))
+
+	_ = best.Write(w)
+
+	fmt.Printf("Serialized hashtable is %d bytes (%d bytes of total space in buffer).\n", w.Buffered(), w.Size())
+	lit := strings.Builder{}
+	w.Flush()
+	for i, b := range buf.Bytes() {
+		if i%12 == 0 {
+			lit.WriteString("\n")
+		}
+		lit.WriteString(fmt.Sprintf("0x%s, ", hex.EncodeToString([]byte{b})))
+	}
+
+	return lit.String()
+}
+
+// buildBest builds a hash table of keys and values tries times, and returns the one with the fewest hash functions
+func buildBest(keys []uint32, values []uint16, tries int) (*CHDPoker, error) {
+	var best *CHDPoker
+	for i := 0; i < tries; i++ {
+		b := BuilderPoker()
+		for j := range keys {
+			b.Add(keys[j], values[j])
+		}
+
+		h, err := b.Build()
+		if err != nil {
+			return nil, err
+		}
+
+		if best == nil || len(h.r) < len(best.r) {
+			best = h
+		}
+	}
+
+	return best, nil
 }
 
 var t = template.Must(template.New("").Parse(`// Code generated by go generate; DO NOT EDIT.
@@ -145,6 +324,21 @@ var matched_card_hashes = []byte{ {{ .Contents }}
 }
 `))
 
+var tSeven = template.Must(template.New("").Parse(`// Code generated by go generate; DO NOT EDIT.
+// This file was generated by automatically at {{ .Timestamp }}
+
+package eval
+
+const num_seven_card_rand_hashes = {{ .R }}
+
+const num_seven_card_indices = {{ .Indices }}
+
+const num_seven_card_values = {{ .Values }}
+
+var seven_card_hashes = []byte{ {{ .Contents }}
+}
+`))
+
 // CHDPoker hash table lookup.
 type CHDPoker struct {
 	// Random hash function table.
@@ -152,11 +346,11 @@ type CHDPoker struct {
 	r_len uint64
 	// Array of indices into hash function table r. We assume there aren't
 	// more than 2^16 hash functions O_o
-	indices [2444]uint16
+	indices []uint16
 	i_len   uint64
 	// Final table of values.
 	//keys   []uint32
-	values [4888]uint16
+	values []uint16
 	v_len  uint64
 }
 
@@ -254,7 +448,7 @@ func (c *CHDPoker) Write(w io.Writer) error {
 
 // Try to find a hash function that does not cause collisions with table, when
 // applied to the keys in the bucket.
-func tryHashPoker(hasher *chdHasherPoker, seen map[uint64]bool, keys []uint32, values *[4888]uint16, indices *[2444]uint16, bucket *bucketPoker, ri uint16, r uint64) bool {
+func tryHashPoker(hasher *chdHasherPoker, seen map[uint64]bool, keys []uint32, values []uint16, indices []uint16, bucket *bucketPoker, ri uint16, r uint64) bool {
 	// Track duplicates within this bucket.
 	duplicate := make(map[uint64]bool)
 	// Make hashes for each entry in the bucket.
@@ -296,11 +490,11 @@ func (b *CHDBuilderPoker) Build() (*CHDPoker, error) {
 
 	keys := make([]uint32, n)
 	//values := make([]uint16, n)
-	values := [4888]uint16{}
+	values := make([]uint16, n)
 	hasher := newCHDHasherPoker(n, m)
 	buckets := make(bucketVectorPoker, m)
 	//indices := make([]uint16, m)
-	indices := [2444]uint16{}
+	indices := make([]uint16, m)
 
 	// An extra check to make sure we don't use an invalid index
 	for i := range indices {
@@ -336,7 +530,7 @@ nextBucket:
 
 		// Check existing hash functions.
 		for ri, r := range hasher.r {
-			if tryHashPoker(hasher, seen, keys, &values, &indices, &bucket, uint16(ri), r) {
+			if tryHashPoker(hasher, seen, keys, values, indices, &bucket, uint16(ri), r) {
 				continue nextBucket
 			}
 		}
@@ -349,7 +543,7 @@ nextBucket:
 				collisions = i
 			}
 			ri, r := hasher.Generate()
-			if tryHashPoker(hasher, seen, keys, &values, &indices, &bucket, ri, r) {
+			if tryHashPoker(hasher, seen, keys, values, indices, &bucket, ri, r) {
 				hasher.Add(r)
 				continue nextBucket
 			}
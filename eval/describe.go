@@ -0,0 +1,168 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eval
+
+import (
+	"fmt"
+	"sort"
+)
+
+// HandCategory is the kind of a 5-card poker hand, like a flush or two pair.
+type HandCategory uint8
+
+// The categories of poker hands, from best to worst. A royal flush is the best StraightFlush.
+const (
+	StraightFlush HandCategory = iota + 1
+	FourOfAKind
+	FullHouse
+	Flush
+	Straight
+	ThreeOfAKind
+	TwoPair
+	OnePair
+	HighCard
+)
+
+var handCategoryNames = [...]string{
+	"",
+	"Straight flush",
+	"Four of a kind",
+	"Full house",
+	"Flush",
+	"Straight",
+	"Three of a kind",
+	"Two pair",
+	"One pair",
+	"High card",
+}
+
+func (c HandCategory) String() string {
+	if int(c) < len(handCategoryNames) && c != 0 {
+		return handCategoryNames[c]
+	}
+	return "Unknown"
+}
+
+// worstScores holds the worst (highest) score of each category, in order
+var worstScores = [...]int{10, 166, 322, 1599, 1609, 2467, 3325, 6185, 7462}
+
+// ScoreCategory returns the category of a hand with score, as returned by HandValue (or any of the functions that
+// find the best hand), or 0 if score is not in [1, 7462].
+func ScoreCategory(score int) HandCategory {
+	if score < 1 {
+		return 0
+	}
+
+	for i, worst := range worstScores {
+		if score <= worst {
+			return HandCategory(i + 1)
+		}
+	}
+	return 0
+}
+
+// HandDescription describes a 5-card poker hand. Name is a human-readable description of it, like
+// "Full house, Kings full of Sevens" or "Ace-high flush". Kickers are the cards that do not make up the category,
+// but break ties between hands of the same rank, from highest to lowest: for example, the three unpaired cards of
+// one pair. Straights, flushes, and full houses have no kickers, and neither does a high card hand, since every card
+// plays. Cards are the cards of the hand, from the ones that matter most to the ones that matter least.
+type HandDescription struct {
+	Category HandCategory
+	Score    int
+	Name     string
+	Cards    []Card
+	Kickers  []Card
+}
+
+var rankNames = [13]string{"Two", "Three", "Four", "Five", "Six", "Seven", "Eight", "Nine", "Ten", "Jack", "Queen", "King", "Ace"}
+var rankPlurals = [13]string{"Twos", "Threes", "Fours", "Fives", "Sixes", "Sevens", "Eights", "Nines", "Tens", "Jacks", "Queens", "Kings", "Aces"}
+
+func rankOf(c Card) int32 {
+	return int32(c>>8) & 0xF
+}
+
+// DescribeHand describes the hand made of the five cards passed in (see HandDescription). To describe the best
+// hand of more than five cards, pass it the cards returned by BestFiveOfSeven or one of its relatives.
+//
+// WARNING: See the warning associated with HandValue.
+func DescribeHand(c0, c1, c2, c3, c4 Card) HandDescription {
+	score := HandValue(c0, c1, c2, c3, c4)
+	d := HandDescription{
+		Category: ScoreCategory(score),
+		Score:    score,
+		Cards:    []Card{c0, c1, c2, c3, c4},
+	}
+
+	counts := map[int32]int{}
+	for _, c := range d.Cards {
+		counts[rankOf(c)]++
+	}
+
+	// The cards of the biggest group of a rank come first, and groups of the same size go from highest to lowest
+	sort.SliceStable(d.Cards, func(i, j int) bool {
+		ri, rj := rankOf(d.Cards[i]), rankOf(d.Cards[j])
+		if counts[ri] != counts[rj] {
+			return counts[ri] > counts[rj]
+		}
+		return ri > rj
+	})
+
+	// In a five-high straight, the ace plays low
+	if (d.Category == Straight || d.Category == StraightFlush) && rankOf(d.Cards[0]) == 12 && rankOf(d.Cards[1]) == 3 {
+		d.Cards = append(d.Cards[1:], d.Cards[0])
+	}
+
+	top := rankOf(d.Cards[0])
+
+	switch d.Category {
+	case StraightFlush:
+		if top == 12 {
+			d.Name = "Royal flush"
+		} else {
+			d.Name = fmt.Sprintf("%s-high straight flush", rankNames[top])
+		}
+	case FourOfAKind:
+		d.Name = fmt.Sprintf("Four of a kind, %s", rankPlurals[top])
+		d.Kickers = d.Cards[4:]
+	case FullHouse:
+		d.Name = fmt.Sprintf("Full house, %s full of %s", rankPlurals[top], rankPlurals[rankOf(d.Cards[3])])
+	case Flush:
+		d.Name = fmt.Sprintf("%s-high flush", rankNames[top])
+	case Straight:
+		d.Name = fmt.Sprintf("%s-high straight", rankNames[top])
+	case ThreeOfAKind:
+		d.Name = fmt.Sprintf("Three of a kind, %s", rankPlurals[top])
+		d.Kickers = d.Cards[3:]
+	case TwoPair:
+		d.Name = fmt.Sprintf("Two pair, %s and %s", rankPlurals[top], rankPlurals[rankOf(d.Cards[2])])
+		d.Kickers = d.Cards[4:]
+	case OnePair:
+		d.Name = fmt.Sprintf("Pair of %s", rankPlurals[top])
+		d.Kickers = d.Cards[2:]
+	case HighCard:
+		d.Name = fmt.Sprintf("%s high", rankNames[top])
+	}
+
+	return d
+}
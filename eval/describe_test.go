@@ -0,0 +1,97 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eval
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDescribeHand(t *testing.T) {
+	tables := []struct {
+		hand     string
+		category HandCategory
+		name     string
+		kickers  string
+	}{
+		{"Ts Js Qs Ks As", StraightFlush, "Royal flush", ""},
+		{"5h 6h 7h 8h 9h", StraightFlush, "Nine-high straight flush", ""},
+		{"Ad 2d 3d 4d 5d", StraightFlush, "Five-high straight flush", ""},
+		{"Ac Ad Ah As 7c", FourOfAKind, "Four of a kind, Aces", "7c"},
+		{"7c Kd Ks 7h Kh", FullHouse, "Full house, Kings full of Sevens", ""},
+		{"2c 9c Jc Ac 4c", Flush, "Ace-high flush", ""},
+		{"6d 7c 8h 9s Td", Straight, "Ten-high straight", ""},
+		{"5s 4h Ac 3d 2c", Straight, "Five-high straight", ""},
+		{"Qc Qd Qh 3s 9c", ThreeOfAKind, "Three of a kind, Queens", "9c 3s"},
+		{"8c Ad 8h 2s As", TwoPair, "Two pair, Aces and Eights", "2s"},
+		{"6c 6d Ks 2h 9c", OnePair, "Pair of Sixes", "Ks 9c 2h"},
+		{"Ah Jd 8c 5s 3c", HighCard, "Ace high", ""},
+	}
+
+	for _, table := range tables {
+		c := parseCards(strings.Fields(table.hand))
+
+		var wantKickers []Card
+		if table.kickers != "" {
+			wantKickers = parseCards(strings.Fields(table.kickers))
+		}
+
+		d := DescribeHand(c[0], c[1], c[2], c[3], c[4])
+		if d.Category != table.category || d.Name != table.name || !reflect.DeepEqual(d.Kickers, wantKickers) {
+			t.Errorf("\nFAIL:\nIn: %s \nWant: %s, %s, %v \nGot: %s, %s, %v \n", table.hand, table.category, table.name, wantKickers, d.Category, d.Name, d.Kickers)
+		}
+
+		if d.Score != HandValue(c[0], c[1], c[2], c[3], c[4]) || len(d.Cards) != 5 {
+			t.Errorf("\nFAIL:\nIn: %s \nWant: score %d, 5 cards \nGot: score %d, %v \n", table.hand, HandValue(c[0], c[1], c[2], c[3], c[4]), d.Score, d.Cards)
+		}
+	}
+}
+
+func TestScoreCategory(t *testing.T) {
+	tables := []struct {
+		score int
+		want  HandCategory
+	}{
+		{0, 0},
+		{1, StraightFlush},
+		{10, StraightFlush},
+		{11, FourOfAKind},
+		{167, FullHouse},
+		{1599, Flush},
+		{1600, Straight},
+		{1610, ThreeOfAKind},
+		{3325, TwoPair},
+		{3326, OnePair},
+		{6186, HighCard},
+		{7462, HighCard},
+		{7463, 0},
+	}
+
+	for _, table := range tables {
+		if got := ScoreCategory(table.score); got != table.want {
+			t.Errorf("\nFAIL:\nIn: %d \nWant: %s \nGot: %s \n", table.score, table.want, got)
+		}
+	}
+}
@@ -0,0 +1,359 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eval
+
+import (
+	"errors"
+	"math/rand"
+)
+
+// DefaultMaxBoards and DefaultSamples are the limits used by an EquityCalculator that does not set its own.
+const (
+	DefaultMaxBoards = 100000
+	DefaultSamples   = 20000
+)
+
+// ErrTooFewHands is the error returned when calculating equity with fewer than two hands.
+var ErrTooFewHands = errors.New("equity needs at least two hands")
+
+// ErrBadHand is the error returned when calculating equity with a hand of fewer than two cards.
+var ErrBadHand = errors.New("a hand must hold at least two cards")
+
+// ErrBadBoard is the error returned when calculating equity with a board of more than five cards.
+var ErrBadBoard = errors.New("a board holds at most five cards")
+
+// ErrDuplicateCard is the error returned when calculating equity with the same card in more than one place.
+var ErrDuplicateCard = errors.New("the same card was passed more than once")
+
+// ErrNotEnoughCards is the error returned when there are not enough cards left to complete the board.
+var ErrNotEnoughCards = errors.New("not enough cards left to complete the board")
+
+// HandEquity is how one hand fares against the others. Win, Tie and Lose are the percentages of boards on which
+// the hand has the best hand alone, shares the best hand, or is beaten. Equity is the percentage of the pot the
+// hand can expect to win: all of it on the boards it wins, and its share of it on the boards it ties.
+type HandEquity struct {
+	Win    float64
+	Tie    float64
+	Lose   float64
+	Equity float64
+}
+
+// EquityResult holds the equity of each hand, in the order the hands were passed in. Boards is the number of boards
+// the hands were compared on. Exact is true if those were every board that could still come, and false if they
+// were a random sample of them.
+type EquityResult struct {
+	Hands  []HandEquity
+	Boards int
+	Exact  bool
+}
+
+// EquityCalculator calculates the equity of hands against each other (see Equity). If there are no more than
+// MaxBoards ways the rest of the board can come, it tries every one of them. Otherwise, it deals Samples random
+// boards, drawing from RNG. The zero value uses DefaultMaxBoards and DefaultSamples, and draws from the global source
+// in math/rand.
+type EquityCalculator struct {
+	MaxBoards int
+	Samples   int
+	RNG       RNG
+}
+
+// Equity calculates the equity of each of hands against the others, given the community cards already dealt in
+// board (which may be empty), and the cards in dead, which are out of play. Hands of two cards are played as in
+// Texas hold'em, using any five of the seven cards, and hands of more as in Omaha, using exactly two of them.
+// Equity is the same as EquityCalculator{}.Equity.
+func Equity(hands [][]Card, board []Card, dead []Card) (EquityResult, error) {
+	return EquityCalculator{}.Equity(hands, board, dead)
+}
+
+// Equity calculates the equity of each of hands against the others, in the same way as the package-level Equity,
+// but with the limits set by c.
+func (c EquityCalculator) Equity(hands [][]Card, board []Card, dead []Card) (EquityResult, error) {
+	if len(hands) < 2 {
+		return EquityResult{}, ErrTooFewHands
+	}
+
+	used := cardSet{}
+	for _, hand := range hands {
+		if len(hand) < 2 {
+			return EquityResult{}, ErrBadHand
+		}
+		if err := used.add(hand); err != nil {
+			return EquityResult{}, err
+		}
+	}
+
+	if err := used.addBoard(board, dead); err != nil {
+		return EquityResult{}, err
+	}
+
+	deck := used.deck()
+
+	need := 5 - len(board)
+	if need > len(deck) {
+		return EquityResult{}, ErrNotEnoughCards
+	}
+
+	t := newEquityTally(len(hands), board)
+	t.hands = hands
+
+	exact := combinations(len(deck), need, c.maxBoards()) <= c.maxBoards()
+	if exact {
+		t.enumerate(deck, 0, need, 1)
+	} else {
+		t.sample(deck, need, c.samples(), c.rng(), 1)
+	}
+
+	return t.result(exact), nil
+}
+
+func (c EquityCalculator) maxBoards() int {
+	if c.MaxBoards <= 0 {
+		return DefaultMaxBoards
+	}
+	return c.MaxBoards
+}
+
+func (c EquityCalculator) samples() int {
+	if c.Samples <= 0 {
+		return DefaultSamples
+	}
+	return c.Samples
+}
+
+func (c EquityCalculator) rng() RNG {
+	if c.RNG == nil {
+		return globalRNG{}
+	}
+	return c.RNG
+}
+
+// cardSet holds the cards already in use, to check that no card is used twice
+type cardSet map[Card]bool
+
+func (s cardSet) add(cards []Card) error {
+	for _, card := range cards {
+		if !validCard(card) {
+			return ErrBadCard
+		}
+		if s[card] {
+			return ErrDuplicateCard
+		}
+		s[card] = true
+	}
+	return nil
+}
+
+// addBoard adds the community cards and the dead cards
+func (s cardSet) addBoard(board []Card, dead []Card) error {
+	if len(board) > 5 {
+		return ErrBadBoard
+	}
+
+	if err := s.add(board); err != nil {
+		return err
+	}
+
+	return s.add(dead)
+}
+
+// deck returns the cards not in s
+func (s cardSet) deck() []Card {
+	deck := []Card{}
+	for _, card := range DefaultDeck {
+		if !s[card] {
+			deck = append(deck, card)
+		}
+	}
+	return deck
+}
+
+// validCard returns true if c is one of the 52 cards in DefaultDeck
+func validCard(c Card) bool {
+	rank := int32(c>>8) & 0xF
+	if rank > 12 {
+		return false
+	}
+
+	for _, suit := range suits {
+		if c == makeCard(rank, suit) {
+			return true
+		}
+	}
+	return false
+}
+
+// makeCard returns the card of rank (deuce=0,trey=1,...,ace=12) and suit (one of suits)
+func makeCard(rank int32, suit int32) Card {
+	return Card((0x10000 << rank) | suit | (rank << 8) | primeRanks[rank])
+}
+
+// combinations returns the number of ways to choose k of n things, or a number greater than max if there are more
+// than max of them
+func combinations(n int, k int, max int) int {
+	ret := 1
+	for i := 1; i <= k; i++ {
+		ret = ret * (n - k + i) / i
+		if ret > max {
+			return max + 1
+		}
+	}
+	return ret
+}
+
+type globalRNG struct{}
+
+func (globalRNG) Intn(n int) int {
+	return rand.Intn(n)
+}
+
+// equityTally counts the results of the hands on each board they are compared on. Each board counts for its
+// weight, which is 1 unless the hands come from weighted ranges.
+type equityTally struct {
+	hands  [][]Card
+	board  [5]Card
+	dealt  int
+	scores []int
+	wins   []float64
+	ties   []float64
+	shares []float64
+	weight float64
+	boards int
+}
+
+func newEquityTally(n int, board []Card) *equityTally {
+	t := &equityTally{
+		dealt:  len(board),
+		scores: make([]int, n),
+		wins:   make([]float64, n),
+		ties:   make([]float64, n),
+		shares: make([]float64, n),
+	}
+	copy(t.board[:], board)
+
+	return t
+}
+
+// merge adds the counts of o to t
+func (t *equityTally) merge(o *equityTally) {
+	for i := range t.wins {
+		t.wins[i] += o.wins[i]
+		t.ties[i] += o.ties[i]
+		t.shares[i] += o.shares[i]
+	}
+	t.weight += o.weight
+	t.boards += o.boards
+}
+
+// enumerate completes the board with every combination of n of the cards in deck from index from on, and adds
+// each board to the tally with weight w
+func (t *equityTally) enumerate(deck []Card, from int, n int, w float64) {
+	if n == 0 {
+		t.add(w)
+		return
+	}
+
+	for i := from; i <= len(deck)-n; i++ {
+		t.board[5-n] = deck[i]
+		t.enumerate(deck, i+1, n-1, w)
+	}
+}
+
+// sample completes the board with n random cards from deck, samples times, and adds each board to the tally with
+// weight w
+func (t *equityTally) sample(deck []Card, n int, samples int, r RNG, w float64) {
+	deck = append([]Card{}, deck...)
+
+	for s := 0; s < samples; s++ {
+		// A partial Fisher-Yates shuffle puts n random cards at the front of the deck
+		for i := 0; i < n; i++ {
+			j := i + r.Intn(len(deck)-i)
+			deck[i], deck[j] = deck[j], deck[i]
+			t.board[t.dealt+i] = deck[i]
+		}
+
+		t.add(w)
+	}
+}
+
+// add compares the hands on the current board, and adds the result to the tally with weight w
+func (t *equityTally) add(w float64) {
+	best := 8000
+	winners := 0
+
+	for i, hand := range t.hands {
+		var score int
+		if len(hand) == 2 {
+			score = LookupHandValue7(hand[0], hand[1], t.board[0], t.board[1], t.board[2], t.board[3], t.board[4])
+		} else {
+			_, score = BestOmahaHand(hand, t.board[:])
+		}
+
+		t.scores[i] = score
+		if score < best {
+			best = score
+			winners = 1
+		} else if score == best {
+			winners++
+		}
+	}
+
+	for i, score := range t.scores {
+		if score != best {
+			continue
+		}
+
+		if winners == 1 {
+			t.wins[i] += w
+		} else {
+			t.ties[i] += w
+		}
+		t.shares[i] += w / float64(winners)
+	}
+
+	t.weight += w
+	t.boards++
+}
+
+func (t *equityTally) result(exact bool) EquityResult {
+	ret := EquityResult{
+		Hands:  make([]HandEquity, len(t.wins)),
+		Boards: t.boards,
+		Exact:  exact,
+	}
+
+	if t.weight == 0 {
+		return ret
+	}
+
+	for i := range t.wins {
+		ret.Hands[i] = HandEquity{
+			Win:    100 * t.wins[i] / t.weight,
+			Tie:    100 * t.ties[i] / t.weight,
+			Lose:   100 * (t.weight - t.wins[i] - t.ties[i]) / t.weight,
+			Equity: 100 * t.shares[i] / t.weight,
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,167 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eval
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestEquity(t *testing.T) {
+	cards := func(strs ...string) []Card {
+		ret := []Card{}
+		for _, s := range strs {
+			ret = append(ret, MustParseCardString(s))
+		}
+		return ret
+	}
+
+	tables := []struct {
+		description string
+		hands       [][]Card
+		board       []Card
+		dead        []Card
+		wantBoards  int
+		want        []HandEquity
+	}{
+		{
+			// Only the last king saves the kings, out of the 44 cards left
+			"OneOuterOnTheTurn",
+			[][]Card{cards("AS", "AD"), cards("KS", "KD")},
+			cards("AH", "KH", "2C", "7D"),
+			nil,
+			44,
+			[]HandEquity{
+				{Win: 100 * 43.0 / 44, Lose: 100 * 1.0 / 44, Equity: 100 * 43.0 / 44},
+				{Win: 100 * 1.0 / 44, Lose: 100 * 43.0 / 44, Equity: 100 * 1.0 / 44},
+			},
+		},
+		{
+			"DeadOuter",
+			[][]Card{cards("AS", "AD"), cards("KS", "KD")},
+			cards("AH", "KH", "2C", "7D"),
+			cards("KC"),
+			43,
+			[]HandEquity{
+				{Win: 100, Equity: 100},
+				{Lose: 100},
+			},
+		},
+		{
+			"RoyalOnTheBoardSplitsThreeWays",
+			[][]Card{cards("2C", "3D"), cards("4C", "5D"), cards("6C", "7D")},
+			cards("AS", "KS", "QS", "JS", "TS"),
+			nil,
+			1,
+			[]HandEquity{
+				{Tie: 100, Equity: 100.0 / 3},
+				{Tie: 100, Equity: 100.0 / 3},
+				{Tie: 100, Equity: 100.0 / 3},
+			},
+		},
+		{
+			// Omaha hands must use two hole cards, so the four aces on the board are no help to anyone
+			"OmahaTwoPairVersusNothing",
+			[][]Card{cards("KH", "KD", "2C", "3C"), cards("QH", "JD", "4S", "6S")},
+			cards("AS", "AH", "AD", "AC"),
+			nil,
+			40,
+			nil,
+		},
+	}
+
+	for _, table := range tables {
+		t.Run(table.description, func(t *testing.T) {
+			result, err := Equity(table.hands, table.board, table.dead)
+			if err != nil {
+				t.Fatalf("\nFAIL:\nUnexpected error: %s\n", err)
+			}
+
+			if !result.Exact || result.Boards != table.wantBoards {
+				t.Errorf("\nFAIL:\nWant: %d boards, exactly \nGot: %d boards (exact: %v)\n", table.wantBoards, result.Boards, result.Exact)
+			}
+
+			var total float64 = 0
+			for i, got := range result.Hands {
+				total += got.Equity
+				if table.want != nil && !equityClose(got, table.want[i], 1e-9) {
+					t.Errorf("\nFAIL:\nHand %d \nWant: %+v \nGot: %+v \n", i, table.want[i], got)
+				}
+			}
+
+			if math.Abs(total-100) > 1e-9 {
+				t.Errorf("\nFAIL:\nEquities add up to %f, not 100\n", total)
+			}
+		})
+	}
+
+	t.Run("SampledPreflop", func(t *testing.T) {
+		calc := EquityCalculator{Samples: 50000, RNG: rand.New(rand.NewSource(1))}
+
+		result, err := calc.Equity([][]Card{cards("AS", "AH"), cards("KS", "KH")}, nil, nil)
+		if err != nil {
+			t.Fatalf("\nFAIL:\nUnexpected error: %s\n", err)
+		}
+
+		if result.Exact || result.Boards != 50000 {
+			t.Errorf("\nFAIL:\nWant: 50000 sampled boards \nGot: %d boards (exact: %v)\n", result.Boards, result.Exact)
+		}
+
+		// Aces are about an 82% favourite over kings
+		want := HandEquity{Win: 81.7, Tie: 0.5, Lose: 17.8, Equity: 81.9}
+		if got := result.Hands[0]; !equityClose(got, want, 1) {
+			t.Errorf("\nFAIL:\nWant: %+v \nGot: %+v \n", want, got)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		errTables := []struct {
+			description string
+			hands       [][]Card
+			board       []Card
+			dead        []Card
+			want        error
+		}{
+			{"OneHand", [][]Card{cards("AS", "AH")}, nil, nil, ErrTooFewHands},
+			{"OneCardHand", [][]Card{cards("AS", "AH"), cards("KS")}, nil, nil, ErrBadHand},
+			{"SixCardBoard", [][]Card{cards("AS", "AH"), cards("KS", "KH")}, cards("2C", "3C", "4C", "5C", "6C", "7C"), nil, ErrBadBoard},
+			{"SharedCard", [][]Card{cards("AS", "AH"), cards("KS", "KH")}, cards("2C", "3C", "AS"), nil, ErrDuplicateCard},
+			{"DeadCardInHand", [][]Card{cards("AS", "AH"), cards("KS", "KH")}, nil, cards("KH"), ErrDuplicateCard},
+			{"NotACard", [][]Card{cards("AS", "AH"), {1, 2}}, nil, nil, ErrBadCard},
+		}
+
+		for _, table := range errTables {
+			if _, err := Equity(table.hands, table.board, table.dead); !errors.Is(err, table.want) {
+				t.Errorf("\nFAIL:\n%s \nWant: %v \nGot: %v \n", table.description, table.want, err)
+			}
+		}
+	})
+}
+
+func equityClose(a HandEquity, b HandEquity, tolerance float64) bool {
+	return math.Abs(a.Win-b.Win) <= tolerance && math.Abs(a.Tie-b.Tie) <= tolerance &&
+		math.Abs(a.Lose-b.Lose) <= tolerance && math.Abs(a.Equity-b.Equity) <= tolerance
+}
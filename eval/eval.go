@@ -74,11 +74,20 @@ func HandValue(c0, c1, c2, c3, c4 Card) int {
 //
 // WARNING: See the warning associated with HandValue.
 func BestFiveOfSeven(c0, c1, c2, c3, c4, c5, c6 Card) ([]Card, int) {
+	hand, score := BestFiveOfSevenArray(c0, c1, c2, c3, c4, c5, c6)
+	return hand[:], score
+}
+
+// BestFiveOfSevenArray is the same as BestFiveOfSeven, except it returns the best hand as an array, so that
+// it does not allocate. It always finds the same hand as BestFiveOfSeven.
+//
+// WARNING: See the warning associated with HandValue.
+func BestFiveOfSevenArray(c0, c1, c2, c3, c4, c5, c6 Card) ([5]Card, int) {
 	base := [7]Card{c0, c1, c2, c3, c4, c5, c6}
-	var bestHand []Card
+	var bestHand [5]Card
 	bestScore := 8000 // larger value than the worst hand, so the first real hand will always be better
 	for ndx := range base {
-		hand, score := BestFiveOfSix(
+		hand, score := BestFiveOfSixArray(
 			base[ndx],
 			base[(ndx+1)%7],
 			base[(ndx+2)%7],
@@ -100,6 +109,15 @@ func BestFiveOfSeven(c0, c1, c2, c3, c4, c5, c6 Card) ([]Card, int) {
 //
 // WARNING: See the warning associated with HandValue.
 func BestFiveOfSix(c0, c1, c2, c3, c4, c5 Card) ([]Card, int) {
+	hand, score := BestFiveOfSixArray(c0, c1, c2, c3, c4, c5)
+	return hand[:], score
+}
+
+// BestFiveOfSixArray is the same as BestFiveOfSix, except it returns the best hand as an array, so that
+// it does not allocate. It always finds the same hand as BestFiveOfSix.
+//
+// WARNING: See the warning associated with HandValue.
+func BestFiveOfSixArray(c0, c1, c2, c3, c4, c5 Card) ([5]Card, int) {
 	base := [6]Card{c0, c1, c2, c3, c4, c5}
 	bestNdx := 0
 	bestScore := 8000 // larger value than the worst hand, so the first real hand will always be better
@@ -116,7 +134,60 @@ func BestFiveOfSix(c0, c1, c2, c3, c4, c5 Card) ([]Card, int) {
 			bestNdx = ndx
 		}
 	}
-	return []Card{base[bestNdx], base[(bestNdx+1)%6], base[(bestNdx+2)%6], base[(bestNdx+3)%6], base[(bestNdx+4)%6]}, bestScore
+	return [5]Card{base[bestNdx], base[(bestNdx+1)%6], base[(bestNdx+2)%6], base[(bestNdx+3)%6], base[(bestNdx+4)%6]}, bestScore
+}
+
+// HandValue6 returns the score of the best 5-card hand that can be made from the 6 cards passed in,
+// the same as BestFiveOfSix, without finding which 5 cards make it up. It does not allocate.
+//
+// WARNING: See the warning associated with HandValue.
+func HandValue6(c0, c1, c2, c3, c4, c5 Card) int {
+	best := HandValue(c0, c1, c2, c3, c4)
+	if v := HandValue(c0, c1, c2, c3, c5); v < best {
+		best = v
+	}
+	if v := HandValue(c0, c1, c2, c4, c5); v < best {
+		best = v
+	}
+	if v := HandValue(c0, c1, c3, c4, c5); v < best {
+		best = v
+	}
+	if v := HandValue(c0, c2, c3, c4, c5); v < best {
+		best = v
+	}
+	if v := HandValue(c1, c2, c3, c4, c5); v < best {
+		best = v
+	}
+	return best
+}
+
+// HandValue7 returns the score of the best 5-card hand that can be made from the 7 cards passed in,
+// the same as BestFiveOfSeven, without finding which 5 cards make it up. Each of the 21 combinations
+// is only scored once, so it is faster than BestFiveOfSeven, and it does not allocate.
+//
+// WARNING: See the warning associated with HandValue.
+func HandValue7(c0, c1, c2, c3, c4, c5, c6 Card) int {
+	base := [7]Card{c0, c1, c2, c3, c4, c5, c6}
+	best := 8000 // larger value than the worst hand, so the first real hand will always be better
+
+	// Each combination leaves out two of the cards, i and j
+	for i := 0; i < 6; i++ {
+		for j := i + 1; j < 7; j++ {
+			var hand [5]Card
+			n := 0
+			for ndx := range base {
+				if ndx != i && ndx != j {
+					hand[n] = base[ndx]
+					n++
+				}
+			}
+
+			if v := HandValue(hand[0], hand[1], hand[2], hand[3], hand[4]); v < best {
+				best = v
+			}
+		}
+	}
+	return best
 }
 
 var flushes = []int16{
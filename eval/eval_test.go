@@ -25,6 +25,8 @@ package eval
 
 import (
 	"fmt"
+	"math/rand"
+	"reflect"
 	"testing"
 )
 
@@ -592,3 +594,109 @@ func TestBestFiveOfSeven(t *testing.T) {
 		})
 	}
 }
+
+func TestAllocationFreeEvaluation(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20000; i++ {
+		var d Deck
+		d.ShuffleWith(r)
+		c := d[:7]
+
+		hand7, score7 := BestFiveOfSeven(c[0], c[1], c[2], c[3], c[4], c[5], c[6])
+		array7, arrayScore7 := BestFiveOfSevenArray(c[0], c[1], c[2], c[3], c[4], c[5], c[6])
+		value7 := HandValue7(c[0], c[1], c[2], c[3], c[4], c[5], c[6])
+
+		if !reflect.DeepEqual(hand7, array7[:]) || arrayScore7 != score7 || value7 != score7 {
+			t.Fatalf("\nFAIL:\nIn: %v \nWant: %v, %d \nGot: %v, %d and %d \n", c, hand7, score7, array7, arrayScore7, value7)
+		}
+
+		hand6, score6 := BestFiveOfSix(c[0], c[1], c[2], c[3], c[4], c[5])
+		array6, arrayScore6 := BestFiveOfSixArray(c[0], c[1], c[2], c[3], c[4], c[5])
+		value6 := HandValue6(c[0], c[1], c[2], c[3], c[4], c[5])
+
+		if !reflect.DeepEqual(hand6, array6[:]) || arrayScore6 != score6 || value6 != score6 {
+			t.Fatalf("\nFAIL:\nIn: %v \nWant: %v, %d \nGot: %v, %d and %d \n", c[:6], hand6, score6, array6, arrayScore6, value6)
+		}
+	}
+
+	c := DefaultDeck[:7]
+	funcs := map[string]func(){
+		"BestFiveOfSevenArray": func() { BestFiveOfSevenArray(c[0], c[1], c[2], c[3], c[4], c[5], c[6]) },
+		"BestFiveOfSixArray":   func() { BestFiveOfSixArray(c[0], c[1], c[2], c[3], c[4], c[5]) },
+		"HandValue7":           func() { HandValue7(c[0], c[1], c[2], c[3], c[4], c[5], c[6]) },
+		"HandValue6":           func() { HandValue6(c[0], c[1], c[2], c[3], c[4], c[5]) },
+	}
+
+	for name, f := range funcs {
+		if allocs := testing.AllocsPerRun(100, f); allocs != 0 {
+			t.Errorf("\nFAIL:\n%s \nWant: 0 allocs \nGot: %f \n", name, allocs)
+		}
+	}
+}
+
+func TestLookupHandValue7(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 200000; i++ {
+		var d Deck
+		d.ShuffleWith(r)
+		c := d[:7]
+
+		want := HandValue7(c[0], c[1], c[2], c[3], c[4], c[5], c[6])
+		if got := LookupHandValue7(c[0], c[1], c[2], c[3], c[4], c[5], c[6]); got != want {
+			t.Fatalf("\nFAIL:\nIn: %v \nWant: %d \nGot: %d \n", c, want, got)
+		}
+	}
+
+	// Every multiset of ranks, dealt so that no suit has 5 cards, and every combination of 7 cards of one suit
+	var walk func(c []Card, rank int32)
+	walk = func(c []Card, rank int32) {
+		if len(c) == 7 {
+			want := HandValue7(c[0], c[1], c[2], c[3], c[4], c[5], c[6])
+			if got := LookupHandValue7(c[0], c[1], c[2], c[3], c[4], c[5], c[6]); got != want {
+				t.Fatalf("\nFAIL:\nIn: %v \nWant: %d \nGot: %d \n", c, want, got)
+			}
+			return
+		}
+
+		for next := rank; next < 13; next++ {
+			if len(c) >= 4 && next == int32(c[len(c)-4]>>8)&0xF {
+				continue
+			}
+			walk(append(c, makeCard(next, suits[len(c)%4])), next)
+		}
+	}
+	walk(nil, 0)
+
+	for ndx := 0; ndx < 8192; ndx++ {
+		if countOnes(ndx) != 7 {
+			continue
+		}
+
+		var c []Card
+		for rank := int32(0); rank < 13; rank++ {
+			if ndx&(1<<rank) != 0 {
+				c = append(c, makeCard(rank, suits[0]))
+			}
+		}
+
+		want := HandValue7(c[0], c[1], c[2], c[3], c[4], c[5], c[6])
+		if got := LookupHandValue7(c[0], c[1], c[2], c[3], c[4], c[5], c[6]); got != want {
+			t.Fatalf("\nFAIL:\nIn: %v \nWant: %d \nGot: %d \n", c, want, got)
+		}
+	}
+
+	c := DefaultDeck[:7]
+	if allocs := testing.AllocsPerRun(100, func() { LookupHandValue7(c[0], c[1], c[2], c[3], c[4], c[5], c[6]) }); allocs != 0 {
+		t.Errorf("\nFAIL:\nWant: 0 allocs \nGot: %f \n", allocs)
+	}
+}
+
+func countOnes(n int) int {
+	count := 0
+	for ; n != 0; n &= n - 1 {
+		count++
+	}
+	return count
+}
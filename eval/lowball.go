@@ -0,0 +1,152 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eval
+
+// EightOrBetter is the score of the worst low hand (8-7-6-5-4) that qualifies for the low half of a pot
+// under the 8-or-better rule. A hand qualifies if its LowHandValue is less than or equal to EightOrBetter.
+const EightOrBetter = 0x87654
+
+// LowHandValue takes five cards, and returns an integer representing their rank as an ace-to-five
+// low hand. Lower is better (e.g. 5-4-3-2-A is the best possible low). In ace-to-five lowball, aces
+// are always low, and straights and flushes do not count against the hand, but pairs do: any hand
+// with a pair is worse than any hand without one, two pair is worse than one pair, and so on.
+//
+// No particular range is guaranteed for the returned values, only their ordering. They are not comparable
+// with the values returned by HandValue.
+//
+// WARNING: See the warning associated with HandValue.
+func LowHandValue(c0, c1, c2, c3, c4 Card) int {
+	var counts [14]int
+
+	for _, c := range [5]Card{c0, c1, c2, c3, c4} {
+		counts[lowRank(c)]++
+	}
+
+	// Each rank is placed into a 4 bit nibble, ordered first by how many times it appears (most first),
+	// then by rank (highest first). The number of times the most common rank appears, and whether there
+	// is a second pair, are placed in the bits above that, so any made hand always ranks lower.
+	score := 0
+	shift := 16
+	maxCount := 0
+	pairs := 0
+
+	for count := 4; count > 0; count-- {
+		for rank := 13; rank > 0; rank-- {
+			if counts[rank] != count {
+				continue
+			}
+
+			if count > maxCount {
+				maxCount = count
+			}
+			if count >= 2 {
+				pairs++
+			}
+
+			for i := 0; i < count; i++ {
+				score |= rank << shift
+				shift -= 4
+			}
+		}
+	}
+
+	return (((maxCount-1)<<1)|(pairs>>1&1))<<20 | score
+}
+
+// BestLowFiveOfSeven uses LowHandValue as an oracle to find the best ace-to-five low hand that can be made
+// from any 5 of the 7 cards passed in, as in Seven-card Stud Hi/Lo. BestLowFiveOfSeven returns a slice
+// of the 5 cards which make up the best low hand, and the score associated with that hand (lower is better).
+// Whether that hand qualifies is up to the caller (see EightOrBetter).
+//
+// WARNING: See the warning associated with HandValue.
+func BestLowFiveOfSeven(c0, c1, c2, c3, c4, c5, c6 Card) ([]Card, int) {
+	base := [7]Card{c0, c1, c2, c3, c4, c5, c6}
+	var best [5]Card
+	bestScore := -1
+
+	// Choose the two cards to leave out
+	for i := 0; i < 7; i++ {
+		for j := i + 1; j < 7; j++ {
+			var hand [5]Card
+			ndx := 0
+			for k := 0; k < 7; k++ {
+				if k != i && k != j {
+					hand[ndx] = base[k]
+					ndx++
+				}
+			}
+
+			score := LowHandValue(hand[0], hand[1], hand[2], hand[3], hand[4])
+			if bestScore == -1 || score < bestScore {
+				bestScore = score
+				best = hand
+			}
+		}
+	}
+
+	return best[:], bestScore
+}
+
+// BestOmahaLowHand uses LowHandValue as an oracle to find the best ace-to-five low hand that can be made
+// from exactly two of the cards in hand and exactly three of the cards in board, as in Omaha Hi/Lo.
+// BestOmahaLowHand returns a slice of the 5 cards which make up the best low hand, and the score associated
+// with that hand (lower is better). Whether that hand qualifies is up to the caller (see EightOrBetter).
+// If no hand can be made (because hand has fewer than 2 cards, or board fewer than 3), it returns nil and -1.
+//
+// WARNING: See the warning associated with HandValue.
+func BestOmahaLowHand(hand []Card, board []Card) ([]Card, int) {
+	var best [5]Card
+	bestScore := -1
+
+	for h0 := 0; h0 < len(hand); h0++ {
+		for h1 := h0 + 1; h1 < len(hand); h1++ {
+			for b0 := 0; b0 < len(board); b0++ {
+				for b1 := b0 + 1; b1 < len(board); b1++ {
+					for b2 := b1 + 1; b2 < len(board); b2++ {
+						score := LowHandValue(hand[h0], hand[h1], board[b0], board[b1], board[b2])
+						if bestScore == -1 || score < bestScore {
+							bestScore = score
+							best = [5]Card{hand[h0], hand[h1], board[b0], board[b1], board[b2]}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if bestScore == -1 {
+		return nil, bestScore
+	}
+
+	return best[:], bestScore
+}
+
+// lowRank returns the rank of c for ace-to-five low purposes, where an ace is 1 and a king is 13
+func lowRank(c Card) int {
+	rank := int((c >> 8) & 0x0F)
+	if rank == 12 {
+		return 1
+	}
+	return rank + 2
+}
@@ -0,0 +1,146 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eval
+
+import (
+	"fmt"
+	"testing"
+)
+
+func parseCards(strs []string) []Card {
+	cards := []Card{}
+	for _, s := range strs {
+		cards = append(cards, MustParseCardString(s))
+	}
+	return cards
+}
+
+func TestLowHandValue(t *testing.T) {
+	// Each hand is strictly better (lower) than the next
+	ordered := [][]string{
+		{"5C", "4D", "3H", "2S", "AC"},
+		{"6C", "4D", "3H", "2S", "AC"},
+		{"6C", "5D", "4H", "3S", "2C"},
+		{"8C", "7D", "6H", "5S", "4C"},
+		{"9C", "4D", "3H", "2S", "AC"},
+		{"KC", "QD", "JH", "TS", "9C"},
+		{"AC", "AD", "3H", "2S", "4C"},
+		{"2C", "2D", "3H", "4S", "5C"},
+		{"KC", "KD", "QH", "JS", "TC"},
+		{"AC", "AD", "2H", "2S", "3C"},
+		{"AC", "AD", "AH", "2S", "3C"},
+		{"AC", "AD", "AH", "2S", "2C"},
+		{"AC", "AD", "AH", "AS", "2C"},
+		{"KC", "KD", "KH", "KS", "QC"},
+	}
+
+	prev := -1
+	for i, strs := range ordered {
+		cards := parseCards(strs)
+		score := LowHandValue(cards[0], cards[1], cards[2], cards[3], cards[4])
+
+		if score <= prev {
+			t.Errorf("\nFAIL:\nIn: %s \nWant worse than: %s \nGot: %x <= %x \n", strs, ordered[i-1], score, prev)
+		}
+
+		prev = score
+	}
+
+	// Straights and flushes don't count
+	wheel := parseCards([]string{"5C", "4D", "3H", "2S", "AC"})
+	suitedWheel := parseCards([]string{"5H", "4H", "3H", "2H", "AH"})
+	if LowHandValue(wheel[0], wheel[1], wheel[2], wheel[3], wheel[4]) != LowHandValue(suitedWheel[0], suitedWheel[1], suitedWheel[2], suitedWheel[3], suitedWheel[4]) {
+		t.Errorf("\nFAIL:\nIn: %s, %s \nWant: equal scores \n", wheel, suitedWheel)
+	}
+}
+
+func TestEightOrBetter(t *testing.T) {
+	tables := []struct {
+		description string
+		hand        []string
+		want        bool
+	}{
+		{"Wheel", []string{"5C", "4D", "3H", "2S", "AC"}, true},
+		{"EightSevenSixFiveFour", []string{"8C", "7D", "6H", "5S", "4C"}, true},
+		{"NineHigh", []string{"9C", "4D", "3H", "2S", "AC"}, false},
+		{"PairedLow", []string{"AC", "AD", "3H", "2S", "4C"}, false},
+	}
+
+	for _, table := range tables {
+		testname := fmt.Sprintf("%s", table.description)
+		t.Run(testname, func(t *testing.T) {
+			cards := parseCards(table.hand)
+			result := LowHandValue(cards[0], cards[1], cards[2], cards[3], cards[4]) <= EightOrBetter
+			if result != table.want {
+				t.Errorf("\nFAIL:\nIn: %s \nWant: %t \nGot: %t \n", table.hand, table.want, result)
+			}
+		})
+	}
+}
+
+func TestBestOmahaLowHand(t *testing.T) {
+	tables := []struct {
+		description string
+		hand        []string
+		board       []string
+		want        []string
+	}{
+		{
+			"NutLow",
+			[]string{"AH", "2D", "KC", "KS"},
+			[]string{"3C", "4D", "5H", "QS", "JD"},
+			[]string{"5H", "4D", "3C", "2D", "AH"},
+		},
+		{
+			"CounterfeitedAceMustStillUseTwo",
+			[]string{"AH", "3D", "KC", "KS"},
+			[]string{"AC", "2D", "5H", "8S", "JD"},
+			[]string{"8S", "5H", "3D", "2D", "AH"},
+		},
+	}
+
+	for _, table := range tables {
+		testname := fmt.Sprintf("%s", table.description)
+		t.Run(testname, func(t *testing.T) {
+			want := parseCards(table.want)
+			wantScore := LowHandValue(want[0], want[1], want[2], want[3], want[4])
+
+			_, result := BestOmahaLowHand(parseCards(table.hand), parseCards(table.board))
+			if result != wantScore {
+				t.Errorf("\nFAIL:\nIn: %s, %s \nWant: %x \nGot: %x \n", table.hand, table.board, wantScore, result)
+			}
+		})
+	}
+}
+
+func TestBestLowFiveOfSeven(t *testing.T) {
+	cards := parseCards([]string{"KC", "7D", "AH", "7S", "2C", "4H", "6D"})
+	want := parseCards([]string{"7D", "6D", "4H", "2C", "AH"})
+	wantScore := LowHandValue(want[0], want[1], want[2], want[3], want[4])
+
+	_, result := BestLowFiveOfSeven(cards[0], cards[1], cards[2], cards[3], cards[4], cards[5], cards[6])
+	if result != wantScore {
+		t.Errorf("\nFAIL:\nIn: %s \nWant: %x \nGot: %x \n", cards, wantScore, result)
+	}
+}
@@ -128,3 +128,54 @@ func (c *chdPoker) get(key uint32) uint16 {
 
 	return v
 }
+
+// chdSeven is the same as chdPoker, but sized for the multisets of ranks of 7-card hands (see LookupHandValue7).
+type chdSeven struct {
+	r       [num_seven_card_rand_hashes]uint64
+	indices [num_seven_card_indices]uint16
+	values  [num_seven_card_values]uint16
+}
+
+// readSeven reads a serialized chdSeven.
+func readSeven(r io.Reader) (*chdSeven, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &chdSeven{}
+
+	var ndx int = 0
+
+	for i := range c.r {
+		c.r[i] = binary.LittleEndian.Uint64(b[ndx+(8*i) : ndx+(8*i)+8])
+	}
+	ndx = ndx + (len(c.r) * 8)
+
+	for i := range c.indices {
+		c.indices[i] = binary.LittleEndian.Uint16(b[ndx+(2*i) : ndx+(2*i)+2])
+	}
+	ndx = ndx + (len(c.indices) * 2)
+
+	for i := range c.values {
+		c.values[i] = binary.LittleEndian.Uint16(b[ndx+(2*i) : ndx+(2*i)+2])
+	}
+
+	return c, nil
+}
+
+// Get an entry from the hash table.
+func (c *chdSeven) get(key uint32) uint16 {
+
+	h := hasherPoker(key) ^ c.r[0]
+
+	ri := c.indices[h%num_seven_card_indices]
+
+	r := c.r[ri]
+
+	ti := (h ^ r) % num_seven_card_values
+
+	v := c.values[ti]
+
+	return v
+}
@@ -0,0 +1,58 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eval
+
+// BestOmahaHand uses HandValue as an oracle to find the best Omaha hand that can be made from the
+// hole cards in hand and the community cards in board. Per the rules of Omaha, a hand must be made
+// of exactly two cards from hand and exactly three from board, so hand must hold at least 2 cards
+// (typically 4 or 5), and board must hold at least 3 cards (typically 5). BestOmahaHand returns a slice
+// of the 5 cards which make up the best hand, and the score associated with that hand (lower is better).
+//
+// WARNING: See the warning associated with HandValue.
+func BestOmahaHand(hand []Card, board []Card) ([]Card, int) {
+	var best [5]Card
+	bestScore := 8000 // larger value than the worst hand, so the first real hand will always be better
+
+	for h0 := 0; h0 < len(hand); h0++ {
+		for h1 := h0 + 1; h1 < len(hand); h1++ {
+			for b0 := 0; b0 < len(board); b0++ {
+				for b1 := b0 + 1; b1 < len(board); b1++ {
+					for b2 := b1 + 1; b2 < len(board); b2++ {
+						score := HandValue(hand[h0], hand[h1], board[b0], board[b1], board[b2])
+						if score < bestScore {
+							bestScore = score
+							best = [5]Card{hand[h0], hand[h1], board[b0], board[b1], board[b2]}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if bestScore == 8000 {
+		return nil, bestScore
+	}
+
+	return best[:], bestScore
+}
@@ -0,0 +1,87 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eval
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBestOmahaHand(t *testing.T) {
+	tables := []struct {
+		description string
+		hand        []string
+		board       []string
+		want        int
+	}{
+		{
+			"RoyalBoardPlaysAsTrips",
+			[]string{"AH", "AD", "2C", "3C"},
+			[]string{"AS", "KS", "QS", "JS", "TS"},
+			1610,
+		},
+		{
+			"FourFlushBoardOneSuitedHoleCard",
+			[]string{"AH", "2C", "3D", "4S"},
+			[]string{"KH", "QH", "JH", "9H", "8C"},
+			6191,
+		},
+		{
+			"FiveCardHandFlush",
+			[]string{"AH", "5H", "3D", "4S", "2C"},
+			[]string{"KH", "QH", "JD", "9H", "8C"},
+			342,
+		},
+		{
+			"FiveCardHandStraightIgnoresBoardPair",
+			[]string{"9C", "TD", "2C", "2S", "7D"},
+			[]string{"KH", "QH", "JD", "8S", "8C"},
+			1601,
+		},
+	}
+
+	for _, table := range tables {
+		testname := fmt.Sprintf("%s", table.description)
+		t.Run(testname, func(t *testing.T) {
+			hand := []Card{}
+			for _, s := range table.hand {
+				hand = append(hand, MustParseCardString(s))
+			}
+
+			board := []Card{}
+			for _, s := range table.board {
+				board = append(board, MustParseCardString(s))
+			}
+
+			best, result := BestOmahaHand(hand, board)
+			if result != table.want {
+				t.Errorf("\nFAIL:\nIn: %s, %s \nWant: %d \nGot: %d \n", table.hand, table.board, table.want, result)
+			}
+
+			if len(best) != 5 {
+				t.Errorf("\nFAIL:\nIn: %s, %s \nWant 5 cards \nGot: %v \n", table.hand, table.board, best)
+			}
+		})
+	}
+}
@@ -0,0 +1,564 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eval
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrBadRange is the error wrapped by the error returned by ParseRange if part of the string is not valid range
+// notation.
+var ErrBadRange = errors.New("invalid range")
+
+// ErrRangesConflict is the error returned when calculating the equity of ranges that leave no way to deal every
+// range a hand without dealing some card twice.
+var ErrRangesConflict = errors.New("no combination of hands from these ranges is possible")
+
+// Combo is a single combination of two hole cards in a Range. Weight is how likely the combination is to be in the
+// range, from 0 (never) to 1 (always).
+type Combo struct {
+	Cards  [2]Card
+	Weight float64
+}
+
+// Range is a set of hold'em hands a player might have, as weighted combinations of hole cards. No combination is in a
+// Range more than once.
+type Range []Combo
+
+const rankChars = "23456789TJQKA"
+
+// ParseRange parses a range written in the notation used by most poker software, as a list of hands separated by
+// commas:
+//
+//	QQ        a pair: every combination of two queens
+//	AKs, AKo  suited or offsuit: every combination of an ace and a king of the same suit, or of different suits
+//	AK        both suited and offsuit
+//	AhKh      a single combination of two specific cards
+//	QQ+       a pair, and every higher pair
+//	A5s+      an unpaired hand, and every hand with a higher second card, up to AKs
+//	76s+      a connector, and every higher connector, up to AKs
+//	TT-77     every pair from one to the other
+//	A5s-A2s   every hand from one to the other, with the same first card, or the same gap between the cards
+//
+// Any hand may be followed by a weight, as in AKo:0.5, which is how likely each of its combinations is to be in the
+// range, from 0 to 1. Hands without one have a weight of 1. If a combination is listed more than once, the last
+// weight listed for it applies. Ranks and suits may be in either case, and whitespace is ignored.
+func ParseRange(s string) (Range, error) {
+	var r Range
+	index := map[[2]Card]int{}
+
+	for _, token := range strings.Split(s, ",") {
+		token = strings.Join(strings.Fields(token), "")
+		if token == "" {
+			continue
+		}
+
+		combos, err := parseRangeToken(token)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrBadRange, token)
+		}
+
+		for _, combo := range combos {
+			if i, ok := index[combo.Cards]; ok {
+				r[i].Weight = combo.Weight
+				continue
+			}
+
+			index[combo.Cards] = len(r)
+			r = append(r, combo)
+		}
+	}
+
+	return r, nil
+}
+
+// MustParseRange is the same as ParseRange, except it panics if s is not a valid range
+func MustParseRange(s string) Range {
+	r, err := ParseRange(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// handClass is a hand in range notation, with ranks from deuce=0 to ace=12, and hi >= lo. If neither suited nor
+// offsuit is set, the class includes both.
+type handClass struct {
+	hi, lo          int32
+	suited, offsuit bool
+}
+
+func parseRangeToken(token string) ([]Combo, error) {
+	weight := 1.0
+	if i := strings.IndexByte(token, ':'); i >= 0 {
+		w, err := strconv.ParseFloat(token[i+1:], 64)
+		if err != nil || w < 0 || w > 1 {
+			return nil, ErrBadRange
+		}
+		weight = w
+		token = token[:i]
+	}
+
+	// A specific combination, like AhKh
+	if len(token) == 4 && strings.ContainsAny(token[1:2]+token[3:4], "cdhsCDHS") {
+		c0, err0 := ParseCardBytes([]byte(token[:2]))
+		c1, err1 := ParseCardBytes([]byte(token[2:]))
+		if err0 != nil || err1 != nil || c0 == c1 {
+			return nil, ErrBadRange
+		}
+		return []Combo{newCombo(c0, c1, weight)}, nil
+	}
+
+	var classes []handClass
+
+	if i := strings.IndexByte(token, '-'); i >= 0 {
+		from, err0 := parseHandClass(token[:i])
+		to, err1 := parseHandClass(token[i+1:])
+		if err0 != nil || err1 != nil {
+			return nil, ErrBadRange
+		}
+
+		classes = classesBetween(from, to)
+	} else if strings.HasSuffix(token, "+") {
+		from, err := parseHandClass(strings.TrimSuffix(token, "+"))
+		if err != nil {
+			return nil, ErrBadRange
+		}
+
+		classes = classesAbove(from)
+	} else {
+		class, err := parseHandClass(token)
+		if err != nil {
+			return nil, ErrBadRange
+		}
+
+		classes = []handClass{class}
+	}
+
+	if classes == nil {
+		return nil, ErrBadRange
+	}
+
+	var combos []Combo
+	for _, class := range classes {
+		combos = append(combos, class.combos(weight)...)
+	}
+
+	return combos, nil
+}
+
+func parseHandClass(s string) (handClass, error) {
+	s = strings.ToUpper(s)
+	if len(s) < 2 || len(s) > 3 {
+		return handClass{}, ErrBadRange
+	}
+
+	r0 := strings.IndexByte(rankChars, s[0])
+	r1 := strings.IndexByte(rankChars, s[1])
+	if r0 < 0 || r1 < 0 {
+		return handClass{}, ErrBadRange
+	}
+
+	class := handClass{hi: int32(r0), lo: int32(r1)}
+	if class.lo > class.hi {
+		class.hi, class.lo = class.lo, class.hi
+	}
+
+	if len(s) == 3 {
+		switch {
+		case s[2] == 'S' && class.hi != class.lo:
+			class.suited = true
+		case s[2] == 'O' && class.hi != class.lo:
+			class.offsuit = true
+		default:
+			return handClass{}, ErrBadRange
+		}
+	}
+
+	return class, nil
+}
+
+// classesAbove returns class and every class above it: higher pairs for a pair, higher connectors for a connector,
+// and higher second cards for any other hand
+func classesAbove(class handClass) []handClass {
+	var ret []handClass
+
+	// Pairs and connectors move both cards up, and other hands only the second card, until it meets the first
+	both := class.hi-class.lo < 2
+	for c := class; c.hi <= 12 && (both || c.lo < c.hi); {
+		ret = append(ret, c)
+
+		if both {
+			c.hi++
+		}
+		c.lo++
+	}
+
+	return ret
+}
+
+// classesBetween returns every class from from to to, which must be both pairs, or have the same first card, or the
+// same gap between the cards, and the same suitedness. It returns nil if they do not.
+func classesBetween(from handClass, to handClass) []handClass {
+	if from.suited != to.suited || from.offsuit != to.offsuit {
+		return nil
+	}
+
+	if from.hi < to.hi || (from.hi == to.hi && from.lo < to.lo) {
+		from, to = to, from
+	}
+
+	var ret []handClass
+
+	switch {
+	case from.hi == from.lo && to.hi == to.lo:
+		for c := to; c.hi <= from.hi; c.hi, c.lo = c.hi+1, c.lo+1 {
+			ret = append(ret, c)
+		}
+	case from.hi == to.hi && from.lo != from.hi && to.lo != to.hi:
+		for c := to; c.lo <= from.lo; c.lo++ {
+			ret = append(ret, c)
+		}
+	case from.hi-from.lo == to.hi-to.lo && from.hi != from.lo:
+		for c := to; c.hi <= from.hi; c.hi, c.lo = c.hi+1, c.lo+1 {
+			ret = append(ret, c)
+		}
+	}
+
+	return ret
+}
+
+// combos returns every combination of cards in the class, with weight w
+func (class handClass) combos(w float64) []Combo {
+	var ret []Combo
+
+	for s0, suit0 := range suits {
+		for s1, suit1 := range suits {
+			if class.hi == class.lo && s1 <= s0 {
+				continue
+			}
+			if (class.suited && s0 != s1) || (class.offsuit && s0 == s1) {
+				continue
+			}
+
+			ret = append(ret, newCombo(makeCard(class.hi, suit0), makeCard(class.lo, suit1), w))
+		}
+	}
+
+	return ret
+}
+
+// newCombo returns a combo of c0 and c1, in a consistent order so that the same two cards always make the same Combo
+func newCombo(c0 Card, c1 Card, w float64) Combo {
+	if c0 < c1 {
+		c0, c1 = c1, c0
+	}
+	return Combo{Cards: [2]Card{c0, c1}, Weight: w}
+}
+
+// Without returns the combinations in r that do not hold any of cards, such as the community cards or a player's
+// known hole cards. This is card removal: those combinations can no longer be dealt.
+func (r Range) Without(cards ...Card) Range {
+	ret := Range{}
+
+	for _, combo := range r {
+		blocked := false
+		for _, card := range cards {
+			blocked = blocked || combo.Cards[0] == card || combo.Cards[1] == card
+		}
+
+		if !blocked {
+			ret = append(ret, combo)
+		}
+	}
+
+	return ret
+}
+
+// RangeEquity calculates the equity of each of ranges against the others, as Equity does for single hands, given the
+// community cards already dealt in board and the cards in dead. Combinations that hold any of those cards are
+// removed from each range, and combinations are weighted by their weights. RangeEquity is the same as
+// EquityCalculator{}.RangeEquity.
+func RangeEquity(ranges []Range, board []Card, dead []Card) (EquityResult, error) {
+	return EquityCalculator{}.RangeEquity(ranges, board, dead)
+}
+
+// RangeEquity calculates the equity of each of ranges against the others, in the same way as the package-level
+// RangeEquity, but with the limits set by c. If there are no more than MaxBoards boards to try across every
+// possible combination of hands from the ranges, it tries every one of them. Otherwise, it deals Samples random
+// combinations of hands and boards. Either way, the work is split between as many goroutines as can run at once
+// (see runtime.GOMAXPROCS), and when sampling, each one draws from its own math/rand source, seeded from RNG.
+//
+// RangeEquity returns ErrRangesConflict if the ranges cannot all be dealt a hand without dealing some card twice.
+// When sampling, it only tries a limited number of times in a row (10000) to deal every range a hand, so it also
+// returns ErrRangesConflict if the few combinations that can be dealt together are too unlikely to be found.
+func (c EquityCalculator) RangeEquity(ranges []Range, board []Card, dead []Card) (EquityResult, error) {
+	if len(ranges) < 2 {
+		return EquityResult{}, ErrTooFewHands
+	}
+
+	known := cardSet{}
+	if err := known.addBoard(board, dead); err != nil {
+		return EquityResult{}, err
+	}
+
+	removed := append(append([]Card{}, board...), dead...)
+
+	live := make([]Range, len(ranges))
+	maxBoards := c.maxBoards()
+	matchups := 1
+	for i, r := range ranges {
+		for _, combo := range r.Without(removed...) {
+			if combo.Weight > 0 {
+				live[i] = append(live[i], combo)
+			}
+		}
+
+		if len(live[i]) == 0 {
+			return EquityResult{}, ErrRangesConflict
+		}
+
+		if matchups <= maxBoards {
+			matchups *= len(live[i])
+		}
+	}
+
+	need := 5 - len(board)
+	deckSize := 52 - len(removed) - 2*len(ranges)
+	if need > deckSize {
+		return EquityResult{}, ErrNotEnoughCards
+	}
+
+	// The cards left to deal from before any hands are dealt, which each matchup only has to remove its hands from
+	base := known.deck()
+
+	workers := runtime.GOMAXPROCS(0)
+	tallies := make([]*equityTally, workers)
+	for w := range tallies {
+		tallies[w] = newEquityTally(len(ranges), board)
+	}
+
+	exact := matchups <= maxBoards && combinations(deckSize, need, maxBoards) <= maxBoards/matchups
+
+	var wg sync.WaitGroup
+	failed := make([]bool, workers)
+	if exact {
+		all := liveMatchups(live)
+		if len(all) == 0 {
+			return EquityResult{}, ErrRangesConflict
+		}
+
+		for w := range tallies {
+			wg.Add(1)
+			go func(t *equityTally, w int) {
+				defer wg.Done()
+				var deck []Card
+				for i := w; i < len(all); i += workers {
+					t.hands = all[i].hands
+					deck = deckWithout(deck, base, t.hands)
+					t.enumerate(deck, 0, need, all[i].weight)
+				}
+			}(tallies[w], w)
+		}
+	} else {
+		samples := c.samples()
+
+		cums := make([][]float64, len(live))
+		for i, r := range live {
+			cums[i] = cumulativeWeights(r)
+		}
+
+		seeds := make([]int64, workers)
+		for w := range seeds {
+			seeds[w] = int64(c.rng().Intn(math.MaxInt32))
+		}
+
+		for w := range tallies {
+			wg.Add(1)
+			go func(t *equityTally, w int) {
+				defer wg.Done()
+				r := rand.New(rand.NewSource(seeds[w]))
+				n := samples / workers
+				if w < samples%workers {
+					n++
+				}
+
+				var deck []Card
+				for s := 0; s < n; s++ {
+					hands, ok := sampleMatchup(live, cums, r)
+					if !ok {
+						failed[w] = true
+						return
+					}
+
+					t.hands = hands
+					deck = deckWithout(deck, base, hands)
+					t.sample(deck, need, 1, r, 1)
+				}
+			}(tallies[w], w)
+		}
+	}
+
+	wg.Wait()
+	for _, f := range failed {
+		if f {
+			return EquityResult{}, ErrRangesConflict
+		}
+	}
+
+	for _, t := range tallies[1:] {
+		tallies[0].merge(t)
+	}
+
+	return tallies[0].result(exact), nil
+}
+
+// deckWithout returns the cards in deck that are not in hands, reusing the memory of dst
+func deckWithout(dst []Card, deck []Card, hands [][]Card) []Card {
+	dst = dst[:0]
+	for _, card := range deck {
+		if !inHands(hands, card) {
+			dst = append(dst, card)
+		}
+	}
+	return dst
+}
+
+// inHands returns true if card is in any of hands
+func inHands(hands [][]Card, card Card) bool {
+	for _, hand := range hands {
+		for _, c := range hand {
+			if c == card {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchup is one hand from each range, weighted by the product of the weights of the hands
+type matchup struct {
+	hands  [][]Card
+	weight float64
+}
+
+// liveMatchups returns every combination of one hand from each range, in which no card is dealt twice
+func liveMatchups(ranges []Range) []matchup {
+	var ret []matchup
+
+	hands := make([][]Card, len(ranges))
+	var walk func(i int, weight float64)
+	walk = func(i int, weight float64) {
+		if i == len(ranges) {
+			ret = append(ret, matchup{hands: append([][]Card{}, hands...), weight: weight})
+			return
+		}
+
+		for _, combo := range ranges[i] {
+			if dealtBefore(hands[:i], combo) {
+				continue
+			}
+
+			hands[i] = []Card{combo.Cards[0], combo.Cards[1]}
+			walk(i+1, weight*combo.Weight)
+		}
+	}
+	walk(0, 1)
+
+	return ret
+}
+
+// sampleMatchup picks one hand from each range at random, so that no card is dealt twice. Each possible matchup is
+// picked in proportion to its weight, the product of the weights of its hands, so the matchups it picks need no
+// further weighting. cums holds the cumulative weights of each range (see cumulativeWeights). It returns false if
+// it cannot find a matchup in a reasonable number of tries.
+func sampleMatchup(ranges []Range, cums [][]float64, r *rand.Rand) ([][]Card, bool) {
+	const maxTries = 10000
+
+	hands := make([][]Card, len(ranges))
+	for try := 0; try < maxTries; try++ {
+		ok := true
+		for i, rng := range ranges {
+			combo := pickCombo(rng, cums[i], r)
+			if dealtBefore(hands[:i], combo) {
+				ok = false
+				break
+			}
+			hands[i] = []Card{combo.Cards[0], combo.Cards[1]}
+		}
+
+		if ok {
+			return hands, true
+		}
+	}
+
+	return nil, false
+}
+
+// cumulativeWeights returns the running totals of the weights of the combos in rng
+func cumulativeWeights(rng Range) []float64 {
+	cum := make([]float64, len(rng))
+
+	total := 0.0
+	for i, combo := range rng {
+		total += combo.Weight
+		cum[i] = total
+	}
+
+	return cum
+}
+
+// pickCombo picks a combo from rng at random, in proportion to its weight, by finding the first combo whose running
+// total in cum (see cumulativeWeights) is above a random point between 0 and the total weight
+func pickCombo(rng Range, cum []float64, r *rand.Rand) Combo {
+	x := r.Float64() * cum[len(cum)-1]
+
+	i := sort.Search(len(cum), func(i int) bool { return cum[i] > x })
+	if i == len(cum) {
+		// x can only reach the total weight by rounding
+		i--
+	}
+
+	return rng[i]
+}
+
+// dealtBefore returns true if either card in combo is in hands
+func dealtBefore(hands [][]Card, combo Combo) bool {
+	for _, hand := range hands {
+		for _, card := range hand {
+			if card == combo.Cards[0] || card == combo.Cards[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,214 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eval
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	tables := []struct {
+		description string
+		input       string
+		want        int
+	}{
+		{"Pair", "QQ", 6},
+		{"PairPlus", "QQ+", 18},
+		{"Suited", "AKs", 4},
+		{"Offsuit", "KJo", 12},
+		{"SuitedAndOffsuit", "AK", 16},
+		{"SpecificCombo", "AhKh", 1},
+		{"PairDash", "TT-77", 24},
+		{"KickerDash", "A5s-A2s", 16},
+		{"KickerDashReversed", "A2s-A5s", 16},
+		{"ConnectorDash", "76s-54s", 12},
+		{"KickerPlus", "K9s+", 16},
+		{"ConnectorPlus", "76s+", 32},
+		{"Weighted", "AKo:0.5", 12},
+		{"LowerCaseAndSpaces", " qq+ , ak s ", 22},
+		{"OverlappingHandsCountedOnce", "QQ+, AKs, A5s-A2s, KJo, 76s+", 78},
+	}
+
+	for _, table := range tables {
+		t.Run(table.description, func(t *testing.T) {
+			r, err := ParseRange(table.input)
+			if err != nil {
+				t.Fatalf("\nFAIL:\nIn: %s \nUnexpected error: %s\n", table.input, err)
+			}
+
+			if len(r) != table.want {
+				t.Errorf("\nFAIL:\nIn: %s \nWant: %d combos \nGot: %d \n", table.input, table.want, len(r))
+			}
+
+			seen := map[[2]Card]bool{}
+			for _, combo := range r {
+				if seen[combo.Cards] || combo.Cards[0] == combo.Cards[1] {
+					t.Errorf("\nFAIL:\nIn: %s \nBad or repeated combo: %v \n", table.input, combo.Cards)
+				}
+				seen[combo.Cards] = true
+			}
+		})
+	}
+
+	t.Run("Weights", func(t *testing.T) {
+		r := MustParseRange("AA, AsAh:0.25, AKo:0.5")
+
+		var total float64 = 0
+		for _, combo := range r {
+			total += combo.Weight
+		}
+
+		if want := 5 + 0.25 + 12*0.5; total != want {
+			t.Errorf("\nFAIL:\nWant: total weight %f \nGot: %f \n", want, total)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, input := range []string{"AKx", "A", "AAs", "AA-KQs", "AKs-QJo", "AKs:2", "AKs:x", "AsAs", "QQ+-", "A1s"} {
+			if _, err := ParseRange(input); !errors.Is(err, ErrBadRange) {
+				t.Errorf("\nFAIL:\nIn: %s \nWant: %v \nGot: %v \n", input, ErrBadRange, err)
+			}
+		}
+	})
+}
+
+func TestRangeWithout(t *testing.T) {
+	r := MustParseRange("AA, AK").Without(MustParseCardString("AS"), MustParseCardString("KH"))
+
+	// AA loses the 3 combos with the ace of spades, and AK the 4 with it and the 3 others with the king of hearts
+	if len(r) != 3+9 {
+		t.Errorf("\nFAIL:\nWant: %d combos \nGot: %d \n", 3+9, len(r))
+	}
+}
+
+func TestRangeEquity(t *testing.T) {
+	cards := func(strs ...string) []Card {
+		ret := []Card{}
+		for _, s := range strs {
+			ret = append(ret, MustParseCardString(s))
+		}
+		return ret
+	}
+
+	t.Run("SingleCombosMatchEquity", func(t *testing.T) {
+		board := cards("AH", "KH", "2C", "7D")
+
+		want, err := Equity([][]Card{cards("AS", "AD"), cards("KS", "KD")}, board, nil)
+		if err != nil {
+			t.Fatalf("\nFAIL:\nUnexpected error: %s\n", err)
+		}
+
+		got, err := RangeEquity([]Range{MustParseRange("AsAd"), MustParseRange("KsKd")}, board, nil)
+		if err != nil {
+			t.Fatalf("\nFAIL:\nUnexpected error: %s\n", err)
+		}
+
+		if !got.Exact || got.Boards != want.Boards {
+			t.Errorf("\nFAIL:\nWant: %d boards, exactly \nGot: %d boards (exact: %v)\n", want.Boards, got.Boards, got.Exact)
+		}
+
+		for i := range want.Hands {
+			if !equityClose(got.Hands[i], want.Hands[i], 1e-9) {
+				t.Errorf("\nFAIL:\nRange %d \nWant: %+v \nGot: %+v \n", i, want.Hands[i], got.Hands[i])
+			}
+		}
+	})
+
+	t.Run("WeightedWithCardRemoval", func(t *testing.T) {
+		// Every ace but the ace of spades and diamonds, and every king but the king of spades and diamonds, is on the
+		// board or dead, so each range is down to one combo
+		board := cards("AH", "KH", "2C", "7D", "3S")
+		dead := cards("AC", "KC")
+
+		got, err := RangeEquity([]Range{MustParseRange("AA, QsQd:0.5"), MustParseRange("KK")}, board, dead)
+		if err != nil {
+			t.Fatalf("\nFAIL:\nUnexpected error: %s\n", err)
+		}
+
+		// Trip aces win with a weight of 1, and queens lose with a weight of 0.5
+		want := HandEquity{Win: 200.0 / 3, Lose: 100.0 / 3, Equity: 200.0 / 3}
+		if !got.Exact || got.Boards != 2 || !equityClose(got.Hands[0], want, 1e-9) {
+			t.Errorf("\nFAIL:\nWant: %+v on 2 boards \nGot: %+v on %d boards \n", want, got.Hands[0], got.Boards)
+		}
+	})
+
+	t.Run("SampledPreflop", func(t *testing.T) {
+		calc := EquityCalculator{Samples: 50000, RNG: rand.New(rand.NewSource(1))}
+
+		got, err := calc.RangeEquity([]Range{MustParseRange("AA"), MustParseRange("KK")}, nil, nil)
+		if err != nil {
+			t.Fatalf("\nFAIL:\nUnexpected error: %s\n", err)
+		}
+
+		if got.Exact || got.Boards != 50000 {
+			t.Errorf("\nFAIL:\nWant: 50000 sampled boards \nGot: %d boards (exact: %v)\n", got.Boards, got.Exact)
+		}
+
+		if math.Abs(got.Hands[0].Equity-81.9) > 1 {
+			t.Errorf("\nFAIL:\nWant: equity of about 81.9 \nGot: %+v \n", got.Hands[0])
+		}
+	})
+
+	t.Run("SampledTinyWeights", func(t *testing.T) {
+		calc := EquityCalculator{Samples: 20000, RNG: rand.New(rand.NewSource(1))}
+
+		// Only the relative weights matter, however small they all are
+		aces := MustParseRange("AA")
+		for i := range aces {
+			aces[i].Weight = 1e-12
+		}
+
+		got, err := calc.RangeEquity([]Range{aces, MustParseRange("KK")}, nil, nil)
+		if err != nil {
+			t.Fatalf("\nFAIL:\nUnexpected error: %s\n", err)
+		}
+
+		if math.Abs(got.Hands[0].Equity-81.9) > 2 {
+			t.Errorf("\nFAIL:\nWant: equity of about 81.9 \nGot: %+v \n", got.Hands[0])
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		if _, err := RangeEquity([]Range{MustParseRange("AA")}, nil, nil); !errors.Is(err, ErrTooFewHands) {
+			t.Errorf("\nFAIL:\nWant: %v \nGot: %v \n", ErrTooFewHands, err)
+		}
+
+		if _, err := RangeEquity([]Range{MustParseRange("AsAh"), MustParseRange("AsAd")}, nil, nil); !errors.Is(err, ErrRangesConflict) {
+			t.Errorf("\nFAIL:\nWant: %v \nGot: %v \n", ErrRangesConflict, err)
+		}
+
+		if _, err := RangeEquity([]Range{MustParseRange("AsAh"), MustParseRange("KK")}, cards("AS"), nil); !errors.Is(err, ErrRangesConflict) {
+			t.Errorf("\nFAIL:\nWant: %v \nGot: %v \n", ErrRangesConflict, err)
+		}
+
+		// When sampling, ranges that can never be dealt together are given up on, rather than tried forever
+		calc := EquityCalculator{MaxBoards: 1, Samples: 100, RNG: rand.New(rand.NewSource(1))}
+		if _, err := calc.RangeEquity([]Range{MustParseRange("AsAh"), MustParseRange("AsAd")}, nil, nil); !errors.Is(err, ErrRangesConflict) {
+			t.Errorf("\nFAIL:\nWant: %v \nGot: %v \n", ErrRangesConflict, err)
+		}
+	})
+}
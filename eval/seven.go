@@ -0,0 +1,102 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package eval
+
+import (
+	"bytes"
+	"math/bits"
+)
+
+var sevenHashes *chdSeven
+
+// sevenFlushes holds the score of the best flush (or straight flush) made from the cards of one suit, indexed by
+// their rank bits, for 5 to 7 cards
+var sevenFlushes [8192]uint16
+
+// pow5 holds the place value of each rank in the key of a multiset of ranks
+var pow5 [13]uint32
+
+//go:generate go run ./cmd/buildhashtable/buildhashtable.go -table seven
+func init() {
+	r := bytes.NewReader(seven_card_hashes)
+	sevenHashes, _ = readSeven(r)
+
+	pow5[0] = 1
+	for i := 1; i < 13; i++ {
+		pow5[i] = pow5[i-1] * 5
+	}
+
+	// Taking away one card from more than 5 leaves a smaller index, so the best flush of every 5 of them is known
+	for ndx := range sevenFlushes {
+		switch n := bits.OnesCount(uint(ndx)); {
+		case n == 5:
+			sevenFlushes[ndx] = uint16(flushes[ndx])
+		case n > 5:
+			best := uint16(8000)
+			for rest := uint(ndx); rest != 0; rest &= rest - 1 {
+				if v := sevenFlushes[ndx&^(1<<bits.TrailingZeros(rest))]; v < best {
+					best = v
+				}
+			}
+			sevenFlushes[ndx] = best
+		}
+	}
+}
+
+// LookupHandValue7 returns the score of the best 5-card hand that can be made from the 7 cards passed in, the same
+// as HandValue7 and BestFiveOfSeven. Rather than scoring the 21 combinations of 5 cards, it scores all 7 at once,
+// with a single lookup: in a table of flushes if 5 or more cards are of the same suit (in which case no full house or
+// quads are possible), or otherwise in a perfect hash table of every multiset of 7 ranks. It does not allocate.
+//
+// WARNING: See the warning associated with HandValue.
+func LookupHandValue7(c0, c1, c2, c3, c4, c5, c6 Card) int {
+	cards := [7]Card{c0, c1, c2, c3, c4, c5, c6}
+
+	// Count the cards of each suit in 3 bits, at 3 times the suit bit's value
+	var suitCounts uint32 = 0
+	for _, c := range cards {
+		suitCounts += 1 << (3 * uint32((c>>12)&0xF))
+	}
+
+	for _, suit := range suits {
+		if (suitCounts>>(3*uint32(suit>>12)))&7 < 5 {
+			continue
+		}
+
+		var ndx int32 = 0
+		for _, c := range cards {
+			if int32(c)&suit != 0 {
+				ndx |= int32(c) >> 16
+			}
+		}
+		return int(sevenFlushes[ndx])
+	}
+
+	var key uint32 = 0
+	for _, c := range cards {
+		key += pow5[(c>>8)&0xF]
+	}
+
+	return int(sevenHashes.get(key))
+}
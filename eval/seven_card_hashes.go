@@ -0,0 +1,13093 @@
+// Code generated by go generate; DO NOT EDIT.
+// This file was generated by automatically at 2026-10-18 01:49:47.737680396 +0000 UTC m=+3.308739446
+
+package eval
+
+const num_seven_card_rand_hashes = 1168
+
+const num_seven_card_indices = 24602
+
+const num_seven_card_values = 49205
+
+var seven_card_hashes = []byte{ 
+0xed, 0x74, 0xc7, 0x98, 0x2f, 0xbc, 0x63, 0x4f, 0xe2, 0x9c, 0xe5, 0xfa, 
+0x0b, 0xc9, 0x8b, 0x3d, 0xa7, 0x0f, 0x32, 0x67, 0xc3, 0x00, 0xe1, 0xb2, 
+0x59, 0xda, 0x7f, 0xd7, 0xbd, 0xb1, 0xe1, 0x24, 0x43, 0x16, 0x2f, 0x17, 
+0xfb, 0x64, 0xa7, 0xf5, 0xde, 0x12, 0x18, 0x5c, 0xab, 0xc6, 0x8f, 0xcc, 
+0x07, 0x8c, 0x6c, 0xf3, 0x10, 0x1b, 0xd9, 0x15, 0xd0, 0xbb, 0xab, 0x5e, 
+0x30, 0xfd, 0xa1, 0xc0, 0xdd, 0xf7, 0xd0, 0x69, 0x3e, 0x39, 0x6a, 0xaf, 
+0xb7, 0xed, 0x68, 0x49, 0xbf, 0xe1, 0x15, 0xa1, 0x4b, 0xd6, 0x02, 0xbc, 
+0x90, 0xfd, 0x40, 0x0a, 0xb8, 0x68, 0xaf, 0xcc, 0xaf, 0x71, 0xfd, 0x74, 
+0x22, 0x7a, 0x9b, 0x86, 0x71, 0x1b, 0x80, 0x6a, 0xce, 0x7b, 0xb5, 0x76, 
+0x16, 0xb4, 0x4d, 0x6f, 0x2b, 0x04, 0x42, 0x4e, 0xf5, 0xe9, 0x58, 0x1b, 
+0x7c, 0x84, 0x6e, 0x61, 0xc2, 0x66, 0xf4, 0x36, 0x76, 0x3c, 0x44, 0xeb, 
+0x57, 0xeb, 0xec, 0x53, 0x1c, 0x96, 0x53, 0x9f, 0x4b, 0x48, 0x74, 0x15, 
+0xe8, 0x41, 0x06, 0x5b, 0x83, 0x84, 0xb7, 0x9a, 0xc5, 0x6e, 0x12, 0x45, 
+0xb7, 0xf9, 0x59, 0x3c, 0x82, 0xdc, 0x33, 0x82, 0xd2, 0xf6, 0x96, 0x12, 
+0x1d, 0x1a, 0x3c, 0xdb, 0x4f, 0x2d, 0xc4, 0xd5, 0xdd, 0xcf, 0x8c, 0x73, 
+0xf6, 0xc9, 0xd0, 0x31, 0xf8, 0xb2, 0x2d, 0x2a, 0x1c, 0xbc, 0x48, 0x93, 
+0xa4, 0xcf, 0x6d, 0x40, 0x14, 0x37, 0x77, 0xe7, 0xea, 0x15, 0x34, 0xb7, 
+0x8c, 0x92, 0x8d, 0x05, 0x81, 0x7b, 0x52, 0x6d, 0xe5, 0x8d, 0x15, 0xb2, 
+0x39, 0xa8, 0xbd, 0x5e, 0x6e, 0x7a, 0x53, 0x56, 0xb2, 0xc6, 0x27, 0x65, 
+0x42, 0x91, 0x73, 0x26, 0xd4, 0x91, 0xd4, 0xc8, 0xa2, 0x72, 0x6d, 0x47, 
+0x08, 0x46, 0xb0, 0x21, 0xcd, 0x1d, 0xcd, 0xfe, 0xe0, 0x46, 0x75, 0x01, 
+0x90, 0x27, 0x66, 0x42, 0x77, 0xed, 0xfe, 0xe4, 0x23, 0x29, 0x91, 0xd6, 
+0x70, 0x23, 0x47, 0x87, 0x99, 0x28, 0xc5, 0xaf, 0x85, 0xf0, 0x81, 0x12, 
+0xe1, 0x8a, 0x00, 0xf1, 0xc9, 0x5f, 0xc6, 0x42, 0xc2, 0xe7, 0x00, 0xc1, 
+0xee, 0xe0, 0x0d, 0x24, 0xf1, 0x56, 0xaf, 0xb7, 0xf9, 0xc8, 0x8a, 0x45, 
+0x9d, 0xd1, 0x51, 0xed, 0x6b, 0xaa, 0x5a, 0xef, 0xe5, 0xa8, 0xdf, 0x9e, 
+0x77, 0x15, 0xd3, 0x14, 0x42, 0x9c, 0x21, 0xe3, 0x38, 0x92, 0x94, 0x50, 
+0xc5, 0x42, 0xd1, 0x59, 0x34, 0x9a, 0x28, 0x4e, 0x6e, 0x58, 0xd5, 0xdb, 
+0xf4, 0x4a, 0x6e, 0x45, 0xb1, 0x56, 0x5f, 0x32, 0xf3, 0x6a, 0xc2, 0x3a, 
+0xbf, 0xdf, 0x78, 0x5c, 0xb4, 0xd4, 0xfc, 0x90, 0x1b, 0x92, 0x4d, 0xa1, 
+0x16, 0x3f, 0xcd, 0xde, 0x68, 0xac, 0x5a, 0x0b, 0x9e, 0x43, 0x22, 0xf7, 
+0x18, 0x33, 0x79, 0xee, 0x8b, 0x6e, 0xea, 0xc0, 0x8d, 0x24, 0x1c, 0xb0, 
+0x56, 0x74, 0xa2, 0x99, 0x74, 0xc5, 0x1b, 0x2d, 0x20, 0x06, 0x33, 0x0b, 
+0xb9, 0x64, 0xf8, 0x6b, 0x2d, 0x69, 0x2c, 0x59, 0x78, 0xb6, 0x37, 0xf4, 
+0xa0, 0xd8, 0x93, 0x3a, 0xc0, 0x8a, 0x26, 0x07, 0xc9, 0x29, 0xf9, 0x64, 
+0x3b, 0x10, 0x55, 0xd3, 0x7e, 0x2c, 0xb0, 0x2b, 0x42, 0x11, 0xf4, 0x58, 
+0x08, 0xe4, 0x3a, 0xe3, 0x93, 0xf3, 0x86, 0x7e, 0x08, 0x74, 0x96, 0x89, 
+0xf4, 0x5d, 0xe8, 0x92, 0x6a, 0xac, 0x5b, 0xfa, 0x9d, 0xde, 0x2d, 0x23, 
+0xff, 0xc8, 0xa0, 0x7b, 0x1d, 0x01, 0x8f, 0xc8, 0xc0, 0xc6, 0x9b, 0xc6, 
+0x7f, 0xe6, 0x4a, 0xd7, 0x1c, 0x3f, 0x49, 0x21, 0x3b, 0xd1, 0xcb, 0xbf, 
+0xab, 0x01, 0xa3, 0x57, 0x4f, 0xe0, 0x13, 0x17, 0x36, 0x49, 0x9f, 0x34, 
+0xc8, 0xae, 0xd7, 0xc3, 0x93, 0xda, 0xf8, 0x5b, 0x11, 0xcc, 0x1a, 0xb2, 
+0xb7, 0x96, 0xe0, 0xef, 0xce, 0xde, 0xb0, 0x53, 0x59, 0x7a, 0xa0, 0xa1, 
+0x27, 0xf3, 0xa9, 0x17, 0x0f, 0xcc, 0x14, 0x5a, 0x31, 0x52, 0x69, 0xd3, 
+0x21, 0x8d, 0xfe, 0x3b, 0xfc, 0xe2, 0xfa, 0xab, 0x74, 0xeb, 0x40, 0x79, 
+0x9f, 0x29, 0x47, 0x4b, 0xc7, 0x33, 0x95, 0x04, 0xf4, 0xe3, 0xe2, 0x92, 
+0x1f, 0x7b, 0xe0, 0xc3, 0x90, 0xf1, 0x3e, 0xc7, 0xdd, 0xee, 0xe8, 0x07, 
+0x12, 0x70, 0xd0, 0xf2, 0xab, 0xdf, 0x29, 0x33, 0x87, 0x96, 0x50, 0xba, 
+0x66, 0xfb, 0xe8, 0xec, 0x49, 0x68, 0x36, 0xe9, 0xee, 0x30, 0x62, 0xb0, 
+0x92, 0x1d, 0x25, 0xc8, 0xe0, 0x37, 0xb1, 0x72, 0xc0, 0x0f, 0xa8, 0xee, 
+0xcd, 0x77, 0xf7, 0xb6, 0x0b, 0x11, 0x0a, 0xd5, 0xc7, 0x0b, 0x54, 0x97, 
+0x03, 0xa2, 0xe5, 0xcf, 0xcd, 0x29, 0xeb, 0x88, 0x67, 0x12, 0x1a, 0x8b, 
+0x79, 0x2e, 0x94, 0x35, 0x32, 0xd9, 0x9c, 0xd0, 0xe8, 0xcc, 0xf9, 0x0e, 
+0x18, 0x9a, 0x78, 0x24, 0x58, 0x3c, 0x80, 0x9f, 0x19, 0x6b, 0xc4, 0x44, 
+0x68, 0x07, 0xea, 0x43, 0xdf, 0x8d, 0x5e, 0x4e, 0x0b, 0x0a, 0x30, 0x29, 
+0xfd, 0x9a, 0xa6, 0x92, 0x30, 0xf2, 0x58, 0x8a, 0x27, 0xb8, 0x3a, 0x73, 
+0x2c, 0xcb, 0x75, 0x53, 0xe2, 0xc8, 0xd1, 0x62, 0x6d, 0x99, 0x7a, 0xfc, 
+0xbe, 0xc1, 0x37, 0x24, 0x42, 0xd1, 0x2f, 0x5a, 0xb9, 0x2b, 0x10, 0xfb, 
+0xb0, 0x6f, 0x27, 0xa5, 0x8a, 0x91, 0x6c, 0x70, 0xee, 0x92, 0x3d, 0x7e, 
+0x1f, 0xf8, 0xac, 0x99, 0x62, 0x9d, 0x5d, 0xc2, 0x2c, 0x83, 0xa5, 0x95, 
+0x5c, 0xe6, 0x81, 0x0f, 0xbe, 0x88, 0x22, 0xbd, 0x12, 0x2a, 0x18, 0xd0, 
+0xc3, 0x72, 0x7e, 0x73, 0x76, 0xa4, 0x86, 0xe2, 0x7e, 0x6f, 0x25, 0xef, 
+0x75, 0xec, 0x80, 0xf6, 0xae, 0xad, 0x9f, 0x93, 0x29, 0x38, 0xfe, 0xdb, 
+0x2f, 0x87, 0x7e, 0x63, 0x1f, 0x91, 0xaf, 0xd8, 0x0b, 0x84, 0x65, 0x60, 
+0x50, 0x4a, 0x40, 0x92, 0xbc, 0x5d, 0x0c, 0x7b, 0x6a, 0xca, 0x95, 0x9f, 
+0xbe, 0x87, 0xdf, 0xd0, 0x32, 0x74, 0x16, 0x7e, 0xc7, 0x3c, 0x79, 0xad, 
+0xa9, 0xae, 0x3e, 0x57, 0x6a, 0xa4, 0xba, 0x3f, 0x7f, 0xdc, 0x7d, 0x56, 
+0x9a, 0xf6, 0xd0, 0x15, 0x49, 0x9e, 0xc8, 0xa8, 0x7c, 0x6f, 0x73, 0xdb, 
+0x1e, 0x9e, 0x8c, 0x20, 0xc1, 0xdd, 0x0d, 0xf2, 0xc1, 0xad, 0x0b, 0x40, 
+0x7a, 0x3f, 0x93, 0xcf, 0x0b, 0xac, 0xb4, 0xae, 0xf6, 0x1d, 0x06, 0x22, 
+0x95, 0x35, 0xa6, 0x44, 0xfe, 0xea, 0xef, 0x5d, 0x96, 0x30, 0x7b, 0x4a, 
+0xc4, 0x3e, 0x4d, 0x08, 0xf2, 0x9a, 0xd6, 0xfd, 0xf5, 0x18, 0x70, 0x72, 
+0x20, 0x27, 0x6a, 0x30, 0x0c, 0x66, 0xc6, 0x22, 0x3c, 0x58, 0x32, 0x90, 
+0x64, 0x86, 0x6e, 0x97, 0x03, 0xe1, 0x19, 0xa6, 0x84, 0xdc, 0xb8, 0x55, 
+0x21, 0x7e, 0xae, 0x63, 0x07, 0x13, 0x4f, 0x16, 0x02, 0x21, 0x61, 0xea, 
+0x14, 0x8c, 0xdc, 0xbb, 0x31, 0x0c, 0xb0, 0xf7, 0xcf, 0xfb, 0xe5, 0x25, 
+0x0e, 0xff, 0xc2, 0xac, 0x8a, 0x11, 0x83, 0x2e, 0x02, 0xac, 0xb2, 0xcd, 
+0xd1, 0x89, 0x31, 0x17, 0x67, 0x69, 0x9a, 0x0f, 0x9e, 0x68, 0x0b, 0x8d, 
+0x1d, 0x9d, 0x74, 0x7c, 0x96, 0x8e, 0xc9, 0x06, 0x01, 0x93, 0xae, 0x86, 
+0x64, 0xa6, 0xef, 0x89, 0x7a, 0x5c, 0x05, 0x58, 0x9b, 0x03, 0x18, 0x31, 
+0xab, 0xb9, 0x34, 0xfa, 0xa1, 0x12, 0xd4, 0xd6, 0x5a, 0x2e, 0x25, 0xcf, 
+0x4d, 0xc3, 0xcf, 0xd1, 0x4c, 0x6e, 0x38, 0x1c, 0x41, 0xc3, 0xcc, 0x2b, 
+0x18, 0x08, 0xa9, 0x51, 0x23, 0x03, 0x4c, 0x8a, 0xb5, 0x44, 0xc4, 0x0f, 
+0x93, 0xe1, 0x0a, 0xce, 0xd1, 0xf4, 0xe3, 0x01, 0x25, 0x53, 0x74, 0x76, 
+0x29, 0x2b, 0xa9, 0xf1, 0xf1, 0xdd, 0x05, 0xf2, 0x27, 0x09, 0xe3, 0x00, 
+0x71, 0x12, 0x15, 0x9b, 0x0c, 0xdf, 0x4d, 0x11, 0x06, 0xc3, 0x81, 0x2a, 
+0xe7, 0x08, 0x0b, 0xd7, 0x8f, 0x3a, 0x36, 0xef, 0xd5, 0x16, 0x7c, 0x40, 
+0xd3, 0x68, 0x9a, 0xfb, 0x1d, 0x25, 0x20, 0xcf, 0xd8, 0xee, 0xce, 0xd2, 
+0x53, 0x13, 0x0a, 0xfb, 0x66, 0x76, 0xe9, 0xba, 0x95, 0x40, 0x74, 0x1e, 
+0x28, 0xfa, 0x64, 0x79, 0xf7, 0xea, 0x94, 0x07, 0xb8, 0xee, 0xc6, 0xbb, 
+0x3b, 0x66, 0x8c, 0x68, 0x14, 0x30, 0x4f, 0x63, 0xf6, 0xc2, 0xc6, 0x14, 
+0x6a, 0x9c, 0x9c, 0xc7, 0x95, 0x86, 0x30, 0x92, 0x9c, 0x76, 0x06, 0x46, 
+0x13, 0x5b, 0x87, 0x9a, 0x4c, 0xcb, 0x14, 0xf9, 0x96, 0x3f, 0x3c, 0x80, 
+0x26, 0x6b, 0x34, 0x22, 0xb1, 0xa4, 0xbb, 0x5d, 0x84, 0x7d, 0x24, 0x42, 
+0xf1, 0x85, 0x26, 0x99, 0xaf, 0xe0, 0x57, 0x5e, 0x7f, 0x6c, 0xd7, 0x76, 
+0x06, 0x4e, 0xf4, 0xec, 0xe1, 0x19, 0xcf, 0x7e, 0x97, 0xae, 0xfa, 0x28, 
+0xba, 0xde, 0xe9, 0x6b, 0x1b, 0x13, 0x1b, 0xc8, 0x84, 0x39, 0x6c, 0xf2, 
+0xb0, 0xd1, 0xe5, 0x83, 0x4a, 0xc8, 0xe0, 0x7d, 0xb9, 0xac, 0xd9, 0xe1, 
+0x8c, 0x08, 0xdb, 0xf8, 0x2a, 0x11, 0x23, 0x51, 0x19, 0x54, 0xbd, 0x55, 
+0x18, 0x5b, 0x6a, 0x62, 0xb5, 0x65, 0x74, 0x89, 0x63, 0xab, 0x59, 0x49, 
+0xef, 0x6e, 0x15, 0x15, 0xb3, 0x5f, 0xc6, 0x03, 0x36, 0x95, 0x5b, 0xdd, 
+0x9f, 0x84, 0x82, 0x7e, 0x72, 0xd2, 0x2d, 0x13, 0x69, 0x32, 0x40, 0x09, 
+0x54, 0xb7, 0x3a, 0x6a, 0x7f, 0x34, 0x97, 0xc5, 0x4f, 0xc0, 0xef, 0x2b, 
+0xf9, 0x21, 0xcc, 0xa4, 0xd4, 0xc3, 0x7e, 0x28, 0x91, 0x4f, 0xa3, 0xb3, 
+0xeb, 0x5c, 0x96, 0x66, 0xe3, 0xfc, 0x16, 0x5b, 0x8e, 0x82, 0xc1, 0x35, 
+0x2f, 0xa9, 0x51, 0xb1, 0xb8, 0x5c, 0x6c, 0xe3, 0xc1, 0x86, 0x9f, 0x80, 
+0xfa, 0xfd, 0xc8, 0xf9, 0x3f, 0x2b, 0xad, 0x4f, 0x53, 0x20, 0x68, 0xd8, 
+0x3a, 0xbd, 0xf7, 0x33, 0xe3, 0xb5, 0x61, 0x43, 0x9a, 0xb9, 0xcf, 0xea, 
+0x0d, 0xe2, 0x77, 0x35, 0x50, 0xe7, 0x97, 0xc4, 0xa7, 0xbb, 0xa4, 0xd6, 
+0x9c, 0x40, 0x87, 0x35, 0x34, 0x85, 0xe4, 0x90, 0xa4, 0x8b, 0x82, 0x8f, 
+0x73, 0x99, 0xe5, 0x7a, 0x6f, 0x7e, 0x49, 0x2c, 0x7e, 0x89, 0xf2, 0x55, 
+0x77, 0x3c, 0x63, 0xc7, 0xe9, 0x2f, 0xa2, 0x70, 0x0e, 0xc5, 0x67, 0x5a, 
+0x77, 0x4b, 0x49, 0xe3, 0x4e, 0x3e, 0xa6, 0x62, 0x96, 0xbd, 0x75, 0x84, 
+0x3f, 0xbd, 0x81, 0x5f, 0x5e, 0x47, 0xaf, 0x2d, 0xbc, 0x28, 0x75, 0xcd, 
+0x7c, 0xed, 0x88, 0x33, 0x6a, 0x66, 0x15, 0x15, 0x14, 0x83, 0x73, 0xec, 
+0x90, 0xf1, 0x21, 0x15, 0x62, 0xb6, 0xf2, 0xcd, 0xa4, 0x4a, 0x3e, 0x07, 
+0xd8, 0xd0, 0x3b, 0x2f, 0xa8, 0xa3, 0x16, 0x79, 0xd1, 0xde, 0xcf, 0x04, 
+0xdf, 0x18, 0xb3, 0x20, 0x33, 0xaf, 0xf4, 0xe0, 0x8b, 0x2a, 0x47, 0x02, 
+0x50, 0xdd, 0xb3, 0x9d, 0x52, 0xee, 0x52, 0x84, 0x3d, 0x8c, 0x15, 0xb8, 
+0xe4, 0x76, 0x69, 0x6e, 0x8a, 0x17, 0x76, 0x48, 0x76, 0x97, 0x3c, 0xe2, 
+0xff, 0x10, 0x45, 0x37, 0x3d, 0x91, 0x0e, 0x99, 0xbe, 0x8b, 0x6d, 0xb3, 
+0x73, 0x8c, 0x6e, 0xe9, 0x77, 0xc7, 0x65, 0xda, 0xb4, 0x6a, 0x2d, 0xb6, 
+0x7c, 0xbe, 0x74, 0xae, 0x32, 0xef, 0xb3, 0x90, 0xa1, 0x88, 0xb8, 0x84, 
+0x77, 0x00, 0x63, 0x42, 0xa9, 0x54, 0x5e, 0x43, 0xed, 0x85, 0x1c, 0xc2, 
+0x2a, 0xb1, 0xe0, 0x8e, 0x61, 0x33, 0xdf, 0x31, 0x5b, 0xd8, 0x83, 0xa5, 
+0x3a, 0xa2, 0x67, 0x0b, 0xb0, 0x85, 0x7a, 0x82, 0xd9, 0xd1, 0xd4, 0x15, 
+0x29, 0x66, 0x53, 0x32, 0x76, 0x5b, 0x02, 0x3f, 0x41, 0xfd, 0x75, 0xf3, 
+0xa1, 0x72, 0x96, 0x3c, 0xd7, 0x95, 0x0c, 0x9c, 0xe4, 0x46, 0x07, 0x41, 
+0x94, 0x9f, 0x3a, 0xbe, 0x83, 0x69, 0x77, 0xb6, 0x3d, 0xb3, 0x0b, 0x10, 
+0x33, 0x3d, 0x03, 0x44, 0xf5, 0x76, 0xd6, 0x00, 0x90, 0xa5, 0x7b, 0xa6, 
+0xe5, 0x9b, 0x75, 0xfe, 0xfc, 0xf4, 0x60, 0x09, 0x28, 0x33, 0xff, 0x32, 
+0xa9, 0xcc, 0x15, 0x77, 0xff, 0xbf, 0xab, 0xa1, 0xab, 0xef, 0x89, 0x0b, 
+0x75, 0xd5, 0xd2, 0x23, 0xef, 0x8c, 0xc4, 0x0f, 0xef, 0xcf, 0x48, 0xeb, 
+0xc9, 0xa9, 0xae, 0x00, 0x06, 0xc4, 0x4d, 0x0c, 0x68, 0xe1, 0xea, 0x53, 
+0x04, 0x5e, 0xc3, 0x5c, 0x34, 0xba, 0x49, 0x21, 0x76, 0x59, 0x59, 0x5a, 
+0x13, 0x6d, 0x61, 0x81, 0x3b, 0x16, 0xa9, 0x27, 0x4f, 0x8f, 0xea, 0xe9, 
+0x7f, 0x8a, 0xc0, 0xe3, 0x47, 0xf4, 0x48, 0xed, 0xc5, 0xde, 0x71, 0x04, 
+0x36, 0x44, 0x24, 0x1d, 0xab, 0x2a, 0xf4, 0x0b, 0xe3, 0x9c, 0x54, 0x35, 
+0x4d, 0x79, 0xc0, 0x18, 0xa9, 0x01, 0x81, 0x8b, 0xa8, 0x3a, 0x8e, 0x41, 
+0x48, 0xe9, 0x65, 0x92, 0x3d, 0x18, 0x32, 0x80, 0x61, 0x75, 0xcd, 0x8d, 
+0xf7, 0xec, 0x85, 0xd0, 0x09, 0xe1, 0xde, 0xe2, 0xa1, 0x18, 0x9e, 0xde, 
+0xc3, 0x46, 0xb4, 0xd3, 0xa6, 0x0e, 0xf4, 0x9d, 0x44, 0xda, 0xf2, 0xad, 
+0x51, 0x50, 0xa8, 0x84, 0x1b, 0x35, 0x8b, 0xb3, 0x08, 0x89, 0x51, 0x9d, 
+0xdf, 0x38, 0x22, 0xca, 0x5b, 0x48, 0x94, 0xf6, 0xdd, 0x32, 0x97, 0x1c, 
+0x5b, 0x14, 0x40, 0xea, 0xcf, 0x42, 0xbd, 0x5b, 0xaf, 0xe0, 0x4a, 0x33, 
+0xdb, 0x0e, 0xd8, 0x69, 0x16, 0xcf, 0xab, 0xb6, 0x34, 0xb4, 0x2d, 0xd6, 
+0x86, 0xaa, 0xc2, 0x28, 0x0b, 0xed, 0x6e, 0x20, 0xfc, 0x4a, 0x4d, 0x2c, 
+0x3c, 0x78, 0x38, 0xb6, 0x74, 0xfb, 0x6d, 0x12, 0xe1, 0xa2, 0xbe, 0xb5, 
+0x39, 0x66, 0x02, 0x80, 0xe7, 0xc6, 0x5a, 0x27, 0xf5, 0x8c, 0xbd, 0x0c, 
+0xb7, 0x8e, 0x39, 0xf3, 0x53, 0xaa, 0xd0, 0xe6, 0x71, 0x73, 0x78, 0xd9, 
+0xc5, 0x5d, 0x52, 0x9f, 0x65, 0x5e, 0xbb, 0xd4, 0x68, 0x41, 0x03, 0x02, 
+0x83, 0x4c, 0x23, 0xf7, 0xa7, 0x0a, 0x86, 0x19, 0x40, 0xc8, 0x72, 0xca, 
+0x7c, 0x01, 0x06, 0xb8, 0xbe, 0x6e, 0x29, 0x67, 0x5b, 0x76, 0x2f, 0x81, 
+0x3f, 0xb6, 0x49, 0x62, 0x8f, 0xd2, 0x43, 0x7c, 0xef, 0x9c, 0xb8, 0x01, 
+0xc0, 0xc8, 0x3f, 0x61, 0x18, 0x35, 0x59, 0x06, 0xc8, 0xe2, 0xa1, 0x7c, 
+0x87, 0x15, 0x93, 0x8a, 0x1f, 0x4b, 0x89, 0xad, 0x7a, 0x7c, 0x33, 0x92, 
+0x0a, 0x67, 0xb1, 0xd9, 0x59, 0x56, 0x8c, 0xfd, 0x4c, 0xa2, 0x62, 0xb4, 
+0x42, 0x6c, 0x00, 0x77, 0xf0, 0xeb, 0x36, 0x5a, 0xc7, 0xe7, 0xa8, 0xfb, 
+0x0f, 0xac, 0xd0, 0x3b, 0x04, 0x17, 0x19, 0xfd, 0xa9, 0x1a, 0xd6, 0x66, 
+0x23, 0x39, 0x6c, 0xfe, 0x78, 0x29, 0x1f, 0x94, 0xfc, 0x71, 0x1c, 0xb0, 
+0x6b, 0x8d, 0xca, 0x3b, 0xbf, 0xb2, 0x46, 0xfd, 0x27, 0x75, 0x12, 0xca, 
+0x2e, 0xc6, 0x75, 0xf5, 0x4b, 0x6b, 0x8f, 0xbb, 0xc3, 0x58, 0xe7, 0xa5, 
+0x79, 0x0d, 0x50, 0x0a, 0x8a, 0x73, 0x99, 0x1d, 0x44, 0x52, 0xb5, 0xe8, 
+0xeb, 0x42, 0x0c, 0x31, 0x68, 0x0a, 0x33, 0xa0, 0xe6, 0x4b, 0x70, 0xed, 
+0xac, 0xe5, 0xc6, 0x44, 0x3d, 0x03, 0xbd, 0xd1, 0xd0, 0x67, 0x82, 0x3d, 
+0x43, 0x95, 0xaa, 0x8f, 0x5d, 0xce, 0x41, 0x17, 0xb6, 0xe1, 0x89, 0x9a, 
+0x89, 0x66, 0x1f, 0x07, 0x5f, 0x20, 0xaa, 0x36, 0xd1, 0xdc, 0xb6, 0x66, 
+0x67, 0x09, 0x81, 0xca, 0x95, 0x03, 0xee, 0xb8, 0xb8, 0x6c, 0xeb, 0xa1, 
+0xad, 0xdc, 0x48, 0x13, 0x48, 0x44, 0x90, 0x83, 0x8c, 0x15, 0x77, 0xb4, 
+0x4f, 0xc2, 0x6f, 0xc3, 0x3a, 0xa5, 0x53, 0x54, 0x40, 0x81, 0x4a, 0xbe, 
+0xb7, 0x60, 0x1a, 0x39, 0x09, 0xd6, 0x64, 0x09, 0x1b, 0x2a, 0x35, 0xcb, 
+0x10, 0x61, 0xde, 0x12, 0x49, 0x16, 0xb2, 0x3d, 0x1d, 0x34, 0xbf, 0x0b, 
+0xf2, 0xea, 0x27, 0x0c, 0x35, 0x73, 0x54, 0xcf, 0xe6, 0xf0, 0xb8, 0x41, 
+0x6e, 0x11, 0x76, 0x5e, 0x63, 0x4e, 0xee, 0xf6, 0xca, 0x75, 0x30, 0xd4, 
+0xbc, 0xe4, 0xb9, 0x5d, 0xf8, 0xba, 0x58, 0x29, 0xc0, 0x5b, 0x52, 0x35, 
+0xf5, 0xb6, 0x3a, 0x55, 0xad, 0x28, 0x21, 0x7b, 0x8e, 0x97, 0x22, 0x34, 
+0x5d, 0x3d, 0x0b, 0xf1, 0xb3, 0xd0, 0x9f, 0xa7, 0xd2, 0xe3, 0xeb, 0xdf, 
+0x67, 0x04, 0xc3, 0x5f, 0xe1, 0x6d, 0x90, 0x84, 0x87, 0x71, 0xaf, 0x7a, 
+0x80, 0xc4, 0xfc, 0xe3, 0x45, 0xd6, 0xc3, 0xd1, 0x96, 0x2a, 0xd3, 0x84, 
+0x15, 0x14, 0x3f, 0xb0, 0xa4, 0x6d, 0x49, 0x75, 0xdc, 0x07, 0x3d, 0xc7, 
+0x7f, 0x5a, 0x02, 0x75, 0xa9, 0x5e, 0x11, 0x2e, 0xdf, 0xe1, 0x17, 0xa2, 
+0x6d, 0xf5, 0x1a, 0x33, 0xe5, 0x51, 0x06, 0x6e, 0x28, 0xed, 0xa6, 0x2d, 
+0xee, 0xdd, 0x17, 0x6a, 0x38, 0x87, 0x0a, 0x8d, 0x83, 0xb2, 0xe5, 0xa5, 
+0xc6, 0x06, 0xa6, 0x5d, 0xef, 0x41, 0xad, 0x0d, 0x4f, 0x5e, 0x35, 0xad, 
+0xe2, 0x9f, 0x0f, 0xa2, 0x76, 0x9e, 0x46, 0x9a, 0x91, 0xa8, 0x74, 0xb9, 
+0xa3, 0x60, 0xa4, 0x1a, 0x44, 0xf2, 0xe0, 0xcb, 0xf0, 0xd1, 0x34, 0x72, 
+0x5e, 0x90, 0xf1, 0x03, 0x41, 0xdf, 0x2a, 0x3d, 0x84, 0xf4, 0xa6, 0x97, 
+0xe7, 0xff, 0x84, 0xb9, 0xe1, 0xfb, 0x04, 0x60, 0x02, 0x8f, 0x75, 0x72, 
+0x74, 0x66, 0xfe, 0x73, 0x17, 0x9c, 0x5d, 0x20, 0x4a, 0xe0, 0x87, 0xa8, 
+0x03, 0xb8, 0x94, 0xb7, 0x3c, 0xb8, 0xba, 0x06, 0xcb, 0xa8, 0x12, 0x22, 
+0x4e, 0xd7, 0x22, 0x14, 0x1f, 0x63, 0xc7, 0xf9, 0x3e, 0xdc, 0x4b, 0x2c, 
+0xc4, 0x4b, 0x3a, 0x83, 0xad, 0x4c, 0x47, 0xb3, 0xe5, 0x34, 0x78, 0x50, 
+0x72, 0x7f, 0x16, 0xd2, 0xe6, 0xc0, 0x1c, 0xc6, 0xa4, 0xbd, 0xf2, 0x14, 
+0xbb, 0x76, 0x8c, 0x44, 0x15, 0x06, 0x7c, 0x4a, 0x91, 0xd1, 0xff, 0x98, 
+0xbe, 0x11, 0x6a, 0x8c, 0x15, 0xa0, 0x26, 0xc6, 0x06, 0x11, 0xba, 0xfb, 
+0xd8, 0x07, 0x8c, 0x12, 0x8c, 0xd5, 0x6d, 0x18, 0xa4, 0x89, 0x67, 0x77, 
+0xdc, 0x28, 0xc1, 0x62, 0x11, 0xda, 0xda, 0x51, 0x11, 0x22, 0x09, 0xd1, 
+0xd0, 0xb6, 0x57, 0x82, 0x85, 0x71, 0xde, 0x64, 0x31, 0x4e, 0x6b, 0x93, 
+0x09, 0xc6, 0x5d, 0xe4, 0xdd, 0x86, 0x40, 0xde, 0x1b, 0x04, 0xa5, 0x71, 
+0x1c, 0xd5, 0xda, 0x00, 0xf1, 0x29, 0x13, 0xfa, 0xf7, 0xd6, 0x17, 0x83, 
+0x38, 0x0c, 0x5d, 0x02, 0xe0, 0x17, 0xd8, 0x03, 0xce, 0xf7, 0x79, 0x4f, 
+0xe6, 0xb1, 0x2b, 0x98, 0x6f, 0x56, 0x6e, 0x31, 0x7e, 0x3f, 0x41, 0x12, 
+0x97, 0x5f, 0xcc, 0xed, 0xbd, 0x97, 0x39, 0x5a, 0xe4, 0x21, 0xe9, 0xde, 
+0xd8, 0xc8, 0x4a, 0xbb, 0x18, 0xc3, 0xae, 0xc5, 0x73, 0x94, 0xa3, 0xfa, 
+0x22, 0x9f, 0x8a, 0xfa, 0x4e, 0xf2, 0xca, 0x6f, 0x7a, 0x9a, 0xc3, 0x45, 
+0x98, 0xf5, 0x67, 0xe8, 0x91, 0x27, 0xe3, 0xc0, 0x2d, 0xd6, 0x7f, 0xbc, 
+0x28, 0xdc, 0xd3, 0x2f, 0x11, 0x52, 0x38, 0x74, 0x2a, 0xe7, 0x91, 0xc9, 
+0x34, 0xfe, 0xf2, 0xf7, 0xd2, 0x1c, 0x53, 0x3d, 0x98, 0xa2, 0xa4, 0x84, 
+0x66, 0x43, 0x8c, 0x98, 0x81, 0xf0, 0x25, 0x14, 0x04, 0x87, 0x69, 0x85, 
+0x05, 0xbf, 0xe3, 0x37, 0x59, 0x8d, 0x82, 0x97, 0x7a, 0x57, 0x0f, 0xf5, 
+0x70, 0xc9, 0x7f, 0x52, 0x69, 0xa3, 0x85, 0xa7, 0x94, 0x95, 0xcf, 0x51, 
+0x09, 0xf8, 0xad, 0xb9, 0x59, 0x3b, 0x5e, 0x01, 0x2d, 0x82, 0x07, 0x49, 
+0x62, 0x3c, 0xf1, 0xf9, 0x00, 0xa2, 0xab, 0x3f, 0x59, 0x86, 0xb5, 0xe3, 
+0xd2, 0x58, 0x17, 0x74, 0x17, 0x63, 0x21, 0xb9, 0xf1, 0xdc, 0x10, 0xce, 
+0x9c, 0xf8, 0x62, 0x54, 0x1b, 0x17, 0x4a, 0xed, 0x0d, 0xda, 0x53, 0x85, 
+0x0e, 0xbf, 0xd5, 0xb9, 0xff, 0xb4, 0x08, 0x4a, 0x2b, 0xcf, 0x2d, 0xd9, 
+0x00, 0xc3, 0xd7, 0x9b, 0xf3, 0x85, 0x44, 0x29, 0xed, 0xc0, 0x24, 0x6d, 
+0x41, 0xdc, 0xef, 0x97, 0x51, 0xa1, 0xe8, 0xb7, 0xf8, 0x3b, 0x8e, 0xee, 
+0x9d, 0xfa, 0xdb, 0xa2, 0xc5, 0x51, 0x60, 0x31, 0xe6, 0xb0, 0x6b, 0x3c, 
+0xa5, 0xa8, 0xaa, 0xf0, 0x29, 0x38, 0xf9, 0xda, 0xd2, 0x7e, 0x74, 0x8a, 
+0x83, 0xc8, 0x2d, 0x76, 0x8f, 0x6c, 0x0e, 0xd0, 0x50, 0x08, 0x97, 0x38, 
+0x29, 0x0e, 0x26, 0xab, 0x4a, 0x79, 0x77, 0x9a, 0xc0, 0x4a, 0xa3, 0xd3, 
+0xac, 0x48, 0x54, 0x34, 0x3f, 0x4b, 0xc1, 0xcb, 0x84, 0x6b, 0x1d, 0xfb, 
+0x9c, 0xb3, 0xea, 0x89, 0x01, 0x6b, 0x44, 0x08, 0xd5, 0x14, 0xdb, 0xaf, 
+0xfe, 0x4d, 0xf1, 0x04, 0x4a, 0xd9, 0x6a, 0x87, 0xc7, 0xaa, 0xe6, 0x3b, 
+0x8e, 0x62, 0x27, 0xe9, 0xdc, 0x17, 0x1f, 0xe2, 0x55, 0xf6, 0x6d, 0x77, 
+0x8b, 0x87, 0xa6, 0xcc, 0xaa, 0x93, 0x0e, 0x2a, 0x03, 0xf1, 0xfe, 0x8d, 
+0xb4, 0x75, 0x30, 0x3b, 0xd9, 0x6d, 0x58, 0x6c, 0x73, 0x56, 0xff, 0x13, 
+0x1a, 0xef, 0xda, 0x2e, 0x14, 0x29, 0x58, 0xe7, 0xe0, 0xd3, 0x35, 0x9c, 
+0x53, 0x77, 0x3f, 0x8c, 0xe5, 0x57, 0xbb, 0x3f, 0x5c, 0x8e, 0x3b, 0x11, 
+0x51, 0xe6, 0x00, 0x81, 0xaf, 0xf5, 0x99, 0x6c, 0xe8, 0x57, 0xdb, 0xac, 
+0x4c, 0xdc, 0x3b, 0xba, 0x9d, 0x0b, 0xb7, 0x33, 0xcd, 0x69, 0x34, 0xbf, 
+0x32, 0x52, 0x19, 0xb5, 0x2e, 0x84, 0x05, 0x81, 0xc5, 0x8b, 0xec, 0x77, 
+0x93, 0x25, 0xb1, 0x31, 0xa9, 0x60, 0x48, 0xa1, 0x3a, 0xd8, 0x3f, 0x45, 
+0x6f, 0xdd, 0x79, 0x59, 0xa8, 0x82, 0x98, 0x50, 0xec, 0xc3, 0x32, 0x32, 
+0xee, 0xbf, 0x97, 0xba, 0x02, 0xb9, 0x70, 0x78, 0xe1, 0x49, 0x1b, 0x1b, 
+0x32, 0x1d, 0x1a, 0x29, 0xcc, 0xc5, 0x5b, 0xf4, 0x10, 0x13, 0x70, 0x2a, 
+0xce, 0x06, 0x8e, 0xfa, 0x95, 0x46, 0x5f, 0x7a, 0xb5, 0xe2, 0x3b, 0x0a, 
+0xa5, 0x7c, 0xeb, 0x72, 0x5c, 0xbf, 0xcc, 0x6a, 0x43, 0xa4, 0x6e, 0xb6, 
+0x75, 0xe8, 0x01, 0xa9, 0xe2, 0xb0, 0xe0, 0x5c, 0x4a, 0x49, 0xe1, 0x8e, 
+0xaa, 0xeb, 0x10, 0xbc, 0x2f, 0x19, 0xcf, 0x88, 0xbf, 0x9f, 0x91, 0x47, 
+0x02, 0xbd, 0x49, 0xfc, 0x4e, 0x41, 0xba, 0x38, 0x38, 0xd6, 0x92, 0xdf, 
+0x94, 0xc1, 0x01, 0xd7, 0xec, 0xf6, 0x99, 0x2a, 0x67, 0x01, 0x95, 0x9d, 
+0xd4, 0x97, 0x45, 0x22, 0x52, 0xf4, 0x1b, 0xa5, 0x18, 0x3f, 0xef, 0xef, 
+0x0d, 0xf2, 0xfc, 0x6f, 0xfd, 0x3e, 0x2d, 0x61, 0xe0, 0x30, 0x88, 0x5e, 
+0xcb, 0x1d, 0x76, 0xae, 0x5d, 0x7b, 0x7e, 0x63, 0x13, 0x09, 0xee, 0x98, 
+0x49, 0x97, 0x21, 0xdc, 0x99, 0x5f, 0x91, 0xd3, 0x31, 0x1c, 0x6b, 0x10, 
+0xe4, 0xb7, 0x0c, 0x83, 0x93, 0x61, 0xc9, 0x1d, 0x27, 0x58, 0x80, 0x27, 
+0x33, 0xd8, 0x44, 0x2f, 0x87, 0xcf, 0xce, 0x67, 0xdf, 0xf0, 0x1a, 0x05, 
+0x07, 0xe6, 0x3f, 0x96, 0x5c, 0xf9, 0xe8, 0x5e, 0xf9, 0x11, 0xe4, 0x9f, 
+0x1d, 0x70, 0x4e, 0x74, 0xda, 0x7b, 0x95, 0xa4, 0xfb, 0xfd, 0xcf, 0x75, 
+0x3c, 0xdc, 0x85, 0x7c, 0xda, 0xf0, 0xa6, 0x25, 0x58, 0x5b, 0x57, 0x9e, 
+0xcd, 0x8f, 0x41, 0x74, 0x7a, 0xfc, 0x30, 0x73, 0xae, 0xea, 0xa6, 0x57, 
+0xa2, 0x4b, 0xbb, 0x11, 0x7c, 0x25, 0x37, 0xab, 0x27, 0xde, 0xfb, 0x80, 
+0x9f, 0x81, 0xa6, 0x62, 0xc7, 0xad, 0xbe, 0xea, 0x2a, 0x3b, 0x30, 0x59, 
+0xa5, 0x59, 0xd5, 0xb5, 0x9d, 0xa6, 0x1a, 0x1e, 0x2c, 0x7d, 0xdc, 0xb2, 
+0x41, 0xd8, 0x3c, 0x34, 0x08, 0x4a, 0x64, 0x4e, 0x80, 0xcf, 0x6d, 0x01, 
+0xac, 0x04, 0x08, 0x1e, 0x39, 0xbf, 0xb0, 0x97, 0x3e, 0xb6, 0xd1, 0x2a, 
+0xb9, 0xe9, 0x5e, 0x9d, 0x1f, 0x36, 0x4f, 0x55, 0xfa, 0xa6, 0x1d, 0x61, 
+0x4d, 0xd2, 0xa2, 0x83, 0x88, 0xee, 0xae, 0x83, 0x14, 0xb7, 0xd1, 0x5e, 
+0xd9, 0x08, 0x42, 0x16, 0xcb, 0x10, 0xdc, 0x2c, 0x27, 0x7b, 0x27, 0xd3, 
+0x1a, 0xce, 0x2f, 0xd4, 0x26, 0xd1, 0x7b, 0x5b, 0x87, 0x49, 0x67, 0xf9, 
+0x1b, 0x47, 0x26, 0xcc, 0xb6, 0x2a, 0xdc, 0x45, 0x3a, 0xf1, 0x89, 0x41, 
+0x10, 0xb7, 0x67, 0xa8, 0xaa, 0x53, 0x15, 0x5d, 0x4b, 0x4b, 0x9e, 0x41, 
+0xd8, 0xa0, 0xd1, 0x1a, 0xdd, 0xe1, 0xbd, 0x20, 0x40, 0x2b, 0x8a, 0xa0, 
+0xa9, 0x04, 0xdf, 0xb5, 0xd1, 0x70, 0xec, 0x8c, 0xc9, 0xed, 0x4c, 0x23, 
+0x2d, 0xc3, 0x37, 0x4c, 0x96, 0xa1, 0xa9, 0xdf, 0x95, 0x52, 0xe4, 0xd0, 
+0xdb, 0x17, 0x12, 0x61, 0xc9, 0x29, 0xd8, 0xdc, 0x84, 0xe6, 0xdd, 0x7d, 
+0xd6, 0x7d, 0x2e, 0x6d, 0x3d, 0x20, 0xe2, 0x1f, 0xab, 0x71, 0x57, 0x41, 
+0x57, 0x15, 0x44, 0xfc, 0x59, 0x20, 0x4f, 0x7a, 0x13, 0x42, 0xd5, 0xfe, 
+0x68, 0xcf, 0x5e, 0x1e, 0x8c, 0xbd, 0x42, 0x0e, 0xae, 0x17, 0x6f, 0x96, 
+0x4f, 0xdf, 0x32, 0xd2, 0xd9, 0x2e, 0xda, 0x45, 0x28, 0x07, 0xb9, 0x34, 
+0x61, 0x02, 0xca, 0x8e, 0x82, 0x55, 0x0b, 0x5e, 0x80, 0xe4, 0x0e, 0x31, 
+0x89, 0x33, 0x69, 0xab, 0xa9, 0x8b, 0xd8, 0x80, 0x97, 0xa8, 0x7a, 0xed, 
+0xa7, 0x40, 0x2c, 0x68, 0x29, 0x84, 0x8b, 0x9e, 0xd0, 0x5e, 0x67, 0x02, 
+0x29, 0xf7, 0xd3, 0x41, 0x87, 0xbf, 0xc6, 0x03, 0x9c, 0x91, 0x7a, 0x37, 
+0xf1, 0x8b, 0x0b, 0xc0, 0xc7, 0x65, 0xc2, 0xd6, 0x24, 0xdc, 0x0d, 0x05, 
+0x64, 0x4f, 0xb8, 0x68, 0x2b, 0xc6, 0x01, 0xa8, 0x54, 0xae, 0x21, 0x29, 
+0x16, 0x51, 0xb9, 0x94, 0x75, 0xc0, 0x08, 0x1a, 0x0e, 0x32, 0x5e, 0xeb, 
+0xb3, 0x2b, 0x55, 0x83, 0x86, 0xf6, 0xec, 0xcf, 0xcb, 0x66, 0x01, 0x54, 
+0x4a, 0xb0, 0x1d, 0x36, 0x6f, 0xfb, 0x0c, 0x1e, 0x52, 0x92, 0x81, 0x0a, 
+0x71, 0x55, 0x52, 0xb9, 0x6a, 0x5e, 0x01, 0xe8, 0x30, 0x28, 0x5f, 0x17, 
+0x0e, 0xa0, 0x27, 0xf7, 0xde, 0x0c, 0xeb, 0xa3, 0x36, 0x8c, 0xcf, 0xcf, 
+0x95, 0x89, 0x13, 0x45, 0x67, 0x3a, 0xd3, 0x30, 0xf1, 0x88, 0x24, 0x37, 
+0xc2, 0xd0, 0x14, 0xb3, 0xe0, 0xea, 0xe6, 0x29, 0xf7, 0x99, 0x8f, 0xda, 
+0xce, 0x8f, 0xff, 0x8b, 0xbd, 0xb0, 0xf7, 0x4a, 0x46, 0x16, 0x91, 0x11, 
+0xcb, 0xf7, 0xf0, 0x43, 0xd1, 0xae, 0xb1, 0x84, 0x2f, 0x83, 0xd2, 0x4e, 
+0x82, 0x44, 0x36, 0xe1, 0xf3, 0x87, 0x26, 0x9f, 0x43, 0xee, 0x6e, 0xec, 
+0x9e, 0x42, 0x26, 0x1c, 0x2a, 0xca, 0xec, 0x14, 0x35, 0x09, 0x25, 0x93, 
+0x82, 0x0e, 0x87, 0x4f, 0xbd, 0x8e, 0x4f, 0x12, 0xe5, 0xcb, 0x1c, 0xc0, 
+0xca, 0x61, 0x9b, 0xc5, 0xfc, 0x49, 0x7f, 0xea, 0x41, 0x28, 0xe0, 0x77, 
+0x0f, 0x3c, 0xf1, 0x13, 0xaa, 0x58, 0xee, 0x26, 0x40, 0x11, 0xdc, 0x88, 
+0xb7, 0x3a, 0x03, 0x7c, 0x40, 0x10, 0x3e, 0xfd, 0xb9, 0x95, 0x15, 0x84, 
+0x86, 0xa9, 0x52, 0xc5, 0x47, 0x74, 0xbc, 0x8f, 0x3c, 0xb4, 0x8f, 0x8e, 
+0xf3, 0x42, 0x8f, 0xb4, 0xeb, 0x0c, 0x2e, 0x82, 0x9a, 0xa5, 0x35, 0xb8, 
+0x6b, 0xdf, 0xfa, 0x83, 0x84, 0x52, 0xc0, 0xf9, 0x07, 0xff, 0xfe, 0x79, 
+0xa1, 0x6d, 0x11, 0x3b, 0xbb, 0x80, 0x0c, 0x51, 0x27, 0x0a, 0x80, 0x3e, 
+0x2e, 0x3a, 0xba, 0x24, 0x7a, 0x0d, 0x27, 0x99, 0x35, 0x64, 0x20, 0x09, 
+0xfa, 0x03, 0xa8, 0xa5, 0x49, 0xc6, 0x5b, 0x2e, 0x43, 0xee, 0x80, 0x90, 
+0x03, 0x2a, 0x1f, 0x11, 0x8a, 0xe6, 0x22, 0x6d, 0x57, 0xb1, 0x04, 0xb6, 
+0x4c, 0xab, 0x51, 0x29, 0xb2, 0x63, 0x65, 0x94, 0xab, 0x0c, 0xa2, 0x32, 
+0xfa, 0x2b, 0xaf, 0xc3, 0x71, 0x42, 0x5f, 0xc4, 0x29, 0xee, 0xe8, 0x93, 
+0x5f, 0x96, 0x82, 0xd0, 0xd3, 0x41, 0x2d, 0x37, 0x03, 0x49, 0x0f, 0x9b, 
+0x37, 0xa1, 0xdd, 0x86, 0xf0, 0x17, 0x6c, 0x03, 0x3f, 0x34, 0xa2, 0xe4, 
+0x1b, 0x6d, 0x13, 0x59, 0x56, 0x3b, 0xca, 0xe3, 0xc3, 0xf2, 0x44, 0x93, 
+0xc5, 0xc5, 0xe3, 0xcf, 0x75, 0x06, 0x34, 0x25, 0xbd, 0xec, 0x4f, 0xce, 
+0x2f, 0x78, 0x4b, 0x05, 0x51, 0xaf, 0x2c, 0x00, 0x6d, 0xbd, 0x7e, 0x93, 
+0xd5, 0xad, 0xa9, 0x0e, 0xab, 0xac, 0xfb, 0xf4, 0xbc, 0x94, 0x1b, 0x84, 
+0x46, 0xa5, 0x59, 0xab, 0x1f, 0x3c, 0xf2, 0x69, 0x0f, 0xce, 0x44, 0x35, 
+0xf7, 0xb4, 0xe7, 0x4c, 0xc9, 0xe1, 0x73, 0x71, 0x2c, 0xbe, 0xe7, 0x3f, 
+0x42, 0xcf, 0xac, 0x5d, 0x39, 0x1c, 0x19, 0x27, 0x0f, 0x7d, 0xa9, 0x33, 
+0x82, 0xdf, 0x7e, 0x5d, 0xbe, 0x76, 0x8b, 0xdc, 0xe8, 0xc7, 0xff, 0xc0, 
+0x23, 0x8a, 0x1c, 0xd2, 0xdc, 0x29, 0x1d, 0xc1, 0x64, 0x00, 0x4b, 0x83, 
+0x9e, 0x66, 0x8c, 0x69, 0x95, 0x68, 0x5f, 0xb7, 0xc2, 0xaa, 0x26, 0xe6, 
+0xd8, 0x7e, 0x67, 0xa6, 0x30, 0x10, 0x5e, 0xbb, 0xc4, 0xdf, 0x61, 0x47, 
+0xfc, 0xb2, 0xb6, 0x48, 0xc8, 0xcb, 0xc0, 0x73, 0xf6, 0xdb, 0xde, 0x84, 
+0x4f, 0x4c, 0x49, 0x0c, 0x20, 0xef, 0x8a, 0x44, 0x17, 0x28, 0x79, 0x42, 
+0xe6, 0xae, 0xa7, 0xd2, 0x2f, 0xb3, 0x12, 0x7a, 0x17, 0xd6, 0xef, 0x96, 
+0x6c, 0xcb, 0x0e, 0x2b, 0x58, 0x66, 0x15, 0x28, 0x58, 0x3d, 0x3d, 0x13, 
+0x06, 0x4f, 0x4d, 0xd8, 0xfc, 0x4a, 0x84, 0x5f, 0xe6, 0x1f, 0xb1, 0x1f, 
+0x73, 0xe6, 0xe9, 0x65, 0xeb, 0xfc, 0x0f, 0x8a, 0x35, 0xc4, 0x93, 0x18, 
+0x3e, 0x96, 0x97, 0x0e, 0x93, 0x07, 0xa8, 0x70, 0xdc, 0x48, 0x22, 0x96, 
+0xc5, 0x0d, 0xc9, 0xe7, 0xad, 0x4b, 0x97, 0xe0, 0x7d, 0x6b, 0xa6, 0x08, 
+0x5d, 0x04, 0xd3, 0xf5, 0xc7, 0x67, 0x1f, 0x84, 0x74, 0x9c, 0xd3, 0xfe, 
+0x7e, 0x29, 0x2f, 0x22, 0x52, 0x15, 0xd5, 0xf3, 0xf9, 0x95, 0xc2, 0x88, 
+0x51, 0x1b, 0x7b, 0x1a, 0xef, 0xf1, 0x2c, 0xa7, 0xaf, 0xaa, 0x29, 0xa3, 
+0x66, 0x57, 0xe3, 0xaa, 0x99, 0xf4, 0x4f, 0x08, 0x20, 0x83, 0xc9, 0x55, 
+0xff, 0xc5, 0x0c, 0x48, 0x09, 0x78, 0xf8, 0xeb, 0x97, 0xeb, 0x60, 0x83, 
+0x6a, 0xd9, 0xae, 0xa7, 0xc2, 0x28, 0x24, 0xbc, 0x1a, 0xec, 0x06, 0xc7, 
+0x1a, 0x63, 0x2a, 0x61, 0x66, 0x84, 0xa6, 0xda, 0xd1, 0xe7, 0x73, 0x2a, 
+0x49, 0x2d, 0x8b, 0x6b, 0xc8, 0xd0, 0xf3, 0xd5, 0x7a, 0x11, 0x9d, 0x2b, 
+0x6f, 0xd3, 0x78, 0x2a, 0xd5, 0x8d, 0xc6, 0x74, 0x97, 0x38, 0xac, 0x2a, 
+0x0b, 0x56, 0x90, 0xf0, 0x38, 0x07, 0x0e, 0xec, 0xb3, 0x3b, 0x03, 0xa9, 
+0x51, 0x78, 0xea, 0x91, 0x5e, 0x0e, 0xf6, 0x8c, 0x31, 0xfc, 0x2f, 0xbd, 
+0x40, 0x10, 0xa0, 0x0c, 0xde, 0xc4, 0x63, 0x79, 0xb8, 0x3e, 0x43, 0x53, 
+0xbb, 0xf7, 0x1e, 0xfe, 0x8d, 0x68, 0xee, 0x3c, 0xc5, 0x7e, 0xef, 0x06, 
+0x98, 0x97, 0x25, 0x96, 0x65, 0xa7, 0xfc, 0xc8, 0x2e, 0x93, 0x72, 0xf8, 
+0x99, 0x89, 0x56, 0x43, 0xcc, 0xa4, 0x64, 0x9c, 0x74, 0xf6, 0x9b, 0x74, 
+0x25, 0xd1, 0x93, 0x16, 0x38, 0x45, 0x97, 0xff, 0xe7, 0xef, 0x59, 0x90, 
+0x59, 0x96, 0xf8, 0xde, 0xcb, 0x2f, 0x9b, 0x32, 0x16, 0x3e, 0x1b, 0xc8, 
+0x6f, 0xd2, 0x10, 0x95, 0xbd, 0x57, 0xe3, 0xad, 0x2b, 0x4a, 0x58, 0x3b, 
+0x0b, 0xb4, 0xa4, 0x7d, 0x74, 0xd7, 0x5d, 0x65, 0x7e, 0x6c, 0x11, 0xf5, 
+0xe9, 0x41, 0x15, 0xe9, 0x6c, 0x16, 0x3e, 0xf6, 0x45, 0x2b, 0xe7, 0x9b, 
+0x9f, 0x22, 0x93, 0x0c, 0x47, 0x87, 0xdb, 0x22, 0x90, 0xab, 0xe9, 0x82, 
+0xd5, 0xd5, 0x07, 0xe8, 0x06, 0xf6, 0x3b, 0x8c, 0xf4, 0x74, 0xbe, 0xee, 
+0x8e, 0xe2, 0x3b, 0xdf, 0xc7, 0xca, 0xf9, 0xc6, 0x0f, 0x69, 0x2a, 0xc8, 
+0x5f, 0x85, 0x4e, 0xa1, 0x18, 0xdf, 0x73, 0x59, 0xdb, 0x1c, 0x90, 0x8e, 
+0x54, 0xd0, 0x93, 0xab, 0xc5, 0xb9, 0x3d, 0xa9, 0xc4, 0xaf, 0xce, 0x93, 
+0x91, 0xd3, 0xaf, 0xa0, 0xd9, 0xd9, 0x4b, 0x7b, 0xd4, 0xde, 0x5b, 0x43, 
+0x91, 0xbb, 0xfa, 0x80, 0xd5, 0x73, 0xd8, 0x4b, 0x44, 0xc4, 0x61, 0x0c, 
+0x77, 0x14, 0x62, 0x09, 0xa6, 0x3e, 0x45, 0x1b, 0xa5, 0xdd, 0x96, 0xf8, 
+0xd5, 0x1d, 0x59, 0xed, 0xc4, 0xf4, 0x5b, 0x77, 0x34, 0x91, 0x54, 0xe0, 
+0x94, 0x31, 0x60, 0x76, 0x58, 0x47, 0x9b, 0x45, 0xb2, 0x7b, 0x97, 0x2c, 
+0x4d, 0x00, 0x85, 0xa7, 0xc3, 0x72, 0x9a, 0x8a, 0x71, 0x13, 0x73, 0xe7, 
+0x57, 0x39, 0x85, 0x84, 0x97, 0x65, 0x55, 0xdc, 0x0a, 0xb6, 0x45, 0x3d, 
+0x2a, 0x5b, 0xd8, 0x4e, 0x51, 0xb0, 0x46, 0x76, 0x79, 0xbb, 0x35, 0xae, 
+0x83, 0xce, 0x27, 0xf3, 0x23, 0x99, 0xd8, 0x8b, 0x5b, 0x57, 0xa7, 0x59, 
+0x43, 0xe4, 0xad, 0x59, 0x27, 0xbf, 0x2e, 0xd6, 0x53, 0x21, 0xa0, 0x1f, 
+0x93, 0xdf, 0x5c, 0xea, 0x52, 0xbc, 0x87, 0xdf, 0x4d, 0x04, 0x88, 0xa7, 
+0x0f, 0xb3, 0xcf, 0xf8, 0xbb, 0x4a, 0xad, 0xaa, 0x3d, 0x45, 0x55, 0x8f, 
+0xc0, 0x88, 0x0f, 0x84, 0x0f, 0xe5, 0xac, 0x2f, 0x26, 0x31, 0x75, 0x93, 
+0x30, 0x7c, 0xaf, 0xf7, 0x4b, 0x77, 0x63, 0x91, 0x46, 0xb9, 0x73, 0x29, 
+0x3a, 0xb8, 0x87, 0x35, 0x9f, 0xad, 0x56, 0x95, 0xed, 0xb1, 0xc0, 0x5f, 
+0x44, 0x7f, 0x36, 0x28, 0x58, 0xab, 0xfc, 0xe1, 0x21, 0x55, 0xc9, 0xbd, 
+0xa5, 0x5a, 0x36, 0xc4, 0x73, 0xbc, 0x75, 0xde, 0x14, 0xaf, 0x5c, 0x63, 
+0xd2, 0x6e, 0xab, 0xea, 0x10, 0x57, 0x70, 0x94, 0x9d, 0x9e, 0x02, 0xe0, 
+0xf6, 0xe1, 0x74, 0x56, 0x80, 0x7b, 0x8c, 0x4e, 0xa3, 0x83, 0x9e, 0xba, 
+0xbe, 0xbe, 0xc5, 0xa9, 0x9b, 0x79, 0x24, 0x91, 0x5f, 0xba, 0x77, 0xc8, 
+0x98, 0xc5, 0xe3, 0xf2, 0xc0, 0x52, 0x80, 0xc0, 0xa6, 0xf8, 0xe8, 0x30, 
+0x16, 0x94, 0x18, 0xd4, 0x5c, 0x3c, 0x6a, 0xba, 0xda, 0xe4, 0xd7, 0x55, 
+0xfd, 0x38, 0xa1, 0xa2, 0x1a, 0x9a, 0xc6, 0x38, 0xf9, 0xb2, 0x5e, 0x32, 
+0x18, 0x1a, 0x30, 0x5b, 0x55, 0x1d, 0x4c, 0x91, 0x48, 0x90, 0xcd, 0xd0, 
+0xff, 0x0d, 0xb2, 0xaf, 0x3b, 0xbe, 0x18, 0x66, 0xc3, 0xa3, 0xea, 0x2a, 
+0xa4, 0x4a, 0xfe, 0x2b, 0xa8, 0x1f, 0x11, 0x74, 0xad, 0x16, 0x62, 0xad, 
+0x15, 0x56, 0x6c, 0xb3, 0x80, 0x0c, 0x15, 0x01, 0x01, 0x16, 0x45, 0x73, 
+0xbd, 0x67, 0x09, 0x08, 0x9e, 0xa1, 0x52, 0xb0, 0x29, 0x02, 0x08, 0x88, 
+0xf5, 0xc5, 0xf9, 0x4b, 0xb7, 0x1d, 0xa9, 0x60, 0xf6, 0x00, 0x84, 0x95, 
+0x16, 0x4f, 0xcd, 0xb9, 0x0b, 0xbb, 0x16, 0xb5, 0xd6, 0xad, 0x28, 0xfc, 
+0x22, 0x2d, 0x26, 0x32, 0xd3, 0x1f, 0x59, 0x39, 0x68, 0xcd, 0x49, 0xc6, 
+0xab, 0x52, 0xc8, 0xb2, 0x89, 0x4f, 0xf9, 0x05, 0x44, 0x7a, 0xbd, 0x63, 
+0xa7, 0xc7, 0xad, 0x12, 0x2a, 0x4c, 0x51, 0xf3, 0x1e, 0xf2, 0x76, 0x10, 
+0x5c, 0x65, 0x3b, 0xa7, 0x2e, 0xde, 0x17, 0x45, 0xda, 0xb8, 0x70, 0xa1, 
+0x54, 0xdb, 0x0e, 0x3a, 0x77, 0xd1, 0x8a, 0xff, 0x96, 0x33, 0x2f, 0xdb, 
+0x58, 0xbd, 0x7c, 0x44, 0xee, 0xef, 0x12, 0xec, 0xfc, 0xbf, 0x34, 0x9b, 
+0xdb, 0x9b, 0x71, 0xa0, 0xbd, 0x46, 0x5f, 0x84, 0x0b, 0xad, 0x41, 0x0a, 
+0x20, 0x94, 0x8b, 0x08, 0x91, 0xb1, 0x56, 0xf9, 0x02, 0x08, 0xf9, 0xd7, 
+0xc7, 0x22, 0x43, 0x89, 0xe9, 0x36, 0xf5, 0x51, 0x00, 0xaa, 0xef, 0xb6, 
+0x86, 0xeb, 0x89, 0x1d, 0x81, 0xb1, 0xe2, 0xfd, 0x84, 0xb3, 0x61, 0xdc, 
+0x7b, 0xe5, 0x06, 0xe2, 0xda, 0xbf, 0x89, 0x61, 0x6d, 0x22, 0xdf, 0x36, 
+0x78, 0x01, 0xab, 0x47, 0xfc, 0xf8, 0xbf, 0x3c, 0x1e, 0x5e, 0xfd, 0x36, 
+0x5c, 0x1e, 0x9c, 0x9b, 0xb4, 0x60, 0x71, 0xf9, 0x2b, 0x24, 0x85, 0x28, 
+0x19, 0xec, 0x0f, 0x28, 0x55, 0x59, 0xd7, 0x17, 0xdf, 0x0e, 0xfc, 0x62, 
+0x7a, 0x49, 0x84, 0x17, 0x83, 0x50, 0x99, 0x40, 0x05, 0xbc, 0x21, 0x7b, 
+0x40, 0xc7, 0xc9, 0x57, 0xe6, 0xc9, 0xf6, 0x1c, 0xdc, 0x9a, 0x0a, 0x97, 
+0x93, 0x1d, 0xd2, 0xbb, 0x33, 0x60, 0x84, 0x61, 0x9b, 0x01, 0xeb, 0xe8, 
+0x34, 0xf0, 0xa1, 0x19, 0x61, 0x3a, 0x3f, 0x2b, 0x44, 0xf5, 0x69, 0x55, 
+0xbb, 0xf5, 0x06, 0x30, 0x01, 0x57, 0xb0, 0x4e, 0xa0, 0x1d, 0xea, 0x6c, 
+0x60, 0xe1, 0xb0, 0x0e, 0xc8, 0x3e, 0x49, 0x19, 0xd3, 0x4c, 0xbc, 0x20, 
+0xc8, 0x75, 0x23, 0x2e, 0xe2, 0x64, 0x86, 0x18, 0xae, 0x18, 0x19, 0x95, 
+0xf8, 0xa6, 0x78, 0x02, 0xca, 0xec, 0x9c, 0xa6, 0x58, 0x29, 0x04, 0xf0, 
+0xe0, 0xaa, 0xca, 0xf9, 0x91, 0xba, 0xa3, 0x3c, 0x30, 0x5a, 0xf6, 0x13, 
+0xde, 0xe8, 0x91, 0x02, 0xdb, 0xfe, 0x62, 0x06, 0x61, 0x54, 0x82, 0x36, 
+0x5d, 0x0b, 0xe6, 0x51, 0xae, 0xfc, 0x24, 0x16, 0xe0, 0x45, 0x49, 0xb3, 
+0x43, 0xea, 0x2f, 0xaa, 0x67, 0x71, 0xaf, 0xc1, 0x5c, 0xb9, 0xf2, 0x97, 
+0xfb, 0x31, 0x2a, 0x58, 0x0d, 0xb3, 0x37, 0xff, 0x08, 0x21, 0xc3, 0x21, 
+0xe1, 0xb3, 0x32, 0x96, 0x1b, 0xf0, 0x01, 0x3f, 0x48, 0xfe, 0x98, 0x20, 
+0x9d, 0xc5, 0x5d, 0x60, 0xe4, 0x8c, 0x63, 0x49, 0x97, 0x9a, 0xbe, 0x66, 
+0xe4, 0x76, 0x9f, 0x73, 0x21, 0x67, 0x4e, 0xe6, 0x09, 0xc4, 0xb0, 0xad, 
+0x63, 0x7f, 0xe1, 0x8e, 0x52, 0x5c, 0xef, 0x68, 0x22, 0x5a, 0xe1, 0x60, 
+0xd7, 0x50, 0x35, 0x4f, 0x2c, 0xd5, 0xf4, 0x33, 0x7b, 0xb5, 0xb2, 0x38, 
+0x84, 0x63, 0xde, 0x71, 0x39, 0x03, 0x02, 0xe1, 0x61, 0x9b, 0x12, 0xa0, 
+0xe7, 0x63, 0x72, 0x93, 0x92, 0x5e, 0xd0, 0xb2, 0x8a, 0x50, 0xf1, 0x41, 
+0xe5, 0xaf, 0x1d, 0x9f, 0x35, 0x28, 0x6c, 0xb8, 0xc2, 0x71, 0xfd, 0xc1, 
+0xfb, 0x0d, 0x43, 0x88, 0xf8, 0xb1, 0xb2, 0x34, 0xf1, 0xd0, 0xc8, 0xaf, 
+0x49, 0x3f, 0x92, 0x3f, 0xd7, 0xfd, 0x62, 0x5b, 0xa5, 0x99, 0xef, 0x83, 
+0x2d, 0xb0, 0x01, 0x4e, 0xbb, 0x9c, 0x90, 0xfa, 0xe8, 0x6e, 0x06, 0xf9, 
+0x65, 0xd3, 0xff, 0x51, 0xeb, 0x74, 0x7a, 0x16, 0xc5, 0xff, 0xfc, 0x25, 
+0x0a, 0x1d, 0x76, 0x5f, 0x37, 0x39, 0x07, 0x39, 0xd7, 0xf3, 0xb5, 0x9f, 
+0xcb, 0xae, 0xcd, 0xef, 0xf5, 0xf9, 0x1f, 0xe5, 0x08, 0xd6, 0x88, 0x47, 
+0x14, 0x15, 0xe9, 0x8e, 0x7f, 0xbd, 0x12, 0xfe, 0xd5, 0xf6, 0x4d, 0xd4, 
+0x19, 0xd6, 0x4f, 0xe8, 0xd3, 0x1f, 0x5d, 0x8d, 0x0d, 0xc4, 0x8a, 0xe5, 
+0xb7, 0x91, 0x93, 0xcd, 0xb5, 0x30, 0xd3, 0xd4, 0x14, 0x22, 0xdf, 0x8b, 
+0xf7, 0x9b, 0xe3, 0x38, 0xb9, 0x04, 0x30, 0xba, 0xe3, 0xda, 0xb9, 0xb4, 
+0xfd, 0x49, 0x4a, 0xd1, 0x46, 0x73, 0xe5, 0xd1, 0x17, 0x92, 0x07, 0x40, 
+0x03, 0xf8, 0x8b, 0xfb, 0xa4, 0x99, 0x51, 0x30, 0xa0, 0x89, 0x24, 0xfd, 
+0x4b, 0x22, 0xad, 0xfc, 0x14, 0xbf, 0xe4, 0xdf, 0xff, 0xe3, 0xe8, 0x65, 
+0x44, 0xd3, 0xb3, 0x36, 0x95, 0x2b, 0x6c, 0x86, 0xe5, 0x12, 0xe1, 0x26, 
+0x9c, 0x2c, 0x84, 0x2a, 0xc0, 0x5d, 0xee, 0xc4, 0x0a, 0xe0, 0xfd, 0x4d, 
+0xee, 0x14, 0x21, 0x8d, 0xfb, 0x8e, 0x9a, 0xcd, 0xe2, 0x35, 0xe7, 0x4a, 
+0xe7, 0x59, 0xa6, 0xdb, 0x33, 0xbd, 0xf3, 0x84, 0x06, 0x97, 0xb2, 0xf8, 
+0x3e, 0xdb, 0x75, 0xbc, 0xe7, 0xb4, 0x54, 0x2e, 0x6d, 0xea, 0x5a, 0x2b, 
+0x40, 0x5d, 0xa0, 0x7f, 0x6a, 0xa7, 0xdf, 0x95, 0x53, 0xb8, 0xe7, 0xd3, 
+0x27, 0xb6, 0x9e, 0x95, 0x49, 0x22, 0x70, 0x5d, 0xe2, 0xf8, 0xdb, 0xf6, 
+0x16, 0x76, 0x50, 0x04, 0x84, 0x65, 0x76, 0x60, 0x78, 0x1f, 0xc9, 0x98, 
+0x5d, 0xd7, 0x00, 0x7e, 0x35, 0xd4, 0xf4, 0x02, 0xc6, 0x8b, 0x1b, 0xa0, 
+0x75, 0xce, 0x76, 0x13, 0x2c, 0x44, 0xc6, 0xd9, 0x58, 0x0f, 0x0e, 0x5a, 
+0x10, 0x0d, 0x26, 0xed, 0x4b, 0x97, 0x52, 0x2a, 0xbb, 0x12, 0x6b, 0xc6, 
+0xdf, 0xdb, 0x90, 0x41, 0xad, 0x26, 0x8a, 0x1d, 0x68, 0xf7, 0xa9, 0xb3, 
+0x1d, 0x40, 0x49, 0x7a, 0x2f, 0xbb, 0xc0, 0xd6, 0xf6, 0x82, 0xf5, 0x97, 
+0x6e, 0x16, 0x76, 0xbc, 0xe4, 0xa0, 0x16, 0x4f, 0xf0, 0x3f, 0xce, 0xac, 
+0x3a, 0x23, 0xa7, 0xd6, 0x2a, 0x26, 0xda, 0x5e, 0x9f, 0xf8, 0x05, 0x73, 
+0xda, 0xae, 0x5c, 0xe2, 0x4a, 0x11, 0x5a, 0xcc, 0x3c, 0xfe, 0x38, 0x34, 
+0x60, 0x9b, 0xbc, 0x98, 0x36, 0x3c, 0x3d, 0x80, 0xca, 0xeb, 0xd3, 0x7b, 
+0x53, 0xad, 0x95, 0xe8, 0xed, 0x3a, 0x0f, 0x41, 0x62, 0x91, 0xd7, 0x55, 
+0xda, 0x53, 0xeb, 0x1f, 0xd2, 0x7e, 0x1b, 0x41, 0xd4, 0x08, 0x55, 0xd6, 
+0xbe, 0xbf, 0xd6, 0x9c, 0xfc, 0xa9, 0xfc, 0x3a, 0x0a, 0xdc, 0x45, 0xd4, 
+0xac, 0xe1, 0xa9, 0xbf, 0xf4, 0xcc, 0xbe, 0xeb, 0xe8, 0x61, 0xfa, 0x56, 
+0x77, 0x19, 0xdd, 0xb4, 0xc7, 0xfc, 0x68, 0x32, 0x89, 0xc1, 0xe3, 0x21, 
+0x39, 0xb7, 0x6e, 0x96, 0x98, 0x19, 0xc9, 0xeb, 0xfc, 0xfd, 0x80, 0x82, 
+0x04, 0xe1, 0x47, 0x1a, 0x24, 0x7a, 0x51, 0x5c, 0x4c, 0x81, 0x7f, 0x5f, 
+0x08, 0xf1, 0xe4, 0xda, 0x94, 0xa1, 0x35, 0x4f, 0x9e, 0xec, 0xc2, 0x39, 
+0xab, 0x98, 0xed, 0xfe, 0x33, 0xfd, 0x41, 0x9f, 0xd7, 0x19, 0x42, 0xd3, 
+0xcc, 0xad, 0xc5, 0x9e, 0x98, 0x82, 0xfb, 0x39, 0x5b, 0x94, 0x0f, 0x2c, 
+0xc4, 0xd8, 0x93, 0x17, 0x7a, 0x4f, 0xc8, 0x1a, 0x91, 0x20, 0xb8, 0x39, 
+0x54, 0x43, 0x59, 0x6a, 0xdd, 0xbf, 0x06, 0xb6, 0x19, 0x8c, 0x91, 0x2d, 
+0x68, 0x49, 0x72, 0xb8, 0xb8, 0x0b, 0x32, 0x9c, 0xcf, 0xee, 0xc6, 0xfe, 
+0xbe, 0x13, 0x34, 0x90, 0x93, 0xfd, 0x85, 0xc9, 0x72, 0x4a, 0x28, 0x4f, 
+0x75, 0x61, 0xc6, 0x25, 0x77, 0x58, 0xf9, 0xc9, 0xeb, 0xdb, 0x79, 0xe8, 
+0x52, 0x4e, 0xa9, 0x3e, 0x36, 0x11, 0x3d, 0x15, 0xbe, 0xc4, 0xc1, 0x2f, 
+0x6d, 0xb4, 0x0f, 0x1c, 0x2c, 0x49, 0xf1, 0x82, 0x9e, 0x4a, 0x86, 0x72, 
+0xf8, 0xba, 0x37, 0x48, 0x85, 0x12, 0xd2, 0x17, 0xc1, 0x1f, 0x76, 0xfe, 
+0xf9, 0x09, 0x13, 0xe7, 0x3a, 0x91, 0xa3, 0xa0, 0xbd, 0x82, 0xf7, 0x22, 
+0x42, 0x71, 0x93, 0x40, 0x68, 0x17, 0x17, 0x70, 0x9d, 0x96, 0x11, 0x14, 
+0x2e, 0x93, 0xcf, 0xe0, 0x69, 0x5e, 0xcc, 0xc0, 0x55, 0xde, 0x19, 0x14, 
+0x40, 0xbd, 0xd6, 0x0a, 0x3e, 0x15, 0x0b, 0x55, 0xba, 0x21, 0x74, 0x03, 
+0x29, 0x11, 0x51, 0x31, 0xec, 0x5b, 0x25, 0x3c, 0x3a, 0xad, 0xcd, 0x0d, 
+0x6d, 0x13, 0x63, 0xf5, 0x35, 0x34, 0xbe, 0xc9, 0x78, 0xf5, 0x76, 0x5d, 
+0x1f, 0xbd, 0x7b, 0x93, 0xd3, 0x9f, 0xf6, 0xde, 0x24, 0x64, 0x4a, 0x93, 
+0xa0, 0xbf, 0xc5, 0x42, 0xd7, 0x9a, 0xd6, 0x72, 0x3c, 0x3e, 0xc9, 0xc3, 
+0x62, 0xbd, 0x93, 0xc0, 0x9f, 0x12, 0x98, 0x8d, 0x36, 0xfb, 0x25, 0xac, 
+0x7e, 0xad, 0xf0, 0x77, 0x36, 0x59, 0x90, 0x9d, 0x33, 0x6f, 0x3f, 0x3c, 
+0x90, 0x7d, 0xc5, 0x3f, 0x6e, 0x9c, 0x85, 0x45, 0x06, 0xb6, 0x00, 0x83, 
+0x6a, 0x6d, 0xc3, 0x09, 0x6e, 0x14, 0xd6, 0xc2, 0xb5, 0x81, 0xf9, 0xe0, 
+0xbf, 0x10, 0xfc, 0x3f, 0x1a, 0xfe, 0xbc, 0xf0, 0x8a, 0x89, 0xe0, 0xe4, 
+0xdc, 0x73, 0xae, 0x09, 0x67, 0xaf, 0x73, 0x9e, 0xed, 0xb9, 0x19, 0x31, 
+0x6a, 0x21, 0xdb, 0x91, 0x83, 0x10, 0x80, 0xb0, 0x36, 0x09, 0x04, 0x87, 
+0x50, 0x78, 0x5f, 0x0d, 0x4c, 0xa5, 0xad, 0xeb, 0xf4, 0x22, 0x77, 0x2b, 
+0xa7, 0x3d, 0x3c, 0xe4, 0xcf, 0x25, 0x77, 0xf8, 0xc3, 0x89, 0x13, 0xde, 
+0x1b, 0x56, 0xad, 0x56, 0x2b, 0x6a, 0x82, 0xc1, 0x62, 0xef, 0x8f, 0xc9, 
+0x34, 0x65, 0xd8, 0x2d, 0xad, 0x53, 0x9e, 0x74, 0x37, 0x6c, 0x24, 0x90, 
+0x4b, 0x43, 0x1a, 0x38, 0x52, 0xf4, 0xf1, 0x74, 0x62, 0xc6, 0xcf, 0xe0, 
+0xbf, 0x67, 0xa5, 0x32, 0x02, 0xc5, 0x96, 0xcb, 0x9d, 0xc6, 0xf6, 0x79, 
+0xd0, 0x05, 0x96, 0x5e, 0xe3, 0xe3, 0x6a, 0x61, 0xd6, 0x9c, 0xba, 0xb1, 
+0xac, 0xa0, 0x8b, 0xcc, 0x0b, 0x4f, 0x9a, 0xc5, 0xd8, 0x91, 0x49, 0x31, 
+0x55, 0x63, 0x85, 0x14, 0xf0, 0x8a, 0x00, 0x4f, 0xa0, 0x2d, 0xce, 0xbe, 
+0x7e, 0x74, 0x34, 0x55, 0xde, 0x83, 0x82, 0xc0, 0x2d, 0x47, 0xe0, 0xd7, 
+0xda, 0x7f, 0xcf, 0xc6, 0x81, 0xe5, 0x0c, 0xcf, 0xbd, 0x02, 0x5e, 0x56, 
+0xbd, 0xb4, 0x16, 0xb4, 0x68, 0xa7, 0xbd, 0x96, 0xfe, 0x46, 0x1f, 0xae, 
+0x39, 0x0e, 0x43, 0x85, 0xc2, 0x01, 0x49, 0xc0, 0x72, 0xc5, 0x1b, 0x15, 
+0x53, 0xd8, 0xc8, 0x2e, 0x15, 0x9b, 0x31, 0x63, 0x5e, 0xfc, 0xbd, 0xcc, 
+0x52, 0x4d, 0x6a, 0x46, 0x33, 0x4a, 0xed, 0xc7, 0x28, 0x25, 0xca, 0xa6, 
+0x62, 0x8b, 0x8e, 0x4f, 0x80, 0x73, 0x77, 0x03, 0xea, 0x81, 0xb8, 0xa5, 
+0x42, 0xfd, 0xfa, 0x8a, 0xb2, 0x8b, 0x13, 0x88, 0x78, 0x17, 0x69, 0x22, 
+0x65, 0x6e, 0xfe, 0x19, 0x27, 0x5f, 0xa4, 0xdb, 0x6d, 0x33, 0x6e, 0x9b, 
+0x10, 0x5d, 0x68, 0xbb, 0x35, 0x55, 0x6d, 0x46, 0x69, 0x32, 0xcd, 0x09, 
+0xf8, 0xbe, 0xeb, 0xd6, 0x5f, 0xbe, 0xe0, 0x7e, 0x8c, 0x22, 0x79, 0xc2, 
+0x0c, 0x29, 0x26, 0xaf, 0xdb, 0x93, 0x8b, 0x3d, 0x81, 0x2e, 0x01, 0x77, 
+0xc6, 0xb7, 0x76, 0x84, 0xf4, 0xc6, 0x26, 0xf7, 0x57, 0x17, 0xdf, 0x98, 
+0xc5, 0x88, 0x0e, 0x78, 0x24, 0x4a, 0xcc, 0xca, 0xce, 0x6c, 0x50, 0x7b, 
+0xe0, 0xb1, 0x03, 0x7b, 0x38, 0x7f, 0x82, 0x03, 0x7e, 0xdb, 0xa1, 0xc2, 
+0x4e, 0x2f, 0x1c, 0x21, 0x14, 0xac, 0x61, 0x6c, 0xa5, 0x59, 0x03, 0x2e, 
+0x0a, 0x80, 0x98, 0x6f, 0x35, 0xda, 0x14, 0xf7, 0x1e, 0x04, 0xc6, 0xe9, 
+0xe1, 0x22, 0x59, 0xde, 0x0d, 0xc8, 0xf8, 0x60, 0x9e, 0xf4, 0x40, 0x12, 
+0x14, 0x67, 0x39, 0x1a, 0xc9, 0x27, 0x92, 0xe7, 0x60, 0xbb, 0x98, 0x24, 
+0x53, 0x06, 0xac, 0x5b, 0xce, 0xdd, 0xf2, 0x25, 0x12, 0x6e, 0xc2, 0xa1, 
+0x76, 0x7c, 0x2a, 0x22, 0x2b, 0x92, 0x12, 0xae, 0x5f, 0x43, 0x19, 0xeb, 
+0xbf, 0x58, 0x09, 0x72, 0xdd, 0x45, 0x0c, 0x4f, 0x1f, 0x85, 0xdf, 0xc2, 
+0xcf, 0xd0, 0x5e, 0x94, 0x76, 0x58, 0x36, 0xa7, 0x59, 0x97, 0x0a, 0x18, 
+0x04, 0xad, 0xde, 0x5a, 0x09, 0xac, 0xe0, 0xb5, 0x7a, 0xcf, 0x2f, 0x9b, 
+0x15, 0x03, 0x15, 0x0e, 0x8f, 0xa2, 0xfd, 0x81, 0x07, 0x80, 0xc0, 0xd9, 
+0x9e, 0xe3, 0xfe, 0x9d, 0x55, 0x5d, 0x3f, 0x2f, 0x6b, 0x7f, 0xa6, 0x41, 
+0xcc, 0x18, 0x5b, 0x04, 0x6e, 0x58, 0x31, 0x28, 0xb7, 0x71, 0x06, 0xf0, 
+0x66, 0x77, 0xaf, 0x48, 0x5a, 0x0c, 0x2b, 0x7f, 0xfd, 0x1f, 0x92, 0x15, 
+0xd5, 0x96, 0x5b, 0x15, 0x65, 0x5c, 0xd9, 0xb4, 0x8d, 0x8d, 0xdd, 0x18, 
+0x07, 0x14, 0xc1, 0x79, 0xe4, 0xa8, 0x2e, 0x8f, 0x38, 0x3a, 0x1a, 0x44, 
+0xbe, 0x12, 0x78, 0xa7, 0x31, 0x87, 0xbc, 0x72, 0xe8, 0xe6, 0xa0, 0x0e, 
+0x77, 0x2a, 0xb7, 0xde, 0xc9, 0x28, 0x73, 0x7e, 0x79, 0xad, 0xc8, 0x84, 
+0x58, 0xe3, 0x8c, 0x62, 0xf5, 0x07, 0x95, 0x34, 0x8c, 0x21, 0xdf, 0xe7, 
+0xd3, 0x37, 0xc2, 0x68, 0xfe, 0x30, 0x90, 0x06, 0x6f, 0x32, 0x52, 0x1c, 
+0x97, 0x6d, 0x29, 0xbf, 0xf5, 0x40, 0xd8, 0x10, 0xfe, 0x5d, 0xb4, 0x69, 
+0xb6, 0x36, 0x91, 0x8b, 0x75, 0x48, 0x14, 0x2e, 0x85, 0x51, 0xb9, 0xe5, 
+0xb3, 0x52, 0x29, 0x8a, 0xd3, 0x0b, 0x81, 0x6a, 0x35, 0x19, 0x60, 0x21, 
+0xed, 0x73, 0xa5, 0xd3, 0x63, 0xae, 0x51, 0x5e, 0x7f, 0x9e, 0xf2, 0x30, 
+0x80, 0x00, 0xdb, 0xd7, 0x78, 0x99, 0x5e, 0xb5, 0x5c, 0x1b, 0x2f, 0x53, 
+0x29, 0xe1, 0x60, 0x1e, 0x37, 0xb5, 0x2a, 0x0c, 0x76, 0x47, 0x36, 0x31, 
+0xee, 0x25, 0x71, 0x1c, 0xc3, 0x82, 0xbd, 0xaa, 0x97, 0x17, 0xf4, 0x3e, 
+0x1d, 0x8a, 0x74, 0x85, 0xe0, 0x50, 0x17, 0x5c, 0x68, 0x23, 0x8a, 0x75, 
+0xf4, 0xee, 0x58, 0x4c, 0x9f, 0x33, 0x4e, 0x13, 0x1c, 0x5c, 0xb0, 0x21, 
+0xc9, 0x4d, 0x25, 0x54, 0x24, 0xaa, 0x4b, 0xba, 0x16, 0x73, 0x02, 0xeb, 
+0x84, 0x12, 0x1e, 0xaa, 0x9c, 0x83, 0x4a, 0xe6, 0x14, 0x0f, 0x0a, 0x97, 
+0xc1, 0x43, 0xaf, 0x70, 0xe3, 0x8d, 0x38, 0xc3, 0xbe, 0x69, 0xe3, 0x65, 
+0x75, 0xf6, 0xc3, 0xa8, 0xc4, 0x62, 0x64, 0xdd, 0x70, 0x0a, 0xb0, 0x26, 
+0x69, 0xb4, 0x7b, 0x3c, 0x06, 0xa7, 0x35, 0xbf, 0xee, 0x89, 0xfe, 0xc4, 
+0x15, 0x52, 0x06, 0x15, 0xb4, 0x7d, 0xba, 0xee, 0xf8, 0xa8, 0xa9, 0x0f, 
+0x8d, 0x63, 0x36, 0x76, 0x23, 0x2b, 0xfe, 0xf1, 0x9e, 0xf5, 0x8f, 0x5e, 
+0x18, 0x22, 0x80, 0x25, 0x52, 0x96, 0x90, 0x0f, 0x4d, 0xff, 0xd4, 0xb5, 
+0x48, 0xff, 0xe9, 0x9a, 0x44, 0x5f, 0x7f, 0x77, 0x5c, 0xfd, 0x4c, 0xf4, 
+0x96, 0x27, 0xc7, 0x6e, 0xcc, 0x46, 0xc9, 0xc8, 0xcb, 0x20, 0xb0, 0xba, 
+0xa3, 0x61, 0x9d, 0x76, 0xab, 0xe5, 0xf4, 0x50, 0x15, 0x68, 0x14, 0x63, 
+0x5d, 0x90, 0xea, 0x47, 0xb9, 0xcd, 0x8b, 0x6d, 0x67, 0xc8, 0x0f, 0x96, 
+0x6d, 0xe0, 0xe6, 0x47, 0xe1, 0xf9, 0xeb, 0x95, 0xc2, 0x67, 0x6d, 0xec, 
+0x70, 0x48, 0x5a, 0x07, 0xa7, 0x8f, 0x47, 0xca, 0x2c, 0xb7, 0xb8, 0x28, 
+0x3b, 0xce, 0xe3, 0xb5, 0x04, 0x07, 0xd7, 0xe0, 0x2f, 0xba, 0x96, 0x38, 
+0x61, 0x3b, 0xfe, 0xd2, 0xe6, 0xbc, 0xc2, 0x4d, 0x78, 0xb2, 0xf9, 0x26, 
+0xfc, 0xa7, 0xa1, 0x9b, 0x7b, 0x55, 0xe1, 0x12, 0xec, 0x87, 0x3e, 0x16, 
+0xf3, 0x20, 0xbc, 0x54, 0x4d, 0xfd, 0xeb, 0x36, 0x8c, 0x75, 0x72, 0x8b, 
+0x9a, 0x11, 0x26, 0x82, 0x5c, 0xa5, 0x97, 0x6e, 0x8d, 0x68, 0xa6, 0xc7, 
+0xe0, 0x45, 0xe4, 0x6d, 0xcb, 0xcc, 0xcd, 0x7f, 0x9a, 0x4d, 0xee, 0x54, 
+0xf4, 0x8b, 0x94, 0xc2, 0xff, 0x4e, 0x94, 0x1d, 0x73, 0x48, 0x9e, 0x62, 
+0x10, 0x0d, 0x8d, 0x53, 0x7d, 0x71, 0xf4, 0x85, 0xaa, 0x43, 0x88, 0x67, 
+0xfc, 0x91, 0x0d, 0xc6, 0xa7, 0x28, 0x6f, 0xcf, 0x23, 0x34, 0xb5, 0xfe, 
+0xc3, 0x1f, 0xc6, 0x61, 0x5d, 0x5e, 0xdd, 0xcb, 0x93, 0x15, 0xed, 0x5b, 
+0x52, 0x3c, 0x6a, 0xec, 0x9d, 0x78, 0xbf, 0x7c, 0x2c, 0xc4, 0x36, 0x95, 
+0x04, 0xb6, 0x02, 0x67, 0xfd, 0xd1, 0xbf, 0xfb, 0x75, 0x6d, 0xc9, 0xcd, 
+0x39, 0xf4, 0xd8, 0xd3, 0xd2, 0x85, 0x5d, 0xfd, 0x12, 0xec, 0xb6, 0x97, 
+0x9a, 0x46, 0x3d, 0x79, 0x54, 0xd7, 0xe6, 0x4d, 0x0b, 0xa9, 0x27, 0xc4, 
+0x7c, 0x24, 0x7a, 0x3c, 0x7c, 0xf7, 0x8e, 0x74, 0xee, 0x29, 0x37, 0x56, 
+0xdc, 0x89, 0xfb, 0x91, 0xc2, 0x3f, 0x03, 0x0a, 0x29, 0x72, 0xf9, 0xe3, 
+0x05, 0x37, 0x42, 0xf7, 0x65, 0xc5, 0x4a, 0x4c, 0xc8, 0x06, 0x49, 0xc9, 
+0x7d, 0x11, 0x6a, 0xd1, 0xa7, 0x4e, 0xfb, 0x66, 0x83, 0xa4, 0x45, 0xeb, 
+0x9f, 0xf2, 0x79, 0xd3, 0x2f, 0xcd, 0x0b, 0xee, 0x9e, 0x98, 0x75, 0x8d, 
+0x75, 0xdd, 0x4e, 0xb0, 0xc1, 0xe4, 0x92, 0x69, 0xa4, 0x54, 0x49, 0x40, 
+0xa0, 0xec, 0x57, 0x41, 0x04, 0xda, 0xd0, 0xf0, 0x5f, 0x2d, 0x71, 0xec, 
+0xfc, 0x36, 0x38, 0xb4, 0x04, 0xa0, 0x54, 0x6c, 0xb6, 0x79, 0x47, 0x91, 
+0x7b, 0x5c, 0x87, 0xe7, 0x24, 0xbb, 0xbb, 0xb4, 0x24, 0x94, 0x90, 0xe2, 
+0xc9, 0x35, 0x1c, 0x7a, 0x64, 0x7a, 0x4f, 0x6c, 0xce, 0xbb, 0x12, 0x16, 
+0x40, 0x37, 0xdc, 0xc0, 0x30, 0xbe, 0xee, 0xc4, 0xab, 0x82, 0xf6, 0x18, 
+0x09, 0x01, 0x8b, 0xf7, 0x5a, 0x00, 0x3d, 0x34, 0x97, 0x44, 0x7a, 0x68, 
+0x0e, 0x44, 0xed, 0xf6, 0x16, 0xcf, 0x72, 0x29, 0x0d, 0x14, 0x2e, 0x72, 
+0x82, 0x3f, 0xf4, 0xd5, 0xb5, 0x23, 0x6a, 0x34, 0xe8, 0x82, 0x5b, 0x03, 
+0xc8, 0xca, 0xb9, 0x9f, 0x20, 0xce, 0x77, 0xb0, 0xc8, 0x57, 0x4f, 0x9d, 
+0x7c, 0xf1, 0x9d, 0xdc, 0x9b, 0x4e, 0x8f, 0x74, 0x40, 0xce, 0x6d, 0xea, 
+0x6e, 0x7a, 0xd8, 0x25, 0xf2, 0x6a, 0x82, 0xab, 0x3b, 0xd6, 0x75, 0x9e, 
+0xdb, 0xa6, 0x8a, 0xce, 0x83, 0xb8, 0xa2, 0x18, 0xcd, 0x51, 0x81, 0x8c, 
+0xe4, 0x1a, 0x9c, 0x51, 0x1b, 0x29, 0x14, 0x7f, 0x69, 0x0b, 0x46, 0x86, 
+0x25, 0x06, 0x35, 0x4a, 0xfe, 0xea, 0xdd, 0xa8, 0x67, 0x78, 0xfa, 0x51, 
+0xdd, 0x92, 0xf4, 0x3b, 0x40, 0x71, 0xc7, 0x29, 0x2a, 0xf5, 0x1e, 0x8c, 
+0x55, 0xdb, 0x4c, 0xfd, 0x12, 0xaf, 0xf2, 0xfc, 0x8b, 0x17, 0x2e, 0x24, 
+0x53, 0x40, 0x79, 0xfe, 0x6b, 0x05, 0x8c, 0x49, 0x8e, 0xe7, 0x53, 0x8e, 
+0x15, 0x04, 0x32, 0xa3, 0xb7, 0x5e, 0xb3, 0xea, 0x9d, 0x28, 0xdc, 0x67, 
+0x89, 0xd3, 0x13, 0x6e, 0x25, 0x3b, 0x09, 0x55, 0x2b, 0x54, 0xeb, 0x4b, 
+0x8b, 0x49, 0x9e, 0xd1, 0x58, 0x67, 0x1d, 0x34, 0x33, 0x45, 0xcf, 0xdf, 
+0xfc, 0x38, 0xc8, 0x00, 0x07, 0x5d, 0x1c, 0xdd, 0x70, 0x58, 0x0e, 0x61, 
+0x8e, 0x65, 0x1f, 0x43, 0x38, 0xa0, 0x8c, 0xa5, 0xaf, 0x05, 0xc2, 0xfa, 
+0xe4, 0x7f, 0x82, 0xd3, 0xf7, 0x10, 0xfb, 0xa6, 0xe2, 0x0b, 0x97, 0xe2, 
+0xc5, 0xee, 0x29, 0xb9, 0x73, 0xc4, 0x95, 0xf0, 0xe4, 0x48, 0xe2, 0x0f, 
+0x62, 0x3d, 0x0e, 0x13, 0xb3, 0x3f, 0xbf, 0x76, 0x22, 0x81, 0x35, 0x7e, 
+0xf2, 0x3d, 0xdc, 0xa7, 0x5f, 0x0e, 0x09, 0x71, 0xab, 0x67, 0x7f, 0x4f, 
+0x1a, 0x78, 0x6c, 0x95, 0xe6, 0x94, 0x81, 0x51, 0xa1, 0x2b, 0xee, 0xe9, 
+0xb8, 0x34, 0x08, 0xf7, 0x9b, 0x0b, 0xc3, 0xb3, 0x63, 0x6b, 0x6b, 0x19, 
+0x9f, 0xf1, 0x2f, 0xa2, 0x50, 0x9b, 0x50, 0xdd, 0x06, 0x80, 0xdb, 0xd1, 
+0xf0, 0xb5, 0x70, 0x83, 0x70, 0x68, 0x1e, 0xc9, 0x35, 0xb9, 0x98, 0x0b, 
+0x1b, 0xee, 0x7f, 0x14, 0x88, 0x85, 0x21, 0x7a, 0x8a, 0xd6, 0x43, 0xf8, 
+0x6a, 0x7e, 0x35, 0x02, 0xb7, 0x98, 0x67, 0xe6, 0x8d, 0x8b, 0x65, 0x20, 
+0x4c, 0x28, 0xd3, 0xa8, 0x7a, 0x0e, 0xae, 0xee, 0xb6, 0x51, 0xa4, 0xe2, 
+0xaa, 0x46, 0x1b, 0xa7, 0xc3, 0x77, 0x54, 0x06, 0x8e, 0x49, 0xc2, 0x86, 
+0x94, 0x05, 0x79, 0x3b, 0x3b, 0xa3, 0xd4, 0x27, 0xad, 0xe5, 0x1b, 0xd3, 
+0x45, 0x66, 0x03, 0x02, 0x3c, 0xd6, 0x57, 0xd2, 0x2b, 0x53, 0xfb, 0xd5, 
+0x70, 0xe3, 0xd7, 0x80, 0xe5, 0xfe, 0x1d, 0xd2, 0x55, 0xf9, 0x50, 0x9f, 
+0xb2, 0x1b, 0xc0, 0x7d, 0x72, 0x41, 0xfb, 0x4c, 0x5f, 0x01, 0x83, 0x59, 
+0x00, 0x7a, 0x35, 0x36, 0x70, 0x36, 0x7c, 0x82, 0xc5, 0x5a, 0xa3, 0x0d, 
+0x41, 0xb4, 0x57, 0xa1, 0xf1, 0x08, 0x6c, 0xb8, 0x02, 0x57, 0xc0, 0x78, 
+0x2a, 0x28, 0xb9, 0xfe, 0x20, 0xca, 0x89, 0x91, 0x5c, 0x65, 0x3c, 0x78, 
+0x65, 0x62, 0xd0, 0x7d, 0xd0, 0xaa, 0xe3, 0x92, 0xbd, 0x89, 0xca, 0x97, 
+0xcf, 0xf1, 0xdd, 0x3b, 0x36, 0xd3, 0x21, 0x36, 0x28, 0x18, 0xf9, 0xfc, 
+0xb6, 0x2d, 0xe3, 0x69, 0x91, 0x8a, 0x2d, 0xeb, 0xd7, 0x67, 0xb5, 0xcd, 
+0x0f, 0x4a, 0x41, 0xd0, 0x27, 0x52, 0x1b, 0x57, 0xcd, 0xd6, 0xbd, 0x99, 
+0x3d, 0x98, 0x6d, 0xcc, 0xeb, 0x86, 0x24, 0xcf, 0xb6, 0x93, 0x70, 0x63, 
+0x98, 0x6b, 0x3f, 0x30, 0x8b, 0xa1, 0x8a, 0xb0, 0x59, 0x98, 0x07, 0x77, 
+0x6f, 0xd3, 0x5e, 0x9e, 0x3e, 0x2b, 0x58, 0xe4, 0x95, 0x67, 0x3a, 0xd4, 
+0x4d, 0xfa, 0x41, 0x22, 0x4e, 0x2a, 0xc2, 0x11, 0x14, 0x13, 0x9c, 0xbb, 
+0x5a, 0xe0, 0x18, 0x11, 0x8e, 0x8b, 0xf7, 0x2c, 0xfc, 0x82, 0x71, 0x24, 
+0xfe, 0x00, 0xfa, 0xc6, 0x34, 0x80, 0x25, 0x5a, 0x1d, 0xe2, 0x02, 0xdc, 
+0x43, 0x54, 0x34, 0x48, 0x7b, 0xce, 0x91, 0x36, 0x41, 0x56, 0x38, 0xd4, 
+0x5e, 0x08, 0x22, 0x7f, 0xed, 0xa5, 0x24, 0x25, 0x52, 0x2a, 0x9f, 0xb3, 
+0xe0, 0x10, 0x91, 0x07, 0xaa, 0xe2, 0x6d, 0xb6, 0xf2, 0x6c, 0x5f, 0xdd, 
+0x54, 0xee, 0x71, 0x10, 0x20, 0x6a, 0x4a, 0x24, 0x8b, 0x1e, 0x7d, 0xc7, 
+0x54, 0xa0, 0x2e, 0x8c, 0xaf, 0x90, 0x86, 0xe8, 0x78, 0x36, 0x5f, 0xd2, 
+0xdc, 0xcd, 0x64, 0x8e, 0x4a, 0xdf, 0x60, 0x5f, 0x3a, 0x67, 0xc4, 0x6f, 
+0x58, 0x57, 0x64, 0x17, 0xe1, 0x3d, 0x9b, 0x18, 0x4b, 0xd6, 0x04, 0x5f, 
+0x91, 0x9a, 0xef, 0x70, 0xe4, 0xf0, 0x19, 0xd9, 0x03, 0xf9, 0x32, 0x9c, 
+0x05, 0xf3, 0xc0, 0x40, 0x9e, 0x56, 0x4d, 0x3b, 0x83, 0x31, 0xaf, 0x08, 
+0x5a, 0xd6, 0x93, 0x3a, 0xe4, 0xac, 0xb2, 0x42, 0x31, 0x2f, 0x11, 0xad, 
+0x56, 0xda, 0xbc, 0x75, 0x98, 0xd6, 0x0b, 0x54, 0xf7, 0x91, 0xf1, 0x8e, 
+0xc0, 0xc9, 0xd6, 0x91, 0x11, 0x47, 0xff, 0x01, 0xee, 0x35, 0x4a, 0xb3, 
+0xfd, 0xc9, 0x32, 0xa5, 0x66, 0xed, 0xdb, 0xd8, 0xfa, 0xa0, 0xc6, 0xb7, 
+0x1d, 0xa5, 0x45, 0x1b, 0x29, 0xb2, 0xaa, 0x1f, 0x21, 0xc7, 0xe5, 0x95, 
+0x26, 0xef, 0x97, 0x6a, 0x08, 0xc6, 0x26, 0x11, 0x95, 0xa8, 0x16, 0x24, 
+0x3b, 0xf7, 0xeb, 0xd5, 0x5f, 0x0c, 0xea, 0xab, 0xe9, 0xee, 0x7f, 0x19, 
+0x1f, 0x2a, 0xac, 0xc4, 0xd9, 0xde, 0x19, 0x3d, 0xf0, 0x26, 0xfc, 0x5a, 
+0xc7, 0x6d, 0x5c, 0xa1, 0x62, 0x3e, 0xe4, 0x46, 0xd4, 0x69, 0x93, 0xfd, 
+0x51, 0xcc, 0xfd, 0xe1, 0x9c, 0x9b, 0x7e, 0xa6, 0xb1, 0x95, 0xf3, 0xa8, 
+0xb2, 0x8d, 0x24, 0x00, 0xff, 0x45, 0xc7, 0x0d, 0x17, 0x0f, 0x74, 0xed, 
+0xd0, 0x26, 0x09, 0x27, 0x40, 0xe4, 0xeb, 0x2f, 0x2e, 0x18, 0x87, 0xfa, 
+0x03, 0xf2, 0x1d, 0x8f, 0x1d, 0xbd, 0xf3, 0x50, 0x55, 0x77, 0x56, 0x1f, 
+0x31, 0xe8, 0x43, 0xca, 0x61, 0xb1, 0x76, 0xc3, 0x17, 0x1e, 0xb3, 0xb3, 
+0x74, 0x1c, 0x3b, 0x35, 0x16, 0x71, 0x6e, 0xa9, 0x8a, 0x53, 0xe9, 0x0b, 
+0x27, 0xbc, 0x87, 0x0b, 0xb8, 0x4e, 0xd6, 0xaa, 0xaf, 0x2d, 0xf4, 0xeb, 
+0x40, 0x2e, 0x8b, 0x20, 0x27, 0xde, 0x0b, 0xd1, 0x50, 0x61, 0xdc, 0x01, 
+0x23, 0x31, 0x3a, 0x3d, 0x3a, 0x6a, 0xea, 0x39, 0x37, 0x48, 0x45, 0x52, 
+0x5a, 0x0e, 0x87, 0xd6, 0xdd, 0x65, 0x1a, 0xa5, 0x4a, 0x69, 0xfb, 0xf6, 
+0x61, 0x34, 0xe7, 0xb0, 0xa5, 0x9b, 0x85, 0x65, 0xfa, 0xbb, 0x17, 0x06, 
+0x8f, 0x24, 0x32, 0x33, 0xaf, 0xe0, 0xa4, 0xe3, 0x7a, 0xeb, 0xa6, 0xb2, 
+0x50, 0xd4, 0x46, 0x44, 0xde, 0x57, 0x95, 0xe3, 0x6b, 0xfb, 0xbe, 0xb7, 
+0xa1, 0x45, 0xb4, 0x13, 0xa7, 0x73, 0x04, 0x29, 0x99, 0x97, 0xc6, 0x94, 
+0x4b, 0xed, 0x06, 0x5f, 0x84, 0xd9, 0x0a, 0x80, 0x0d, 0x2a, 0xd8, 0xfc, 
+0xb2, 0xb6, 0x07, 0x8b, 0xaf, 0x90, 0x1e, 0x87, 0xa8, 0x74, 0x48, 0xba, 
+0xa9, 0x5a, 0xc6, 0x21, 0x03, 0x54, 0x96, 0xbb, 0x3f, 0xf1, 0x76, 0xf4, 
+0x4e, 0x58, 0x75, 0x83, 0x69, 0x5d, 0xa1, 0xb4, 0x29, 0x17, 0x27, 0xf8, 
+0xe4, 0x8e, 0x30, 0x24, 0x3b, 0x36, 0x13, 0x14, 0x26, 0xda, 0xc8, 0x6b, 
+0xd9, 0xde, 0x4a, 0xc6, 0x0d, 0x48, 0x80, 0x45, 0x7c, 0x03, 0xca, 0x81, 
+0x2d, 0xa5, 0xd4, 0x91, 0xc9, 0x02, 0x42, 0x13, 0x05, 0x7d, 0xea, 0xe1, 
+0x91, 0xf3, 0xf2, 0xea, 0x7f, 0x65, 0x8f, 0xbc, 0x0e, 0x9c, 0xb4, 0x5b, 
+0x76, 0x9c, 0x9b, 0x17, 0x0b, 0x5a, 0x6a, 0x70, 0xd6, 0x0b, 0x54, 0x64, 
+0x7f, 0x7c, 0x7c, 0x41, 0x63, 0x39, 0x03, 0x40, 0x15, 0x8b, 0x9d, 0xed, 
+0x7b, 0x96, 0x1b, 0xe6, 0xd1, 0xec, 0xdb, 0x44, 0xf8, 0x12, 0x80, 0x10, 
+0xb7, 0xe7, 0x7c, 0xfb, 0xa3, 0x35, 0x1f, 0xea, 0x31, 0xe1, 0x3d, 0x90, 
+0x77, 0x8d, 0x2c, 0x23, 0xdb, 0x1a, 0x84, 0x08, 0xf8, 0xa2, 0xa4, 0x98, 
+0x86, 0x41, 0x66, 0x6e, 0x46, 0x29, 0xff, 0x49, 0x60, 0x10, 0x2e, 0xc4, 
+0xe9, 0xcb, 0x69, 0x6a, 0x19, 0xb2, 0x17, 0x17, 0xa5, 0x78, 0x16, 0x08, 
+0x83, 0x4a, 0xfb, 0x59, 0x0c, 0x85, 0x43, 0x44, 0x8c, 0x87, 0xaa, 0xc3, 
+0xa9, 0x1f, 0x1e, 0x92, 0x1b, 0x94, 0xe1, 0x38, 0xe0, 0x2e, 0x4e, 0x80, 
+0x5d, 0xcb, 0x05, 0xe4, 0x55, 0xb5, 0x8c, 0x30, 0x83, 0x03, 0xe0, 0x1f, 
+0x7f, 0xef, 0x2f, 0x6a, 0x54, 0xd9, 0x1b, 0x71, 0x2a, 0xde, 0xac, 0x07, 
+0xfb, 0xb6, 0x0c, 0x5c, 0xb6, 0x7f, 0x9c, 0x9e, 0xd1, 0x00, 0xb0, 0x1f, 
+0x4a, 0x76, 0x95, 0xc3, 0xa6, 0x00, 0xa1, 0x59, 0x67, 0x43, 0x5c, 0xa3, 
+0xb6, 0xe3, 0x8d, 0x1c, 0xb4, 0x99, 0x53, 0xaf, 0x49, 0x06, 0x3d, 0x13, 
+0x7b, 0xd1, 0x8e, 0x13, 0x4f, 0xee, 0xbd, 0xe3, 0x5b, 0x25, 0x32, 0x4a, 
+0x7a, 0xf0, 0x10, 0xf4, 0x1c, 0x4a, 0xd5, 0xfb, 0x08, 0x83, 0xe8, 0x8a, 
+0xff, 0x4a, 0x82, 0x5b, 0x5b, 0xbf, 0x1a, 0xb7, 0xb7, 0xf9, 0x14, 0xfa, 
+0xbb, 0xf4, 0x6c, 0xd0, 0x20, 0xe9, 0x4b, 0xe1, 0xda, 0x57, 0x55, 0x1c, 
+0xec, 0x47, 0x21, 0x02, 0xe6, 0x22, 0x20, 0xd8, 0xdf, 0x96, 0x34, 0xc7, 
+0x8a, 0x62, 0x15, 0x27, 0x8a, 0x1f, 0x26, 0x27, 0xdb, 0xe8, 0x76, 0x3e, 
+0x74, 0x76, 0xec, 0xa9, 0x07, 0xd7, 0xd7, 0x93, 0x31, 0xaf, 0x9b, 0xc7, 
+0xb1, 0x3b, 0xc6, 0x0d, 0x8d, 0xe6, 0x14, 0xf4, 0x5f, 0x61, 0x55, 0xb7, 
+0xaf, 0x6a, 0xba, 0x44, 0xe4, 0x40, 0x39, 0xe7, 0x0a, 0x6f, 0x22, 0x01, 
+0x27, 0x97, 0xed, 0x4e, 0xa6, 0xa9, 0x03, 0x97, 0xbf, 0xae, 0x05, 0x5c, 
+0xa3, 0x91, 0xe8, 0x26, 0xe5, 0xfb, 0x05, 0xb2, 0xe5, 0xc8, 0xec, 0x3d, 
+0x29, 0x7f, 0xc0, 0xad, 0x08, 0xad, 0xda, 0xa9, 0xcc, 0xde, 0x8a, 0xea, 
+0x30, 0xff, 0xb9, 0xfb, 0x92, 0x89, 0xb3, 0xf2, 0xf6, 0xc0, 0xf8, 0x23, 
+0xd2, 0x3b, 0x7b, 0x39, 0x76, 0x16, 0xf0, 0xc1, 0xe0, 0xe0, 0xf7, 0x09, 
+0x7d, 0x87, 0x00, 0x8b, 0x86, 0x3c, 0xae, 0x08, 0x54, 0xb8, 0x03, 0xc9, 
+0xaa, 0x8a, 0xcd, 0x88, 0x1a, 0x20, 0x66, 0x85, 0x84, 0x7e, 0x84, 0x9e, 
+0x0b, 0x24, 0x10, 0x1e, 0x4b, 0x98, 0xa3, 0xc8, 0x91, 0x16, 0xf7, 0x93, 
+0x21, 0x1d, 0xb3, 0xf8, 0x8c, 0x75, 0xeb, 0xfe, 0xd8, 0x32, 0x3c, 0xc2, 
+0x84, 0x03, 0x4d, 0x98, 0x34, 0x14, 0xcd, 0xb2, 0xf7, 0x4e, 0x86, 0xb8, 
+0x82, 0xff, 0xb8, 0x7f, 0x62, 0x68, 0xa1, 0x6d, 0x23, 0x8f, 0x70, 0x1a, 
+0xaa, 0x36, 0x24, 0xca, 0x95, 0xb1, 0x1c, 0x62, 0xcc, 0x03, 0x88, 0xc8, 
+0x3c, 0x86, 0x7d, 0x78, 0xb9, 0x0f, 0xeb, 0xb2, 0xea, 0x1b, 0x7b, 0x95, 
+0xb1, 0x11, 0x58, 0x94, 0xe6, 0xc9, 0x78, 0x98, 0x98, 0x40, 0x30, 0x17, 
+0x3e, 0x78, 0x8c, 0xf4, 0xb0, 0xe1, 0x07, 0xec, 0x17, 0x3d, 0x1c, 0x21, 
+0xc8, 0xb6, 0x03, 0x7e, 0x74, 0x8f, 0x84, 0x78, 0x7d, 0xae, 0xf3, 0xa6, 
+0x61, 0x86, 0xe4, 0x4c, 0x39, 0x37, 0x8d, 0xc0, 0x01, 0xe1, 0x5e, 0x90, 
+0x77, 0xfa, 0x23, 0xf9, 0x0c, 0x1b, 0x82, 0x70, 0xed, 0xa6, 0x3c, 0xa3, 
+0x97, 0xe0, 0x6c, 0x5a, 0xd7, 0x76, 0xe4, 0x61, 0x27, 0xd7, 0x0b, 0xba, 
+0xc0, 0x2d, 0x37, 0x8f, 0xef, 0xb2, 0xaa, 0x4e, 0xa1, 0xe9, 0x77, 0x4c, 
+0x23, 0xf9, 0x41, 0xb1, 0x7e, 0xad, 0x1d, 0xfc, 0xcd, 0x85, 0xc8, 0x72, 
+0xd2, 0x93, 0xf6, 0x6f, 0xf0, 0x0a, 0x6e, 0x4e, 0x23, 0xac, 0x63, 0x61, 
+0xb8, 0xc5, 0x89, 0x34, 0xe7, 0x1c, 0xf6, 0x04, 0xaa, 0x8f, 0x71, 0x5c, 
+0x56, 0x5f, 0x58, 0x34, 0xe5, 0xda, 0x04, 0xa2, 0x1c, 0xbc, 0x38, 0xe5, 
+0x1b, 0xe6, 0x2d, 0xe0, 0xdf, 0xff, 0x3f, 0x31, 0xc1, 0xf9, 0xe6, 0x26, 
+0x3f, 0x14, 0xa0, 0x3b, 0x64, 0xcb, 0x45, 0x7b, 0xd7, 0xbe, 0x8d, 0x2e, 
+0xfd, 0x55, 0xc4, 0xbb, 0x0e, 0x7b, 0x7b, 0xba, 0x0f, 0x0c, 0x7e, 0x61, 
+0x7c, 0xdc, 0x6e, 0xea, 0xa1, 0xce, 0x75, 0xbf, 0x74, 0xf0, 0x2d, 0x52, 
+0x86, 0xbd, 0xad, 0x6b, 0x8f, 0x33, 0x0a, 0x62, 0xd8, 0x91, 0x08, 0xcd, 
+0x6f, 0xeb, 0x21, 0x6a, 0xf8, 0x21, 0x53, 0x8c, 0x64, 0xdc, 0x57, 0xe4, 
+0x3a, 0x35, 0x36, 0x5f, 0x94, 0x76, 0x8d, 0xc5, 0x3e, 0xd3, 0x9b, 0xc0, 
+0xda, 0x5e, 0xdc, 0x2a, 0xad, 0x50, 0xf0, 0x7e, 0x0b, 0x5f, 0x4a, 0x93, 
+0x61, 0xab, 0x5b, 0xec, 0xc1, 0xe7, 0x47, 0x73, 0xab, 0x7e, 0x66, 0x45, 
+0xaf, 0x67, 0x71, 0x30, 0x81, 0xc8, 0xf1, 0x71, 0x35, 0xa3, 0x81, 0xf5, 
+0xbe, 0x3d, 0xe1, 0x85, 0x14, 0x34, 0xb4, 0x3f, 0x53, 0x93, 0x29, 0x3c, 
+0x5b, 0x13, 0xbd, 0x96, 0xfe, 0xd4, 0x89, 0x6a, 0x3f, 0x0e, 0x67, 0x0f, 
+0x5f, 0x7f, 0xc1, 0x9a, 0x99, 0x4e, 0xe4, 0x38, 0x72, 0xfc, 0x87, 0x76, 
+0x9c, 0x65, 0x1a, 0x99, 0x86, 0x90, 0x73, 0x5c, 0xd4, 0x22, 0x5a, 0x9b, 
+0xfb, 0x43, 0x24, 0x3d, 0x22, 0xfc, 0x16, 0xd0, 0x99, 0xd9, 0x8a, 0x3d, 
+0x07, 0x92, 0xa7, 0x58, 0x21, 0x61, 0x2a, 0xa8, 0x6e, 0x1e, 0x96, 0x8b, 
+0x9e, 0x86, 0x97, 0x87, 0xb2, 0x61, 0x29, 0x5f, 0x01, 0x3a, 0xe6, 0x8e, 
+0x9a, 0xfb, 0xba, 0x34, 0xe9, 0xdb, 0x05, 0xa4, 0xb6, 0x62, 0x48, 0x87, 
+0xcb, 0x3d, 0x52, 0x06, 0xa6, 0xc1, 0x22, 0xfb, 0x46, 0xa1, 0x71, 0x1d, 
+0xd8, 0x20, 0x1e, 0xf0, 0x90, 0x8c, 0x48, 0x12, 0x43, 0xa7, 0x44, 0x9a, 
+0xf6, 0xc2, 0xf2, 0x83, 0xce, 0xba, 0xaf, 0x55, 0xa9, 0x53, 0x7a, 0xb1, 
+0x27, 0x90, 0x18, 0x0f, 0x75, 0xb9, 0x4b, 0x5c, 0x43, 0xcc, 0xda, 0xfc, 
+0x74, 0x6e, 0x18, 0x7b, 0xf5, 0x14, 0x7a, 0xed, 0xbd, 0x25, 0x5b, 0x6f, 
+0xc8, 0xa0, 0x9b, 0xe6, 0xf5, 0x58, 0x89, 0x8d, 0xaf, 0x0e, 0x07, 0x8d, 
+0x8f, 0x17, 0xd7, 0xb6, 0xd5, 0x91, 0xb4, 0xa4, 0x0a, 0x14, 0x0a, 0x4e, 
+0xd4, 0x15, 0x1d, 0x22, 0x08, 0xe2, 0xcb, 0xcb, 0xd1, 0xb3, 0xe6, 0xd0, 
+0x63, 0x34, 0x2a, 0x8b, 0xeb, 0x78, 0x3f, 0x3a, 0xca, 0x1d, 0x25, 0xfb, 
+0xc3, 0xfa, 0x2a, 0x35, 0x28, 0xbb, 0x2a, 0xa1, 0xc7, 0x8f, 0x1d, 0x9b, 
+0x38, 0xd2, 0xbe, 0x01, 0xf4, 0xff, 0xa1, 0xe3, 0x76, 0xbd, 0xcf, 0x12, 
+0xa9, 0xe9, 0x46, 0xb8, 0xe6, 0xbe, 0x6d, 0x8d, 0x13, 0x6c, 0x39, 0xa8, 
+0x9f, 0x4c, 0x7a, 0x32, 0x0a, 0xfd, 0x1d, 0x0a, 0x79, 0x24, 0xc9, 0x75, 
+0xfe, 0x27, 0x3e, 0x03, 0x8c, 0xd7, 0x42, 0xff, 0x7e, 0x35, 0x2a, 0x11, 
+0x7a, 0xe1, 0xe0, 0x38, 0xad, 0xa7, 0xd9, 0xc0, 0x22, 0x3c, 0x94, 0x93, 
+0xf1, 0xde, 0x3a, 0x2d, 0x18, 0x08, 0x73, 0x9e, 0x17, 0x84, 0x78, 0x81, 
+0x69, 0xb8, 0xcf, 0x1c, 0x91, 0x09, 0x75, 0xf3, 0xcf, 0xa0, 0x7f, 0xd8, 
+0x8c, 0xae, 0x4b, 0x7e, 0xa3, 0x74, 0xf4, 0x2f, 0x4a, 0x1b, 0x24, 0x60, 
+0x16, 0x86, 0x30, 0xa6, 0x51, 0x8f, 0x15, 0xa1, 0x7d, 0x37, 0x96, 0x48, 
+0x31, 0xe4, 0x4c, 0xea, 0x23, 0x4d, 0x18, 0x96, 0xdc, 0xe3, 0x6c, 0x60, 
+0xce, 0x3b, 0x56, 0xb6, 0x04, 0x96, 0x1a, 0x6b, 0x80, 0xc6, 0x38, 0x59, 
+0x1a, 0x9a, 0x90, 0x04, 0xdd, 0x5e, 0x89, 0xd9, 0xe9, 0xca, 0x7c, 0x4d, 
+0xef, 0x0e, 0x44, 0xb7, 0x05, 0xdc, 0x6a, 0xb7, 0x64, 0x42, 0x08, 0x63, 
+0x1d, 0xbe, 0x5f, 0xe4, 0x2f, 0x38, 0xc4, 0x65, 0xcb, 0x94, 0xfc, 0x6d, 
+0x73, 0x24, 0xb5, 0xe6, 0x8e, 0x1d, 0xc7, 0x9b, 0x2b, 0x5c, 0x97, 0xea, 
+0xbe, 0x1f, 0x1c, 0x1b, 0xde, 0x58, 0xbe, 0x84, 0x84, 0x5c, 0x06, 0x23, 
+0x4d, 0xb9, 0x0e, 0xa3, 0x70, 0xd3, 0x50, 0x56, 0x36, 0x45, 0x4d, 0xd3, 
+0x5f, 0x95, 0x15, 0xc8, 0x6a, 0x3e, 0x6f, 0x63, 0x84, 0xc2, 0xde, 0x51, 
+0x77, 0xf0, 0x5b, 0xa3, 0x69, 0x27, 0x08, 0xb7, 0xc6, 0x38, 0xe5, 0x14, 
+0x93, 0x7b, 0x7f, 0x3c, 0x4b, 0x9d, 0xf3, 0xcf, 0x7b, 0xe4, 0x4a, 0xa4, 
+0x37, 0x86, 0xd2, 0x1d, 0x3f, 0x57, 0x8b, 0x79, 0xdc, 0x85, 0xbe, 0xa1, 
+0x55, 0x2d, 0x85, 0x46, 0x78, 0x63, 0x0f, 0x95, 0xd1, 0x84, 0xdf, 0x50, 
+0x07, 0xab, 0x5b, 0x1f, 0xe9, 0x5b, 0x9c, 0x8b, 0xe1, 0x08, 0x07, 0xd8, 
+0xda, 0x90, 0xbf, 0x33, 0x91, 0x68, 0x03, 0x75, 0x77, 0xdb, 0xa2, 0xc4, 
+0xe6, 0x48, 0xbc, 0x69, 0x96, 0x50, 0x43, 0x0b, 0xd9, 0x7d, 0x9b, 0xca, 
+0xfb, 0xd2, 0x81, 0x7f, 0x8d, 0xf8, 0x5b, 0x70, 0x0f, 0x5a, 0x9d, 0xf4, 
+0x81, 0x80, 0x48, 0x00, 0xc5, 0x28, 0xa0, 0x8c, 0xd2, 0xb9, 0x2e, 0x00, 
+0xce, 0x3b, 0x5d, 0x03, 0xec, 0x8f, 0x1e, 0xa4, 0x4a, 0x75, 0x7c, 0x71, 
+0xa4, 0xc9, 0xd8, 0x4d, 0xf7, 0x59, 0x52, 0x0c, 0x7d, 0x92, 0x09, 0xd0, 
+0xce, 0xd7, 0xa2, 0xe3, 0xa0, 0x4e, 0x2a, 0xeb, 0x81, 0xa4, 0x10, 0xbe, 
+0xe0, 0x62, 0xf8, 0x16, 0x42, 0xdc, 0xfc, 0x29, 0x2a, 0xe0, 0x41, 0xc2, 
+0xe5, 0xcc, 0x80, 0xc7, 0x51, 0x97, 0x0d, 0x2a, 0x50, 0xff, 0xc9, 0x11, 
+0x54, 0x7e, 0x8b, 0x0b, 0x01, 0x1e, 0x43, 0x1d, 0xe1, 0x31, 0x76, 0x7a, 
+0xd0, 0x70, 0x72, 0xd5, 0x27, 0x21, 0x5d, 0x72, 0x4d, 0x50, 0xa7, 0xd6, 
+0xb6, 0x81, 0x6c, 0x20, 0x47, 0x15, 0x0e, 0x78, 0x27, 0xc5, 0xdb, 0xb1, 
+0x0e, 0x2b, 0x0e, 0x70, 0x94, 0x21, 0xba, 0x57, 0x12, 0x6b, 0xd6, 0xdd, 
+0x1b, 0xa0, 0x63, 0x01, 0xbc, 0xf6, 0xf1, 0xe2, 0x4d, 0x64, 0xaa, 0x30, 
+0x02, 0x15, 0x20, 0x89, 0xaf, 0xf3, 0xd2, 0xb9, 0x00, 0x3b, 0x1b, 0xb4, 
+0x89, 0x81, 0xdb, 0x70, 0x5d, 0xb2, 0x74, 0xcf, 0x48, 0x6a, 0xd6, 0x53, 
+0xd9, 0x13, 0x95, 0x2c, 0xfc, 0x53, 0x37, 0xfb, 0xbe, 0x4b, 0x32, 0xcf, 
+0x07, 0xe7, 0x49, 0x8a, 0x1b, 0x9d, 0x9c, 0xfd, 0x29, 0x06, 0xe7, 0x6f, 
+0x79, 0x91, 0xcf, 0x14, 0xb6, 0x19, 0x45, 0x24, 0xce, 0xab, 0x7f, 0xc5, 
+0x7e, 0x3e, 0x91, 0x34, 0x9a, 0xd7, 0x35, 0x26, 0x39, 0xf2, 0x4a, 0x72, 
+0x3f, 0xc2, 0x7f, 0x60, 0xff, 0xaa, 0xa3, 0x77, 0x69, 0x8b, 0xe2, 0x19, 
+0xe7, 0x0b, 0xfc, 0x4b, 0xcb, 0x98, 0x43, 0x23, 0xf9, 0xf6, 0xed, 0x59, 
+0xd9, 0x22, 0x12, 0x0e, 0x45, 0x71, 0x62, 0x06, 0x12, 0xa5, 0x2a, 0xa0, 
+0xd9, 0x6e, 0x49, 0xdd, 0xa0, 0x36, 0x7e, 0x6c, 0x77, 0xe4, 0x23, 0xf8, 
+0x60, 0x15, 0x52, 0xcb, 0x09, 0xfd, 0x1f, 0xc8, 0x42, 0x81, 0x76, 0x48, 
+0x7b, 0x93, 0xa5, 0x9e, 0x6f, 0x08, 0x85, 0xe2, 0x7b, 0x69, 0xcf, 0xbe, 
+0xbf, 0x24, 0xfc, 0x63, 0xb1, 0x92, 0xd7, 0x77, 0x80, 0x54, 0xa2, 0xb1, 
+0xdb, 0xae, 0x68, 0x2a, 0xfc, 0x64, 0xd0, 0x7b, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x35, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xba, 0x01, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xa7, 0x00, 0xff, 0xff, 0x2b, 0x01, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x42, 0x00, 
+0xff, 0xff, 0xae, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xe2, 0x03, 0xff, 0xff, 0x81, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0xd7, 0x03, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x99, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x57, 0x02, 0xff, 0xff, 0xb7, 0x03, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0xed, 0x01, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x55, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x38, 0x01, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x65, 0x00, 0xff, 0xff, 0x3a, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x68, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0xe7, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0x00, 
+0xff, 0xff, 0x36, 0x01, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x3f, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xbc, 0x01, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0xb1, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xc3, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xa8, 0x01, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0xe6, 0x02, 0xff, 0xff, 0x1b, 0x03, 0xff, 0xff, 0xa4, 0x00, 
+0xff, 0xff, 0x71, 0x00, 0xff, 0xff, 0x6a, 0x00, 0xff, 0xff, 0x6e, 0x01, 
+0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x33, 0x03, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xed, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xfe, 0x01, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0xf9, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x07, 0x02, 0xff, 0xff, 0x98, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x78, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xb1, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xe1, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xde, 0x01, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x7c, 0x01, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xb1, 0x01, 
+0xff, 0xff, 0x90, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0xc7, 0x01, 0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0xb2, 0x01, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x73, 0x01, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x7f, 0x00, 0xff, 0xff, 0x86, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x88, 0x01, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0xb1, 0x00, 0xff, 0xff, 0x51, 0x02, 0xff, 0xff, 0x57, 0x02, 
+0xff, 0xff, 0x8e, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0xe5, 0x00, 
+0xff, 0xff, 0x61, 0x02, 0xff, 0xff, 0x3e, 0x01, 0xff, 0xff, 0x7e, 0x02, 
+0xff, 0xff, 0xda, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x95, 0x02, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xa1, 0x00, 
+0xff, 0xff, 0x0f, 0x01, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xa2, 0x02, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0xb2, 0x02, 0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0xc7, 0x02, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0xd0, 0x02, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x56, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x3e, 0x02, 
+0xff, 0xff, 0x04, 0x03, 0xff, 0xff, 0x0a, 0x03, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x19, 0x02, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x19, 0x03, 
+0xff, 0xff, 0x1b, 0x03, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xe6, 0x02, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x45, 0x01, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x11, 0x03, 
+0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0xee, 0x02, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xe6, 0x02, 0xff, 0xff, 0xc3, 0x00, 0xff, 0xff, 0x53, 0x01, 
+0xff, 0xff, 0xf1, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x74, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0x96, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xba, 0x02, 
+0xff, 0xff, 0xb7, 0x02, 0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x9b, 0x01, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0xaf, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xc9, 0x00, 
+0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x98, 0x02, 0xff, 0xff, 0xb3, 0x00, 
+0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0xc1, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x83, 0x02, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0xf0, 0x00, 0xff, 0xff, 0x22, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0xb2, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0x6b, 0x02, 0xff, 0xff, 0xe0, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x01, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0xcf, 0x00, 0xff, 0xff, 0xf8, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x99, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0xc9, 0x00, 0xff, 0xff, 0xba, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x6d, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x3a, 0x02, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x9d, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x12, 0x02, 0xff, 0xff, 0x1f, 0x01, 
+0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x40, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x7e, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0xc5, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0xf5, 0x01, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0xf9, 0x01, 0xff, 0xff, 0xb5, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x73, 0x00, 
+0xff, 0xff, 0x67, 0x01, 0xff, 0xff, 0x10, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xa8, 0x00, 0xff, 0xff, 0xeb, 0x01, 
+0xff, 0xff, 0xbf, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x22, 0x00, 
+0xff, 0xff, 0xe6, 0x01, 0xff, 0xff, 0xb6, 0x01, 0xff, 0xff, 0x2f, 0x01, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xcf, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x00, 
+0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x55, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x69, 0x00, 
+0xff, 0xff, 0x51, 0x01, 0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x4d, 0x01, 0xff, 0xff, 0x61, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xd5, 0x00, 0xff, 0xff, 0xc3, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x72, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x15, 0x01, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xe6, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0xea, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x19, 0x01, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x95, 0x00, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x9d, 0x02, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0xa2, 0x00, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x15, 0x02, 
+0xff, 0xff, 0xa0, 0x01, 0xff, 0xff, 0x25, 0x01, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0x4d, 0x03, 0xff, 0xff, 0x71, 0x01, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x53, 0x03, 
+0xff, 0xff, 0xea, 0x01, 0xff, 0xff, 0x08, 0x03, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x45, 0x01, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x5f, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x63, 0x03, 0xff, 0xff, 0xb8, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x27, 0x01, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x49, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x20, 0x01, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x40, 0x03, 0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x87, 0x03, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x92, 0x03, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xae, 0x00, 
+0xff, 0xff, 0xae, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xa5, 0x03, 
+0xff, 0xff, 0x94, 0x00, 0xff, 0xff, 0x2f, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xef, 0x00, 0xff, 0xff, 0xde, 0x00, 
+0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x87, 0x01, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x7e, 0x00, 0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0xd3, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0xb1, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x55, 0x02, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0xe3, 0x03, 0xff, 0xff, 0xaa, 0x00, 0xff, 0xff, 0x51, 0x01, 
+0xff, 0xff, 0xeb, 0x03, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x03, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x98, 0x01, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfc, 0x03, 
+0xff, 0xff, 0x4d, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xfb, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x65, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x37, 0x04, 
+0xff, 0xff, 0x71, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0x7b, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x01, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x39, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x46, 0x04, 0xff, 0xff, 0x35, 0x00, 
+0xff, 0xff, 0x41, 0x04, 0xff, 0xff, 0x76, 0x01, 0xff, 0xff, 0x71, 0x00, 
+0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x10, 0x04, 
+0xff, 0xff, 0xa0, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x80, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x29, 0x01, 0xff, 0xff, 0xe3, 0x00, 
+0xff, 0xff, 0x60, 0x00, 0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0xc1, 0x03, 
+0xff, 0xff, 0xc3, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xb3, 0x00, 
+0xff, 0xff, 0xb3, 0x03, 0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0xba, 0x01, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x31, 0x01, 0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x3f, 0x00, 
+0xff, 0xff, 0x7e, 0x02, 0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x8b, 0x03, 0xff, 0xff, 0x3b, 0x01, 
+0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x86, 0x03, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x80, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x7f, 0x03, 0xff, 0xff, 0x19, 0x01, 
+0xff, 0xff, 0xed, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x00, 0x03, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x89, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x6b, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x46, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x3f, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x4b, 0x03, 
+0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x6a, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x87, 0x02, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x00, 
+0xff, 0xff, 0x6b, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xc3, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x69, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x84, 0x01, 0xff, 0xff, 0x68, 0x00, 
+0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0xe0, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x6b, 0x00, 0xff, 0xff, 0x83, 0x00, 
+0xff, 0xff, 0xbe, 0x01, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x72, 0x00, 
+0xff, 0xff, 0x07, 0x01, 0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x9e, 0x01, 0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0x75, 0x01, 
+0xff, 0xff, 0xbe, 0x00, 0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0xc6, 0x00, 
+0xff, 0xff, 0xb5, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0xfe, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x03, 0x01, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xb9, 0x00, 0xff, 0xff, 0xac, 0x00, 
+0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x68, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x25, 0x02, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x54, 0x02, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xa1, 0x00, 
+0xff, 0xff, 0xcf, 0x00, 0xff, 0xff, 0x19, 0x02, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0xb2, 0x00, 
+0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0xaa, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0xb4, 0x02, 
+0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xca, 0x02, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0xbd, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x6c, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x75, 0x01, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0xec, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x67, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0xb3, 0x01, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0xa1, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0xcf, 0x02, 0xff, 0xff, 0x6a, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xcb, 0x02, 0xff, 0xff, 0xc9, 0x00, 
+0xff, 0xff, 0xa4, 0x00, 0xff, 0xff, 0xfa, 0x00, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x99, 0x02, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x71, 0x02, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x55, 0x02, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x7d, 0x00, 0xff, 0xff, 0x4b, 0x02, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x67, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x22, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x86, 0x01, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x10, 0x02, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0xce, 0x01, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x11, 0x01, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0xe6, 0x00, 0xff, 0xff, 0x64, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x92, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x00, 
+0xff, 0xff, 0xc3, 0x00, 0xff, 0xff, 0x90, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0xc1, 0x01, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x27, 0x02, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0x37, 0x01, 0xff, 0xff, 0x76, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x30, 0x00, 
+0xff, 0xff, 0xc3, 0x00, 0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0xba, 0x00, 0xff, 0xff, 0x3f, 0x00, 
+0xff, 0xff, 0x3c, 0x03, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x74, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x5b, 0x03, 
+0xff, 0xff, 0x8b, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x74, 0x01, 0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x7a, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xa1, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x0c, 0x01, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x9d, 0x03, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x2e, 0x01, 0xff, 0xff, 0x63, 0x03, 0xff, 0xff, 0xa1, 0x00, 
+0xff, 0xff, 0x6c, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x54, 0x00, 
+0xff, 0xff, 0x07, 0x04, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xdc, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x84, 0x00, 0xff, 0xff, 0x49, 0x04, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x3c, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0xea, 0x03, 0xff, 0xff, 0xd9, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x48, 0x00, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x9c, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0xca, 0x01, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x96, 0x00, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0xf3, 0x01, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0xb4, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x74, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x64, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x1a, 0x02, 0xff, 0xff, 0x58, 0x00, 
+0xff, 0xff, 0xb1, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x86, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0xcc, 0x00, 0xff, 0xff, 0xbe, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xd0, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x65, 0x00, 0xff, 0xff, 0x9c, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x78, 0x01, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x11, 0x01, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x2e, 0x01, 
+0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x9f, 0x00, 0xff, 0xff, 0xbc, 0x03, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0xfd, 0x03, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x41, 0x00, 
+0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0xf0, 0x00, 0xff, 0xff, 0x67, 0x00, 
+0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x2b, 0x04, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x69, 0x01, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xca, 0x03, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x58, 0x00, 
+0xff, 0xff, 0x33, 0x01, 0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0xde, 0x00, 0xff, 0xff, 0x7d, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x01, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x42, 0x03, 0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0xb1, 0x00, 0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x9e, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xe7, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0xc7, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0xda, 0x02, 0xff, 0xff, 0x6a, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0xaa, 0x00, 
+0xff, 0xff, 0x74, 0x01, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x90, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xcc, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xaf, 0x00, 0xff, 0xff, 0xd1, 0x01, 
+0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x8b, 0x00, 
+0xff, 0xff, 0xec, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x6b, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0a, 0x01, 0xff, 0xff, 0x3c, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0xac, 0x02, 0xff, 0xff, 0xc0, 0x01, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xd1, 0x02, 
+0xff, 0xff, 0x60, 0x00, 0xff, 0xff, 0xa8, 0x00, 0xff, 0xff, 0x1a, 0x03, 
+0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x0b, 0x03, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x57, 0x02, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x9e, 0x00, 0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x67, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x1f, 0x02, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x00, 0x02, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0xfa, 0x01, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0xce, 0x01, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xa4, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x67, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x01, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0xa2, 0x00, 0xff, 0xff, 0x50, 0x03, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xea, 0x02, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x9d, 0x02, 0xff, 0xff, 0x83, 0x01, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0xb7, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x96, 0x03, 
+0xff, 0xff, 0xea, 0x02, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0xe2, 0x01, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x7e, 0x00, 0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0x82, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x7d, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x92, 0x03, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x6d, 0x00, 
+0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xd7, 0x00, 
+0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x09, 0x04, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x67, 0x00, 
+0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x41, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x9a, 0x00, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0xba, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x60, 0x03, 0xff, 0xff, 0x48, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xb4, 0x03, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x52, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x0b, 0x01, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0xa0, 0x03, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x8a, 0x01, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x46, 0x01, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x1d, 0x01, 
+0xff, 0xff, 0xed, 0x02, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x70, 0x03, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x7d, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x38, 0x01, 
+0xff, 0xff, 0x44, 0x02, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x45, 0x03, 0xff, 0xff, 0xfa, 0x01, 0xff, 0xff, 0x71, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x8a, 0x01, 
+0xff, 0xff, 0x3e, 0x03, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0xb2, 0x01, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xf9, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x26, 0x01, 0xff, 0xff, 0x70, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x46, 0x01, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x8d, 0x00, 0xff, 0xff, 0xb7, 0x00, 0xff, 0xff, 0x08, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x3b, 0x02, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xf3, 0x00, 
+0xff, 0xff, 0x90, 0x00, 0xff, 0xff, 0x47, 0x01, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xf9, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0x13, 0x01, 0xff, 0xff, 0x8c, 0x00, 
+0xff, 0xff, 0x64, 0x01, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x48, 0x01, 0xff, 0xff, 0xb3, 0x00, 
+0xff, 0xff, 0x82, 0x01, 0xff, 0xff, 0xda, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0xe2, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x2a, 0x01, 
+0xff, 0xff, 0xfa, 0x01, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x4d, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0xed, 0x01, 0xff, 0xff, 0x93, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x16, 0x02, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xb1, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x3d, 0x01, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x89, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0xea, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x22, 0x01, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x01, 0xff, 0xff, 0x73, 0x00, 
+0xff, 0xff, 0x3f, 0x01, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x86, 0x02, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x86, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0xcd, 0x02, 0xff, 0xff, 0xdc, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x9e, 0x01, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x71, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xdb, 0x02, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x55, 0x00, 
+0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x79, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x23, 0x03, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x13, 0x03, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x2f, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0xed, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x83, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x78, 0x01, 0xff, 0xff, 0xce, 0x02, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xae, 0x00, 0xff, 0xff, 0xc2, 0x02, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0xb6, 0x02, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x46, 0x01, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x65, 0x00, 0xff, 0xff, 0x58, 0x00, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xa5, 0x02, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0xc2, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x6a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xb4, 0x00, 0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xcb, 0x01, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x4f, 0x01, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x68, 0x02, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x55, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x5f, 0x02, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xb4, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x8f, 0x00, 
+0xff, 0xff, 0x70, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xf6, 0x01, 
+0xff, 0xff, 0x2d, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x74, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0xa5, 0x01, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xea, 0x01, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0xcb, 0x01, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x3a, 0x01, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x76, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0xd8, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x07, 0x01, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x6e, 0x01, 
+0xff, 0xff, 0xa6, 0x01, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x7c, 0x00, 
+0xff, 0xff, 0xd4, 0x00, 0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xb7, 0x00, 0xff, 0xff, 0xed, 0x00, 0xff, 0xff, 0xc4, 0x02, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0xd3, 0x00, 0xff, 0xff, 0x0b, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x8c, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x29, 0x01, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xd9, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x38, 0x01, 
+0xff, 0xff, 0x78, 0x01, 0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0xd6, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0xb3, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x5a, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x71, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x9e, 0x01, 0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0xf5, 0x00, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x4c, 0x03, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0x09, 0x01, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0xbf, 0x00, 
+0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0xc9, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x70, 0x00, 0xff, 0xff, 0x58, 0x01, 
+0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xd4, 0x00, 0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x39, 0x01, 0xff, 0xff, 0x95, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0xce, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x6a, 0x01, 0xff, 0xff, 0x73, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x2d, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x35, 0x01, 
+0xff, 0xff, 0x76, 0x01, 0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0x67, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x09, 0x01, 0xff, 0xff, 0x5f, 0x00, 
+0xff, 0xff, 0xe0, 0x01, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0xba, 0x00, 
+0xff, 0xff, 0x1c, 0x01, 0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0xb8, 0x03, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0xc4, 0x03, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x23, 0x01, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x02, 
+0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x35, 0x01, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0xfa, 0x00, 0xff, 0xff, 0xae, 0x02, 0xff, 0xff, 0x13, 0x02, 
+0xff, 0xff, 0xdd, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x50, 0x01, 0xff, 0xff, 0x18, 0x01, 
+0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0xf1, 0x03, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x51, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x08, 0x01, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x5e, 0x01, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1b, 0x04, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x2e, 0x04, 0xff, 0xff, 0x33, 0x04, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x39, 0x04, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x35, 0x01, 0xff, 0xff, 0xdc, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0x81, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x77, 0x01, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x3b, 0x01, 0xff, 0xff, 0x95, 0x00, 
+0xff, 0xff, 0x67, 0x01, 0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x15, 0x04, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x5c, 0x01, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x5e, 0x03, 0xff, 0xff, 0x56, 0x01, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xac, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0xa7, 0x02, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0xcf, 0x03, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x6b, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x9d, 0x03, 0xff, 0xff, 0x9b, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x19, 0x02, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x27, 0x01, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xf9, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x60, 0x00, 
+0xff, 0xff, 0x0a, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x4e, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x7b, 0x03, 0xff, 0xff, 0x3c, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x71, 0x03, 
+0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x0e, 0x01, 0xff, 0xff, 0x0d, 0x01, 
+0xff, 0xff, 0xcf, 0x00, 0xff, 0xff, 0x04, 0x01, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0x06, 0x01, 0xff, 0xff, 0x98, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0xfa, 0x00, 0xff, 0xff, 0x55, 0x03, 
+0xff, 0xff, 0xf2, 0x01, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x3f, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x68, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x9b, 0x00, 0xff, 0xff, 0xf7, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x73, 0x01, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x93, 0x02, 0xff, 0xff, 0x20, 0x01, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0xf2, 0x00, 0xff, 0xff, 0x1e, 0x03, 0xff, 0xff, 0x53, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x51, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xaf, 0x00, 0xff, 0xff, 0xb6, 0x01, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xb4, 0x00, 0xff, 0xff, 0xbd, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0xd6, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x9e, 0x01, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x86, 0x01, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xc7, 0x00, 
+0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x9e, 0x00, 
+0xff, 0xff, 0x97, 0x01, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xfc, 0x01, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0xc4, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xdc, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x8b, 0x00, 0xff, 0xff, 0x3c, 0x00, 
+0xff, 0xff, 0x3c, 0x02, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x46, 0x02, 
+0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x4f, 0x00, 
+0xff, 0xff, 0xb7, 0x00, 0xff, 0xff, 0xdb, 0x01, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x43, 0x02, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0x91, 0x00, 0xff, 0xff, 0x46, 0x02, 0xff, 0xff, 0x51, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xa9, 0x02, 0xff, 0xff, 0x6f, 0x00, 
+0xff, 0xff, 0x74, 0x00, 0xff, 0xff, 0xbd, 0x02, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0xc5, 0x00, 0xff, 0xff, 0x8b, 0x00, 0xff, 0xff, 0xd2, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x65, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x77, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x02, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x8e, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x99, 0x02, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x5e, 0x00, 
+0xff, 0xff, 0xa0, 0x00, 0xff, 0xff, 0x5e, 0x02, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x75, 0x02, 0xff, 0xff, 0x63, 0x00, 
+0xff, 0xff, 0x58, 0x02, 0xff, 0xff, 0x40, 0x01, 0xff, 0xff, 0xf5, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x10, 0x01, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x20, 0x01, 
+0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0xf7, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0xde, 0x01, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0xa7, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xe5, 0x02, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0xaa, 0x01, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x00, 
+0xff, 0xff, 0x91, 0x00, 0xff, 0xff, 0xc1, 0x00, 0xff, 0xff, 0xe1, 0x00, 
+0xff, 0xff, 0xd5, 0x00, 0xff, 0xff, 0x88, 0x00, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0xa3, 0x00, 0xff, 0xff, 0xce, 0x01, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x3d, 0x01, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x0c, 0x01, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x8b, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xfb, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x3f, 0x00, 
+0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x79, 0x00, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x84, 0x03, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x65, 0x03, 0xff, 0xff, 0x63, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0xa8, 0x03, 
+0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0xd6, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x9d, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x31, 0x04, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x0e, 0x04, 
+0xff, 0xff, 0x24, 0x04, 0xff, 0xff, 0xc6, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x84, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x48, 0x04, 
+0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x82, 0x01, 0xff, 0xff, 0xea, 0x00, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x83, 0x00, 
+0xff, 0xff, 0x82, 0x00, 0xff, 0xff, 0x0d, 0x03, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xa9, 0x00, 0xff, 0xff, 0x4a, 0x01, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x6a, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x2d, 0x04, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0xff, 0x03, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x1e, 0x02, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x03, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0xdc, 0x03, 0xff, 0xff, 0xdb, 0x03, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x84, 0x01, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x5c, 0x01, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x83, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x3a, 0x01, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x42, 0x00, 
+0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xaf, 0x03, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x7d, 0x00, 
+0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x9f, 0x03, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x31, 0x02, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x86, 0x00, 0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x01, 
+0xff, 0xff, 0x33, 0x03, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0x65, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xf2, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x3a, 0x00, 
+0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0xfd, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xed, 0x00, 0xff, 0xff, 0x67, 0x03, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x9d, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x94, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x5d, 0x01, 0xff, 0xff, 0x3f, 0x00, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x95, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xca, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xeb, 0x01, 0xff, 0xff, 0xc3, 0x00, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xb3, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x42, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x51, 0x03, 0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x45, 0x02, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x44, 0x02, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x44, 0x03, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0xb3, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x52, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0xfe, 0x00, 0xff, 0xff, 0xe5, 0x01, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x37, 0x03, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xec, 0x01, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xc7, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x8d, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x63, 0x01, 0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xde, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x4d, 0x01, 
+0xff, 0xff, 0xbe, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x30, 0x03, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x95, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x27, 0x03, 0xff, 0xff, 0x82, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x82, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0xdf, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x50, 0x01, 
+0xff, 0xff, 0xd0, 0x01, 0xff, 0xff, 0x8c, 0x02, 0xff, 0xff, 0x74, 0x00, 
+0xff, 0xff, 0x16, 0x01, 0xff, 0xff, 0xad, 0x01, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x34, 0x01, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0xd8, 0x01, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0xae, 0x00, 
+0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xd0, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0xe9, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x26, 0x01, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xef, 0x01, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x95, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x5f, 0x00, 
+0xff, 0xff, 0xa0, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x01, 
+0xff, 0xff, 0xb8, 0x01, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xfc, 0x01, 
+0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x5c, 0x01, 0xff, 0xff, 0x96, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x39, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0x0c, 0x02, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x4c, 0x00, 
+0xff, 0xff, 0x11, 0x02, 0xff, 0xff, 0xdd, 0x00, 0xff, 0xff, 0x4c, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x73, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x98, 0x00, 0xff, 0xff, 0x9b, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x2a, 0x02, 0xff, 0xff, 0x6f, 0x00, 
+0xff, 0xff, 0xba, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0xc8, 0x01, 
+0xff, 0xff, 0x90, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x13, 0x01, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x53, 0x00, 
+0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x50, 0x02, 
+0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0xb4, 0x00, 0xff, 0xff, 0xfa, 0x00, 0xff, 0xff, 0x17, 0x02, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x65, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0xf4, 0x00, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xa5, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x35, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0xdd, 0x00, 
+0xff, 0xff, 0x34, 0x01, 0xff, 0xff, 0x6a, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x56, 0x00, 
+0xff, 0xff, 0x91, 0x02, 0xff, 0xff, 0x3b, 0x01, 0xff, 0xff, 0x51, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0x9c, 0x02, 
+0xff, 0xff, 0xa1, 0x02, 0xff, 0xff, 0x9d, 0x00, 0xff, 0xff, 0xb5, 0x00, 
+0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0xa3, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xa5, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xa2, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x4d, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x89, 0x00, 0xff, 0xff, 0x05, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x00, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x08, 0x02, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x70, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x64, 0x00, 
+0xff, 0xff, 0xda, 0x02, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x53, 0x01, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xec, 0x02, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0xce, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0xa6, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xc4, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xcd, 0x00, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x42, 0x00, 
+0xff, 0xff, 0x1c, 0x03, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x20, 0x03, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0xf6, 0x00, 
+0xff, 0xff, 0x6a, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x58, 0x00, 
+0xff, 0xff, 0xba, 0x01, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x14, 0x03, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x0f, 0x03, 
+0xff, 0xff, 0xaa, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x1b, 0x01, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0xf9, 0x02, 0xff, 0xff, 0x61, 0x00, 
+0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x7e, 0x02, 0xff, 0xff, 0xf7, 0x02, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x65, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x71, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xce, 0x00, 0xff, 0xff, 0xc3, 0x02, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xaf, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xbb, 0x00, 
+0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0xa9, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xa9, 0x01, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x1c, 0x01, 0xff, 0xff, 0x95, 0x00, 0xff, 0xff, 0xa0, 0x02, 
+0xff, 0xff, 0xcb, 0x01, 0xff, 0xff, 0x50, 0x02, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xd1, 0x01, 
+0xff, 0xff, 0x75, 0x01, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x45, 0x00, 
+0xff, 0xff, 0x8d, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x77, 0x02, 0xff, 0xff, 0xf5, 0x00, 0xff, 0xff, 0x54, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x51, 0x02, 0xff, 0xff, 0xbe, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0xb5, 0x01, 
+0xff, 0xff, 0x64, 0x02, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x09, 0x01, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x98, 0x00, 0xff, 0xff, 0x5e, 0x02, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x4d, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x52, 0x02, 0xff, 0xff, 0x22, 0x00, 
+0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0xa2, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0xa5, 0x01, 0xff, 0xff, 0xaa, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0xda, 0x01, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0xdc, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0xeb, 0x00, 
+0xff, 0xff, 0x54, 0x00, 0xff, 0xff, 0x89, 0x00, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0a, 0x03, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x3f, 0x02, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xea, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x03, 0x01, 0xff, 0xff, 0x0b, 0x01, 0xff, 0xff, 0x6f, 0x01, 
+0xff, 0xff, 0x42, 0x01, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x58, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x60, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x85, 0x00, 
+0xff, 0xff, 0x67, 0x01, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x38, 0x01, 
+0xff, 0xff, 0x29, 0x02, 0xff, 0xff, 0xcb, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x24, 0x02, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x19, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x08, 0x02, 
+0xff, 0xff, 0xbe, 0x00, 0xff, 0xff, 0xd6, 0x00, 0xff, 0xff, 0xee, 0x01, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x24, 0x01, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x5e, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xb6, 0x01, 
+0xff, 0xff, 0x70, 0x00, 0xff, 0xff, 0xbf, 0x00, 0xff, 0xff, 0x57, 0x01, 
+0xff, 0xff, 0x17, 0x02, 0xff, 0xff, 0xc9, 0x01, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x1b, 0x01, 0xff, 0xff, 0x19, 0x01, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x79, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x99, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x93, 0x00, 
+0xff, 0xff, 0xa9, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x52, 0x00, 
+0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0xe4, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x1e, 0x01, 0xff, 0xff, 0x08, 0x02, 
+0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0xc2, 0x00, 0xff, 0xff, 0x26, 0x03, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x00, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x00, 0xff, 0xff, 0xfd, 0x01, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x52, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x3e, 0x01, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x60, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xd7, 0x01, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x45, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x00, 
+0xff, 0xff, 0xde, 0x01, 0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0xf1, 0x00, 
+0xff, 0xff, 0x07, 0x01, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0xb1, 0x02, 0xff, 0xff, 0xd7, 0x01, 0xff, 0xff, 0x74, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x19, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x79, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x13, 0x01, 0xff, 0xff, 0xc5, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0xd9, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x4d, 0x00, 0xff, 0xff, 0x7c, 0x01, 
+0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x58, 0x01, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0xce, 0x01, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0x83, 0x01, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0xa7, 0x00, 0xff, 0xff, 0x4e, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x5e, 0x00, 
+0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x8d, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x5d, 0x01, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x6b, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0xe4, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x45, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xf6, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x6e, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x28, 0x02, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xd1, 0x00, 
+0xff, 0xff, 0xc2, 0x00, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0xc1, 0x00, 0xff, 0xff, 0x72, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xb4, 0x00, 0xff, 0xff, 0x35, 0x01, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xec, 0x00, 0xff, 0xff, 0x63, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xed, 0x00, 
+0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x3a, 0x03, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0xee, 0x00, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0xdb, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0xef, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xa9, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x84, 0x02, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x77, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0xf8, 0x00, 
+0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x6b, 0x00, 
+0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0xc6, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x6d, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0xfd, 0x00, 0xff, 0xff, 0xca, 0x02, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x90, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0x65, 0x02, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x54, 0x03, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x96, 0x01, 0xff, 0xff, 0x8c, 0x00, 
+0xff, 0xff, 0x89, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0xd0, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xac, 0x00, 
+0xff, 0xff, 0x60, 0x03, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x08, 0x01, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x9e, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x55, 0x02, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xec, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x8f, 0x01, 
+0xff, 0xff, 0x0e, 0x01, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x9a, 0x00, 0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x80, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x57, 0x01, 0xff, 0xff, 0x56, 0x00, 
+0xff, 0xff, 0xb6, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x11, 0x01, 
+0xff, 0xff, 0x6e, 0x03, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x78, 0x03, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x5d, 0x01, 
+0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xed, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x15, 0x03, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x1b, 0x01, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x60, 0x00, 0xff, 0xff, 0x4d, 0x01, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x49, 0x01, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x50, 0x02, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x04, 0x01, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x8e, 0x03, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x8e, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x22, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x7a, 0x02, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x4d, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0xa6, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0x9b, 0x00, 0xff, 0xff, 0x30, 0x01, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x22, 0x01, 0xff, 0xff, 0xb0, 0x03, 
+0xff, 0xff, 0x34, 0x01, 0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x7f, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x35, 0x01, 0xff, 0xff, 0xea, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xa2, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x39, 0x01, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x35, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x6f, 0x02, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xd9, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0xc9, 0x03, 
+0xff, 0xff, 0x42, 0x01, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xef, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0xe4, 0x00, 0xff, 0xff, 0x99, 0x00, 
+0xff, 0xff, 0x46, 0x01, 0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xd1, 0x03, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x71, 0x00, 
+0xff, 0xff, 0x49, 0x01, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x7d, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x17, 0x01, 
+0xff, 0xff, 0xd5, 0x03, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xd9, 0x03, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x4e, 0x01, 
+0xff, 0xff, 0x8e, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x46, 0x01, 
+0xff, 0xff, 0xda, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xf3, 0x03, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x51, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x75, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x99, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x7f, 0x02, 
+0xff, 0xff, 0x55, 0x02, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xe4, 0x03, 
+0xff, 0xff, 0x65, 0x01, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xa6, 0x00, 
+0xff, 0xff, 0x1d, 0x04, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0x20, 0x04, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x47, 0x01, 0xff, 0xff, 0x62, 0x00, 
+0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0xf6, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x01, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x7b, 0x00, 
+0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x38, 0x04, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x02, 0x01, 0xff, 0xff, 0x82, 0x00, 0xff, 0xff, 0xbc, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0xb7, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x78, 0x01, 
+0xff, 0xff, 0x42, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x7f, 0x01, 
+0xff, 0xff, 0x4b, 0x01, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x55, 0x04, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x02, 0x03, 0xff, 0xff, 0x69, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xa9, 0x00, 0xff, 0xff, 0x25, 0x01, 
+0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x53, 0x04, 
+0xff, 0xff, 0xab, 0x01, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x4c, 0x04, 0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x43, 0x04, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x67, 0x01, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xf1, 0x00, 0xff, 0xff, 0x3f, 0x04, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x74, 0x01, 0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x3c, 0x04, 
+0xff, 0xff, 0xfd, 0x00, 0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0x5d, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x70, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x60, 0x01, 0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x32, 0x04, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x6e, 0x00, 
+0xff, 0xff, 0xd0, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x2c, 0x04, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x89, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x68, 0x00, 
+0xff, 0xff, 0x27, 0x04, 0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0xc6, 0x00, 
+0xff, 0xff, 0x15, 0x01, 0xff, 0xff, 0x6a, 0x01, 0xff, 0xff, 0xbc, 0x00, 
+0xff, 0xff, 0x92, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x46, 0x01, 
+0xff, 0xff, 0x9f, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x1c, 0x04, 0xff, 0xff, 0x7b, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x64, 0x01, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x12, 0x04, 0xff, 0xff, 0xff, 0x03, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x6d, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x0a, 0x04, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x30, 0x00, 
+0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x22, 0x01, 
+0xff, 0xff, 0x5b, 0x01, 0xff, 0xff, 0x59, 0x01, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x18, 0x01, 
+0xff, 0xff, 0xf9, 0x03, 0xff, 0xff, 0xbd, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x3a, 0x02, 0xff, 0xff, 0xbe, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0xf6, 0x03, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x54, 0x01, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x54, 0x00, 
+0xff, 0xff, 0xee, 0x03, 0xff, 0xff, 0xed, 0x03, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x52, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x4f, 0x01, 
+0xff, 0xff, 0x3c, 0x01, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0xde, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xac, 0x02, 
+0xff, 0xff, 0xa9, 0x01, 0xff, 0xff, 0xdd, 0x03, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x00, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x03, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x8d, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0xe0, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x30, 0x00, 
+0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xac, 0x00, 0xff, 0xff, 0xce, 0x03, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xcb, 0x00, 0xff, 0xff, 0x2b, 0x01, 0xff, 0xff, 0xec, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x43, 0x01, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xc7, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x79, 0x00, 0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0xee, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xf3, 0x00, 
+0xff, 0xff, 0xc3, 0x03, 0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x3b, 0x01, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x07, 0x01, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x3d, 0x01, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x8a, 0x00, 
+0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x3b, 0x01, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x89, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0xac, 0x00, 0xff, 0xff, 0x3e, 0x01, 
+0xff, 0xff, 0x3a, 0x01, 0xff, 0xff, 0x6d, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x99, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xad, 0x00, 0xff, 0xff, 0x89, 0x00, 0xff, 0xff, 0xf3, 0x00, 
+0xff, 0xff, 0xb0, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x9c, 0x00, 0xff, 0xff, 0xaa, 0x03, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xc5, 0x00, 
+0xff, 0xff, 0x54, 0x00, 0xff, 0xff, 0x0f, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xfe, 0x01, 0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x4e, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x99, 0x03, 0xff, 0xff, 0x4f, 0x01, 
+0xff, 0xff, 0x31, 0x01, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x28, 0x01, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x95, 0x03, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x91, 0x03, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0x5a, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x8c, 0x03, 
+0xff, 0xff, 0xfb, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0xc6, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xcd, 0x00, 0xff, 0xff, 0xd7, 0x00, 
+0xff, 0xff, 0xb2, 0x01, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x4f, 0x00, 
+0xff, 0xff, 0x85, 0x03, 0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0xa9, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x55, 0x00, 
+0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0x21, 0x01, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x82, 0x03, 0xff, 0xff, 0x4a, 0x02, 
+0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0xbd, 0x01, 0xff, 0xff, 0x8c, 0x02, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x80, 0x03, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0x1d, 0x01, 0xff, 0xff, 0x33, 0x01, 0xff, 0xff, 0x7a, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x72, 0x02, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x7b, 0x01, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0xa6, 0x00, 
+0xff, 0xff, 0x47, 0x01, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0xa4, 0x01, 
+0xff, 0xff, 0x00, 0x03, 0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x86, 0x00, 
+0xff, 0xff, 0x5e, 0x01, 0xff, 0xff, 0xf0, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x9f, 0x00, 
+0xff, 0xff, 0x79, 0x03, 0xff, 0xff, 0xd8, 0x00, 0xff, 0xff, 0x14, 0x01, 
+0xff, 0xff, 0x9c, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x76, 0x03, 0xff, 0xff, 0xa3, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x83, 0x02, 0xff, 0xff, 0xd8, 0x02, 0xff, 0xff, 0xd4, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0xb4, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x10, 0x01, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0xb6, 0x00, 0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xa5, 0x00, 
+0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x86, 0x00, 0xff, 0xff, 0x9d, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x9e, 0x00, 0xff, 0xff, 0xd3, 0x02, 
+0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0xe2, 0x00, 
+0xff, 0xff, 0xa7, 0x02, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x4b, 0x02, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x0a, 0x01, 0xff, 0xff, 0xae, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x4d, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x7b, 0x00, 
+0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0x5f, 0x03, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x02, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x5a, 0x03, 
+0xff, 0xff, 0xb3, 0x00, 0xff, 0xff, 0x12, 0x01, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x04, 0x01, 0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x56, 0x03, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x02, 0x01, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x19, 0x01, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x5d, 0x04, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0x79, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x6b, 0x00, 
+0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xf5, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x99, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x6d, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0xb4, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xd7, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x81, 0x00, 
+0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x48, 0x03, 0xff, 0xff, 0x30, 0x00, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x54, 0x00, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x26, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x88, 0x00, 0xff, 0xff, 0xe4, 0x00, 0xff, 0xff, 0x5e, 0x00, 
+0xff, 0xff, 0x91, 0x02, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xf5, 0x00, 
+0xff, 0xff, 0x13, 0x01, 0xff, 0xff, 0xf4, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0xf3, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x49, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x40, 0x03, 0xff, 0xff, 0xda, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xba, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xec, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0xb6, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x3d, 0x03, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x1b, 0x01, 0xff, 0xff, 0xa8, 0x00, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0xb9, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x39, 0x01, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x46, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xa9, 0x01, 0xff, 0xff, 0xba, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x21, 0x01, 0xff, 0xff, 0x07, 0x01, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0xd5, 0x00, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0x9f, 0x00, 0xff, 0xff, 0x36, 0x02, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x9c, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x35, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xe8, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0xb3, 0x01, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x6c, 0x00, 
+0xff, 0xff, 0xf5, 0x00, 0xff, 0xff, 0x89, 0x00, 0xff, 0xff, 0xb3, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x35, 0x00, 
+0xff, 0xff, 0xe4, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xc8, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xe3, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0xa1, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xdb, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x74, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x10, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xb5, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xa1, 0x01, 
+0xff, 0xff, 0xdf, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x34, 0x03, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x56, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xbb, 0x01, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x00, 0xff, 0xff, 0x32, 0x03, 
+0xff, 0xff, 0x7e, 0x00, 0xff, 0xff, 0x15, 0x01, 0xff, 0xff, 0x6d, 0x02, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x41, 0x01, 0xff, 0xff, 0x7d, 0x01, 0xff, 0xff, 0x4c, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x2f, 0x03, 
+0xff, 0xff, 0x7e, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x2d, 0x03, 0xff, 0xff, 0x9f, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x82, 0x00, 
+0xff, 0xff, 0x15, 0x01, 0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x2a, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0xcd, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xc8, 0x01, 0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x9b, 0x02, 0xff, 0xff, 0x94, 0x00, 0xff, 0xff, 0xed, 0x00, 
+0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0xd9, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x88, 0x00, 
+0xff, 0xff, 0xe5, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0xcf, 0x01, 0xff, 0xff, 0x9f, 0x00, 0xff, 0xff, 0xb5, 0x01, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x9f, 0x02, 0xff, 0xff, 0xa9, 0x03, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x96, 0x00, 0xff, 0xff, 0x70, 0x00, 
+0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x9e, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0x75, 0x01, 0xff, 0xff, 0x2b, 0x03, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x72, 0x01, 0xff, 0xff, 0xa5, 0x02, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x9f, 0x01, 0xff, 0xff, 0x48, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x5e, 0x01, 0xff, 0xff, 0xfd, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x38, 0x01, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0xdb, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xc7, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x96, 0x00, 0xff, 0xff, 0xac, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xaf, 0x00, 0xff, 0xff, 0x5d, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xc9, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x12, 0x02, 0xff, 0xff, 0x2d, 0x02, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0xbb, 0x00, 0xff, 0xff, 0x19, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x04, 0x01, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xe1, 0x01, 0xff, 0xff, 0xc3, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x22, 0x00, 
+0xff, 0xff, 0xe5, 0x01, 0xff, 0xff, 0xac, 0x01, 0xff, 0xff, 0x3c, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0xbb, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x01, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x9e, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0xa7, 0x02, 0xff, 0xff, 0xea, 0x01, 
+0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x82, 0x01, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xf5, 0x01, 
+0xff, 0xff, 0xaa, 0x01, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x87, 0x00, 
+0xff, 0xff, 0xac, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0xf7, 0x01, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x45, 0x00, 
+0xff, 0xff, 0x7f, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x91, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xb6, 0x00, 0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x3c, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x1a, 0x02, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0xa4, 0x01, 0xff, 0xff, 0x60, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x68, 0x01, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x90, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0xc3, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xf4, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x01, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x93, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xde, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x96, 0x01, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0xad, 0x00, 0xff, 0xff, 0xab, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x9c, 0x00, 0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0xf8, 0x00, 
+0xff, 0xff, 0x39, 0x01, 0xff, 0xff, 0x25, 0x03, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0xc3, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x9e, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x15, 0x02, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x7e, 0x00, 
+0xff, 0xff, 0xb0, 0x00, 0xff, 0xff, 0xa9, 0x01, 0xff, 0xff, 0x35, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0xfc, 0x00, 
+0xff, 0xff, 0xc8, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x7f, 0x00, 
+0xff, 0xff, 0x1b, 0x02, 0xff, 0xff, 0x1c, 0x02, 0xff, 0xff, 0xc0, 0x00, 
+0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x1f, 0x02, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0xd7, 0x00, 0xff, 0xff, 0x0a, 0x01, 0xff, 0xff, 0xc2, 0x00, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x6f, 0x03, 0xff, 0xff, 0x6f, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x41, 0x01, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x63, 0x00, 
+0xff, 0xff, 0xbd, 0x00, 0xff, 0xff, 0x2b, 0x02, 0xff, 0xff, 0x1d, 0x01, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x09, 0x01, 0xff, 0xff, 0x29, 0x01, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x70, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xf3, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x60, 0x00, 
+0xff, 0xff, 0x31, 0x02, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x99, 0x01, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0xd4, 0x01, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0xb1, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x37, 0x02, 0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0xd2, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x47, 0x02, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0xb9, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x67, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0xa6, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x13, 0x01, 
+0xff, 0xff, 0xab, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x43, 0x01, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x9c, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x59, 0x02, 0xff, 0xff, 0xb0, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xb7, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x02, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x92, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x5f, 0x04, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xef, 0x01, 
+0xff, 0xff, 0x69, 0x02, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x8b, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xaf, 0x00, 
+0xff, 0xff, 0x60, 0x04, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x32, 0x01, 
+0xff, 0xff, 0xb6, 0x00, 0xff, 0xff, 0xb5, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x7c, 0x02, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0xe6, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x2a, 0x01, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0xb4, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x45, 0x00, 
+0xff, 0xff, 0xe1, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x8b, 0x02, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x8e, 0x02, 0xff, 0xff, 0x8f, 0x02, 
+0xff, 0xff, 0xa9, 0x01, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xad, 0x01, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xf3, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x8d, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xa3, 0x02, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x6a, 0x00, 0xff, 0xff, 0xa8, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xab, 0x02, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xb0, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x71, 0x01, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0xb0, 0x02, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x7a, 0x01, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x08, 0x01, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0xe1, 0x01, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0xd1, 0x01, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x82, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xc5, 0x02, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xc9, 0x02, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0xc1, 0x01, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x9d, 0x01, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x82, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x24, 0x03, 0xff, 0xff, 0x09, 0x02, 
+0xff, 0xff, 0x8d, 0x01, 0xff, 0xff, 0xd7, 0x02, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xde, 0x02, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xe0, 0x02, 0xff, 0xff, 0x75, 0x02, 0xff, 0xff, 0x58, 0x00, 
+0xff, 0xff, 0xac, 0x01, 0xff, 0xff, 0x18, 0x04, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0xcd, 0x02, 
+0xff, 0xff, 0xe7, 0x02, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0xe9, 0x02, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x01, 
+0xff, 0xff, 0x25, 0x01, 0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xf6, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0xf1, 0x02, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x60, 0x01, 0xff, 0xff, 0xab, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x02, 
+0xff, 0xff, 0x97, 0x02, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0xd5, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x87, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x9a, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x21, 0x03, 
+0xff, 0xff, 0x8b, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0xed, 0x01, 0xff, 0xff, 0x3c, 0x02, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x15, 0x03, 0xff, 0xff, 0xcc, 0x02, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x07, 0x03, 
+0xff, 0xff, 0x06, 0x03, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x01, 0x03, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xfd, 0x02, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xf8, 0x02, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x65, 0x04, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x00, 0xff, 0xff, 0x67, 0x04, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0xcd, 0x01, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0xeb, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x86, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0xd9, 0x02, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x53, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0xd3, 0x02, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x68, 0x04, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x00, 0xff, 0xff, 0xe1, 0x01, 
+0xff, 0xff, 0xf0, 0x00, 0xff, 0xff, 0x94, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x85, 0x02, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0xc1, 0x00, 0xff, 0xff, 0x47, 0x02, 0xff, 0xff, 0x57, 0x04, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x66, 0x01, 0xff, 0xff, 0xad, 0x02, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x6a, 0x00, 
+0xff, 0xff, 0xa4, 0x02, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x69, 0x04, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x89, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x94, 0x02, 0xff, 0xff, 0x81, 0x02, 0xff, 0xff, 0xa0, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x8c, 0x02, 0xff, 0xff, 0x4f, 0x00, 
+0xff, 0xff, 0xda, 0x00, 0xff, 0xff, 0xac, 0x00, 0xff, 0xff, 0xbd, 0x00, 
+0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x5d, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x81, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x6b, 0x04, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x6c, 0x04, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0xf4, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x38, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x8f, 0x00, 0xff, 0xff, 0x93, 0x01, 0xff, 0xff, 0x7d, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x6d, 0x02, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x8c, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x6d, 0x00, 
+0xff, 0xff, 0x60, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x01, 0x01, 
+0xff, 0xff, 0x96, 0x02, 0xff, 0xff, 0x4f, 0x02, 0xff, 0xff, 0x1a, 0x02, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xc5, 0x00, 0xff, 0xff, 0x4e, 0x01, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x02, 0x02, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0xb1, 0x01, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x44, 0x02, 
+0xff, 0xff, 0x6a, 0x01, 0xff, 0xff, 0x9b, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x3e, 0x02, 0xff, 0xff, 0x6e, 0x04, 
+0xff, 0xff, 0x0b, 0x02, 0xff, 0xff, 0x9c, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x6f, 0x04, 0xff, 0xff, 0xa2, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x09, 0x03, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x8e, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x9a, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x14, 0x01, 
+0xff, 0xff, 0x1d, 0x01, 0xff, 0xff, 0x24, 0x02, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x28, 0x02, 
+0xff, 0xff, 0x26, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x71, 0x04, 0xff, 0xff, 0xe4, 0x00, 0xff, 0xff, 0xca, 0x01, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xc4, 0x00, 0xff, 0xff, 0x76, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x9e, 0x01, 
+0xff, 0xff, 0x72, 0x04, 0xff, 0xff, 0xc3, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0xfc, 0x01, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x88, 0x00, 0xff, 0xff, 0xa2, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x73, 0x04, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x74, 0x04, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x57, 0x01, 
+0xff, 0xff, 0x75, 0x04, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x76, 0x04, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x98, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0xa4, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x77, 0x04, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x07, 0x02, 0xff, 0xff, 0x78, 0x04, 0xff, 0xff, 0x06, 0x02, 
+0xff, 0xff, 0xe8, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x6b, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xf0, 0x01, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xb9, 0x01, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xb6, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0xde, 0x01, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x7a, 0x04, 0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0xf1, 0x01, 
+0xff, 0xff, 0xc3, 0x00, 0xff, 0xff, 0x7d, 0x00, 0xff, 0xff, 0x08, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xce, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x7c, 0x04, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xe7, 0x01, 
+0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0xe0, 0x00, 0xff, 0xff, 0x5d, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x7e, 0x04, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x7f, 0x04, 0xff, 0xff, 0x4b, 0x03, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x9c, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xf9, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x6e, 0x00, 
+0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0xc0, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x66, 0x01, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xf0, 0x00, 0xff, 0xff, 0x52, 0x01, 0xff, 0xff, 0x72, 0x00, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x20, 0x01, 0xff, 0xff, 0x56, 0x01, 0xff, 0xff, 0x81, 0x04, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xa1, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x70, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0xfd, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0xa0, 0x01, 0xff, 0xff, 0x5e, 0x00, 
+0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0xd6, 0x00, 0xff, 0xff, 0x70, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0xc4, 0x01, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x30, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0xb6, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x31, 0x03, 0xff, 0xff, 0x67, 0x00, 0xff, 0xff, 0x01, 0x01, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0xad, 0x00, 0xff, 0xff, 0xe5, 0x00, 
+0xff, 0xff, 0x53, 0x01, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x22, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x20, 0x01, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0xc1, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x36, 0x03, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x04, 0x01, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x8a, 0x04, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0xf3, 0x00, 
+0xff, 0xff, 0x8b, 0x04, 0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x1f, 0x01, 
+0xff, 0xff, 0xd5, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x3c, 0x03, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0xf2, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x8c, 0x04, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xa5, 0x01, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0xa3, 0x01, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x62, 0x01, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xdf, 0x00, 
+0xff, 0xff, 0x8d, 0x04, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x8e, 0x04, 0xff, 0xff, 0x49, 0x01, 
+0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x43, 0x03, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x47, 0x03, 
+0xff, 0xff, 0x87, 0x04, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x97, 0x03, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xc4, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x6a, 0x01, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x2d, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x91, 0x02, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x60, 0x01, 
+0xff, 0xff, 0x0f, 0x01, 0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0xd7, 0x00, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x81, 0x01, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x4f, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x65, 0x00, 
+0xff, 0xff, 0x21, 0x01, 0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0x55, 0x00, 
+0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x66, 0x03, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x67, 0x03, 0xff, 0xff, 0xc1, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x9f, 0x01, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0xcd, 0x00, 0xff, 0xff, 0x0c, 0x03, 
+0xff, 0xff, 0x6a, 0x03, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x48, 0x00, 
+0xff, 0xff, 0xe6, 0x00, 0xff, 0xff, 0x65, 0x01, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0xaa, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x4d, 0x00, 
+0xff, 0xff, 0xb1, 0x01, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x33, 0x02, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0xe1, 0x02, 
+0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x8b, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x2f, 0x01, 0xff, 0xff, 0xe9, 0x01, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xb5, 0x01, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x8d, 0x03, 0xff, 0xff, 0x29, 0x01, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x56, 0x00, 
+0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x93, 0x03, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xee, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0xcf, 0x01, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x69, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xa7, 0x03, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xc9, 0x01, 
+0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0xc6, 0x02, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0xea, 0x01, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0xb8, 0x00, 0xff, 0xff, 0x30, 0x01, 0xff, 0xff, 0xbe, 0x03, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x83, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0xc5, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xfb, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xd3, 0x03, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x70, 0x01, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xda, 0x03, 0xff, 0xff, 0x0c, 0x01, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xe1, 0x03, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0xe4, 0x03, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x49, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xe1, 0x00, 0xff, 0xff, 0xec, 0x03, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xbd, 0x03, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0xd6, 0x02, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0xf5, 0x03, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x22, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x96, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x97, 0x03, 
+0xff, 0xff, 0x02, 0x04, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x0e, 0x04, 0xff, 0xff, 0x0f, 0x04, 0xff, 0xff, 0x4e, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x82, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x3a, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x28, 0x04, 
+0xff, 0xff, 0x88, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0xc5, 0x02, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xe9, 0x02, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x45, 0x04, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x4b, 0x04, 0xff, 0xff, 0x4d, 0x04, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xaf, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x59, 0x04, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x83, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x7e, 0x01, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x50, 0x04, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x4a, 0x04, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0xe2, 0x02, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x3a, 0x04, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x4a, 0x03, 
+0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x91, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x26, 0x04, 0xff, 0xff, 0x2b, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x19, 0x04, 
+0xff, 0xff, 0x17, 0x04, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0xe9, 0x01, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0xba, 0x03, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x11, 0x04, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xa6, 0x03, 
+0xff, 0xff, 0x0c, 0x04, 0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x54, 0x01, 0xff, 0xff, 0xce, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x65, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xe0, 0x00, 0xff, 0xff, 0x35, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0xbe, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x70, 0x00, 0xff, 0xff, 0xd8, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xa7, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xcb, 0x03, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x9c, 0x00, 0xff, 0xff, 0x25, 0x02, 
+0xff, 0xff, 0xba, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x89, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0xcc, 0x00, 0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0x54, 0x00, 
+0xff, 0xff, 0xbd, 0x03, 0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x03, 0xff, 0xff, 0x51, 0x00, 
+0xff, 0xff, 0x9e, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x5e, 0x00, 
+0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xcb, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0xad, 0x03, 0xff, 0xff, 0x92, 0x00, 0xff, 0xff, 0xc2, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x2a, 0x02, 0xff, 0xff, 0xf6, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x50, 0x01, 0xff, 0xff, 0x65, 0x00, 
+0xff, 0xff, 0x98, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xe6, 0x01, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x03, 
+0xff, 0xff, 0xd3, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x9e, 0x03, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x79, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x97, 0x03, 0xff, 0xff, 0xcd, 0x00, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x66, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xc0, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x45, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x88, 0x03, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0xa8, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xda, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x96, 0x01, 0xff, 0xff, 0x52, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0x03, 0x03, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xeb, 0x01, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x81, 0x03, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x45, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x5e, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x54, 0x01, 0xff, 0xff, 0x03, 0x01, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x72, 0x03, 
+0xff, 0xff, 0xcd, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x17, 0x01, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x58, 0x01, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x69, 0x03, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x68, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x09, 0x02, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x5d, 0x03, 0xff, 0xff, 0xa2, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x59, 0x03, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0xd7, 0x02, 0xff, 0xff, 0x92, 0x00, 0xff, 0xff, 0x57, 0x03, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0xb3, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0x54, 0x00, 0xff, 0xff, 0xd0, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x91, 0x01, 0xff, 0xff, 0x8f, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0xc8, 0x01, 0xff, 0xff, 0x9c, 0x01, 0xff, 0xff, 0x54, 0x00, 
+0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x1e, 0x04, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0xbe, 0x01, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x9f, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x8f, 0x01, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0xdf, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x52, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x8f, 0x04, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x41, 0x03, 0xff, 0xff, 0xa1, 0x01, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xd1, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0xb4, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x7f, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x39, 0x03, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0xab, 0x01, 
+0xff, 0xff, 0xdc, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x64, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xfc, 0x02, 0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x02, 0x01, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x89, 0x04, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x44, 0x01, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0xbc, 0x01, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x68, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x67, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x88, 0x04, 
+0xff, 0xff, 0x8e, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xc0, 0x01, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x54, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xc2, 0x01, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x86, 0x04, 0xff, 0xff, 0xb6, 0x01, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x65, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x85, 0x04, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x84, 0x04, 
+0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x83, 0x04, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x82, 0x04, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x63, 0x01, 
+0xff, 0xff, 0xe8, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x62, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x26, 0x02, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xcc, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xc4, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xcd, 0x01, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0xa2, 0x00, 0xff, 0xff, 0x22, 0x00, 
+0xff, 0xff, 0xd1, 0x01, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xd2, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x48, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xe6, 0x03, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x6b, 0x01, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x5f, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x5e, 0x00, 
+0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x80, 0x04, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xee, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0xc4, 0x00, 0xff, 0xff, 0x18, 0x01, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xdb, 0x01, 0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0xe4, 0x01, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xb7, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xed, 0x01, 0xff, 0xff, 0xcf, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x7d, 0x04, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x7b, 0x04, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x44, 0x02, 
+0xff, 0xff, 0x83, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x79, 0x04, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x48, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x84, 0x01, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0xfb, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x38, 0x01, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xcc, 0x00, 0xff, 0xff, 0xad, 0x00, 
+0xff, 0xff, 0x03, 0x02, 0xff, 0xff, 0x04, 0x02, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0xce, 0x00, 0xff, 0xff, 0xa5, 0x00, 
+0xff, 0xff, 0x96, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0xac, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x09, 0x02, 
+0xff, 0xff, 0x0a, 0x02, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x83, 0x01, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x26, 0x01, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x99, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0xa9, 0x01, 
+0xff, 0xff, 0x06, 0x02, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x1c, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x00, 0xff, 0xff, 0xdc, 0x01, 
+0xff, 0xff, 0x97, 0x01, 0xff, 0xff, 0xbf, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x53, 0x02, 0xff, 0xff, 0x53, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x9a, 0x00, 0xff, 0xff, 0x5a, 0x00, 
+0xff, 0xff, 0x18, 0x02, 0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x19, 0x02, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0x9d, 0x00, 0xff, 0xff, 0x9a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x91, 0x00, 0xff, 0xff, 0x7d, 0x00, 0xff, 0xff, 0x50, 0x01, 
+0xff, 0xff, 0x8d, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x3a, 0x01, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x4f, 0x02, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x4e, 0x02, 0xff, 0xff, 0x32, 0x02, 0xff, 0xff, 0x70, 0x04, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x8c, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x2f, 0x02, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0xde, 0x00, 0xff, 0xff, 0xf2, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0xe6, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x34, 0x02, 0xff, 0xff, 0xc2, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x36, 0x02, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x3d, 0x02, 
+0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x42, 0x02, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0xc4, 0x01, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x66, 0x00, 
+0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0xa6, 0x00, 0xff, 0xff, 0x6d, 0x04, 
+0xff, 0xff, 0x48, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0xda, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x0b, 0x01, 0xff, 0xff, 0x76, 0x00, 
+0xff, 0xff, 0xa5, 0x00, 0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x4d, 0x02, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x7f, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x4e, 0x02, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xbe, 0x00, 
+0xff, 0xff, 0x54, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x7a, 0x00, 
+0xff, 0xff, 0xf5, 0x01, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x5a, 0x02, 0xff, 0xff, 0x65, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xbe, 0x01, 0xff, 0xff, 0x0a, 0x02, 
+0xff, 0xff, 0x13, 0x01, 0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x66, 0x02, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x70, 0x02, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x33, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x79, 0x02, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x7a, 0x02, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x1f, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x6d, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x5e, 0x01, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0xa3, 0x00, 0xff, 0xff, 0x47, 0x02, 0xff, 0xff, 0x6a, 0x04, 
+0xff, 0xff, 0xaa, 0x00, 0xff, 0xff, 0x10, 0x02, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0xb6, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x8d, 0x02, 0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xdf, 0x01, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x97, 0x01, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x49, 0x01, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x12, 0x01, 0xff, 0xff, 0x29, 0x01, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x9d, 0x01, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xa6, 0x02, 0xff, 0xff, 0x52, 0x02, 0xff, 0xff, 0xb4, 0x01, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0xae, 0x02, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0xb1, 0x02, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x4c, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xc5, 0x00, 0xff, 0xff, 0x5e, 0x00, 
+0xff, 0xff, 0xde, 0x00, 0xff, 0xff, 0xb9, 0x02, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xbb, 0x02, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xc1, 0x02, 0xff, 0xff, 0xc4, 0x02, 
+0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x4b, 0x01, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0xcc, 0x01, 0xff, 0xff, 0x64, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xd6, 0x02, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x02, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0xf7, 0x00, 0xff, 0xff, 0xa8, 0x00, 0xff, 0xff, 0x54, 0x01, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xf3, 0x02, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x66, 0x04, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x4c, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x8f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x64, 0x04, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0d, 0x03, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0xd5, 0x03, 
+0xff, 0xff, 0xfe, 0x02, 0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x12, 0x03, 0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x63, 0x04, 
+0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x82, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xc1, 0x01, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x22, 0x03, 
+0xff, 0xff, 0x19, 0x01, 0xff, 0xff, 0xbd, 0x01, 0xff, 0xff, 0x45, 0x00, 
+0xff, 0xff, 0x7c, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x67, 0x01, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xcd, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x17, 0x03, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x9c, 0x00, 0xff, 0xff, 0xa9, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0c, 0x03, 
+0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x03, 0x03, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x21, 0x02, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x1a, 0x01, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x68, 0x00, 
+0xff, 0xff, 0x62, 0x04, 0xff, 0xff, 0x04, 0x01, 0xff, 0xff, 0xe9, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xd7, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0xef, 0x02, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x61, 0x04, 0xff, 0xff, 0xf8, 0x01, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0xe8, 0x02, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x84, 0x00, 0xff, 0xff, 0xab, 0x02, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xe1, 0x02, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x4d, 0x03, 
+0xff, 0xff, 0xd7, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x42, 0x03, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0xd9, 0x01, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0xfe, 0x01, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xbf, 0x01, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x71, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xc0, 0x02, 
+0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0xf8, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0xa7, 0x02, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0xb5, 0x02, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x65, 0x00, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x4c, 0x00, 
+0xff, 0xff, 0xa0, 0x00, 0xff, 0xff, 0xc4, 0x00, 0xff, 0xff, 0xb0, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x7a, 0x00, 
+0xff, 0xff, 0xba, 0x00, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xa7, 0x02, 0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0xaf, 0x03, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x7c, 0x00, 
+0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0xc7, 0x00, 0xff, 0xff, 0x9a, 0x02, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xa8, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x92, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x01, 0x01, 0xff, 0xff, 0x9f, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x6e, 0x01, 0xff, 0xff, 0xfe, 0x02, 0xff, 0xff, 0xd5, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x8f, 0x00, 0xff, 0xff, 0x89, 0x02, 
+0xff, 0xff, 0x88, 0x02, 0xff, 0xff, 0xc8, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0xd0, 0x01, 0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0xec, 0x00, 
+0xff, 0xff, 0x98, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0xba, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0xaf, 0x01, 
+0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x6b, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x7d, 0x02, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0xe5, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x74, 0x01, 
+0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x78, 0x02, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x74, 0x02, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x90, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x6e, 0x02, 
+0xff, 0xff, 0x6a, 0x01, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x20, 0x02, 
+0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x02, 
+0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xf1, 0x00, 
+0xff, 0xff, 0xbb, 0x01, 0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x61, 0x02, 0xff, 0xff, 0x71, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x56, 0x01, 0xff, 0xff, 0x6e, 0x00, 
+0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xa2, 0x02, 
+0xff, 0xff, 0xa1, 0x00, 0xff, 0xff, 0x5c, 0x02, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x40, 0x01, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x7e, 0x00, 0xff, 0xff, 0x87, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x82, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0xe8, 0x01, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xd2, 0x03, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x7b, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x45, 0x02, 
+0xff, 0xff, 0xc2, 0x01, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0xfb, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x43, 0x02, 
+0xff, 0xff, 0x9a, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x4f, 0x00, 
+0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x7a, 0x00, 
+0xff, 0xff, 0x4e, 0x01, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x6a, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0xae, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x39, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x9a, 0x00, 
+0xff, 0xff, 0xbb, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x9d, 0x01, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xcc, 0x00, 0xff, 0xff, 0x32, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x3e, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x82, 0x01, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x64, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0xa9, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x5e, 0x04, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x6e, 0x02, 
+0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0xc0, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x30, 0x00, 
+0xff, 0xff, 0xbd, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x56, 0x00, 
+0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x00, 
+0xff, 0xff, 0xe3, 0x01, 0xff, 0xff, 0xd3, 0x01, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x36, 0x02, 0xff, 0xff, 0x4b, 0x03, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x6a, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x30, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0xda, 0x00, 0xff, 0xff, 0xc1, 0x00, 
+0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x9c, 0x01, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0xbb, 0x01, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0xf9, 0x03, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x85, 0x02, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xd8, 0x02, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0xca, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x14, 0x02, 0xff, 0xff, 0x12, 0x02, 
+0xff, 0xff, 0x15, 0x03, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x45, 0x00, 
+0xff, 0xff, 0xac, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x7d, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0xa3, 0x00, 0xff, 0xff, 0xf0, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x8a, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0xc4, 0x00, 
+0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x31, 0x01, 0xff, 0xff, 0x7e, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x18, 0x02, 0xff, 0xff, 0x04, 0x01, 
+0xff, 0xff, 0xf8, 0x01, 0xff, 0xff, 0x69, 0x01, 0xff, 0xff, 0x65, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x27, 0x01, 0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xec, 0x01, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x46, 0x01, 
+0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x28, 0x03, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xee, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xfc, 0x01, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x7d, 0x01, 
+0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x3b, 0x01, 
+0xff, 0xff, 0x30, 0x01, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x6d, 0x00, 
+0xff, 0xff, 0x77, 0x01, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0xc8, 0x00, 0xff, 0xff, 0x0a, 0x01, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x7e, 0x02, 0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x40, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xda, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xbb, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x85, 0x01, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0xb0, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xb4, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xe9, 0x01, 
+0xff, 0xff, 0xbb, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0xa3, 0x00, 
+0xff, 0xff, 0xca, 0x00, 0xff, 0xff, 0x55, 0x01, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x40, 0x03, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x29, 0x03, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x4d, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x1c, 0x01, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0x3a, 0x01, 0xff, 0xff, 0xdf, 0x01, 0xff, 0xff, 0x53, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x00, 0xff, 0xff, 0x77, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0xdd, 0x01, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x2a, 0x03, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x4d, 0x00, 
+0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x02, 0x01, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xfb, 0x02, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x34, 0x01, 
+0xff, 0xff, 0x6b, 0x00, 0xff, 0xff, 0xd7, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0xf7, 0x00, 
+0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xd3, 0x01, 
+0xff, 0xff, 0x72, 0x01, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x38, 0x01, 
+0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x67, 0x00, 
+0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0xae, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xa6, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0xb6, 0x01, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0x48, 0x00, 
+0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x01, 0x02, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x9b, 0x01, 0xff, 0xff, 0xe4, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x60, 0x00, 
+0xff, 0xff, 0x5f, 0x01, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xd1, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x0b, 0x01, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x1e, 0x01, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x48, 0x00, 
+0xff, 0xff, 0x6a, 0x01, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0xc2, 0x00, 
+0xff, 0xff, 0x94, 0x00, 0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x24, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0xc3, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x3f, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x17, 0x01, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0xb5, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xa0, 0x00, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xa8, 0x02, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x98, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0xdc, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x92, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x66, 0x02, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0xba, 0x01, 0xff, 0xff, 0xdd, 0x00, 0xff, 0xff, 0x5a, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x6c, 0x01, 0xff, 0xff, 0x42, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xc1, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x1a, 0x01, 0xff, 0xff, 0xad, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0xe1, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xb7, 0x01, 0xff, 0xff, 0x51, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x5e, 0x01, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xb5, 0x01, 
+0xff, 0xff, 0xd0, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x9e, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xe5, 0x00, 
+0xff, 0xff, 0x05, 0x01, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x63, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xb0, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xe7, 0x00, 0xff, 0xff, 0xd3, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xab, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xa6, 0x01, 0xff, 0xff, 0x6b, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xe9, 0x00, 0xff, 0xff, 0xb5, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x8f, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0xad, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xeb, 0x00, 0xff, 0xff, 0xde, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x9e, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x66, 0x01, 0xff, 0xff, 0x1d, 0x01, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0xb0, 0x00, 0xff, 0xff, 0x6c, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x8a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xe3, 0x00, 0xff, 0xff, 0x51, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x24, 0x01, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0x70, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x64, 0x00, 
+0xff, 0xff, 0xeb, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x09, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xba, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x76, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x66, 0x01, 
+0xff, 0xff, 0x3a, 0x01, 0xff, 0xff, 0x48, 0x03, 0xff, 0xff, 0x45, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xc6, 0x00, 0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0xf2, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x59, 0x02, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x6a, 0x00, 0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x7f, 0x00, 
+0xff, 0xff, 0xb1, 0x00, 0xff, 0xff, 0xc4, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x02, 0x01, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0xbe, 0x00, 0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x8e, 0x00, 
+0xff, 0xff, 0xc3, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0xcb, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x46, 0x03, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0xfc, 0x00, 0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x27, 0x03, 
+0xff, 0xff, 0x70, 0x00, 0xff, 0xff, 0x54, 0x00, 0xff, 0xff, 0x55, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x1e, 0x01, 0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0xc4, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x9d, 0x01, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x72, 0x01, 0xff, 0xff, 0x6e, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x52, 0x03, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x42, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x9a, 0x01, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x7d, 0x01, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xd9, 0x01, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x01, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x4c, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x8e, 0x00, 
+0xff, 0xff, 0x51, 0x01, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x6c, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x98, 0x01, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x5c, 0x01, 0xff, 0xff, 0xa0, 0x00, 
+0xff, 0xff, 0x07, 0x01, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x47, 0x01, 
+0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x61, 0x03, 
+0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x2d, 0x01, 0xff, 0xff, 0x54, 0x00, 
+0xff, 0xff, 0xbc, 0x01, 0xff, 0xff, 0xa7, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x97, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x5b, 0x01, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x17, 0x01, 0xff, 0xff, 0x3a, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x68, 0x03, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0xa5, 0x00, 0xff, 0xff, 0x8a, 0x01, 
+0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0f, 0x01, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0xc1, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x6d, 0x03, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x78, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0xc8, 0x00, 0xff, 0xff, 0xe4, 0x00, 0xff, 0xff, 0xa6, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xd8, 0x00, 0xff, 0xff, 0x64, 0x00, 
+0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x81, 0x01, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x16, 0x01, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x17, 0x01, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x01, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x83, 0x01, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x01, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x9e, 0x00, 0xff, 0xff, 0x98, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xb8, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x47, 0x03, 0xff, 0xff, 0x96, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1e, 0x01, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x1f, 0x01, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x7e, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x83, 0x03, 0xff, 0xff, 0x6d, 0x02, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x22, 0x01, 0xff, 0xff, 0x54, 0x00, 
+0xff, 0xff, 0xbb, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x91, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xaa, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0xb7, 0x01, 0xff, 0xff, 0xad, 0x00, 
+0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x89, 0x03, 0xff, 0xff, 0x8a, 0x03, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0x23, 0x01, 0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x99, 0x02, 
+0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xae, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x1f, 0x01, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0xd1, 0x01, 0xff, 0xff, 0x4a, 0x03, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0xab, 0x00, 0xff, 0xff, 0xf0, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x59, 0x02, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0x6c, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x29, 0x01, 
+0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0xfe, 0x00, 0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x87, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x8f, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0xca, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x4c, 0x00, 
+0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x37, 0x01, 
+0xff, 0xff, 0xa6, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x07, 0x01, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x38, 0x01, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0xb9, 0x03, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x88, 0x01, 0xff, 0xff, 0x4d, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x3c, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x51, 0x02, 
+0xff, 0xff, 0xd6, 0x00, 0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xac, 0x00, 
+0xff, 0xff, 0xaa, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0xc2, 0x03, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x8f, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x7a, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x41, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x96, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x01, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x44, 0x01, 
+0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x3d, 0x02, 
+0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x4b, 0x02, 0xff, 0xff, 0xdd, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x54, 0x00, 0xff, 0xff, 0x2c, 0x01, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x47, 0x01, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x35, 0x01, 0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x89, 0x00, 0xff, 0xff, 0x48, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0xb9, 0x02, 0xff, 0xff, 0xd8, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0xcd, 0x00, 0xff, 0xff, 0x4b, 0x01, 0xff, 0xff, 0x4d, 0x01, 
+0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xde, 0x03, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0xd9, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x6e, 0x00, 
+0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x1f, 0x01, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0xd6, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x90, 0x00, 0xff, 0xff, 0xf1, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0xb0, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x3c, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xf4, 0x03, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x55, 0x01, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x57, 0x01, 
+0xff, 0xff, 0xf8, 0x03, 0xff, 0xff, 0x15, 0x01, 0xff, 0xff, 0x93, 0x02, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xca, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x58, 0x01, 0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x7d, 0x02, 0xff, 0xff, 0xfb, 0x03, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0xfe, 0x03, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xf0, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x05, 0x04, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x06, 0x04, 0xff, 0xff, 0x08, 0x04, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0xa2, 0x00, 0xff, 0xff, 0x14, 0x04, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x18, 0x04, 
+0xff, 0xff, 0x1a, 0x04, 0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x89, 0x00, 
+0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xb8, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x42, 0x00, 
+0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x6c, 0x01, 0xff, 0xff, 0x22, 0x04, 
+0xff, 0xff, 0x83, 0x01, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0xea, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0xa9, 0x00, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x91, 0x03, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x6e, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x99, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xbc, 0x00, 
+0xff, 0xff, 0xe6, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x90, 0x00, 
+0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x6f, 0x01, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0xc9, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x24, 0x01, 0xff, 0xff, 0x6c, 0x02, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x73, 0x01, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0xbd, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x23, 0x02, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x25, 0x01, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0xc5, 0x03, 
+0xff, 0xff, 0x79, 0x01, 0xff, 0xff, 0x7a, 0x01, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x7c, 0x01, 0xff, 0xff, 0xdc, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0xb8, 0x01, 0xff, 0xff, 0x4a, 0x01, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x7d, 0x01, 0xff, 0xff, 0x55, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x52, 0x04, 0xff, 0xff, 0x82, 0x01, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x58, 0x04, 0xff, 0xff, 0x5c, 0x04, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x5b, 0x04, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x99, 0x01, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x3c, 0x01, 
+0xff, 0xff, 0x7e, 0x01, 0xff, 0xff, 0x4f, 0x04, 0xff, 0xff, 0x52, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xcb, 0x00, 
+0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x82, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x3d, 0x04, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x91, 0x00, 0xff, 0xff, 0x73, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xdc, 0x02, 0xff, 0xff, 0x3c, 0x00, 
+0xff, 0xff, 0x3b, 0x04, 0xff, 0xff, 0x72, 0x01, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x35, 0x04, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x2f, 0x04, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0x68, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x29, 0x04, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x24, 0x04, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x68, 0x01, 
+0xff, 0xff, 0xe3, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x66, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xc4, 0x00, 0xff, 0xff, 0x31, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x61, 0x01, 0xff, 0xff, 0x89, 0x00, 
+0xff, 0xff, 0x60, 0x01, 0xff, 0xff, 0x7f, 0x01, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x0b, 0x04, 0xff, 0xff, 0x5d, 0x01, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x03, 0x04, 
+0xff, 0xff, 0x8e, 0x00, 0xff, 0xff, 0x01, 0x04, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x82, 0x02, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x2e, 0x02, 
+0xff, 0xff, 0x54, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0xa3, 0x00, 
+0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x53, 0x01, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0xe8, 0x03, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x6b, 0x00, 0xff, 0xff, 0x62, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0xed, 0x00, 
+0xff, 0xff, 0x4b, 0x01, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x4a, 0x01, 
+0xff, 0xff, 0xfe, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xed, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0xd0, 0x03, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xce, 0x00, 
+0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0xd7, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xd5, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x45, 0x01, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0f, 0x01, 
+0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x94, 0x00, 
+0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xcc, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x01, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x40, 0x01, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0xc0, 0x01, 0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x62, 0x00, 
+0xff, 0xff, 0x0e, 0x01, 0xff, 0xff, 0xba, 0x03, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x5a, 0x01, 0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xb5, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0xdd, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x36, 0x01, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x53, 0x00, 
+0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x32, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0xb5, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x5a, 0x00, 
+0xff, 0xff, 0xd7, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0xcb, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x8d, 0x00, 0xff, 0xff, 0x9d, 0x03, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x9b, 0x03, 0xff, 0xff, 0x2b, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x2a, 0x01, 0xff, 0xff, 0x98, 0x03, 
+0xff, 0xff, 0x44, 0x01, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x46, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x90, 0x03, 0xff, 0xff, 0x00, 0x01, 
+0xff, 0xff, 0x8f, 0x03, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x8e, 0x00, 
+0xff, 0xff, 0xdb, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x26, 0x01, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x24, 0x01, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x23, 0x01, 0xff, 0xff, 0x97, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x06, 0x01, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xdb, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x1c, 0x01, 0xff, 0xff, 0xb3, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0xb2, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x1a, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x61, 0x01, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x18, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xb3, 0x00, 
+0xff, 0xff, 0x7c, 0x03, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x75, 0x03, 0xff, 0xff, 0xab, 0x00, 0xff, 0xff, 0x92, 0x01, 
+0xff, 0xff, 0x73, 0x03, 0xff, 0xff, 0xbd, 0x00, 0xff, 0xff, 0x16, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xaf, 0x01, 0xff, 0xff, 0xd3, 0x00, 
+0xff, 0xff, 0x93, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x41, 0x01, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0xa0, 0x01, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x64, 0x03, 0xff, 0xff, 0x96, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x95, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0xbe, 0x00, 0xff, 0xff, 0x04, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x05, 0x01, 
+0xff, 0xff, 0x5c, 0x03, 0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0xb9, 0x00, 0xff, 0xff, 0x03, 0x01, 0xff, 0xff, 0x68, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x3d, 0x01, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0xb2, 0x01, 0xff, 0xff, 0xe4, 0x01, 0xff, 0xff, 0xd3, 0x00, 
+0xff, 0xff, 0xd2, 0x00, 0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x35, 0x01, 0xff, 0xff, 0x3a, 0x00, 
+0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xef, 0x00, 
+0xff, 0xff, 0xbb, 0x00, 0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x6c, 0x00, 
+0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x83, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xe3, 0x00, 0xff, 0xff, 0xb6, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0xb7, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0xfb, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xe4, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0xfa, 0x00, 
+0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x6f, 0x00, 
+0xff, 0xff, 0x9f, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xf6, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xa0, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x6b, 0x00, 
+0xff, 0xff, 0xf1, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0xec, 0x00, 
+0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0xf0, 0x00, 0xff, 0xff, 0x5a, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x96, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x58, 0x01, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x7d, 0x00, 0xff, 0xff, 0xdd, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x7e, 0x01, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x35, 0x01, 0xff, 0xff, 0xaf, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x00, 
+0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0x3f, 0x01, 0xff, 0xff, 0x42, 0x00, 
+0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xe4, 0x00, 
+0xff, 0xff, 0x91, 0x01, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0xdd, 0x00, 
+0xff, 0xff, 0x07, 0x02, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x16, 0x01, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0xdf, 0x00, 0xff, 0xff, 0x56, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xc7, 0x01, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0xcf, 0x00, 0xff, 0xff, 0x82, 0x00, 0xff, 0xff, 0x13, 0x01, 
+0xff, 0xff, 0x6c, 0x01, 0xff, 0xff, 0x8a, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0x61, 0x00, 
+0xff, 0xff, 0xbb, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x4b, 0x02, 
+0xff, 0xff, 0xb9, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xde, 0x00, 
+0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0xe1, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x94, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0xe2, 0x02, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x79, 0x00, 0xff, 0xff, 0x67, 0x00, 
+0xff, 0xff, 0x60, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x2e, 0x03, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0xd8, 0x00, 0xff, 0xff, 0x7d, 0x01, 
+0xff, 0xff, 0x12, 0x01, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xca, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xf5, 0x00, 
+0xff, 0xff, 0xd3, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xb3, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0xd2, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0xf4, 0x01, 0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0xee, 0x00, 
+0xff, 0xff, 0xfc, 0x00, 0xff, 0xff, 0x31, 0x01, 0xff, 0xff, 0x66, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x9a, 0x00, 
+0xff, 0xff, 0xde, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x08, 0x01, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x99, 0x00, 
+0xff, 0xff, 0xd2, 0x00, 0xff, 0xff, 0xde, 0x01, 0xff, 0xff, 0xcf, 0x00, 
+0xff, 0xff, 0x1a, 0x01, 0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0xcd, 0x02, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xb7, 0x00, 0xff, 0xff, 0xeb, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xc0, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0xe6, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0xbf, 0x01, 0xff, 0xff, 0xf7, 0x00, 0xff, 0xff, 0xcc, 0x00, 
+0xff, 0xff, 0xcb, 0x00, 0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xe8, 0x01, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x88, 0x00, 0xff, 0xff, 0x99, 0x01, 0xff, 0xff, 0xc8, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x7e, 0x00, 0xff, 0xff, 0x92, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x6d, 0x01, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x60, 0x00, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xf6, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x00, 
+0xff, 0xff, 0xba, 0x00, 0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0xb1, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x01, 
+0xff, 0xff, 0x14, 0x02, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x00, 
+0xff, 0xff, 0x8e, 0x00, 0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0xbc, 0x00, 
+0xff, 0xff, 0x0e, 0x02, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x99, 0x02, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x55, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x7e, 0x01, 0xff, 0xff, 0xa7, 0x00, 0xff, 0xff, 0x69, 0x00, 
+0xff, 0xff, 0x24, 0x01, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0xa8, 0x00, 0xff, 0xff, 0x1d, 0x02, 0xff, 0xff, 0xf6, 0x00, 
+0xff, 0xff, 0xc1, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x21, 0x02, 
+0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x6a, 0x01, 
+0xff, 0xff, 0xc4, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x3a, 0x01, 
+0xff, 0xff, 0xaa, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x6b, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0x77, 0x00, 
+0xff, 0xff, 0x39, 0x01, 0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xa0, 0x01, 0xff, 0xff, 0x33, 0x02, 
+0xff, 0xff, 0xb8, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x38, 0x02, 
+0xff, 0xff, 0xba, 0x00, 0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0xde, 0x01, 
+0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0x92, 0x00, 0xff, 0xff, 0xb8, 0x00, 
+0xff, 0xff, 0xb4, 0x00, 0xff, 0xff, 0x98, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x65, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x65, 0x01, 0xff, 0xff, 0x9a, 0x00, 0xff, 0xff, 0x62, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0xf4, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xd6, 0x01, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x4c, 0x02, 0xff, 0xff, 0x73, 0x01, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xb8, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x96, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x4d, 0x01, 
+0xff, 0xff, 0xa7, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x56, 0x02, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x5d, 0x02, 
+0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x19, 0x02, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xbd, 0x00, 0xff, 0xff, 0xee, 0x01, 0xff, 0xff, 0x61, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x07, 0x02, 
+0xff, 0xff, 0x73, 0x02, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0x5c, 0x00, 0xff, 0xff, 0x7b, 0x02, 0xff, 0xff, 0x34, 0x01, 
+0xff, 0xff, 0xac, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xb4, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0xbf, 0x00, 0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0xad, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x92, 0x00, 0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x8a, 0x02, 
+0xff, 0xff, 0xef, 0x00, 0xff, 0xff, 0x65, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x99, 0x00, 0xff, 0xff, 0x41, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x58, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x01, 0x01, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x9b, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0xb3, 0x02, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x78, 0x02, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x7c, 0x02, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xa2, 0x01, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0xff, 0x00, 
+0xff, 0xff, 0xcc, 0x02, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0xa7, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0xad, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xdd, 0x02, 0xff, 0xff, 0xdf, 0x02, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xe2, 0x02, 0xff, 0xff, 0xba, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x83, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xc1, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0xe1, 0x01, 0xff, 0xff, 0xfe, 0x00, 
+0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0xf9, 0x01, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xfc, 0x02, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x99, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x67, 0x00, 0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x8c, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x10, 0x03, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x54, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x35, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0xc0, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0xa7, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x09, 0x03, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x49, 0x00, 
+0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x86, 0x00, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xf4, 0x02, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xb2, 0x01, 0xff, 0xff, 0x73, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0xd0, 0x00, 
+0xff, 0xff, 0xae, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0xd5, 0x02, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x83, 0x00, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0xb0, 0x00, 0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0x87, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x97, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0xbe, 0x02, 0xff, 0xff, 0x41, 0x00, 
+0xff, 0xff, 0xa5, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x66, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x67, 0x01, 0xff, 0xff, 0x9f, 0x02, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xa2, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xec, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x41, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x87, 0x02, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xbe, 0x01, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x7a, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0xdb, 0x01, 0xff, 0xff, 0x6b, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x67, 0x00, 0xff, 0xff, 0x6f, 0x02, 
+0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x97, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0xa7, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x83, 0x00, 
+0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x00, 
+0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x6d, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x1a, 0x01, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x4a, 0x02, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x9c, 0x00, 0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0xdb, 0x01, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x32, 0x02, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x74, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x30, 0x02, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x2e, 0x02, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xdb, 0x00, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x41, 0x00, 
+0xff, 0xff, 0xec, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x70, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x6b, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0xab, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0xcc, 0x01, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x0f, 0x01, 0xff, 0xff, 0xb7, 0x01, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x71, 0x00, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x3f, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xbd, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x05, 0x02, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x05, 0x02, 
+0xff, 0xff, 0xcc, 0x00, 0xff, 0xff, 0x27, 0x03, 0xff, 0xff, 0x02, 0x02, 
+0xff, 0xff, 0x2a, 0x01, 0xff, 0xff, 0x0e, 0x01, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0xe0, 0x01, 0xff, 0xff, 0x3c, 0x00, 
+0xff, 0xff, 0x28, 0x01, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0xf4, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x94, 0x00, 
+0xff, 0xff, 0x4e, 0x01, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x24, 0x01, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x9c, 0x01, 0xff, 0xff, 0x9e, 0x00, 
+0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x91, 0x00, 0xff, 0xff, 0xc6, 0x01, 
+0xff, 0xff, 0xe0, 0x01, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0xc0, 0x01, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0xda, 0x01, 0xff, 0xff, 0xac, 0x01, 0xff, 0xff, 0xaf, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x7d, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x2a, 0x01, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0x2c, 0x03, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x53, 0x02, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0xad, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x62, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0xa4, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x87, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0xc5, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0xb1, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0xea, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xaa, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x55, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x6c, 0x00, 
+0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x12, 0x01, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x81, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xac, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x4f, 0x01, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x38, 0x03, 0xff, 0xff, 0xbf, 0x00, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x3b, 0x03, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x16, 0x01, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x2e, 0x01, 0xff, 0xff, 0x1d, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x18, 0x03, 
+0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xab, 0x00, 
+0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x2c, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x4e, 0x03, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0xe7, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0xa4, 0x00, 0xff, 0xff, 0x9b, 0x01, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0e, 0x02, 0xff, 0xff, 0x42, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x58, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x60, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0x5e, 0x03, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x4d, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xc2, 0x00, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x65, 0x03, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x95, 0x01, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x30, 0x00, 
+0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0xa8, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x6a, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x6c, 0x03, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x03, 0xff, 0xff, 0x41, 0x00, 
+0xff, 0xff, 0xdf, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x83, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x11, 0x01, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xf2, 0x02, 0xff, 0xff, 0xf6, 0x00, 
+0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x5b, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0xba, 0x00, 0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x8e, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0xfa, 0x01, 0xff, 0xff, 0x8b, 0x01, 
+0xff, 0xff, 0xc8, 0x00, 0xff, 0xff, 0x8a, 0x02, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x9c, 0x03, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x7c, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xa4, 0x03, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xdd, 0x02, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0xb1, 0x03, 0xff, 0xff, 0xb2, 0x03, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x8a, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x7e, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x91, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x84, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x5b, 0x00, 
+0xff, 0xff, 0x88, 0x00, 0xff, 0xff, 0xcf, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xa5, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0x6c, 0x01, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x5e, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x52, 0x00, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x51, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0xba, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0xfa, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xe3, 0x00, 0xff, 0xff, 0x42, 0x00, 
+0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x7e, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0xf2, 0x03, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7b, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xaf, 0x03, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x27, 0x02, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x79, 0x00, 
+0xff, 0xff, 0x7f, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x10, 0x04, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x6b, 0x00, 
+0xff, 0xff, 0x7a, 0x02, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0xb0, 0x03, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x74, 0x01, 
+0xff, 0xff, 0x86, 0x01, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x60, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0xa8, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x4e, 0x04, 
+0xff, 0xff, 0x60, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0xff, 0x01, 0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x56, 0x04, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x9a, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x60, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x83, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x80, 0x00, 
+0xff, 0xff, 0x27, 0x01, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x7b, 0x02, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0x21, 0x04, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x0c, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0xc5, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x6d, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0xbf, 0x03, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0xa9, 0x03, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x02, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x27, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0xf7, 0x00, 
+0xff, 0xff, 0x74, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x30, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0xc1, 0x00, 0xff, 0xff, 0x49, 0x03, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xac, 0x00, 
+0xff, 0xff, 0x9a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x6a, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x68, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xb1, 0x01, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x92, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0xb9, 0x00, 
+0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0xcc, 0x01, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x3f, 0x01, 0xff, 0xff, 0x90, 0x00, 0xff, 0xff, 0x91, 0x00, 
+0xff, 0xff, 0x01, 0x01, 0xff, 0xff, 0x7f, 0x00, 0xff, 0xff, 0x61, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x00, 0xff, 0xff, 0x6e, 0x01, 
+0xff, 0xff, 0x3b, 0x01, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xae, 0x01, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0xe6, 0x01, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x35, 0x02, 0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0x41, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x01, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x60, 0x01, 0xff, 0xff, 0x9d, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x80, 0x02, 
+0xff, 0xff, 0x1f, 0x01, 0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x96, 0x02, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x4c, 0x02, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0xff, 0x02, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x2e, 0x01, 0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x4d, 0x00, 
+0xff, 0xff, 0x20, 0x01, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x1f, 0x03, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x45, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0xf0, 0x02, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0xae, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x75, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xc8, 0x02, 0xff, 0xff, 0xb8, 0x02, 0xff, 0xff, 0x78, 0x00, 
+0xff, 0xff, 0x6d, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x99, 0x02, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0xb7, 0x01, 0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x4f, 0x00, 
+0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x79, 0x01, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x02, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x88, 0x00, 0xff, 0xff, 0x0e, 0x01, 0xff, 0xff, 0x6b, 0x00, 
+0xff, 0xff, 0x9a, 0x00, 0xff, 0xff, 0xc5, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0x12, 0x02, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xc2, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0xf3, 0x01, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x84, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0xb0, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xf3, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0xec, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xd7, 0x00, 0xff, 0xff, 0xd9, 0x00, 
+0xff, 0xff, 0x9f, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x96, 0x00, 
+0xff, 0xff, 0xdd, 0x01, 0xff, 0xff, 0xd1, 0x00, 0xff, 0xff, 0x4f, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x34, 0x01, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xe1, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x61, 0x02, 0xff, 0xff, 0xa3, 0x00, 
+0xff, 0xff, 0xd2, 0x02, 0xff, 0xff, 0xf9, 0x00, 0xff, 0xff, 0x96, 0x00, 
+0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xd8, 0x00, 0xff, 0xff, 0x89, 0x00, 
+0xff, 0xff, 0xfe, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x21, 0x03, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xda, 0x01, 0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x4a, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xac, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x77, 0x03, 
+0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x09, 0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x9d, 0x00, 
+0xff, 0xff, 0x33, 0x01, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0xa6, 0x03, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x10, 0x01, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x5f, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x33, 0x02, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x21, 0x01, 0xff, 0xff, 0xd3, 0x00, 
+0xff, 0xff, 0xd2, 0x03, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0xbf, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x9d, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x00, 0x04, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0x63, 0x01, 0xff, 0xff, 0x16, 0x04, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x25, 0x04, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x34, 0x04, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x82, 0x00, 0xff, 0xff, 0x53, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x9c, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x48, 0x00, 
+0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xb8, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x71, 0x01, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0xae, 0x00, 0xff, 0xff, 0x6d, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x1f, 0x01, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x4f, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x62, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0xc2, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xb5, 0x00, 
+0xff, 0xff, 0x04, 0x04, 0xff, 0xff, 0x28, 0x01, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0xef, 0x03, 0xff, 0xff, 0x47, 0x01, 0xff, 0xff, 0xdb, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0xb2, 0x00, 0xff, 0xff, 0xa3, 0x01, 
+0xff, 0xff, 0x6f, 0x03, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0xe0, 0x03, 0xff, 0xff, 0xf1, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x4c, 0x01, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xdf, 0x02, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xd0, 0x00, 0xff, 0xff, 0x46, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x22, 0x01, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0x91, 0x00, 0xff, 0xff, 0xb6, 0x03, 0xff, 0xff, 0xb5, 0x03, 
+0xff, 0xff, 0xcb, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x7f, 0x00, 0xff, 0xff, 0x86, 0x00, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0x50, 0x03, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xea, 0x01, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x69, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0xb5, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0xa3, 0x00, 
+0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0x7d, 0x03, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0xf9, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x30, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x0b, 0x01, 
+0xff, 0xff, 0xae, 0x00, 0xff, 0xff, 0xf6, 0x00, 0xff, 0xff, 0x3a, 0x00, 
+0xff, 0xff, 0x08, 0x01, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x30, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x7f, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x8f, 0x00, 0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0xc6, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x4a, 0x03, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x52, 0x00, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xc4, 0x00, 0xff, 0xff, 0xf9, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0xaf, 0x00, 
+0xff, 0xff, 0xc8, 0x01, 0xff, 0xff, 0xae, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x89, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0xb0, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x92, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x7c, 0x00, 
+0xff, 0xff, 0x9c, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0xb6, 0x01, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x64, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0xb0, 0x01, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0xa7, 0x00, 0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0xaf, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x76, 0x00, 
+0xff, 0xff, 0xe2, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x0a, 0x01, 
+0xff, 0xff, 0xda, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0xd6, 0x00, 
+0xff, 0xff, 0x84, 0x00, 0xff, 0xff, 0x7d, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x57, 0x01, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x9a, 0x02, 
+0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0xb6, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x7e, 0x00, 
+0xff, 0xff, 0x18, 0x01, 0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x2a, 0x01, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x52, 0x00, 
+0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0xce, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0x85, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x94, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0xe2, 0x01, 0xff, 0xff, 0xe3, 0x01, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xcc, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0xa5, 0x02, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0xc0, 0x00, 
+0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x01, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xda, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x48, 0x00, 
+0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x80, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x20, 0x02, 0xff, 0xff, 0x21, 0x01, 
+0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x4c, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0xfd, 0x00, 0xff, 0xff, 0x71, 0x01, 0xff, 0xff, 0xbf, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x7b, 0x00, 
+0xff, 0xff, 0x74, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x40, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x01, 
+0xff, 0xff, 0x82, 0x00, 0xff, 0xff, 0xe4, 0x00, 0xff, 0xff, 0x4c, 0x00, 
+0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x64, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x6c, 0x02, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x72, 0x02, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0xcc, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xd7, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x9d, 0x00, 0xff, 0xff, 0xb1, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x94, 0x00, 
+0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x72, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xc2, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x8e, 0x00, 0xff, 0xff, 0x54, 0x01, 0xff, 0xff, 0x32, 0x01, 
+0xff, 0xff, 0xa5, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x71, 0x00, 
+0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x58, 0x02, 
+0xff, 0xff, 0xf5, 0x02, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xf4, 0x01, 
+0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x2f, 0x02, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x8d, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0xa6, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0xa8, 0x00, 
+0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x08, 0x03, 0xff, 0xff, 0xfb, 0x02, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0xf2, 0x02, 0xff, 0xff, 0x2a, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xcb, 0x00, 0xff, 0xff, 0x71, 0x02, 
+0xff, 0xff, 0xe4, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xef, 0x00, 
+0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0xd4, 0x02, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0xbd, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0xaa, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0xad, 0x01, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x64, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x63, 0x01, 
+0xff, 0xff, 0xa1, 0x02, 0xff, 0xff, 0xc3, 0x00, 0xff, 0xff, 0x97, 0x02, 
+0xff, 0xff, 0x92, 0x02, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xb7, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x85, 0x02, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x95, 0x01, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xe2, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x62, 0x02, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x5c, 0x01, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x94, 0x00, 0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x1e, 0x02, 0xff, 0xff, 0xd3, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x10, 0x02, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x0f, 0x02, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x4e, 0x01, 0xff, 0xff, 0x51, 0x01, 0xff, 0xff, 0x78, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x26, 0x01, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0xf2, 0x00, 0xff, 0xff, 0xf8, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0xf0, 0x01, 0xff, 0xff, 0xed, 0x00, 0xff, 0xff, 0x38, 0x02, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x95, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x41, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x30, 0x00, 0xff, 0xff, 0xc1, 0x01, 0xff, 0xff, 0xd9, 0x01, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x78, 0x01, 
+0xff, 0xff, 0xd4, 0x01, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x59, 0x00, 0xff, 0xff, 0x84, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x00, 0xff, 0xff, 0x83, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x60, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0xbc, 0x01, 0xff, 0xff, 0x8f, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x35, 0x03, 
+0xff, 0xff, 0x6a, 0x01, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x28, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x33, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0xe9, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x7a, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x9b, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x0b, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xc4, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x55, 0x00, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0xa6, 0x01, 0xff, 0xff, 0x29, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x28, 0x01, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0xf2, 0x01, 0xff, 0xff, 0x74, 0x03, 
+0xff, 0xff, 0x7a, 0x03, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xf1, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xa3, 0x03, 0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0xa8, 0x03, 0xff, 0xff, 0xae, 0x03, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0xc5, 0x03, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xad, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0xb3, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x71, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x62, 0x00, 
+0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0xa4, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x71, 0x00, 
+0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0xa1, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x13, 0x04, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x48, 0x03, 
+0xff, 0xff, 0x1f, 0x04, 0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x42, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x4a, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x47, 0x04, 
+0xff, 0xff, 0x51, 0x04, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x90, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x95, 0x01, 
+0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x54, 0x00, 
+0xff, 0xff, 0xa2, 0x03, 0xff, 0xff, 0xcd, 0x00, 0xff, 0xff, 0x2b, 0x01, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x83, 0x01, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x1c, 0x01, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x75, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xd5, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x5e, 0x00, 0xff, 0xff, 0xcc, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x13, 0x02, 0xff, 0xff, 0x7a, 0x02, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0xc9, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x70, 0x00, 0xff, 0xff, 0x48, 0x00, 
+0xff, 0xff, 0x9f, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0xd5, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x3d, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x02, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x2b, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x0e, 0x03, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x83, 0x01, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0xaa, 0x02, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0xbe, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0xe1, 0x01, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x5e, 0x02, 0xff, 0xff, 0xb5, 0x01, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xaf, 0x00, 0xff, 0xff, 0x9f, 0x02, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x57, 0x01, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0xc8, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x01, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x6c, 0x02, 0xff, 0xff, 0xcb, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x2b, 0x01, 
+0xff, 0xff, 0xdc, 0x00, 0xff, 0xff, 0xbd, 0x02, 0xff, 0xff, 0x7a, 0x00, 
+0xff, 0xff, 0x71, 0x00, 0xff, 0xff, 0xff, 0x00, 0xff, 0xff, 0x96, 0x02, 
+0xff, 0xff, 0xae, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x59, 0x01, 0xff, 0xff, 0x32, 0x00, 
+0xff, 0xff, 0xfd, 0x02, 0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x68, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xd6, 0x01, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x01, 
+0xff, 0xff, 0x99, 0x00, 0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x86, 0x02, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xe1, 0x00, 
+0xff, 0xff, 0xfb, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x12, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2d, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xd6, 0x00, 0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x3a, 0x01, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x5b, 0x01, 0xff, 0xff, 0x38, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xcd, 0x03, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0xd1, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x08, 0x01, 0xff, 0xff, 0x1a, 0x01, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0x2a, 0x04, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x1b, 0x04, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x57, 0x04, 
+0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x3e, 0x04, 0xff, 0xff, 0x75, 0x01, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x23, 0x04, 0xff, 0xff, 0x7b, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x6a, 0x00, 0xff, 0xff, 0xe9, 0x03, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x3f, 0x01, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x99, 0x01, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x9d, 0x00, 0xff, 0xff, 0xda, 0x01, 
+0xff, 0xff, 0x67, 0x00, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x71, 0x00, 
+0xff, 0xff, 0xfa, 0x02, 0xff, 0xff, 0xaf, 0x00, 0xff, 0xff, 0xcd, 0x00, 
+0xff, 0xff, 0xbf, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0xdb, 0x00, 0xff, 0xff, 0x7b, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x5a, 0x00, 
+0xff, 0xff, 0x90, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x5d, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x00, 0xff, 0xff, 0x6a, 0x00, 
+0xff, 0xff, 0x84, 0x02, 0xff, 0xff, 0x54, 0x00, 0xff, 0xff, 0x03, 0x01, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x7a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x71, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0xc6, 0x01, 0xff, 0xff, 0x9e, 0x02, 0xff, 0xff, 0x81, 0x02, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x4c, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x25, 0x00, 
+0xff, 0xff, 0xe2, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x8e, 0x00, 0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x7c, 0x00, 
+0xff, 0xff, 0x7c, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xc3, 0x02, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x74, 0x01, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x6a, 0x02, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x9a, 0x02, 0xff, 0xff, 0xe5, 0x03, 
+0xff, 0xff, 0x72, 0x01, 0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x38, 0x00, 0xff, 0xff, 0x94, 0x03, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x5a, 0x04, 
+0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x75, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x81, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x68, 0x01, 
+0xff, 0xff, 0xb3, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x51, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0xe1, 0x00, 0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0xb2, 0x00, 
+0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0xc8, 0x03, 0xff, 0xff, 0x7e, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0xe7, 0x01, 0xff, 0xff, 0xac, 0x03, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x94, 0x03, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x8d, 0x01, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x44, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x91, 0x01, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xf5, 0x01, 
+0xff, 0xff, 0x6b, 0x03, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x01, 0xff, 0xff, 0xd0, 0x02, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x01, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x32, 0x00, 0xff, 0xff, 0x0b, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0xa7, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x00, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x6d, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0xbf, 0x01, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0xc6, 0x01, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0xc9, 0x01, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x94, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xf4, 0x00, 
+0xff, 0xff, 0xb3, 0x00, 0xff, 0xff, 0xdc, 0x01, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x72, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xee, 0x01, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x0b, 0x02, 
+0xff, 0xff, 0x77, 0x00, 0xff, 0xff, 0x6e, 0x00, 0xff, 0xff, 0x5d, 0x00, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x41, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x58, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x6f, 0x01, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x01, 0xff, 0xff, 0x87, 0x00, 
+0xff, 0xff, 0x53, 0x02, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x2f, 0x01, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x87, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x5f, 0x01, 0xff, 0xff, 0x3f, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x48, 0x01, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x8b, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x56, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xf6, 0x02, 0xff, 0xff, 0x39, 0x00, 
+0xff, 0xff, 0x00, 0x03, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x1d, 0x03, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x18, 0x03, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x16, 0x03, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xfa, 0x02, 0xff, 0xff, 0x6f, 0x00, 
+0xff, 0xff, 0x2a, 0x00, 0xff, 0xff, 0x8d, 0x00, 0xff, 0xff, 0x87, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0xe5, 0x02, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0xaa, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x3d, 0x00, 
+0xff, 0xff, 0x20, 0x02, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x9c, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xe1, 0x01, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x82, 0x02, 0xff, 0xff, 0xbf, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0x63, 0x02, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x63, 0x00, 0xff, 0xff, 0x3f, 0x00, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x8d, 0x00, 0xff, 0xff, 0x22, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x19, 0x01, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0x23, 0x02, 0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xbf, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x8b, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x60, 0x00, 
+0xff, 0xff, 0x51, 0x00, 0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x30, 0x00, 
+0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0xad, 0x00, 0xff, 0xff, 0xc9, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x66, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x9f, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0xc0, 0x00, 0xff, 0xff, 0x34, 0x00, 
+0xff, 0xff, 0x79, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x56, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x45, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x47, 0x01, 0xff, 0xff, 0x99, 0x02, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xa0, 0x01, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0xb8, 0x01, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x5d, 0x00, 0xff, 0xff, 0x07, 0x01, 
+0xff, 0xff, 0x62, 0x00, 0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xa7, 0x00, 0xff, 0xff, 0x4f, 0x00, 
+0xff, 0xff, 0x8c, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0xc5, 0x02, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xa2, 0x01, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x88, 0x00, 0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x56, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x86, 0x00, 0xff, 0xff, 0x8f, 0x00, 
+0xff, 0xff, 0x5a, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x62, 0x03, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x9c, 0x02, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0xc2, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x7d, 0x00, 
+0xff, 0xff, 0x7e, 0x03, 0xff, 0xff, 0x01, 0x01, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x5b, 0x00, 
+0xff, 0xff, 0x4e, 0x00, 0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x95, 0x00, 0xff, 0xff, 0xe1, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0xf6, 0x00, 
+0xff, 0xff, 0x25, 0x01, 0xff, 0xff, 0xc2, 0x02, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0xb7, 0x00, 0xff, 0xff, 0x9d, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x9a, 0x03, 
+0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x84, 0x00, 0xff, 0xff, 0x37, 0x00, 
+0xff, 0xff, 0xc1, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0xab, 0x03, 0xff, 0xff, 0x3c, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0xa4, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xd9, 0x01, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0xbb, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x96, 0x03, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0xe1, 0x01, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0xc7, 0x03, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0xcc, 0x03, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xd8, 0x03, 0xff, 0xff, 0xdf, 0x03, 
+0xff, 0xff, 0x2a, 0x03, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xa9, 0x00, 
+0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x79, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x7b, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x57, 0x03, 0xff, 0xff, 0x91, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x7b, 0x00, 0xff, 0xff, 0x6b, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x02, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x80, 0x01, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0xb8, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1e, 0x01, 0xff, 0xff, 0x46, 0x01, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x36, 0x04, 0xff, 0xff, 0x7e, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x30, 0x04, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x53, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x6d, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x42, 0x00, 0xff, 0xff, 0x1e, 0x04, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0xbc, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xfe, 0x02, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0xdb, 0x03, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x5a, 0x01, 
+0xff, 0xff, 0xfb, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0xc1, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0xf7, 0x03, 0xff, 0xff, 0x82, 0x00, 0xff, 0xff, 0x08, 0x01, 
+0xff, 0xff, 0xce, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x49, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x79, 0x01, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0xbd, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x07, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x2c, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x49, 0x00, 
+0xff, 0xff, 0x80, 0x00, 0xff, 0xff, 0x20, 0x01, 0xff, 0xff, 0x81, 0x00, 
+0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x76, 0x00, 
+0xff, 0xff, 0x3f, 0x01, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0x85, 0x01, 
+0xff, 0xff, 0xc0, 0x03, 0xff, 0xff, 0x3e, 0x01, 0xff, 0xff, 0x47, 0x00, 
+0xff, 0xff, 0xca, 0x00, 0xff, 0xff, 0x8c, 0x00, 0xff, 0xff, 0xe4, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x3b, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x99, 0x01, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0xb2, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x2c, 0x01, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0xc7, 0x00, 0xff, 0xff, 0x74, 0x00, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x8c, 0x01, 0xff, 0xff, 0x81, 0x01, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0xb4, 0x01, 0xff, 0xff, 0xd2, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xba, 0x00, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x76, 0x02, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x15, 0x01, 0xff, 0xff, 0x5c, 0x00, 
+0xff, 0xff, 0xce, 0x00, 0xff, 0xff, 0x13, 0x01, 0xff, 0xff, 0x51, 0x00, 
+0xff, 0xff, 0x12, 0x01, 0xff, 0xff, 0x4f, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x46, 0x00, 0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0xd3, 0x01, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0xd3, 0x00, 0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x14, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x00, 0x01, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x78, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x0f, 0x02, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1a, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x8e, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xd1, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x8d, 0x00, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x3f, 0x03, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x36, 0x00, 
+0xff, 0xff, 0x44, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xaa, 0x01, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xbd, 0x00, 
+0xff, 0xff, 0xf8, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x13, 0x01, 0xff, 0xff, 0x9f, 0x01, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x01, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x70, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xe0, 0x00, 0xff, 0xff, 0xb9, 0x01, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x67, 0x00, 
+0xff, 0xff, 0x66, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x00, 
+0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0xbd, 0x01, 0xff, 0xff, 0xa2, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x4a, 0x00, 0xff, 0xff, 0x4f, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0xc5, 0x01, 0xff, 0xff, 0xd4, 0x00, 0xff, 0xff, 0x4c, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x58, 0x00, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0xbd, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x00, 
+0xff, 0xff, 0x90, 0x01, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x22, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0xde, 0x00, 0xff, 0xff, 0x4b, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0xb2, 0x00, 
+0xff, 0xff, 0x9f, 0x01, 0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0x7a, 0x00, 
+0xff, 0xff, 0xbb, 0x00, 0xff, 0xff, 0xd6, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x90, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x00, 0xff, 0xff, 0x55, 0x01, 
+0xff, 0xff, 0xf4, 0x01, 0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x2d, 0x01, 0xff, 0xff, 0xd4, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x01, 
+0xff, 0xff, 0xe5, 0x01, 0xff, 0xff, 0xc7, 0x00, 0xff, 0xff, 0xf5, 0x00, 
+0xff, 0xff, 0x78, 0x00, 0xff, 0xff, 0x10, 0x01, 0xff, 0xff, 0x86, 0x00, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x0d, 0x02, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x1d, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0xa7, 0x00, 0xff, 0xff, 0xe8, 0x00, 
+0xff, 0xff, 0x32, 0x01, 0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x37, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x22, 0x02, 0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x5b, 0x01, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x4d, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x1f, 0x00, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x1b, 0x00, 
+0xff, 0xff, 0x5f, 0x01, 0xff, 0xff, 0x3b, 0x00, 0xff, 0xff, 0x82, 0x00, 
+0xff, 0xff, 0xc1, 0x01, 0xff, 0xff, 0x41, 0x01, 0xff, 0xff, 0x3b, 0x02, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x53, 0x00, 0xff, 0xff, 0x03, 0x01, 
+0xff, 0xff, 0x28, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x0e, 0x00, 
+0xff, 0xff, 0x0d, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x2e, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0xa3, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0x49, 0x01, 
+0xff, 0xff, 0x5b, 0x02, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x74, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x4b, 0x00, 
+0xff, 0xff, 0x6a, 0x02, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x77, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x76, 0x02, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x3a, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x5e, 0x01, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x84, 0x02, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x26, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x93, 0x02, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x9b, 0x02, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x56, 0x00, 
+0xff, 0xff, 0xe5, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x39, 0x01, 
+0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x5a, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x67, 0x00, 0xff, 0xff, 0x8c, 0x01, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x42, 0x01, 
+0xff, 0xff, 0x68, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0xbf, 0x02, 
+0xff, 0xff, 0x85, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xc6, 0x02, 0xff, 0xff, 0x93, 0x00, 
+0xff, 0xff, 0x61, 0x00, 0xff, 0xff, 0xca, 0x01, 0xff, 0xff, 0x4c, 0x02, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x1d, 0x00, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x01, 
+0xff, 0xff, 0x74, 0x00, 0xff, 0xff, 0x94, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xea, 0x02, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0xd5, 0x01, 0xff, 0xff, 0xac, 0x00, 0xff, 0xff, 0x67, 0x00, 
+0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x25, 0x00, 0xff, 0xff, 0x50, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x84, 0x00, 
+0xff, 0xff, 0x12, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x13, 0x00, 
+0xff, 0xff, 0x05, 0x03, 0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x2a, 0x01, 0xff, 0xff, 0x31, 0x01, 0xff, 0xff, 0xff, 0xff, 
+0xff, 0xff, 0x6f, 0x00, 0xff, 0xff, 0xef, 0x00, 0xff, 0xff, 0x59, 0x00, 
+0xff, 0xff, 0x1e, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x14, 0x03, 0xff, 0xff, 0x19, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x1f, 0x02, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x5d, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x35, 0x00, 0xff, 0xff, 0x02, 0x03, 
+0xff, 0xff, 0x64, 0x00, 0xff, 0xff, 0xa5, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0xf0, 0x02, 0xff, 0xff, 0x56, 0x00, 
+0xff, 0xff, 0xa0, 0x02, 0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0xc9, 0x01, 0xff, 0xff, 0xe3, 0x02, 
+0xff, 0xff, 0x17, 0x00, 0xff, 0xff, 0x8a, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x84, 0x00, 0xff, 0xff, 0x2b, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xbc, 0x02, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x09, 0x00, 
+0xff, 0xff, 0x60, 0x01, 0xff, 0xff, 0x99, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x9d, 0x02, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xd8, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x90, 0x02, 0xff, 0xff, 0x23, 0x00, 
+0xff, 0xff, 0xce, 0x00, 0xff, 0xff, 0x27, 0x00, 0xff, 0xff, 0x46, 0x00, 
+0xff, 0xff, 0x93, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x65, 0x02, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x16, 0x00, 
+0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0xa6, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x49, 0x02, 
+0xff, 0xff, 0x54, 0x00, 0xff, 0xff, 0x0b, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x6a, 0x00, 
+0xff, 0xff, 0x73, 0x00, 0xff, 0xff, 0x36, 0x00, 0xff, 0xff, 0x15, 0x00, 
+0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0x2c, 0x02, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x27, 0x02, 0xff, 0xff, 0x20, 0x00, 
+0xff, 0xff, 0xb7, 0x01, 0xff, 0xff, 0xa1, 0x00, 0xff, 0xff, 0x95, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x2d, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x96, 0x01, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0xdc, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x10, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x97, 0x00, 
+0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0xa6, 0x01, 
+0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x4f, 0x01, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x3d, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0xd4, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x1b, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0xaa, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x88, 0x00, 0xff, 0xff, 0x43, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x55, 0x00, 
+0xff, 0xff, 0x5e, 0x01, 0xff, 0xff, 0x6f, 0x01, 0xff, 0xff, 0x40, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xd5, 0x01, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x82, 0x00, 
+0xff, 0xff, 0x3e, 0x00, 0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x21, 0x00, 
+0xff, 0xff, 0xa4, 0x00, 0xff, 0xff, 0x34, 0x00, 0xff, 0xff, 0x1f, 0x00, 
+0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x56, 0x01, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0xcc, 0x01, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0xab, 0x01, 
+0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x26, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x72, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x23, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x50, 0x00, 
+0xff, 0xff, 0x5b, 0x00, 0xff, 0xff, 0x0e, 0x00, 0xff, 0xff, 0x6b, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x83, 0x00, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0xc9, 0x00, 0xff, 0xff, 0x0f, 0x00, 0xff, 0xff, 0x6a, 0x00, 
+0xff, 0xff, 0x09, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xa4, 0x01, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x04, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0x29, 0x00, 0xff, 0xff, 0x88, 0x00, 0xff, 0xff, 0xb5, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x75, 0x02, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0x79, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xde, 0x00, 0xff, 0xff, 0x70, 0x01, 
+0xff, 0xff, 0x45, 0x00, 0xff, 0xff, 0x0d, 0x00, 0xff, 0xff, 0xe9, 0x00, 
+0xff, 0xff, 0x4f, 0x03, 0xff, 0xff, 0x63, 0x01, 0xff, 0xff, 0x17, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0xab, 0x00, 0xff, 0xff, 0x11, 0x00, 
+0xff, 0xff, 0x24, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x1e, 0x00, 
+0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0x03, 0x00, 0xff, 0xff, 0x52, 0x00, 0xff, 0xff, 0x29, 0x00, 
+0xff, 0xff, 0x16, 0x00, 0xff, 0xff, 0x47, 0x00, 0xff, 0xff, 0x0a, 0x00, 
+0xff, 0xff, 0xcd, 0x00, 0xff, 0xff, 0x1a, 0x00, 0xff, 0xff, 0x2c, 0x00, 
+0xff, 0xff, 0xf9, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x19, 0x00, 
+0xff, 0xff, 0x22, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x3e, 0x00, 
+0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x1c, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x18, 0x00, 0xff, 0xff, 0xac, 0x00, 0xff, 0xff, 0x3a, 0x02, 
+0xff, 0xff, 0x90, 0x01, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x01, 
+0xff, 0xff, 0x2f, 0x00, 0xff, 0xff, 0x48, 0x00, 0xff, 0xff, 0xda, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x3b, 0x02, 
+0xff, 0xff, 0x20, 0x00, 0xff, 0xff, 0x84, 0x00, 0xff, 0xff, 0x02, 0x00, 
+0xff, 0xff, 0x21, 0x00, 0xff, 0xff, 0x5f, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x08, 0x00, 0xff, 0xff, 0xd5, 0x01, 
+0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x57, 0x00, 
+0xff, 0xff, 0x2d, 0x00, 0xff, 0xff, 0x6c, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x1e, 0x00, 0xff, 0xff, 0x2e, 0x00, 0xff, 0xff, 0xd5, 0x01, 
+0xff, 0xff, 0x13, 0x00, 0xff, 0xff, 0x4c, 0x00, 0xff, 0xff, 0x12, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x31, 0x00, 0xff, 0xff, 0x0d, 0x00, 
+0xff, 0xff, 0x06, 0x00, 0xff, 0xff, 0x86, 0x01, 0xff, 0xff, 0x04, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x03, 0xff, 0xff, 0x1c, 0x00, 
+0xff, 0xff, 0x3f, 0x00, 0xff, 0xff, 0x33, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x89, 0x02, 0xff, 0xff, 0x18, 0x00, 
+0xff, 0xff, 0x40, 0x00, 0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0xe7, 0x03, 0xff, 0xff, 0x24, 0x00, 
+0xff, 0xff, 0x43, 0x00, 0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x01, 0x00, 
+0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0xfa, 0x03, 0xff, 0xff, 0x31, 0x00, 
+0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x10, 0x00, 0xff, 0xff, 0x06, 0x00, 
+0xff, 0xff, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x04, 0xff, 0xff, 0x44, 0x00, 
+0xff, 0xff, 0x02, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x52, 0x00, 
+0xff, 0xff, 0x0a, 0x00, 0xff, 0xff, 0x05, 0x00, 0xff, 0xff, 0x2f, 0x00, 
+0xff, 0xff, 0x76, 0x00, 0xff, 0xff, 0x57, 0x00, 0xff, 0xff, 0x05, 0x00, 
+0xff, 0xff, 0x14, 0x00, 0xff, 0xff, 0x39, 0x00, 0xff, 0xff, 0x03, 0x00, 
+0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x15, 0x00, 0xff, 0xff, 0x00, 0x00, 
+0xff, 0xff, 0x01, 0x00, 0xff, 0xff, 0x07, 0x00, 0xff, 0xff, 0x40, 0x04, 
+0xff, 0xff, 0x44, 0x04, 0xff, 0xff, 0x11, 0x00, 0xff, 0xff, 0x08, 0x00, 
+0xff, 0xff, 0x86, 0x00, 0xff, 0xff, 0x0c, 0x00, 0xff, 0xff, 0x54, 0x04, 
+0x2e, 0x01, 0x73, 0x16, 0x95, 0x00, 0xd7, 0x17, 0x0a, 0x01, 0xc3, 0x0a, 
+0x81, 0x11, 0x90, 0x00, 0x1e, 0x0a, 0xa4, 0x09, 0x49, 0x0a, 0xcb, 0x08, 
+0x1a, 0x01, 0xb1, 0x00, 0xe9, 0x09, 0xa8, 0x0d, 0x22, 0x0e, 0xa9, 0x0c, 
+0xd7, 0x07, 0xa5, 0x09, 0x41, 0x06, 0xae, 0x10, 0xbf, 0x0b, 0xa5, 0x09, 
+0xe6, 0x09, 0xb2, 0x00, 0x41, 0x0a, 0xe9, 0x08, 0x16, 0x01, 0xe2, 0x07, 
+0x01, 0x01, 0xf4, 0x00, 0xfd, 0x15, 0xcc, 0x0c, 0xe8, 0x00, 0xeb, 0x00, 
+0x9e, 0x08, 0xfe, 0x00, 0xab, 0x06, 0xd2, 0x11, 0xce, 0x06, 0x81, 0x10, 
+0xe4, 0x00, 0x1b, 0x07, 0x45, 0x06, 0x96, 0x0f, 0xdd, 0x00, 0xaa, 0x09, 
+0x89, 0x0c, 0xdb, 0x09, 0xe6, 0x00, 0x39, 0x0a, 0xff, 0x00, 0x1b, 0x1b, 
+0x30, 0x0b, 0xf9, 0x0b, 0xc4, 0x06, 0x5b, 0x08, 0x17, 0x0c, 0x40, 0x01, 
+0x6e, 0x13, 0x1a, 0x0b, 0x54, 0x00, 0x99, 0x11, 0x26, 0x07, 0x23, 0x0f, 
+0x31, 0x00, 0x96, 0x0a, 0x94, 0x11, 0xf8, 0x00, 0xbb, 0x0f, 0x69, 0x0f, 
+0x6f, 0x0c, 0x23, 0x08, 0xdc, 0x09, 0x12, 0x0b, 0x2c, 0x12, 0x1c, 0x0b, 
+0x5f, 0x06, 0xfa, 0x0a, 0x46, 0x06, 0xc9, 0x0f, 0x21, 0x09, 0x56, 0x00, 
+0xb6, 0x0c, 0xdd, 0x17, 0x5c, 0x0b, 0xef, 0x07, 0x0e, 0x19, 0xe1, 0x00, 
+0xf5, 0x07, 0xaa, 0x09, 0x7d, 0x0b, 0x12, 0x01, 0x3d, 0x01, 0x4c, 0x06, 
+0x5b, 0x0c, 0xb1, 0x00, 0x29, 0x0b, 0xb6, 0x0e, 0x17, 0x13, 0x31, 0x00, 
+0x87, 0x17, 0x1d, 0x01, 0xb1, 0x0c, 0xd9, 0x00, 0xd3, 0x09, 0x96, 0x07, 
+0xbc, 0x16, 0x33, 0x01, 0xbe, 0x00, 0x2a, 0x0a, 0xb3, 0x00, 0xb3, 0x16, 
+0xf9, 0x0a, 0x29, 0x01, 0x18, 0x0c, 0x2c, 0x0a, 0x9f, 0x08, 0x9d, 0x0c, 
+0x53, 0x0b, 0x1a, 0x01, 0x71, 0x0d, 0xb4, 0x15, 0x0c, 0x00, 0x78, 0x00, 
+0x91, 0x00, 0xd0, 0x0a, 0x60, 0x06, 0xa8, 0x0c, 0xa7, 0x0c, 0xbf, 0x09, 
+0x27, 0x08, 0x96, 0x1b, 0x08, 0x0d, 0xc4, 0x0a, 0x16, 0x01, 0x95, 0x07, 
+0xfc, 0x09, 0xd0, 0x09, 0x13, 0x0a, 0xb5, 0x12, 0x5d, 0x12, 0x90, 0x00, 
+0xfc, 0x00, 0x6d, 0x0b, 0x66, 0x08, 0xf8, 0x0b, 0x52, 0x0b, 0xf6, 0x00, 
+0xa2, 0x00, 0x6f, 0x10, 0x02, 0x13, 0x2c, 0x18, 0x66, 0x13, 0x1e, 0x01, 
+0xe0, 0x08, 0x4e, 0x09, 0x2a, 0x00, 0x7d, 0x00, 0xdb, 0x11, 0x5f, 0x00, 
+0x24, 0x01, 0x0f, 0x01, 0xac, 0x07, 0xa9, 0x0b, 0x23, 0x00, 0x35, 0x18, 
+0xb2, 0x0a, 0xb0, 0x09, 0xdf, 0x0c, 0x73, 0x16, 0xa9, 0x0f, 0xe1, 0x00, 
+0x03, 0x01, 0xa3, 0x0b, 0x07, 0x01, 0xe9, 0x00, 0xb8, 0x0b, 0xb4, 0x00, 
+0xde, 0x00, 0xe6, 0x09, 0x02, 0x0c, 0xdf, 0x13, 0xaf, 0x00, 0xb8, 0x0a, 
+0x74, 0x0c, 0xf1, 0x0a, 0xb8, 0x0a, 0x69, 0x07, 0x24, 0x0c, 0x68, 0x0c, 
+0x10, 0x0c, 0x60, 0x0a, 0xf6, 0x0e, 0x24, 0x00, 0xe7, 0x09, 0x02, 0x13, 
+0x43, 0x06, 0xac, 0x00, 0xaa, 0x1a, 0xd5, 0x00, 0xe1, 0x13, 0x28, 0x01, 
+0xd0, 0x09, 0x62, 0x17, 0x25, 0x09, 0xa2, 0x00, 0xa8, 0x00, 0x67, 0x0b, 
+0x42, 0x06, 0xda, 0x0a, 0x19, 0x00, 0xb3, 0x08, 0x35, 0x12, 0x12, 0x0b, 
+0x6f, 0x00, 0xe9, 0x1a, 0x07, 0x01, 0xf1, 0x0a, 0xb2, 0x00, 0x33, 0x01, 
+0xb0, 0x09, 0x8c, 0x0a, 0x9f, 0x07, 0xb4, 0x07, 0xee, 0x0e, 0xaf, 0x00, 
+0xb0, 0x00, 0x48, 0x06, 0x5b, 0x0a, 0xed, 0x0e, 0x64, 0x19, 0x54, 0x00, 
+0x98, 0x15, 0xc8, 0x1b, 0x19, 0x08, 0xfb, 0x14, 0x1c, 0x01, 0x20, 0x0b, 
+0x7a, 0x0c, 0x04, 0x13, 0xe6, 0x15, 0x6b, 0x00, 0xed, 0x0b, 0x5c, 0x0b, 
+0xab, 0x00, 0x38, 0x01, 0xa7, 0x0c, 0x72, 0x0c, 0x77, 0x09, 0x75, 0x0b, 
+0xa7, 0x0c, 0xf7, 0x0c, 0x13, 0x01, 0x39, 0x13, 0x39, 0x0a, 0x5c, 0x0b, 
+0x62, 0x0a, 0x48, 0x06, 0xe4, 0x06, 0x61, 0x06, 0x58, 0x17, 0x7e, 0x0b, 
+0x90, 0x00, 0x25, 0x07, 0xe2, 0x0b, 0xda, 0x00, 0x46, 0x0e, 0xb3, 0x00, 
+0x15, 0x01, 0xa8, 0x00, 0xbb, 0x14, 0x4d, 0x0a, 0x53, 0x0b, 0xe1, 0x07, 
+0x9e, 0x0b, 0x3a, 0x07, 0xfe, 0x00, 0x6c, 0x0d, 0xea, 0x00, 0x61, 0x17, 
+0x1c, 0x0b, 0x3a, 0x0c, 0xd7, 0x00, 0xc2, 0x00, 0xb3, 0x00, 0x48, 0x06, 
+0x0c, 0x00, 0xec, 0x13, 0xd1, 0x09, 0x48, 0x00, 0x5e, 0x15, 0x4d, 0x00, 
+0x54, 0x00, 0x1e, 0x15, 0xea, 0x00, 0x22, 0x0a, 0xed, 0x06, 0xef, 0x00, 
+0x89, 0x10, 0x62, 0x00, 0x93, 0x00, 0x1d, 0x07, 0xb9, 0x06, 0xfc, 0x00, 
+0x5e, 0x10, 0x4d, 0x06, 0xbd, 0x00, 0x94, 0x07, 0x57, 0x18, 0x51, 0x0c, 
+0xfb, 0x14, 0xd0, 0x00, 0x1b, 0x07, 0xeb, 0x0b, 0x25, 0x0c, 0xeb, 0x00, 
+0x13, 0x0a, 0xf3, 0x0c, 0x9c, 0x0c, 0x83, 0x0b, 0x6c, 0x0a, 0x41, 0x0a, 
+0xfb, 0x0b, 0x5b, 0x08, 0x4a, 0x0b, 0x52, 0x0c, 0x1c, 0x08, 0x7e, 0x0c, 
+0xa7, 0x00, 0x63, 0x06, 0xdc, 0x14, 0x15, 0x01, 0x71, 0x06, 0x7b, 0x0a, 
+0x32, 0x12, 0xd4, 0x09, 0x38, 0x1c, 0x77, 0x16, 0x30, 0x07, 0x55, 0x0a, 
+0xf3, 0x18, 0x37, 0x01, 0x8f, 0x00, 0xd9, 0x06, 0x00, 0x14, 0x3b, 0x0c, 
+0x97, 0x07, 0xe0, 0x00, 0x64, 0x0c, 0x39, 0x01, 0x4e, 0x0d, 0x98, 0x15, 
+0x1e, 0x15, 0x2b, 0x01, 0x8f, 0x06, 0x05, 0x13, 0xd1, 0x0e, 0xda, 0x10, 
+0x37, 0x01, 0x74, 0x0b, 0x0a, 0x01, 0x32, 0x0c, 0xe1, 0x0b, 0x6c, 0x00, 
+0xfc, 0x0a, 0xc4, 0x00, 0xbb, 0x08, 0xcf, 0x0a, 0xb6, 0x0e, 0xb0, 0x1a, 
+0xbc, 0x14, 0x0d, 0x00, 0xbb, 0x0a, 0x90, 0x00, 0xe9, 0x00, 0x81, 0x10, 
+0x24, 0x01, 0x17, 0x00, 0xfe, 0x07, 0xe2, 0x00, 0xdd, 0x10, 0x0c, 0x0c, 
+0x6d, 0x0d, 0xba, 0x09, 0xba, 0x0a, 0x10, 0x00, 0xca, 0x00, 0xcd, 0x15, 
+0x29, 0x0c, 0xf9, 0x00, 0xbb, 0x06, 0x4b, 0x00, 0x34, 0x0a, 0x8a, 0x11, 
+0xda, 0x00, 0x03, 0x11, 0xeb, 0x00, 0x80, 0x0a, 0x48, 0x00, 0x04, 0x15, 
+0x35, 0x0a, 0x1d, 0x0a, 0x6b, 0x0c, 0x9f, 0x0c, 0x5d, 0x08, 0x5a, 0x0c, 
+0xfc, 0x06, 0x14, 0x0a, 0xb5, 0x09, 0xaf, 0x0f, 0xe6, 0x0a, 0x0b, 0x00, 
+0xd7, 0x18, 0x8a, 0x0b, 0xfb, 0x0a, 0x19, 0x1a, 0xce, 0x00, 0x09, 0x0a, 
+0x54, 0x00, 0x40, 0x06, 0x4c, 0x10, 0x05, 0x01, 0xdb, 0x00, 0xbb, 0x00, 
+0xf9, 0x0e, 0x60, 0x00, 0xdf, 0x09, 0xf7, 0x0e, 0x13, 0x07, 0x58, 0x17, 
+0xb0, 0x09, 0x62, 0x17, 0xa0, 0x07, 0x36, 0x0c, 0x42, 0x06, 0xae, 0x0a, 
+0xdd, 0x06, 0x1b, 0x01, 0x7e, 0x0e, 0xef, 0x11, 0xe1, 0x08, 0x3e, 0x00, 
+0x2f, 0x00, 0x18, 0x08, 0x14, 0x07, 0x01, 0x01, 0x88, 0x09, 0x78, 0x00, 
+0x3c, 0x13, 0x91, 0x0a, 0xec, 0x0b, 0x57, 0x0a, 0xfc, 0x0a, 0x05, 0x0b, 
+0xdd, 0x18, 0x45, 0x14, 0xbf, 0x00, 0x3f, 0x0d, 0xd9, 0x00, 0x10, 0x0c, 
+0xba, 0x0e, 0x9d, 0x08, 0x6b, 0x00, 0x94, 0x07, 0x6f, 0x09, 0x35, 0x0d, 
+0x23, 0x0c, 0x9d, 0x0a, 0xc6, 0x0a, 0x26, 0x01, 0xde, 0x15, 0xf2, 0x09, 
+0x5f, 0x07, 0x4a, 0x06, 0x82, 0x0a, 0x93, 0x0f, 0x38, 0x0b, 0xac, 0x07, 
+0x33, 0x0a, 0xad, 0x0f, 0xce, 0x00, 0x9b, 0x0c, 0x3d, 0x01, 0xf7, 0x0b, 
+0xc2, 0x0b, 0xa3, 0x0a, 0xc9, 0x0c, 0xbb, 0x0a, 0x50, 0x0c, 0xac, 0x16, 
+0x93, 0x0b, 0xc9, 0x00, 0xb1, 0x0c, 0xbe, 0x09, 0xe6, 0x0d, 0xba, 0x14, 
+0x90, 0x00, 0xba, 0x09, 0x7d, 0x0b, 0xce, 0x06, 0x24, 0x00, 0xa8, 0x00, 
+0x30, 0x00, 0xd4, 0x00, 0x23, 0x08, 0x68, 0x06, 0x62, 0x06, 0xb7, 0x0e, 
+0x17, 0x00, 0x56, 0x0b, 0x68, 0x11, 0xb1, 0x00, 0xf8, 0x0e, 0x65, 0x00, 
+0xbb, 0x0e, 0x35, 0x0d, 0xe4, 0x00, 0xdf, 0x08, 0x33, 0x0a, 0x39, 0x13, 
+0x48, 0x0d, 0x94, 0x0b, 0x1a, 0x00, 0xdc, 0x00, 0xa6, 0x10, 0xd2, 0x11, 
+0x48, 0x06, 0xf1, 0x00, 0xd2, 0x0a, 0x98, 0x0a, 0x3a, 0x01, 0x9f, 0x08, 
+0xd3, 0x0f, 0x28, 0x07, 0x11, 0x07, 0xc1, 0x0b, 0x4d, 0x11, 0xce, 0x0b, 
+0xf3, 0x0c, 0xdb, 0x0a, 0xc5, 0x14, 0x40, 0x06, 0xc9, 0x0f, 0xe6, 0x09, 
+0x8f, 0x06, 0x71, 0x07, 0x1a, 0x00, 0x19, 0x0d, 0x1c, 0x01, 0x38, 0x10, 
+0x1c, 0x00, 0x47, 0x09, 0x36, 0x0b, 0x0c, 0x13, 0x73, 0x0b, 0xe9, 0x0c, 
+0x11, 0x0f, 0x8b, 0x00, 0xa2, 0x0a, 0xe6, 0x0a, 0x1d, 0x01, 0x09, 0x0d, 
+0xa5, 0x10, 0x17, 0x0c, 0x93, 0x00, 0xbe, 0x0a, 0xec, 0x00, 0xa2, 0x0a, 
+0xd2, 0x11, 0x26, 0x0f, 0x65, 0x08, 0xaa, 0x1a, 0x18, 0x08, 0x08, 0x0d, 
+0xee, 0x00, 0xa9, 0x00, 0x0e, 0x0a, 0x18, 0x0a, 0x27, 0x0b, 0x23, 0x01, 
+0x86, 0x0c, 0x10, 0x01, 0x29, 0x0f, 0x67, 0x0b, 0xff, 0x0c, 0x5b, 0x17, 
+0xe5, 0x07, 0x77, 0x00, 0x7b, 0x0c, 0x80, 0x0b, 0xce, 0x0f, 0x9c, 0x0b, 
+0xb8, 0x00, 0xbd, 0x0c, 0x35, 0x0a, 0xe2, 0x0b, 0x85, 0x00, 0xc8, 0x0c, 
+0xd5, 0x00, 0xb7, 0x00, 0x0b, 0x0d, 0x02, 0x0d, 0x27, 0x00, 0xfb, 0x16, 
+0xf3, 0x09, 0xdf, 0x0c, 0xaa, 0x07, 0x6d, 0x00, 0x31, 0x01, 0x2e, 0x08, 
+0x23, 0x00, 0x32, 0x18, 0xcf, 0x06, 0xbd, 0x13, 0x19, 0x0d, 0x2f, 0x07, 
+0xe0, 0x00, 0x4d, 0x08, 0xc3, 0x00, 0x8b, 0x0a, 0x6b, 0x00, 0xe6, 0x06, 
+0xc6, 0x09, 0xec, 0x0b, 0x94, 0x07, 0x7e, 0x00, 0xa0, 0x00, 0x1b, 0x1b, 
+0x9e, 0x0b, 0x8e, 0x17, 0x26, 0x01, 0xfb, 0x0a, 0x68, 0x06, 0x29, 0x01, 
+0xed, 0x00, 0x1a, 0x0b, 0xa1, 0x0a, 0x2e, 0x15, 0x47, 0x0b, 0xd9, 0x0b, 
+0x3c, 0x0c, 0xaa, 0x09, 0x42, 0x06, 0x54, 0x07, 0x16, 0x13, 0x18, 0x01, 
+0x74, 0x0b, 0x29, 0x0b, 0xe6, 0x00, 0x80, 0x0a, 0x29, 0x0e, 0x4c, 0x14, 
+0x20, 0x07, 0x2e, 0x0a, 0x77, 0x00, 0x48, 0x09, 0x19, 0x01, 0x9a, 0x06, 
+0xe7, 0x09, 0xd8, 0x0c, 0x72, 0x0c, 0x66, 0x14, 0x9a, 0x0b, 0x43, 0x14, 
+0x2a, 0x18, 0x97, 0x07, 0xf1, 0x00, 0xf7, 0x0c, 0xad, 0x00, 0xfb, 0x00, 
+0x56, 0x06, 0xd7, 0x10, 0x86, 0x0c, 0x0f, 0x19, 0xb2, 0x09, 0xa1, 0x0a, 
+0xe0, 0x0a, 0x34, 0x01, 0x4e, 0x0d, 0xa9, 0x00, 0xd0, 0x0a, 0x12, 0x0c, 
+0x13, 0x12, 0xd3, 0x00, 0x48, 0x0c, 0x2e, 0x09, 0x17, 0x09, 0xe3, 0x00, 
+0x87, 0x00, 0xb4, 0x00, 0x82, 0x0b, 0x10, 0x0b, 0x1e, 0x14, 0xee, 0x00, 
+0xf4, 0x08, 0x62, 0x00, 0x33, 0x0a, 0x16, 0x01, 0x41, 0x1a, 0xeb, 0x0b, 
+0x33, 0x01, 0xab, 0x00, 0x0a, 0x01, 0x84, 0x00, 0x34, 0x18, 0xd9, 0x07, 
+0x47, 0x0b, 0xe3, 0x07, 0xfa, 0x0b, 0xf9, 0x00, 0xe3, 0x0b, 0xeb, 0x07, 
+0x33, 0x00, 0xfa, 0x00, 0x47, 0x00, 0x1e, 0x0e, 0xbd, 0x00, 0x4d, 0x0a, 
+0xcb, 0x0e, 0xda, 0x0a, 0x78, 0x09, 0xd1, 0x00, 0xd2, 0x15, 0x3d, 0x0b, 
+0x53, 0x07, 0xb2, 0x16, 0xa1, 0x0b, 0x39, 0x0d, 0x9b, 0x00, 0xf1, 0x14, 
+0xf6, 0x09, 0x09, 0x0d, 0xd4, 0x0f, 0xb0, 0x09, 0xc7, 0x0a, 0xcd, 0x00, 
+0x44, 0x06, 0xf8, 0x00, 0x55, 0x07, 0xbc, 0x00, 0x27, 0x14, 0xb8, 0x00, 
+0xc2, 0x07, 0x77, 0x0b, 0xde, 0x00, 0xa5, 0x0a, 0x84, 0x00, 0x9c, 0x08, 
+0x8c, 0x11, 0xa8, 0x00, 0x9e, 0x00, 0x8e, 0x17, 0x02, 0x16, 0xac, 0x1a, 
+0x64, 0x00, 0x81, 0x10, 0x7d, 0x0b, 0xcb, 0x0b, 0x79, 0x00, 0x26, 0x15, 
+0x10, 0x00, 0x0d, 0x01, 0xd1, 0x06, 0x28, 0x0a, 0x1a, 0x1b, 0x4b, 0x0a, 
+0x9a, 0x11, 0x23, 0x01, 0xbd, 0x0c, 0x53, 0x09, 0x2f, 0x01, 0xf2, 0x0b, 
+0x69, 0x07, 0xd3, 0x00, 0x49, 0x06, 0x3a, 0x0c, 0xc2, 0x00, 0xb1, 0x09, 
+0xfe, 0x00, 0x60, 0x00, 0x22, 0x09, 0xeb, 0x0b, 0x0b, 0x0f, 0xeb, 0x1b, 
+0xa9, 0x07, 0x15, 0x01, 0x76, 0x16, 0xd5, 0x00, 0x8a, 0x07, 0x4e, 0x17, 
+0xba, 0x08, 0x40, 0x09, 0xbc, 0x09, 0x1a, 0x00, 0x81, 0x0a, 0xc0, 0x0b, 
+0x42, 0x01, 0x1a, 0x01, 0xda, 0x0a, 0x31, 0x01, 0xdc, 0x00, 0xfb, 0x16, 
+0x68, 0x0b, 0xcf, 0x0a, 0x3f, 0x0a, 0x97, 0x18, 0xbf, 0x1a, 0x3b, 0x1a, 
+0x1a, 0x0b, 0xd4, 0x00, 0x75, 0x0b, 0x4a, 0x0a, 0x0f, 0x01, 0x46, 0x06, 
+0x85, 0x0a, 0x5f, 0x0a, 0xa8, 0x09, 0x51, 0x0b, 0xb9, 0x0e, 0xce, 0x00, 
+0x60, 0x0b, 0x8c, 0x0a, 0x52, 0x07, 0x2c, 0x18, 0x31, 0x00, 0x6e, 0x10, 
+0xde, 0x13, 0x57, 0x00, 0xa8, 0x09, 0xda, 0x0f, 0xa9, 0x0c, 0xf6, 0x0f, 
+0xe5, 0x0a, 0x7a, 0x00, 0x1c, 0x01, 0x55, 0x11, 0x49, 0x00, 0x0c, 0x09, 
+0x24, 0x0a, 0x40, 0x09, 0xdd, 0x0d, 0x42, 0x06, 0x3c, 0x0c, 0xe6, 0x00, 
+0x8d, 0x06, 0xbf, 0x09, 0x6d, 0x09, 0xfb, 0x18, 0x0d, 0x13, 0x9d, 0x08, 
+0xe0, 0x00, 0xf3, 0x14, 0xd1, 0x09, 0xff, 0x0c, 0x86, 0x0d, 0x3a, 0x01, 
+0xb7, 0x00, 0xc1, 0x0b, 0xb2, 0x00, 0xda, 0x0a, 0xf0, 0x00, 0x2f, 0x15, 
+0x72, 0x0c, 0x1f, 0x07, 0x3d, 0x01, 0x7e, 0x16, 0x6e, 0x09, 0x59, 0x0a, 
+0x0e, 0x01, 0xd0, 0x00, 0x58, 0x0a, 0xb6, 0x00, 0xbf, 0x09, 0x7c, 0x16, 
+0x54, 0x0a, 0x53, 0x00, 0xbd, 0x00, 0x48, 0x00, 0x2e, 0x0c, 0x8d, 0x06, 
+0xa6, 0x0c, 0x62, 0x1b, 0x61, 0x00, 0x50, 0x0c, 0x36, 0x01, 0x62, 0x0e, 
+0xf3, 0x08, 0x68, 0x06, 0x4c, 0x00, 0x18, 0x01, 0xaa, 0x15, 0xdf, 0x00, 
+0xf3, 0x00, 0xa1, 0x00, 0x9d, 0x00, 0xac, 0x0a, 0xb8, 0x00, 0xfa, 0x00, 
+0x42, 0x0a, 0x6c, 0x00, 0xc0, 0x09, 0x5a, 0x06, 0x85, 0x0c, 0x33, 0x0a, 
+0x20, 0x16, 0xc5, 0x00, 0x9b, 0x11, 0x7f, 0x00, 0x2f, 0x00, 0xee, 0x0d, 
+0xad, 0x0a, 0xba, 0x00, 0x33, 0x0b, 0x18, 0x00, 0x9c, 0x00, 0x2f, 0x01, 
+0xf2, 0x18, 0xa4, 0x09, 0x3b, 0x16, 0x01, 0x01, 0x60, 0x07, 0xb2, 0x09, 
+0x03, 0x13, 0x41, 0x1a, 0xd3, 0x19, 0x66, 0x11, 0x1b, 0x07, 0x31, 0x12, 
+0x4d, 0x0f, 0x41, 0x0a, 0xa4, 0x0a, 0x71, 0x08, 0xfc, 0x0a, 0x3f, 0x00, 
+0xb4, 0x17, 0xb5, 0x00, 0x00, 0x01, 0x68, 0x18, 0xfc, 0x00, 0x01, 0x0c, 
+0x4b, 0x0a, 0x3b, 0x00, 0x4d, 0x0b, 0x2b, 0x09, 0x55, 0x00, 0x76, 0x0a, 
+0x2d, 0x0c, 0xa0, 0x07, 0x20, 0x0d, 0x07, 0x01, 0xde, 0x08, 0xf5, 0x0a, 
+0x69, 0x0b, 0xe8, 0x10, 0x46, 0x0e, 0xd0, 0x14, 0x0e, 0x01, 0xd7, 0x0b, 
+0x17, 0x00, 0xb1, 0x09, 0xe1, 0x0b, 0xbe, 0x14, 0x65, 0x09, 0x3e, 0x0a, 
+0xca, 0x0e, 0xe2, 0x00, 0xcd, 0x00, 0x18, 0x08, 0x47, 0x00, 0x8b, 0x0a, 
+0x10, 0x0c, 0xc2, 0x00, 0xc4, 0x00, 0xcc, 0x0f, 0x48, 0x0d, 0xd9, 0x00, 
+0xd0, 0x06, 0x1a, 0x00, 0x9f, 0x07, 0x11, 0x00, 0xaf, 0x0a, 0x0d, 0x0a, 
+0xf0, 0x00, 0x8c, 0x06, 0xba, 0x0a, 0x88, 0x0b, 0x69, 0x17, 0x31, 0x0a, 
+0xbf, 0x0b, 0x11, 0x01, 0x36, 0x01, 0x4a, 0x15, 0x0c, 0x0c, 0x64, 0x09, 
+0x69, 0x0b, 0x6d, 0x00, 0xeb, 0x00, 0x15, 0x13, 0xaa, 0x16, 0xcb, 0x00, 
+0xb5, 0x0c, 0x3e, 0x00, 0x45, 0x0c, 0x18, 0x08, 0x39, 0x13, 0x21, 0x01, 
+0x9a, 0x07, 0x56, 0x18, 0x5b, 0x0c, 0x80, 0x0b, 0xb1, 0x09, 0xf1, 0x09, 
+0x45, 0x0a, 0x68, 0x00, 0x80, 0x08, 0x01, 0x17, 0x2b, 0x0b, 0x2b, 0x01, 
+0x9b, 0x00, 0x0c, 0x01, 0x97, 0x0a, 0x72, 0x08, 0x90, 0x10, 0x5d, 0x0b, 
+0x42, 0x06, 0x37, 0x08, 0x1e, 0x14, 0xe7, 0x00, 0xae, 0x00, 0x8f, 0x0b, 
+0x12, 0x0e, 0x60, 0x15, 0x39, 0x07, 0x70, 0x12, 0xa1, 0x0a, 0x69, 0x17, 
+0x46, 0x0b, 0xcf, 0x08, 0x04, 0x08, 0xcb, 0x0b, 0xe9, 0x09, 0x07, 0x0b, 
+0x28, 0x14, 0xa2, 0x06, 0x1f, 0x01, 0xae, 0x0b, 0xe0, 0x11, 0x57, 0x0b, 
+0x6c, 0x0d, 0x92, 0x0c, 0x3b, 0x01, 0x27, 0x12, 0x91, 0x00, 0xf2, 0x0b, 
+0x13, 0x0a, 0x93, 0x13, 0xcf, 0x00, 0x4b, 0x06, 0x68, 0x0b, 0xb7, 0x10, 
+0x49, 0x00, 0x59, 0x18, 0xa8, 0x0a, 0x6e, 0x13, 0x81, 0x10, 0x04, 0x01, 
+0xa6, 0x14, 0xba, 0x0e, 0x6f, 0x08, 0x6d, 0x09, 0xba, 0x00, 0xce, 0x15, 
+0x61, 0x00, 0x14, 0x01, 0x37, 0x0a, 0xf7, 0x10, 0x7a, 0x00, 0x2b, 0x01, 
+0x3f, 0x0b, 0x56, 0x07, 0xa7, 0x00, 0xef, 0x00, 0x43, 0x00, 0xf6, 0x00, 
+0x1f, 0x01, 0xa7, 0x08, 0x6d, 0x0a, 0xb2, 0x0a, 0xbd, 0x00, 0x40, 0x0e, 
+0x48, 0x1a, 0xe1, 0x08, 0x88, 0x0c, 0x3d, 0x01, 0x40, 0x06, 0x41, 0x06, 
+0xc5, 0x00, 0xbc, 0x00, 0x35, 0x0a, 0xb3, 0x0a, 0x60, 0x00, 0x63, 0x09, 
+0x1e, 0x15, 0xe6, 0x09, 0x64, 0x00, 0x94, 0x07, 0x7b, 0x08, 0xcb, 0x00, 
+0x0b, 0x01, 0x45, 0x06, 0x1c, 0x01, 0xd2, 0x09, 0x42, 0x14, 0x34, 0x01, 
+0xe9, 0x08, 0xa5, 0x09, 0xb5, 0x0c, 0xe0, 0x0c, 0x87, 0x18, 0x75, 0x0b, 
+0xd2, 0x0c, 0x8e, 0x0a, 0xb1, 0x0a, 0x83, 0x08, 0x58, 0x19, 0x7d, 0x0b, 
+0x04, 0x0b, 0xe2, 0x19, 0xef, 0x0b, 0x3b, 0x1a, 0xa1, 0x0a, 0xfe, 0x09, 
+0xb2, 0x09, 0x6c, 0x0d, 0xdb, 0x0d, 0x09, 0x11, 0xad, 0x00, 0x80, 0x0b, 
+0xea, 0x0c, 0xc5, 0x00, 0x8a, 0x0c, 0xe2, 0x0b, 0xa8, 0x08, 0x16, 0x07, 
+0x8f, 0x16, 0x4f, 0x0b, 0xcc, 0x00, 0xb7, 0x0a, 0x5a, 0x08, 0x55, 0x07, 
+0xb1, 0x10, 0x92, 0x00, 0x61, 0x0a, 0xcb, 0x0e, 0x3c, 0x01, 0x93, 0x11, 
+0x94, 0x0b, 0xaa, 0x00, 0x7b, 0x18, 0x17, 0x0c, 0xf3, 0x18, 0x0f, 0x0b, 
+0x25, 0x0b, 0x41, 0x00, 0xda, 0x0f, 0xb1, 0x00, 0x17, 0x0c, 0x40, 0x06, 
+0xd7, 0x07, 0x0f, 0x0c, 0xc0, 0x0c, 0x11, 0x10, 0x8e, 0x0b, 0xdc, 0x00, 
+0xfa, 0x0a, 0xf3, 0x08, 0x08, 0x01, 0x87, 0x00, 0xc5, 0x0a, 0xa6, 0x0a, 
+0xa6, 0x07, 0x33, 0x0b, 0x16, 0x01, 0xb4, 0x0b, 0x9b, 0x00, 0x23, 0x0e, 
+0xe5, 0x15, 0x29, 0x15, 0x5f, 0x06, 0x89, 0x0b, 0xc5, 0x00, 0x6c, 0x0a, 
+0x4a, 0x06, 0x88, 0x00, 0x2e, 0x07, 0x30, 0x00, 0x95, 0x00, 0x1e, 0x01, 
+0x9c, 0x06, 0xbd, 0x00, 0xfa, 0x0a, 0x55, 0x06, 0x65, 0x0a, 0x88, 0x0b, 
+0x6e, 0x00, 0x65, 0x0c, 0x97, 0x07, 0xad, 0x00, 0x38, 0x07, 0x51, 0x00, 
+0x7e, 0x00, 0xef, 0x06, 0x21, 0x09, 0xf6, 0x0e, 0x10, 0x00, 0x2d, 0x0c, 
+0xb7, 0x00, 0x75, 0x16, 0xb4, 0x19, 0xbe, 0x00, 0x25, 0x00, 0xc9, 0x00, 
+0xe3, 0x00, 0x96, 0x0a, 0xfc, 0x07, 0x83, 0x06, 0xe3, 0x06, 0x25, 0x09, 
+0xda, 0x0f, 0xbe, 0x15, 0xbd, 0x00, 0x4f, 0x0a, 0x3f, 0x0a, 0x3f, 0x0c, 
+0x15, 0x01, 0x4e, 0x17, 0x47, 0x06, 0xb3, 0x08, 0xce, 0x06, 0x84, 0x09, 
+0xc1, 0x00, 0x6e, 0x09, 0x68, 0x08, 0x33, 0x18, 0x54, 0x00, 0x70, 0x0c, 
+0x23, 0x0c, 0x02, 0x01, 0x4b, 0x0f, 0xdb, 0x0d, 0xec, 0x0b, 0x1d, 0x01, 
+0x1d, 0x0a, 0x3f, 0x01, 0x7a, 0x07, 0xfd, 0x00, 0x47, 0x06, 0xfe, 0x09, 
+0xf6, 0x0c, 0x29, 0x01, 0xe7, 0x00, 0xec, 0x08, 0x8d, 0x0a, 0x55, 0x0b, 
+0x0c, 0x0a, 0x33, 0x0a, 0xe3, 0x0b, 0x86, 0x00, 0xfa, 0x0a, 0x72, 0x0b, 
+0x47, 0x06, 0xc8, 0x14, 0xb1, 0x0c, 0xf1, 0x09, 0x71, 0x06, 0xfc, 0x09, 
+0x25, 0x16, 0x2a, 0x0b, 0x5c, 0x0b, 0x3f, 0x0d, 0xab, 0x00, 0xa6, 0x09, 
+0xba, 0x15, 0x24, 0x0c, 0xd2, 0x00, 0x66, 0x13, 0x95, 0x1b, 0x33, 0x00, 
+0x6d, 0x09, 0x3d, 0x00, 0x3b, 0x01, 0xcc, 0x00, 0xf2, 0x09, 0xa5, 0x0a, 
+0x6a, 0x0d, 0x92, 0x0f, 0x6f, 0x10, 0x3a, 0x01, 0xf6, 0x0c, 0x88, 0x0b, 
+0x02, 0x13, 0x48, 0x00, 0xd1, 0x18, 0x94, 0x00, 0xf4, 0x17, 0xfd, 0x0d, 
+0xf9, 0x0f, 0xe3, 0x0a, 0xfd, 0x09, 0xe1, 0x07, 0x54, 0x0b, 0xf2, 0x00, 
+0x33, 0x0a, 0x66, 0x14, 0xee, 0x0e, 0x4f, 0x11, 0xd1, 0x18, 0xe1, 0x00, 
+0x5e, 0x07, 0xdb, 0x00, 0xfd, 0x09, 0x89, 0x0b, 0x93, 0x0c, 0x8d, 0x00, 
+0xa5, 0x15, 0x8f, 0x06, 0x34, 0x15, 0xf2, 0x14, 0x68, 0x0b, 0xfe, 0x09, 
+0xec, 0x07, 0x08, 0x0a, 0x0a, 0x0c, 0x26, 0x01, 0xf7, 0x0c, 0xce, 0x14, 
+0xca, 0x00, 0x80, 0x09, 0x01, 0x0b, 0xdc, 0x00, 0xd3, 0x00, 0xa3, 0x0b, 
+0xe1, 0x09, 0x96, 0x00, 0x9d, 0x08, 0xc1, 0x00, 0xee, 0x1a, 0x8a, 0x0b, 
+0x15, 0x0a, 0xdf, 0x0c, 0x42, 0x06, 0xa1, 0x0a, 0xd2, 0x0c, 0xeb, 0x0a, 
+0x49, 0x06, 0xad, 0x0a, 0x54, 0x0b, 0x02, 0x0e, 0x56, 0x00, 0xa0, 0x0b, 
+0x41, 0x1a, 0x83, 0x18, 0x1b, 0x00, 0x56, 0x0a, 0xff, 0x00, 0x2a, 0x00, 
+0x5f, 0x00, 0xe4, 0x00, 0x6f, 0x00, 0x32, 0x07, 0xf9, 0x00, 0x25, 0x01, 
+0x5e, 0x07, 0xd5, 0x0f, 0x11, 0x01, 0x54, 0x00, 0x7c, 0x16, 0x26, 0x12, 
+0x8f, 0x17, 0xac, 0x0a, 0xaa, 0x00, 0x90, 0x00, 0xc2, 0x00, 0xbc, 0x0c, 
+0x69, 0x13, 0xe1, 0x00, 0x6e, 0x13, 0xd8, 0x00, 0x57, 0x11, 0x2e, 0x0c, 
+0x2b, 0x0a, 0x40, 0x0a, 0x65, 0x0c, 0xb1, 0x0a, 0x9a, 0x16, 0x40, 0x06, 
+0x74, 0x16, 0x10, 0x0b, 0x67, 0x12, 0xaf, 0x00, 0xd0, 0x00, 0x1c, 0x00, 
+0xd7, 0x00, 0xcf, 0x00, 0xe8, 0x17, 0x12, 0x0a, 0x1e, 0x13, 0x03, 0x0c, 
+0x85, 0x11, 0xb8, 0x0a, 0x07, 0x01, 0x81, 0x16, 0xb2, 0x17, 0x97, 0x0b, 
+0x46, 0x06, 0xe7, 0x00, 0x57, 0x11, 0x7b, 0x10, 0x13, 0x01, 0xa7, 0x10, 
+0xf1, 0x13, 0xc5, 0x0a, 0x9e, 0x17, 0xc0, 0x00, 0x33, 0x01, 0xda, 0x0a, 
+0x91, 0x06, 0xe1, 0x07, 0xdd, 0x00, 0x4c, 0x00, 0x58, 0x06, 0x1a, 0x0e, 
+0xc1, 0x17, 0x73, 0x06, 0xb0, 0x00, 0x3c, 0x0b, 0x60, 0x11, 0x2f, 0x00, 
+0xf5, 0x00, 0xbe, 0x11, 0xa6, 0x0a, 0xde, 0x00, 0x7d, 0x0b, 0xdd, 0x00, 
+0x0d, 0x0a, 0x30, 0x0c, 0x55, 0x06, 0x63, 0x0a, 0x52, 0x0b, 0xd7, 0x07, 
+0xb1, 0x18, 0x5f, 0x00, 0x5e, 0x12, 0xe4, 0x00, 0x98, 0x14, 0xb7, 0x0a, 
+0xf0, 0x00, 0x61, 0x0b, 0xca, 0x0f, 0xdd, 0x09, 0x05, 0x01, 0xc9, 0x0c, 
+0x98, 0x0a, 0x14, 0x01, 0x90, 0x16, 0x86, 0x17, 0x0c, 0x01, 0x39, 0x13, 
+0x10, 0x0b, 0x41, 0x12, 0x9c, 0x00, 0xdc, 0x00, 0xb1, 0x00, 0x95, 0x0c, 
+0xda, 0x0d, 0x12, 0x01, 0x6a, 0x0b, 0xef, 0x0d, 0xdd, 0x18, 0x76, 0x16, 
+0x65, 0x08, 0x7c, 0x16, 0xf2, 0x0a, 0xb2, 0x00, 0xa9, 0x08, 0x41, 0x0d, 
+0xd2, 0x00, 0x0f, 0x16, 0x5a, 0x00, 0x80, 0x08, 0x4b, 0x06, 0xdd, 0x09, 
+0x2c, 0x09, 0xa9, 0x0b, 0xfc, 0x09, 0xb8, 0x0a, 0xf1, 0x00, 0xf1, 0x13, 
+0xf8, 0x00, 0xfc, 0x0a, 0xe0, 0x0b, 0xf1, 0x00, 0xde, 0x09, 0x25, 0x01, 
+0xfe, 0x0f, 0xdd, 0x0c, 0x7a, 0x10, 0x13, 0x07, 0x82, 0x0b, 0x76, 0x0a, 
+0x17, 0x00, 0x88, 0x0c, 0x63, 0x0a, 0x17, 0x00, 0x69, 0x13, 0x02, 0x15, 
+0x22, 0x0f, 0x3e, 0x0f, 0xfe, 0x0b, 0x18, 0x13, 0xd0, 0x06, 0x1e, 0x0a, 
+0x2f, 0x0c, 0x5e, 0x12, 0xab, 0x0b, 0x01, 0x0f, 0xd7, 0x00, 0xf5, 0x00, 
+0x4a, 0x00, 0x61, 0x00, 0x81, 0x10, 0x0b, 0x00, 0x6b, 0x00, 0x25, 0x0b, 
+0xf4, 0x18, 0x10, 0x07, 0x43, 0x12, 0x46, 0x0c, 0xed, 0x00, 0x98, 0x07, 
+0xa9, 0x07, 0x56, 0x0b, 0xe8, 0x0a, 0x25, 0x01, 0xf6, 0x00, 0x7f, 0x07, 
+0x68, 0x0c, 0x55, 0x00, 0x40, 0x0a, 0x18, 0x01, 0x28, 0x11, 0x6b, 0x17, 
+0x17, 0x0a, 0xdd, 0x0c, 0x3b, 0x00, 0x1f, 0x01, 0x2d, 0x0a, 0xab, 0x00, 
+0xd8, 0x0a, 0x4c, 0x06, 0xb9, 0x0a, 0xd7, 0x00, 0x0f, 0x0b, 0x68, 0x00, 
+0x34, 0x01, 0x05, 0x15, 0xeb, 0x08, 0xed, 0x06, 0x4e, 0x06, 0x50, 0x17, 
+0x3a, 0x0d, 0x55, 0x00, 0xb9, 0x0e, 0x61, 0x0a, 0x13, 0x0b, 0x7a, 0x18, 
+0x1b, 0x00, 0xa7, 0x09, 0x27, 0x00, 0xfa, 0x0b, 0xb3, 0x06, 0x06, 0x01, 
+0x73, 0x0b, 0x43, 0x06, 0x09, 0x01, 0x47, 0x06, 0xf6, 0x0e, 0x0e, 0x01, 
+0x19, 0x0c, 0xb8, 0x0a, 0xc8, 0x0a, 0x07, 0x0a, 0xd7, 0x00, 0x0c, 0x00, 
+0xfe, 0x00, 0x41, 0x01, 0xc8, 0x0c, 0x10, 0x0b, 0xdc, 0x00, 0x4d, 0x09, 
+0x0f, 0x0b, 0x07, 0x01, 0xee, 0x0b, 0x0d, 0x0c, 0x65, 0x0c, 0x3a, 0x1a, 
+0x11, 0x0b, 0x60, 0x0c, 0xc8, 0x0c, 0x53, 0x00, 0xd3, 0x09, 0x43, 0x0a, 
+0x68, 0x08, 0x1c, 0x00, 0xfd, 0x09, 0x45, 0x1c, 0x74, 0x16, 0xf0, 0x0a, 
+0xeb, 0x08, 0x1d, 0x07, 0xb8, 0x0a, 0x47, 0x0e, 0xb6, 0x0c, 0xb3, 0x00, 
+0xaf, 0x0a, 0x21, 0x09, 0x97, 0x0b, 0xf9, 0x10, 0xdf, 0x13, 0x74, 0x0b, 
+0x70, 0x08, 0x4d, 0x11, 0x21, 0x14, 0x34, 0x0a, 0x4b, 0x11, 0xfa, 0x15, 
+0x0f, 0x01, 0x28, 0x00, 0x35, 0x01, 0x2c, 0x16, 0xe2, 0x08, 0xd7, 0x07, 
+0xc2, 0x0c, 0x01, 0x01, 0x60, 0x00, 0x1f, 0x01, 0xab, 0x09, 0x68, 0x0b, 
+0x21, 0x01, 0xc4, 0x14, 0xbc, 0x0c, 0x53, 0x00, 0x19, 0x01, 0xf8, 0x0f, 
+0x8f, 0x0a, 0xe3, 0x0b, 0xd4, 0x0f, 0xaf, 0x0a, 0x28, 0x00, 0x17, 0x13, 
+0x48, 0x00, 0x98, 0x0a, 0x8e, 0x1a, 0x18, 0x08, 0xa0, 0x17, 0x4d, 0x0e, 
+0x03, 0x0c, 0x3a, 0x0c, 0x23, 0x00, 0x3b, 0x01, 0x13, 0x0c, 0x05, 0x01, 
+0xe2, 0x08, 0xca, 0x12, 0x4b, 0x00, 0x41, 0x06, 0xa0, 0x08, 0xbf, 0x00, 
+0x76, 0x0a, 0x17, 0x0c, 0xec, 0x07, 0x6b, 0x07, 0xa6, 0x0f, 0xf3, 0x00, 
+0x7a, 0x00, 0xb2, 0x0a, 0xc8, 0x07, 0x46, 0x0b, 0xf3, 0x00, 0xed, 0x0c, 
+0x82, 0x1b, 0x2c, 0x01, 0x11, 0x0d, 0x67, 0x08, 0xa8, 0x10, 0x1c, 0x0c, 
+0x10, 0x0f, 0x2b, 0x18, 0x9f, 0x07, 0xd8, 0x0a, 0x6d, 0x00, 0xd3, 0x00, 
+0xb9, 0x0a, 0x7c, 0x00, 0xa8, 0x00, 0xec, 0x06, 0x5b, 0x08, 0xea, 0x09, 
+0x37, 0x01, 0xce, 0x00, 0x22, 0x01, 0x24, 0x08, 0x35, 0x00, 0xb8, 0x00, 
+0xbd, 0x0c, 0xb0, 0x11, 0x78, 0x08, 0xea, 0x08, 0x0d, 0x01, 0x5c, 0x08, 
+0x87, 0x08, 0xce, 0x06, 0x26, 0x0b, 0x00, 0x0b, 0x6c, 0x00, 0x3f, 0x00, 
+0x14, 0x0b, 0x3c, 0x1a, 0x55, 0x00, 0xf5, 0x08, 0xcf, 0x00, 0xec, 0x0b, 
+0xa7, 0x0c, 0xfe, 0x0c, 0xd5, 0x00, 0x3c, 0x01, 0x3d, 0x10, 0xaa, 0x0c, 
+0x65, 0x0c, 0x1f, 0x0a, 0x33, 0x00, 0x14, 0x01, 0x2f, 0x01, 0x98, 0x06, 
+0x4b, 0x0a, 0xf5, 0x0d, 0xfc, 0x09, 0xa7, 0x0a, 0x60, 0x07, 0xef, 0x0a, 
+0xeb, 0x08, 0x8f, 0x00, 0x1a, 0x08, 0x66, 0x11, 0xb3, 0x08, 0x43, 0x1a, 
+0xc7, 0x0c, 0xe5, 0x00, 0x2b, 0x09, 0xbd, 0x0a, 0x97, 0x06, 0x1e, 0x0a, 
+0xa8, 0x09, 0xa4, 0x09, 0x60, 0x0b, 0x79, 0x07, 0x3b, 0x00, 0xb8, 0x0c, 
+0xb3, 0x08, 0xfe, 0x10, 0x2a, 0x18, 0x5d, 0x0b, 0xe8, 0x16, 0xae, 0x0b, 
+0x2f, 0x00, 0x34, 0x01, 0x5d, 0x0c, 0x72, 0x16, 0xd8, 0x00, 0xb8, 0x0a, 
+0xb0, 0x00, 0xf3, 0x00, 0x16, 0x09, 0xc1, 0x00, 0x07, 0x17, 0x51, 0x0b, 
+0xa0, 0x13, 0x4a, 0x14, 0x94, 0x16, 0xa2, 0x0a, 0x1f, 0x01, 0x06, 0x01, 
+0x1e, 0x15, 0x3b, 0x0c, 0x73, 0x11, 0x29, 0x1b, 0x68, 0x0c, 0xd2, 0x0f, 
+0x26, 0x1a, 0x61, 0x0a, 0x1f, 0x0a, 0xbf, 0x0c, 0x14, 0x0a, 0x20, 0x14, 
+0x55, 0x00, 0x2b, 0x01, 0x77, 0x19, 0x99, 0x06, 0x18, 0x0c, 0x05, 0x01, 
+0x75, 0x0b, 0x9c, 0x15, 0xe8, 0x0c, 0x0e, 0x01, 0x07, 0x0a, 0xf5, 0x00, 
+0x78, 0x00, 0x81, 0x0b, 0x2f, 0x0c, 0xbd, 0x00, 0xc5, 0x00, 0xef, 0x0b, 
+0x1a, 0x01, 0x52, 0x07, 0xd7, 0x17, 0x1b, 0x01, 0x9d, 0x08, 0x4c, 0x08, 
+0x6a, 0x0b, 0x22, 0x0c, 0xe9, 0x0c, 0x29, 0x0b, 0x8a, 0x09, 0x66, 0x0c, 
+0x25, 0x01, 0x60, 0x0b, 0x24, 0x0c, 0x2a, 0x0a, 0x06, 0x0c, 0xf1, 0x00, 
+0xbb, 0x0a, 0xea, 0x00, 0x5d, 0x08, 0xa7, 0x0c, 0xd4, 0x00, 0x74, 0x0c, 
+0x7f, 0x0b, 0x18, 0x01, 0xdd, 0x0c, 0xb1, 0x0a, 0x30, 0x16, 0xe8, 0x0a, 
+0x27, 0x12, 0xb1, 0x09, 0x1e, 0x00, 0xbe, 0x00, 0x55, 0x06, 0xdd, 0x0c, 
+0x1d, 0x0a, 0x24, 0x0c, 0x1b, 0x00, 0xc4, 0x00, 0x75, 0x0a, 0xd2, 0x0f, 
+0x7a, 0x0c, 0x67, 0x0d, 0x38, 0x0a, 0x4c, 0x06, 0x69, 0x13, 0x5f, 0x07, 
+0x21, 0x0d, 0xe5, 0x0d, 0x0a, 0x01, 0xec, 0x08, 0x8a, 0x0c, 0x2a, 0x00, 
+0xb5, 0x0a, 0x7f, 0x00, 0x7e, 0x0b, 0xb0, 0x09, 0xef, 0x00, 0x96, 0x17, 
+0xd6, 0x06, 0x81, 0x0e, 0x0b, 0x0f, 0x4a, 0x00, 0xc3, 0x00, 0x7a, 0x0c, 
+0x7a, 0x0a, 0xc5, 0x00, 0x72, 0x16, 0xb7, 0x0b, 0x40, 0x0b, 0xaf, 0x0a, 
+0xdc, 0x00, 0x16, 0x17, 0x6a, 0x0b, 0x80, 0x0b, 0xf1, 0x14, 0xbc, 0x09, 
+0x7c, 0x18, 0xb6, 0x11, 0xb1, 0x09, 0xe2, 0x10, 0x6b, 0x00, 0xf5, 0x00, 
+0xd9, 0x07, 0x4d, 0x06, 0x23, 0x00, 0x68, 0x07, 0x1d, 0x13, 0xbb, 0x07, 
+0x78, 0x00, 0xcd, 0x15, 0x81, 0x1b, 0xbb, 0x12, 0x9c, 0x00, 0x48, 0x00, 
+0x27, 0x0b, 0x4a, 0x0a, 0xa7, 0x10, 0x91, 0x0c, 0x4b, 0x0a, 0x1b, 0x01, 
+0x56, 0x0c, 0x3b, 0x0b, 0x40, 0x08, 0x65, 0x09, 0x56, 0x07, 0xaf, 0x00, 
+0x26, 0x08, 0x05, 0x10, 0x8e, 0x06, 0xec, 0x0b, 0xf9, 0x0b, 0x17, 0x01, 
+0x78, 0x0a, 0xaf, 0x00, 0x85, 0x0c, 0x25, 0x07, 0xbd, 0x0c, 0xc0, 0x0b, 
+0xf0, 0x00, 0xe6, 0x00, 0xb6, 0x0b, 0x46, 0x0b, 0xe6, 0x0a, 0x8e, 0x06, 
+0xef, 0x00, 0xd8, 0x0a, 0x73, 0x08, 0xc4, 0x0a, 0x1a, 0x10, 0x47, 0x06, 
+0x23, 0x01, 0xdf, 0x16, 0x21, 0x01, 0x91, 0x00, 0x3c, 0x12, 0xc0, 0x00, 
+0xcd, 0x00, 0x5f, 0x08, 0x82, 0x08, 0xce, 0x06, 0x63, 0x00, 0x8c, 0x06, 
+0xb7, 0x0e, 0x62, 0x0d, 0x78, 0x00, 0x2b, 0x0a, 0x39, 0x00, 0xb2, 0x07, 
+0x0c, 0x00, 0xde, 0x00, 0xfe, 0x0c, 0x88, 0x0b, 0xf6, 0x0e, 0xd8, 0x00, 
+0x46, 0x06, 0x15, 0x01, 0xea, 0x09, 0xf6, 0x0b, 0xa1, 0x06, 0x25, 0x00, 
+0x47, 0x0b, 0x22, 0x0c, 0x01, 0x01, 0x7a, 0x00, 0x6e, 0x00, 0x36, 0x1c, 
+0x70, 0x06, 0xbb, 0x0b, 0x81, 0x11, 0xef, 0x0e, 0xf2, 0x0d, 0x23, 0x0c, 
+0x14, 0x00, 0x1b, 0x01, 0x25, 0x1a, 0x23, 0x08, 0x2d, 0x0c, 0xd4, 0x00, 
+0xed, 0x00, 0x10, 0x00, 0x36, 0x18, 0x48, 0x09, 0xd1, 0x0e, 0x3d, 0x0b, 
+0x5d, 0x11, 0xc9, 0x0a, 0xaa, 0x00, 0xde, 0x00, 0xbe, 0x0c, 0xf6, 0x00, 
+0xef, 0x00, 0xe9, 0x00, 0x1d, 0x01, 0x1b, 0x0c, 0x41, 0x01, 0x10, 0x01, 
+0xf9, 0x0b, 0x66, 0x0c, 0xeb, 0x00, 0x7e, 0x16, 0xa9, 0x00, 0x42, 0x06, 
+0xc5, 0x14, 0xa2, 0x0a, 0xbd, 0x00, 0x32, 0x01, 0x2e, 0x01, 0x13, 0x00, 
+0x0e, 0x19, 0x63, 0x0a, 0x9f, 0x07, 0xfc, 0x09, 0xb6, 0x0b, 0x2a, 0x0f, 
+0x5b, 0x08, 0x4f, 0x0a, 0xde, 0x13, 0xfb, 0x18, 0x22, 0x09, 0xe4, 0x07, 
+0xa9, 0x0b, 0xc0, 0x0e, 0x94, 0x00, 0xa7, 0x00, 0x7d, 0x10, 0x72, 0x0b, 
+0x9d, 0x08, 0xc8, 0x07, 0x15, 0x01, 0x2c, 0x01, 0xb0, 0x00, 0x6e, 0x11, 
+0x7d, 0x16, 0x1e, 0x0b, 0xff, 0x09, 0xa5, 0x10, 0x24, 0x0c, 0x84, 0x0a, 
+0x03, 0x01, 0xdc, 0x09, 0xf6, 0x00, 0x52, 0x07, 0x4c, 0x00, 0x3d, 0x00, 
+0xe7, 0x00, 0x58, 0x0a, 0x55, 0x00, 0xb7, 0x0c, 0xbd, 0x00, 0x46, 0x0b, 
+0x54, 0x00, 0x64, 0x0d, 0xc4, 0x14, 0x22, 0x0c, 0xd0, 0x00, 0xb7, 0x0e, 
+0xb2, 0x09, 0x24, 0x01, 0x19, 0x11, 0x5f, 0x0a, 0xbc, 0x0c, 0x49, 0x00, 
+0xaa, 0x06, 0x63, 0x06, 0x11, 0x0b, 0xfc, 0x15, 0x58, 0x00, 0x59, 0x0c, 
+0xf3, 0x18, 0xe4, 0x06, 0x09, 0x01, 0xd5, 0x0e, 0xda, 0x0a, 0x42, 0x14, 
+0xe7, 0x09, 0xa6, 0x0c, 0x90, 0x00, 0x79, 0x19, 0x84, 0x0a, 0x0e, 0x0c, 
+0xba, 0x00, 0xdf, 0x00, 0x88, 0x17, 0xe4, 0x06, 0xf5, 0x0c, 0x4f, 0x17, 
+0x6d, 0x14, 0x7d, 0x0c, 0xe2, 0x0f, 0xa7, 0x10, 0x33, 0x01, 0xd7, 0x07, 
+0xca, 0x0b, 0xe0, 0x08, 0x79, 0x00, 0xf8, 0x00, 0x13, 0x0d, 0x26, 0x0e, 
+0x25, 0x16, 0x36, 0x0a, 0x8a, 0x12, 0xf7, 0x00, 0xd9, 0x00, 0x0c, 0x0c, 
+0x39, 0x12, 0xe9, 0x09, 0xc5, 0x0b, 0xe3, 0x0a, 0x67, 0x07, 0x54, 0x0b, 
+0x9f, 0x0c, 0xca, 0x0b, 0xc3, 0x0e, 0xea, 0x13, 0xbb, 0x14, 0x67, 0x08, 
+0x0b, 0x01, 0x07, 0x01, 0x63, 0x09, 0xd2, 0x19, 0x6b, 0x00, 0xc9, 0x09, 
+0xf1, 0x00, 0xb6, 0x00, 0xfc, 0x08, 0xc5, 0x09, 0x58, 0x18, 0x04, 0x16, 
+0x36, 0x00, 0xfe, 0x0a, 0xf8, 0x00, 0x38, 0x0c, 0xbe, 0x0a, 0x61, 0x17, 
+0x13, 0x01, 0x2e, 0x07, 0xc8, 0x0c, 0xba, 0x09, 0x52, 0x07, 0x23, 0x0f, 
+0xe1, 0x0c, 0xee, 0x0a, 0x7c, 0x0c, 0xd6, 0x07, 0xb5, 0x0f, 0xa7, 0x08, 
+0x29, 0x0c, 0xa1, 0x19, 0x3e, 0x01, 0x7e, 0x0b, 0xaa, 0x00, 0x07, 0x01, 
+0xcd, 0x15, 0x16, 0x01, 0x7a, 0x00, 0x3b, 0x00, 0x26, 0x00, 0xea, 0x00, 
+0xf1, 0x00, 0x04, 0x0c, 0x5e, 0x12, 0x06, 0x07, 0x90, 0x00, 0xbc, 0x00, 
+0x01, 0x01, 0x26, 0x01, 0x70, 0x17, 0xd2, 0x0c, 0xc8, 0x14, 0xb9, 0x00, 
+0xf3, 0x18, 0x82, 0x0a, 0x1c, 0x0e, 0x85, 0x0c, 0x30, 0x0a, 0xf9, 0x17, 
+0x1a, 0x00, 0xd6, 0x18, 0xe9, 0x0a, 0xa4, 0x0b, 0x5c, 0x0c, 0x8f, 0x00, 
+0x60, 0x0f, 0xbb, 0x16, 0xf6, 0x10, 0xf8, 0x06, 0x5f, 0x12, 0x78, 0x0b, 
+0x20, 0x0a, 0x53, 0x0c, 0xde, 0x13, 0xe8, 0x0c, 0xe6, 0x0d, 0x3a, 0x1a, 
+0x95, 0x00, 0x3e, 0x00, 0x02, 0x01, 0xcf, 0x06, 0x9c, 0x08, 0xd6, 0x16, 
+0x3e, 0x01, 0xa2, 0x0c, 0x09, 0x01, 0x08, 0x0d, 0x09, 0x0b, 0xe6, 0x0d, 
+0xd6, 0x14, 0xf2, 0x00, 0x89, 0x10, 0x43, 0x0c, 0xa1, 0x0b, 0x0b, 0x0a, 
+0x65, 0x0c, 0x6d, 0x00, 0xd7, 0x00, 0xdb, 0x0a, 0x1b, 0x07, 0x1f, 0x0c, 
+0x1e, 0x14, 0x96, 0x0b, 0xc7, 0x00, 0x69, 0x06, 0x8d, 0x0a, 0x29, 0x0e, 
+0x67, 0x14, 0x1f, 0x0b, 0xad, 0x0a, 0xd6, 0x17, 0x4e, 0x17, 0x2e, 0x07, 
+0xe8, 0x07, 0x4a, 0x14, 0xa5, 0x09, 0x23, 0x01, 0xdb, 0x09, 0x11, 0x09, 
+0xa5, 0x0a, 0xad, 0x00, 0x1c, 0x00, 0x59, 0x0c, 0xc4, 0x00, 0x21, 0x0a, 
+0x23, 0x09, 0xb1, 0x09, 0x01, 0x0c, 0xe4, 0x08, 0x85, 0x16, 0x54, 0x00, 
+0x44, 0x06, 0x05, 0x01, 0x40, 0x0a, 0xf7, 0x00, 0x0c, 0x0c, 0xf1, 0x13, 
+0x86, 0x00, 0x8e, 0x06, 0x26, 0x00, 0xd3, 0x00, 0x1c, 0x17, 0x01, 0x01, 
+0x41, 0x16, 0xc0, 0x0e, 0x19, 0x0d, 0x54, 0x07, 0xd9, 0x11, 0xcd, 0x00, 
+0xaa, 0x1a, 0x0b, 0x0a, 0x94, 0x00, 0x2b, 0x01, 0x0c, 0x13, 0xed, 0x09, 
+0x48, 0x0b, 0x7a, 0x00, 0xdb, 0x09, 0xd3, 0x00, 0xe8, 0x00, 0xbb, 0x09, 
+0xc4, 0x14, 0x16, 0x14, 0xc6, 0x00, 0x74, 0x0c, 0xe0, 0x00, 0xa8, 0x08, 
+0xcb, 0x00, 0xc6, 0x00, 0xcd, 0x00, 0xd2, 0x09, 0x1f, 0x00, 0xbb, 0x09, 
+0x28, 0x00, 0xd3, 0x00, 0x79, 0x10, 0x10, 0x0c, 0xc7, 0x0c, 0xd9, 0x00, 
+0xa4, 0x09, 0x38, 0x0c, 0x0b, 0x00, 0xe6, 0x0a, 0x66, 0x08, 0x61, 0x00, 
+0x75, 0x16, 0x4f, 0x00, 0xea, 0x00, 0x1e, 0x01, 0xdd, 0x00, 0x3e, 0x09, 
+0xd6, 0x00, 0xa7, 0x00, 0x2b, 0x0a, 0x92, 0x00, 0xc5, 0x0b, 0x79, 0x10, 
+0xd7, 0x00, 0xc3, 0x00, 0x79, 0x10, 0x66, 0x0c, 0x05, 0x0c, 0x0d, 0x01, 
+0xbc, 0x0c, 0x43, 0x13, 0xc7, 0x00, 0xdf, 0x00, 0x03, 0x0c, 0xdc, 0x06, 
+0xc1, 0x0c, 0xd6, 0x07, 0x3d, 0x01, 0x22, 0x09, 0x9b, 0x0c, 0x99, 0x12, 
+0xbe, 0x00, 0x33, 0x01, 0xff, 0x0a, 0x50, 0x17, 0x2f, 0x00, 0x9a, 0x0a, 
+0xdf, 0x00, 0x3b, 0x00, 0x32, 0x01, 0x65, 0x00, 0x82, 0x0b, 0xad, 0x00, 
+0x8f, 0x00, 0x49, 0x06, 0x97, 0x06, 0x1d, 0x0a, 0x41, 0x06, 0x24, 0x01, 
+0xaf, 0x11, 0x87, 0x0d, 0x3f, 0x0c, 0x95, 0x07, 0x3b, 0x00, 0x8b, 0x0a, 
+0x2f, 0x08, 0x02, 0x15, 0x24, 0x10, 0xbc, 0x00, 0x5a, 0x0c, 0xca, 0x09, 
+0x6b, 0x1a, 0x4f, 0x0e, 0x8c, 0x0a, 0xf7, 0x00, 0xf7, 0x0b, 0x3e, 0x01, 
+0x05, 0x0b, 0xe0, 0x0b, 0xc5, 0x09, 0xb0, 0x19, 0x36, 0x0a, 0x47, 0x06, 
+0x0d, 0x00, 0xf9, 0x13, 0x75, 0x0a, 0xf1, 0x14, 0x70, 0x08, 0x65, 0x18, 
+0x13, 0x09, 0x63, 0x00, 0x32, 0x19, 0xd3, 0x11, 0x4c, 0x00, 0xb6, 0x00, 
+0x57, 0x06, 0x38, 0x01, 0xbc, 0x0c, 0x16, 0x0a, 0x7d, 0x0b, 0xdb, 0x09, 
+0xba, 0x14, 0x6c, 0x00, 0x56, 0x18, 0xc5, 0x00, 0xdb, 0x00, 0xf1, 0x1a, 
+0x0d, 0x01, 0x1c, 0x07, 0xcf, 0x15, 0xe9, 0x00, 0xc7, 0x00, 0x85, 0x00, 
+0x24, 0x00, 0xf3, 0x0b, 0x45, 0x0f, 0xac, 0x06, 0x6c, 0x0d, 0xf1, 0x00, 
+0x18, 0x01, 0x3b, 0x0c, 0x3b, 0x00, 0xfc, 0x16, 0xc0, 0x00, 0x2f, 0x0f, 
+0x55, 0x00, 0xc1, 0x00, 0xa9, 0x0c, 0x10, 0x01, 0xe3, 0x0b, 0x12, 0x0a, 
+0x06, 0x01, 0x0f, 0x15, 0x8e, 0x0a, 0xa5, 0x09, 0xe3, 0x00, 0xf9, 0x0a, 
+0x92, 0x0c, 0xfd, 0x00, 0x0f, 0x0c, 0xac, 0x00, 0xa8, 0x00, 0x5f, 0x0a, 
+0x5f, 0x00, 0xbd, 0x11, 0x6c, 0x1a, 0x12, 0x0d, 0xda, 0x06, 0x2b, 0x00, 
+0x1e, 0x01, 0xb9, 0x00, 0x35, 0x00, 0xf3, 0x14, 0x28, 0x15, 0x99, 0x0a, 
+0x85, 0x11, 0x1c, 0x01, 0xf8, 0x09, 0x03, 0x16, 0xfa, 0x0c, 0x4e, 0x0c, 
+0xaa, 0x0b, 0xe4, 0x07, 0x95, 0x00, 0x16, 0x01, 0x54, 0x0a, 0x48, 0x06, 
+0x8a, 0x0c, 0x4e, 0x06, 0xbd, 0x11, 0x3d, 0x01, 0xb9, 0x0a, 0x26, 0x12, 
+0x2b, 0x0f, 0xba, 0x00, 0x55, 0x0b, 0x0b, 0x01, 0xd4, 0x0a, 0x61, 0x0a, 
+0xe7, 0x09, 0xd4, 0x0c, 0xb2, 0x00, 0x26, 0x09, 0x42, 0x0a, 0x06, 0x01, 
+0xe8, 0x00, 0x96, 0x0b, 0x11, 0x07, 0x7e, 0x0f, 0x45, 0x0c, 0xaf, 0x00, 
+0x27, 0x0b, 0x3d, 0x13, 0xf2, 0x14, 0x3b, 0x00, 0x35, 0x0c, 0x41, 0x00, 
+0xf3, 0x0c, 0x14, 0x01, 0xee, 0x0c, 0x2e, 0x0c, 0x3e, 0x09, 0x21, 0x01, 
+0xce, 0x15, 0x70, 0x0c, 0x9b, 0x0c, 0x50, 0x00, 0xc2, 0x00, 0x1e, 0x01, 
+0xf0, 0x0a, 0x4d, 0x06, 0xde, 0x06, 0xf1, 0x13, 0xeb, 0x0c, 0x3b, 0x00, 
+0x0f, 0x01, 0x14, 0x07, 0x4f, 0x06, 0xa0, 0x12, 0x43, 0x06, 0x0d, 0x00, 
+0x72, 0x0c, 0xbc, 0x00, 0x53, 0x07, 0xa1, 0x00, 0x8c, 0x11, 0xe0, 0x13, 
+0x4a, 0x14, 0x1d, 0x0a, 0x16, 0x0b, 0xba, 0x09, 0xe3, 0x0b, 0xd1, 0x14, 
+0xa7, 0x00, 0xcd, 0x0b, 0xeb, 0x00, 0xe4, 0x0a, 0xd8, 0x0a, 0x7e, 0x0b, 
+0x9b, 0x06, 0xbc, 0x0c, 0x39, 0x0c, 0x6f, 0x0a, 0x1e, 0x01, 0xf0, 0x00, 
+0xb8, 0x0a, 0x90, 0x06, 0x1d, 0x00, 0xdf, 0x08, 0x88, 0x0b, 0x47, 0x06, 
+0x1e, 0x0a, 0x67, 0x07, 0xdd, 0x00, 0x0d, 0x13, 0x74, 0x0c, 0x0c, 0x01, 
+0xfb, 0x00, 0x3c, 0x00, 0x36, 0x09, 0xb2, 0x1c, 0x43, 0x06, 0xda, 0x0b, 
+0x36, 0x00, 0xc8, 0x00, 0xdc, 0x0d, 0x42, 0x06, 0xde, 0x0c, 0x55, 0x0b, 
+0x7e, 0x0b, 0xfc, 0x09, 0x67, 0x0b, 0xa0, 0x15, 0xc8, 0x00, 0x37, 0x0a, 
+0xa0, 0x0c, 0x20, 0x0d, 0x5f, 0x00, 0xce, 0x06, 0x46, 0x0d, 0x07, 0x01, 
+0x35, 0x09, 0xea, 0x00, 0xd4, 0x06, 0xdd, 0x00, 0x10, 0x07, 0xac, 0x07, 
+0xb3, 0x08, 0x98, 0x0a, 0xcd, 0x00, 0x0d, 0x00, 0xf6, 0x0b, 0xd3, 0x0c, 
+0x88, 0x0b, 0x26, 0x0d, 0x33, 0x0b, 0x01, 0x01, 0xb8, 0x11, 0x9e, 0x00, 
+0xa1, 0x0a, 0x25, 0x07, 0x24, 0x01, 0xcd, 0x0a, 0xd3, 0x18, 0x2f, 0x0c, 
+0xa8, 0x0a, 0xf7, 0x07, 0xd6, 0x07, 0x8a, 0x00, 0xfa, 0x0a, 0x8e, 0x0a, 
+0x79, 0x0b, 0xff, 0x0e, 0x19, 0x00, 0xf9, 0x00, 0x27, 0x12, 0xc1, 0x00, 
+0xce, 0x06, 0x15, 0x0a, 0x3f, 0x0f, 0x64, 0x0d, 0xaa, 0x07, 0x5a, 0x0c, 
+0xca, 0x12, 0x65, 0x09, 0x85, 0x0a, 0xdf, 0x09, 0x8b, 0x11, 0xac, 0x15, 
+0xf4, 0x07, 0xb6, 0x11, 0xed, 0x00, 0x10, 0x0b, 0x71, 0x0a, 0x8f, 0x09, 
+0xf5, 0x0d, 0xde, 0x09, 0x35, 0x0d, 0xbe, 0x00, 0x3e, 0x00, 0x31, 0x01, 
+0xf7, 0x00, 0x04, 0x15, 0x34, 0x01, 0x18, 0x00, 0xe3, 0x1a, 0x8c, 0x06, 
+0x26, 0x08, 0x11, 0x07, 0xe1, 0x00, 0xee, 0x0d, 0x68, 0x07, 0xac, 0x0b, 
+0x5b, 0x0c, 0xac, 0x00, 0xf7, 0x0f, 0x80, 0x12, 0x6f, 0x10, 0x8c, 0x12, 
+0xe4, 0x0d, 0xe1, 0x08, 0xf4, 0x08, 0x0d, 0x00, 0x84, 0x00, 0x2c, 0x0a, 
+0xf1, 0x00, 0xa4, 0x09, 0x12, 0x07, 0x37, 0x08, 0x6d, 0x11, 0x14, 0x0b, 
+0x8b, 0x13, 0xba, 0x0e, 0x0e, 0x00, 0x2a, 0x01, 0x55, 0x07, 0xeb, 0x09, 
+0x66, 0x00, 0x9b, 0x0c, 0x30, 0x0b, 0xd3, 0x0c, 0xae, 0x10, 0x60, 0x18, 
+0x1c, 0x00, 0xcf, 0x18, 0x2e, 0x0c, 0x14, 0x0a, 0x34, 0x0b, 0x57, 0x11, 
+0x08, 0x09, 0xb1, 0x08, 0xb8, 0x0a, 0x52, 0x13, 0x30, 0x0c, 0x47, 0x06, 
+0xda, 0x0a, 0x0a, 0x0a, 0x1a, 0x0b, 0x51, 0x14, 0x1e, 0x0c, 0x26, 0x1a, 
+0xe4, 0x0a, 0x53, 0x07, 0xb0, 0x00, 0x4a, 0x06, 0x9c, 0x08, 0x2d, 0x0c, 
+0xa5, 0x09, 0x10, 0x01, 0x7f, 0x07, 0xbc, 0x0c, 0xca, 0x00, 0x35, 0x0a, 
+0x85, 0x0c, 0x6d, 0x1a, 0x4f, 0x0a, 0xd5, 0x0b, 0xab, 0x0c, 0xa5, 0x06, 
+0x53, 0x19, 0xdf, 0x08, 0x10, 0x0f, 0x32, 0x00, 0xae, 0x0a, 0xdf, 0x00, 
+0xe9, 0x00, 0x1e, 0x07, 0xdb, 0x00, 0x2e, 0x01, 0x4a, 0x0b, 0xe3, 0x0a, 
+0xaa, 0x07, 0xf0, 0x06, 0x00, 0x01, 0xdb, 0x06, 0xa7, 0x00, 0xd2, 0x00, 
+0xc3, 0x0b, 0xfb, 0x15, 0xd2, 0x06, 0xd6, 0x15, 0x5a, 0x06, 0x01, 0x19, 
+0xfa, 0x13, 0x08, 0x18, 0x1f, 0x13, 0x5f, 0x12, 0x5c, 0x08, 0xee, 0x0e, 
+0xfc, 0x09, 0xcc, 0x0b, 0xc8, 0x00, 0x3c, 0x00, 0x55, 0x00, 0xd5, 0x11, 
+0x1b, 0x08, 0xde, 0x09, 0x0e, 0x00, 0x9b, 0x00, 0xcb, 0x00, 0xac, 0x00, 
+0xb2, 0x00, 0xe8, 0x10, 0x56, 0x06, 0xca, 0x0b, 0x05, 0x0b, 0xdc, 0x00, 
+0x65, 0x09, 0x52, 0x07, 0xb6, 0x00, 0xe0, 0x0b, 0x77, 0x13, 0x3e, 0x0a, 
+0xc9, 0x09, 0x7e, 0x06, 0xeb, 0x0c, 0xde, 0x00, 0xb7, 0x0a, 0x96, 0x17, 
+0xcc, 0x0b, 0x3c, 0x10, 0xc3, 0x00, 0xe9, 0x13, 0x16, 0x01, 0xbd, 0x00, 
+0xe1, 0x08, 0x1c, 0x0f, 0xf9, 0x0a, 0x9e, 0x00, 0xeb, 0x09, 0xed, 0x00, 
+0xe2, 0x00, 0xd7, 0x0b, 0x0d, 0x01, 0x68, 0x0c, 0x70, 0x0c, 0xc0, 0x00, 
+0xab, 0x00, 0xb6, 0x10, 0x63, 0x00, 0xbb, 0x14, 0x4c, 0x13, 0xd0, 0x15, 
+0x1d, 0x0f, 0xd7, 0x18, 0xd2, 0x0c, 0xaf, 0x00, 0x41, 0x06, 0xd9, 0x15, 
+0x3b, 0x13, 0xdd, 0x14, 0x12, 0x07, 0xd4, 0x0e, 0xcf, 0x00, 0xe4, 0x0d, 
+0xb1, 0x0c, 0x59, 0x0c, 0x5c, 0x0c, 0x9f, 0x08, 0xb1, 0x0c, 0x70, 0x00, 
+0xce, 0x0a, 0x08, 0x01, 0xac, 0x00, 0xd6, 0x15, 0xbd, 0x0c, 0xf8, 0x0c, 
+0xb2, 0x09, 0x85, 0x00, 0x94, 0x07, 0xfb, 0x15, 0x39, 0x0d, 0xc7, 0x0c, 
+0xab, 0x06, 0xf4, 0x00, 0x15, 0x01, 0xed, 0x07, 0x6f, 0x00, 0x4a, 0x11, 
+0xee, 0x0a, 0x25, 0x0b, 0x1a, 0x0d, 0xa4, 0x09, 0x1b, 0x07, 0xdf, 0x0c, 
+0x57, 0x07, 0x11, 0x0b, 0xce, 0x00, 0xfb, 0x06, 0x56, 0x06, 0xf3, 0x0a, 
+0xb0, 0x11, 0x0a, 0x0d, 0x4e, 0x0a, 0x48, 0x0d, 0x68, 0x06, 0x32, 0x00, 
+0xa0, 0x0b, 0xce, 0x0c, 0xde, 0x0d, 0x11, 0x0c, 0x64, 0x0c, 0x85, 0x00, 
+0x5d, 0x12, 0xd3, 0x0a, 0x3f, 0x0d, 0x51, 0x17, 0x8b, 0x0b, 0x66, 0x14, 
+0x66, 0x12, 0xef, 0x0b, 0x18, 0x08, 0xfd, 0x00, 0xf4, 0x13, 0xe3, 0x07, 
+0xb3, 0x08, 0xe2, 0x00, 0xd5, 0x09, 0x66, 0x09, 0x52, 0x07, 0x26, 0x07, 
+0xde, 0x0a, 0x4d, 0x11, 0x78, 0x10, 0x9d, 0x17, 0x71, 0x10, 0x6a, 0x0a, 
+0xe5, 0x0b, 0x77, 0x09, 0x95, 0x0c, 0xa9, 0x09, 0xec, 0x0b, 0xcb, 0x0c, 
+0x01, 0x01, 0x31, 0x01, 0x44, 0x0c, 0xc6, 0x00, 0xd5, 0x09, 0xd6, 0x17, 
+0xb4, 0x09, 0x71, 0x13, 0xbf, 0x06, 0x8b, 0x0a, 0x85, 0x16, 0x17, 0x0c, 
+0xce, 0x18, 0x83, 0x00, 0xf4, 0x0c, 0xa3, 0x0a, 0x86, 0x0c, 0x42, 0x09, 
+0xac, 0x08, 0x8f, 0x0d, 0xb0, 0x12, 0xc0, 0x0b, 0x15, 0x01, 0xab, 0x0b, 
+0x1c, 0x07, 0xcd, 0x0a, 0x83, 0x00, 0xfa, 0x15, 0x72, 0x0b, 0x9e, 0x00, 
+0xb1, 0x08, 0xbd, 0x0c, 0xff, 0x00, 0x95, 0x16, 0x4b, 0x06, 0xbe, 0x00, 
+0x52, 0x0b, 0x81, 0x09, 0xef, 0x00, 0xc0, 0x0b, 0x23, 0x01, 0x47, 0x06, 
+0x2e, 0x07, 0x2f, 0x0c, 0x12, 0x0a, 0x15, 0x01, 0xe9, 0x13, 0x37, 0x0d, 
+0x86, 0x0c, 0xe4, 0x1a, 0xbe, 0x0a, 0x90, 0x00, 0x4a, 0x11, 0x4a, 0x11, 
+0x30, 0x0c, 0x3b, 0x01, 0x0c, 0x01, 0xb2, 0x0c, 0xfb, 0x0b, 0xb3, 0x16, 
+0xc4, 0x14, 0x48, 0x06, 0x31, 0x01, 0x07, 0x0a, 0xed, 0x0e, 0x11, 0x01, 
+0xfe, 0x0c, 0x62, 0x09, 0x86, 0x00, 0xfd, 0x09, 0xee, 0x00, 0x1d, 0x0c, 
+0xf4, 0x07, 0xa2, 0x0a, 0xe5, 0x00, 0xc2, 0x08, 0x57, 0x06, 0x81, 0x09, 
+0x2f, 0x00, 0x69, 0x08, 0xce, 0x00, 0x77, 0x0b, 0x81, 0x11, 0x98, 0x07, 
+0xb4, 0x09, 0x90, 0x00, 0x6e, 0x09, 0xed, 0x06, 0xb3, 0x00, 0x1e, 0x16, 
+0x33, 0x0b, 0xeb, 0x00, 0x24, 0x08, 0x8c, 0x0a, 0x19, 0x08, 0x28, 0x01, 
+0x23, 0x09, 0xf6, 0x0b, 0xc3, 0x00, 0x39, 0x01, 0x72, 0x0b, 0x94, 0x0b, 
+0x1d, 0x0a, 0x56, 0x00, 0xab, 0x00, 0xbb, 0x09, 0xb3, 0x00, 0x25, 0x07, 
+0x8e, 0x16, 0x08, 0x01, 0xfe, 0x00, 0x9a, 0x15, 0x44, 0x06, 0x4a, 0x0b, 
+0xc2, 0x09, 0xde, 0x00, 0x9c, 0x00, 0x20, 0x01, 0xb0, 0x00, 0x2c, 0x01, 
+0xd4, 0x00, 0x26, 0x09, 0x26, 0x00, 0xd2, 0x0f, 0xcf, 0x0b, 0x41, 0x06, 
+0x9e, 0x00, 0x49, 0x06, 0xf2, 0x09, 0x45, 0x06, 0xc1, 0x0e, 0x79, 0x07, 
+0x3f, 0x01, 0x3c, 0x00, 0xee, 0x0b, 0x14, 0x01, 0x1a, 0x01, 0xb9, 0x0e, 
+0x8c, 0x11, 0x8d, 0x16, 0xdc, 0x0f, 0xfd, 0x0a, 0x5e, 0x18, 0x98, 0x06, 
+0x92, 0x0a, 0xa7, 0x08, 0xb4, 0x0b, 0x3e, 0x0c, 0x02, 0x01, 0x1b, 0x01, 
+0x16, 0x01, 0xe6, 0x0a, 0x44, 0x00, 0xd2, 0x0c, 0x56, 0x00, 0x4f, 0x0c, 
+0x49, 0x0a, 0x06, 0x0b, 0x77, 0x09, 0x77, 0x09, 0x2d, 0x01, 0x98, 0x15, 
+0x3b, 0x01, 0x57, 0x06, 0x05, 0x09, 0xc8, 0x0c, 0x90, 0x0c, 0xaf, 0x11, 
+0x11, 0x0d, 0x2e, 0x0a, 0x26, 0x0d, 0x77, 0x0a, 0x53, 0x0b, 0xf7, 0x0f, 
+0x33, 0x12, 0xde, 0x00, 0x83, 0x00, 0xa7, 0x09, 0xc7, 0x17, 0x23, 0x09, 
+0xfa, 0x15, 0xe3, 0x00, 0x06, 0x0c, 0xea, 0x13, 0x3d, 0x00, 0x4a, 0x11, 
+0x96, 0x0b, 0x41, 0x01, 0x6c, 0x0a, 0xf5, 0x0c, 0xe5, 0x0d, 0xe6, 0x09, 
+0x47, 0x00, 0xde, 0x06, 0xef, 0x0c, 0x1f, 0x01, 0xb3, 0x16, 0xa7, 0x09, 
+0x90, 0x0c, 0xa6, 0x0f, 0xcb, 0x0b, 0x49, 0x00, 0xc3, 0x0a, 0x2f, 0x00, 
+0xce, 0x0a, 0x41, 0x01, 0xb9, 0x0a, 0xbf, 0x0b, 0xeb, 0x00, 0x43, 0x0a, 
+0xb8, 0x09, 0xd7, 0x00, 0x6b, 0x0a, 0xb1, 0x09, 0xb9, 0x00, 0x92, 0x0f, 
+0x89, 0x00, 0xc2, 0x00, 0x92, 0x0f, 0xdc, 0x09, 0x3e, 0x0a, 0xb5, 0x12, 
+0x0e, 0x00, 0x47, 0x06, 0x47, 0x00, 0x44, 0x14, 0x00, 0x0f, 0xef, 0x0e, 
+0x3d, 0x07, 0x98, 0x17, 0x0d, 0x01, 0x91, 0x00, 0x62, 0x06, 0x86, 0x00, 
+0xd0, 0x00, 0xe4, 0x00, 0x3c, 0x01, 0x2d, 0x14, 0xdf, 0x0d, 0x26, 0x00, 
+0x49, 0x1a, 0xdf, 0x15, 0x39, 0x08, 0xac, 0x0a, 0x56, 0x0a, 0xdc, 0x09, 
+0x99, 0x0a, 0xb2, 0x00, 0x7e, 0x0b, 0xa8, 0x08, 0xfc, 0x16, 0x2b, 0x00, 
+0xc5, 0x0a, 0xb7, 0x09, 0x29, 0x01, 0x3f, 0x0e, 0x3f, 0x0a, 0x19, 0x0d, 
+0x13, 0x01, 0x0a, 0x15, 0x60, 0x0f, 0xc6, 0x09, 0x95, 0x0b, 0xa1, 0x00, 
+0xaa, 0x0b, 0xcd, 0x0a, 0xea, 0x00, 0xe1, 0x00, 0xe9, 0x00, 0x47, 0x06, 
+0xfe, 0x00, 0x20, 0x09, 0xe2, 0x07, 0x9a, 0x06, 0x42, 0x14, 0x67, 0x0b, 
+0x2f, 0x01, 0x01, 0x01, 0x06, 0x01, 0x78, 0x0a, 0xa1, 0x00, 0xdb, 0x00, 
+0x75, 0x12, 0x53, 0x0b, 0xe5, 0x00, 0x2b, 0x01, 0xd8, 0x17, 0xc8, 0x00, 
+0xfa, 0x15, 0x29, 0x01, 0x67, 0x1b, 0xe2, 0x0b, 0xa8, 0x00, 0x67, 0x07, 
+0x8f, 0x00, 0xbe, 0x00, 0x29, 0x0a, 0xea, 0x09, 0x7b, 0x0c, 0x31, 0x01, 
+0x19, 0x01, 0x3e, 0x00, 0xf3, 0x00, 0xb2, 0x07, 0xca, 0x0b, 0x4e, 0x17, 
+0x23, 0x01, 0xfe, 0x10, 0x1d, 0x08, 0xe7, 0x00, 0x87, 0x16, 0x5d, 0x0b, 
+0x12, 0x0a, 0x54, 0x07, 0x10, 0x07, 0xa8, 0x09, 0x5f, 0x07, 0xfe, 0x0c, 
+0xa4, 0x09, 0x2a, 0x12, 0xed, 0x08, 0x0b, 0x00, 0x9e, 0x08, 0x75, 0x12, 
+0xaa, 0x00, 0xce, 0x15, 0x6f, 0x12, 0x78, 0x00, 0x5f, 0x00, 0xe4, 0x07, 
+0x56, 0x00, 0x3d, 0x01, 0xfa, 0x15, 0xe8, 0x13, 0xa5, 0x0f, 0xb8, 0x00, 
+0x1f, 0x15, 0xf5, 0x00, 0xed, 0x0b, 0x25, 0x00, 0xf7, 0x0f, 0x4a, 0x0b, 
+0x39, 0x13, 0x12, 0x0d, 0x9e, 0x0b, 0xf2, 0x18, 0x4b, 0x10, 0x88, 0x0b, 
+0x04, 0x01, 0x7b, 0x0a, 0xd7, 0x16, 0x22, 0x0e, 0x04, 0x01, 0xf3, 0x14, 
+0x2f, 0x01, 0xe6, 0x06, 0xb9, 0x00, 0xd2, 0x0c, 0xbc, 0x0a, 0x15, 0x01, 
+0x13, 0x0d, 0xd4, 0x0f, 0x40, 0x01, 0xc9, 0x0c, 0x86, 0x00, 0x3e, 0x01, 
+0xc3, 0x0b, 0xa7, 0x00, 0x86, 0x0d, 0x46, 0x16, 0xa1, 0x0a, 0x71, 0x06, 
+0xe0, 0x09, 0xed, 0x09, 0x02, 0x01, 0xbb, 0x12, 0x89, 0x0b, 0x1b, 0x0b, 
+0x75, 0x16, 0x28, 0x14, 0x42, 0x01, 0x8a, 0x0c, 0x20, 0x09, 0x9e, 0x08, 
+0x93, 0x00, 0x5f, 0x0a, 0x2f, 0x00, 0x46, 0x06, 0xe9, 0x13, 0x19, 0x0d, 
+0xcd, 0x0a, 0x47, 0x0b, 0xc2, 0x0a, 0x75, 0x0b, 0x50, 0x17, 0x5f, 0x00, 
+0x08, 0x0a, 0x0c, 0x0c, 0xa7, 0x0a, 0x90, 0x00, 0x57, 0x06, 0xe1, 0x07, 
+0xde, 0x00, 0xf3, 0x00, 0x2f, 0x07, 0x4a, 0x00, 0x2d, 0x08, 0x49, 0x06, 
+0x07, 0x0a, 0x5b, 0x0f, 0xbd, 0x00, 0x0c, 0x01, 0x27, 0x01, 0x86, 0x0b, 
+0x63, 0x0a, 0x90, 0x10, 0x58, 0x00, 0xa6, 0x00, 0x14, 0x01, 0x61, 0x00, 
+0x9f, 0x0c, 0x42, 0x06, 0x97, 0x06, 0x9c, 0x00, 0xfc, 0x09, 0xaa, 0x0a, 
+0x2b, 0x16, 0x29, 0x0a, 0x1e, 0x01, 0x8e, 0x06, 0x41, 0x06, 0x40, 0x0c, 
+0x61, 0x00, 0xb1, 0x09, 0xa4, 0x00, 0x15, 0x0b, 0x9b, 0x0a, 0xeb, 0x0c, 
+0x56, 0x00, 0xfa, 0x14, 0xdf, 0x13, 0x23, 0x00, 0x5f, 0x0a, 0xaf, 0x10, 
+0xfe, 0x00, 0x1c, 0x0c, 0xb7, 0x0a, 0xbe, 0x09, 0x2f, 0x01, 0x9d, 0x00, 
+0xba, 0x17, 0xea, 0x00, 0xa0, 0x07, 0x2e, 0x0c, 0x59, 0x0c, 0x4a, 0x06, 
+0x5d, 0x0c, 0x3e, 0x0c, 0x27, 0x16, 0x46, 0x0b, 0x18, 0x00, 0xbb, 0x00, 
+0x18, 0x08, 0xc8, 0x09, 0x1f, 0x01, 0x11, 0x01, 0x2d, 0x0c, 0xba, 0x00, 
+0x68, 0x0b, 0xdb, 0x06, 0x73, 0x0b, 0x77, 0x16, 0x94, 0x0f, 0x24, 0x09, 
+0xd6, 0x16, 0xb6, 0x12, 0xf6, 0x0b, 0xce, 0x0b, 0x26, 0x14, 0xd0, 0x18, 
+0x40, 0x07, 0x0d, 0x01, 0xa6, 0x0c, 0x11, 0x00, 0xf0, 0x0b, 0xf6, 0x0b, 
+0x96, 0x17, 0x08, 0x0a, 0x6c, 0x00, 0xb8, 0x0c, 0x78, 0x19, 0xf3, 0x00, 
+0x77, 0x06, 0xc6, 0x00, 0x3e, 0x0c, 0x50, 0x12, 0x84, 0x00, 0xde, 0x00, 
+0x45, 0x06, 0xdb, 0x10, 0xb2, 0x08, 0x05, 0x0a, 0xbf, 0x06, 0x9f, 0x0b, 
+0x71, 0x00, 0xdb, 0x0a, 0x63, 0x0e, 0xd0, 0x00, 0xfd, 0x09, 0x6e, 0x00, 
+0xe6, 0x00, 0x6c, 0x00, 0x23, 0x08, 0x2f, 0x00, 0x3b, 0x01, 0xbc, 0x14, 
+0xb5, 0x00, 0x43, 0x14, 0xc7, 0x0c, 0x62, 0x09, 0x3e, 0x0a, 0x91, 0x00, 
+0x19, 0x08, 0x37, 0x10, 0xab, 0x00, 0x41, 0x18, 0x5f, 0x00, 0x2d, 0x08, 
+0xe4, 0x0b, 0xde, 0x08, 0x9f, 0x0c, 0x9d, 0x00, 0x98, 0x0b, 0x47, 0x0c, 
+0xe9, 0x00, 0x2f, 0x01, 0x86, 0x17, 0xc2, 0x00, 0xe3, 0x07, 0xbd, 0x00, 
+0x9e, 0x0c, 0x9b, 0x0c, 0x16, 0x01, 0x56, 0x06, 0x1e, 0x14, 0xb7, 0x0a, 
+0x6c, 0x00, 0xbb, 0x16, 0xcc, 0x0e, 0xec, 0x0b, 0xd3, 0x00, 0xf9, 0x00, 
+0xcf, 0x06, 0x70, 0x07, 0x17, 0x00, 0x33, 0x0a, 0x54, 0x17, 0x15, 0x0e, 
+0x5f, 0x07, 0x68, 0x14, 0xdb, 0x0a, 0xb1, 0x08, 0x72, 0x17, 0x9d, 0x08, 
+0xc4, 0x16, 0x9b, 0x0c, 0x8d, 0x16, 0xe3, 0x17, 0x0b, 0x0b, 0xc0, 0x00, 
+0xdb, 0x0b, 0x04, 0x0b, 0xf6, 0x0a, 0xe3, 0x0b, 0x53, 0x07, 0x09, 0x0d, 
+0x54, 0x0a, 0xfa, 0x00, 0xa1, 0x15, 0x28, 0x07, 0xdd, 0x0a, 0x6e, 0x14, 
+0x2d, 0x14, 0x98, 0x06, 0x47, 0x06, 0x40, 0x06, 0xe7, 0x06, 0x01, 0x01, 
+0xf7, 0x0c, 0x2a, 0x01, 0x17, 0x14, 0x98, 0x17, 0x11, 0x0b, 0xd5, 0x0c, 
+0xe8, 0x0c, 0x28, 0x0a, 0xca, 0x0c, 0x67, 0x18, 0xff, 0x00, 0xed, 0x07, 
+0xb1, 0x00, 0xb2, 0x0a, 0x9c, 0x0a, 0x12, 0x0a, 0xb3, 0x09, 0xf1, 0x00, 
+0x8f, 0x06, 0xfa, 0x14, 0x1b, 0x07, 0xdb, 0x00, 0x8f, 0x00, 0xd7, 0x07, 
+0x93, 0x0c, 0x83, 0x00, 0xe5, 0x06, 0xfa, 0x16, 0x05, 0x10, 0xd2, 0x18, 
+0xbf, 0x09, 0xb5, 0x00, 0xbf, 0x0b, 0xe3, 0x07, 0x39, 0x13, 0x26, 0x01, 
+0x5c, 0x17, 0x20, 0x14, 0x9c, 0x08, 0x55, 0x00, 0x36, 0x01, 0x88, 0x0b, 
+0x30, 0x0f, 0xb1, 0x15, 0x93, 0x06, 0x39, 0x0a, 0x14, 0x0b, 0xad, 0x08, 
+0xf1, 0x09, 0x49, 0x00, 0x46, 0x0b, 0xd4, 0x00, 0x62, 0x09, 0x80, 0x0b, 
+0x31, 0x00, 0xe4, 0x17, 0x3e, 0x00, 0xf3, 0x0c, 0xff, 0x09, 0x34, 0x01, 
+0x2b, 0x18, 0x3f, 0x0f, 0xae, 0x10, 0xed, 0x00, 0xaa, 0x0b, 0xee, 0x00, 
+0x42, 0x14, 0x2a, 0x0a, 0x04, 0x0c, 0xd0, 0x0a, 0x9b, 0x06, 0x9c, 0x0c, 
+0x01, 0x0c, 0x9d, 0x08, 0x73, 0x16, 0x2c, 0x00, 0xfd, 0x09, 0xb4, 0x0c, 
+0x6e, 0x00, 0x20, 0x01, 0xc0, 0x00, 0xe0, 0x00, 0x02, 0x13, 0x2d, 0x0c, 
+0xf9, 0x00, 0x5a, 0x08, 0xd8, 0x07, 0x53, 0x07, 0x22, 0x01, 0x49, 0x0a, 
+0xec, 0x06, 0x8e, 0x17, 0xb2, 0x0a, 0x0b, 0x00, 0x9f, 0x08, 0x8f, 0x00, 
+0xbd, 0x09, 0xcc, 0x0c, 0x2c, 0x01, 0x21, 0x0a, 0x6b, 0x17, 0xaf, 0x09, 
+0xe6, 0x00, 0x52, 0x17, 0x40, 0x0b, 0xdd, 0x00, 0xa9, 0x07, 0x47, 0x0b, 
+0x01, 0x01, 0xca, 0x00, 0x11, 0x0d, 0x67, 0x00, 0xd8, 0x00, 0x13, 0x0b, 
+0xf2, 0x18, 0x56, 0x0a, 0x62, 0x09, 0x2a, 0x18, 0x68, 0x1c, 0xc4, 0x00, 
+0x93, 0x0b, 0x60, 0x0c, 0x93, 0x0b, 0xa3, 0x0a, 0xa1, 0x00, 0xae, 0x16, 
+0x19, 0x01, 0xc3, 0x15, 0x71, 0x0d, 0x1d, 0x01, 0x87, 0x0c, 0xe8, 0x00, 
+0xd5, 0x0c, 0x58, 0x17, 0xbc, 0x0a, 0x94, 0x0c, 0xd5, 0x00, 0x6d, 0x14, 
+0xfa, 0x0b, 0xf3, 0x00, 0x2f, 0x07, 0xcf, 0x15, 0xf1, 0x09, 0xe7, 0x0a, 
+0x2a, 0x01, 0x48, 0x06, 0xe6, 0x00, 0x09, 0x16, 0xd3, 0x00, 0x25, 0x0b, 
+0xcb, 0x00, 0x63, 0x07, 0xd8, 0x0b, 0xd2, 0x0c, 0xf8, 0x0b, 0xa4, 0x0a, 
+0x3c, 0x01, 0x7d, 0x16, 0x16, 0x14, 0x47, 0x00, 0x3e, 0x07, 0xc1, 0x00, 
+0x04, 0x13, 0xc1, 0x0e, 0x0a, 0x0a, 0xfd, 0x14, 0xf9, 0x0c, 0x20, 0x0d, 
+0xa1, 0x0a, 0x4e, 0x06, 0xaa, 0x0b, 0xe9, 0x13, 0x47, 0x06, 0x19, 0x14, 
+0xdf, 0x0e, 0x26, 0x01, 0x34, 0x0b, 0x27, 0x01, 0x4f, 0x0c, 0x36, 0x0a, 
+0x7b, 0x0a, 0x49, 0x06, 0x78, 0x00, 0xfc, 0x08, 0x6e, 0x09, 0x1b, 0x0b, 
+0x6d, 0x00, 0x07, 0x0a, 0x31, 0x0b, 0x85, 0x17, 0xdb, 0x00, 0xd5, 0x00, 
+0x27, 0x00, 0xf7, 0x0c, 0x1f, 0x01, 0xec, 0x00, 0x27, 0x01, 0x8d, 0x0a, 
+0xe6, 0x00, 0x8c, 0x12, 0xeb, 0x1b, 0x40, 0x09, 0x8e, 0x12, 0x25, 0x0c, 
+0xd2, 0x0a, 0x1e, 0x01, 0x3a, 0x01, 0x9d, 0x00, 0xb6, 0x00, 0x56, 0x06, 
+0x5b, 0x00, 0xac, 0x00, 0x92, 0x11, 0x67, 0x12, 0xe2, 0x14, 0xd9, 0x0e, 
+0xf7, 0x0f, 0x79, 0x10, 0x90, 0x1a, 0x29, 0x0f, 0x60, 0x0a, 0x26, 0x01, 
+0x12, 0x0c, 0x04, 0x0b, 0x41, 0x01, 0x97, 0x18, 0x10, 0x00, 0x4d, 0x19, 
+0xba, 0x0a, 0x33, 0x07, 0x23, 0x01, 0x0f, 0x15, 0x95, 0x0f, 0x61, 0x1b, 
+0x28, 0x12, 0x35, 0x01, 0xbd, 0x09, 0xf0, 0x00, 0x1d, 0x00, 0x2e, 0x0c, 
+0xa5, 0x0a, 0xc7, 0x00, 0x0a, 0x0a, 0xd8, 0x0b, 0x62, 0x0e, 0x2a, 0x01, 
+0xde, 0x18, 0xe6, 0x00, 0xe9, 0x09, 0x2d, 0x0c, 0x1d, 0x01, 0x17, 0x01, 
+0xe4, 0x0b, 0xd6, 0x00, 0x36, 0x0b, 0x72, 0x16, 0x4b, 0x13, 0x48, 0x00, 
+0xa0, 0x08, 0xf7, 0x0b, 0xa6, 0x0c, 0x9d, 0x0f, 0x32, 0x01, 0x1b, 0x00, 
+0x1a, 0x01, 0x5e, 0x15, 0x9d, 0x0c, 0x05, 0x11, 0xab, 0x00, 0xb4, 0x16, 
+0xa9, 0x00, 0x94, 0x00, 0x3d, 0x00, 0x1a, 0x0b, 0xde, 0x08, 0x61, 0x00, 
+0xe9, 0x0c, 0xb3, 0x16, 0x19, 0x0d, 0x27, 0x14, 0xe3, 0x00, 0x1c, 0x0b, 
+0x16, 0x01, 0x30, 0x01, 0x90, 0x00, 0x9b, 0x0a, 0x1c, 0x01, 0x9d, 0x00, 
+0xee, 0x0a, 0x04, 0x0b, 0xab, 0x00, 0x65, 0x00, 0xa9, 0x07, 0x01, 0x17, 
+0xb8, 0x00, 0x28, 0x01, 0x78, 0x12, 0xbe, 0x00, 0x6b, 0x0a, 0x5e, 0x12, 
+0x24, 0x00, 0xed, 0x0d, 0x27, 0x10, 0x17, 0x0c, 0x08, 0x01, 0xde, 0x00, 
+0x44, 0x15, 0x30, 0x0f, 0xee, 0x0a, 0x24, 0x01, 0xde, 0x00, 0xcf, 0x06, 
+0x23, 0x08, 0x9e, 0x0b, 0x7c, 0x0a, 0x49, 0x0a, 0x99, 0x0b, 0x6f, 0x10, 
+0xad, 0x00, 0xf2, 0x00, 0x30, 0x0c, 0xc0, 0x09, 0x8a, 0x11, 0x25, 0x01, 
+0x43, 0x0c, 0x17, 0x00, 0x97, 0x14, 0x86, 0x00, 0xf5, 0x00, 0x18, 0x13, 
+0x05, 0x0c, 0xe9, 0x09, 0xd0, 0x09, 0x67, 0x0b, 0xbe, 0x09, 0x2b, 0x0a, 
+0xdf, 0x0c, 0xe1, 0x0f, 0x30, 0x00, 0xcd, 0x14, 0x45, 0x0f, 0xd7, 0x0b, 
+0x28, 0x0b, 0x19, 0x0d, 0xf0, 0x08, 0x77, 0x00, 0x46, 0x06, 0xfe, 0x00, 
+0x00, 0x0a, 0x64, 0x0e, 0xef, 0x0a, 0xed, 0x06, 0x1a, 0x01, 0xbf, 0x00, 
+0x92, 0x00, 0xc1, 0x00, 0x0c, 0x13, 0xb0, 0x09, 0x08, 0x01, 0xb1, 0x0f, 
+0xe3, 0x0a, 0xc3, 0x00, 0xbb, 0x09, 0x9b, 0x0c, 0xfc, 0x09, 0x71, 0x00, 
+0xdb, 0x06, 0xeb, 0x0b, 0xd3, 0x0c, 0x68, 0x00, 0xad, 0x0a, 0x1b, 0x01, 
+0x5d, 0x07, 0x54, 0x07, 0x84, 0x00, 0x94, 0x12, 0xb1, 0x00, 0xd1, 0x00, 
+0xcc, 0x0b, 0x93, 0x0b, 0x1d, 0x01, 0x19, 0x00, 0x63, 0x00, 0x23, 0x00, 
+0xa2, 0x0b, 0xd4, 0x10, 0xbd, 0x0c, 0xd5, 0x00, 0x22, 0x0c, 0xce, 0x0c, 
+0x13, 0x0e, 0x56, 0x00, 0xaf, 0x09, 0x35, 0x01, 0x89, 0x0b, 0xa4, 0x06, 
+0x26, 0x07, 0x52, 0x0c, 0x5a, 0x08, 0x7b, 0x0c, 0x5c, 0x0b, 0xad, 0x0a, 
+0x9b, 0x00, 0x5c, 0x0b, 0x29, 0x01, 0x23, 0x00, 0x1b, 0x0b, 0x18, 0x00, 
+0xf9, 0x18, 0xea, 0x0a, 0xce, 0x06, 0x98, 0x00, 0x6c, 0x0a, 0x43, 0x00, 
+0x87, 0x0c, 0xd0, 0x06, 0xde, 0x13, 0x75, 0x0b, 0x8b, 0x12, 0x7f, 0x0b, 
+0x1d, 0x17, 0x54, 0x11, 0x65, 0x0a, 0xa4, 0x0a, 0xa2, 0x0a, 0x2c, 0x0b, 
+0x62, 0x17, 0x19, 0x1a, 0xee, 0x0a, 0x9f, 0x0b, 0xba, 0x09, 0x7d, 0x0c, 
+0x8f, 0x00, 0x6a, 0x08, 0xae, 0x0a, 0xa5, 0x0d, 0xa2, 0x15, 0xc0, 0x00, 
+0xac, 0x00, 0x13, 0x0d, 0xe9, 0x09, 0xbb, 0x0a, 0xf6, 0x0c, 0xe0, 0x09, 
+0xd3, 0x09, 0xc8, 0x0c, 0xbc, 0x0c, 0x85, 0x16, 0xc8, 0x00, 0xd6, 0x07, 
+0x76, 0x0a, 0xcc, 0x09, 0x29, 0x0a, 0x78, 0x06, 0xc1, 0x16, 0x0e, 0x01, 
+0x23, 0x00, 0x99, 0x0a, 0x2d, 0x01, 0x29, 0x01, 0x93, 0x13, 0xad, 0x0a, 
+0xe0, 0x08, 0xbb, 0x09, 0xdb, 0x06, 0x29, 0x01, 0x20, 0x09, 0x3c, 0x0b, 
+0x04, 0x0b, 0x35, 0x12, 0xe5, 0x00, 0xea, 0x00, 0xd0, 0x00, 0xc1, 0x0e, 
+0x53, 0x09, 0xfc, 0x08, 0x1b, 0x00, 0xaa, 0x09, 0x6d, 0x00, 0x77, 0x0a, 
+0x81, 0x0a, 0x34, 0x00, 0x25, 0x01, 0xb8, 0x00, 0x3e, 0x0b, 0x73, 0x0b, 
+0xbf, 0x0b, 0x62, 0x00, 0x0f, 0x01, 0x53, 0x0b, 0x82, 0x11, 0xd8, 0x0b, 
+0x7e, 0x0f, 0xa1, 0x0a, 0xdf, 0x13, 0xe5, 0x0a, 0x1e, 0x14, 0x38, 0x09, 
+0x00, 0x01, 0xcd, 0x00, 0x7c, 0x18, 0xa1, 0x0a, 0xe0, 0x00, 0x3a, 0x0c, 
+0x30, 0x00, 0xa3, 0x17, 0x3a, 0x0c, 0xf6, 0x00, 0x25, 0x00, 0xe4, 0x00, 
+0x1a, 0x00, 0xb4, 0x08, 0xea, 0x00, 0xae, 0x1c, 0x14, 0x01, 0x2f, 0x09, 
+0xa5, 0x09, 0xbe, 0x0a, 0x19, 0x00, 0xec, 0x09, 0x19, 0x01, 0x33, 0x00, 
+0xaf, 0x11, 0xc5, 0x00, 0x0f, 0x0c, 0x05, 0x01, 0xfc, 0x09, 0x9b, 0x0c, 
+0xe2, 0x00, 0x7b, 0x1b, 0x51, 0x0b, 0x6a, 0x0d, 0x2e, 0x01, 0x5f, 0x00, 
+0xc9, 0x08, 0xd3, 0x0c, 0x1a, 0x00, 0x3d, 0x0b, 0x3d, 0x01, 0xbf, 0x0c, 
+0x54, 0x17, 0x81, 0x18, 0x45, 0x0c, 0x27, 0x12, 0x18, 0x13, 0x26, 0x14, 
+0x7c, 0x00, 0xd2, 0x10, 0xa3, 0x0a, 0x3c, 0x00, 0x16, 0x01, 0x38, 0x01, 
+0xea, 0x00, 0xd8, 0x07, 0xd2, 0x00, 0xe4, 0x0a, 0x12, 0x0a, 0x20, 0x0b, 
+0x78, 0x19, 0x50, 0x0c, 0xaf, 0x09, 0xf2, 0x00, 0x70, 0x0c, 0xc6, 0x14, 
+0xca, 0x0e, 0x7b, 0x0a, 0x97, 0x14, 0x1f, 0x14, 0xa2, 0x07, 0xa5, 0x0a, 
+0x8a, 0x13, 0xd2, 0x09, 0x4e, 0x0c, 0x72, 0x0b, 0x84, 0x14, 0xf3, 0x00, 
+0x0b, 0x01, 0x11, 0x01, 0xde, 0x0b, 0xb3, 0x00, 0x17, 0x1a, 0xd0, 0x00, 
+0x3e, 0x0b, 0x6e, 0x09, 0xba, 0x09, 0xe9, 0x09, 0x61, 0x0a, 0xda, 0x00, 
+0xb6, 0x0b, 0xb5, 0x09, 0x22, 0x0a, 0x07, 0x0c, 0x77, 0x0a, 0xe3, 0x0a, 
+0x9f, 0x0f, 0x4c, 0x11, 0x21, 0x01, 0xd6, 0x15, 0x1f, 0x15, 0xb1, 0x09, 
+0xcb, 0x0f, 0xc0, 0x0b, 0x2e, 0x0a, 0xa8, 0x0c, 0xaf, 0x16, 0xa0, 0x00, 
+0x22, 0x0f, 0x23, 0x00, 0x6f, 0x08, 0x0b, 0x00, 0x05, 0x15, 0x22, 0x01, 
+0xe9, 0x0c, 0x83, 0x00, 0x6b, 0x06, 0xc2, 0x00, 0x72, 0x1b, 0x77, 0x0b, 
+0x80, 0x0b, 0xfc, 0x07, 0x0f, 0x00, 0xa1, 0x0a, 0x42, 0x06, 0xe4, 0x00, 
+0xfd, 0x00, 0xa1, 0x07, 0x06, 0x0c, 0xfd, 0x09, 0x9f, 0x07, 0x36, 0x0d, 
+0x29, 0x0b, 0xc3, 0x00, 0x28, 0x01, 0x37, 0x01, 0x88, 0x00, 0x20, 0x0b, 
+0xe9, 0x00, 0x10, 0x01, 0x8d, 0x06, 0x6e, 0x13, 0xcb, 0x0c, 0x69, 0x07, 
+0x24, 0x01, 0x5e, 0x0b, 0x78, 0x09, 0xd5, 0x0b, 0x4b, 0x11, 0x3a, 0x13, 
+0x44, 0x0a, 0x5c, 0x0b, 0xb2, 0x17, 0x2d, 0x0b, 0x35, 0x0a, 0x29, 0x0a, 
+0xf6, 0x0c, 0xc9, 0x09, 0x1a, 0x0f, 0xe1, 0x16, 0x32, 0x00, 0x2e, 0x07, 
+0x4e, 0x17, 0xc3, 0x0a, 0x6a, 0x17, 0x05, 0x0b, 0xce, 0x0b, 0x18, 0x11, 
+0x2a, 0x00, 0xe7, 0x15, 0x0d, 0x01, 0x98, 0x07, 0x3d, 0x13, 0xbf, 0x00, 
+0xb1, 0x0a, 0x09, 0x01, 0xff, 0x00, 0x7c, 0x0c, 0x06, 0x09, 0x76, 0x0b, 
+0x13, 0x0e, 0x02, 0x01, 0x81, 0x09, 0xce, 0x15, 0x38, 0x0c, 0x5f, 0x00, 
+0x95, 0x09, 0x78, 0x1c, 0xd3, 0x0a, 0x18, 0x00, 0xd8, 0x09, 0x5c, 0x0c, 
+0xd8, 0x0a, 0x38, 0x15, 0x0e, 0x00, 0x80, 0x0b, 0xfe, 0x00, 0x6d, 0x00, 
+0xa8, 0x0c, 0xd4, 0x08, 0x4f, 0x17, 0x99, 0x07, 0x6f, 0x0c, 0x62, 0x0e, 
+0x62, 0x19, 0xae, 0x00, 0x74, 0x16, 0x30, 0x12, 0x1e, 0x0a, 0x3d, 0x10, 
+0x33, 0x18, 0xcf, 0x00, 0x11, 0x0c, 0x08, 0x10, 0x27, 0x0b, 0xfb, 0x16, 
+0x3f, 0x0b, 0x55, 0x00, 0x8f, 0x0a, 0x20, 0x09, 0xaf, 0x09, 0x5f, 0x15, 
+0x10, 0x15, 0xfa, 0x00, 0xe3, 0x00, 0x4e, 0x06, 0x3e, 0x1a, 0x0a, 0x0a, 
+0x01, 0x10, 0x1a, 0x08, 0xc0, 0x00, 0x70, 0x00, 0xa1, 0x0b, 0xd2, 0x00, 
+0xe3, 0x0a, 0x19, 0x0c, 0xf5, 0x07, 0x0c, 0x00, 0x0d, 0x13, 0x02, 0x01, 
+0x83, 0x0a, 0x64, 0x0c, 0x68, 0x13, 0x17, 0x13, 0x41, 0x0a, 0xbe, 0x00, 
+0x15, 0x01, 0x49, 0x06, 0x42, 0x0b, 0x5f, 0x0a, 0xc2, 0x00, 0x95, 0x0f, 
+0xd2, 0x09, 0xb2, 0x16, 0x96, 0x18, 0x02, 0x01, 0x13, 0x0b, 0x71, 0x08, 
+0x46, 0x0c, 0xbe, 0x00, 0xa0, 0x07, 0xc5, 0x09, 0xe8, 0x17, 0x19, 0x01, 
+0xa6, 0x09, 0x3a, 0x01, 0x1e, 0x15, 0x3a, 0x01, 0x5b, 0x06, 0x49, 0x15, 
+0x3a, 0x01, 0x85, 0x00, 0xa9, 0x16, 0x45, 0x06, 0xfc, 0x06, 0x29, 0x0a, 
+0xb6, 0x00, 0x28, 0x01, 0xbe, 0x00, 0x38, 0x0d, 0x26, 0x00, 0x2d, 0x01, 
+0xd3, 0x00, 0x34, 0x01, 0x8b, 0x11, 0x1b, 0x0f, 0x41, 0x01, 0xaa, 0x06, 
+0x3b, 0x01, 0x13, 0x01, 0xd1, 0x00, 0xbc, 0x09, 0x96, 0x18, 0xc9, 0x00, 
+0x48, 0x0f, 0xbe, 0x09, 0x1e, 0x1a, 0x0d, 0x13, 0x53, 0x07, 0x17, 0x00, 
+0x9b, 0x10, 0xd7, 0x00, 0x0f, 0x0b, 0x3f, 0x00, 0xda, 0x0b, 0xa7, 0x00, 
+0x3a, 0x12, 0xe2, 0x08, 0xe7, 0x09, 0x13, 0x0b, 0x93, 0x00, 0x32, 0x01, 
+0xce, 0x0a, 0x62, 0x09, 0x0e, 0x01, 0x70, 0x06, 0xce, 0x18, 0x26, 0x12, 
+0x1c, 0x07, 0x15, 0x0a, 0x2a, 0x01, 0x30, 0x01, 0x52, 0x07, 0x68, 0x0b, 
+0x42, 0x13, 0x5f, 0x0a, 0x5f, 0x06, 0x97, 0x00, 0xc9, 0x0e, 0x32, 0x01, 
+0x12, 0x11, 0xc4, 0x00, 0x0e, 0x13, 0xdf, 0x15, 0xbc, 0x09, 0xac, 0x07, 
+0xd7, 0x16, 0xc8, 0x0c, 0xbd, 0x00, 0x42, 0x0a, 0xe5, 0x16, 0xdb, 0x00, 
+0xde, 0x0e, 0xb7, 0x00, 0x38, 0x01, 0xd0, 0x0a, 0x42, 0x01, 0xa9, 0x16, 
+0xdb, 0x00, 0xcb, 0x0f, 0xa0, 0x07, 0xdb, 0x09, 0x24, 0x01, 0xf5, 0x00, 
+0x1d, 0x0b, 0x72, 0x00, 0x89, 0x10, 0x65, 0x08, 0xe3, 0x00, 0x66, 0x15, 
+0x23, 0x0c, 0xb3, 0x1c, 0x07, 0x0a, 0xbb, 0x0a, 0xb5, 0x00, 0xd9, 0x15, 
+0x2b, 0x1a, 0xbb, 0x09, 0xc7, 0x0a, 0x40, 0x09, 0xdd, 0x0c, 0x1f, 0x0b, 
+0x40, 0x0d, 0xb7, 0x00, 0xaa, 0x06, 0x58, 0x06, 0xdd, 0x00, 0x55, 0x00, 
+0xda, 0x00, 0x80, 0x16, 0x94, 0x0f, 0x42, 0x01, 0xad, 0x07, 0x1b, 0x07, 
+0x89, 0x0b, 0x40, 0x0a, 0x0c, 0x00, 0x4c, 0x13, 0x71, 0x1a, 0x61, 0x17, 
+0x6c, 0x11, 0x53, 0x0e, 0x02, 0x13, 0xf0, 0x00, 0xfb, 0x0b, 0x97, 0x0f, 
+0x24, 0x00, 0x44, 0x06, 0x9b, 0x00, 0x3b, 0x0c, 0x1b, 0x08, 0x23, 0x19, 
+0xdd, 0x0d, 0x3f, 0x01, 0x0a, 0x0a, 0x9b, 0x16, 0x07, 0x01, 0x97, 0x15, 
+0x6f, 0x08, 0x00, 0x0d, 0xe1, 0x09, 0x1c, 0x0c, 0xd0, 0x0b, 0xf5, 0x0c, 
+0xa1, 0x07, 0x2a, 0x01, 0xe5, 0x06, 0x58, 0x09, 0x5d, 0x12, 0xb9, 0x00, 
+0x86, 0x0c, 0xe3, 0x00, 0xfc, 0x07, 0x45, 0x06, 0xa8, 0x08, 0x4b, 0x06, 
+0x8c, 0x0a, 0x35, 0x0d, 0xf5, 0x09, 0xe3, 0x00, 0x7c, 0x00, 0xed, 0x0d, 
+0x43, 0x06, 0x0a, 0x16, 0x2d, 0x18, 0x76, 0x12, 0x0c, 0x00, 0xc4, 0x00, 
+0x61, 0x17, 0xd5, 0x00, 0x43, 0x0c, 0xd0, 0x0b, 0xb7, 0x00, 0x90, 0x00, 
+0x91, 0x0c, 0x94, 0x11, 0x68, 0x07, 0x67, 0x07, 0x0c, 0x00, 0x70, 0x13, 
+0x68, 0x19, 0x4c, 0x14, 0xf2, 0x09, 0xc2, 0x0b, 0x74, 0x16, 0xa2, 0x00, 
+0x70, 0x0c, 0xa5, 0x10, 0x11, 0x0e, 0x8b, 0x0b, 0x46, 0x0b, 0x6c, 0x00, 
+0x63, 0x17, 0x1d, 0x0b, 0xe5, 0x00, 0x59, 0x07, 0x62, 0x17, 0x2f, 0x0c, 
+0x55, 0x00, 0x2c, 0x09, 0xcc, 0x0c, 0x11, 0x0d, 0x59, 0x0c, 0x33, 0x0a, 
+0x2f, 0x01, 0xa6, 0x0a, 0x3a, 0x18, 0xe2, 0x09, 0xfd, 0x00, 0xb9, 0x0a, 
+0x86, 0x1b, 0xf6, 0x00, 0x54, 0x0a, 0xff, 0x00, 0x7d, 0x00, 0x4e, 0x0a, 
+0x25, 0x01, 0xf0, 0x00, 0xa8, 0x08, 0xd3, 0x0c, 0xe9, 0x08, 0x40, 0x00, 
+0xc6, 0x00, 0x06, 0x01, 0x28, 0x0b, 0x1d, 0x0a, 0xd0, 0x09, 0x06, 0x13, 
+0x0f, 0x0c, 0xf2, 0x0b, 0xe2, 0x00, 0x5a, 0x0a, 0x52, 0x07, 0x1d, 0x00, 
+0xbe, 0x00, 0xa6, 0x0c, 0x45, 0x0c, 0x49, 0x00, 0x23, 0x09, 0x36, 0x10, 
+0xda, 0x0b, 0x18, 0x01, 0xca, 0x08, 0x44, 0x0c, 0x7b, 0x07, 0x1e, 0x1a, 
+0xbd, 0x14, 0x79, 0x00, 0x26, 0x0c, 0x95, 0x1a, 0x28, 0x0a, 0x97, 0x07, 
+0x52, 0x0b, 0x01, 0x0d, 0x1c, 0x00, 0x12, 0x0a, 0x39, 0x08, 0xd7, 0x00, 
+0xd6, 0x15, 0xd8, 0x00, 0xb0, 0x09, 0x29, 0x0e, 0x2e, 0x01, 0xfc, 0x09, 
+0xa0, 0x00, 0x83, 0x00, 0xff, 0x00, 0x93, 0x0c, 0xed, 0x0c, 0x01, 0x01, 
+0xa7, 0x0c, 0x32, 0x00, 0xbe, 0x14, 0x05, 0x15, 0xbc, 0x00, 0x3a, 0x09, 
+0x5a, 0x17, 0x91, 0x00, 0x27, 0x08, 0x14, 0x0a, 0xee, 0x00, 0x0c, 0x13, 
+0x34, 0x0a, 0x5b, 0x17, 0x93, 0x10, 0x2f, 0x07, 0x30, 0x16, 0x4c, 0x0a, 
+0x5c, 0x0c, 0xdc, 0x06, 0xc2, 0x00, 0x36, 0x01, 0x2a, 0x0a, 0x83, 0x10, 
+0x91, 0x00, 0x4c, 0x06, 0x58, 0x00, 0x79, 0x0a, 0xd8, 0x0a, 0xe4, 0x06, 
+0xf2, 0x17, 0x21, 0x0b, 0x56, 0x06, 0xc1, 0x09, 0x0b, 0x00, 0x1b, 0x01, 
+0x6b, 0x00, 0x26, 0x08, 0x14, 0x19, 0x17, 0x17, 0x2c, 0x0b, 0x78, 0x09, 
+0x1d, 0x0d, 0x8d, 0x12, 0x87, 0x0c, 0x57, 0x00, 0x85, 0x11, 0xa6, 0x0c, 
+0xd2, 0x09, 0xf1, 0x0e, 0xf1, 0x00, 0xac, 0x0b, 0x6c, 0x00, 0xf5, 0x06, 
+0x73, 0x16, 0xee, 0x0a, 0xba, 0x0a, 0x97, 0x06, 0x1b, 0x0b, 0x41, 0x12, 
+0x6f, 0x0a, 0x48, 0x00, 0x33, 0x01, 0xcb, 0x00, 0xe1, 0x13, 0x74, 0x0b, 
+0x54, 0x00, 0x31, 0x0c, 0x7f, 0x0b, 0x23, 0x08, 0x86, 0x0b, 0xfa, 0x14, 
+0xfe, 0x00, 0xce, 0x15, 0xfa, 0x00, 0x1a, 0x0b, 0x47, 0x06, 0xa5, 0x0b, 
+0x95, 0x0f, 0x32, 0x00, 0x63, 0x09, 0xb4, 0x00, 0xb8, 0x0e, 0x63, 0x00, 
+0x23, 0x0b, 0x8f, 0x0b, 0xe6, 0x09, 0xdd, 0x0c, 0x0b, 0x01, 0x61, 0x1b, 
+0xd6, 0x00, 0x5b, 0x08, 0x04, 0x0a, 0xfa, 0x14, 0xdb, 0x06, 0xcf, 0x00, 
+0x94, 0x0f, 0xd4, 0x00, 0x49, 0x19, 0x72, 0x0b, 0xb8, 0x00, 0xb8, 0x00, 
+0xd6, 0x0b, 0xe0, 0x00, 0xcd, 0x16, 0xe2, 0x09, 0xd0, 0x0b, 0xc2, 0x00, 
+0x89, 0x14, 0x1d, 0x0f, 0xce, 0x14, 0x08, 0x01, 0x78, 0x0b, 0xb7, 0x0a, 
+0xe6, 0x16, 0x58, 0x00, 0x67, 0x1b, 0x39, 0x01, 0xbe, 0x0c, 0x14, 0x01, 
+0xb4, 0x00, 0xba, 0x09, 0xf5, 0x14, 0x62, 0x00, 0x5a, 0x0a, 0x87, 0x00, 
+0xd0, 0x06, 0x6c, 0x00, 0x0e, 0x13, 0x5e, 0x0b, 0x1d, 0x08, 0x3d, 0x00, 
+0xfc, 0x07, 0x74, 0x07, 0xd2, 0x09, 0x7a, 0x00, 0x3b, 0x00, 0xeb, 0x0a, 
+0x0f, 0x00, 0x8e, 0x17, 0x4c, 0x15, 0xd9, 0x06, 0xd6, 0x16, 0x3d, 0x00, 
+0xaf, 0x09, 0xba, 0x14, 0x6e, 0x09, 0x24, 0x00, 0xb7, 0x00, 0xaa, 0x00, 
+0x21, 0x09, 0x1f, 0x01, 0x77, 0x09, 0xe2, 0x09, 0x77, 0x00, 0x4f, 0x0c, 
+0xff, 0x09, 0xdd, 0x00, 0x82, 0x10, 0xe4, 0x00, 0x16, 0x01, 0x45, 0x0f, 
+0xd4, 0x00, 0xc6, 0x00, 0x12, 0x01, 0xe4, 0x0a, 0x55, 0x06, 0x36, 0x01, 
+0x78, 0x00, 0x2a, 0x0a, 0x65, 0x08, 0xf4, 0x07, 0xc2, 0x0a, 0xca, 0x0b, 
+0x32, 0x01, 0x70, 0x08, 0x48, 0x06, 0x2e, 0x09, 0x26, 0x00, 0xcf, 0x18, 
+0xcb, 0x0b, 0xe1, 0x00, 0x4a, 0x0b, 0xc9, 0x1b, 0x3e, 0x01, 0xf8, 0x00, 
+0xde, 0x00, 0xbc, 0x14, 0x0a, 0x0a, 0x0a, 0x01, 0x92, 0x00, 0x11, 0x01, 
+0x11, 0x01, 0xbf, 0x0b, 0x1f, 0x0a, 0xe0, 0x0c, 0x02, 0x13, 0x41, 0x06, 
+0xcb, 0x0f, 0x59, 0x17, 0x9f, 0x00, 0x55, 0x0b, 0x2e, 0x0c, 0xda, 0x0d, 
+0xae, 0x00, 0x1c, 0x0c, 0x2d, 0x0c, 0x49, 0x00, 0x5a, 0x08, 0x0c, 0x00, 
+0x19, 0x08, 0x6c, 0x00, 0xd1, 0x00, 0xb1, 0x08, 0x42, 0x06, 0x06, 0x0b, 
+0x40, 0x0a, 0x6e, 0x00, 0xb6, 0x06, 0x39, 0x12, 0xb2, 0x07, 0xf9, 0x00, 
+0x17, 0x0a, 0xbb, 0x00, 0xe8, 0x0a, 0x25, 0x00, 0xab, 0x0b, 0xce, 0x06, 
+0x68, 0x06, 0x0e, 0x01, 0x9b, 0x00, 0x3c, 0x01, 0x7f, 0x0b, 0xd9, 0x07, 
+0x8b, 0x0a, 0x30, 0x00, 0xd0, 0x09, 0x40, 0x09, 0x2f, 0x01, 0xa8, 0x00, 
+0x27, 0x01, 0x2b, 0x18, 0xd1, 0x06, 0x35, 0x0c, 0xc4, 0x0a, 0x96, 0x0a, 
+0xae, 0x00, 0x67, 0x0b, 0x4b, 0x00, 0x79, 0x00, 0xef, 0x00, 0xe4, 0x0a, 
+0x11, 0x01, 0xe4, 0x00, 0xde, 0x15, 0x56, 0x0a, 0x41, 0x0e, 0x9f, 0x00, 
+0x19, 0x08, 0x0d, 0x01, 0xb8, 0x15, 0xc0, 0x09, 0x2c, 0x01, 0x31, 0x12, 
+0x6f, 0x13, 0xdb, 0x07, 0x7a, 0x09, 0x61, 0x06, 0x92, 0x0c, 0x23, 0x0f, 
+0x98, 0x06, 0x6b, 0x00, 0x41, 0x1a, 0x0c, 0x00, 0x99, 0x15, 0x1c, 0x01, 
+0x96, 0x0a, 0xc7, 0x0a, 0x2f, 0x0a, 0x1d, 0x00, 0xbb, 0x00, 0x39, 0x0b, 
+0x91, 0x1a, 0x3b, 0x0b, 0xce, 0x0b, 0xbe, 0x09, 0xd9, 0x07, 0xec, 0x06, 
+0x64, 0x09, 0x5b, 0x0a, 0x74, 0x16, 0x27, 0x0c, 0x2d, 0x01, 0x84, 0x00, 
+0xd1, 0x00, 0x0a, 0x01, 0x4d, 0x19, 0x42, 0x06, 0x81, 0x0a, 0x49, 0x0a, 
+0xb9, 0x00, 0xfd, 0x1a, 0xaf, 0x09, 0x31, 0x01, 0x30, 0x0b, 0x26, 0x00, 
+0xfd, 0x09, 0x61, 0x00, 0xf0, 0x00, 0xbb, 0x0a, 0x42, 0x14, 0xda, 0x00, 
+0x6d, 0x0a, 0xe0, 0x00, 0x41, 0x10, 0xe5, 0x0b, 0x85, 0x0c, 0x3b, 0x00, 
+0x93, 0x0b, 0xc4, 0x08, 0x5c, 0x00, 0x8f, 0x17, 0x42, 0x15, 0x83, 0x00, 
+0x4a, 0x06, 0x68, 0x12, 0x47, 0x06, 0x9c, 0x0f, 0xac, 0x00, 0xde, 0x00, 
+0x85, 0x0c, 0x60, 0x0a, 0x2e, 0x07, 0xb4, 0x00, 0xba, 0x16, 0x77, 0x0b, 
+0xa4, 0x0a, 0xe8, 0x0c, 0x3f, 0x00, 0xc8, 0x00, 0x8d, 0x0a, 0x40, 0x00, 
+0xe6, 0x15, 0xc7, 0x0c, 0xbe, 0x0c, 0xcd, 0x0b, 0xc8, 0x00, 0xf1, 0x0a, 
+0x64, 0x0c, 0x0e, 0x13, 0x26, 0x01, 0xa0, 0x11, 0x27, 0x0c, 0xf6, 0x06, 
+0x89, 0x16, 0x53, 0x07, 0x16, 0x0e, 0xb1, 0x00, 0x1d, 0x0b, 0xde, 0x13, 
+0xb3, 0x09, 0xff, 0x00, 0xcc, 0x0b, 0xff, 0x09, 0x17, 0x01, 0x3e, 0x00, 
+0x1c, 0x17, 0xa8, 0x10, 0x0c, 0x0c, 0xbd, 0x00, 0x85, 0x00, 0xa9, 0x07, 
+0x32, 0x1c, 0x70, 0x07, 0x7b, 0x00, 0xbf, 0x09, 0x24, 0x08, 0x9d, 0x0f, 
+0x1a, 0x08, 0x43, 0x06, 0xda, 0x10, 0xb0, 0x09, 0x27, 0x16, 0x4e, 0x0c, 
+0x04, 0x13, 0xaa, 0x00, 0x80, 0x0b, 0x38, 0x0e, 0xa7, 0x0a, 0x34, 0x0a, 
+0x40, 0x00, 0x9b, 0x0c, 0x81, 0x1b, 0x2c, 0x0a, 0xb6, 0x0b, 0xf1, 0x00, 
+0x1d, 0x01, 0xb5, 0x00, 0x88, 0x0c, 0xeb, 0x00, 0x41, 0x0a, 0xc2, 0x0a, 
+0x07, 0x01, 0x95, 0x0f, 0xd3, 0x09, 0xb2, 0x00, 0xf3, 0x00, 0xef, 0x0a, 
+0x67, 0x14, 0x25, 0x01, 0x67, 0x14, 0x9f, 0x00, 0xcc, 0x00, 0x64, 0x06, 
+0x6c, 0x0c, 0x74, 0x08, 0x1b, 0x01, 0xaa, 0x00, 0x3c, 0x01, 0x48, 0x06, 
+0xee, 0x0c, 0x0c, 0x00, 0xe9, 0x00, 0xfc, 0x09, 0x01, 0x01, 0x10, 0x07, 
+0xdf, 0x11, 0xfa, 0x00, 0x6c, 0x0a, 0x3f, 0x01, 0xd5, 0x12, 0xbc, 0x09, 
+0x47, 0x09, 0xe8, 0x00, 0xf2, 0x09, 0x10, 0x01, 0x1f, 0x01, 0xf0, 0x0d, 
+0xf1, 0x09, 0x1a, 0x0b, 0xfe, 0x14, 0x80, 0x0e, 0x2d, 0x15, 0xb3, 0x00, 
+0xfb, 0x14, 0x19, 0x0d, 0xab, 0x00, 0x6b, 0x0a, 0xd5, 0x00, 0x04, 0x0b, 
+0x4b, 0x06, 0xdd, 0x0c, 0x0f, 0x13, 0x1d, 0x08, 0xcf, 0x0a, 0x13, 0x0e, 
+0xa1, 0x0a, 0xa9, 0x15, 0xbe, 0x16, 0x36, 0x0a, 0x76, 0x0a, 0x90, 0x17, 
+0xaa, 0x07, 0xde, 0x0e, 0xc0, 0x09, 0x4a, 0x0b, 0xfb, 0x00, 0xad, 0x00, 
+0x0c, 0x01, 0xa2, 0x06, 0x2f, 0x00, 0x0b, 0x09, 0x5f, 0x11, 0xdb, 0x09, 
+0x62, 0x07, 0x77, 0x00, 0x29, 0x0b, 0x24, 0x08, 0x3a, 0x12, 0xc8, 0x0a, 
+0xaf, 0x00, 0xfa, 0x00, 0x98, 0x07, 0xb6, 0x0e, 0x4e, 0x17, 0x24, 0x08, 
+0xcd, 0x00, 0xce, 0x0a, 0xfc, 0x0a, 0x45, 0x0f, 0xaf, 0x0a, 0x3f, 0x01, 
+0xdf, 0x00, 0xf2, 0x00, 0x12, 0x0a, 0x7e, 0x0c, 0xc1, 0x0e, 0x73, 0x0a, 
+0x96, 0x0a, 0x9d, 0x06, 0x82, 0x0a, 0x49, 0x19, 0x31, 0x0b, 0x7b, 0x00, 
+0x34, 0x01, 0x44, 0x0a, 0x14, 0x07, 0xee, 0x00, 0x72, 0x00, 0x8f, 0x00, 
+0xf6, 0x0c, 0x72, 0x0b, 0xdb, 0x00, 0xb7, 0x00, 0xb7, 0x00, 0x23, 0x15, 
+0x02, 0x01, 0x78, 0x00, 0x47, 0x06, 0xbf, 0x0c, 0x20, 0x14, 0xd5, 0x00, 
+0x7c, 0x0c, 0x02, 0x0c, 0x35, 0x01, 0x1b, 0x07, 0xfa, 0x00, 0x96, 0x0a, 
+0x22, 0x0c, 0x58, 0x14, 0xae, 0x0f, 0x9e, 0x08, 0x93, 0x0c, 0xc0, 0x0e, 
+0x98, 0x17, 0xf5, 0x07, 0xbf, 0x00, 0x49, 0x0d, 0xf4, 0x06, 0x38, 0x01, 
+0x2f, 0x00, 0x27, 0x01, 0x3c, 0x01, 0xd2, 0x00, 0x1b, 0x0c, 0x7c, 0x0d, 
+0x38, 0x0c, 0x99, 0x12, 0xc6, 0x14, 0x1c, 0x10, 0x0a, 0x01, 0xd5, 0x0f, 
+0x0f, 0x0b, 0xdc, 0x0a, 0x38, 0x01, 0x53, 0x07, 0xe4, 0x0a, 0x55, 0x0a, 
+0xbc, 0x0a, 0xd4, 0x00, 0x85, 0x0c, 0x07, 0x01, 0x87, 0x16, 0xed, 0x07, 
+0x7e, 0x0c, 0x65, 0x0c, 0x16, 0x01, 0x56, 0x0a, 0xb1, 0x09, 0xc8, 0x10, 
+0xdc, 0x09, 0xb4, 0x16, 0xcf, 0x00, 0xb3, 0x0c, 0x76, 0x0a, 0xe3, 0x14, 
+0x89, 0x0c, 0x08, 0x0a, 0x07, 0x0b, 0x42, 0x01, 0x63, 0x00, 0x60, 0x0b, 
+0xef, 0x06, 0x4c, 0x00, 0xe7, 0x09, 0x1e, 0x07, 0x5f, 0x00, 0xc4, 0x00, 
+0x87, 0x0c, 0xd0, 0x00, 0x82, 0x06, 0x56, 0x06, 0xb2, 0x00, 0x2b, 0x16, 
+0xde, 0x08, 0x18, 0x01, 0xc3, 0x10, 0x3c, 0x0b, 0xd2, 0x00, 0x51, 0x0b, 
+0x64, 0x09, 0x95, 0x07, 0xa8, 0x0c, 0x8f, 0x00, 0x43, 0x13, 0x9c, 0x00, 
+0x4a, 0x06, 0x0a, 0x0d, 0xf3, 0x14, 0x52, 0x13, 0x5a, 0x17, 0x18, 0x1a, 
+0x60, 0x00, 0x27, 0x0c, 0xf5, 0x0c, 0x11, 0x0b, 0xd8, 0x0a, 0xad, 0x0a, 
+0x1e, 0x0a, 0x0f, 0x01, 0xf9, 0x0a, 0x0e, 0x00, 0xcc, 0x0b, 0x30, 0x0b, 
+0x60, 0x0a, 0x12, 0x01, 0xe8, 0x00, 0x95, 0x0b, 0xf7, 0x00, 0xe5, 0x0a, 
+0xa9, 0x00, 0x15, 0x01, 0xec, 0x0b, 0x35, 0x01, 0x70, 0x07, 0x95, 0x1a, 
+0xee, 0x0e, 0x7a, 0x10, 0x16, 0x0e, 0xde, 0x09, 0xd0, 0x09, 0x20, 0x01, 
+0x63, 0x00, 0xea, 0x0c, 0x75, 0x0a, 0x25, 0x01, 0x86, 0x1b, 0xa7, 0x00, 
+0xdb, 0x0a, 0x47, 0x0d, 0xcd, 0x0b, 0xd2, 0x0c, 0xcb, 0x09, 0x8a, 0x10, 
+0x42, 0x06, 0x1f, 0x16, 0xa0, 0x15, 0x41, 0x0a, 0x06, 0x01, 0x30, 0x01, 
+0x0c, 0x0c, 0x8a, 0x12, 0xdc, 0x00, 0x1b, 0x01, 0x92, 0x00, 0xac, 0x07, 
+0xbd, 0x0c, 0x53, 0x19, 0x25, 0x0b, 0x25, 0x01, 0xe0, 0x13, 0x47, 0x0b, 
+0x29, 0x12, 0x1e, 0x01, 0x3c, 0x0c, 0x9b, 0x00, 0xb0, 0x00, 0xee, 0x00, 
+0x3e, 0x01, 0xd7, 0x00, 0xd0, 0x00, 0xdc, 0x00, 0x43, 0x0c, 0xce, 0x1b, 
+0x19, 0x0d, 0xdd, 0x00, 0xa0, 0x0b, 0x41, 0x12, 0xfc, 0x00, 0xa2, 0x0b, 
+0x63, 0x09, 0xed, 0x09, 0x09, 0x01, 0xef, 0x0a, 0x23, 0x01, 0x1d, 0x01, 
+0x40, 0x06, 0x1c, 0x08, 0x5d, 0x07, 0x5a, 0x17, 0xd9, 0x11, 0x11, 0x01, 
+0x3a, 0x01, 0x4c, 0x06, 0x4e, 0x0c, 0x23, 0x01, 0xe6, 0x09, 0xe8, 0x13, 
+0x41, 0x06, 0xb3, 0x00, 0x31, 0x00, 0x3e, 0x00, 0xe9, 0x0a, 0x34, 0x01, 
+0xa6, 0x09, 0xce, 0x00, 0x62, 0x0d, 0x56, 0x0a, 0x43, 0x06, 0x28, 0x0b, 
+0x52, 0x0b, 0x53, 0x17, 0x91, 0x00, 0xdf, 0x00, 0x32, 0x0b, 0xfc, 0x00, 
+0x2b, 0x01, 0x5a, 0x06, 0xf6, 0x1c, 0x59, 0x0c, 0x5c, 0x0b, 0x5f, 0x0a, 
+0xac, 0x13, 0xd6, 0x07, 0x8f, 0x16, 0xc6, 0x00, 0x2d, 0x14, 0x3e, 0x10, 
+0x1b, 0x01, 0xfe, 0x0f, 0xdc, 0x00, 0x42, 0x0a, 0x42, 0x0a, 0x6e, 0x00, 
+0xde, 0x0c, 0x79, 0x00, 0x30, 0x00, 0xd2, 0x0a, 0x36, 0x00, 0xf6, 0x06, 
+0xb4, 0x0a, 0x1a, 0x0c, 0x09, 0x08, 0xba, 0x16, 0x0b, 0x01, 0x64, 0x15, 
+0x68, 0x07, 0x40, 0x00, 0x52, 0x07, 0xfe, 0x09, 0x80, 0x09, 0x49, 0x06, 
+0xb1, 0x00, 0x92, 0x11, 0x8b, 0x13, 0x4c, 0x00, 0xcd, 0x00, 0x93, 0x0b, 
+0xd6, 0x15, 0xd9, 0x00, 0x12, 0x0a, 0x84, 0x00, 0xfd, 0x08, 0x89, 0x0b, 
+0xfb, 0x0a, 0x6d, 0x00, 0xa1, 0x0c, 0xd7, 0x07, 0x88, 0x0b, 0xca, 0x00, 
+0x09, 0x0a, 0x0f, 0x0b, 0xd9, 0x0a, 0x34, 0x0a, 0x9a, 0x0a, 0xd7, 0x00, 
+0x55, 0x11, 0xaa, 0x08, 0x59, 0x17, 0x7b, 0x1b, 0xaf, 0x10, 0x9d, 0x00, 
+0x67, 0x07, 0x4f, 0x0c, 0xab, 0x00, 0x4a, 0x0a, 0xe8, 0x1a, 0xbc, 0x00, 
+0x24, 0x08, 0xaf, 0x00, 0x40, 0x0f, 0x2f, 0x07, 0x33, 0x0b, 0x77, 0x19, 
+0x40, 0x01, 0x6b, 0x00, 0xd3, 0x10, 0x62, 0x0e, 0x18, 0x0c, 0xac, 0x06, 
+0x3c, 0x01, 0xa3, 0x15, 0x6c, 0x0a, 0xf0, 0x00, 0x1c, 0x08, 0x3d, 0x01, 
+0x78, 0x10, 0x28, 0x15, 0x07, 0x01, 0x73, 0x16, 0x18, 0x01, 0x3a, 0x0c, 
+0x3a, 0x01, 0x61, 0x00, 0xe7, 0x00, 0x34, 0x01, 0x70, 0x00, 0x01, 0x0d, 
+0x0f, 0x0b, 0xc9, 0x0a, 0x29, 0x0a, 0xe8, 0x09, 0x2a, 0x01, 0xc2, 0x07, 
+0xf3, 0x09, 0x8b, 0x0b, 0xaa, 0x0b, 0xe7, 0x00, 0xfb, 0x00, 0x6f, 0x0c, 
+0xb4, 0x00, 0xf9, 0x00, 0x3b, 0x00, 0x19, 0x0c, 0xea, 0x09, 0xdf, 0x08, 
+0x78, 0x00, 0x2a, 0x0b, 0x9e, 0x00, 0x95, 0x0b, 0xde, 0x0c, 0x02, 0x01, 
+0xa5, 0x07, 0xba, 0x00, 0xc1, 0x17, 0xc6, 0x0a, 0x45, 0x08, 0x52, 0x0b, 
+0xb4, 0x0c, 0x25, 0x01, 0x2c, 0x01, 0xb3, 0x08, 0x5e, 0x07, 0x67, 0x0b, 
+0x56, 0x0a, 0x2c, 0x01, 0xa1, 0x0b, 0x5e, 0x12, 0xf6, 0x0b, 0x18, 0x0c, 
+0x1a, 0x0e, 0xdf, 0x08, 0x6d, 0x0a, 0xfe, 0x00, 0x84, 0x00, 0xad, 0x00, 
+0x54, 0x11, 0xc5, 0x0a, 0x23, 0x00, 0xe3, 0x00, 0x0d, 0x0d, 0x9d, 0x00, 
+0xed, 0x0e, 0x27, 0x00, 0xa6, 0x0c, 0x83, 0x00, 0x96, 0x17, 0xaf, 0x09, 
+0x68, 0x07, 0xfe, 0x0e, 0x90, 0x06, 0xce, 0x0a, 0x23, 0x0c, 0xdc, 0x09, 
+0x19, 0x00, 0xdd, 0x0c, 0xd6, 0x16, 0xa5, 0x09, 0x66, 0x11, 0xc5, 0x00, 
+0xb3, 0x07, 0x34, 0x0a, 0xc8, 0x00, 0x29, 0x0a, 0x74, 0x0f, 0x2d, 0x0a, 
+0x5b, 0x10, 0x49, 0x15, 0x6b, 0x0e, 0xc5, 0x00, 0x9d, 0x0f, 0xd9, 0x00, 
+0x3e, 0x01, 0x18, 0x00, 0xcb, 0x00, 0xe1, 0x08, 0x45, 0x0c, 0x58, 0x00, 
+0xb9, 0x1b, 0x9a, 0x15, 0x00, 0x01, 0xf6, 0x00, 0x33, 0x0a, 0xdc, 0x00, 
+0x7a, 0x18, 0xdf, 0x08, 0xce, 0x00, 0x03, 0x0c, 0xd6, 0x0b, 0xf1, 0x0a, 
+0x1d, 0x0b, 0x66, 0x0c, 0x60, 0x12, 0xdc, 0x0a, 0x3e, 0x0a, 0x2e, 0x01, 
+0xeb, 0x07, 0xeb, 0x09, 0xaf, 0x10, 0x25, 0x00, 0x91, 0x0c, 0x14, 0x0b, 
+0x22, 0x01, 0x47, 0x00, 0xa6, 0x0f, 0xfa, 0x14, 0xf7, 0x00, 0xc5, 0x00, 
+0xc8, 0x00, 0x9e, 0x00, 0x0f, 0x01, 0x68, 0x08, 0xee, 0x09, 0x8d, 0x16, 
+0x51, 0x0b, 0x05, 0x08, 0xcd, 0x0a, 0xb0, 0x12, 0x18, 0x01, 0xad, 0x0a, 
+0x3b, 0x01, 0xa8, 0x0f, 0xbe, 0x00, 0x4a, 0x11, 0xe7, 0x00, 0xc3, 0x00, 
+0x8d, 0x0b, 0xb6, 0x0b, 0xf4, 0x08, 0x24, 0x00, 0xfa, 0x0a, 0x37, 0x01, 
+0xfa, 0x00, 0x11, 0x00, 0xab, 0x0b, 0xae, 0x00, 0x43, 0x0c, 0x17, 0x01, 
+0xee, 0x11, 0xbd, 0x09, 0xc3, 0x00, 0x49, 0x0a, 0x2e, 0x09, 0xec, 0x00, 
+0x26, 0x09, 0x64, 0x06, 0x98, 0x15, 0xa0, 0x19, 0x38, 0x0c, 0x0f, 0x0b, 
+0x42, 0x09, 0x02, 0x13, 0x36, 0x01, 0x12, 0x0a, 0x20, 0x01, 0xaf, 0x0a, 
+0x83, 0x00, 0x33, 0x12, 0xe8, 0x13, 0x9d, 0x0c, 0x42, 0x13, 0xf3, 0x09, 
+0xbb, 0x00, 0xc2, 0x0a, 0x56, 0x0a, 0x9d, 0x00, 0x58, 0x17, 0x14, 0x00, 
+0x2b, 0x18, 0x08, 0x0b, 0x30, 0x01, 0x1a, 0x01, 0x1a, 0x0e, 0xd6, 0x09, 
+0xf2, 0x14, 0xca, 0x0c, 0x27, 0x01, 0xb1, 0x0c, 0xaa, 0x1a, 0x6e, 0x10, 
+0x32, 0x0b, 0xf6, 0x0a, 0xb5, 0x0b, 0x06, 0x01, 0xba, 0x0b, 0x30, 0x0c, 
+0xf7, 0x0b, 0xd1, 0x0e, 0x89, 0x0b, 0x14, 0x11, 0x20, 0x09, 0xdc, 0x07, 
+0x97, 0x15, 0x54, 0x0a, 0x1b, 0x0d, 0x1b, 0x01, 0xeb, 0x00, 0x25, 0x0b, 
+0xde, 0x08, 0x7d, 0x0b, 0xb6, 0x00, 0x36, 0x07, 0xf5, 0x09, 0x50, 0x17, 
+0x47, 0x00, 0x64, 0x0c, 0x15, 0x0a, 0x07, 0x01, 0x6f, 0x13, 0xfb, 0x16, 
+0x89, 0x0b, 0xb5, 0x06, 0xb1, 0x00, 0x20, 0x09, 0x5b, 0x00, 0xbb, 0x00, 
+0xdb, 0x10, 0xc9, 0x0c, 0x03, 0x01, 0x6b, 0x06, 0xa0, 0x19, 0x49, 0x06, 
+0xb6, 0x1b, 0xbf, 0x00, 0x6c, 0x00, 0xdf, 0x08, 0x9c, 0x18, 0x67, 0x12, 
+0x35, 0x01, 0x2e, 0x01, 0x24, 0x01, 0x07, 0x0a, 0x5a, 0x00, 0x67, 0x13, 
+0x2f, 0x0c, 0xc8, 0x00, 0xd0, 0x09, 0x0d, 0x13, 0xbe, 0x0c, 0x5b, 0x06, 
+0xd1, 0x09, 0x84, 0x00, 0x12, 0x0a, 0x98, 0x00, 0xbb, 0x06, 0x1e, 0x16, 
+0xfe, 0x08, 0xc9, 0x0f, 0x7a, 0x18, 0x9c, 0x0a, 0xa6, 0x0c, 0xb1, 0x00, 
+0xa7, 0x0c, 0x5c, 0x08, 0x3b, 0x01, 0xd7, 0x00, 0x33, 0x0a, 0xbc, 0x0a, 
+0xbf, 0x10, 0x1b, 0x0f, 0xd2, 0x0c, 0x31, 0x00, 0x89, 0x00, 0xd4, 0x00, 
+0xff, 0x08, 0x8f, 0x06, 0x1b, 0x0b, 0xbb, 0x00, 0xaf, 0x00, 0x54, 0x19, 
+0xdc, 0x06, 0xc0, 0x00, 0x0e, 0x01, 0xf5, 0x00, 0xf3, 0x0c, 0xfd, 0x09, 
+0x73, 0x10, 0xb4, 0x0b, 0xce, 0x00, 0xc8, 0x07, 0xb1, 0x0c, 0x2f, 0x00, 
+0x1b, 0x0b, 0x96, 0x15, 0x36, 0x08, 0xe0, 0x08, 0x91, 0x0a, 0xe0, 0x00, 
+0x08, 0x0b, 0xd7, 0x0b, 0x3f, 0x09, 0xac, 0x00, 0x9a, 0x16, 0xca, 0x00, 
+0x1e, 0x0b, 0x0c, 0x00, 0x2b, 0x0a, 0xb9, 0x06, 0xd4, 0x10, 0x96, 0x0a, 
+0x8f, 0x00, 0xcc, 0x00, 0xd2, 0x00, 0x68, 0x08, 0xd0, 0x09, 0xdc, 0x00, 
+0x14, 0x07, 0x01, 0x07, 0xf1, 0x00, 0x2b, 0x01, 0x07, 0x13, 0xa6, 0x0b, 
+0x43, 0x0c, 0xc1, 0x0e, 0x73, 0x07, 0x0c, 0x0a, 0xdd, 0x0d, 0x55, 0x0a, 
+0xbd, 0x07, 0x0f, 0x0b, 0xff, 0x0c, 0xc8, 0x0c, 0x38, 0x09, 0xca, 0x0c, 
+0x26, 0x01, 0x88, 0x0b, 0xec, 0x06, 0xf0, 0x00, 0x2b, 0x00, 0xdc, 0x09, 
+0xf6, 0x00, 0x23, 0x01, 0x17, 0x14, 0x6a, 0x0a, 0x1b, 0x0e, 0xc0, 0x0b, 
+0x31, 0x00, 0x9d, 0x08, 0x2f, 0x00, 0x99, 0x15, 0xff, 0x09, 0x4a, 0x0b, 
+0xbf, 0x0b, 0x26, 0x12, 0x26, 0x08, 0xbb, 0x08, 0x3d, 0x07, 0xad, 0x00, 
+0x25, 0x00, 0xe2, 0x09, 0xae, 0x00, 0xe6, 0x0b, 0xb6, 0x0e, 0xf0, 0x0d, 
+0xfa, 0x00, 0xd2, 0x00, 0xb3, 0x0c, 0xb5, 0x00, 0x27, 0x07, 0xed, 0x06, 
+0xf5, 0x14, 0x6c, 0x00, 0x60, 0x0a, 0x36, 0x00, 0x3d, 0x01, 0xce, 0x00, 
+0xb4, 0x0a, 0x0a, 0x01, 0x1c, 0x0b, 0x1f, 0x15, 0xe8, 0x13, 0x32, 0x18, 
+0x02, 0x0c, 0x6a, 0x0d, 0x1e, 0x01, 0xbe, 0x10, 0x43, 0x07, 0x49, 0x0c, 
+0x2e, 0x08, 0xf1, 0x13, 0xf2, 0x14, 0xa1, 0x15, 0xda, 0x00, 0xb4, 0x0b, 
+0xd6, 0x07, 0x3c, 0x10, 0x3e, 0x01, 0x1f, 0x00, 0x37, 0x01, 0xec, 0x07, 
+0x0c, 0x17, 0x42, 0x06, 0xe1, 0x0f, 0x13, 0x11, 0xec, 0x00, 0x14, 0x07, 
+0xb5, 0x00, 0xd1, 0x09, 0xbc, 0x00, 0xb7, 0x11, 0xbf, 0x1a, 0x75, 0x16, 
+0x7e, 0x0e, 0xca, 0x0e, 0xf7, 0x00, 0xef, 0x0a, 0x88, 0x0b, 0xee, 0x0e, 
+0xcf, 0x0b, 0xfe, 0x00, 0xd2, 0x09, 0xf5, 0x0c, 0xd6, 0x0b, 0xbe, 0x00, 
+0x7d, 0x00, 0x02, 0x01, 0x7b, 0x0c, 0xde, 0x16, 0xb2, 0x00, 0x61, 0x0a, 
+0xcd, 0x0a, 0x77, 0x00, 0xba, 0x00, 0x3a, 0x13, 0x16, 0x0a, 0xdc, 0x09, 
+0xab, 0x0b, 0xbc, 0x00, 0x58, 0x06, 0xb4, 0x0b, 0x94, 0x0f, 0xce, 0x0c, 
+0x27, 0x12, 0xd2, 0x00, 0x9c, 0x19, 0xa6, 0x09, 0xc6, 0x09, 0x8c, 0x06, 
+0x8e, 0x06, 0x34, 0x00, 0x12, 0x09, 0xc9, 0x00, 0xe3, 0x0b, 0x55, 0x00, 
+0x3d, 0x13, 0xfc, 0x00, 0x6c, 0x00, 0xbc, 0x0c, 0x46, 0x08, 0x5b, 0x0a, 
+0x24, 0x00, 0x51, 0x14, 0x90, 0x00, 0x4e, 0x17, 0x22, 0x0c, 0x7b, 0x10, 
+0x92, 0x00, 0x41, 0x01, 0xf2, 0x00, 0x2d, 0x01, 0x94, 0x00, 0xc4, 0x00, 
+0x5f, 0x00, 0xc6, 0x09, 0x8c, 0x06, 0x3d, 0x01, 0x90, 0x0c, 0x62, 0x0e, 
+0x22, 0x01, 0x31, 0x12, 0x35, 0x09, 0xa0, 0x0c, 0xf1, 0x00, 0xce, 0x00, 
+0xcb, 0x0f, 0xb6, 0x00, 0xbe, 0x09, 0x83, 0x0a, 0x47, 0x00, 0xca, 0x09, 
+0xf5, 0x00, 0x42, 0x01, 0xfc, 0x09, 0xa8, 0x08, 0xbf, 0x00, 0xe2, 0x07, 
+0xd1, 0x09, 0x85, 0x17, 0xe8, 0x00, 0x2f, 0x01, 0xf5, 0x0d, 0xbb, 0x09, 
+0xbf, 0x00, 0x6a, 0x0a, 0xdd, 0x0a, 0x48, 0x0c, 0xb3, 0x00, 0xc5, 0x00, 
+0x3d, 0x00, 0x0f, 0x0b, 0xd0, 0x09, 0x0e, 0x0c, 0x13, 0x0b, 0x4c, 0x1a, 
+0x4a, 0x12, 0x81, 0x11, 0x17, 0x00, 0x31, 0x01, 0xa9, 0x00, 0x07, 0x01, 
+0x07, 0x0c, 0x69, 0x0b, 0x22, 0x0e, 0xe3, 0x0b, 0xf9, 0x09, 0xd8, 0x00, 
+0x93, 0x0f, 0xed, 0x06, 0xe7, 0x00, 0x51, 0x0b, 0x6e, 0x13, 0x2d, 0x0c, 
+0x6e, 0x00, 0xb2, 0x00, 0xb7, 0x10, 0x72, 0x0b, 0x2d, 0x15, 0xf6, 0x10, 
+0x26, 0x00, 0x8c, 0x12, 0x4a, 0x13, 0x04, 0x09, 0xde, 0x08, 0x00, 0x0f, 
+0x41, 0x0a, 0x3f, 0x09, 0xe0, 0x09, 0xf4, 0x08, 0x93, 0x0f, 0x01, 0x01, 
+0xe2, 0x00, 0xfb, 0x0b, 0x53, 0x17, 0x26, 0x0b, 0xf3, 0x00, 0x90, 0x0c, 
+0xa7, 0x09, 0x19, 0x0a, 0xd9, 0x00, 0xc5, 0x09, 0x2f, 0x08, 0x8f, 0x00, 
+0x24, 0x00, 0xf3, 0x08, 0x3a, 0x01, 0x8b, 0x0b, 0xc3, 0x00, 0x1a, 0x0e, 
+0x72, 0x0b, 0xab, 0x08, 0x1c, 0x0c, 0xfd, 0x15, 0xa1, 0x0a, 0x26, 0x12, 
+0x72, 0x0a, 0xd3, 0x0f, 0xaf, 0x12, 0x98, 0x0a, 0xe2, 0x10, 0x8d, 0x11, 
+0x37, 0x08, 0x11, 0x0e, 0x34, 0x0a, 0x41, 0x0f, 0xde, 0x16, 0x66, 0x08, 
+0x39, 0x0c, 0x66, 0x14, 0x1e, 0x07, 0xf8, 0x00, 0xe4, 0x0d, 0x15, 0x13, 
+0x8f, 0x17, 0xd5, 0x0b, 0xfa, 0x18, 0x62, 0x17, 0x5c, 0x08, 0x27, 0x07, 
+0xfe, 0x0c, 0x3f, 0x0c, 0x72, 0x16, 0xd1, 0x00, 0xcd, 0x00, 0x5f, 0x07, 
+0x55, 0x0a, 0xc1, 0x00, 0xb7, 0x0c, 0x02, 0x0c, 0x6c, 0x18, 0xe3, 0x0a, 
+0x1e, 0x15, 0xcd, 0x15, 0x37, 0x01, 0x6f, 0x00, 0x36, 0x01, 0x47, 0x06, 
+0x10, 0x13, 0x43, 0x06, 0x9c, 0x00, 0x42, 0x15, 0x3f, 0x00, 0x2a, 0x18, 
+0x62, 0x09, 0x6b, 0x00, 0xe0, 0x0b, 0x26, 0x00, 0xe1, 0x00, 0x2c, 0x0a, 
+0x34, 0x01, 0x46, 0x0b, 0x92, 0x08, 0xe4, 0x0d, 0xf8, 0x00, 0x72, 0x0b, 
+0x13, 0x0b, 0xed, 0x00, 0x2e, 0x01, 0x3e, 0x00, 0xfc, 0x1a, 0xd6, 0x0b, 
+0xfb, 0x00, 0xbc, 0x16, 0xab, 0x0c, 0x29, 0x0a, 0xd2, 0x00, 0x17, 0x0c, 
+0x4b, 0x0f, 0x5f, 0x00, 0x36, 0x0b, 0x02, 0x15, 0xcc, 0x00, 0x69, 0x0b, 
+0x17, 0x0c, 0x25, 0x0b, 0x4a, 0x0a, 0x4b, 0x0a, 0xd2, 0x09, 0xe3, 0x00, 
+0x18, 0x01, 0x0f, 0x0c, 0xf1, 0x00, 0x3e, 0x01, 0xe4, 0x00, 0x41, 0x1a, 
+0x5c, 0x13, 0x6f, 0x14, 0x45, 0x06, 0x83, 0x0b, 0x9a, 0x09, 0x42, 0x13, 
+0xfa, 0x00, 0x4b, 0x13, 0x2b, 0x09, 0x39, 0x0c, 0xb2, 0x0c, 0x3e, 0x0b, 
+0xd9, 0x00, 0x62, 0x09, 0xd9, 0x00, 0xa3, 0x0d, 0x80, 0x07, 0xee, 0x0a, 
+0xe8, 0x0c, 0x10, 0x0c, 0x94, 0x00, 0x04, 0x01, 0x0a, 0x01, 0x25, 0x00, 
+0x3c, 0x01, 0x3e, 0x0e, 0xe5, 0x15, 0xae, 0x00, 0xc4, 0x00, 0xba, 0x00, 
+0xef, 0x0a, 0xa7, 0x08, 0xba, 0x0e, 0x10, 0x0b, 0xa1, 0x0b, 0x7d, 0x13, 
+0xa1, 0x06, 0x2c, 0x00, 0x66, 0x14, 0xfe, 0x0f, 0xc5, 0x14, 0xa3, 0x07, 
+0x4b, 0x00, 0xb6, 0x0e, 0x32, 0x0c, 0xaf, 0x10, 0x8b, 0x0c, 0xc2, 0x0a, 
+0x14, 0x07, 0xcc, 0x0b, 0x08, 0x11, 0x07, 0x0b, 0x47, 0x0b, 0xe9, 0x00, 
+0xa4, 0x09, 0x2a, 0x0c, 0x3f, 0x00, 0xd0, 0x00, 0x0f, 0x01, 0xef, 0x0a, 
+0x4d, 0x0d, 0x08, 0x0d, 0x12, 0x0b, 0xbf, 0x0b, 0x16, 0x13, 0x57, 0x14, 
+0xe1, 0x10, 0xae, 0x06, 0x72, 0x16, 0x1e, 0x15, 0xba, 0x00, 0x72, 0x0b, 
+0xf6, 0x00, 0xcf, 0x0a, 0x20, 0x01, 0x04, 0x09, 0xdb, 0x06, 0xa5, 0x10, 
+0xb5, 0x09, 0xfd, 0x10, 0x85, 0x17, 0xe9, 0x08, 0xbc, 0x07, 0x24, 0x0c, 
+0x9f, 0x0c, 0xa1, 0x0a, 0x53, 0x19, 0x0d, 0x0a, 0x23, 0x00, 0x78, 0x0b, 
+0x10, 0x01, 0x60, 0x07, 0xa7, 0x09, 0xb0, 0x00, 0x57, 0x00, 0x93, 0x12, 
+0x5f, 0x00, 0x05, 0x13, 0xec, 0x07, 0xdb, 0x07, 0x1c, 0x0c, 0xa0, 0x00, 
+0xc1, 0x00, 0xfe, 0x08, 0x3a, 0x18, 0x2a, 0x01, 0x88, 0x0b, 0x2f, 0x00, 
+0x1b, 0x08, 0xfe, 0x08, 0xe3, 0x00, 0x27, 0x11, 0x6f, 0x10, 0x72, 0x07, 
+0xdb, 0x07, 0xfc, 0x09, 0xd9, 0x06, 0xdd, 0x09, 0xde, 0x06, 0x3a, 0x0a, 
+0x9d, 0x18, 0xcf, 0x06, 0x4e, 0x17, 0xf7, 0x06, 0xf7, 0x0d, 0x56, 0x1a, 
+0xcf, 0x00, 0xb1, 0x00, 0xdf, 0x09, 0x12, 0x01, 0x21, 0x01, 0xe0, 0x00, 
+0xfe, 0x09, 0x66, 0x09, 0x43, 0x13, 0xbb, 0x14, 0xef, 0x0b, 0x77, 0x00, 
+0x9a, 0x0b, 0x99, 0x00, 0x6c, 0x17, 0x22, 0x0f, 0x4c, 0x0f, 0x4d, 0x06, 
+0xf4, 0x00, 0x6f, 0x0c, 0x1c, 0x0e, 0xc4, 0x08, 0x22, 0x01, 0x5e, 0x0c, 
+0x07, 0x01, 0x59, 0x17, 0xe9, 0x09, 0xee, 0x0e, 0x3f, 0x00, 0xa1, 0x0b, 
+0x78, 0x0a, 0xec, 0x08, 0xec, 0x09, 0xda, 0x00, 0xa9, 0x00, 0xc1, 0x00, 
+0xf6, 0x08, 0x2d, 0x0c, 0x59, 0x17, 0x7c, 0x0c, 0xab, 0x00, 0x97, 0x06, 
+0x2c, 0x0a, 0x47, 0x00, 0x66, 0x09, 0xe9, 0x08, 0x8a, 0x11, 0x68, 0x0b, 
+0xce, 0x0b, 0x86, 0x00, 0x6f, 0x00, 0x60, 0x00, 0x2c, 0x01, 0x59, 0x09, 
+0x60, 0x0a, 0x94, 0x00, 0xc5, 0x09, 0x4a, 0x06, 0xc5, 0x09, 0x0b, 0x10, 
+0xdf, 0x08, 0x46, 0x19, 0x41, 0x06, 0x23, 0x09, 0x77, 0x00, 0x40, 0x06, 
+0x29, 0x0a, 0x40, 0x06, 0x5f, 0x11, 0x0c, 0x13, 0x7a, 0x08, 0x92, 0x00, 
+0xa7, 0x08, 0x23, 0x09, 0x97, 0x06, 0xbe, 0x0c, 0xf3, 0x00, 0xeb, 0x07, 
+0x80, 0x09, 0xba, 0x14, 0x41, 0x0a, 0x32, 0x0b, 0x7b, 0x00, 0xd5, 0x0b, 
+0xdd, 0x09, 0x33, 0x12, 0x13, 0x0b, 0x64, 0x0d, 0x0e, 0x00, 0x72, 0x09, 
+0x9e, 0x0f, 0xe6, 0x00, 0x58, 0x00, 0x40, 0x01, 0x7d, 0x0b, 0x56, 0x06, 
+0xbf, 0x0b, 0x57, 0x00, 0x10, 0x01, 0x8e, 0x08, 0xca, 0x00, 0xd2, 0x0b, 
+0x32, 0x01, 0xb8, 0x15, 0xda, 0x0f, 0xdf, 0x13, 0x7f, 0x0b, 0xa9, 0x07, 
+0x5e, 0x08, 0xe8, 0x0c, 0xdd, 0x0d, 0x84, 0x00, 0x70, 0x07, 0x39, 0x09, 
+0xc2, 0x0a, 0xf8, 0x00, 0xe1, 0x0b, 0xc5, 0x08, 0x27, 0x01, 0xb1, 0x0c, 
+0xc7, 0x00, 0x26, 0x0c, 0x1a, 0x0b, 0xfe, 0x00, 0xec, 0x13, 0x5f, 0x06, 
+0x78, 0x10, 0x67, 0x0b, 0xe0, 0x08, 0xb7, 0x12, 0x24, 0x08, 0x42, 0x06, 
+0x3e, 0x09, 0xf8, 0x00, 0x62, 0x00, 0x3e, 0x0a, 0x3f, 0x01, 0x13, 0x0b, 
+0x9d, 0x06, 0x3e, 0x0a, 0xf5, 0x13, 0xfc, 0x09, 0xb4, 0x0c, 0x3f, 0x01, 
+0x0b, 0x01, 0xb2, 0x16, 0x1a, 0x0b, 0xe4, 0x00, 0x53, 0x0b, 0xe7, 0x0a, 
+0xe9, 0x13, 0x3f, 0x09, 0x79, 0x00, 0xd0, 0x0b, 0x0a, 0x0a, 0x40, 0x06, 
+0xe7, 0x0f, 0x70, 0x00, 0x03, 0x12, 0x74, 0x15, 0xbf, 0x00, 0xa3, 0x0a, 
+0x34, 0x01, 0xce, 0x00, 0x9c, 0x0f, 0x36, 0x0d, 0x97, 0x0a, 0xf3, 0x08, 
+0xd7, 0x00, 0xc8, 0x0c, 0x12, 0x00, 0xf1, 0x0e, 0xeb, 0x00, 0x90, 0x0c, 
+0xdd, 0x09, 0x75, 0x0b, 0xed, 0x0b, 0xa6, 0x0c, 0x7f, 0x16, 0x87, 0x0c, 
+0x1d, 0x07, 0x1e, 0x0a, 0x22, 0x09, 0x8b, 0x0a, 0x33, 0x0a, 0xd2, 0x0c, 
+0x80, 0x0b, 0x4e, 0x17, 0x10, 0x07, 0xce, 0x14, 0x9f, 0x12, 0xfd, 0x00, 
+0x95, 0x0b, 0x23, 0x01, 0x25, 0x01, 0x1f, 0x0b, 0xac, 0x00, 0xfb, 0x16, 
+0xd8, 0x15, 0x71, 0x08, 0xf5, 0x07, 0xf4, 0x00, 0x53, 0x00, 0x5c, 0x00, 
+0xb9, 0x0b, 0x39, 0x01, 0x9b, 0x0c, 0xb2, 0x00, 0xc0, 0x00, 0xa5, 0x0a, 
+0xd6, 0x0c, 0x4b, 0x11, 0xba, 0x00, 0xce, 0x14, 0xe1, 0x00, 0xd0, 0x15, 
+0x3c, 0x01, 0x3c, 0x0b, 0x79, 0x08, 0x98, 0x10, 0x68, 0x19, 0x81, 0x11, 
+0x8d, 0x11, 0xcb, 0x12, 0xa6, 0x10, 0x6c, 0x0a, 0xb4, 0x00, 0x13, 0x01, 
+0x6e, 0x12, 0x39, 0x01, 0xe3, 0x07, 0xb8, 0x00, 0x56, 0x00, 0x91, 0x06, 
+0x7d, 0x0a, 0xc3, 0x08, 0xbb, 0x00, 0xf1, 0x09, 0xf3, 0x08, 0x65, 0x0c, 
+0x6e, 0x00, 0x6e, 0x0a, 0x7e, 0x16, 0x84, 0x11, 0x2f, 0x01, 0x40, 0x01, 
+0x9f, 0x07, 0x5f, 0x11, 0x3b, 0x0b, 0x1e, 0x0a, 0xc3, 0x00, 0xf8, 0x0b, 
+0xfa, 0x14, 0x03, 0x01, 0xd2, 0x09, 0xf3, 0x0b, 0x1a, 0x08, 0xb3, 0x07, 
+0x70, 0x17, 0xb8, 0x00, 0x56, 0x0b, 0x3b, 0x00, 0xa2, 0x0a, 0xd0, 0x00, 
+0x82, 0x09, 0xef, 0x00, 0x51, 0x0f, 0x0f, 0x0b, 0x7d, 0x16, 0xb0, 0x00, 
+0xc2, 0x00, 0x4d, 0x06, 0x86, 0x0c, 0x0c, 0x0d, 0xe2, 0x00, 0x25, 0x0c, 
+0x31, 0x07, 0xed, 0x13, 0xbc, 0x0a, 0x09, 0x01, 0xce, 0x00, 0xde, 0x08, 
+0x15, 0x0b, 0x64, 0x0e, 0x43, 0x06, 0x6f, 0x09, 0xef, 0x00, 0x4d, 0x0d, 
+0xda, 0x0f, 0x58, 0x0b, 0x0d, 0x01, 0x67, 0x0a, 0xef, 0x0a, 0x5c, 0x08, 
+0x67, 0x08, 0x56, 0x00, 0x7d, 0x10, 0x0c, 0x0c, 0xc2, 0x17, 0x28, 0x0a, 
+0x94, 0x11, 0xd7, 0x00, 0x89, 0x09, 0x61, 0x00, 0x7e, 0x16, 0x01, 0x01, 
+0x30, 0x17, 0xfd, 0x09, 0xe1, 0x0b, 0x60, 0x07, 0x60, 0x00, 0x98, 0x0a, 
+0x1e, 0x0a, 0xaa, 0x0b, 0xa5, 0x0a, 0x2d, 0x01, 0x5f, 0x00, 0x03, 0x16, 
+0x75, 0x0a, 0x8c, 0x0b, 0x0e, 0x0c, 0xff, 0x0b, 0xd5, 0x00, 0x30, 0x0b, 
+0xeb, 0x13, 0x56, 0x0b, 0x1a, 0x0e, 0x35, 0x18, 0xae, 0x0a, 0x10, 0x0f, 
+0x90, 0x0c, 0xbe, 0x14, 0x67, 0x00, 0xc4, 0x14, 0x75, 0x0b, 0x1d, 0x13, 
+0x3b, 0x13, 0x5a, 0x0c, 0x32, 0x01, 0xb8, 0x15, 0x99, 0x0b, 0xa2, 0x06, 
+0xdd, 0x00, 0xbd, 0x00, 0x5a, 0x08, 0x04, 0x0c, 0x0f, 0x01, 0xd7, 0x15, 
+0x90, 0x10, 0xf6, 0x0e, 0x8b, 0x0a, 0x76, 0x0b, 0xfc, 0x00, 0x72, 0x0b, 
+0x79, 0x08, 0x5e, 0x07, 0x91, 0x0c, 0xc7, 0x0c, 0x43, 0x0a, 0xd6, 0x00, 
+0xec, 0x06, 0x1d, 0x00, 0xd7, 0x00, 0x86, 0x17, 0x00, 0x0a, 0x34, 0x0a, 
+0x19, 0x0c, 0x5c, 0x0c, 0xd5, 0x0b, 0x3b, 0x00, 0xb1, 0x00, 0x46, 0x15, 
+0x13, 0x0d, 0x09, 0x01, 0x97, 0x0a, 0xc0, 0x0e, 0x01, 0x0d, 0xb4, 0x0b, 
+0x6d, 0x09, 0x11, 0x07, 0xfb, 0x0a, 0x71, 0x0c, 0x5b, 0x0c, 0x71, 0x0e, 
+0x0c, 0x01, 0xbc, 0x00, 0x9a, 0x12, 0x3f, 0x00, 0xba, 0x00, 0x8b, 0x0a, 
+0x16, 0x19, 0xc5, 0x08, 0x2c, 0x0b, 0xfc, 0x1a, 0xd0, 0x00, 0x18, 0x14, 
+0xb0, 0x00, 0xbf, 0x00, 0x77, 0x09, 0x12, 0x0a, 0x27, 0x01, 0xed, 0x0d, 
+0x68, 0x07, 0xea, 0x08, 0x99, 0x12, 0xd2, 0x0f, 0xb3, 0x00, 0x34, 0x0a, 
+0x2b, 0x0a, 0xd8, 0x0a, 0xa5, 0x10, 0x11, 0x01, 0x0b, 0x16, 0x13, 0x07, 
+0xd6, 0x07, 0xec, 0x08, 0xd2, 0x0c, 0xfc, 0x0d, 0x26, 0x00, 0x44, 0x06, 
+0x20, 0x0d, 0x93, 0x0f, 0x57, 0x07, 0x01, 0x0c, 0xf8, 0x0d, 0xb3, 0x00, 
+0x91, 0x17, 0xfb, 0x15, 0x6d, 0x1c, 0x96, 0x07, 0xd7, 0x07, 0xf8, 0x00, 
+0x3e, 0x0a, 0x40, 0x06, 0xf8, 0x00, 0x65, 0x09, 0x87, 0x18, 0xf7, 0x0b, 
+0xaf, 0x11, 0xef, 0x0a, 0xa5, 0x09, 0x38, 0x08, 0x1b, 0x0e, 0xf3, 0x09, 
+0x41, 0x01, 0xed, 0x0b, 0x5f, 0x0b, 0x38, 0x0c, 0x4d, 0x0d, 0x01, 0x01, 
+0x15, 0x14, 0xd7, 0x0b, 0x57, 0x1a, 0x92, 0x00, 0x9b, 0x15, 0x4b, 0x0a, 
+0xd2, 0x0a, 0x3b, 0x00, 0xbc, 0x09, 0xbf, 0x0b, 0x37, 0x09, 0x06, 0x01, 
+0xfd, 0x16, 0x3a, 0x12, 0x7f, 0x0e, 0x3b, 0x01, 0x59, 0x06, 0x60, 0x0c, 
+0x72, 0x0a, 0xa6, 0x09, 0x60, 0x0a, 0x23, 0x08, 0xbf, 0x00, 0xb5, 0x00, 
+0xac, 0x0a, 0x96, 0x07, 0x45, 0x06, 0xba, 0x09, 0x3c, 0x01, 0x48, 0x0b, 
+0x8f, 0x00, 0x28, 0x01, 0x10, 0x01, 0xa8, 0x09, 0x80, 0x0a, 0x10, 0x01, 
+0xf7, 0x0f, 0xce, 0x0b, 0x77, 0x0b, 0x3c, 0x18, 0xc2, 0x00, 0xf6, 0x0b, 
+0xe2, 0x1a, 0xcc, 0x00, 0x41, 0x01, 0x95, 0x0b, 0x32, 0x18, 0xc3, 0x11, 
+0xab, 0x15, 0x6c, 0x00, 0x29, 0x00, 0xc9, 0x0f, 0xf4, 0x00, 0x1b, 0x01, 
+0x76, 0x0a, 0x3e, 0x01, 0xb7, 0x00, 0x6a, 0x0b, 0xae, 0x0a, 0xe1, 0x07, 
+0xf4, 0x00, 0xba, 0x00, 0x35, 0x01, 0xcc, 0x00, 0xe8, 0x09, 0x39, 0x01, 
+0x0c, 0x0d, 0x26, 0x01, 0x2a, 0x01, 0x63, 0x09, 0xb6, 0x00, 0xfd, 0x0b, 
+0x87, 0x18, 0xe2, 0x0c, 0x29, 0x01, 0x40, 0x06, 0x89, 0x0c, 0x20, 0x00, 
+0x5f, 0x07, 0x38, 0x0a, 0xbd, 0x11, 0xcb, 0x09, 0x47, 0x00, 0x8e, 0x0a, 
+0xc9, 0x0c, 0x9d, 0x0c, 0xe0, 0x13, 0xd7, 0x15, 0x58, 0x1c, 0xce, 0x0a, 
+0xda, 0x0d, 0xa5, 0x09, 0xcd, 0x0a, 0xbf, 0x00, 0x4a, 0x14, 0x20, 0x01, 
+0x91, 0x17, 0x30, 0x00, 0xa4, 0x15, 0x16, 0x17, 0x16, 0x18, 0xde, 0x08, 
+0x01, 0x14, 0x7c, 0x16, 0x11, 0x07, 0x4b, 0x14, 0xb8, 0x0a, 0xe9, 0x00, 
+0xe3, 0x07, 0xf2, 0x09, 0x70, 0x08, 0x68, 0x0c, 0x7e, 0x00, 0xfc, 0x0a, 
+0x1a, 0x0f, 0x0e, 0x00, 0xe0, 0x08, 0xd6, 0x0c, 0x59, 0x0c, 0xbb, 0x00, 
+0xee, 0x00, 0xfd, 0x07, 0x68, 0x07, 0xa7, 0x09, 0xba, 0x08, 0x26, 0x0b, 
+0xc8, 0x00, 0xe4, 0x00, 0x28, 0x0b, 0xec, 0x00, 0x02, 0x01, 0xb9, 0x00, 
+0x04, 0x01, 0xa8, 0x00, 0x2f, 0x00, 0x6c, 0x00, 0x12, 0x01, 0x58, 0x0e, 
+0x4b, 0x06, 0xd4, 0x0c, 0x65, 0x08, 0x66, 0x12, 0x3c, 0x00, 0x5d, 0x07, 
+0xe0, 0x09, 0x8a, 0x0b, 0x84, 0x11, 0xfa, 0x0a, 0x43, 0x0c, 0x6b, 0x0d, 
+0x73, 0x0c, 0xc0, 0x1a, 0xba, 0x0a, 0x1c, 0x07, 0xf1, 0x14, 0xe6, 0x00, 
+0xbe, 0x0a, 0xac, 0x0a, 0x35, 0x01, 0x42, 0x06, 0x96, 0x15, 0x79, 0x00, 
+0xcd, 0x0a, 0x29, 0x0a, 0x38, 0x01, 0x6f, 0x0c, 0x60, 0x1b, 0x5b, 0x06, 
+0xf5, 0x06, 0xe4, 0x06, 0x66, 0x12, 0x42, 0x13, 0x8b, 0x0a, 0x09, 0x0b, 
+0xda, 0x00, 0xbd, 0x10, 0x62, 0x0a, 0xa7, 0x00, 0xba, 0x08, 0x2d, 0x01, 
+0x0b, 0x00, 0x97, 0x17, 0xa5, 0x10, 0xa5, 0x10, 0x94, 0x00, 0x45, 0x0a, 
+0x55, 0x00, 0xe4, 0x18, 0x49, 0x00, 0x12, 0x00, 0xd7, 0x0c, 0x4a, 0x06, 
+0x13, 0x01, 0x91, 0x13, 0x5e, 0x12, 0xc8, 0x00, 0xca, 0x09, 0x57, 0x00, 
+0xc6, 0x09, 0x09, 0x01, 0x26, 0x0b, 0xe3, 0x0a, 0xc9, 0x00, 0x5b, 0x0f, 
+0x08, 0x0a, 0x04, 0x09, 0x58, 0x11, 0x2a, 0x0b, 0x64, 0x0c, 0x07, 0x0a, 
+0xff, 0x0e, 0x65, 0x0c, 0xce, 0x0e, 0x67, 0x0b, 0xaf, 0x0f, 0xbb, 0x09, 
+0x71, 0x07, 0x6d, 0x09, 0x3c, 0x00, 0xca, 0x0f, 0xcb, 0x00, 0xaf, 0x00, 
+0x55, 0x06, 0x3f, 0x0a, 0x85, 0x16, 0x15, 0x01, 0x0c, 0x0d, 0x89, 0x0c, 
+0x1e, 0x01, 0x3b, 0x01, 0xe8, 0x0c, 0x3d, 0x01, 0x57, 0x0a, 0x28, 0x10, 
+0xf8, 0x00, 0x7a, 0x09, 0x1c, 0x07, 0xe5, 0x16, 0x0b, 0x00, 0x43, 0x00, 
+0x06, 0x0b, 0x96, 0x17, 0x9c, 0x0f, 0x42, 0x06, 0x25, 0x16, 0x95, 0x07, 
+0xae, 0x00, 0xf4, 0x0c, 0x9b, 0x0a, 0x17, 0x01, 0x43, 0x06, 0x19, 0x08, 
+0xae, 0x06, 0x49, 0x0a, 0x6a, 0x08, 0xc2, 0x00, 0x3e, 0x09, 0x38, 0x0c, 
+0x63, 0x0a, 0x58, 0x06, 0x35, 0x0a, 0x85, 0x0b, 0x98, 0x00, 0xde, 0x0c, 
+0x40, 0x00, 0xe4, 0x0d, 0xd6, 0x07, 0xc9, 0x00, 0xbe, 0x0c, 0x6f, 0x0a, 
+0x70, 0x13, 0xca, 0x00, 0x97, 0x0b, 0xc9, 0x00, 0xa8, 0x09, 0xfb, 0x13, 
+0x2d, 0x0c, 0x11, 0x01, 0x9e, 0x08, 0x24, 0x0c, 0x35, 0x00, 0x35, 0x01, 
+0x44, 0x06, 0x1c, 0x07, 0x10, 0x07, 0xf1, 0x09, 0x31, 0x0b, 0xd6, 0x15, 
+0x1d, 0x0b, 0x37, 0x01, 0xe8, 0x0c, 0x82, 0x11, 0x36, 0x00, 0x17, 0x00, 
+0xf5, 0x08, 0xde, 0x0e, 0x60, 0x06, 0x41, 0x0f, 0x40, 0x06, 0xab, 0x0b, 
+0xd1, 0x00, 0x9f, 0x00, 0x1f, 0x01, 0x27, 0x01, 0xe9, 0x00, 0x34, 0x0b, 
+0x76, 0x0a, 0xc1, 0x00, 0xac, 0x0a, 0xcc, 0x0b, 0x1a, 0x01, 0xe1, 0x13, 
+0x50, 0x15, 0xd7, 0x17, 0xbe, 0x15, 0x5a, 0x08, 0xc4, 0x00, 0x03, 0x0d, 
+0xa9, 0x07, 0x05, 0x0c, 0x9c, 0x00, 0xe2, 0x07, 0x55, 0x06, 0x2a, 0x01, 
+0x88, 0x00, 0x47, 0x0b, 0xa5, 0x0b, 0xee, 0x00, 0xe4, 0x06, 0x3b, 0x0b, 
+0xe0, 0x0b, 0x17, 0x00, 0xd9, 0x15, 0x6f, 0x0c, 0x6b, 0x00, 0x30, 0x07, 
+0xb5, 0x0b, 0x31, 0x00, 0x06, 0x0b, 0x6f, 0x0a, 0xc5, 0x09, 0x43, 0x00, 
+0x64, 0x0c, 0xe1, 0x00, 0xf6, 0x00, 0x54, 0x00, 0x4a, 0x0a, 0xc8, 0x0c, 
+0xa9, 0x0c, 0x30, 0x12, 0x2a, 0x18, 0x12, 0x07, 0x71, 0x06, 0xa6, 0x09, 
+0x6b, 0x0d, 0x15, 0x01, 0x9d, 0x00, 0xc0, 0x0b, 0x29, 0x00, 0xaf, 0x00, 
+0x78, 0x09, 0xde, 0x0c, 0xaf, 0x00, 0x08, 0x01, 0xb5, 0x0c, 0x2f, 0x01, 
+0x33, 0x01, 0x70, 0x07, 0xd1, 0x09, 0x6e, 0x0a, 0x6b, 0x07, 0x48, 0x00, 
+0x95, 0x00, 0xbc, 0x14, 0x53, 0x00, 0x26, 0x00, 0x25, 0x01, 0xc7, 0x14, 
+0xb2, 0x17, 0x58, 0x17, 0x97, 0x0a, 0xb4, 0x08, 0x17, 0x00, 0x81, 0x10, 
+0x44, 0x0b, 0xbb, 0x00, 0xd3, 0x00, 0xee, 0x0b, 0xfc, 0x13, 0x56, 0x0a, 
+0xba, 0x0a, 0x41, 0x0b, 0x1a, 0x0b, 0x3a, 0x1a, 0x35, 0x0a, 0x3b, 0x1a, 
+0x73, 0x0d, 0x38, 0x0c, 0xf4, 0x0c, 0xa8, 0x00, 0xe4, 0x12, 0x31, 0x01, 
+0x3e, 0x0e, 0xab, 0x00, 0x7a, 0x10, 0xa7, 0x08, 0x3b, 0x0b, 0xf3, 0x00, 
+0xe0, 0x08, 0x07, 0x10, 0x32, 0x01, 0x25, 0x0c, 0xfd, 0x00, 0x13, 0x00, 
+0x27, 0x11, 0x51, 0x0b, 0x30, 0x01, 0x1e, 0x00, 0xbf, 0x09, 0x7f, 0x0e, 
+0xee, 0x00, 0xef, 0x0b, 0x60, 0x0b, 0xa1, 0x06, 0xb5, 0x16, 0x16, 0x01, 
+0x65, 0x0d, 0x77, 0x00, 0xd5, 0x0a, 0x58, 0x13, 0x8b, 0x09, 0x25, 0x0b, 
+0x9f, 0x07, 0x08, 0x0a, 0x33, 0x00, 0x8b, 0x0b, 0x48, 0x0b, 0x80, 0x0a, 
+0xe8, 0x00, 0xe6, 0x16, 0xb8, 0x0a, 0x65, 0x08, 0x6b, 0x12, 0x66, 0x11, 
+0xd9, 0x0a, 0xbe, 0x0c, 0x3e, 0x0a, 0xb2, 0x09, 0xd2, 0x10, 0xb2, 0x00, 
+0x2b, 0x09, 0xc9, 0x0e, 0xd1, 0x15, 0x1b, 0x0f, 0xd9, 0x11, 0x61, 0x00, 
+0x0d, 0x01, 0x5c, 0x0b, 0x17, 0x00, 0x8e, 0x17, 0x03, 0x13, 0x26, 0x09, 
+0x81, 0x11, 0x80, 0x09, 0xa1, 0x00, 0xf9, 0x00, 0xaa, 0x15, 0xe3, 0x00, 
+0xa0, 0x0b, 0xd9, 0x16, 0x42, 0x13, 0xeb, 0x07, 0xf0, 0x00, 0xf0, 0x0e, 
+0xbc, 0x0c, 0xf6, 0x00, 0xfb, 0x06, 0xe1, 0x00, 0xb3, 0x0a, 0xa7, 0x09, 
+0x2f, 0x15, 0x8b, 0x0a, 0xb6, 0x11, 0x5d, 0x0b, 0x5a, 0x08, 0x17, 0x01, 
+0x04, 0x01, 0x6a, 0x0a, 0xf8, 0x07, 0xd1, 0x00, 0xb9, 0x00, 0xb6, 0x00, 
+0x57, 0x18, 0x22, 0x0c, 0x42, 0x01, 0x9d, 0x08, 0x58, 0x0b, 0x4d, 0x11, 
+0x5a, 0x00, 0xff, 0x0e, 0xd3, 0x09, 0xf8, 0x0f, 0x1f, 0x01, 0xc5, 0x0a, 
+0xff, 0x0c, 0xec, 0x00, 0x92, 0x0f, 0xb9, 0x0a, 0x77, 0x00, 0x5f, 0x00, 
+0x83, 0x00, 0xbd, 0x14, 0xfe, 0x00, 0x0e, 0x00, 0xa8, 0x00, 0xd5, 0x0b, 
+0x7b, 0x10, 0x57, 0x0b, 0x3d, 0x0b, 0xd2, 0x06, 0x8f, 0x00, 0xa2, 0x0b, 
+0x1f, 0x01, 0x1f, 0x01, 0x46, 0x0c, 0xbd, 0x0d, 0x29, 0x0a, 0x98, 0x15, 
+0x0c, 0x13, 0xc3, 0x15, 0x24, 0x00, 0xa2, 0x06, 0x0d, 0x0d, 0xba, 0x00, 
+0xd9, 0x0a, 0xf3, 0x0c, 0xf6, 0x07, 0x55, 0x0a, 0xb9, 0x00, 0x0e, 0x0c, 
+0xf2, 0x13, 0xfd, 0x00, 0xb9, 0x1b, 0x8f, 0x0b, 0x2f, 0x00, 0xb6, 0x0e, 
+0xa5, 0x10, 0xd0, 0x0a, 0x8c, 0x0c, 0x7a, 0x00, 0x3c, 0x0b, 0xa1, 0x0b, 
+0x04, 0x13, 0x57, 0x11, 0x41, 0x0a, 0x8a, 0x09, 0xf3, 0x09, 0xe0, 0x00, 
+0xa7, 0x0c, 0xd6, 0x0b, 0xfc, 0x14, 0x04, 0x0c, 0x0c, 0x01, 0xb7, 0x0a, 
+0x72, 0x10, 0x49, 0x06, 0xfa, 0x14, 0xe1, 0x08, 0xe1, 0x0f, 0xaf, 0x09, 
+0xae, 0x0a, 0xf2, 0x09, 0x38, 0x0d, 0xd2, 0x0a, 0x81, 0x0a, 0x9c, 0x08, 
+0x23, 0x00, 0x7d, 0x0d, 0xa0, 0x00, 0x26, 0x01, 0xb2, 0x08, 0xdb, 0x0b, 
+0xbe, 0x00, 0x5a, 0x08, 0xf0, 0x00, 0xd3, 0x00, 0x23, 0x00, 0x59, 0x0c, 
+0xf4, 0x06, 0xc5, 0x00, 0xf2, 0x17, 0x87, 0x17, 0xa7, 0x0c, 0x84, 0x11, 
+0x42, 0x06, 0xaf, 0x09, 0x19, 0x00, 0xe4, 0x00, 0xa9, 0x09, 0x96, 0x15, 
+0xca, 0x00, 0xeb, 0x07, 0xa6, 0x0a, 0xa2, 0x0b, 0x3f, 0x0d, 0x71, 0x10, 
+0x54, 0x0a, 0x2f, 0x01, 0xd5, 0x0b, 0x27, 0x07, 0xb0, 0x09, 0x0a, 0x01, 
+0x40, 0x00, 0xd6, 0x07, 0x18, 0x08, 0x29, 0x01, 0x02, 0x01, 0x36, 0x11, 
+0x77, 0x06, 0x17, 0x1a, 0xd0, 0x0a, 0x45, 0x0c, 0xc7, 0x0c, 0x5a, 0x0c, 
+0xa7, 0x09, 0x23, 0x00, 0xed, 0x00, 0x6e, 0x00, 0xe3, 0x00, 0x79, 0x08, 
+0xbc, 0x00, 0x27, 0x0b, 0xa5, 0x0a, 0xce, 0x00, 0x71, 0x0c, 0x66, 0x0c, 
+0xe3, 0x06, 0x9a, 0x12, 0x0e, 0x13, 0xeb, 0x00, 0xff, 0x09, 0x1b, 0x07, 
+0x10, 0x0b, 0xc8, 0x0a, 0x3e, 0x0d, 0xa1, 0x07, 0xe8, 0x0d, 0x44, 0x0c, 
+0x90, 0x0c, 0xd7, 0x00, 0x66, 0x08, 0x2a, 0x0a, 0xe0, 0x0a, 0xe2, 0x07, 
+0xc7, 0x0a, 0xea, 0x09, 0xf6, 0x0d, 0x93, 0x0b, 0x3d, 0x01, 0x27, 0x00, 
+0x6a, 0x12, 0x05, 0x0b, 0x9a, 0x16, 0xf5, 0x00, 0xf6, 0x08, 0x83, 0x00, 
+0x95, 0x0c, 0x47, 0x0e, 0x8d, 0x06, 0x97, 0x0b, 0xe2, 0x00, 0xcf, 0x0a, 
+0x0f, 0x01, 0x28, 0x0a, 0xd4, 0x00, 0x8a, 0x11, 0xc8, 0x09, 0x49, 0x0b, 
+0x78, 0x0a, 0xb7, 0x0d, 0xc7, 0x0c, 0xb8, 0x0b, 0x18, 0x01, 0x01, 0x01, 
+0xb6, 0x09, 0x26, 0x01, 0xb6, 0x0e, 0xac, 0x06, 0xbf, 0x00, 0x28, 0x0a, 
+0x2e, 0x07, 0xf4, 0x0c, 0x22, 0x0c, 0xf1, 0x0a, 0x30, 0x0b, 0x5c, 0x0b, 
+0x72, 0x09, 0x3b, 0x01, 0xe5, 0x0a, 0x61, 0x0a, 0x71, 0x10, 0xe6, 0x09, 
+0x36, 0x0a, 0x32, 0x0b, 0x77, 0x00, 0x20, 0x0a, 0xaa, 0x0b, 0x70, 0x10, 
+0xb5, 0x00, 0x07, 0x0a, 0x7c, 0x0c, 0x23, 0x09, 0xaf, 0x0a, 0x48, 0x06, 
+0xae, 0x00, 0xa9, 0x07, 0xa9, 0x00, 0x63, 0x0d, 0x36, 0x01, 0xb2, 0x06, 
+0x4a, 0x11, 0xe3, 0x0c, 0x06, 0x10, 0xd6, 0x0c, 0xb9, 0x11, 0xdf, 0x13, 
+0x80, 0x16, 0x41, 0x12, 0x22, 0x0c, 0x4a, 0x11, 0x21, 0x09, 0x3c, 0x00, 
+0x7b, 0x0c, 0x40, 0x0a, 0xf9, 0x0a, 0x34, 0x00, 0x75, 0x0c, 0x50, 0x08, 
+0x8b, 0x13, 0xd6, 0x07, 0x67, 0x08, 0x05, 0x10, 0x26, 0x0b, 0xd5, 0x09, 
+0x47, 0x00, 0xf5, 0x11, 0xaf, 0x00, 0xbf, 0x00, 0xe5, 0x0a, 0x4a, 0x06, 
+0x6c, 0x00, 0x2e, 0x07, 0xc6, 0x0a, 0x28, 0x0a, 0x31, 0x0b, 0x29, 0x01, 
+0x45, 0x0c, 0xca, 0x00, 0xbc, 0x00, 0x02, 0x13, 0x32, 0x0b, 0x00, 0x0f, 
+0x9f, 0x0c, 0x41, 0x06, 0x0a, 0x01, 0x60, 0x00, 0x35, 0x0d, 0x68, 0x08, 
+0x1f, 0x0c, 0xea, 0x08, 0x4c, 0x00, 0x32, 0x00, 0x66, 0x00, 0x42, 0x00, 
+0xdb, 0x00, 0xd3, 0x09, 0x84, 0x0a, 0xe5, 0x00, 0x15, 0x01, 0x20, 0x15, 
+0xef, 0x00, 0xf3, 0x00, 0xc4, 0x0a, 0x0f, 0x13, 0x6d, 0x00, 0x1e, 0x13, 
+0xa4, 0x0a, 0x0b, 0x0a, 0xcf, 0x0a, 0x47, 0x09, 0x7c, 0x0a, 0xfc, 0x08, 
+0x72, 0x10, 0xa9, 0x00, 0xbe, 0x00, 0xd6, 0x0b, 0x2f, 0x00, 0x79, 0x00, 
+0x0b, 0x00, 0xb7, 0x00, 0x20, 0x14, 0xb2, 0x08, 0x3c, 0x00, 0x17, 0x1a, 
+0x57, 0x07, 0xb5, 0x0b, 0x67, 0x11, 0x3b, 0x0b, 0x42, 0x14, 0x0f, 0x00, 
+0x65, 0x08, 0x33, 0x0a, 0xc3, 0x0a, 0xf0, 0x0e, 0x97, 0x15, 0x47, 0x06, 
+0x9d, 0x0c, 0x26, 0x01, 0x0f, 0x0b, 0x0f, 0x19, 0x6b, 0x00, 0x2e, 0x01, 
+0xa3, 0x0a, 0x9f, 0x07, 0x67, 0x09, 0xa3, 0x0b, 0xca, 0x0b, 0xf9, 0x13, 
+0x42, 0x06, 0x41, 0x0a, 0x78, 0x00, 0xc3, 0x0a, 0xd3, 0x0c, 0xfd, 0x07, 
+0x5f, 0x06, 0x0a, 0x0d, 0x47, 0x06, 0x3e, 0x09, 0xa1, 0x0a, 0xcb, 0x0c, 
+0xd7, 0x0b, 0xe1, 0x09, 0x2a, 0x0e, 0xc2, 0x0c, 0x37, 0x0a, 0x70, 0x10, 
+0xdc, 0x00, 0x1b, 0x0e, 0x31, 0x07, 0xe1, 0x0b, 0xbe, 0x00, 0x03, 0x0d, 
+0xb8, 0x00, 0x62, 0x09, 0xcc, 0x16, 0x6c, 0x17, 0x4b, 0x06, 0x75, 0x0b, 
+0xf2, 0x0a, 0x1e, 0x00, 0x88, 0x0b, 0xdb, 0x00, 0xa7, 0x08, 0xb4, 0x17, 
+0x53, 0x00, 0xcd, 0x15, 0xb2, 0x09, 0x4d, 0x0d, 0xe2, 0x07, 0xd9, 0x0a, 
+0x3f, 0x01, 0x70, 0x00, 0x1d, 0x0a, 0x35, 0x0c, 0xb0, 0x11, 0x5f, 0x18, 
+0x3e, 0x0e, 0x4c, 0x0a, 0xeb, 0x0b, 0x26, 0x00, 0xfd, 0x10, 0x32, 0x0b, 
+0x2d, 0x01, 0x50, 0x17, 0xff, 0x0e, 0x3f, 0x01, 0xe7, 0x0a, 0xc2, 0x0c, 
+0x37, 0x0a, 0xcf, 0x14, 0xdc, 0x00, 0x31, 0x00, 0xdc, 0x09, 0x69, 0x0c, 
+0x9e, 0x00, 0xca, 0x0b, 0xda, 0x00, 0x33, 0x00, 0xfa, 0x0a, 0xe3, 0x0a, 
+0x61, 0x17, 0xcd, 0x00, 0xea, 0x09, 0x40, 0x06, 0x1e, 0x0a, 0xa5, 0x09, 
+0x1d, 0x10, 0xa5, 0x0a, 0xd5, 0x00, 0xac, 0x00, 0x80, 0x0b, 0xfe, 0x09, 
+0x73, 0x16, 0xba, 0x0e, 0xd3, 0x0e, 0x56, 0x1a, 0x15, 0x0b, 0x99, 0x15, 
+0xa7, 0x0c, 0x32, 0x0b, 0x53, 0x00, 0xba, 0x09, 0x63, 0x00, 0x2d, 0x0c, 
+0xf6, 0x00, 0x20, 0x09, 0x30, 0x1a, 0x8a, 0x10, 0x03, 0x15, 0xf4, 0x0c, 
+0xec, 0x15, 0xf0, 0x00, 0x93, 0x0f, 0x7c, 0x0c, 0x26, 0x12, 0x08, 0x01, 
+0x27, 0x01, 0x23, 0x08, 0x7f, 0x0b, 0x87, 0x08, 0x81, 0x0a, 0xb4, 0x00, 
+0x1f, 0x01, 0x2f, 0x00, 0x53, 0x0c, 0xc0, 0x1a, 0xec, 0x00, 0xd7, 0x15, 
+0xd5, 0x00, 0xaa, 0x06, 0x26, 0x00, 0x2b, 0x18, 0xac, 0x00, 0x7e, 0x16, 
+0x78, 0x0a, 0x55, 0x0a, 0xed, 0x0d, 0x00, 0x01, 0xaa, 0x10, 0x2f, 0x01, 
+0x32, 0x18, 0x91, 0x00, 0x30, 0x07, 0xea, 0x0a, 0x39, 0x01, 0xd6, 0x0c, 
+0xc2, 0x0a, 0x2f, 0x0c, 0x2f, 0x01, 0xb6, 0x0c, 0x5b, 0x17, 0xd8, 0x07, 
+0xba, 0x09, 0x01, 0x0d, 0xec, 0x00, 0xb2, 0x0c, 0x28, 0x0a, 0x66, 0x08, 
+0x24, 0x0f, 0x4a, 0x11, 0xfa, 0x0b, 0xc5, 0x00, 0xd3, 0x09, 0xf0, 0x0a, 
+0xac, 0x0a, 0x40, 0x01, 0x63, 0x0a, 0xdb, 0x06, 0x14, 0x0b, 0xc5, 0x00, 
+0x24, 0x01, 0x6f, 0x10, 0xaa, 0x0b, 0x08, 0x0a, 0x47, 0x0b, 0x84, 0x00, 
+0xf8, 0x10, 0x3d, 0x00, 0x27, 0x14, 0x46, 0x0b, 0x81, 0x11, 0x25, 0x01, 
+0x4b, 0x00, 0xb0, 0x09, 0x60, 0x18, 0x17, 0x07, 0x2f, 0x01, 0x6c, 0x00, 
+0x23, 0x00, 0x73, 0x0b, 0x11, 0x01, 0xba, 0x0b, 0x10, 0x01, 0x5a, 0x18, 
+0x11, 0x00, 0xda, 0x00, 0x61, 0x0a, 0x7a, 0x0c, 0xff, 0x0a, 0x3f, 0x00, 
+0x25, 0x01, 0xa8, 0x00, 0x39, 0x01, 0x68, 0x07, 0x9d, 0x00, 0xd2, 0x0c, 
+0xaa, 0x00, 0x04, 0x0d, 0xa6, 0x09, 0xea, 0x00, 0x80, 0x0a, 0x53, 0x00, 
+0xdf, 0x00, 0x3f, 0x0e, 0x38, 0x0b, 0x48, 0x06, 0x80, 0x0b, 0xe4, 0x00, 
+0x9f, 0x17, 0xb9, 0x00, 0xd5, 0x09, 0x5d, 0x11, 0x08, 0x17, 0x88, 0x09, 
+0xd1, 0x0b, 0x7c, 0x00, 0x1e, 0x16, 0xb0, 0x09, 0x55, 0x07, 0x01, 0x0d, 
+0x06, 0x01, 0xd1, 0x0a, 0x24, 0x00, 0x9d, 0x0c, 0x6d, 0x0b, 0x4b, 0x13, 
+0xea, 0x08, 0xc2, 0x0a, 0x2d, 0x1a, 0xda, 0x11, 0xed, 0x00, 0x2b, 0x01, 
+0x08, 0x0a, 0x21, 0x09, 0x4f, 0x0c, 0x2d, 0x14, 0xb7, 0x11, 0x05, 0x13, 
+0x36, 0x01, 0xce, 0x00, 0x55, 0x00, 0x6f, 0x00, 0xc7, 0x09, 0x3a, 0x01, 
+0x30, 0x0b, 0x85, 0x16, 0xe8, 0x00, 0x66, 0x13, 0xcf, 0x0b, 0x0c, 0x00, 
+0x38, 0x01, 0xf6, 0x00, 0x83, 0x08, 0xb3, 0x09, 0x30, 0x0b, 0x42, 0x06, 
+0xad, 0x00, 0x25, 0x07, 0x78, 0x00, 0x37, 0x09, 0x14, 0x01, 0xf6, 0x0f, 
+0xc4, 0x0b, 0x1c, 0x01, 0x2b, 0x09, 0x3d, 0x00, 0xa2, 0x15, 0x40, 0x0d, 
+0x89, 0x10, 0x3b, 0x00, 0xe4, 0x00, 0xcd, 0x0c, 0x1e, 0x0a, 0xd6, 0x0b, 
+0x34, 0x01, 0x00, 0x01, 0x2d, 0x01, 0xee, 0x0a, 0x1b, 0x10, 0xfd, 0x0b, 
+0x23, 0x0c, 0x60, 0x00, 0x9a, 0x06, 0xc7, 0x00, 0x5f, 0x0b, 0x8a, 0x11, 
+0x63, 0x00, 0x47, 0x0c, 0xaa, 0x00, 0xf2, 0x18, 0x2c, 0x0a, 0xf2, 0x13, 
+0x26, 0x09, 0xc1, 0x17, 0x24, 0x19, 0x40, 0x0d, 0xd4, 0x0c, 0x33, 0x0a, 
+0xee, 0x06, 0x17, 0x0c, 0x12, 0x01, 0x53, 0x0c, 0x61, 0x06, 0x4e, 0x0a, 
+0x8d, 0x13, 0x60, 0x0f, 0x66, 0x0c, 0x14, 0x01, 0x10, 0x01, 0xed, 0x0b, 
+0x33, 0x14, 0x9b, 0x00, 0xdf, 0x00, 0xdc, 0x14, 0xba, 0x09, 0x84, 0x12, 
+0xfc, 0x08, 0x62, 0x0d, 0xf2, 0x00, 0xdb, 0x0d, 0x0c, 0x00, 0x5f, 0x0a, 
+0x31, 0x00, 0x32, 0x01, 0xb1, 0x00, 0x96, 0x15, 0x3e, 0x00, 0xba, 0x09, 
+0x62, 0x0d, 0x54, 0x11, 0xbf, 0x00, 0xa8, 0x13, 0x41, 0x06, 0x4a, 0x0a, 
+0x66, 0x0c, 0x53, 0x00, 0x29, 0x12, 0xdd, 0x0a, 0xc0, 0x00, 0x28, 0x0b, 
+0x9e, 0x00, 0xe2, 0x0c, 0x6b, 0x00, 0x69, 0x17, 0x57, 0x07, 0x17, 0x07, 
+0x3a, 0x13, 0x93, 0x00, 0x93, 0x00, 0xf1, 0x0b, 0xb5, 0x12, 0x39, 0x01, 
+0xd0, 0x0b, 0xa4, 0x09, 0x4f, 0x0c, 0xdb, 0x0e, 0x67, 0x00, 0x4b, 0x00, 
+0xb3, 0x0a, 0x30, 0x00, 0x65, 0x18, 0x1e, 0x08, 0x8d, 0x11, 0xc7, 0x14, 
+0x42, 0x01, 0xff, 0x00, 0xc4, 0x00, 0x9b, 0x07, 0x63, 0x17, 0x12, 0x0a, 
+0x25, 0x0b, 0xe6, 0x09, 0xa7, 0x0c, 0x7b, 0x12, 0x5f, 0x0b, 0x87, 0x0c, 
+0x90, 0x06, 0xb8, 0x0a, 0x49, 0x06, 0x43, 0x06, 0x76, 0x0a, 0x0b, 0x09, 
+0xdf, 0x08, 0x6f, 0x00, 0x76, 0x17, 0xa7, 0x0f, 0xb6, 0x10, 0x1a, 0x10, 
+0xf6, 0x00, 0x84, 0x00, 0x0d, 0x0c, 0xe9, 0x08, 0x9d, 0x00, 0xbc, 0x17, 
+0x06, 0x14, 0xca, 0x00, 0x0f, 0x01, 0xe1, 0x07, 0xf9, 0x00, 0x46, 0x19, 
+0x5a, 0x17, 0xfc, 0x0d, 0x19, 0x00, 0xd8, 0x07, 0x56, 0x00, 0x66, 0x13, 
+0x36, 0x0a, 0xd2, 0x00, 0x34, 0x01, 0x54, 0x11, 0xf0, 0x11, 0x06, 0x10, 
+0xae, 0x0a, 0x55, 0x06, 0x14, 0x16, 0x30, 0x0b, 0xf0, 0x0a, 0x79, 0x00, 
+0x68, 0x0b, 0xe9, 0x08, 0xc1, 0x0c, 0xbc, 0x0b, 0x31, 0x01, 0x7b, 0x13, 
+0xdd, 0x0a, 0x65, 0x11, 0x98, 0x15, 0x46, 0x0c, 0x44, 0x0c, 0x93, 0x0c, 
+0xe3, 0x07, 0x2d, 0x01, 0x32, 0x00, 0x6d, 0x09, 0x1e, 0x01, 0x29, 0x01, 
+0x61, 0x07, 0x80, 0x0a, 0x9c, 0x0f, 0x2b, 0x0a, 0xa0, 0x07, 0x37, 0x10, 
+0x28, 0x1a, 0x88, 0x0b, 0x28, 0x00, 0x8c, 0x0a, 0x46, 0x0b, 0x4b, 0x06, 
+0xac, 0x00, 0x28, 0x01, 0x3b, 0x00, 0xe9, 0x0c, 0x12, 0x0b, 0xde, 0x08, 
+0x04, 0x13, 0x97, 0x15, 0xb5, 0x00, 0x7d, 0x0b, 0xea, 0x00, 0x99, 0x0a, 
+0x60, 0x00, 0xa9, 0x09, 0x7d, 0x19, 0x72, 0x0b, 0xa2, 0x08, 0xce, 0x14, 
+0xee, 0x0a, 0xa2, 0x07, 0xee, 0x08, 0xc8, 0x1b, 0x70, 0x00, 0xbf, 0x0b, 
+0x2d, 0x01, 0xf5, 0x0c, 0x29, 0x0a, 0x5f, 0x0c, 0x3f, 0x0a, 0x52, 0x07, 
+0x98, 0x17, 0x2d, 0x0c, 0xd0, 0x06, 0xd9, 0x07, 0xf9, 0x0b, 0x87, 0x08, 
+0x48, 0x00, 0xe0, 0x00, 0x00, 0x0a, 0xf5, 0x08, 0x7e, 0x16, 0x7e, 0x0c, 
+0xf1, 0x09, 0xd4, 0x00, 0x78, 0x07, 0x23, 0x01, 0xa6, 0x0b, 0xa9, 0x00, 
+0x9f, 0x07, 0x0a, 0x0a, 0xb7, 0x00, 0x0d, 0x13, 0x07, 0x01, 0x49, 0x0b, 
+0xb5, 0x00, 0x0d, 0x0c, 0xd8, 0x00, 0xf4, 0x07, 0xad, 0x00, 0x6a, 0x07, 
+0xad, 0x00, 0x81, 0x0a, 0x0d, 0x01, 0xbf, 0x0c, 0x2a, 0x01, 0x24, 0x13, 
+0xc6, 0x0a, 0xac, 0x0b, 0x9e, 0x0b, 0xc2, 0x17, 0x9c, 0x0c, 0x3c, 0x0b, 
+0xd3, 0x0c, 0x79, 0x0b, 0xcf, 0x00, 0xd8, 0x0a, 0x79, 0x08, 0x48, 0x06, 
+0x00, 0x14, 0x22, 0x01, 0x06, 0x0b, 0x43, 0x14, 0xd2, 0x00, 0x1c, 0x01, 
+0x8a, 0x0b, 0xf1, 0x0a, 0x34, 0x0a, 0x9b, 0x00, 0xcb, 0x09, 0xbc, 0x08, 
+0x7d, 0x0c, 0xea, 0x1a, 0x83, 0x0b, 0x13, 0x0b, 0x3f, 0x0f, 0xac, 0x09, 
+0xec, 0x06, 0x60, 0x00, 0x33, 0x0b, 0x06, 0x01, 0x85, 0x16, 0xd9, 0x00, 
+0x24, 0x0c, 0xfe, 0x0c, 0x77, 0x0d, 0x62, 0x0e, 0xc8, 0x00, 0x27, 0x00, 
+0x6e, 0x00, 0xb4, 0x00, 0xe5, 0x0b, 0x26, 0x08, 0x34, 0x00, 0x9d, 0x17, 
+0x3b, 0x01, 0x5b, 0x00, 0xe6, 0x00, 0xc4, 0x00, 0x27, 0x12, 0x03, 0x01, 
+0xe1, 0x09, 0xb0, 0x0a, 0x40, 0x06, 0x5c, 0x0b, 0x2b, 0x13, 0x3a, 0x12, 
+0xa7, 0x09, 0x97, 0x00, 0xb6, 0x0b, 0x2d, 0x08, 0x07, 0x17, 0x35, 0x0a, 
+0x85, 0x0c, 0x72, 0x0b, 0xe9, 0x00, 0x25, 0x16, 0x61, 0x17, 0xb9, 0x00, 
+0xb1, 0x15, 0x77, 0x19, 0xe1, 0x08, 0xf6, 0x0b, 0xbc, 0x0c, 0xcd, 0x00, 
+0x5e, 0x07, 0xfc, 0x09, 0xa6, 0x10, 0x29, 0x01, 0xf2, 0x00, 0x3a, 0x13, 
+0x4a, 0x11, 0xd9, 0x0b, 0xbf, 0x09, 0x47, 0x0c, 0xaf, 0x1c, 0x35, 0x01, 
+0xe3, 0x08, 0x4a, 0x0a, 0x89, 0x0b, 0x87, 0x0d, 0xf8, 0x10, 0xc7, 0x09, 
+0x18, 0x01, 0xe7, 0x00, 0x42, 0x01, 0x25, 0x00, 0x46, 0x09, 0xfd, 0x00, 
+0x00, 0x01, 0x4a, 0x0a, 0xb2, 0x0c, 0x3d, 0x07, 0xb9, 0x0e, 0x01, 0x01, 
+0xba, 0x0b, 0x12, 0x0e, 0x0c, 0x0b, 0x42, 0x01, 0x17, 0x0c, 0x8b, 0x0a, 
+0x1f, 0x15, 0xff, 0x18, 0x84, 0x0b, 0xe3, 0x00, 0x52, 0x07, 0x55, 0x07, 
+0xa8, 0x0c, 0x4f, 0x0b, 0xd7, 0x0b, 0xa6, 0x0c, 0x2c, 0x0a, 0xd3, 0x00, 
+0x3d, 0x0c, 0x2c, 0x10, 0xb1, 0x11, 0x89, 0x08, 0xef, 0x00, 0xec, 0x0b, 
+0x5c, 0x0c, 0x3b, 0x0c, 0x4b, 0x0a, 0xa7, 0x00, 0x6b, 0x0b, 0xc4, 0x00, 
+0xf8, 0x0b, 0xba, 0x17, 0x21, 0x09, 0x55, 0x00, 0xa1, 0x06, 0xf3, 0x09, 
+0xb8, 0x00, 0xcb, 0x08, 0xc1, 0x0e, 0x31, 0x00, 0x6b, 0x07, 0x25, 0x07, 
+0xdc, 0x0a, 0x04, 0x01, 0xa5, 0x0f, 0x96, 0x0a, 0x18, 0x08, 0xae, 0x11, 
+0x1e, 0x0b, 0xce, 0x06, 0x25, 0x0c, 0x1c, 0x01, 0x3b, 0x0c, 0xec, 0x07, 
+0x8a, 0x0c, 0x37, 0x00, 0x92, 0x11, 0xb7, 0x00, 0x29, 0x12, 0x53, 0x00, 
+0xb2, 0x17, 0x63, 0x00, 0x01, 0x01, 0x63, 0x0e, 0xd4, 0x00, 0xe4, 0x00, 
+0xf3, 0x0c, 0x6c, 0x0a, 0x78, 0x0b, 0x4b, 0x0a, 0xdb, 0x06, 0xb5, 0x0c, 
+0x7b, 0x0c, 0x1a, 0x0c, 0x51, 0x0c, 0xc3, 0x00, 0x2e, 0x01, 0x84, 0x0a, 
+0x83, 0x00, 0x9b, 0x00, 0x34, 0x00, 0x68, 0x08, 0xb5, 0x00, 0xb2, 0x00, 
+0x45, 0x13, 0xb0, 0x0a, 0x18, 0x14, 0xca, 0x0e, 0x0e, 0x19, 0x34, 0x0a, 
+0x79, 0x00, 0x28, 0x01, 0x1e, 0x16, 0x27, 0x12, 0x3d, 0x01, 0x21, 0x01, 
+0xc9, 0x0c, 0x07, 0x01, 0x27, 0x0b, 0x05, 0x0b, 0x06, 0x01, 0x2b, 0x17, 
+0xcb, 0x0f, 0x7e, 0x0b, 0xf6, 0x06, 0x9e, 0x0c, 0x2b, 0x13, 0x85, 0x0a, 
+0x77, 0x11, 0x90, 0x1a, 0x05, 0x0c, 0xb9, 0x00, 0xd3, 0x00, 0xd5, 0x00, 
+0x48, 0x0b, 0x14, 0x0e, 0x4d, 0x19, 0x31, 0x01, 0x39, 0x0b, 0xec, 0x00, 
+0xfc, 0x00, 0x6a, 0x17, 0x47, 0x00, 0xd1, 0x00, 0x32, 0x00, 0xf9, 0x00, 
+0x58, 0x17, 0x14, 0x0b, 0x4d, 0x00, 0xe5, 0x0d, 0x2c, 0x0a, 0x5b, 0x08, 
+0x3e, 0x0d, 0x87, 0x0c, 0xa6, 0x0f, 0xdc, 0x00, 0x19, 0x19, 0x93, 0x0b, 
+0x2a, 0x0a, 0x54, 0x0a, 0xe9, 0x0c, 0xf0, 0x0b, 0x0e, 0x00, 0x1d, 0x0a, 
+0x6f, 0x0a, 0x2c, 0x01, 0x2c, 0x01, 0xea, 0x08, 0x92, 0x0c, 0x12, 0x0e, 
+0x24, 0x00, 0x0b, 0x01, 0x19, 0x01, 0x6c, 0x0a, 0x78, 0x0a, 0x2d, 0x01, 
+0x62, 0x00, 0x82, 0x0a, 0x5e, 0x11, 0x1c, 0x01, 0xc4, 0x14, 0xac, 0x00, 
+0xc0, 0x00, 0xee, 0x0e, 0xd6, 0x12, 0x33, 0x18, 0x00, 0x01, 0x13, 0x0b, 
+0xe5, 0x07, 0xd6, 0x00, 0x06, 0x01, 0xb7, 0x0a, 0x0c, 0x13, 0xaf, 0x09, 
+0x5f, 0x0b, 0x5b, 0x08, 0x75, 0x0a, 0x02, 0x0a, 0x2b, 0x01, 0xbd, 0x0c, 
+0xd5, 0x14, 0x4f, 0x16, 0x63, 0x00, 0xc2, 0x00, 0xd3, 0x10, 0xf4, 0x0c, 
+0xf8, 0x00, 0xbc, 0x0c, 0x78, 0x09, 0x18, 0x00, 0x33, 0x0a, 0x44, 0x0c, 
+0xda, 0x0a, 0xdc, 0x00, 0x25, 0x0b, 0x76, 0x17, 0xa7, 0x08, 0x1a, 0x08, 
+0x16, 0x01, 0xc7, 0x09, 0x0b, 0x09, 0xd9, 0x06, 0x26, 0x0c, 0x22, 0x01, 
+0xaa, 0x00, 0x7a, 0x00, 0x62, 0x09, 0x65, 0x08, 0x37, 0x0b, 0xd7, 0x00, 
+0x02, 0x0c, 0xa4, 0x00, 0xc6, 0x14, 0x7d, 0x0c, 0x3c, 0x13, 0x6d, 0x00, 
+0xab, 0x00, 0x2c, 0x18, 0xc9, 0x0e, 0x49, 0x06, 0x47, 0x06, 0xd6, 0x17, 
+0xcf, 0x15, 0x1c, 0x10, 0xdd, 0x00, 0x9d, 0x00, 0xe0, 0x0d, 0xc4, 0x08, 
+0x04, 0x01, 0x40, 0x0d, 0x0d, 0x00, 0x05, 0x0f, 0x1b, 0x00, 0xda, 0x07, 
+0x8b, 0x0b, 0xd9, 0x06, 0xe0, 0x00, 0x54, 0x00, 0xd0, 0x09, 0xf1, 0x00, 
+0xc1, 0x00, 0x92, 0x17, 0x65, 0x0c, 0xd9, 0x0b, 0xe0, 0x0b, 0x27, 0x10, 
+0x28, 0x01, 0x67, 0x14, 0xf4, 0x09, 0xa8, 0x10, 0x64, 0x0c, 0x53, 0x0e, 
+0x02, 0x01, 0xf8, 0x0b, 0x78, 0x14, 0x41, 0x0b, 0x71, 0x07, 0xbf, 0x0b, 
+0x87, 0x0c, 0x4a, 0x14, 0xb9, 0x00, 0xdc, 0x00, 0xb3, 0x00, 0x73, 0x16, 
+0xce, 0x13, 0xb2, 0x16, 0x0a, 0x0b, 0xd7, 0x09, 0x24, 0x00, 0x04, 0x0b, 
+0x42, 0x06, 0x1a, 0x10, 0xf9, 0x00, 0x3a, 0x18, 0x92, 0x00, 0xe5, 0x06, 
+0x72, 0x16, 0x25, 0x00, 0x2c, 0x01, 0x21, 0x09, 0xbd, 0x10, 0xe0, 0x17, 
+0xcd, 0x0c, 0x42, 0x01, 0x31, 0x01, 0x67, 0x0a, 0xbe, 0x00, 0x6c, 0x18, 
+0xf2, 0x00, 0x38, 0x01, 0xa2, 0x0b, 0x28, 0x0b, 0x58, 0x0a, 0x3f, 0x00, 
+0xf5, 0x08, 0xd1, 0x09, 0x0c, 0x01, 0x32, 0x01, 0x06, 0x01, 0x30, 0x01, 
+0xf1, 0x0b, 0xf8, 0x0e, 0x4f, 0x0c, 0x01, 0x0a, 0xb1, 0x11, 0xa4, 0x09, 
+0xe3, 0x0a, 0x7d, 0x18, 0xde, 0x0c, 0x2b, 0x01, 0xac, 0x0a, 0x33, 0x0b, 
+0x17, 0x00, 0x3e, 0x0e, 0xa9, 0x10, 0x89, 0x08, 0xf5, 0x08, 0xe1, 0x13, 
+0x2c, 0x1a, 0xc1, 0x00, 0xe4, 0x0d, 0x3b, 0x00, 0xb1, 0x00, 0x60, 0x0a, 
+0xa0, 0x15, 0xad, 0x0d, 0x17, 0x00, 0x6b, 0x0a, 0x10, 0x07, 0x48, 0x06, 
+0xe4, 0x0c, 0x20, 0x0b, 0x4e, 0x11, 0xe9, 0x08, 0x05, 0x0c, 0xbc, 0x09, 
+0xec, 0x07, 0x02, 0x01, 0x59, 0x0c, 0x3b, 0x0b, 0xdd, 0x00, 0x0c, 0x01, 
+0xdf, 0x18, 0xad, 0x06, 0x19, 0x08, 0x6d, 0x0a, 0x53, 0x0d, 0x17, 0x1a, 
+0xc8, 0x0a, 0x0a, 0x0b, 0x96, 0x15, 0xbd, 0x14, 0xd1, 0x00, 0x41, 0x06, 
+0x04, 0x01, 0xb7, 0x09, 0x1f, 0x07, 0x73, 0x07, 0x55, 0x00, 0x5f, 0x06, 
+0x33, 0x0a, 0x2e, 0x01, 0x21, 0x01, 0xc2, 0x17, 0xcc, 0x00, 0x5c, 0x0b, 
+0x50, 0x17, 0xb5, 0x12, 0xfd, 0x08, 0x7e, 0x0b, 0xf4, 0x00, 0xf5, 0x00, 
+0x3a, 0x13, 0x8c, 0x1c, 0xdd, 0x09, 0xad, 0x0f, 0x2f, 0x0c, 0xae, 0x11, 
+0x2d, 0x08, 0xe3, 0x00, 0xab, 0x16, 0xfd, 0x06, 0xad, 0x0a, 0x16, 0x01, 
+0xd0, 0x15, 0x32, 0x1c, 0xee, 0x00, 0xe4, 0x00, 0x25, 0x0e, 0x3a, 0x13, 
+0x1f, 0x1b, 0x35, 0x18, 0xd7, 0x16, 0xc2, 0x0a, 0x1c, 0x0f, 0xd8, 0x15, 
+0xdb, 0x09, 0xc8, 0x09, 0x11, 0x0c, 0x34, 0x0b, 0x65, 0x00, 0x32, 0x01, 
+0xb9, 0x00, 0xbf, 0x0f, 0x2e, 0x09, 0x48, 0x0b, 0x75, 0x13, 0x3b, 0x00, 
+0xd9, 0x0a, 0x77, 0x00, 0x26, 0x15, 0x5d, 0x08, 0xa8, 0x0f, 0x70, 0x0c, 
+0xfe, 0x00, 0xee, 0x07, 0xe8, 0x09, 0xb2, 0x00, 0xf8, 0x1a, 0xa3, 0x08, 
+0x29, 0x01, 0x33, 0x0b, 0xd4, 0x00, 0xdc, 0x09, 0x1c, 0x17, 0xeb, 0x00, 
+0xb5, 0x0b, 0xa2, 0x0a, 0xa5, 0x0b, 0x43, 0x14, 0xd4, 0x09, 0x6b, 0x00, 
+0xe0, 0x09, 0x7c, 0x00, 0x92, 0x00, 0x24, 0x0c, 0xf2, 0x14, 0x6a, 0x0a, 
+0x96, 0x00, 0xc7, 0x0c, 0x3b, 0x00, 0xe0, 0x0b, 0x27, 0x08, 0xb9, 0x0a, 
+0xd1, 0x00, 0x17, 0x0a, 0x94, 0x0b, 0xb1, 0x09, 0x3a, 0x0a, 0xbd, 0x11, 
+0x22, 0x0a, 0xce, 0x06, 0x11, 0x01, 0x58, 0x06, 0xa9, 0x0b, 0xbe, 0x09, 
+0x00, 0x01, 0xba, 0x00, 0xc7, 0x0c, 0x20, 0x08, 0x67, 0x0b, 0x64, 0x15, 
+0x9a, 0x06, 0x4f, 0x00, 0xf4, 0x08, 0xc1, 0x00, 0x79, 0x09, 0x3b, 0x00, 
+0xb8, 0x0a, 0x7a, 0x0c, 0x42, 0x15, 0xb5, 0x00, 0xf7, 0x0b, 0x78, 0x00, 
+0x1d, 0x00, 0x90, 0x0c, 0xe7, 0x00, 0xfd, 0x00, 0xde, 0x00, 0x04, 0x0b, 
+0x5f, 0x00, 0xeb, 0x00, 0x0d, 0x0c, 0x70, 0x08, 0xb5, 0x0c, 0x97, 0x06, 
+0xed, 0x00, 0xcf, 0x06, 0x5d, 0x0b, 0x33, 0x01, 0xff, 0x00, 0xca, 0x00, 
+0xa0, 0x15, 0x8e, 0x06, 0xb5, 0x00, 0xc9, 0x0f, 0x74, 0x0c, 0x35, 0x0a, 
+0x33, 0x12, 0xca, 0x0f, 0x66, 0x08, 0x26, 0x0c, 0xc3, 0x00, 0xb9, 0x00, 
+0x06, 0x01, 0xdd, 0x18, 0x19, 0x08, 0xf3, 0x14, 0xac, 0x00, 0x11, 0x0d, 
+0xd9, 0x0a, 0xc7, 0x0a, 0xd2, 0x09, 0x13, 0x0b, 0x70, 0x00, 0x27, 0x12, 
+0xb2, 0x07, 0x15, 0x14, 0x3a, 0x0c, 0x37, 0x01, 0x21, 0x0a, 0xef, 0x11, 
+0x3c, 0x1c, 0x82, 0x0b, 0xa6, 0x0a, 0x0f, 0x0b, 0x86, 0x11, 0xe1, 0x0b, 
+0x9e, 0x00, 0x2c, 0x01, 0xb8, 0x0e, 0xb1, 0x0c, 0xee, 0x00, 0x9f, 0x0c, 
+0xcc, 0x0e, 0xb1, 0x15, 0x51, 0x0c, 0x90, 0x0c, 0xe6, 0x0b, 0x9d, 0x00, 
+0x33, 0x00, 0x21, 0x01, 0x3d, 0x01, 0x2f, 0x0a, 0xb1, 0x09, 0xba, 0x09, 
+0x2b, 0x18, 0xde, 0x0c, 0xc5, 0x0a, 0xc6, 0x09, 0x53, 0x00, 0x85, 0x0a, 
+0xa1, 0x0a, 0x1d, 0x0e, 0xc4, 0x0a, 0xc5, 0x00, 0xcc, 0x0b, 0x18, 0x0c, 
+0xc5, 0x09, 0xdf, 0x08, 0x43, 0x0c, 0x3e, 0x0a, 0x24, 0x0f, 0x02, 0x15, 
+0x3f, 0x0b, 0xc5, 0x00, 0x27, 0x00, 0xfb, 0x15, 0x62, 0x09, 0xd8, 0x17, 
+0x1c, 0x0b, 0x9c, 0x0c, 0x23, 0x09, 0x0c, 0x00, 0x8e, 0x0a, 0xd2, 0x00, 
+0xa9, 0x0c, 0x7d, 0x0a, 0x6b, 0x00, 0x26, 0x0b, 0x2b, 0x1a, 0x1d, 0x13, 
+0x71, 0x09, 0xa5, 0x10, 0xf5, 0x0d, 0x36, 0x01, 0xdf, 0x09, 0x09, 0x01, 
+0xc2, 0x1b, 0x03, 0x08, 0xa0, 0x15, 0x12, 0x00, 0x07, 0x13, 0x24, 0x08, 
+0x38, 0x0c, 0x67, 0x00, 0xde, 0x15, 0x45, 0x06, 0xf2, 0x00, 0xcf, 0x00, 
+0xac, 0x0a, 0xe1, 0x07, 0xf5, 0x00, 0x28, 0x0b, 0xab, 0x0c, 0x29, 0x1b, 
+0xb5, 0x00, 0x9e, 0x0c, 0xe8, 0x00, 0xba, 0x17, 0x39, 0x13, 0x2f, 0x01, 
+0xa9, 0x15, 0x05, 0x0b, 0x79, 0x00, 0xca, 0x00, 0x2d, 0x0a, 0xa6, 0x0a, 
+0x55, 0x0a, 0x7c, 0x0c, 0x36, 0x09, 0x22, 0x0a, 0x1a, 0x01, 0x20, 0x09, 
+0xa1, 0x0b, 0x7a, 0x00, 0x97, 0x0b, 0x03, 0x01, 0xe0, 0x09, 0x09, 0x0a, 
+0xf7, 0x00, 0xea, 0x08, 0xbb, 0x00, 0xf2, 0x0a, 0x10, 0x07, 0xfe, 0x09, 
+0x82, 0x0b, 0x71, 0x00, 0xae, 0x00, 0xe2, 0x0b, 0x77, 0x00, 0x04, 0x16, 
+0xc8, 0x00, 0x73, 0x0b, 0x48, 0x00, 0xfa, 0x0c, 0x45, 0x0a, 0x78, 0x00, 
+0x84, 0x0b, 0x28, 0x01, 0x8d, 0x06, 0xf2, 0x14, 0x3c, 0x0b, 0xc2, 0x07, 
+0x18, 0x00, 0x24, 0x0c, 0x36, 0x0d, 0x0c, 0x00, 0x20, 0x16, 0x3e, 0x08, 
+0x56, 0x11, 0x40, 0x01, 0x5f, 0x12, 0x20, 0x01, 0x3f, 0x0d, 0x21, 0x09, 
+0xec, 0x08, 0x91, 0x13, 0xa8, 0x00, 0x84, 0x00, 0xb2, 0x00, 0x24, 0x00, 
+0xdd, 0x00, 0x40, 0x08, 0x1b, 0x07, 0x9a, 0x06, 0x4d, 0x00, 0xcc, 0x0b, 
+0x8d, 0x06, 0xc1, 0x07, 0xfb, 0x06, 0x36, 0x0a, 0xf0, 0x0a, 0x52, 0x0b, 
+0xbd, 0x09, 0x5a, 0x0c, 0x71, 0x10, 0xa0, 0x15, 0x25, 0x07, 0x9b, 0x00, 
+0x36, 0x0d, 0x25, 0x01, 0x68, 0x06, 0x9f, 0x0c, 0x70, 0x00, 0xe3, 0x13, 
+0xb2, 0x00, 0x3c, 0x00, 0x5f, 0x12, 0x14, 0x01, 0x56, 0x0a, 0x2b, 0x18, 
+0xca, 0x09, 0x13, 0x0d, 0x9c, 0x08, 0x3e, 0x0e, 0xde, 0x0c, 0xdf, 0x13, 
+0xc2, 0x0e, 0x13, 0x01, 0xe6, 0x09, 0x4a, 0x0a, 0xf7, 0x0b, 0xab, 0x15, 
+0xce, 0x06, 0x2a, 0x0c, 0x96, 0x07, 0x57, 0x0a, 0xc5, 0x00, 0xef, 0x0a, 
+0x0c, 0x01, 0xaf, 0x0b, 0xd2, 0x06, 0xfd, 0x00, 0x33, 0x0b, 0xba, 0x09, 
+0x10, 0x01, 0x1e, 0x1a, 0xa9, 0x0b, 0xb7, 0x00, 0x98, 0x13, 0x1a, 0x00, 
+0xfa, 0x14, 0xef, 0x07, 0xbb, 0x08, 0xb2, 0x08, 0xe8, 0x12, 0x8b, 0x0a, 
+0x27, 0x01, 0xa9, 0x0b, 0xdf, 0x16, 0xb4, 0x00, 0xe8, 0x0c, 0x00, 0x01, 
+0x96, 0x10, 0x96, 0x0b, 0x98, 0x07, 0xa7, 0x0c, 0xa4, 0x09, 0xe9, 0x0c, 
+0x90, 0x0b, 0xb6, 0x0b, 0xb0, 0x09, 0x72, 0x00, 0xf4, 0x0c, 0xff, 0x0a, 
+0x3f, 0x0e, 0x78, 0x0c, 0x95, 0x12, 0xa5, 0x10, 0x48, 0x18, 0xe7, 0x00, 
+0xa8, 0x00, 0x75, 0x13, 0xa9, 0x00, 0x3f, 0x0b, 0xb0, 0x00, 0x8a, 0x11, 
+0x6e, 0x00, 0xc7, 0x09, 0xa4, 0x09, 0x2f, 0x0a, 0xb7, 0x0a, 0xef, 0x0b, 
+0x5e, 0x07, 0xe8, 0x1a, 0xae, 0x11, 0x51, 0x13, 0xb3, 0x17, 0x0e, 0x00, 
+0xa9, 0x00, 0x5f, 0x0a, 0x8f, 0x06, 0xbe, 0x11, 0x12, 0x01, 0x0b, 0x00, 
+0xee, 0x00, 0x29, 0x01, 0x9f, 0x08, 0x2f, 0x01, 0x63, 0x09, 0xc2, 0x00, 
+0x02, 0x01, 0x20, 0x09, 0x1a, 0x0f, 0xc2, 0x00, 0x47, 0x00, 0xd7, 0x15, 
+0x5c, 0x00, 0xf4, 0x09, 0xde, 0x0d, 0xdc, 0x0f, 0xcc, 0x00, 0xb7, 0x0a, 
+0x0e, 0x00, 0xc8, 0x00, 0x6d, 0x09, 0x2b, 0x01, 0x8e, 0x17, 0x1b, 0x08, 
+0x02, 0x0c, 0xfe, 0x00, 0x7c, 0x00, 0x9e, 0x0b, 0xf7, 0x07, 0x9c, 0x0c, 
+0x77, 0x11, 0x30, 0x12, 0x96, 0x17, 0xa0, 0x07, 0xda, 0x0a, 0xc2, 0x00, 
+0x5f, 0x08, 0xcb, 0x00, 0x3d, 0x00, 0xb5, 0x00, 0xda, 0x15, 0x65, 0x00, 
+0x6f, 0x0c, 0xab, 0x16, 0xe9, 0x00, 0x16, 0x14, 0x1a, 0x00, 0x94, 0x0b, 
+0x22, 0x0c, 0x43, 0x06, 0x1f, 0x0a, 0xec, 0x00, 0xa9, 0x16, 0xce, 0x00, 
+0xac, 0x0b, 0xe1, 0x13, 0xdb, 0x00, 0x04, 0x0b, 0xc6, 0x00, 0x68, 0x0c, 
+0xda, 0x0d, 0x36, 0x09, 0x8b, 0x0b, 0x1d, 0x01, 0x49, 0x00, 0xf4, 0x14, 
+0xde, 0x0a, 0xdb, 0x00, 0xb6, 0x00, 0x79, 0x0a, 0xab, 0x0b, 0xe5, 0x00, 
+0x52, 0x0c, 0x53, 0x0c, 0x19, 0x00, 0xad, 0x0f, 0x12, 0x01, 0xca, 0x0e, 
+0xd9, 0x0a, 0x77, 0x09, 0x3c, 0x01, 0x08, 0x0d, 0x34, 0x00, 0xe1, 0x0c, 
+0xcc, 0x00, 0x73, 0x0c, 0xc8, 0x00, 0x3e, 0x0a, 0x6e, 0x12, 0x85, 0x0c, 
+0xbe, 0x10, 0xf2, 0x17, 0xaf, 0x00, 0xa6, 0x0c, 0x97, 0x1a, 0x23, 0x01, 
+0x70, 0x0c, 0xfa, 0x00, 0xf5, 0x0c, 0x73, 0x14, 0xb3, 0x06, 0xb0, 0x0f, 
+0x52, 0x0b, 0x0c, 0x01, 0x39, 0x01, 0xdb, 0x0a, 0x6f, 0x08, 0x0d, 0x0c, 
+0x03, 0x14, 0x89, 0x0b, 0x95, 0x1a, 0xa1, 0x0b, 0x67, 0x11, 0xe9, 0x06, 
+0xde, 0x09, 0x87, 0x0c, 0x17, 0x00, 0x35, 0x09, 0x46, 0x0d, 0xce, 0x0a, 
+0xf3, 0x18, 0xe8, 0x00, 0xc3, 0x07, 0xf4, 0x07, 0xfc, 0x09, 0x35, 0x00, 
+0x53, 0x00, 0xd0, 0x18, 0x22, 0x0f, 0x9e, 0x08, 0x48, 0x19, 0x57, 0x06, 
+0xca, 0x09, 0x7c, 0x12, 0xa6, 0x0b, 0x1d, 0x0a, 0x58, 0x09, 0x61, 0x12, 
+0x4d, 0x0a, 0x55, 0x0a, 0x9d, 0x0c, 0x3e, 0x0a, 0x1d, 0x0e, 0xc9, 0x00, 
+0xea, 0x08, 0x15, 0x0a, 0x35, 0x01, 0x8b, 0x11, 0x0d, 0x00, 0x59, 0x0c, 
+0x99, 0x12, 0x15, 0x01, 0x35, 0x0d, 0xdd, 0x00, 0x56, 0x0a, 0xc7, 0x00, 
+0xef, 0x0a, 0xe3, 0x00, 0xeb, 0x00, 0x27, 0x12, 0xa8, 0x0c, 0x07, 0x0b, 
+0xd4, 0x0c, 0x43, 0x06, 0x5c, 0x0b, 0x9c, 0x0c, 0xe9, 0x00, 0x3c, 0x0b, 
+0x2b, 0x01, 0xc3, 0x08, 0x11, 0x01, 0xa8, 0x08, 0x84, 0x11, 0xe2, 0x00, 
+0xc7, 0x14, 0x72, 0x0b, 0xd9, 0x00, 0x56, 0x1a, 0xbe, 0x14, 0x2d, 0x0c, 
+0xb8, 0x0a, 0x7d, 0x00, 0x47, 0x0b, 0xd2, 0x09, 0xc9, 0x08, 0x38, 0x0c, 
+0xef, 0x0e, 0x5f, 0x00, 0x39, 0x01, 0x9b, 0x00, 0x95, 0x00, 0x2e, 0x0c, 
+0xee, 0x00, 0x5e, 0x0c, 0x13, 0x01, 0x43, 0x0b, 0x16, 0x13, 0x14, 0x01, 
+0x17, 0x17, 0x97, 0x07, 0x8e, 0x0b, 0x00, 0x0a, 0xba, 0x00, 0xd4, 0x09, 
+0xe1, 0x0f, 0xe7, 0x00, 0xab, 0x0b, 0x04, 0x01, 0x8b, 0x13, 0x0c, 0x00, 
+0x69, 0x06, 0x35, 0x09, 0x7c, 0x09, 0xb7, 0x0e, 0x41, 0x0a, 0x7e, 0x0b, 
+0x1c, 0x07, 0xf1, 0x0e, 0xbc, 0x0a, 0xeb, 0x00, 0x2e, 0x07, 0xe6, 0x0b, 
+0x8b, 0x00, 0x49, 0x0a, 0xa6, 0x0a, 0xfe, 0x0e, 0xd8, 0x18, 0xf9, 0x13, 
+0x08, 0x0b, 0xa2, 0x0d, 0x04, 0x01, 0xca, 0x0c, 0xb7, 0x00, 0x29, 0x0e, 
+0x6b, 0x00, 0x7d, 0x0c, 0x05, 0x0f, 0x92, 0x0c, 0xbd, 0x08, 0xf0, 0x00, 
+0x22, 0x0d, 0x0b, 0x01, 0x6c, 0x00, 0x46, 0x0b, 0xde, 0x15, 0x9e, 0x0b, 
+0x18, 0x0c, 0xf8, 0x0f, 0x75, 0x0a, 0x51, 0x0b, 0xa7, 0x00, 0x1e, 0x0b, 
+0x7a, 0x08, 0xaa, 0x0c, 0xb6, 0x00, 0xce, 0x00, 0xba, 0x08, 0x6b, 0x00, 
+0xf2, 0x09, 0x18, 0x08, 0x1f, 0x0a, 0x6f, 0x0c, 0xa2, 0x00, 0xdc, 0x09, 
+0x95, 0x09, 0x7a, 0x0c, 0xde, 0x0c, 0x7e, 0x0c, 0xdf, 0x00, 0xa8, 0x00, 
+0x42, 0x0b, 0x5a, 0x0a, 0x3e, 0x01, 0xa4, 0x0a, 0x2f, 0x08, 0xac, 0x00, 
+0x35, 0x01, 0xf3, 0x0a, 0xe5, 0x16, 0xd7, 0x07, 0x8d, 0x0a, 0x85, 0x10, 
+0xe1, 0x08, 0x8a, 0x11, 0x28, 0x07, 0x35, 0x01, 0x0a, 0x16, 0xb3, 0x0c, 
+0x6b, 0x0b, 0x9c, 0x0a, 0x3f, 0x0e, 0xa4, 0x0d, 0xbd, 0x09, 0x70, 0x10, 
+0x14, 0x0b, 0x0b, 0x01, 0x42, 0x0a, 0xec, 0x13, 0x13, 0x0b, 0x48, 0x06, 
+0xa9, 0x09, 0xe0, 0x0c, 0xfd, 0x0a, 0xe6, 0x0a, 0x64, 0x19, 0x2b, 0x0b, 
+0x25, 0x1a, 0x2e, 0x09, 0xd3, 0x06, 0x28, 0x0c, 0x0f, 0x0b, 0xe9, 0x0a, 
+0xc0, 0x09, 0xa6, 0x10, 0xc5, 0x10, 0x00, 0x01, 0xa9, 0x16, 0x5c, 0x08, 
+0x10, 0x0b, 0x10, 0x01, 0xad, 0x09, 0xd5, 0x00, 0x4d, 0x00, 0xf9, 0x00, 
+0x14, 0x01, 0xd1, 0x09, 0x18, 0x00, 0x08, 0x0d, 0x1b, 0x00, 0xb4, 0x00, 
+0x7c, 0x0c, 0x7d, 0x18, 0x6c, 0x0a, 0xc5, 0x00, 0x6a, 0x13, 0x0d, 0x01, 
+0xb6, 0x0b, 0x1e, 0x14, 0xd2, 0x0c, 0xa6, 0x10, 0x5d, 0x08, 0x35, 0x09, 
+0xf8, 0x0b, 0xb7, 0x0a, 0x13, 0x0a, 0x7a, 0x08, 0x7c, 0x0c, 0xce, 0x0b, 
+0x4f, 0x06, 0x6e, 0x0a, 0x37, 0x01, 0x4a, 0x00, 0xfa, 0x0b, 0xe8, 0x0c, 
+0xdb, 0x00, 0x91, 0x0c, 0x2c, 0x01, 0x60, 0x06, 0x67, 0x13, 0x27, 0x01, 
+0x1d, 0x0c, 0xe6, 0x0a, 0x64, 0x0a, 0xc9, 0x0e, 0xba, 0x17, 0x1a, 0x00, 
+0x1a, 0x0d, 0x8b, 0x0a, 0x04, 0x01, 0xfa, 0x0a, 0x17, 0x0b, 0x96, 0x0a, 
+0xf8, 0x17, 0x25, 0x16, 0xa6, 0x09, 0x65, 0x18, 0x51, 0x0a, 0x42, 0x06, 
+0xd6, 0x07, 0x93, 0x00, 0x20, 0x01, 0xd1, 0x0e, 0x0b, 0x01, 0x6f, 0x13, 
+0x4f, 0x17, 0xe9, 0x00, 0xd0, 0x09, 0x63, 0x19, 0x53, 0x0c, 0x80, 0x09, 
+0xf3, 0x00, 0x78, 0x10, 0xbf, 0x09, 0x15, 0x13, 0xc3, 0x0a, 0xc5, 0x00, 
+0x7e, 0x16, 0xa1, 0x0c, 0x6f, 0x00, 0xfe, 0x09, 0x78, 0x00, 0x40, 0x00, 
+0xd7, 0x15, 0x1a, 0x0d, 0xab, 0x15, 0xd1, 0x12, 0x8a, 0x12, 0x0f, 0x01, 
+0x36, 0x01, 0x00, 0x01, 0xe2, 0x0b, 0x9c, 0x0f, 0xda, 0x0f, 0x40, 0x06, 
+0x81, 0x0a, 0x97, 0x0b, 0x84, 0x00, 0x13, 0x0a, 0x71, 0x00, 0x0f, 0x13, 
+0x34, 0x18, 0x3c, 0x01, 0xe7, 0x00, 0xed, 0x06, 0xe5, 0x08, 0x00, 0x0d, 
+0xa8, 0x00, 0xe8, 0x1a, 0x98, 0x15, 0xf8, 0x0b, 0xbe, 0x08, 0x24, 0x01, 
+0x6c, 0x07, 0x90, 0x1b, 0x76, 0x0a, 0x26, 0x07, 0x13, 0x0b, 0xdf, 0x08, 
+0xbe, 0x09, 0x3e, 0x01, 0x47, 0x19, 0x26, 0x01, 0xf2, 0x13, 0xac, 0x00, 
+0xe7, 0x00, 0x3e, 0x0b, 0x5e, 0x08, 0x3f, 0x00, 0x34, 0x0f, 0x4e, 0x0c, 
+0xbf, 0x00, 0x91, 0x00, 0xa7, 0x00, 0xe3, 0x18, 0x65, 0x00, 0x39, 0x13, 
+0xd6, 0x07, 0x3b, 0x12, 0xc7, 0x14, 0x49, 0x06, 0xb7, 0x00, 0x6c, 0x0a, 
+0x56, 0x00, 0x46, 0x06, 0xbd, 0x0a, 0xe3, 0x06, 0x2b, 0x12, 0x5d, 0x07, 
+0x1c, 0x07, 0xd7, 0x00, 0x03, 0x01, 0x47, 0x0e, 0x40, 0x0a, 0x65, 0x0c, 
+0xaf, 0x00, 0xc2, 0x00, 0x04, 0x01, 0xc3, 0x00, 0xda, 0x0d, 0xf9, 0x0f, 
+0xba, 0x0a, 0x7e, 0x0c, 0x27, 0x01, 0xe9, 0x00, 0x07, 0x01, 0x71, 0x0f, 
+0xdf, 0x00, 0x1b, 0x07, 0xfa, 0x00, 0xf1, 0x14, 0x83, 0x00, 0x4d, 0x19, 
+0x48, 0x06, 0xdd, 0x0b, 0x03, 0x0d, 0x4d, 0x06, 0x4f, 0x17, 0x3d, 0x0b, 
+0xe6, 0x15, 0xb1, 0x00, 0x21, 0x01, 0xfa, 0x0b, 0xf9, 0x0b, 0x09, 0x0a, 
+0x54, 0x00, 0xfa, 0x0a, 0x28, 0x0a, 0xef, 0x0a, 0xfc, 0x09, 0x19, 0x00, 
+0x3e, 0x09, 0xb1, 0x00, 0x55, 0x07, 0xbe, 0x0c, 0xba, 0x10, 0x0b, 0x01, 
+0x66, 0x13, 0xde, 0x16, 0x8c, 0x06, 0x4a, 0x0a, 0xe2, 0x0f, 0x61, 0x0b, 
+0xd9, 0x06, 0x70, 0x10, 0xa6, 0x10, 0x40, 0x0a, 0x08, 0x01, 0xfa, 0x16, 
+0xc3, 0x16, 0x41, 0x01, 0xc0, 0x13, 0xa5, 0x09, 0xf0, 0x00, 0xa9, 0x16, 
+0x4e, 0x0c, 0xd3, 0x0c, 0xd8, 0x0a, 0xfe, 0x0a, 0x80, 0x16, 0xe0, 0x00, 
+0xd1, 0x0a, 0x2e, 0x08, 0xa1, 0x00, 0x27, 0x01, 0x79, 0x00, 0xe3, 0x00, 
+0x01, 0x0d, 0xb8, 0x0e, 0xca, 0x00, 0x28, 0x01, 0x13, 0x0a, 0x26, 0x01, 
+0x56, 0x18, 0x29, 0x01, 0x43, 0x0d, 0xb1, 0x08, 0x2b, 0x09, 0x3b, 0x00, 
+0x15, 0x01, 0x26, 0x07, 0xb4, 0x00, 0xe3, 0x00, 0xd7, 0x0b, 0xcb, 0x12, 
+0x45, 0x06, 0x8c, 0x0b, 0xf9, 0x11, 0x26, 0x0c, 0xe7, 0x00, 0x38, 0x01, 
+0x11, 0x01, 0xe6, 0x09, 0xb8, 0x0a, 0x3c, 0x0c, 0x2d, 0x18, 0x30, 0x01, 
+0xb0, 0x09, 0x2e, 0x08, 0x78, 0x15, 0xe0, 0x00, 0x1e, 0x0a, 0x0c, 0x01, 
+0xed, 0x09, 0x8b, 0x00, 0x3b, 0x0b, 0xa4, 0x0b, 0xc0, 0x0b, 0x77, 0x0b, 
+0xd8, 0x00, 0x9d, 0x0c, 0x94, 0x0d, 0xe2, 0x00, 0x36, 0x0a, 0x54, 0x07, 
+0xe4, 0x00, 0xed, 0x0d, 0x0e, 0x0c, 0x5f, 0x00, 0x51, 0x0b, 0xd0, 0x0b, 
+0x7a, 0x00, 0x11, 0x0c, 0xf7, 0x00, 0x67, 0x0b, 0x1f, 0x0b, 0xdc, 0x14, 
+0x24, 0x13, 0x12, 0x0a, 0x2b, 0x01, 0xbb, 0x09, 0x95, 0x0f, 0x73, 0x16, 
+0xad, 0x0a, 0xd8, 0x0e, 0xb1, 0x12, 0xd8, 0x18, 0xd2, 0x09, 0x35, 0x00, 
+0x54, 0x0a, 0x21, 0x01, 0x9d, 0x0c, 0xc9, 0x09, 0xdd, 0x0c, 0xb5, 0x00, 
+0x65, 0x18, 0x01, 0x0a, 0xed, 0x08, 0xfb, 0x00, 0xf6, 0x00, 0xa0, 0x0b, 
+0xba, 0x08, 0x66, 0x14, 0x4a, 0x11, 0x17, 0x0b, 0xf4, 0x00, 0x01, 0x0d, 
+0x43, 0x06, 0x13, 0x01, 0x0b, 0x00, 0xb2, 0x00, 0x03, 0x13, 0x44, 0x06, 
+0x8e, 0x11, 0x0a, 0x15, 0xf6, 0x09, 0x60, 0x06, 0xb2, 0x08, 0x18, 0x08, 
+0xb4, 0x0b, 0x28, 0x01, 0x42, 0x15, 0xe8, 0x0c, 0x1d, 0x13, 0x85, 0x17, 
+0x46, 0x06, 0xd2, 0x0f, 0x1a, 0x0d, 0xb4, 0x00, 0x04, 0x01, 0x9e, 0x0b, 
+0xb6, 0x0b, 0x33, 0x0a, 0x0c, 0x01, 0x75, 0x0b, 0xb3, 0x08, 0x4f, 0x0c, 
+0xe9, 0x09, 0x50, 0x17, 0xbc, 0x12, 0x9e, 0x00, 0xd3, 0x00, 0xbb, 0x00, 
+0x07, 0x0e, 0xfe, 0x09, 0x3e, 0x18, 0xb1, 0x08, 0x28, 0x0a, 0x45, 0x06, 
+0x3c, 0x0c, 0x85, 0x07, 0x50, 0x0a, 0xa0, 0x07, 0x0b, 0x01, 0xd5, 0x00, 
+0xa6, 0x09, 0x70, 0x0c, 0x20, 0x09, 0x43, 0x13, 0x12, 0x0a, 0x47, 0x19, 
+0x79, 0x10, 0x40, 0x06, 0x26, 0x12, 0xc7, 0x0c, 0xbd, 0x0c, 0xdc, 0x0a, 
+0x2e, 0x09, 0x5a, 0x0c, 0x5a, 0x0f, 0xa1, 0x08, 0x5d, 0x12, 0x91, 0x00, 
+0xef, 0x00, 0x11, 0x0b, 0x95, 0x07, 0x51, 0x10, 0x21, 0x0d, 0x5e, 0x0c, 
+0xb0, 0x00, 0x77, 0x0c, 0x2b, 0x0a, 0x3c, 0x0b, 0x1d, 0x00, 0xdd, 0x09, 
+0xaa, 0x0c, 0x1e, 0x0a, 0xc9, 0x1b, 0xba, 0x0b, 0x68, 0x0b, 0xb8, 0x00, 
+0xbd, 0x00, 0xe8, 0x0c, 0x14, 0x01, 0xac, 0x0b, 0xd9, 0x06, 0x38, 0x09, 
+0x3e, 0x0e, 0x38, 0x01, 0xe9, 0x00, 0x9e, 0x0c, 0xab, 0x00, 0xc3, 0x00, 
+0x19, 0x0c, 0x12, 0x00, 0x14, 0x01, 0xc4, 0x0a, 0xfb, 0x00, 0x26, 0x0b, 
+0x42, 0x01, 0x19, 0x01, 0xc3, 0x08, 0xff, 0x0c, 0x55, 0x07, 0x0d, 0x00, 
+0x67, 0x08, 0x13, 0x0a, 0xe2, 0x07, 0x45, 0x0a, 0xdb, 0x0b, 0x34, 0x18, 
+0x6f, 0x08, 0x81, 0x13, 0x22, 0x0c, 0x93, 0x00, 0xaf, 0x09, 0x65, 0x0c, 
+0x99, 0x0b, 0x8d, 0x0d, 0x54, 0x0b, 0x41, 0x10, 0x69, 0x19, 0x29, 0x01, 
+0x36, 0x0b, 0x13, 0x00, 0xe0, 0x09, 0x17, 0x00, 0xd0, 0x12, 0xd3, 0x0c, 
+0x03, 0x01, 0x10, 0x00, 0x26, 0x01, 0xd2, 0x09, 0x4c, 0x08, 0x51, 0x0b, 
+0xbb, 0x08, 0x5d, 0x12, 0xae, 0x0a, 0x97, 0x0a, 0xff, 0x00, 0xad, 0x16, 
+0xe0, 0x08, 0x65, 0x08, 0xc0, 0x09, 0x81, 0x0b, 0xe4, 0x0d, 0xd9, 0x07, 
+0x8c, 0x06, 0x08, 0x01, 0xd4, 0x0f, 0xe1, 0x00, 0x99, 0x15, 0x1e, 0x0c, 
+0x2d, 0x18, 0xb6, 0x00, 0x63, 0x00, 0x68, 0x1b, 0xaa, 0x16, 0x8b, 0x0b, 
+0xdd, 0x06, 0x0d, 0x01, 0x1b, 0x0e, 0x2d, 0x08, 0xbe, 0x00, 0xf9, 0x0a, 
+0x7a, 0x07, 0x5a, 0x0c, 0x05, 0x0b, 0x36, 0x01, 0xc6, 0x14, 0xa5, 0x09, 
+0xb9, 0x0e, 0x06, 0x0b, 0x37, 0x0b, 0x33, 0x01, 0x67, 0x0b, 0x3e, 0x0e, 
+0xd0, 0x00, 0x03, 0x01, 0xa2, 0x07, 0x9d, 0x00, 0xf7, 0x00, 0x19, 0x00, 
+0x62, 0x09, 0x7a, 0x09, 0x07, 0x01, 0x4e, 0x0c, 0x56, 0x00, 0x8d, 0x14, 
+0xa0, 0x15, 0x98, 0x0c, 0x33, 0x00, 0x66, 0x12, 0xb5, 0x0a, 0xc5, 0x00, 
+0x9f, 0x0c, 0xcf, 0x00, 0x73, 0x10, 0xd4, 0x0a, 0xef, 0x00, 0x8a, 0x12, 
+0xd1, 0x00, 0x10, 0x01, 0xcb, 0x0b, 0x7c, 0x0c, 0xe6, 0x09, 0x20, 0x09, 
+0xfc, 0x00, 0xf1, 0x00, 0x72, 0x0c, 0xf7, 0x0e, 0xe1, 0x07, 0xd3, 0x09, 
+0x19, 0x01, 0x67, 0x0c, 0x8f, 0x17, 0x1f, 0x1a, 0xe9, 0x00, 0x02, 0x01, 
+0x3d, 0x01, 0xbb, 0x00, 0x46, 0x06, 0x19, 0x17, 0x26, 0x00, 0x17, 0x1a, 
+0xaa, 0x0c, 0x2a, 0x18, 0xe9, 0x0c, 0x32, 0x0b, 0x78, 0x0b, 0xef, 0x00, 
+0x88, 0x0b, 0xc3, 0x00, 0x6b, 0x00, 0x2a, 0x01, 0x2c, 0x12, 0x48, 0x06, 
+0x66, 0x13, 0x77, 0x0b, 0xe7, 0x00, 0xbf, 0x09, 0xbb, 0x17, 0x1d, 0x0f, 
+0x36, 0x01, 0x6f, 0x00, 0x1f, 0x01, 0xdc, 0x14, 0x4b, 0x14, 0x3b, 0x01, 
+0x01, 0x0c, 0x06, 0x15, 0x15, 0x11, 0x8d, 0x06, 0x26, 0x14, 0xcd, 0x14, 
+0xde, 0x09, 0x7b, 0x0c, 0x13, 0x01, 0xdf, 0x00, 0x74, 0x16, 0xf9, 0x0b, 
+0x24, 0x09, 0x3e, 0x0d, 0x2b, 0x09, 0x7a, 0x07, 0x6f, 0x08, 0x02, 0x01, 
+0x70, 0x07, 0x37, 0x0d, 0x1c, 0x08, 0xe1, 0x00, 0x24, 0x00, 0xa1, 0x0a, 
+0xa1, 0x06, 0x81, 0x08, 0x45, 0x06, 0xaa, 0x15, 0x80, 0x0b, 0x79, 0x00, 
+0xd6, 0x07, 0x33, 0x0a, 0xdc, 0x0d, 0x5c, 0x0c, 0xa4, 0x00, 0x7a, 0x0c, 
+0xe1, 0x0b, 0x57, 0x18, 0xd4, 0x09, 0x2e, 0x01, 0x00, 0x0a, 0xfa, 0x18, 
+0x2d, 0x0c, 0x8f, 0x06, 0x22, 0x0f, 0x99, 0x06, 0x9f, 0x07, 0xbb, 0x00, 
+0x60, 0x0b, 0x43, 0x0c, 0x46, 0x0f, 0xf6, 0x0b, 0xae, 0x07, 0x6d, 0x00, 
+0x85, 0x00, 0x7f, 0x0e, 0x42, 0x06, 0x0b, 0x01, 0x07, 0x13, 0xcf, 0x06, 
+0xdd, 0x09, 0x9c, 0x0c, 0xbd, 0x0c, 0x19, 0x14, 0x34, 0x01, 0x39, 0x09, 
+0xef, 0x0a, 0x26, 0x01, 0x07, 0x0a, 0x1d, 0x07, 0xdc, 0x07, 0xe7, 0x09, 
+0x49, 0x0b, 0xc2, 0x00, 0x67, 0x0c, 0x6e, 0x13, 0x40, 0x06, 0x11, 0x0b, 
+0xda, 0x00, 0x55, 0x0a, 0xdf, 0x11, 0x33, 0x01, 0xfc, 0x00, 0xaa, 0x00, 
+0x2f, 0x08, 0x73, 0x00, 0x3f, 0x01, 0x54, 0x00, 0xd4, 0x09, 0x9d, 0x08, 
+0x1f, 0x15, 0x26, 0x01, 0xb5, 0x0b, 0x3f, 0x0a, 0x88, 0x0c, 0x19, 0x00, 
+0xb0, 0x00, 0xd3, 0x0c, 0x33, 0x0c, 0xcd, 0x14, 0xd9, 0x00, 0xac, 0x00, 
+0xd9, 0x00, 0x47, 0x00, 0x8a, 0x12, 0xd8, 0x07, 0x5d, 0x08, 0x2f, 0x01, 
+0xbc, 0x00, 0xa9, 0x00, 0xec, 0x0b, 0x43, 0x12, 0x32, 0x01, 0x5f, 0x06, 
+0x8d, 0x0d, 0x3c, 0x01, 0x81, 0x00, 0xdd, 0x07, 0xb7, 0x0e, 0x13, 0x0a, 
+0xe7, 0x00, 0xa5, 0x0a, 0xfb, 0x00, 0xda, 0x00, 0xe3, 0x06, 0x75, 0x12, 
+0x9c, 0x13, 0xda, 0x06, 0x07, 0x0a, 0xaa, 0x0b, 0x08, 0x13, 0x27, 0x00, 
+0xd2, 0x0c, 0x92, 0x00, 0x30, 0x01, 0xaa, 0x08, 0x7a, 0x09, 0xba, 0x0a, 
+0x98, 0x0a, 0x1e, 0x0a, 0x25, 0x0c, 0x5b, 0x00, 0x3b, 0x00, 0xdb, 0x09, 
+0x7b, 0x12, 0xe1, 0x00, 0xda, 0x06, 0x5a, 0x08, 0x80, 0x00, 0xb0, 0x09, 
+0x4b, 0x06, 0x3d, 0x00, 0xe4, 0x0d, 0x20, 0x01, 0x21, 0x0a, 0x93, 0x0b, 
+0x53, 0x00, 0xaf, 0x11, 0x1d, 0x0a, 0x1c, 0x01, 0x0e, 0x00, 0xe9, 0x00, 
+0x11, 0x01, 0xdb, 0x0f, 0x87, 0x0c, 0xe6, 0x16, 0x05, 0x01, 0xa2, 0x15, 
+0xcb, 0x00, 0xc5, 0x09, 0x47, 0x00, 0x59, 0x0a, 0xe6, 0x00, 0x38, 0x0a, 
+0x5b, 0x0c, 0xba, 0x00, 0x8f, 0x00, 0xab, 0x06, 0xdf, 0x0c, 0xef, 0x0a, 
+0x73, 0x0b, 0x5c, 0x0b, 0x1c, 0x00, 0x2e, 0x09, 0x0e, 0x19, 0xd9, 0x07, 
+0x26, 0x01, 0x38, 0x07, 0x08, 0x0a, 0x24, 0x09, 0xb1, 0x08, 0xfa, 0x18, 
+0xfe, 0x0e, 0xd4, 0x00, 0x30, 0x00, 0x0a, 0x01, 0x47, 0x0b, 0x20, 0x01, 
+0x57, 0x06, 0x49, 0x12, 0x0c, 0x01, 0x73, 0x0b, 0xbc, 0x00, 0x62, 0x00, 
+0x5c, 0x0b, 0x90, 0x09, 0x3e, 0x09, 0x2d, 0x00, 0x93, 0x11, 0x18, 0x0c, 
+0xa9, 0x08, 0xbc, 0x0a, 0xec, 0x00, 0xd5, 0x00, 0x15, 0x01, 0x96, 0x15, 
+0xb3, 0x15, 0xa3, 0x0a, 0x15, 0x01, 0xce, 0x00, 0xe2, 0x00, 0xae, 0x06, 
+0xd0, 0x06, 0xe2, 0x09, 0x72, 0x07, 0xf8, 0x0e, 0xe5, 0x06, 0x20, 0x09, 
+0x51, 0x13, 0x36, 0x0a, 0xea, 0x0c, 0xd1, 0x06, 0xab, 0x00, 0x26, 0x13, 
+0xf9, 0x00, 0xc1, 0x0b, 0x5a, 0x0b, 0xd1, 0x0e, 0x33, 0x01, 0xb2, 0x07, 
+0xfd, 0x00, 0x68, 0x0c, 0x9c, 0x0c, 0xd3, 0x0f, 0x3f, 0x0b, 0x70, 0x0f, 
+0xe9, 0x08, 0xf2, 0x00, 0x96, 0x0a, 0xca, 0x00, 0xef, 0x0e, 0x40, 0x06, 
+0xef, 0x0a, 0x42, 0x06, 0x58, 0x18, 0xcd, 0x00, 0xb8, 0x0e, 0xdb, 0x0d, 
+0x14, 0x11, 0x14, 0x07, 0xe1, 0x09, 0xd3, 0x09, 0xff, 0x00, 0x46, 0x06, 
+0xf0, 0x0a, 0x6a, 0x06, 0xa6, 0x0c, 0x51, 0x0c, 0x48, 0x06, 0x89, 0x0c, 
+0x7b, 0x08, 0x27, 0x00, 0xb9, 0x06, 0x3d, 0x12, 0xbc, 0x0a, 0xae, 0x0a, 
+0x04, 0x0c, 0xb9, 0x00, 0xd2, 0x0c, 0x9c, 0x08, 0xc9, 0x00, 0x82, 0x11, 
+0x0c, 0x10, 0x17, 0x01, 0x6b, 0x00, 0xc5, 0x14, 0xb3, 0x09, 0xa5, 0x0a, 
+0xf8, 0x0e, 0xba, 0x14, 0xa5, 0x09, 0x0d, 0x01, 0x84, 0x00, 0x85, 0x10, 
+0xb6, 0x0b, 0x63, 0x09, 0xb4, 0x0c, 0x47, 0x06, 0xf4, 0x08, 0xe1, 0x08, 
+0x72, 0x08, 0x3a, 0x0c, 0x60, 0x00, 0x0b, 0x10, 0x4c, 0x00, 0x16, 0x01, 
+0x78, 0x07, 0xb2, 0x0a, 0xe7, 0x00, 0x4e, 0x17, 0x61, 0x00, 0xe8, 0x18, 
+0xa8, 0x0f, 0x8e, 0x17, 0x30, 0x0b, 0xd3, 0x00, 0x55, 0x07, 0x33, 0x01, 
+0x42, 0x01, 0xef, 0x00, 0xf5, 0x00, 0x7a, 0x00, 0xfb, 0x00, 0xbc, 0x13, 
+0x9b, 0x0c, 0xb1, 0x08, 0xc9, 0x00, 0x8a, 0x12, 0xcd, 0x15, 0xe9, 0x10, 
+0xa8, 0x09, 0xc8, 0x11, 0xb2, 0x00, 0x6e, 0x00, 0xde, 0x00, 0x92, 0x0f, 
+0xe7, 0x09, 0x2c, 0x17, 0x50, 0x06, 0x4a, 0x11, 0x40, 0x06, 0x90, 0x00, 
+0x11, 0x0b, 0xb5, 0x00, 0x5e, 0x0c, 0xf5, 0x14, 0xd0, 0x00, 0x08, 0x01, 
+0x92, 0x0f, 0xec, 0x00, 0xad, 0x0b, 0x2b, 0x01, 0x21, 0x09, 0xbb, 0x0a, 
+0x1d, 0x0f, 0x79, 0x0a, 0x5d, 0x0c, 0x26, 0x01, 0x7c, 0x16, 0xf2, 0x13, 
+0x3c, 0x01, 0xa3, 0x0a, 0x0a, 0x01, 0x00, 0x0f, 0x08, 0x01, 0xb2, 0x0c, 
+0xbc, 0x0c, 0x66, 0x14, 0x6a, 0x0a, 0x7d, 0x0b, 0x2e, 0x0c, 0x81, 0x0b, 
+0xb1, 0x08, 0xd2, 0x0a, 0xde, 0x08, 0xd2, 0x00, 0x0b, 0x00, 0xde, 0x15, 
+0xf6, 0x09, 0x29, 0x0f, 0xc9, 0x08, 0x27, 0x00, 0x47, 0x06, 0x4e, 0x00, 
+0x31, 0x0c, 0x42, 0x00, 0xc9, 0x0e, 0xeb, 0x16, 0x19, 0x00, 0xfc, 0x09, 
+0xed, 0x0e, 0x65, 0x09, 0xd1, 0x09, 0xce, 0x18, 0xe7, 0x0f, 0x44, 0x06, 
+0x56, 0x0a, 0xb8, 0x00, 0xf1, 0x13, 0x98, 0x06, 0x40, 0x06, 0x60, 0x00, 
+0x8e, 0x0d, 0x45, 0x14, 0x3e, 0x0e, 0x63, 0x0d, 0x9b, 0x00, 0x83, 0x00, 
+0x95, 0x12, 0xca, 0x0c, 0x21, 0x10, 0x7a, 0x09, 0x71, 0x08, 0xa7, 0x00, 
+0xfb, 0x00, 0xec, 0x00, 0xe3, 0x0a, 0xe8, 0x18, 0xe8, 0x13, 0x4a, 0x0a, 
+0xbe, 0x00, 0xe2, 0x14, 0xaf, 0x00, 0x62, 0x06, 0xba, 0x07, 0xa6, 0x09, 
+0x6a, 0x0a, 0x34, 0x0b, 0xc8, 0x09, 0xd5, 0x00, 0x47, 0x00, 0x30, 0x0b, 
+0x6a, 0x0c, 0xe9, 0x00, 0xd9, 0x06, 0xa6, 0x13, 0xd9, 0x0a, 0x05, 0x0b, 
+0x33, 0x0b, 0x6c, 0x00, 0xaa, 0x07, 0x69, 0x12, 0x5c, 0x08, 0xe0, 0x13, 
+0xad, 0x00, 0xb2, 0x00, 0x4a, 0x14, 0xbb, 0x0a, 0x87, 0x19, 0xbd, 0x00, 
+0xe0, 0x00, 0xd1, 0x09, 0x6d, 0x00, 0xa2, 0x0a, 0x88, 0x0c, 0x40, 0x0f, 
+0x47, 0x06, 0x7c, 0x1b, 0x3c, 0x00, 0x00, 0x19, 0x41, 0x01, 0x04, 0x0b, 
+0x90, 0x0c, 0x9c, 0x0f, 0xfd, 0x00, 0x69, 0x0b, 0xc2, 0x0b, 0x47, 0x0d, 
+0x6c, 0x00, 0xce, 0x18, 0xf7, 0x00, 0xf4, 0x08, 0x17, 0x0c, 0x77, 0x00, 
+0x61, 0x06, 0x80, 0x0b, 0xd8, 0x07, 0x9e, 0x08, 0x30, 0x01, 0xb3, 0x06, 
+0x02, 0x0c, 0x96, 0x0b, 0x37, 0x1c, 0x56, 0x00, 0x4c, 0x18, 0xff, 0x0c, 
+0x1c, 0x01, 0x26, 0x12, 0x33, 0x01, 0xf5, 0x0c, 0x22, 0x0f, 0x1f, 0x0a, 
+0xeb, 0x0b, 0xaa, 0x0c, 0x9e, 0x00, 0xff, 0x18, 0xb4, 0x0b, 0x34, 0x0b, 
+0x94, 0x0b, 0xce, 0x0b, 0xc2, 0x17, 0xc6, 0x09, 0xa2, 0x06, 0xec, 0x0c, 
+0x75, 0x0a, 0x22, 0x0c, 0x86, 0x00, 0x23, 0x01, 0x23, 0x0e, 0xbe, 0x08, 
+0x37, 0x09, 0xd6, 0x00, 0x5f, 0x00, 0x38, 0x0a, 0x2a, 0x0e, 0x05, 0x0b, 
+0x25, 0x00, 0x77, 0x00, 0x0c, 0x01, 0xc2, 0x16, 0xf9, 0x00, 0x3b, 0x01, 
+0xbf, 0x09, 0x2f, 0x00, 0x1a, 0x08, 0x16, 0x0a, 0x19, 0x08, 0x39, 0x0c, 
+0xe3, 0x07, 0xdd, 0x09, 0x9c, 0x00, 0x31, 0x00, 0x0b, 0x00, 0xcd, 0x12, 
+0x85, 0x0c, 0xf2, 0x14, 0x4a, 0x0a, 0x50, 0x0c, 0xaa, 0x00, 0x12, 0x01, 
+0xf0, 0x0b, 0x42, 0x06, 0x7b, 0x0c, 0x4e, 0x0a, 0x49, 0x00, 0x95, 0x07, 
+0x90, 0x06, 0x33, 0x01, 0x2b, 0x01, 0x2f, 0x01, 0xe8, 0x09, 0xf4, 0x00, 
+0x92, 0x0c, 0x9e, 0x08, 0x97, 0x0c, 0xe3, 0x0c, 0x03, 0x01, 0xef, 0x0a, 
+0x9f, 0x0b, 0x69, 0x0e, 0x49, 0x06, 0x34, 0x01, 0xfc, 0x09, 0xc4, 0x0a, 
+0x74, 0x16, 0xec, 0x0c, 0x12, 0x07, 0x3f, 0x08, 0xdb, 0x09, 0x4f, 0x17, 
+0x0d, 0x00, 0x48, 0x09, 0xc7, 0x0a, 0x33, 0x00, 0x76, 0x0b, 0x8f, 0x1a, 
+0x89, 0x0b, 0x90, 0x10, 0x26, 0x0c, 0x47, 0x0b, 0x83, 0x00, 0xd7, 0x17, 
+0x40, 0x00, 0x36, 0x09, 0x80, 0x09, 0x4f, 0x06, 0x9e, 0x0b, 0x8f, 0x00, 
+0xbc, 0x0c, 0xcf, 0x0a, 0x0e, 0x00, 0xb6, 0x00, 0xb8, 0x15, 0x44, 0x12, 
+0x65, 0x17, 0x22, 0x0b, 0x78, 0x08, 0x40, 0x0a, 0x93, 0x0b, 0xce, 0x00, 
+0x19, 0x00, 0x1e, 0x0b, 0xe9, 0x0c, 0x42, 0x0a, 0xb8, 0x00, 0xb0, 0x0a, 
+0x60, 0x0c, 0x5c, 0x0b, 0x05, 0x01, 0x40, 0x0d, 0xda, 0x10, 0x90, 0x17, 
+0xdc, 0x0a, 0x42, 0x01, 0x3e, 0x0e, 0xfa, 0x00, 0x6c, 0x0b, 0x57, 0x00, 
+0x93, 0x0b, 0xe2, 0x09, 0x27, 0x01, 0xf3, 0x17, 0x18, 0x0c, 0x3f, 0x0d, 
+0x77, 0x16, 0xaa, 0x06, 0x29, 0x00, 0xba, 0x14, 0x3f, 0x0d, 0xe9, 0x0c, 
+0x56, 0x0a, 0x23, 0x0f, 0x26, 0x16, 0xff, 0x00, 0x2b, 0x13, 0xf8, 0x0f, 
+0xf0, 0x00, 0x30, 0x01, 0x39, 0x0c, 0x15, 0x14, 0x67, 0x07, 0xca, 0x0b, 
+0xaf, 0x0b, 0xac, 0x07, 0xd6, 0x16, 0x50, 0x16, 0x93, 0x0c, 0x60, 0x0a, 
+0x01, 0x0c, 0x1b, 0x0c, 0x55, 0x00, 0x5d, 0x11, 0x55, 0x00, 0x34, 0x0a, 
+0xaa, 0x16, 0x17, 0x01, 0x38, 0x00, 0x10, 0x00, 0x67, 0x13, 0xbc, 0x0c, 
+0x33, 0x0a, 0x3b, 0x0b, 0x7a, 0x00, 0x1d, 0x00, 0x20, 0x0a, 0x54, 0x0a, 
+0x93, 0x12, 0x57, 0x00, 0x92, 0x0c, 0x20, 0x1a, 0x79, 0x00, 0xdc, 0x0d, 
+0x79, 0x0b, 0xc3, 0x0a, 0xdb, 0x09, 0x5a, 0x0a, 0x5b, 0x0c, 0x0a, 0x01, 
+0x44, 0x00, 0xb4, 0x00, 0xf0, 0x00, 0xca, 0x0f, 0x71, 0x10, 0x14, 0x0a, 
+0x41, 0x06, 0x9a, 0x15, 0x7d, 0x09, 0xef, 0x0a, 0x42, 0x06, 0xbf, 0x00, 
+0x1b, 0x0f, 0xba, 0x00, 0x86, 0x17, 0x98, 0x07, 0x1a, 0x0f, 0xa1, 0x00, 
+0xa4, 0x0a, 0x71, 0x0c, 0x96, 0x00, 0x37, 0x01, 0xfa, 0x0a, 0x4d, 0x19, 
+0x08, 0x01, 0x12, 0x0b, 0x6f, 0x09, 0xb3, 0x07, 0x1b, 0x01, 0xe3, 0x00, 
+0x96, 0x00, 0x02, 0x01, 0x8d, 0x16, 0xb4, 0x00, 0xf6, 0x00, 0x5e, 0x11, 
+0x41, 0x01, 0x18, 0x00, 0x30, 0x01, 0xec, 0x0c, 0xbb, 0x17, 0xf6, 0x0b, 
+0x1a, 0x10, 0x25, 0x0c, 0x6a, 0x0b, 0xd2, 0x00, 0x12, 0x0a, 0xda, 0x07, 
+0xd5, 0x14, 0x2b, 0x00, 0x47, 0x0d, 0xa0, 0x00, 0x24, 0x00, 0xa6, 0x0c, 
+0x09, 0x0d, 0xba, 0x00, 0xdf, 0x00, 0xbc, 0x00, 0x41, 0x01, 0x85, 0x00, 
+0x8e, 0x1a, 0x36, 0x01, 0x05, 0x01, 0xb4, 0x17, 0xa9, 0x0d, 0xb3, 0x00, 
+0xff, 0x00, 0x8b, 0x0a, 0xc2, 0x00, 0x35, 0x0a, 0xb2, 0x00, 0x0c, 0x00, 
+0xde, 0x0c, 0xe2, 0x00, 0x20, 0x0b, 0x51, 0x14, 0x5f, 0x00, 0x82, 0x0a, 
+0x17, 0x00, 0x2f, 0x00, 0xf6, 0x0c, 0xec, 0x00, 0xa1, 0x06, 0x56, 0x00, 
+0x04, 0x0c, 0x45, 0x06, 0x45, 0x06, 0xa2, 0x06, 0xd0, 0x00, 0x94, 0x16, 
+0xde, 0x09, 0xe9, 0x0c, 0x71, 0x00, 0xa1, 0x00, 0x1e, 0x01, 0xf7, 0x0b, 
+0x84, 0x10, 0x1a, 0x00, 0x5d, 0x14, 0xe8, 0x09, 0x53, 0x00, 0x22, 0x0f, 
+0x7b, 0x1b, 0x7b, 0x09, 0x1f, 0x01, 0xe4, 0x00, 0x22, 0x0c, 0xe9, 0x09, 
+0x7e, 0x16, 0x30, 0x00, 0x04, 0x13, 0xbf, 0x00, 0x91, 0x00, 0x3d, 0x00, 
+0x86, 0x00, 0x84, 0x00, 0x1a, 0x0d, 0xe5, 0x00, 0x15, 0x13, 0x8b, 0x0c, 
+0x5e, 0x0b, 0x88, 0x08, 0x36, 0x12, 0x24, 0x01, 0xd1, 0x15, 0xb1, 0x00, 
+0xb8, 0x00, 0xd2, 0x09, 0xfa, 0x13, 0xd3, 0x00, 0x31, 0x01, 0xfd, 0x00, 
+0xe1, 0x0b, 0x6d, 0x0b, 0x4d, 0x0e, 0xb6, 0x00, 0x0d, 0x0c, 0xab, 0x15, 
+0x07, 0x0a, 0xbb, 0x08, 0x1a, 0x08, 0x6c, 0x00, 0xfd, 0x15, 0xea, 0x08, 
+0x3c, 0x01, 0x29, 0x01, 0xfb, 0x00, 0xec, 0x00, 0xa4, 0x0d, 0x80, 0x0a, 
+0x70, 0x09, 0xaf, 0x09, 0xd3, 0x09, 0x44, 0x0a, 0x1b, 0x0b, 0x6f, 0x09, 
+0xe5, 0x06, 0xd6, 0x16, 0x73, 0x08, 0x86, 0x0c, 0x80, 0x0a, 0x79, 0x09, 
+0x43, 0x0c, 0xd6, 0x00, 0x8d, 0x0a, 0x83, 0x00, 0xc9, 0x0a, 0xff, 0x00, 
+0x0f, 0x01, 0x52, 0x07, 0x64, 0x00, 0x49, 0x00, 0x54, 0x0e, 0x4e, 0x0c, 
+0xff, 0x0e, 0x93, 0x0c, 0x2d, 0x01, 0x5f, 0x00, 0xd2, 0x0a, 0x36, 0x1c, 
+0x26, 0x1a, 0xe6, 0x09, 0xf9, 0x0b, 0x65, 0x0c, 0xf7, 0x0b, 0x4a, 0x00, 
+0x33, 0x0a, 0x7b, 0x18, 0xd8, 0x0a, 0xcc, 0x17, 0x03, 0x13, 0xd5, 0x0b, 
+0x16, 0x01, 0xdd, 0x0f, 0xdf, 0x00, 0xc4, 0x0a, 0x0b, 0x01, 0xe5, 0x06, 
+0x28, 0x0a, 0x3f, 0x0a, 0x1f, 0x0a, 0xaf, 0x00, 0x10, 0x01, 0x8e, 0x16, 
+0x49, 0x0a, 0xdf, 0x08, 0xf6, 0x0b, 0xd7, 0x00, 0xc2, 0x0e, 0x7b, 0x08, 
+0xe4, 0x00, 0xdb, 0x00, 0xb8, 0x0a, 0x87, 0x17, 0x1d, 0x01, 0x97, 0x0f, 
+0x1b, 0x0a, 0x43, 0x0a, 0xc4, 0x0a, 0xd5, 0x0b, 0xbd, 0x0c, 0x20, 0x01, 
+0x83, 0x00, 0x92, 0x0f, 0x61, 0x00, 0x90, 0x17, 0x2c, 0x01, 0xfa, 0x0f, 
+0x54, 0x00, 0x27, 0x00, 0xe4, 0x11, 0x43, 0x06, 0xd4, 0x00, 0xd8, 0x0b, 
+0x8e, 0x06, 0xff, 0x0f, 0x44, 0x06, 0x9f, 0x0b, 0x80, 0x0b, 0xea, 0x13, 
+0x44, 0x06, 0x9c, 0x08, 0xdc, 0x00, 0x9f, 0x0b, 0x4b, 0x0a, 0x1c, 0x00, 
+0xb2, 0x00, 0xcc, 0x00, 0xf3, 0x00, 0xe2, 0x07, 0x53, 0x07, 0xe1, 0x13, 
+0xad, 0x15, 0x1f, 0x0a, 0x39, 0x13, 0xe0, 0x0b, 0x55, 0x11, 0x33, 0x0b, 
+0x2c, 0x01, 0x28, 0x0a, 0xbf, 0x0c, 0x6d, 0x09, 0x2e, 0x01, 0x73, 0x07, 
+0xf4, 0x07, 0xcf, 0x00, 0x6a, 0x17, 0xb9, 0x0b, 0x27, 0x12, 0x1a, 0x08, 
+0x11, 0x01, 0xed, 0x0b, 0x60, 0x0a, 0xab, 0x00, 0x1d, 0x0c, 0x28, 0x01, 
+0xa9, 0x0b, 0x1e, 0x0a, 0xa5, 0x0f, 0x61, 0x0f, 0x45, 0x0c, 0xe7, 0x00, 
+0xef, 0x08, 0x1e, 0x07, 0x04, 0x0b, 0xcc, 0x0b, 0x3a, 0x01, 0x36, 0x0b, 
+0x7b, 0x00, 0x5d, 0x12, 0x3a, 0x01, 0xc1, 0x0b, 0xdc, 0x09, 0xf1, 0x0b, 
+0xae, 0x00, 0xcd, 0x0a, 0x30, 0x0b, 0x02, 0x01, 0x3e, 0x0d, 0x24, 0x00, 
+0x7a, 0x00, 0xf7, 0x0b, 0xab, 0x0b, 0xf9, 0x00, 0x21, 0x01, 0xc0, 0x00, 
+0x9f, 0x0b, 0xca, 0x00, 0xb2, 0x09, 0x83, 0x00, 0x7f, 0x00, 0x81, 0x10, 
+0x43, 0x06, 0xc6, 0x09, 0xc2, 0x0a, 0x71, 0x17, 0xc0, 0x0c, 0x64, 0x09, 
+0xd6, 0x00, 0x41, 0x06, 0x83, 0x00, 0x15, 0x01, 0x33, 0x00, 0x56, 0x06, 
+0x30, 0x01, 0x39, 0x09, 0xb5, 0x00, 0x93, 0x0c, 0xc6, 0x00, 0x23, 0x0c, 
+0xbd, 0x09, 0x78, 0x10, 0x8d, 0x0a, 0x08, 0x0d, 0x85, 0x00, 0x1e, 0x00, 
+0xc2, 0x0a, 0x04, 0x0b, 0xc9, 0x00, 0x9e, 0x00, 0xd6, 0x09, 0xa0, 0x15, 
+0xd7, 0x17, 0x34, 0x0b, 0x17, 0x00, 0x98, 0x15, 0xe9, 0x0c, 0x1b, 0x08, 
+0xca, 0x0e, 0xbb, 0x00, 0xa3, 0x0b, 0xee, 0x0a, 0xe3, 0x0a, 0x70, 0x08, 
+0xce, 0x00, 0x96, 0x15, 0x41, 0x01, 0x54, 0x10, 0x5f, 0x12, 0x1c, 0x01, 
+0x55, 0x11, 0xdf, 0x0c, 0x7b, 0x18, 0xe0, 0x0b, 0x05, 0x0b, 0x43, 0x0c, 
+0x1d, 0x0a, 0xd2, 0x09, 0xd3, 0x0b, 0x67, 0x13, 0xe1, 0x00, 0x94, 0x16, 
+0x4a, 0x15, 0x1a, 0x01, 0xda, 0x06, 0x6a, 0x0a, 0x0b, 0x00, 0x3b, 0x01, 
+0x06, 0x01, 0xf6, 0x00, 0x9c, 0x0c, 0x64, 0x0c, 0x32, 0x0b, 0xb5, 0x00, 
+0x7a, 0x10, 0x3a, 0x01, 0xb9, 0x0a, 0x70, 0x13, 0xa6, 0x0c, 0xd7, 0x00, 
+0x49, 0x00, 0xd7, 0x16, 0x2a, 0x01, 0xb4, 0x00, 0x2a, 0x07, 0xf4, 0x08, 
+0xf0, 0x06, 0x3b, 0x00, 0x4c, 0x06, 0x06, 0x01, 0x61, 0x06, 0xe1, 0x09, 
+0x7e, 0x16, 0xa7, 0x0a, 0xf1, 0x00, 0x56, 0x06, 0xe9, 0x00, 0xf4, 0x09, 
+0xa2, 0x18, 0xe5, 0x00, 0x66, 0x0c, 0x00, 0x11, 0x25, 0x00, 0xf3, 0x09, 
+0x4a, 0x06, 0xb8, 0x00, 0xee, 0x0e, 0x92, 0x0f, 0x2e, 0x01, 0x0c, 0x0c, 
+0xb4, 0x07, 0x46, 0x0b, 0xa8, 0x00, 0x83, 0x11, 0x0a, 0x0b, 0xe5, 0x00, 
+0x30, 0x08, 0x81, 0x08, 0x31, 0x0b, 0x3e, 0x08, 0xac, 0x16, 0xdb, 0x0f, 
+0x76, 0x0b, 0x3f, 0x0b, 0x70, 0x09, 0xeb, 0x00, 0x29, 0x0c, 0xd9, 0x11, 
+0x31, 0x12, 0x78, 0x00, 0x5c, 0x14, 0x6b, 0x00, 0x27, 0x01, 0xa6, 0x09, 
+0xc0, 0x0b, 0xd6, 0x00, 0xfb, 0x0a, 0xca, 0x0e, 0xae, 0x00, 0xbb, 0x0a, 
+0x35, 0x0b, 0x82, 0x0f, 0xf3, 0x18, 0x2f, 0x08, 0xd3, 0x0f, 0x42, 0x0a, 
+0x06, 0x13, 0xd3, 0x00, 0x37, 0x0a, 0xaf, 0x00, 0xe2, 0x0f, 0xcf, 0x06, 
+0x73, 0x16, 0xae, 0x00, 0xc3, 0x0a, 0x4a, 0x06, 0x9b, 0x1a, 0xfe, 0x00, 
+0x7c, 0x00, 0x2e, 0x01, 0x65, 0x09, 0xcf, 0x14, 0x6f, 0x0c, 0x52, 0x0b, 
+0x69, 0x0e, 0xdb, 0x00, 0xca, 0x0b, 0x7b, 0x17, 0xf7, 0x00, 0x2a, 0x18, 
+0x3c, 0x01, 0xf7, 0x00, 0xde, 0x00, 0xd0, 0x09, 0x2e, 0x0a, 0xdc, 0x0a, 
+0x49, 0x0b, 0x08, 0x0a, 0x1e, 0x13, 0xff, 0x09, 0xed, 0x00, 0x77, 0x00, 
+0x2a, 0x0a, 0x55, 0x0b, 0xc9, 0x09, 0x78, 0x06, 0xce, 0x0a, 0xce, 0x00, 
+0xc0, 0x00, 0x8b, 0x12, 0x3b, 0x0b, 0xf3, 0x0c, 0x1b, 0x1b, 0x9b, 0x00, 
+0x44, 0x14, 0xb0, 0x0f, 0x96, 0x17, 0x67, 0x08, 0xdb, 0x00, 0x6d, 0x00, 
+0xac, 0x0a, 0x33, 0x15, 0xaa, 0x0c, 0x37, 0x0a, 0x96, 0x0b, 0xf7, 0x09, 
+0xb3, 0x1b, 0xdb, 0x00, 0x8c, 0x18, 0x4e, 0x0c, 0x18, 0x00, 0x2f, 0x00, 
+0x6a, 0x0d, 0xe2, 0x07, 0xc7, 0x0c, 0xae, 0x00, 0xc8, 0x09, 0x16, 0x0b, 
+0xe3, 0x07, 0xdd, 0x00, 0x7c, 0x0a, 0x01, 0x0c, 0xda, 0x00, 0x46, 0x19, 
+0xe1, 0x0b, 0x90, 0x0c, 0xd4, 0x09, 0x17, 0x00, 0x40, 0x06, 0x48, 0x12, 
+0xf3, 0x13, 0x27, 0x00, 0xcb, 0x0f, 0x10, 0x01, 0xa1, 0x07, 0x2e, 0x01, 
+0x85, 0x17, 0xb7, 0x0a, 0x0a, 0x0d, 0x33, 0x19, 0xaa, 0x16, 0xff, 0x09, 
+0x1e, 0x01, 0xb1, 0x00, 0x83, 0x00, 0x25, 0x0b, 0xd6, 0x00, 0xf4, 0x06, 
+0xf4, 0x0c, 0xde, 0x0a, 0x49, 0x0a, 0xc4, 0x0b, 0x1d, 0x07, 0x8d, 0x18, 
+0x31, 0x0b, 0x1c, 0x17, 0x29, 0x0c, 0x21, 0x09, 0x9e, 0x0b, 0x91, 0x00, 
+0x83, 0x11, 0x01, 0x17, 0x88, 0x00, 0x1d, 0x01, 0x94, 0x00, 0x9e, 0x0b, 
+0x56, 0x00, 0xce, 0x15, 0xde, 0x16, 0xd2, 0x09, 0xdb, 0x00, 0x54, 0x00, 
+0xaa, 0x1a, 0x83, 0x0a, 0xd5, 0x00, 0xd8, 0x00, 0x86, 0x0c, 0xaa, 0x06, 
+0x25, 0x0c, 0x4c, 0x11, 0xd1, 0x09, 0xd7, 0x0c, 0x0e, 0x0c, 0x8a, 0x17, 
+0xdf, 0x09, 0xce, 0x0a, 0xb1, 0x09, 0xe5, 0x00, 0x5b, 0x08, 0xe6, 0x07, 
+0x8a, 0x0b, 0xf8, 0x17, 0x70, 0x13, 0xb3, 0x00, 0xea, 0x00, 0x12, 0x01, 
+0xf6, 0x07, 0xd3, 0x00, 0x67, 0x08, 0xd7, 0x07, 0x54, 0x00, 0x54, 0x0b, 
+0xcb, 0x0f, 0x3b, 0x0b, 0x8d, 0x06, 0xc5, 0x14, 0xe7, 0x00, 0x7c, 0x07, 
+0xeb, 0x0b, 0xda, 0x0a, 0x00, 0x01, 0x9e, 0x17, 0xc0, 0x0e, 0xdf, 0x0c, 
+0x5f, 0x0c, 0x9b, 0x1a, 0xd2, 0x00, 0xce, 0x14, 0x05, 0x0b, 0x04, 0x0c, 
+0x77, 0x00, 0xde, 0x13, 0xde, 0x13, 0xf0, 0x00, 0xb4, 0x0c, 0xcf, 0x14, 
+0xfb, 0x13, 0x2a, 0x00, 0x20, 0x01, 0xe1, 0x00, 0xb2, 0x0a, 0x01, 0x0c, 
+0x9c, 0x0c, 0x8b, 0x0a, 0xaa, 0x15, 0x56, 0x0a, 0xdb, 0x07, 0x7b, 0x18, 
+0x06, 0x01, 0xe6, 0x15, 0xe6, 0x00, 0xcd, 0x00, 0xf7, 0x0c, 0x26, 0x0c, 
+0xe3, 0x00, 0x15, 0x01, 0x00, 0x0a, 0xe8, 0x15, 0xdb, 0x0a, 0xb8, 0x0b, 
+0xea, 0x00, 0x47, 0x06, 0xe6, 0x0d, 0x56, 0x0b, 0xce, 0x00, 0xd9, 0x06, 
+0xca, 0x0c, 0xc5, 0x0a, 0x3e, 0x0b, 0x3e, 0x0d, 0xf2, 0x00, 0xbf, 0x0b, 
+0x4c, 0x00, 0x2d, 0x08, 0x72, 0x0d, 0xa7, 0x00, 0xb7, 0x11, 0x71, 0x06, 
+0x49, 0x06, 0xb8, 0x0c, 0x3a, 0x01, 0xa7, 0x0f, 0x2d, 0x0a, 0xf5, 0x09, 
+0x39, 0x0c, 0xb9, 0x00, 0x58, 0x17, 0x52, 0x07, 0x45, 0x06, 0x79, 0x09, 
+0x71, 0x0c, 0x3b, 0x00, 0x59, 0x0a, 0x3f, 0x0a, 0xb2, 0x0c, 0xb1, 0x10, 
+0x14, 0x01, 0xc2, 0x08, 0x3c, 0x13, 0xaa, 0x15, 0x6f, 0x00, 0x20, 0x0b, 
+0xd5, 0x0c, 0xfa, 0x15, 0x6d, 0x00, 0x0c, 0x00, 0xf1, 0x09, 0x6b, 0x00, 
+0x25, 0x0c, 0x67, 0x0b, 0x93, 0x0f, 0x1f, 0x01, 0x9c, 0x00, 0xcd, 0x0b, 
+0xec, 0x0b, 0x2d, 0x0a, 0xfa, 0x00, 0xc1, 0x00, 0xdb, 0x0b, 0x81, 0x09, 
+0x4b, 0x0a, 0x29, 0x0f, 0xab, 0x15, 0x98, 0x0a, 0x2e, 0x01, 0xc4, 0x00, 
+0x36, 0x0a, 0x6d, 0x00, 0x2f, 0x01, 0x3b, 0x00, 0xae, 0x10, 0xe9, 0x09, 
+0xd5, 0x0b, 0x6d, 0x09, 0xcd, 0x0b, 0x52, 0x17, 0x22, 0x0c, 0x74, 0x0b, 
+0x48, 0x0c, 0xca, 0x00, 0xdc, 0x00, 0xcf, 0x0a, 0x2f, 0x01, 0xbd, 0x0c, 
+0xa4, 0x0b, 0x9d, 0x0f, 0x22, 0x09, 0xd8, 0x07, 0x79, 0x09, 0xde, 0x15, 
+0x2e, 0x08, 0xd0, 0x12, 0x9b, 0x0c, 0xc8, 0x0c, 0x96, 0x0b, 0x54, 0x0a, 
+0x6f, 0x0a, 0xd1, 0x06, 0x0d, 0x00, 0x44, 0x06, 0xa7, 0x13, 0x32, 0x0b, 
+0xc6, 0x00, 0x52, 0x0b, 0xa7, 0x09, 0x70, 0x17, 0xec, 0x0b, 0x7a, 0x00, 
+0xd4, 0x10, 0xc6, 0x00, 0x2a, 0x0b, 0x6b, 0x00, 0x33, 0x01, 0xd6, 0x07, 
+0x9d, 0x0f, 0xbb, 0x14, 0x69, 0x08, 0x28, 0x01, 0xdb, 0x09, 0x22, 0x01, 
+0x4e, 0x09, 0xa2, 0x0a, 0x7d, 0x0c, 0xa5, 0x09, 0x19, 0x0c, 0x9f, 0x07, 
+0x42, 0x0d, 0xb7, 0x00, 0x25, 0x01, 0x96, 0x07, 0x68, 0x0a, 0x97, 0x15, 
+0xa0, 0x00, 0x6b, 0x17, 0xe4, 0x0d, 0xec, 0x0a, 0x54, 0x0a, 0x2e, 0x01, 
+0x13, 0x00, 0xdf, 0x13, 0x1a, 0x0b, 0xa9, 0x0c, 0xe6, 0x07, 0xfe, 0x0a, 
+0x77, 0x00, 0x30, 0x0b, 0x45, 0x06, 0x46, 0x0a, 0x4b, 0x11, 0xb4, 0x17, 
+0xe2, 0x00, 0x22, 0x09, 0x9f, 0x0f, 0xbf, 0x0a, 0xd1, 0x00, 0xcd, 0x0f, 
+0x53, 0x0c, 0x27, 0x12, 0x22, 0x00, 0x42, 0x06, 0x3b, 0x00, 0x47, 0x19, 
+0x9b, 0x0c, 0x81, 0x0c, 0x4b, 0x11, 0xc0, 0x0e, 0x1b, 0x07, 0xe0, 0x16, 
+0x68, 0x09, 0x35, 0x09, 0x36, 0x10, 0xcc, 0x00, 0xc2, 0x0b, 0xe2, 0x07, 
+0xe2, 0x1a, 0x97, 0x1a, 0x8c, 0x12, 0x9c, 0x06, 0xa9, 0x00, 0x80, 0x08, 
+0xe8, 0x09, 0xf9, 0x00, 0xb6, 0x0e, 0xd8, 0x0a, 0xe7, 0x00, 0xc8, 0x16, 
+0xfe, 0x0c, 0x2c, 0x01, 0x18, 0x08, 0xba, 0x14, 0xe3, 0x07, 0xd6, 0x0b, 
+0xaa, 0x00, 0xa9, 0x00, 0x42, 0x06, 0xba, 0x09, 0xcf, 0x0a, 0x4f, 0x0c, 
+0x22, 0x10, 0x09, 0x01, 0x43, 0x06, 0xae, 0x0f, 0xa1, 0x0a, 0x08, 0x0a, 
+0xc6, 0x09, 0x46, 0x0b, 0xd5, 0x00, 0x47, 0x06, 0xda, 0x11, 0xec, 0x00, 
+0x14, 0x0a, 0xfb, 0x00, 0x18, 0x01, 0x36, 0x09, 0x61, 0x17, 0x88, 0x0d, 
+0xad, 0x0a, 0xbb, 0x09, 0x9c, 0x06, 0xc5, 0x14, 0x2f, 0x00, 0xde, 0x09, 
+0x1f, 0x01, 0x1c, 0x01, 0xc1, 0x0b, 0xe0, 0x0c, 0x8a, 0x00, 0x18, 0x0c, 
+0x92, 0x12, 0x97, 0x0a, 0xd3, 0x0c, 0x23, 0x00, 0x0b, 0x00, 0x0a, 0x01, 
+0x37, 0x01, 0x38, 0x01, 0xc6, 0x00, 0xfa, 0x0b, 0xbe, 0x09, 0xac, 0x0c, 
+0xd8, 0x0e, 0x30, 0x01, 0x34, 0x18, 0xfb, 0x00, 0xd8, 0x15, 0x41, 0x12, 
+0xa1, 0x00, 0x48, 0x06, 0xe2, 0x00, 0x64, 0x09, 0x8d, 0x0d, 0x45, 0x06, 
+0x39, 0x01, 0x0d, 0x0c, 0xe8, 0x13, 0x62, 0x09, 0xee, 0x0d, 0xfc, 0x00, 
+0x70, 0x09, 0xf3, 0x0c, 0x51, 0x14, 0x43, 0x00, 0xdf, 0x11, 0x2b, 0x01, 
+0xec, 0x00, 0x18, 0x00, 0xb1, 0x00, 0x3f, 0x0b, 0xed, 0x0b, 0x41, 0x01, 
+0xcc, 0x00, 0x5b, 0x0f, 0x90, 0x0c, 0xf4, 0x08, 0xb4, 0x06, 0xa9, 0x0b, 
+0xf9, 0x0b, 0x20, 0x01, 0xe7, 0x0b, 0xa0, 0x0c, 0xb7, 0x0a, 0x33, 0x00, 
+0x65, 0x0d, 0xa4, 0x0a, 0xe8, 0x00, 0x26, 0x01, 0xb8, 0x15, 0xf6, 0x0d, 
+0x51, 0x0c, 0xc6, 0x0e, 0x0c, 0x01, 0x2b, 0x09, 0x70, 0x06, 0xf0, 0x0b, 
+0xfc, 0x0d, 0xe4, 0x0a, 0xd0, 0x18, 0x03, 0x01, 0x09, 0x0d, 0xa0, 0x0b, 
+0xd9, 0x0a, 0x1d, 0x0b, 0x7c, 0x0d, 0x0c, 0x09, 0x93, 0x0b, 0x3b, 0x01, 
+0x0d, 0x0c, 0x93, 0x0d, 0x43, 0x15, 0xb0, 0x09, 0x2f, 0x00, 0x61, 0x08, 
+0x1f, 0x01, 0xaa, 0x10, 0x3b, 0x18, 0x62, 0x09, 0xac, 0x0a, 0xb8, 0x0a, 
+0x76, 0x16, 0xdc, 0x0d, 0xbb, 0x00, 0x23, 0x00, 0x9a, 0x17, 0xc1, 0x0e, 
+0x3f, 0x0a, 0xdd, 0x18, 0x62, 0x19, 0x98, 0x06, 0x31, 0x00, 0x59, 0x06, 
+0xee, 0x07, 0x25, 0x08, 0x6f, 0x00, 0x29, 0x07, 0x05, 0x13, 0xdf, 0x00, 
+0x1c, 0x0e, 0x82, 0x14, 0x56, 0x06, 0x66, 0x09, 0x69, 0x08, 0xb6, 0x11, 
+0x95, 0x0b, 0x2b, 0x09, 0x8d, 0x12, 0x70, 0x09, 0x2c, 0x01, 0x6f, 0x08, 
+0x76, 0x0b, 0x49, 0x06, 0x31, 0x0b, 0x6d, 0x00, 0xc9, 0x0e, 0x58, 0x0a, 
+0x51, 0x11, 0xea, 0x00, 0xe8, 0x00, 0x8d, 0x06, 0xcd, 0x0a, 0xa1, 0x0a, 
+0xbd, 0x09, 0x89, 0x0c, 0x87, 0x00, 0x6d, 0x07, 0x66, 0x08, 0xf9, 0x18, 
+0xc0, 0x0b, 0x35, 0x0a, 0x30, 0x0b, 0xab, 0x15, 0x2e, 0x09, 0x1c, 0x01, 
+0x93, 0x0f, 0x83, 0x00, 0x8a, 0x00, 0xd8, 0x0c, 0x5d, 0x17, 0x43, 0x06, 
+0x22, 0x0e, 0xbb, 0x09, 0x32, 0x00, 0x4c, 0x08, 0x94, 0x07, 0x52, 0x07, 
+0x24, 0x00, 0x57, 0x0a, 0x5a, 0x17, 0xc3, 0x00, 0x27, 0x07, 0x28, 0x08, 
+0x22, 0x01, 0x63, 0x0a, 0x55, 0x0a, 0x0d, 0x0c, 0x26, 0x15, 0xf4, 0x00, 
+0x8e, 0x17, 0x45, 0x06, 0x73, 0x16, 0x62, 0x0d, 0x8c, 0x06, 0x57, 0x13, 
+0xc9, 0x00, 0x8b, 0x0b, 0xcf, 0x0c, 0x22, 0x01, 0x5a, 0x17, 0x18, 0x0c, 
+0xfe, 0x0c, 0xad, 0x0a, 0x28, 0x01, 0x18, 0x14, 0x43, 0x0c, 0xae, 0x0c, 
+0x25, 0x01, 0x66, 0x0a, 0xe1, 0x08, 0x96, 0x0a, 0x0c, 0x01, 0xc9, 0x00, 
+0xfd, 0x00, 0x8d, 0x06, 0xda, 0x07, 0x3a, 0x12, 0x25, 0x01, 0x98, 0x0c, 
+0x8f, 0x00, 0x4b, 0x00, 0x76, 0x13, 0xfe, 0x0f, 0xfb, 0x14, 0xfa, 0x0f, 
+0x1b, 0x0b, 0xe0, 0x09, 0xdf, 0x00, 0x98, 0x15, 0xaa, 0x00, 0x2b, 0x01, 
+0x2b, 0x0e, 0xcf, 0x00, 0x4b, 0x08, 0x59, 0x0c, 0x5c, 0x0c, 0xd7, 0x00, 
+0x66, 0x1b, 0x10, 0x0b, 0xa4, 0x0a, 0xfb, 0x00, 0x2b, 0x18, 0xa4, 0x0a, 
+0x26, 0x12, 0xe4, 0x10, 0xf7, 0x00, 0x9f, 0x07, 0xb5, 0x00, 0x44, 0x00, 
+0x90, 0x06, 0xd8, 0x07, 0xeb, 0x0b, 0x4e, 0x0c, 0x90, 0x0c, 0x36, 0x01, 
+0x65, 0x00, 0x60, 0x11, 0x43, 0x0c, 0x7b, 0x00, 0xbb, 0x16, 0xa4, 0x0b, 
+0x3c, 0x01, 0x6f, 0x0c, 0x8c, 0x0b, 0x95, 0x07, 0x44, 0x0a, 0x02, 0x17, 
+0x46, 0x08, 0xe6, 0x09, 0x58, 0x1a, 0xb7, 0x00, 0x8e, 0x1a, 0x31, 0x01, 
+0xaa, 0x0b, 0x5e, 0x0b, 0x6f, 0x09, 0x62, 0x0a, 0xca, 0x00, 0xe3, 0x00, 
+0x2b, 0x0c, 0x6b, 0x0a, 0x77, 0x0a, 0x92, 0x0f, 0x74, 0x10, 0x24, 0x00, 
+0x49, 0x00, 0x0d, 0x01, 0xf6, 0x10, 0x32, 0x00, 0x7a, 0x0c, 0x49, 0x0b, 
+0x95, 0x07, 0x62, 0x19, 0x32, 0x0b, 0x87, 0x0c, 0x7f, 0x16, 0x9c, 0x00, 
+0xb0, 0x09, 0x7d, 0x00, 0x16, 0x07, 0x68, 0x0b, 0x54, 0x09, 0xd0, 0x14, 
+0x62, 0x0d, 0xf6, 0x10, 0x6d, 0x14, 0x8c, 0x06, 0x5a, 0x0a, 0xd8, 0x0a, 
+0x4a, 0x0a, 0xba, 0x08, 0x3a, 0x13, 0x93, 0x0b, 0x11, 0x01, 0xe2, 0x0f, 
+0x68, 0x14, 0x91, 0x00, 0xa9, 0x0b, 0x27, 0x01, 0xc0, 0x00, 0x1f, 0x0b, 
+0x01, 0x01, 0x5f, 0x06, 0x4d, 0x14, 0xfe, 0x0e, 0xe6, 0x0b, 0xd6, 0x0b, 
+0xd1, 0x09, 0x27, 0x15, 0x93, 0x0f, 0x3c, 0x00, 0x4e, 0x0c, 0x7a, 0x08, 
+0x77, 0x0a, 0x90, 0x00, 0x3e, 0x0b, 0x93, 0x11, 0x78, 0x00, 0x5d, 0x0b, 
+0x40, 0x0b, 0x64, 0x00, 0x33, 0x0a, 0x55, 0x0b, 0xcc, 0x00, 0xa8, 0x09, 
+0xa4, 0x09, 0x25, 0x16, 0x6a, 0x17, 0x79, 0x0a, 0xfb, 0x00, 0x6a, 0x0c, 
+0x31, 0x00, 0x6f, 0x00, 0x3d, 0x00, 0xd5, 0x09, 0x17, 0x0c, 0x09, 0x0d, 
+0x6f, 0x00, 0xa7, 0x09, 0xda, 0x00, 0xa7, 0x08, 0x30, 0x12, 0x74, 0x0e, 
+0x40, 0x0a, 0x9c, 0x0a, 0xc1, 0x0e, 0x3c, 0x01, 0xac, 0x06, 0x4d, 0x0a, 
+0x30, 0x00, 0x68, 0x0c, 0xab, 0x16, 0x35, 0x01, 0xe8, 0x0f, 0xbe, 0x00, 
+0xbf, 0x00, 0xa1, 0x00, 0x30, 0x00, 0xfd, 0x09, 0x22, 0x09, 0x33, 0x01, 
+0xf9, 0x0b, 0xfc, 0x07, 0xd5, 0x00, 0xf0, 0x00, 0xe9, 0x09, 0x10, 0x07, 
+0x11, 0x0e, 0x5c, 0x0c, 0x28, 0x14, 0xc6, 0x09, 0x3f, 0x00, 0x20, 0x01, 
+0xc7, 0x00, 0x9b, 0x19, 0xa4, 0x0a, 0x53, 0x00, 0xea, 0x08, 0x89, 0x0b, 
+0x1a, 0x00, 0x00, 0x0d, 0x3b, 0x18, 0x19, 0x08, 0xd8, 0x16, 0x68, 0x13, 
+0xfd, 0x08, 0xb1, 0x00, 0x62, 0x00, 0xc3, 0x00, 0xbe, 0x14, 0x42, 0x0a, 
+0x41, 0x18, 0xa9, 0x15, 0x34, 0x01, 0x3a, 0x0c, 0x3c, 0x00, 0x83, 0x11, 
+0xff, 0x08, 0x01, 0x0c, 0xe5, 0x0b, 0x74, 0x0c, 0xab, 0x0b, 0xf3, 0x00, 
+0xfc, 0x00, 0x4e, 0x00, 0x17, 0x00, 0x16, 0x0a, 0xb2, 0x00, 0x1e, 0x01, 
+0x5f, 0x0b, 0x32, 0x18, 0x4d, 0x14, 0x2c, 0x0a, 0x1f, 0x01, 0xc5, 0x00, 
+0x3e, 0x08, 0xb7, 0x00, 0x56, 0x1a, 0xaf, 0x00, 0x1c, 0x0e, 0x1b, 0x01, 
+0x18, 0x00, 0x31, 0x1c, 0xec, 0x0c, 0xca, 0x0b, 0x8a, 0x0b, 0xa2, 0x06, 
+0xa2, 0x0a, 0xaa, 0x07, 0x6e, 0x0a, 0x71, 0x0c, 0x3c, 0x18, 0x78, 0x06, 
+0x2b, 0x16, 0x6b, 0x00, 0x9c, 0x00, 0x6f, 0x0c, 0x31, 0x0b, 0xe4, 0x00, 
+0xd1, 0x0a, 0xc4, 0x00, 0x85, 0x16, 0xfa, 0x0f, 0xa9, 0x0b, 0xb3, 0x00, 
+0x48, 0x0b, 0x47, 0x06, 0x06, 0x01, 0xc2, 0x0a, 0x0d, 0x01, 0x05, 0x01, 
+0x3b, 0x0b, 0x35, 0x09, 0xda, 0x0b, 0x4a, 0x00, 0xb3, 0x0c, 0x1f, 0x15, 
+0xbd, 0x0a, 0xaf, 0x12, 0xac, 0x00, 0x92, 0x06, 0x38, 0x0a, 0x38, 0x01, 
+0xf0, 0x00, 0x12, 0x08, 0xd8, 0x0a, 0x7e, 0x0b, 0xba, 0x0a, 0x9d, 0x06, 
+0x41, 0x00, 0xcc, 0x00, 0xbd, 0x09, 0x66, 0x00, 0x91, 0x0c, 0xcb, 0x00, 
+0x26, 0x01, 0xce, 0x0a, 0x79, 0x00, 0xec, 0x00, 0x4a, 0x11, 0x23, 0x0c, 
+0xbd, 0x09, 0x1b, 0x0e, 0x95, 0x16, 0xa2, 0x0a, 0xe0, 0x00, 0x7d, 0x0c, 
+0x05, 0x0f, 0xed, 0x00, 0x44, 0x06, 0xcc, 0x09, 0x15, 0x14, 0x1f, 0x15, 
+0x17, 0x17, 0xfd, 0x0a, 0x66, 0x0c, 0x8c, 0x0a, 0xb8, 0x00, 0xce, 0x00, 
+0xb1, 0x0c, 0xca, 0x0f, 0x00, 0x01, 0xf6, 0x07, 0x2d, 0x01, 0x3f, 0x00, 
+0xe2, 0x00, 0x40, 0x01, 0x90, 0x10, 0xe1, 0x16, 0x2f, 0x0c, 0xec, 0x0f, 
+0x84, 0x0e, 0x4a, 0x00, 0xbd, 0x0a, 0xd5, 0x00, 0xec, 0x08, 0x43, 0x0a, 
+0xb8, 0x00, 0xd6, 0x0c, 0xad, 0x0a, 0xb4, 0x00, 0x66, 0x00, 0x91, 0x00, 
+0xd0, 0x18, 0xa3, 0x0d, 0x60, 0x11, 0xbf, 0x0c, 0x16, 0x01, 0x08, 0x0c, 
+0xcf, 0x08, 0x4c, 0x00, 0xf6, 0x0d, 0xbe, 0x19, 0xac, 0x16, 0xdb, 0x0d, 
+0x33, 0x0a, 0xf4, 0x09, 0x49, 0x0c, 0x23, 0x0c, 0x40, 0x06, 0x69, 0x17, 
+0xd3, 0x09, 0x2f, 0x00, 0x57, 0x0a, 0x44, 0x06, 0xca, 0x00, 0x10, 0x01, 
+0x2a, 0x01, 0xfd, 0x09, 0x75, 0x0b, 0x31, 0x00, 0xdc, 0x09, 0x42, 0x01, 
+0xcc, 0x00, 0xf4, 0x00, 0x91, 0x0c, 0xd8, 0x0a, 0x08, 0x01, 0xdd, 0x10, 
+0xed, 0x0b, 0xef, 0x00, 0xf1, 0x09, 0x2f, 0x00, 0x40, 0x06, 0xe6, 0x0a, 
+0x84, 0x0a, 0xe4, 0x0d, 0xd7, 0x0b, 0xba, 0x14, 0xee, 0x0b, 0xb0, 0x00, 
+0x7a, 0x0a, 0x58, 0x17, 0x9e, 0x0b, 0xe6, 0x00, 0x3c, 0x01, 0x8e, 0x0a, 
+0x81, 0x08, 0xc2, 0x08, 0xce, 0x00, 0xc2, 0x0a, 0x2a, 0x0b, 0x02, 0x0c, 
+0xfa, 0x0a, 0xaa, 0x09, 0x49, 0x06, 0xdf, 0x13, 0x95, 0x07, 0xba, 0x16, 
+0x95, 0x07, 0xd2, 0x0c, 0x78, 0x00, 0xd3, 0x00, 0x2f, 0x18, 0xae, 0x11, 
+0xa7, 0x00, 0x92, 0x00, 0xd5, 0x00, 0x2c, 0x12, 0x7f, 0x0c, 0x9e, 0x00, 
+0x3e, 0x00, 0xbc, 0x00, 0x17, 0x00, 0xeb, 0x00, 0x58, 0x18, 0x3e, 0x01, 
+0x92, 0x0f, 0x1e, 0x01, 0xa1, 0x06, 0x0d, 0x01, 0xff, 0x0c, 0xb2, 0x15, 
+0x9b, 0x12, 0xe7, 0x15, 0x2c, 0x10, 0xbb, 0x0a, 0xc2, 0x00, 0xa0, 0x00, 
+0x8d, 0x0b, 0xd2, 0x00, 0xfa, 0x00, 0x8f, 0x00, 0x3e, 0x08, 0xc1, 0x09, 
+0xf0, 0x0a, 0x80, 0x08, 0xd6, 0x00, 0xbd, 0x0c, 0x5b, 0x0c, 0x81, 0x0a, 
+0x4e, 0x0e, 0x4c, 0x14, 0x50, 0x0c, 0x15, 0x0e, 0x27, 0x12, 0xfc, 0x0b, 
+0x41, 0x06, 0x82, 0x09, 0xb9, 0x0a, 0x6b, 0x0a, 0x32, 0x12, 0xc3, 0x00, 
+0xd9, 0x07, 0xa5, 0x09, 0x7b, 0x00, 0xbf, 0x0b, 0xea, 0x0c, 0xac, 0x00, 
+0x0c, 0x01, 0x42, 0x0b, 0x2b, 0x01, 0x8b, 0x09, 0xf3, 0x0c, 0xc2, 0x00, 
+0x1c, 0x0d, 0xc4, 0x00, 0xfa, 0x0b, 0x3e, 0x01, 0xf2, 0x09, 0x6f, 0x00, 
+0xe4, 0x0a, 0x1e, 0x0b, 0x1d, 0x01, 0x56, 0x0b, 0xec, 0x00, 0xbe, 0x09, 
+0xdf, 0x0c, 0x1d, 0x0b, 0xed, 0x09, 0x98, 0x0a, 0x23, 0x09, 0x59, 0x06, 
+0x96, 0x07, 0xdc, 0x09, 0x24, 0x01, 0x9d, 0x0f, 0x05, 0x0c, 0x41, 0x06, 
+0xd4, 0x10, 0x2f, 0x00, 0x1c, 0x0f, 0x29, 0x0f, 0xbc, 0x0c, 0x01, 0x07, 
+0x3f, 0x0f, 0x72, 0x0c, 0x24, 0x0c, 0x95, 0x0b, 0x09, 0x16, 0x5e, 0x12, 
+0x57, 0x00, 0x97, 0x1a, 0xe5, 0x00, 0xde, 0x13, 0xe6, 0x07, 0x76, 0x16, 
+0x52, 0x13, 0x38, 0x01, 0xe1, 0x07, 0x31, 0x09, 0x0b, 0x00, 0xeb, 0x00, 
+0x12, 0x0b, 0x3e, 0x00, 0x1f, 0x0a, 0xad, 0x07, 0xcf, 0x06, 0x9c, 0x00, 
+0x63, 0x0d, 0x89, 0x10, 0x18, 0x01, 0x6c, 0x0b, 0x61, 0x00, 0xdc, 0x00, 
+0x00, 0x01, 0xa7, 0x0c, 0x63, 0x00, 0x0f, 0x01, 0x46, 0x09, 0xc1, 0x00, 
+0x84, 0x0b, 0x41, 0x06, 0x12, 0x0d, 0x20, 0x01, 0xb3, 0x00, 0xe5, 0x16, 
+0x37, 0x01, 0xd4, 0x00, 0xc7, 0x09, 0xae, 0x10, 0x92, 0x00, 0x10, 0x16, 
+0xa1, 0x07, 0x18, 0x0c, 0x6d, 0x09, 0x96, 0x0a, 0x1e, 0x0a, 0x44, 0x13, 
+0x5d, 0x07, 0x75, 0x0a, 0x83, 0x0a, 0xd9, 0x16, 0xfd, 0x00, 0x38, 0x0a, 
+0x04, 0x01, 0x0d, 0x01, 0x54, 0x13, 0xa3, 0x0a, 0xfb, 0x00, 0xdf, 0x18, 
+0x31, 0x09, 0xc0, 0x00, 0xfa, 0x0a, 0x77, 0x0a, 0xcc, 0x0b, 0x94, 0x00, 
+0x7c, 0x00, 0xc8, 0x0c, 0x11, 0x00, 0x7d, 0x0b, 0x42, 0x13, 0xe9, 0x0c, 
+0x1e, 0x0b, 0x55, 0x11, 0xa7, 0x10, 0xf8, 0x00, 0x30, 0x01, 0xa4, 0x09, 
+0x98, 0x0a, 0x5f, 0x0b, 0xa9, 0x08, 0x37, 0x01, 0x49, 0x06, 0x8e, 0x0a, 
+0x87, 0x0c, 0x50, 0x06, 0x2d, 0x01, 0xdd, 0x0d, 0x3b, 0x00, 0x9c, 0x08, 
+0x4a, 0x06, 0x91, 0x17, 0x78, 0x00, 0xbd, 0x0c, 0xfc, 0x09, 0x47, 0x06, 
+0x82, 0x0a, 0xb3, 0x00, 0x13, 0x01, 0xf7, 0x10, 0xd9, 0x0a, 0x4b, 0x0a, 
+0x4b, 0x06, 0x31, 0x00, 0x9b, 0x00, 0x24, 0x01, 0xc2, 0x00, 0x42, 0x06, 
+0xc1, 0x0c, 0x2c, 0x01, 0xf7, 0x0e, 0x79, 0x10, 0xe0, 0x0d, 0x14, 0x07, 
+0x9c, 0x18, 0xa4, 0x09, 0x08, 0x17, 0x0e, 0x00, 0x36, 0x01, 0xfa, 0x00, 
+0xba, 0x06, 0x16, 0x17, 0x55, 0x00, 0xf5, 0x14, 0x6f, 0x08, 0xc9, 0x0e, 
+0x02, 0x01, 0x71, 0x06, 0xce, 0x0a, 0x53, 0x0b, 0x66, 0x13, 0x78, 0x08, 
+0x27, 0x1a, 0x7c, 0x0c, 0x4b, 0x06, 0x22, 0x01, 0xc1, 0x0e, 0x38, 0x0b, 
+0x24, 0x00, 0xe9, 0x0a, 0x57, 0x0a, 0x64, 0x00, 0x10, 0x0b, 0xe3, 0x0a, 
+0x40, 0x01, 0x0c, 0x13, 0xc0, 0x00, 0x81, 0x18, 0xc0, 0x09, 0xea, 0x08, 
+0x46, 0x19, 0x03, 0x0c, 0xda, 0x0f, 0x06, 0x13, 0x2a, 0x00, 0xaa, 0x1a, 
+0x15, 0x01, 0xfc, 0x09, 0x0d, 0x00, 0x1a, 0x01, 0x46, 0x0d, 0x44, 0x0c, 
+0xa4, 0x17, 0xe2, 0x00, 0x7e, 0x00, 0x80, 0x09, 0x3c, 0x01, 0x1d, 0x01, 
+0x83, 0x00, 0x4c, 0x06, 0x45, 0x06, 0x95, 0x00, 0xeb, 0x0b, 0xd9, 0x07, 
+0x54, 0x1c, 0xec, 0x09, 0x58, 0x06, 0xe1, 0x11, 0x2c, 0x09, 0x1a, 0x10, 
+0x81, 0x0a, 0xcc, 0x09, 0xe8, 0x00, 0xc3, 0x0a, 0xa1, 0x15, 0xc8, 0x00, 
+0xb5, 0x00, 0x4c, 0x0a, 0xde, 0x09, 0xe0, 0x16, 0xf1, 0x00, 0xd4, 0x19, 
+0x53, 0x07, 0x7a, 0x0c, 0x6b, 0x0b, 0x27, 0x0b, 0xd7, 0x00, 0x81, 0x18, 
+0x59, 0x00, 0x7b, 0x18, 0xf5, 0x0c, 0xc8, 0x0c, 0xf7, 0x00, 0x81, 0x08, 
+0x12, 0x01, 0x24, 0x01, 0xc7, 0x00, 0x45, 0x08, 0x64, 0x09, 0xc7, 0x00, 
+0xcd, 0x00, 0x9b, 0x00, 0x22, 0x01, 0xc8, 0x09, 0x52, 0x0b, 0xd6, 0x00, 
+0x8b, 0x12, 0x89, 0x0b, 0x1c, 0x01, 0xcc, 0x0f, 0xbb, 0x00, 0xe5, 0x0d, 
+0x1f, 0x1a, 0x83, 0x15, 0x12, 0x01, 0x9b, 0x00, 0x8f, 0x00, 0x28, 0x12, 
+0xe3, 0x06, 0x29, 0x01, 0x90, 0x00, 0x32, 0x00, 0x42, 0x06, 0x2b, 0x0d, 
+0x28, 0x15, 0xce, 0x00, 0x01, 0x0c, 0x47, 0x0b, 0xb2, 0x00, 0xf2, 0x09, 
+0xec, 0x08, 0xb2, 0x1c, 0x83, 0x0a, 0xb2, 0x0c, 0x17, 0x01, 0x2a, 0x12, 
+0xf0, 0x00, 0xfd, 0x09, 0x28, 0x00, 0xb1, 0x0c, 0xfc, 0x08, 0x03, 0x0d, 
+0x4a, 0x11, 0xc8, 0x0a, 0x02, 0x01, 0x80, 0x0b, 0x7e, 0x0b, 0x04, 0x19, 
+0x3d, 0x00, 0xdd, 0x09, 0x84, 0x09, 0x48, 0x06, 0xbd, 0x10, 0x28, 0x12, 
+0x38, 0x00, 0x56, 0x0b, 0xe6, 0x00, 0x6f, 0x08, 0x6b, 0x00, 0xf6, 0x0b, 
+0xb1, 0x0a, 0x55, 0x0a, 0x3e, 0x0a, 0x1d, 0x0a, 0x57, 0x1a, 0x59, 0x0c, 
+0x6c, 0x0a, 0x75, 0x0a, 0x41, 0x12, 0xf2, 0x09, 0x28, 0x12, 0xcf, 0x00, 
+0x2f, 0x00, 0x76, 0x0a, 0xfa, 0x00, 0x2a, 0x01, 0x3d, 0x0b, 0x01, 0x0f, 
+0x3a, 0x01, 0xde, 0x13, 0xa8, 0x09, 0xff, 0x0a, 0x6b, 0x0b, 0x4e, 0x19, 
+0xb0, 0x09, 0x81, 0x18, 0xca, 0x00, 0x1e, 0x0b, 0xf9, 0x00, 0x36, 0x0d, 
+0x6a, 0x0a, 0x3d, 0x0b, 0x1b, 0x0b, 0xb9, 0x0a, 0x10, 0x00, 0x2c, 0x01, 
+0x3b, 0x00, 0x46, 0x09, 0xdb, 0x09, 0x33, 0x01, 0x0e, 0x01, 0x1b, 0x08, 
+0xba, 0x00, 0x5a, 0x10, 0x0e, 0x13, 0x77, 0x00, 0x49, 0x06, 0x7d, 0x0b, 
+0x5c, 0x08, 0xd4, 0x09, 0x83, 0x0a, 0x18, 0x17, 0xdb, 0x00, 0xd1, 0x00, 
+0x9b, 0x0c, 0x30, 0x1a, 0x3a, 0x0c, 0x3c, 0x13, 0xf8, 0x00, 0x2c, 0x0b, 
+0xe7, 0x0a, 0x05, 0x13, 0x10, 0x01, 0xd8, 0x07, 0x72, 0x11, 0x29, 0x0a, 
+0x5f, 0x0c, 0xbb, 0x14, 0xe9, 0x08, 0x7c, 0x0c, 0x1f, 0x0a, 0x32, 0x0c, 
+0x4d, 0x11, 0x72, 0x00, 0x51, 0x0b, 0x94, 0x0f, 0x6d, 0x11, 0x0e, 0x01, 
+0x61, 0x17, 0x8b, 0x0a, 0x40, 0x01, 0x3e, 0x09, 0xf6, 0x0b, 0x7d, 0x16, 
+0xc9, 0x00, 0x7a, 0x09, 0xf8, 0x00, 0x6b, 0x0a, 0x3d, 0x01, 0xd8, 0x00, 
+0x26, 0x0b, 0x22, 0x0c, 0x31, 0x00, 0xc9, 0x00, 0x58, 0x0a, 0xec, 0x00, 
+0xa8, 0x09, 0xee, 0x00, 0xb4, 0x07, 0xc6, 0x09, 0x3c, 0x1a, 0x48, 0x12, 
+0x4e, 0x17, 0x78, 0x00, 0x1b, 0x07, 0x21, 0x01, 0x9b, 0x0c, 0x51, 0x0b, 
+0x19, 0x01, 0x71, 0x0c, 0x24, 0x00, 0x25, 0x0c, 0x44, 0x0c, 0xdf, 0x00, 
+0xad, 0x00, 0xda, 0x00, 0xf7, 0x07, 0xf9, 0x0a, 0x17, 0x00, 0x76, 0x0b, 
+0x90, 0x0c, 0x62, 0x0e, 0xf7, 0x10, 0x3e, 0x00, 0x70, 0x13, 0x9d, 0x08, 
+0xca, 0x0f, 0xc6, 0x00, 0xaf, 0x00, 0x59, 0x18, 0xa4, 0x0a, 0xeb, 0x08, 
+0x82, 0x11, 0x0c, 0x01, 0xc2, 0x00, 0xcc, 0x0f, 0x34, 0x01, 0xf4, 0x09, 
+0x0d, 0x01, 0xaa, 0x00, 0xfa, 0x0b, 0xd8, 0x00, 0xee, 0x08, 0xef, 0x0e, 
+0x7a, 0x08, 0x63, 0x09, 0x38, 0x01, 0x95, 0x0f, 0xcc, 0x0c, 0x77, 0x00, 
+0x74, 0x0b, 0x26, 0x15, 0xf4, 0x06, 0x9f, 0x0c, 0x6f, 0x00, 0x0f, 0x01, 
+0xfe, 0x17, 0x16, 0x01, 0x29, 0x0c, 0x14, 0x0e, 0xee, 0x0b, 0xd5, 0x00, 
+0x38, 0x0c, 0xa1, 0x07, 0x27, 0x12, 0x44, 0x06, 0x9f, 0x00, 0xf3, 0x09, 
+0x42, 0x06, 0xac, 0x0a, 0xe8, 0x0c, 0x4b, 0x0b, 0xb3, 0x00, 0xaa, 0x00, 
+0x13, 0x07, 0x25, 0x01, 0x42, 0x06, 0x18, 0x08, 0xd4, 0x0c, 0x80, 0x0a, 
+0xc5, 0x0a, 0x1e, 0x01, 0x3b, 0x0b, 0xa2, 0x00, 0x26, 0x01, 0xf3, 0x00, 
+0x5d, 0x12, 0x2f, 0x00, 0x2b, 0x09, 0x2f, 0x01, 0xde, 0x08, 0x26, 0x00, 
+0x6a, 0x0c, 0x63, 0x0b, 0x31, 0x01, 0xf0, 0x0a, 0xc2, 0x00, 0x1a, 0x00, 
+0xae, 0x00, 0xf6, 0x00, 0x67, 0x07, 0x84, 0x00, 0x10, 0x00, 0x29, 0x00, 
+0xd9, 0x00, 0x14, 0x0b, 0xbc, 0x09, 0x87, 0x0c, 0xac, 0x0a, 0xac, 0x0c, 
+0x43, 0x06, 0xff, 0x0f, 0xe8, 0x0c, 0xd9, 0x00, 0x22, 0x09, 0xe2, 0x00, 
+0xa8, 0x00, 0xff, 0x00, 0x1d, 0x00, 0x7f, 0x0b, 0x7c, 0x10, 0xce, 0x18, 
+0x26, 0x12, 0xcc, 0x00, 0xcd, 0x15, 0xaf, 0x0b, 0x0b, 0x0a, 0xee, 0x00, 
+0x01, 0x01, 0x40, 0x06, 0x5d, 0x11, 0x01, 0x0c, 0x5a, 0x0c, 0x18, 0x0c, 
+0x2d, 0x0a, 0xb4, 0x0c, 0x0c, 0x0f, 0x29, 0x01, 0x2a, 0x0e, 0x15, 0x0b, 
+0x03, 0x01, 0x47, 0x00, 0x78, 0x08, 0x5c, 0x08, 0x27, 0x01, 0xa0, 0x15, 
+0xc5, 0x14, 0xbc, 0x07, 0x1e, 0x08, 0xa9, 0x16, 0x04, 0x0b, 0xc9, 0x0e, 
+0xb6, 0x00, 0x92, 0x12, 0x5a, 0x08, 0x8f, 0x06, 0x33, 0x15, 0x20, 0x01, 
+0x90, 0x00, 0xd1, 0x00, 0xbd, 0x14, 0xd3, 0x0a, 0x88, 0x0b, 0x1b, 0x01, 
+0x41, 0x0a, 0x3b, 0x0b, 0xa8, 0x0c, 0x48, 0x06, 0x30, 0x00, 0x4b, 0x00, 
+0xd7, 0x07, 0x24, 0x01, 0x01, 0x01, 0x28, 0x0b, 0x33, 0x00, 0xea, 0x00, 
+0xff, 0x00, 0x5f, 0x00, 0xa1, 0x06, 0xe9, 0x08, 0x9d, 0x08, 0x1a, 0x01, 
+0xba, 0x0e, 0x24, 0x09, 0x34, 0x0a, 0x93, 0x0b, 0x47, 0x06, 0xed, 0x00, 
+0x5e, 0x07, 0xa1, 0x12, 0xe4, 0x12, 0x49, 0x0a, 0x6c, 0x00, 0x35, 0x09, 
+0x1c, 0x07, 0x60, 0x00, 0xeb, 0x0b, 0xc7, 0x00, 0x91, 0x0c, 0x66, 0x11, 
+0x90, 0x00, 0x86, 0x16, 0x16, 0x01, 0x66, 0x12, 0x4d, 0x0a, 0x20, 0x00, 
+0xa6, 0x10, 0x8b, 0x07, 0xa8, 0x08, 0x9f, 0x00, 0x47, 0x00, 0x22, 0x0f, 
+0x11, 0x07, 0xb7, 0x00, 0xe7, 0x09, 0x7f, 0x0b, 0xd7, 0x00, 0xc9, 0x09, 
+0x0b, 0x01, 0xd9, 0x0a, 0xd6, 0x14, 0x5f, 0x0a, 0x89, 0x00, 0x4f, 0x0c, 
+0x54, 0x0a, 0x1f, 0x14, 0x38, 0x01, 0xe2, 0x07, 0x53, 0x00, 0xb4, 0x0b, 
+0x36, 0x08, 0x23, 0x0c, 0xfb, 0x00, 0xaf, 0x0a, 0x3c, 0x01, 0x44, 0x13, 
+0x63, 0x00, 0xc6, 0x00, 0x25, 0x0b, 0xb1, 0x00, 0xdd, 0x06, 0xe4, 0x0b, 
+0x6e, 0x00, 0x13, 0x0a, 0x92, 0x00, 0xed, 0x0e, 0x53, 0x07, 0x32, 0x0b, 
+0xd2, 0x10, 0xe4, 0x0a, 0xb8, 0x00, 0x3c, 0x0a, 0xc9, 0x0f, 0x3c, 0x0c, 
+0x0e, 0x01, 0xbb, 0x08, 0xa2, 0x0a, 0x40, 0x06, 0x6d, 0x0b, 0xa8, 0x00, 
+0xa8, 0x0a, 0xff, 0x00, 0xfb, 0x15, 0x5d, 0x0c, 0xf5, 0x00, 0xf5, 0x00, 
+0x93, 0x00, 0x2d, 0x01, 0x23, 0x08, 0x02, 0x0c, 0x4a, 0x0a, 0xf7, 0x00, 
+0xf1, 0x00, 0x94, 0x16, 0x58, 0x07, 0xfe, 0x0c, 0x57, 0x18, 0xc1, 0x00, 
+0x42, 0x01, 0x29, 0x12, 0xcd, 0x00, 0x25, 0x00, 0x4e, 0x0c, 0xa7, 0x0c, 
+0x1f, 0x16, 0xf1, 0x09, 0x05, 0x01, 0x80, 0x00, 0xfd, 0x10, 0x9d, 0x00, 
+0x31, 0x1c, 0x86, 0x0c, 0x0c, 0x0c, 0xe6, 0x07, 0x33, 0x01, 0xf5, 0x08, 
+0x2d, 0x01, 0xd6, 0x07, 0xb7, 0x0a, 0xca, 0x0a, 0x02, 0x01, 0x29, 0x0f, 
+0xe3, 0x13, 0xbf, 0x0b, 0x6a, 0x0b, 0xd4, 0x09, 0x0c, 0x01, 0x80, 0x0a, 
+0x3f, 0x0b, 0x8a, 0x00, 0x6a, 0x0b, 0x92, 0x12, 0x6b, 0x00, 0xa6, 0x0f, 
+0xd4, 0x00, 0x53, 0x14, 0xad, 0x00, 0xa9, 0x15, 0x9e, 0x17, 0xb3, 0x07, 
+0x4b, 0x0a, 0x31, 0x0b, 0xd8, 0x0e, 0xa0, 0x0b, 0x49, 0x09, 0x39, 0x0a, 
+0x4e, 0x00, 0x31, 0x01, 0xaf, 0x00, 0xb3, 0x00, 0xe1, 0x0c, 0xb3, 0x00, 
+0x4f, 0x15, 0x16, 0x0b, 0x2e, 0x08, 0x12, 0x0b, 0x2b, 0x09, 0x29, 0x0f, 
+0x1d, 0x0a, 0x4d, 0x10, 0x5f, 0x06, 0xb7, 0x00, 0x60, 0x0a, 0x44, 0x14, 
+0xbe, 0x0c, 0x48, 0x00, 0xce, 0x0a, 0xbb, 0x00, 0xd7, 0x00, 0x4b, 0x00, 
+0xe9, 0x09, 0x92, 0x0c, 0x71, 0x0c, 0x02, 0x15, 0x10, 0x00, 0xfc, 0x09, 
+0x86, 0x0c, 0x7b, 0x0b, 0xc4, 0x00, 0xca, 0x0a, 0xc3, 0x00, 0x6e, 0x00, 
+0x1d, 0x17, 0x18, 0x01, 0x98, 0x06, 0xf1, 0x00, 0x5c, 0x08, 0x28, 0x0a, 
+0xeb, 0x00, 0x77, 0x09, 0x31, 0x1c, 0x16, 0x0b, 0x18, 0x0c, 0x05, 0x01, 
+0x26, 0x00, 0x52, 0x0c, 0x7c, 0x1b, 0x87, 0x16, 0x25, 0x0c, 0x4d, 0x0e, 
+0x96, 0x0a, 0x41, 0x18, 0xd6, 0x17, 0xeb, 0x15, 0x29, 0x00, 0x6c, 0x00, 
+0x30, 0x00, 0xc4, 0x14, 0xdf, 0x0c, 0x04, 0x01, 0xd0, 0x0b, 0xaa, 0x00, 
+0xe9, 0x0c, 0x44, 0x06, 0x3f, 0x01, 0x04, 0x0b, 0xec, 0x0b, 0xfe, 0x0e, 
+0x09, 0x17, 0xda, 0x0a, 0xb5, 0x0f, 0xe8, 0x00, 0x1a, 0x01, 0xa6, 0x13, 
+0x34, 0x0a, 0x25, 0x08, 0xd2, 0x00, 0x7b, 0x00, 0x19, 0x08, 0xff, 0x18, 
+0xf6, 0x00, 0x84, 0x10, 0x04, 0x0b, 0x49, 0x00, 0x0f, 0x19, 0x0d, 0x01, 
+0x48, 0x00, 0x3a, 0x12, 0xbc, 0x00, 0xae, 0x0f, 0xc4, 0x00, 0xe2, 0x13, 
+0x9d, 0x18, 0x58, 0x0a, 0x1f, 0x07, 0x37, 0x01, 0x65, 0x0f, 0xe3, 0x0b, 
+0xfe, 0x0c, 0x22, 0x01, 0xe3, 0x00, 0xb1, 0x0a, 0xee, 0x00, 0x88, 0x0b, 
+0xdf, 0x00, 0x95, 0x0b, 0x65, 0x0c, 0x3f, 0x0f, 0xcf, 0x06, 0x6f, 0x00, 
+0x19, 0x01, 0xb1, 0x00, 0x50, 0x0c, 0xbf, 0x0b, 0xcd, 0x0a, 0xbc, 0x08, 
+0xb1, 0x0a, 0xb8, 0x00, 0x6f, 0x00, 0x5c, 0x00, 0xa1, 0x06, 0xd6, 0x15, 
+0xb0, 0x09, 0x1e, 0x15, 0xdc, 0x07, 0xc1, 0x16, 0xdf, 0x00, 0xd1, 0x06, 
+0x35, 0x0b, 0xc3, 0x0a, 0x1e, 0x15, 0xfe, 0x16, 0xda, 0x06, 0x66, 0x08, 
+0xac, 0x0a, 0x11, 0x00, 0x1c, 0x07, 0x3c, 0x00, 0x68, 0x19, 0xe7, 0x0d, 
+0x11, 0x0e, 0x43, 0x06, 0xb5, 0x0b, 0x39, 0x01, 0xbd, 0x14, 0x8b, 0x0b, 
+0xa8, 0x00, 0xe7, 0x0a, 0x53, 0x19, 0x5e, 0x0c, 0xb2, 0x15, 0x26, 0x0c, 
+0x0e, 0x0c, 0x60, 0x12, 0x20, 0x16, 0xd3, 0x00, 0x6b, 0x00, 0x28, 0x12, 
+0x60, 0x06, 0xc2, 0x17, 0x85, 0x0a, 0x17, 0x0c, 0x69, 0x0b, 0xce, 0x18, 
+0x33, 0x0c, 0x6d, 0x0a, 0x2b, 0x0a, 0xcf, 0x00, 0x30, 0x0b, 0xc9, 0x0c, 
+0x70, 0x08, 0xb4, 0x00, 0x6f, 0x09, 0xc4, 0x0e, 0xb0, 0x09, 0x86, 0x16, 
+0xcc, 0x0b, 0xdc, 0x00, 0xc0, 0x06, 0xa4, 0x09, 0x11, 0x13, 0xba, 0x09, 
+0x8c, 0x09, 0x8e, 0x06, 0xe4, 0x07, 0x57, 0x0b, 0xc2, 0x0e, 0x32, 0x08, 
+0x30, 0x07, 0x5a, 0x17, 0xcb, 0x00, 0xa1, 0x0a, 0xe7, 0x09, 0xfe, 0x09, 
+0x92, 0x00, 0xa6, 0x09, 0xe1, 0x0b, 0x67, 0x14, 0xff, 0x0c, 0xcb, 0x0b, 
+0xa4, 0x09, 0x10, 0x00, 0xe1, 0x13, 0xfc, 0x00, 0xb7, 0x0b, 0xd8, 0x0a, 
+0xba, 0x14, 0xcd, 0x0e, 0xbd, 0x00, 0x28, 0x01, 0xe3, 0x0b, 0x7e, 0x0b, 
+0xde, 0x17, 0x0a, 0x15, 0xe0, 0x0c, 0xa1, 0x0a, 0xa0, 0x0c, 0xa2, 0x0a, 
+0x30, 0x09, 0xd2, 0x11, 0x46, 0x19, 0x71, 0x00, 0xbe, 0x09, 0x41, 0x12, 
+0xaf, 0x09, 0x17, 0x0a, 0x87, 0x00, 0xb8, 0x0a, 0x47, 0x06, 0xd6, 0x10, 
+0xba, 0x06, 0x5f, 0x00, 0x41, 0x16, 0x5d, 0x12, 0xcc, 0x00, 0xe0, 0x13, 
+0xd8, 0x0a, 0x74, 0x0c, 0xbb, 0x09, 0x49, 0x0a, 0x18, 0x00, 0x8e, 0x1a, 
+0x12, 0x0d, 0xad, 0x09, 0x84, 0x0b, 0x4b, 0x11, 0x5b, 0x17, 0xfc, 0x00, 
+0xc5, 0x09, 0xbf, 0x0b, 0xa6, 0x06, 0x5e, 0x0c, 0xdd, 0x0c, 0x23, 0x08, 
+0xed, 0x0b, 0xc1, 0x00, 0xd0, 0x06, 0x1d, 0x0b, 0x05, 0x01, 0x94, 0x0b, 
+0xb1, 0x00, 0x54, 0x0a, 0x1f, 0x01, 0x37, 0x0a, 0x37, 0x0b, 0x9b, 0x0a, 
+0xc4, 0x00, 0x92, 0x17, 0xee, 0x0e, 0xe3, 0x00, 0x42, 0x06, 0x7d, 0x0c, 
+0xe5, 0x00, 0xb7, 0x11, 0xb7, 0x00, 0x65, 0x08, 0xc0, 0x0c, 0x95, 0x1a, 
+0x40, 0x0e, 0x52, 0x14, 0x43, 0x14, 0x0d, 0x0c, 0x63, 0x00, 0x3f, 0x08, 
+0x42, 0x18, 0x7d, 0x0b, 0x65, 0x0f, 0x0d, 0x01, 0x06, 0x01, 0x0e, 0x01, 
+0xe5, 0x06, 0x3d, 0x0b, 0x35, 0x0d, 0x3f, 0x01, 0xcc, 0x0b, 0x3a, 0x12, 
+0xad, 0x00, 0xb6, 0x10, 0xc8, 0x00, 0xaf, 0x0a, 0x5a, 0x0c, 0xb7, 0x0a, 
+0xe9, 0x09, 0xe6, 0x0d, 0xfa, 0x0a, 0x0b, 0x01, 0x36, 0x08, 0x06, 0x0b, 
+0x38, 0x01, 0xd8, 0x0e, 0x36, 0x07, 0x58, 0x06, 0xb7, 0x00, 0xe4, 0x0a, 
+0x70, 0x10, 0x23, 0x01, 0xba, 0x00, 0x12, 0x01, 0xde, 0x08, 0xbf, 0x0b, 
+0xe7, 0x00, 0xab, 0x0b, 0x31, 0x01, 0xec, 0x0c, 0xfc, 0x0a, 0xec, 0x06, 
+0xa0, 0x15, 0x05, 0x01, 0x43, 0x06, 0xe0, 0x0b, 0x46, 0x06, 0x57, 0x00, 
+0xae, 0x12, 0x3e, 0x0b, 0x4f, 0x19, 0x6e, 0x0a, 0xd2, 0x0c, 0x28, 0x01, 
+0xb4, 0x09, 0x49, 0x0a, 0xb9, 0x00, 0x3c, 0x0b, 0x41, 0x01, 0xe4, 0x08, 
+0x1f, 0x0a, 0xe3, 0x0a, 0xb4, 0x06, 0x14, 0x0a, 0x4f, 0x06, 0x17, 0x00, 
+0xd6, 0x15, 0x70, 0x06, 0x45, 0x06, 0x94, 0x07, 0xa6, 0x0a, 0xa0, 0x19, 
+0xfe, 0x0a, 0x08, 0x0d, 0x96, 0x0b, 0xa1, 0x00, 0x38, 0x0c, 0x37, 0x08, 
+0x27, 0x0b, 0x40, 0x01, 0x87, 0x08, 0xeb, 0x0b, 0x25, 0x13, 0x8f, 0x09, 
+0xb9, 0x10, 0x1a, 0x08, 0x19, 0x1a, 0xc3, 0x11, 0x24, 0x00, 0x4c, 0x06, 
+0xcd, 0x0a, 0xc2, 0x0e, 0x7e, 0x0b, 0x6d, 0x00, 0xc2, 0x0a, 0x05, 0x0b, 
+0xd6, 0x00, 0x2c, 0x01, 0x27, 0x01, 0x18, 0x01, 0x68, 0x08, 0x70, 0x12, 
+0xb0, 0x00, 0xc8, 0x1b, 0x97, 0x0b, 0x80, 0x09, 0x0f, 0x0b, 0x88, 0x00, 
+0xce, 0x00, 0xd4, 0x00, 0x27, 0x10, 0xdf, 0x08, 0xd5, 0x11, 0x1f, 0x16, 
+0xda, 0x10, 0x9d, 0x17, 0xe1, 0x00, 0x7f, 0x0c, 0x1d, 0x0c, 0x42, 0x13, 
+0x60, 0x06, 0xd1, 0x0a, 0xe6, 0x0b, 0xa3, 0x08, 0x9b, 0x0c, 0xaa, 0x07, 
+0xbd, 0x11, 0x19, 0x08, 0x93, 0x0e, 0x0c, 0x15, 0x85, 0x00, 0x1c, 0x0c, 
+0xcb, 0x00, 0x00, 0x0a, 0xe8, 0x09, 0x2e, 0x01, 0xc9, 0x0c, 0x4a, 0x06, 
+0xa2, 0x0b, 0xe4, 0x0a, 0xb8, 0x00, 0xf9, 0x0a, 0x57, 0x06, 0x52, 0x17, 
+0x2b, 0x01, 0x01, 0x0a, 0x37, 0x01, 0x51, 0x00, 0xe3, 0x0b, 0xa9, 0x15, 
+0xf0, 0x0b, 0xf1, 0x00, 0xf4, 0x0c, 0xd3, 0x0a, 0x38, 0x01, 0xd0, 0x18, 
+0x07, 0x01, 0xe5, 0x00, 0x14, 0x0a, 0x8e, 0x0a, 0xf2, 0x00, 0xf8, 0x0b, 
+0xe2, 0x0b, 0xcd, 0x00, 0xa7, 0x08, 0xf7, 0x0b, 0x03, 0x01, 0x1e, 0x00, 
+0x90, 0x0c, 0xdc, 0x09, 0x0a, 0x16, 0xa9, 0x0c, 0x26, 0x07, 0xa9, 0x08, 
+0x5c, 0x0b, 0xe2, 0x00, 0x15, 0x01, 0x4a, 0x00, 0xdb, 0x0a, 0x98, 0x0b, 
+0xe2, 0x00, 0x3f, 0x0a, 0x74, 0x08, 0x83, 0x11, 0x2d, 0x01, 0xea, 0x13, 
+0x95, 0x07, 0x92, 0x13, 0xd6, 0x00, 0xf3, 0x13, 0x10, 0x0b, 0xc6, 0x09, 
+0x27, 0x01, 0xb9, 0x0e, 0xed, 0x07, 0xb0, 0x0a, 0xd3, 0x0c, 0x9c, 0x0f, 
+0xa8, 0x08, 0x96, 0x0a, 0x37, 0x1c, 0xeb, 0x16, 0x02, 0x15, 0x4a, 0x0a, 
+0x26, 0x00, 0xc4, 0x00, 0x95, 0x00, 0x3a, 0x01, 0xbe, 0x0c, 0x21, 0x01, 
+0x97, 0x13, 0x40, 0x0a, 0xc8, 0x00, 0xbc, 0x00, 0x04, 0x0b, 0xe4, 0x11, 
+0x56, 0x1a, 0xd3, 0x0c, 0x5c, 0x08, 0x27, 0x01, 0xac, 0x0b, 0xea, 0x13, 
+0x85, 0x00, 0x68, 0x07, 0x83, 0x00, 0xe1, 0x00, 0x36, 0x0d, 0xf7, 0x00, 
+0x90, 0x10, 0x6a, 0x0a, 0x1b, 0x0b, 0x65, 0x15, 0x81, 0x18, 0xe6, 0x09, 
+0x35, 0x01, 0xdc, 0x00, 0x29, 0x01, 0x16, 0x01, 0xb0, 0x09, 0xf1, 0x16, 
+0x42, 0x06, 0x25, 0x0b, 0x23, 0x00, 0x17, 0x0c, 0x18, 0x01, 0x97, 0x0a, 
+0x1c, 0x0b, 0x26, 0x12, 0xf4, 0x09, 0x25, 0x00, 0xde, 0x0c, 0x00, 0x0a, 
+0x54, 0x0b, 0x4a, 0x0a, 0xbb, 0x14, 0xe7, 0x00, 0x95, 0x09, 0x77, 0x00, 
+0xc6, 0x00, 0x05, 0x09, 0x6a, 0x15, 0xb6, 0x10, 0x72, 0x0b, 0xc2, 0x00, 
+0x9e, 0x00, 0xe8, 0x09, 0x5f, 0x0a, 0x60, 0x00, 0xe6, 0x00, 0xa8, 0x00, 
+0xc4, 0x00, 0x4b, 0x0b, 0xce, 0x06, 0xcc, 0x00, 0xe4, 0x0b, 0x54, 0x11, 
+0xe7, 0x00, 0x2a, 0x01, 0x0b, 0x00, 0xbb, 0x00, 0xea, 0x0c, 0x2d, 0x01, 
+0xfa, 0x15, 0x11, 0x0d, 0x01, 0x07, 0x0f, 0x0b, 0x7d, 0x0b, 0xfa, 0x00, 
+0xdb, 0x00, 0x1f, 0x14, 0xb4, 0x09, 0x06, 0x13, 0x1e, 0x14, 0x18, 0x00, 
+0x08, 0x0a, 0x05, 0x0b, 0x50, 0x00, 0xa3, 0x0a, 0x94, 0x0d, 0x6e, 0x0a, 
+0xb4, 0x0b, 0x93, 0x0c, 0xeb, 0x0b, 0x2e, 0x08, 0xa9, 0x0b, 0x3c, 0x00, 
+0xf6, 0x00, 0x7f, 0x0b, 0xae, 0x0a, 0x07, 0x01, 0xc5, 0x00, 0x02, 0x15, 
+0x42, 0x00, 0x4d, 0x0a, 0xac, 0x13, 0xc7, 0x09, 0x30, 0x0a, 0xab, 0x07, 
+0x93, 0x0f, 0xea, 0x13, 0xd4, 0x00, 0x2f, 0x00, 0x0a, 0x01, 0x0e, 0x13, 
+0x56, 0x0a, 0x30, 0x0a, 0xfc, 0x00, 0x19, 0x01, 0xb7, 0x0b, 0xfd, 0x10, 
+0x5d, 0x12, 0x6a, 0x19, 0x36, 0x0b, 0xf2, 0x0a, 0xdc, 0x00, 0xff, 0x0f, 
+0xd9, 0x0b, 0x12, 0x01, 0x09, 0x16, 0x6e, 0x00, 0x49, 0x06, 0xc9, 0x0c, 
+0xe4, 0x0a, 0xf3, 0x0c, 0x3e, 0x0f, 0x23, 0x00, 0x4c, 0x06, 0x83, 0x0c, 
+0x58, 0x17, 0xaf, 0x00, 0x64, 0x09, 0x18, 0x00, 0xb5, 0x00, 0x79, 0x00, 
+0xec, 0x0c, 0x11, 0x00, 0x20, 0x13, 0xac, 0x09, 0xbb, 0x09, 0xa5, 0x09, 
+0x36, 0x09, 0x49, 0x0a, 0x31, 0x12, 0x4c, 0x11, 0xfc, 0x00, 0x88, 0x09, 
+0x40, 0x01, 0x65, 0x0c, 0xa1, 0x15, 0x8a, 0x0b, 0x1e, 0x13, 0x41, 0x01, 
+0x1e, 0x15, 0x8f, 0x00, 0x17, 0x0b, 0x59, 0x00, 0x40, 0x06, 0x33, 0x01, 
+0xe5, 0x00, 0x20, 0x01, 0xef, 0x0d, 0x48, 0x06, 0xb3, 0x0c, 0x08, 0x01, 
+0xf8, 0x0b, 0xea, 0x08, 0x23, 0x00, 0xf3, 0x00, 0xab, 0x08, 0x29, 0x00, 
+0xec, 0x00, 0x1d, 0x0a, 0x10, 0x13, 0xf2, 0x18, 0xf3, 0x0e, 0x28, 0x0b, 
+0x2e, 0x01, 0x01, 0x0c, 0x68, 0x0c, 0xcd, 0x00, 0x23, 0x08, 0xaa, 0x07, 
+0xf5, 0x0d, 0x6f, 0x0e, 0x49, 0x06, 0x3b, 0x13, 0xe3, 0x1a, 0x23, 0x0e, 
+0x64, 0x09, 0xea, 0x00, 0xa3, 0x0a, 0x9e, 0x18, 0x1e, 0x14, 0x62, 0x00, 
+0x7e, 0x0b, 0x78, 0x0a, 0xf6, 0x0b, 0x3b, 0x00, 0x68, 0x07, 0x34, 0x18, 
+0xa3, 0x0a, 0x3e, 0x08, 0x1a, 0x0c, 0xc0, 0x0a, 0xfd, 0x08, 0x96, 0x17, 
+0x61, 0x0c, 0xf5, 0x0c, 0xcb, 0x0b, 0xe3, 0x0b, 0x60, 0x00, 0x95, 0x0c, 
+0x0b, 0x00, 0xf1, 0x09, 0xa7, 0x00, 0x3f, 0x01, 0x2c, 0x09, 0x4d, 0x1a, 
+0x81, 0x00, 0x42, 0x00, 0xe4, 0x17, 0xe2, 0x08, 0xd3, 0x0a, 0xe3, 0x17, 
+0x2e, 0x0a, 0x02, 0x0c, 0xde, 0x14, 0x1f, 0x01, 0x1f, 0x0b, 0x5a, 0x0c, 
+0xb6, 0x08, 0xab, 0x06, 0x49, 0x06, 0x96, 0x07, 0x09, 0x01, 0xbb, 0x09, 
+0xe8, 0x00, 0x57, 0x0a, 0x07, 0x18, 0xcd, 0x15, 0x02, 0x13, 0x32, 0x0b, 
+0x73, 0x0b, 0xf6, 0x0b, 0x43, 0x13, 0x4a, 0x00, 0x17, 0x01, 0x31, 0x0a, 
+0xd5, 0x0c, 0x43, 0x0a, 0x5f, 0x00, 0x15, 0x07, 0x0e, 0x0c, 0x2b, 0x01, 
+0x12, 0x0a, 0x62, 0x00, 0x5c, 0x0b, 0xb6, 0x11, 0xf4, 0x00, 0x35, 0x0b, 
+0x99, 0x15, 0x31, 0x01, 0xac, 0x00, 0x1c, 0x07, 0x85, 0x0c, 0x56, 0x18, 
+0xe3, 0x00, 0xe7, 0x0b, 0x4a, 0x00, 0x4e, 0x00, 0x38, 0x0a, 0xe8, 0x06, 
+0xd3, 0x00, 0x86, 0x16, 0xe8, 0x00, 0x01, 0x0d, 0x3a, 0x12, 0xb6, 0x00, 
+0x11, 0x0b, 0xc1, 0x00, 0x18, 0x00, 0x1d, 0x01, 0x7b, 0x0c, 0x55, 0x07, 
+0xab, 0x16, 0xbe, 0x0c, 0x32, 0x18, 0xee, 0x00, 0xcf, 0x14, 0xdf, 0x00, 
+0x98, 0x0a, 0xae, 0x00, 0xb4, 0x00, 0x69, 0x0c, 0xe8, 0x00, 0x32, 0x0b, 
+0xe1, 0x07, 0xfb, 0x00, 0x26, 0x09, 0xe3, 0x14, 0x5f, 0x11, 0x66, 0x11, 
+0x52, 0x07, 0x26, 0x0b, 0xcf, 0x00, 0xb4, 0x00, 0xf2, 0x00, 0x92, 0x00, 
+0xc2, 0x00, 0xc9, 0x00, 0xd8, 0x00, 0x4a, 0x13, 0x31, 0x0c, 0x27, 0x00, 
+0x0a, 0x01, 0xa6, 0x0c, 0x0e, 0x00, 0xd0, 0x09, 0xb3, 0x17, 0x41, 0x01, 
+0xbc, 0x00, 0xd5, 0x00, 0x2f, 0x13, 0x1a, 0x00, 0x31, 0x0b, 0x26, 0x01, 
+0xc6, 0x14, 0x56, 0x00, 0x08, 0x01, 0x00, 0x14, 0x3d, 0x00, 0x67, 0x0b, 
+0xc3, 0x0b, 0x10, 0x0b, 0xcd, 0x0a, 0x0f, 0x13, 0x1b, 0x08, 0x2d, 0x01, 
+0x9f, 0x1b, 0x30, 0x00, 0x2e, 0x09, 0xb5, 0x09, 0x75, 0x0a, 0x5d, 0x0c, 
+0xd6, 0x16, 0x44, 0x0b, 0x40, 0x0a, 0xad, 0x00, 0xf5, 0x09, 0xf0, 0x0a, 
+0x3a, 0x0a, 0x79, 0x07, 0xa2, 0x0b, 0x7b, 0x10, 0x42, 0x0a, 0x96, 0x10, 
+0x24, 0x0c, 0x9f, 0x0f, 0xca, 0x0e, 0x7f, 0x07, 0xc0, 0x0e, 0x47, 0x00, 
+0x6d, 0x14, 0x1f, 0x01, 0x34, 0x0a, 0x2f, 0x00, 0x5e, 0x0b, 0x21, 0x01, 
+0xbb, 0x00, 0xa8, 0x0c, 0xd5, 0x00, 0xa0, 0x08, 0x93, 0x0f, 0x91, 0x00, 
+0x94, 0x0f, 0xaf, 0x0a, 0xbd, 0x14, 0x28, 0x01, 0x7d, 0x0b, 0x8d, 0x0d, 
+0x00, 0x01, 0xaf, 0x12, 0xf1, 0x00, 0x26, 0x00, 0x10, 0x01, 0x69, 0x06, 
+0x10, 0x01, 0xb5, 0x1b, 0xa4, 0x09, 0x81, 0x08, 0x73, 0x0b, 0xcc, 0x00, 
+0x7b, 0x00, 0x81, 0x11, 0xef, 0x0e, 0xa7, 0x0a, 0x96, 0x07, 0x04, 0x01, 
+0x23, 0x0c, 0x66, 0x1b, 0x15, 0x07, 0xab, 0x00, 0xcc, 0x00, 0x28, 0x01, 
+0x03, 0x01, 0xde, 0x13, 0x20, 0x0a, 0x56, 0x00, 0x1b, 0x00, 0xb5, 0x00, 
+0xa7, 0x00, 0xcf, 0x00, 0xa3, 0x07, 0x4f, 0x17, 0x81, 0x10, 0xae, 0x0b, 
+0xab, 0x0b, 0x7a, 0x00, 0xf2, 0x09, 0xe4, 0x00, 0x68, 0x07, 0xd1, 0x00, 
+0xc1, 0x0e, 0x49, 0x00, 0x35, 0x0a, 0x36, 0x08, 0x01, 0x0c, 0xa5, 0x0f, 
+0x7e, 0x0c, 0x60, 0x06, 0xc2, 0x0b, 0x24, 0x09, 0x00, 0x01, 0xa8, 0x0c, 
+0x82, 0x0c, 0x9e, 0x00, 0xb8, 0x0a, 0x65, 0x11, 0xdc, 0x0d, 0xe5, 0x06, 
+0xe2, 0x00, 0x58, 0x07, 0x01, 0x0c, 0x60, 0x06, 0x03, 0x0c, 0x33, 0x15, 
+0xb0, 0x00, 0x63, 0x17, 0x71, 0x08, 0x30, 0x0b, 0x1b, 0x01, 0x26, 0x01, 
+0x2c, 0x09, 0x30, 0x01, 0x08, 0x01, 0x11, 0x0d, 0x6d, 0x00, 0x8b, 0x0b, 
+0x6c, 0x00, 0xf3, 0x0c, 0x96, 0x0b, 0x36, 0x10, 0x5d, 0x12, 0x71, 0x07, 
+0xd9, 0x00, 0x10, 0x13, 0x25, 0x01, 0x02, 0x13, 0xc0, 0x0e, 0xe1, 0x00, 
+0x6e, 0x10, 0x8e, 0x16, 0x06, 0x13, 0x6f, 0x09, 0x7e, 0x0b, 0x13, 0x0b, 
+0xc3, 0x0e, 0xc1, 0x17, 0xef, 0x0a, 0x3f, 0x01, 0xdf, 0x09, 0xf3, 0x00, 
+0xb3, 0x00, 0x51, 0x0b, 0x7b, 0x00, 0x36, 0x01, 0x32, 0x01, 0xcc, 0x09, 
+0x7b, 0x0c, 0xe6, 0x09, 0xd8, 0x00, 0xf6, 0x0b, 0xc5, 0x1a, 0x6e, 0x14, 
+0x22, 0x01, 0x68, 0x08, 0x10, 0x01, 0x23, 0x01, 0x0f, 0x00, 0xcd, 0x0c, 
+0xcc, 0x00, 0x2a, 0x18, 0x12, 0x0b, 0x97, 0x06, 0xe2, 0x0b, 0x2e, 0x01, 
+0x44, 0x06, 0x39, 0x0c, 0x93, 0x0b, 0xac, 0x00, 0x06, 0x01, 0x22, 0x0f, 
+0x40, 0x06, 0x80, 0x0e, 0x9f, 0x07, 0xe4, 0x06, 0x49, 0x06, 0x64, 0x0c, 
+0xbb, 0x09, 0xef, 0x0a, 0xdd, 0x00, 0x33, 0x01, 0x22, 0x0c, 0xb4, 0x07, 
+0x48, 0x00, 0xb8, 0x00, 0xcf, 0x00, 0x45, 0x06, 0x02, 0x0d, 0x05, 0x01, 
+0x8f, 0x0b, 0x19, 0x01, 0xb1, 0x1a, 0xe6, 0x0a, 0x12, 0x01, 0x9f, 0x16, 
+0x77, 0x0a, 0x17, 0x00, 0x89, 0x00, 0x69, 0x1b, 0xb6, 0x00, 0x9c, 0x0f, 
+0xc5, 0x09, 0xf4, 0x0a, 0xb9, 0x0a, 0xf1, 0x00, 0x46, 0x06, 0xe6, 0x00, 
+0x27, 0x1a, 0x4d, 0x11, 0xcc, 0x09, 0xd3, 0x19, 0x0d, 0x01, 0xf9, 0x0b, 
+0x9b, 0x00, 0xf3, 0x0b, 0x10, 0x00, 0x23, 0x1b, 0x38, 0x0b, 0xb0, 0x0a, 
+0x80, 0x16, 0xd9, 0x00, 0xe6, 0x06, 0xb5, 0x0b, 0xbe, 0x00, 0xbc, 0x0a, 
+0x17, 0x00, 0x19, 0x00, 0x5d, 0x07, 0x1b, 0x01, 0x88, 0x17, 0x7a, 0x0c, 
+0x52, 0x0b, 0x1e, 0x01, 0x88, 0x0c, 0x40, 0x08, 0xd4, 0x09, 0xce, 0x0b, 
+0xd0, 0x09, 0xdd, 0x00, 0x92, 0x0a, 0x49, 0x00, 0x32, 0x18, 0x8a, 0x00, 
+0xdc, 0x0d, 0xeb, 0x0c, 0xb6, 0x1c, 0x69, 0x0e, 0xcd, 0x14, 0xee, 0x0c, 
+0x38, 0x0c, 0xec, 0x06, 0xe5, 0x0a, 0xd0, 0x06, 0xd1, 0x00, 0x96, 0x0a, 
+0xc0, 0x00, 0xaf, 0x00, 0xeb, 0x0c, 0x13, 0x01, 0xc0, 0x13, 0x30, 0x0c, 
+0x88, 0x0b, 0x7b, 0x0c, 0xbc, 0x0c, 0xcb, 0x0b, 0x22, 0x01, 0x31, 0x00, 
+0xb0, 0x09, 0xd4, 0x00, 0x7b, 0x0c, 0xd6, 0x0b, 0x4f, 0x17, 0x15, 0x13, 
+0xca, 0x0b, 0x85, 0x07, 0x2a, 0x18, 0x5e, 0x07, 0x0c, 0x01, 0xdb, 0x0d, 
+0x27, 0x01, 0xa8, 0x00, 0xef, 0x0d, 0xd9, 0x0b, 0x80, 0x0a, 0x3f, 0x0a, 
+0x1e, 0x13, 0xb8, 0x0d, 0x27, 0x13, 0x60, 0x00, 0x22, 0x09, 0xf5, 0x00, 
+0x31, 0x0c, 0x13, 0x01, 0xba, 0x14, 0x54, 0x07, 0x04, 0x0c, 0xb6, 0x00, 
+0xd0, 0x00, 0xef, 0x00, 0x36, 0x00, 0x22, 0x0c, 0xa0, 0x07, 0x7a, 0x0b, 
+0x58, 0x00, 0x80, 0x08, 0x96, 0x0f, 0xb7, 0x0d, 0xe8, 0x09, 0x4d, 0x18, 
+0xe1, 0x00, 0xbb, 0x0f, 0xa8, 0x11, 0x70, 0x1a, 0x40, 0x01, 0xa5, 0x09, 
+0x20, 0x09, 0x6d, 0x00, 0xe2, 0x08, 0x10, 0x07, 0xb0, 0x09, 0x21, 0x09, 
+0x79, 0x00, 0xfd, 0x00, 0x41, 0x06, 0xf7, 0x0e, 0xd1, 0x0a, 0xb1, 0x00, 
+0x69, 0x13, 0x0f, 0x00, 0x73, 0x0b, 0x78, 0x08, 0x34, 0x01, 0x3f, 0x00, 
+0xcf, 0x00, 0x22, 0x09, 0x4a, 0x00, 0xd2, 0x11, 0x6b, 0x0b, 0xb3, 0x0c, 
+0xc7, 0x0a, 0xb6, 0x0b, 0xd2, 0x00, 0x2e, 0x01, 0xb7, 0x00, 0xbd, 0x00, 
+0xcb, 0x00, 0xbc, 0x09, 0xc4, 0x0a, 0x87, 0x08, 0x93, 0x0b, 0xdf, 0x13, 
+0x3c, 0x01, 0x1f, 0x01, 0xff, 0x0d, 0x71, 0x09, 0x93, 0x0b, 0x38, 0x14, 
+0x8e, 0x0a, 0x78, 0x00, 0x3c, 0x00, 0xb4, 0x00, 0xba, 0x0b, 0xa9, 0x00, 
+0xcc, 0x0b, 0x60, 0x06, 0x9d, 0x0c, 0x9d, 0x08, 0x8d, 0x0e, 0x0c, 0x09, 
+0x67, 0x18, 0x1e, 0x17, 0xb4, 0x08, 0x6b, 0x18, 0xeb, 0x16, 0x1d, 0x0b, 
+0xef, 0x0d, 0x3e, 0x01, 0x5a, 0x08, 0xd0, 0x00, 0xe6, 0x00, 0x63, 0x00, 
+0x07, 0x0b, 0x1b, 0x0b, 0xf5, 0x00, 0xf6, 0x08, 0x40, 0x00, 0xeb, 0x07, 
+0x68, 0x0b, 0xa3, 0x0a, 0xb3, 0x16, 0xdb, 0x00, 0x3d, 0x00, 0x3f, 0x01, 
+0x11, 0x00, 0x22, 0x0c, 0x51, 0x0b, 0x18, 0x01, 0x34, 0x01, 0x38, 0x01, 
+0x1c, 0x0c, 0x40, 0x0a, 0xcf, 0x0a, 0xcf, 0x08, 0x07, 0x09, 0x1b, 0x01, 
+0x0b, 0x01, 0xd4, 0x1c, 0x4a, 0x13, 0x81, 0x0a, 0xfa, 0x00, 0xf5, 0x00, 
+0xe2, 0x07, 0x32, 0x01, 0x6a, 0x0a, 0x82, 0x11, 0x59, 0x0c, 0xfe, 0x0c, 
+0x95, 0x0b, 0x0b, 0x0f, 0x56, 0x0a, 0x08, 0x0a, 0x58, 0x0a, 0xba, 0x08, 
+0xce, 0x00, 0x5d, 0x07, 0x38, 0x0c, 0xaf, 0x10, 0xd5, 0x0b, 0x2c, 0x01, 
+0xc7, 0x00, 0xed, 0x07, 0x17, 0x01, 0x43, 0x06, 0xcb, 0x0b, 0x0c, 0x0c, 
+0xe3, 0x0b, 0xc9, 0x09, 0xe4, 0x00, 0x18, 0x0c, 0xb8, 0x00, 0x15, 0x01, 
+0x47, 0x09, 0x22, 0x01, 0x24, 0x01, 0xe4, 0x09, 0xaa, 0x07, 0x5e, 0x15, 
+0xbb, 0x17, 0x39, 0x12, 0x0d, 0x00, 0x8f, 0x00, 0x8f, 0x0a, 0xd2, 0x06, 
+0x28, 0x00, 0xd9, 0x07, 0xf4, 0x0a, 0xf6, 0x0c, 0xd6, 0x07, 0xb3, 0x06, 
+0x9f, 0x00, 0x0c, 0x00, 0x81, 0x16, 0x11, 0x09, 0x85, 0x07, 0x01, 0x09, 
+0x6a, 0x06, 0xd3, 0x00, 0x0d, 0x01, 0x7a, 0x00, 0xc7, 0x14, 0xef, 0x0a, 
+0xbd, 0x0c, 0x1e, 0x0a, 0x75, 0x0a, 0x3c, 0x01, 0x2e, 0x14, 0xe6, 0x00, 
+0xe2, 0x07, 0x2b, 0x01, 0x85, 0x00, 0xf9, 0x0a, 0xb8, 0x0e, 0x30, 0x08, 
+0x35, 0x01, 0x74, 0x0b, 0x32, 0x01, 0xd9, 0x06, 0x61, 0x00, 0xea, 0x0c, 
+0xc8, 0x0c, 0x07, 0x0a, 0x1d, 0x07, 0x72, 0x0b, 0xd6, 0x0b, 0x22, 0x1a, 
+0xe7, 0x09, 0x56, 0x18, 0xe2, 0x00, 0x08, 0x01, 0xc1, 0x0c, 0x35, 0x0d, 
+0x72, 0x16, 0xeb, 0x07, 0x06, 0x01, 0x26, 0x01, 0x41, 0x09, 0xa3, 0x0a, 
+0xd0, 0x00, 0x62, 0x1b, 0xef, 0x00, 0x12, 0x0e, 0xc9, 0x09, 0x8d, 0x06, 
+0x9f, 0x0b, 0x12, 0x0a, 0x10, 0x0b, 0xda, 0x06, 0x0e, 0x13, 0xa6, 0x10, 
+0x12, 0x0a, 0x0a, 0x0a, 0xfe, 0x0e, 0xaf, 0x0a, 0x12, 0x0d, 0x92, 0x10, 
+0x2f, 0x01, 0x5f, 0x0a, 0x1d, 0x01, 0x79, 0x00, 0x47, 0x00, 0x23, 0x09, 
+0x76, 0x0b, 0x1b, 0x08, 0xa7, 0x10, 0x6f, 0x0e, 0xa6, 0x0c, 0x88, 0x0b, 
+0x52, 0x07, 0x53, 0x07, 0x5f, 0x18, 0x2e, 0x14, 0x59, 0x0c, 0xee, 0x0b, 
+0xc8, 0x00, 0x92, 0x0c, 0x8a, 0x1b, 0x1a, 0x0b, 0xd1, 0x00, 0x67, 0x07, 
+0xd9, 0x06, 0x57, 0x14, 0x3c, 0x00, 0xd1, 0x06, 0x27, 0x00, 0x1a, 0x00, 
+0x7b, 0x00, 0x41, 0x00, 0xda, 0x06, 0xc6, 0x00, 0xe4, 0x0b, 0xef, 0x00, 
+0x40, 0x01, 0x34, 0x0a, 0xba, 0x09, 0xd3, 0x00, 0xbc, 0x14, 0xc8, 0x0c, 
+0xf7, 0x0d, 0xc5, 0x09, 0x83, 0x10, 0x34, 0x0a, 0x38, 0x0c, 0x3f, 0x0a, 
+0xcb, 0x0b, 0x9b, 0x0c, 0xe0, 0x0b, 0x85, 0x0c, 0x99, 0x1b, 0xaa, 0x00, 
+0x06, 0x01, 0x73, 0x0b, 0x16, 0x01, 0x12, 0x11, 0x6f, 0x00, 0xb2, 0x00, 
+0x78, 0x06, 0x98, 0x0a, 0x5d, 0x12, 0xd7, 0x0c, 0xe1, 0x00, 0xb0, 0x00, 
+0x66, 0x18, 0xca, 0x00, 0x64, 0x00, 0xc2, 0x0a, 0x66, 0x00, 0x08, 0x0b, 
+0xd6, 0x07, 0xfa, 0x00, 0x92, 0x00, 0xc4, 0x00, 0x63, 0x0a, 0xd4, 0x09, 
+0x87, 0x0c, 0x4d, 0x06, 0x92, 0x0c, 0xfd, 0x15, 0xce, 0x00, 0x76, 0x0b, 
+0x78, 0x00, 0x30, 0x0b, 0xdb, 0x00, 0xfb, 0x00, 0x8a, 0x12, 0xb6, 0x0e, 
+0xdf, 0x00, 0xc2, 0x00, 0xef, 0x00, 0x55, 0x0b, 0xfa, 0x00, 0x6e, 0x10, 
+0xe2, 0x08, 0xb9, 0x0b, 0x90, 0x06, 0x20, 0x1a, 0xf7, 0x0b, 0xb3, 0x0c, 
+0xa6, 0x09, 0x73, 0x0c, 0x6e, 0x09, 0xcb, 0x00, 0xf0, 0x00, 0x80, 0x09, 
+0xa3, 0x0b, 0x55, 0x11, 0x5c, 0x0b, 0x9d, 0x08, 0x47, 0x19, 0x82, 0x10, 
+0x46, 0x09, 0x91, 0x0c, 0x09, 0x01, 0x4e, 0x0a, 0xc8, 0x14, 0xe0, 0x0b, 
+0x17, 0x01, 0x6b, 0x00, 0xde, 0x08, 0xdc, 0x0a, 0xb7, 0x00, 0xbc, 0x0c, 
+0x6b, 0x08, 0x4a, 0x0b, 0xb3, 0x00, 0x4e, 0x17, 0x9f, 0x0b, 0xe2, 0x00, 
+0x3a, 0x16, 0xd8, 0x0a, 0x83, 0x09, 0x7b, 0x00, 0x9e, 0x08, 0x5c, 0x00, 
+0x1b, 0x0b, 0x5e, 0x0b, 0x93, 0x00, 0x1b, 0x01, 0xac, 0x0a, 0x9d, 0x0a, 
+0x6a, 0x0a, 0x08, 0x0d, 0x7d, 0x16, 0x83, 0x09, 0x93, 0x13, 0x87, 0x00, 
+0x3c, 0x00, 0xc1, 0x0b, 0x61, 0x1a, 0x77, 0x06, 0xe2, 0x13, 0x3a, 0x1a, 
+0xd3, 0x0c, 0xf9, 0x00, 0x2a, 0x01, 0x4b, 0x0b, 0x22, 0x01, 0x39, 0x08, 
+0x79, 0x10, 0x29, 0x0a, 0x64, 0x09, 0xca, 0x0f, 0x8f, 0x00, 0xae, 0x0b, 
+0xde, 0x15, 0xd8, 0x0a, 0xfb, 0x00, 0xdc, 0x00, 0xdf, 0x0c, 0x30, 0x07, 
+0xc4, 0x14, 0x96, 0x07, 0x8d, 0x00, 0xa8, 0x09, 0x7b, 0x17, 0x4f, 0x0c, 
+0xf8, 0x0b, 0xac, 0x00, 0xe9, 0x1a, 0x2b, 0x01, 0xd5, 0x00, 0xb8, 0x0a, 
+0x01, 0x17, 0x5a, 0x08, 0x42, 0x12, 0x44, 0x06, 0x39, 0x01, 0x83, 0x11, 
+0x1f, 0x16, 0x9d, 0x00, 0x45, 0x06, 0x75, 0x0a, 0x92, 0x0f, 0x30, 0x0b, 
+0x49, 0x0a, 0x1b, 0x0b, 0x37, 0x18, 0xc7, 0x0a, 0xb1, 0x00, 0xfe, 0x00, 
+0x52, 0x13, 0x28, 0x12, 0xed, 0x00, 0xc0, 0x00, 0xa6, 0x10, 0x3f, 0x01, 
+0xde, 0x0d, 0x1c, 0x0b, 0x9b, 0x00, 0xa0, 0x0b, 0xbe, 0x0c, 0xd2, 0x0e, 
+0xd9, 0x15, 0x60, 0x0a, 0x35, 0x01, 0x5a, 0x0a, 0x51, 0x0c, 0x01, 0x0c, 
+0xe7, 0x0d, 0x94, 0x0b, 0x8f, 0x06, 0xa0, 0x00, 0xf0, 0x15, 0xc2, 0x0a, 
+0xac, 0x00, 0x1e, 0x01, 0xf2, 0x00, 0x77, 0x09, 0x0a, 0x01, 0x4a, 0x0a, 
+0xad, 0x0b, 0x44, 0x06, 0xf1, 0x00, 0xc7, 0x00, 0xb7, 0x0c, 0x33, 0x00, 
+0x27, 0x15, 0x83, 0x00, 0xc5, 0x0a, 0xf5, 0x0c, 0xa7, 0x08, 0x4e, 0x12, 
+0x81, 0x10, 0xfa, 0x00, 0xcd, 0x0e, 0x5f, 0x18, 0xe0, 0x00, 0xcf, 0x00, 
+0x70, 0x10, 0x06, 0x0f, 0x79, 0x00, 0x3b, 0x0c, 0x97, 0x0a, 0x19, 0x00, 
+0x07, 0x01, 0xee, 0x07, 0xbb, 0x00, 0x65, 0x11, 0xb9, 0x0a, 0x2b, 0x01, 
+0x82, 0x09, 0xbb, 0x09, 0x0a, 0x01, 0x30, 0x0c, 0x17, 0x1a, 0x5c, 0x17, 
+0x37, 0x01, 0xfb, 0x00, 0x33, 0x01, 0x43, 0x0c, 0xde, 0x13, 0x6f, 0x00, 
+0xe7, 0x00, 0x44, 0x0c, 0x9c, 0x00, 0xa1, 0x07, 0x69, 0x17, 0xbb, 0x0f, 
+0x3a, 0x08, 0x26, 0x14, 0x46, 0x06, 0x74, 0x16, 0x2c, 0x09, 0xc7, 0x09, 
+0x04, 0x08, 0xbc, 0x09, 0x99, 0x0a, 0x39, 0x08, 0x30, 0x12, 0x97, 0x07, 
+0x6a, 0x0d, 0x7f, 0x0b, 0x6b, 0x0a, 0xc9, 0x09, 0xcb, 0x0c, 0x0d, 0x00, 
+0x0c, 0x01, 0x41, 0x06, 0x5c, 0x0c, 0x55, 0x06, 0x71, 0x0c, 0xba, 0x00, 
+0x3b, 0x0b, 0x15, 0x01, 0xe2, 0x08, 0x3d, 0x00, 0xec, 0x00, 0xa2, 0x00, 
+0x06, 0x01, 0xf7, 0x00, 0xd3, 0x06, 0x64, 0x09, 0x0a, 0x01, 0x18, 0x08, 
+0x69, 0x0e, 0x16, 0x13, 0x6b, 0x0b, 0x1c, 0x07, 0xde, 0x13, 0xb1, 0x08, 
+0x83, 0x14, 0x11, 0x13, 0x9b, 0x0c, 0x18, 0x01, 0xdb, 0x09, 0x12, 0x01, 
+0xe2, 0x0b, 0xe6, 0x0a, 0x09, 0x01, 0x0e, 0x00, 0xa5, 0x09, 0xf8, 0x00, 
+0xcb, 0x00, 0x25, 0x00, 0xf3, 0x00, 0x1e, 0x01, 0x0c, 0x01, 0x28, 0x12, 
+0x43, 0x06, 0xcd, 0x0a, 0x97, 0x15, 0x05, 0x0b, 0x08, 0x0a, 0x10, 0x07, 
+0xd2, 0x10, 0xd6, 0x00, 0xa2, 0x0e, 0x02, 0x01, 0x54, 0x0c, 0x18, 0x00, 
+0x85, 0x00, 0x33, 0x01, 0xe0, 0x0b, 0x07, 0x10, 0x33, 0x18, 0xe6, 0x00, 
+0x8f, 0x00, 0xe8, 0x00, 0x9b, 0x0c, 0x1a, 0x0b, 0xf2, 0x14, 0x50, 0x0a, 
+0x1e, 0x0a, 0x08, 0x01, 0xa8, 0x08, 0x4f, 0x17, 0x55, 0x06, 0x5f, 0x12, 
+0xee, 0x0d, 0xc2, 0x00, 0x49, 0x06, 0x6b, 0x0a, 0x89, 0x0b, 0x89, 0x00, 
+0x7f, 0x16, 0xc2, 0x08, 0x18, 0x00, 0xd2, 0x00, 0x1c, 0x01, 0xda, 0x0b, 
+0x84, 0x00, 0xd4, 0x0a, 0x6f, 0x10, 0x32, 0x00, 0x72, 0x0d, 0x0a, 0x0d, 
+0xb2, 0x16, 0xbf, 0x00, 0xc7, 0x0a, 0xe2, 0x0c, 0x2c, 0x01, 0xed, 0x0e, 
+0x9e, 0x17, 0xee, 0x0a, 0x78, 0x0b, 0x9b, 0x19, 0x3b, 0x0b, 0x49, 0x00, 
+0xb1, 0x00, 0xc2, 0x00, 0x21, 0x09, 0x38, 0x01, 0x48, 0x12, 0xdf, 0x16, 
+0xdf, 0x00, 0xc1, 0x06, 0x10, 0x07, 0x0c, 0x01, 0xca, 0x0b, 0x94, 0x07, 
+0x5f, 0x00, 0xf9, 0x18, 0xf6, 0x09, 0x11, 0x0b, 0x83, 0x00, 0xde, 0x00, 
+0x11, 0x0b, 0x10, 0x0b, 0x1c, 0x01, 0xa8, 0x09, 0x6e, 0x00, 0xab, 0x0b, 
+0xc6, 0x00, 0x9c, 0x0f, 0x6c, 0x07, 0xb0, 0x00, 0x14, 0x01, 0x57, 0x00, 
+0x77, 0x09, 0x79, 0x0a, 0xeb, 0x0b, 0x90, 0x0c, 0x3a, 0x16, 0x92, 0x11, 
+0x0e, 0x01, 0x92, 0x0f, 0xd2, 0x0c, 0x85, 0x17, 0xc9, 0x08, 0xbd, 0x12, 
+0x75, 0x16, 0xcf, 0x0a, 0x67, 0x07, 0x53, 0x0e, 0x38, 0x0c, 0x44, 0x07, 
+0x54, 0x0c, 0xe4, 0x0d, 0x2c, 0x0d, 0x3c, 0x0b, 0x0c, 0x00, 0x28, 0x00, 
+0x45, 0x13, 0xb9, 0x0e, 0x69, 0x14, 0x36, 0x0d, 0xa8, 0x0c, 0x1c, 0x0b, 
+0xe0, 0x13, 0x5d, 0x12, 0xb6, 0x07, 0xe7, 0x0a, 0xaa, 0x15, 0x9d, 0x0c, 
+0x4c, 0x00, 0x5a, 0x0c, 0xda, 0x00, 0xdd, 0x00, 0xb2, 0x0c, 0xc8, 0x00, 
+0x66, 0x11, 0xa9, 0x00, 0x23, 0x08, 0xf4, 0x17, 0x1b, 0x0b, 0x2c, 0x01, 
+0xcc, 0x0b, 0x5c, 0x0c, 0xac, 0x00, 0x89, 0x09, 0x06, 0x01, 0x2e, 0x01, 
+0xf9, 0x0a, 0x36, 0x07, 0x12, 0x01, 0x23, 0x0a, 0x18, 0x08, 0x5a, 0x0f, 
+0xd0, 0x00, 0xb2, 0x00, 0xff, 0x0f, 0x6a, 0x0a, 0x6d, 0x09, 0xf7, 0x0e, 
+0xaf, 0x0a, 0x42, 0x06, 0xb9, 0x0a, 0x70, 0x09, 0x1e, 0x01, 0x5f, 0x00, 
+0x8a, 0x12, 0xab, 0x18, 0xe3, 0x17, 0x0e, 0x0c, 0x41, 0x09, 0x4c, 0x00, 
+0x97, 0x0a, 0xa6, 0x0a, 0x74, 0x0b, 0x58, 0x00, 0x1f, 0x0b, 0x8b, 0x11, 
+0xc5, 0x00, 0x9c, 0x0e, 0x88, 0x0b, 0x84, 0x10, 0xd4, 0x0c, 0xec, 0x00, 
+0xed, 0x00, 0x13, 0x0a, 0xfd, 0x14, 0xef, 0x0a, 0x24, 0x01, 0xae, 0x10, 
+0x7b, 0x10, 0xe3, 0x00, 0xff, 0x0c, 0x1a, 0x01, 0x4a, 0x0a, 0x6f, 0x10, 
+0xc9, 0x0f, 0xd5, 0x09, 0xb5, 0x0b, 0x49, 0x06, 0x82, 0x0a, 0x0b, 0x15, 
+0xa7, 0x00, 0x24, 0x0c, 0x13, 0x0e, 0x2e, 0x01, 0xe1, 0x0f, 0x8f, 0x1a, 
+0x06, 0x07, 0x92, 0x0c, 0x7e, 0x00, 0xd7, 0x09, 0x73, 0x16, 0x5a, 0x08, 
+0xb5, 0x09, 0xf8, 0x00, 0x22, 0x09, 0xb9, 0x00, 0x54, 0x0a, 0x4c, 0x11, 
+0xfd, 0x00, 0x10, 0x07, 0x2e, 0x08, 0x20, 0x01, 0x1c, 0x01, 0xf3, 0x18, 
+0x83, 0x00, 0xbc, 0x00, 0x61, 0x12, 0x9b, 0x06, 0xb1, 0x08, 0x6f, 0x13, 
+0x88, 0x0a, 0x38, 0x01, 0xa7, 0x0a, 0xc8, 0x0a, 0xb1, 0x00, 0xc3, 0x0a, 
+0x33, 0x0c, 0x01, 0x0c, 0x16, 0x18, 0xc4, 0x0a, 0x29, 0x00, 0xc3, 0x0a, 
+0x2f, 0x00, 0x71, 0x0c, 0x17, 0x1a, 0x8c, 0x06, 0x31, 0x1c, 0xea, 0x00, 
+0x5a, 0x08, 0xc3, 0x0a, 0xb7, 0x00, 0x94, 0x0b, 0x04, 0x01, 0x85, 0x00, 
+0xe2, 0x1a, 0xf6, 0x10, 0x66, 0x12, 0x93, 0x0b, 0x1e, 0x01, 0x48, 0x06, 
+0x0e, 0x00, 0x30, 0x01, 0x11, 0x0b, 0x0a, 0x01, 0xb0, 0x0b, 0x5f, 0x0a, 
+0xc7, 0x00, 0x85, 0x0c, 0xb1, 0x13, 0xbc, 0x0c, 0x16, 0x14, 0x18, 0x00, 
+0xda, 0x0b, 0x91, 0x0c, 0xc2, 0x00, 0x1b, 0x0c, 0x8e, 0x17, 0xfb, 0x0b, 
+0xa5, 0x0a, 0x17, 0x0c, 0xd6, 0x00, 0x3e, 0x0e, 0x37, 0x08, 0x26, 0x00, 
+0xa4, 0x06, 0xf0, 0x00, 0xf8, 0x00, 0xe9, 0x08, 0x4e, 0x0c, 0xae, 0x11, 
+0x1c, 0x01, 0x98, 0x15, 0x46, 0x06, 0x1f, 0x16, 0xc3, 0x00, 0xde, 0x16, 
+0xc0, 0x0e, 0x90, 0x1b, 0xf7, 0x00, 0xc4, 0x00, 0x96, 0x09, 0x4a, 0x0b, 
+0x12, 0x01, 0x07, 0x0a, 0x91, 0x10, 0x67, 0x0b, 0x74, 0x09, 0xec, 0x0b, 
+0x92, 0x0c, 0x1c, 0x01, 0x08, 0x01, 0x73, 0x0c, 0x7e, 0x0b, 0x73, 0x16, 
+0xe5, 0x07, 0xd3, 0x00, 0x10, 0x01, 0x11, 0x0e, 0xd5, 0x0b, 0x15, 0x14, 
+0x12, 0x0a, 0xdb, 0x10, 0x98, 0x06, 0x76, 0x0a, 0x9e, 0x00, 0xb1, 0x0a, 
+0xbd, 0x00, 0x24, 0x01, 0x78, 0x07, 0x30, 0x12, 0x9d, 0x00, 0x8e, 0x0b, 
+0x56, 0x18, 0x25, 0x0b, 0x24, 0x08, 0xbc, 0x09, 0xd0, 0x08, 0x39, 0x0d, 
+0x64, 0x0c, 0xed, 0x00, 0xfc, 0x08, 0xe1, 0x0b, 0x57, 0x07, 0x7a, 0x18, 
+0x25, 0x01, 0x18, 0x08, 0x02, 0x0c, 0x94, 0x07, 0x78, 0x10, 0xc4, 0x0b, 
+0xd2, 0x10, 0xe8, 0x13, 0xaa, 0x00, 0x95, 0x0f, 0x51, 0x17, 0x27, 0x01, 
+0xd6, 0x0c, 0x26, 0x1a, 0xb1, 0x00, 0xd7, 0x07, 0x58, 0x00, 0x3f, 0x0d, 
+0x9c, 0x00, 0xbb, 0x14, 0xf1, 0x13, 0xc7, 0x00, 0xec, 0x08, 0x5f, 0x00, 
+0x16, 0x0b, 0x01, 0x0a, 0xd5, 0x0b, 0x38, 0x01, 0xc3, 0x0e, 0xfc, 0x07, 
+0x24, 0x0f, 0x09, 0x15, 0x03, 0x0a, 0xb3, 0x00, 0x51, 0x0b, 0xae, 0x00, 
+0xda, 0x0d, 0xb6, 0x09, 0x33, 0x00, 0x97, 0x0a, 0x17, 0x14, 0xad, 0x0a, 
+0x2e, 0x07, 0xf1, 0x0a, 0x61, 0x0a, 0x1d, 0x01, 0x8b, 0x00, 0x2e, 0x0c, 
+0x63, 0x09, 0x9d, 0x00, 0xab, 0x0b, 0x90, 0x0c, 0xe7, 0x00, 0x6d, 0x09, 
+0x2e, 0x07, 0xd5, 0x0b, 0xa6, 0x0f, 0xd9, 0x00, 0xde, 0x08, 0x92, 0x0f, 
+0x07, 0x13, 0xcd, 0x14, 0xc9, 0x09, 0xe4, 0x0a, 0x20, 0x09, 0x3c, 0x0b, 
+0x8d, 0x0a, 0xe8, 0x09, 0x9e, 0x00, 0xdb, 0x00, 0x6e, 0x11, 0xba, 0x07, 
+0x23, 0x08, 0x7c, 0x13, 0xe0, 0x0b, 0x3c, 0x01, 0xd0, 0x0a, 0x23, 0x01, 
+0xe7, 0x14, 0xde, 0x0e, 0x67, 0x14, 0x7b, 0x07, 0xa8, 0x00, 0x45, 0x06, 
+0x2a, 0x01, 0xbc, 0x0a, 0xc8, 0x16, 0x18, 0x08, 0xfc, 0x15, 0xa9, 0x0c, 
+0x69, 0x06, 0x21, 0x0d, 0x55, 0x11, 0x81, 0x09, 0x84, 0x0a, 0x8b, 0x0b, 
+0x23, 0x0f, 0x57, 0x00, 0xaa, 0x00, 0x4f, 0x0a, 0x72, 0x0b, 0xf6, 0x09, 
+0xfe, 0x08, 0x42, 0x01, 0xab, 0x09, 0xca, 0x0b, 0xaf, 0x00, 0xfa, 0x0b, 
+0xf4, 0x06, 0xe0, 0x15, 0x1d, 0x0b, 0x51, 0x08, 0x87, 0x00, 0x20, 0x15, 
+0x88, 0x0b, 0x11, 0x00, 0x6b, 0x00, 0x2d, 0x15, 0x7a, 0x0c, 0x06, 0x0f, 
+0x26, 0x01, 0x5f, 0x0a, 0x7c, 0x16, 0x91, 0x0c, 0xec, 0x00, 0xa9, 0x16, 
+0x24, 0x01, 0x76, 0x16, 0x47, 0x00, 0xb9, 0x00, 0xd2, 0x19, 0x63, 0x0a, 
+0x2c, 0x01, 0x0d, 0x13, 0x23, 0x01, 0x11, 0x0e, 0xdf, 0x08, 0xed, 0x0d, 
+0x5f, 0x18, 0xaa, 0x07, 0x59, 0x17, 0x19, 0x00, 0xe1, 0x0c, 0xcb, 0x00, 
+0x49, 0x0b, 0x36, 0x01, 0x72, 0x0c, 0xb4, 0x07, 0xc4, 0x0e, 0x7c, 0x0c, 
+0x01, 0x0d, 0x35, 0x09, 0xf5, 0x0c, 0xfc, 0x00, 0x7d, 0x0c, 0x7a, 0x0c, 
+0xb2, 0x16, 0x23, 0x08, 0xc4, 0x00, 0xd1, 0x00, 0x04, 0x01, 0x68, 0x06, 
+0x32, 0x01, 0x7a, 0x0c, 0x7f, 0x0b, 0x14, 0x0b, 0xac, 0x0a, 0xfd, 0x0d, 
+0xe0, 0x08, 0x53, 0x00, 0x92, 0x00, 0x59, 0x00, 0x23, 0x00, 0xdb, 0x06, 
+0x31, 0x08, 0x38, 0x01, 0x1a, 0x0f, 0xb4, 0x16, 0x2d, 0x14, 0xcc, 0x00, 
+0xf4, 0x00, 0x2b, 0x01, 0x16, 0x01, 0xe3, 0x0a, 0xd9, 0x06, 0x68, 0x1b, 
+0xd5, 0x0c, 0x79, 0x08, 0x42, 0x01, 0x9a, 0x0b, 0xb6, 0x0e, 0x28, 0x0a, 
+0x29, 0x01, 0xcd, 0x0a, 0x03, 0x15, 0x3e, 0x01, 0x04, 0x01, 0x10, 0x01, 
+0xd4, 0x0a, 0xd5, 0x14, 0x2e, 0x0c, 0x29, 0x00, 0x96, 0x0b, 0x87, 0x00, 
+0xdc, 0x0f, 0x79, 0x0b, 0x12, 0x07, 0xb1, 0x00, 0x8e, 0x00, 0x3a, 0x11, 
+0x26, 0x0c, 0x39, 0x12, 0xaa, 0x16, 0xdd, 0x0a, 0xaa, 0x08, 0xd2, 0x09, 
+0xe8, 0x09, 0xf7, 0x0b, 0x1c, 0x01, 0x75, 0x10, 0x24, 0x00, 0x08, 0x01, 
+0xc7, 0x0c, 0x04, 0x0c, 0x32, 0x0b, 0x28, 0x16, 0x40, 0x0f, 0xbd, 0x0c, 
+0xad, 0x00, 0x1c, 0x0c, 0xdc, 0x00, 0x20, 0x01, 0xc5, 0x0a, 0x01, 0x01, 
+0x5d, 0x0b, 0x68, 0x09, 0xa5, 0x0f, 0xd9, 0x00, 0x80, 0x0a, 0xab, 0x00, 
+0x2a, 0x0a, 0x07, 0x01, 0x21, 0x00, 0x21, 0x16, 0xd4, 0x0c, 0xc4, 0x14, 
+0xa9, 0x00, 0xf8, 0x0f, 0x49, 0x00, 0x7a, 0x0c, 0xb2, 0x00, 0xc0, 0x12, 
+0x15, 0x14, 0x48, 0x00, 0xac, 0x16, 0x78, 0x09, 0xec, 0x0c, 0xf5, 0x09, 
+0x33, 0x01, 0xca, 0x00, 0x4f, 0x00, 0x4b, 0x00, 0x7f, 0x16, 0x14, 0x01, 
+0x8b, 0x11, 0x86, 0x00, 0x19, 0x01, 0x22, 0x01, 0x85, 0x16, 0xdf, 0x00, 
+0x22, 0x0e, 0xb1, 0x00, 0x9d, 0x00, 0xee, 0x12, 0x47, 0x06, 0xdd, 0x18, 
+0xe4, 0x0d, 0xa9, 0x00, 0xf6, 0x00, 0xd2, 0x0f, 0xf7, 0x07, 0xcb, 0x0e, 
+0xad, 0x00, 0xb6, 0x10, 0x4e, 0x11, 0xf2, 0x00, 0x5f, 0x06, 0xc4, 0x1a, 
+0x41, 0x06, 0x0c, 0x00, 0x13, 0x0b, 0x43, 0x0c, 0xc8, 0x00, 0xa2, 0x0b, 
+0x2a, 0x0a, 0x19, 0x01, 0x21, 0x01, 0x31, 0x08, 0xbc, 0x16, 0xdf, 0x0a, 
+0x38, 0x0a, 0xa3, 0x06, 0x73, 0x0c, 0x3d, 0x0b, 0xe1, 0x09, 0xd2, 0x00, 
+0xbd, 0x09, 0xf8, 0x00, 0xe8, 0x13, 0x1b, 0x0f, 0x05, 0x0b, 0xe4, 0x00, 
+0xfc, 0x09, 0x14, 0x01, 0x66, 0x09, 0x3f, 0x0e, 0xe2, 0x0b, 0x50, 0x00, 
+0xd3, 0x10, 0x26, 0x01, 0x82, 0x18, 0xcd, 0x00, 0xc6, 0x00, 0xe3, 0x13, 
+0x5a, 0x0c, 0x94, 0x07, 0x61, 0x06, 0x40, 0x01, 0xb6, 0x12, 0x26, 0x0b, 
+0xf1, 0x00, 0x6b, 0x18, 0x39, 0x13, 0xb1, 0x09, 0x26, 0x00, 0x7d, 0x00, 
+0x6f, 0x14, 0x87, 0x16, 0xfc, 0x08, 0xb7, 0x00, 0x08, 0x0b, 0xb5, 0x07, 
+0x25, 0x01, 0xb6, 0x0b, 0xc6, 0x09, 0xff, 0x00, 0x6a, 0x0a, 0x7a, 0x0a, 
+0xdf, 0x00, 0xf9, 0x00, 0x88, 0x16, 0x94, 0x00, 0x6a, 0x07, 0x12, 0x0a, 
+0xe8, 0x1a, 0x14, 0x0d, 0xf0, 0x00, 0xa9, 0x00, 0x78, 0x09, 0xd5, 0x0c, 
+0xce, 0x0a, 0xaa, 0x16, 0x13, 0x0e, 0xce, 0x15, 0x22, 0x0c, 0xf7, 0x0f, 
+0xbb, 0x0e, 0xc8, 0x0c, 0xa8, 0x08, 0xf1, 0x0b, 0x85, 0x16, 0x97, 0x15, 
+0x25, 0x00, 0x7e, 0x0c, 0xcd, 0x00, 0xcc, 0x00, 0x0c, 0x00, 0xfe, 0x0e, 
+0x1e, 0x01, 0xb9, 0x00, 0x6c, 0x11, 0x84, 0x0e, 0xe8, 0x09, 0x60, 0x00, 
+0xb6, 0x16, 0x42, 0x00, 0x32, 0x0b, 0x0c, 0x00, 0x0f, 0x0b, 0xd2, 0x0c, 
+0x6a, 0x14, 0x4c, 0x0c, 0x09, 0x0a, 0x1c, 0x01, 0x0e, 0x0d, 0xfd, 0x09, 
+0x9f, 0x12, 0xd8, 0x0c, 0xa8, 0x00, 0xf7, 0x00, 0xf4, 0x00, 0xb3, 0x00, 
+0xec, 0x00, 0x94, 0x00, 0x6b, 0x0a, 0xae, 0x00, 0x81, 0x10, 0x9f, 0x0f, 
+0x4c, 0x0a, 0x94, 0x07, 0xf3, 0x0a, 0xe7, 0x00, 0xe3, 0x00, 0x17, 0x0c, 
+0xda, 0x00, 0xbc, 0x08, 0x7c, 0x0c, 0x09, 0x01, 0xce, 0x15, 0x0d, 0x0c, 
+0x42, 0x0d, 0xe5, 0x00, 0xf8, 0x0f, 0x4f, 0x15, 0xd0, 0x0a, 0xf3, 0x09, 
+0x46, 0x06, 0x27, 0x0b, 0xca, 0x0e, 0xab, 0x0b, 0x69, 0x0b, 0x22, 0x01, 
+0x97, 0x15, 0xe9, 0x00, 0xe1, 0x00, 0xad, 0x00, 0x56, 0x07, 0x55, 0x0a, 
+0x31, 0x00, 0x63, 0x09, 0x5f, 0x06, 0x09, 0x0a, 0x96, 0x18, 0xc8, 0x00, 
+0x25, 0x00, 0xea, 0x00, 0xaf, 0x0a, 0x1d, 0x0a, 0x1e, 0x0a, 0xe3, 0x17, 
+0xb9, 0x00, 0xee, 0x0e, 0xf0, 0x00, 0xc9, 0x00, 0x24, 0x01, 0x1d, 0x01, 
+0x67, 0x11, 0xf3, 0x09, 0x77, 0x0b, 0x02, 0x0c, 0xc0, 0x00, 0xd4, 0x0c, 
+0xf0, 0x00, 0x6c, 0x0a, 0x9c, 0x00, 0xff, 0x14, 0x8e, 0x16, 0xeb, 0x09, 
+0xf4, 0x09, 0x0b, 0x01, 0xaa, 0x00, 0x2d, 0x01, 0xbc, 0x14, 0xbd, 0x0c, 
+0x64, 0x0a, 0x5f, 0x00, 0xa1, 0x06, 0xc5, 0x00, 0xe2, 0x00, 0xeb, 0x1b, 
+0xd5, 0x0b, 0x2a, 0x0a, 0xb2, 0x00, 0x0f, 0x00, 0x01, 0x14, 0xd8, 0x0a, 
+0x36, 0x01, 0xc3, 0x00, 0x00, 0x0f, 0xed, 0x0b, 0xd0, 0x15, 0x05, 0x0c, 
+0xfb, 0x00, 0x2a, 0x01, 0xa2, 0x15, 0x8e, 0x06, 0x80, 0x0b, 0x94, 0x07, 
+0xd4, 0x00, 0x4a, 0x11, 0x01, 0x0c, 0x83, 0x14, 0x4e, 0x00, 0x54, 0x11, 
+0xc3, 0x0a, 0x0b, 0x01, 0x38, 0x01, 0x04, 0x13, 0x22, 0x0a, 0xba, 0x07, 
+0xec, 0x00, 0x25, 0x01, 0xe3, 0x0b, 0x39, 0x12, 0xdb, 0x0d, 0xea, 0x09, 
+0xbf, 0x09, 0x4e, 0x0c, 0x6a, 0x0d, 0x7f, 0x16, 0x7c, 0x16, 0x7c, 0x0a, 
+0xc6, 0x00, 0x1a, 0x01, 0xaa, 0x16, 0x2e, 0x01, 0x99, 0x17, 0x42, 0x0a, 
+0xce, 0x18, 0xe7, 0x0a, 0x26, 0x12, 0x04, 0x01, 0x65, 0x19, 0xed, 0x00, 
+0xe9, 0x00, 0x6d, 0x09, 0x13, 0x01, 0xa0, 0x15, 0xf1, 0x07, 0xf1, 0x00, 
+0xcc, 0x00, 0xc7, 0x0c, 0x1b, 0x0e, 0x7c, 0x10, 0xe0, 0x08, 0x49, 0x0b, 
+0xdd, 0x0c, 0xce, 0x14, 0x53, 0x0e, 0x3a, 0x0a, 0x1a, 0x0c, 0x98, 0x15, 
+0x22, 0x10, 0x7b, 0x12, 0x04, 0x09, 0x28, 0x01, 0x63, 0x00, 0x8d, 0x12, 
+0x5b, 0x08, 0x39, 0x0a, 0xda, 0x10, 0xf4, 0x00, 0xe2, 0x08, 0x46, 0x0b, 
+0x8b, 0x00, 0x3b, 0x00, 0xf4, 0x00, 0x3c, 0x12, 0xca, 0x0b, 0x07, 0x0a, 
+0x20, 0x0a, 0x58, 0x17, 0xbb, 0x14, 0x64, 0x15, 0xb9, 0x0a, 0xa8, 0x0a, 
+0x22, 0x09, 0x7c, 0x16, 0xc8, 0x00, 0xf3, 0x18, 0x22, 0x0c, 0xb0, 0x09, 
+0x77, 0x00, 0xf4, 0x09, 0x57, 0x1a, 0x9b, 0x1a, 0x40, 0x01, 0x94, 0x0b, 
+0x7c, 0x09, 0xa0, 0x19, 0x42, 0x0a, 0xec, 0x09, 0x25, 0x13, 0x23, 0x0c, 
+0xde, 0x0c, 0x66, 0x12, 0x24, 0x01, 0xe3, 0x00, 0xd8, 0x0a, 0xc6, 0x00, 
+0x18, 0x08, 0x16, 0x01, 0x72, 0x0b, 0x82, 0x15, 0x2e, 0x08, 0x99, 0x00, 
+0x33, 0x12, 0x35, 0x09, 0x33, 0x14, 0x15, 0x01, 0x7f, 0x0b, 0x16, 0x14, 
+0x31, 0x00, 0x53, 0x00, 0xf5, 0x0c, 0x7e, 0x0c, 0xf4, 0x00, 0xd9, 0x00, 
+0x2e, 0x0c, 0x47, 0x0b, 0xd4, 0x00, 0xd8, 0x0a, 0xaf, 0x00, 0xb4, 0x09, 
+0x15, 0x14, 0xf5, 0x0a, 0x8c, 0x11, 0x63, 0x09, 0x94, 0x12, 0x82, 0x0a, 
+0x41, 0x12, 0xce, 0x00, 0xd4, 0x06, 0x45, 0x06, 0x93, 0x0a, 0xc4, 0x0a, 
+0xbe, 0x0c, 0xe6, 0x00, 0x30, 0x0b, 0xd8, 0x00, 0x0b, 0x01, 0x6f, 0x08, 
+0xed, 0x0e, 0x0c, 0x01, 0xf0, 0x00, 0x20, 0x09, 0x01, 0x01, 0xaf, 0x0a, 
+0xd7, 0x17, 0x93, 0x0b, 0x58, 0x0a, 0x28, 0x0b, 0x50, 0x0a, 0xb1, 0x09, 
+0x19, 0x00, 0xbf, 0x08, 0x2c, 0x0a, 0xfd, 0x0a, 0xf6, 0x0b, 0xe4, 0x0b, 
+0x11, 0x0e, 0xbc, 0x0c, 0x9e, 0x0f, 0xc6, 0x14, 0x81, 0x1b, 0x1a, 0x08, 
+0xd7, 0x00, 0x78, 0x00, 0x82, 0x07, 0x31, 0x01, 0x12, 0x00, 0x18, 0x00, 
+0x1b, 0x0c, 0xd3, 0x09, 0xa0, 0x08, 0xfe, 0x00, 0xd8, 0x00, 0x83, 0x09, 
+0x4c, 0x06, 0x24, 0x08, 0xf2, 0x09, 0x49, 0x00, 0x47, 0x18, 0x28, 0x12, 
+0x6a, 0x0a, 0xca, 0x0b, 0x16, 0x01, 0xcf, 0x00, 0x8c, 0x06, 0x0b, 0x01, 
+0x96, 0x09, 0xb5, 0x00, 0xfd, 0x07, 0x38, 0x0d, 0xb2, 0x0a, 0x8c, 0x11, 
+0xc5, 0x0a, 0x40, 0x06, 0x9d, 0x0c, 0x39, 0x01, 0x1f, 0x01, 0xa5, 0x0a, 
+0x09, 0x01, 0x77, 0x00, 0xc3, 0x0a, 0x64, 0x0c, 0x1e, 0x1a, 0x24, 0x00, 
+0x41, 0x00, 0x98, 0x0b, 0xf3, 0x08, 0x09, 0x0b, 0x15, 0x01, 0xed, 0x00, 
+0x5c, 0x0b, 0x18, 0x0c, 0x1e, 0x01, 0xc9, 0x00, 0x54, 0x00, 0x72, 0x00, 
+0xb6, 0x0b, 0x48, 0x06, 0x7c, 0x16, 0x63, 0x00, 0x83, 0x10, 0xbc, 0x0c, 
+0x80, 0x09, 0x18, 0x01, 0xff, 0x09, 0xd2, 0x10, 0x1e, 0x14, 0x94, 0x0b, 
+0x8f, 0x0a, 0x09, 0x0a, 0x56, 0x06, 0x3b, 0x01, 0xed, 0x0b, 0x12, 0x0a, 
+0x39, 0x12, 0xd0, 0x00, 0xd1, 0x00, 0x36, 0x01, 0xaf, 0x10, 0x0b, 0x00, 
+0x91, 0x17, 0xb2, 0x17, 0x9c, 0x00, 0x4a, 0x0c, 0xba, 0x14, 0x09, 0x01, 
+0x8e, 0x17, 0x9d, 0x08, 0xc6, 0x09, 0xc5, 0x09, 0x70, 0x17, 0xc0, 0x14, 
+0xf4, 0x13, 0xdb, 0x00, 0xdc, 0x00, 0x68, 0x0b, 0xfa, 0x16, 0x8d, 0x16, 
+0xf5, 0x0d, 0xf2, 0x00, 0x6a, 0x0a, 0x28, 0x0a, 0xc9, 0x0e, 0x0d, 0x00, 
+0x52, 0x14, 0x96, 0x18, 0xd0, 0x18, 0x67, 0x14, 0xed, 0x06, 0xf2, 0x00, 
+0x45, 0x06, 0x56, 0x06, 0x36, 0x07, 0x55, 0x0a, 0xd0, 0x09, 0xeb, 0x0b, 
+0x00, 0x01, 0xd0, 0x06, 0x6c, 0x00, 0xe6, 0x00, 0x11, 0x01, 0x67, 0x09, 
+0xfe, 0x09, 0xe6, 0x07, 0xc1, 0x0e, 0x60, 0x0c, 0xbd, 0x14, 0x78, 0x00, 
+0x21, 0x01, 0x65, 0x18, 0x66, 0x0c, 0x88, 0x09, 0xde, 0x00, 0x52, 0x00, 
+0x37, 0x01, 0x29, 0x12, 0x97, 0x17, 0x47, 0x0b, 0xb2, 0x09, 0x19, 0x08, 
+0x07, 0x0b, 0xb0, 0x0a, 0x57, 0x00, 0x76, 0x0b, 0xb7, 0x00, 0x42, 0x01, 
+0x7c, 0x00, 0x00, 0x08, 0xa6, 0x09, 0x72, 0x17, 0x60, 0x07, 0x63, 0x00, 
+0xe8, 0x09, 0x6d, 0x09, 0xa7, 0x09, 0x1d, 0x13, 0xc6, 0x00, 0x1b, 0x0b, 
+0x61, 0x00, 0xa6, 0x0c, 0x7f, 0x0b, 0x27, 0x0c, 0x4f, 0x06, 0x7d, 0x06, 
+0x60, 0x00, 0xa9, 0x0c, 0xf6, 0x11, 0xb7, 0x0e, 0xff, 0x0a, 0x9d, 0x00, 
+0x1a, 0x00, 0x7d, 0x0b, 0x35, 0x09, 0x97, 0x00, 0x55, 0x11, 0x18, 0x01, 
+0xf2, 0x00, 0x69, 0x07, 0x8f, 0x00, 0xbc, 0x07, 0x90, 0x00, 0x6f, 0x13, 
+0x5a, 0x0c, 0xe2, 0x00, 0xd9, 0x11, 0xa4, 0x09, 0xe6, 0x09, 0x79, 0x07, 
+0xa9, 0x0b, 0xde, 0x0a, 0xb1, 0x08, 0xc5, 0x09, 0x2b, 0x09, 0x62, 0x0a, 
+0xa1, 0x07, 0x83, 0x0b, 0x4b, 0x0c, 0x71, 0x0c, 0x1b, 0x07, 0x11, 0x07, 
+0xfe, 0x0e, 0x23, 0x09, 0x62, 0x17, 0xcf, 0x00, 0xba, 0x14, 0x61, 0x00, 
+0x2d, 0x0c, 0x67, 0x13, 0xdd, 0x09, 0x9f, 0x11, 0xc7, 0x00, 0xb5, 0x19, 
+0xbd, 0x11, 0xa0, 0x0c, 0xfa, 0x16, 0x23, 0x08, 0x70, 0x0c, 0xb0, 0x09, 
+0xdb, 0x00, 0xfc, 0x09, 0x54, 0x07, 0x65, 0x0c, 0x88, 0x00, 0xd2, 0x11, 
+0xee, 0x06, 0x44, 0x06, 0x3f, 0x0e, 0x10, 0x0b, 0xae, 0x11, 0xbd, 0x14, 
+0x5f, 0x15, 0xfd, 0x0a, 0x0f, 0x0c, 0x0c, 0x0c, 0xd5, 0x00, 0x25, 0x01, 
+0x1a, 0x0c, 0x01, 0x01, 0x98, 0x06, 0xde, 0x13, 0x64, 0x15, 0x38, 0x01, 
+0x2d, 0x09, 0x54, 0x00, 0x70, 0x0a, 0x17, 0x0c, 0x48, 0x06, 0xf3, 0x13, 
+0xe4, 0x00, 0xe9, 0x0c, 0xd2, 0x00, 0xc5, 0x09, 0xd7, 0x19, 0xa5, 0x0f, 
+0x4b, 0x13, 0x44, 0x0c, 0xa7, 0x00, 0x67, 0x0c, 0xc1, 0x0c, 0x49, 0x00, 
+0x41, 0x0a, 0xe4, 0x0e, 0x49, 0x0a, 0x2f, 0x01, 0x1c, 0x01, 0xbd, 0x09, 
+0x4c, 0x0a, 0x15, 0x0a, 0x24, 0x0e, 0xee, 0x00, 0x3e, 0x0b, 0x55, 0x00, 
+0xd6, 0x0b, 0x7a, 0x0c, 0x03, 0x01, 0x30, 0x0b, 0xfe, 0x09, 0xeb, 0x13, 
+0x63, 0x00, 0x60, 0x06, 0xaa, 0x07, 0xbc, 0x0c, 0x25, 0x01, 0xb0, 0x0a, 
+0x85, 0x0c, 0x81, 0x0b, 0x6d, 0x19, 0x11, 0x09, 0x52, 0x0b, 0x4a, 0x11, 
+0x2e, 0x18, 0xc9, 0x00, 0xeb, 0x00, 0xe3, 0x00, 0x6a, 0x0a, 0x7f, 0x00, 
+0x66, 0x0c, 0x41, 0x0b, 0xd0, 0x09, 0xc5, 0x09, 0xd6, 0x14, 0x75, 0x0b, 
+0x54, 0x07, 0xd7, 0x0f, 0xc6, 0x09, 0xaf, 0x09, 0x11, 0x01, 0xd4, 0x00, 
+0x61, 0x0b, 0x64, 0x00, 0x39, 0x01, 0xd2, 0x00, 0xde, 0x00, 0x16, 0x01, 
+0xd2, 0x00, 0x43, 0x0c, 0x77, 0x0b, 0x3b, 0x01, 0x3d, 0x0c, 0x17, 0x01, 
+0x4b, 0x15, 0xfb, 0x14, 0xb9, 0x00, 0x2c, 0x09, 0x52, 0x07, 0x4f, 0x0e, 
+0xd3, 0x00, 0x3e, 0x01, 0x11, 0x19, 0x42, 0x01, 0x16, 0x01, 0x4d, 0x0a, 
+0x1c, 0x01, 0x03, 0x01, 0x75, 0x0a, 0x4e, 0x06, 0x29, 0x0e, 0x98, 0x06, 
+0xf6, 0x0b, 0xb8, 0x00, 0xf1, 0x09, 0xd6, 0x07, 0x1d, 0x01, 0x2e, 0x09, 
+0x7c, 0x0c, 0xd9, 0x0a, 0x22, 0x01, 0x76, 0x0a, 0xe6, 0x0b, 0x8c, 0x0a, 
+0x3c, 0x01, 0xb9, 0x00, 0x50, 0x09, 0x8c, 0x0b, 0x04, 0x0b, 0xab, 0x08, 
+0x7f, 0x07, 0xbc, 0x00, 0x1f, 0x08, 0xa5, 0x09, 0x12, 0x07, 0xb1, 0x11, 
+0x46, 0x0c, 0x1b, 0x00, 0x7d, 0x00, 0x43, 0x06, 0xff, 0x10, 0x54, 0x00, 
+0xc1, 0x00, 0xba, 0x09, 0x8b, 0x0a, 0x35, 0x01, 0xa9, 0x00, 0xab, 0x00, 
+0xe1, 0x0b, 0x6c, 0x18, 0x6e, 0x12, 0x0f, 0x0c, 0xd2, 0x0a, 0x2d, 0x01, 
+0xb3, 0x17, 0x52, 0x14, 0x6f, 0x00, 0xef, 0x00, 0xc0, 0x09, 0x8f, 0x17, 
+0x10, 0x00, 0x38, 0x0a, 0x1c, 0x0b, 0xd8, 0x0a, 0x1a, 0x01, 0x5e, 0x07, 
+0xaa, 0x08, 0x6d, 0x06, 0xbc, 0x0c, 0xce, 0x09, 0x62, 0x00, 0xbd, 0x00, 
+0xf0, 0x00, 0x6e, 0x00, 0xe5, 0x00, 0x02, 0x0c, 0x97, 0x13, 0xfe, 0x07, 
+0x91, 0x0a, 0xb4, 0x0b, 0xb5, 0x16, 0xb7, 0x0b, 0x3b, 0x0c, 0x49, 0x0a, 
+0xa5, 0x0a, 0x26, 0x07, 0x59, 0x19, 0x65, 0x17, 0x55, 0x00, 0x1b, 0x0b, 
+0x3d, 0x12, 0x41, 0x0e, 0x8a, 0x12, 0xa8, 0x0c, 0x4d, 0x11, 0x11, 0x07, 
+0x34, 0x00, 0xad, 0x00, 0xb6, 0x09, 0xa9, 0x15, 0xcb, 0x00, 0xe8, 0x00, 
+0xdb, 0x00, 0xea, 0x00, 0x00, 0x0d, 0xd3, 0x00, 0xe1, 0x09, 0x28, 0x00, 
+0xc0, 0x00, 0xd6, 0x0a, 0x1b, 0x00, 0x13, 0x01, 0xaa, 0x16, 0x1d, 0x00, 
+0x38, 0x0c, 0x42, 0x06, 0xd3, 0x09, 0xca, 0x00, 0x2b, 0x18, 0x54, 0x0b, 
+0x2a, 0x01, 0xbf, 0x11, 0x1a, 0x00, 0xe7, 0x0f, 0x0f, 0x01, 0x1f, 0x0b, 
+0xb4, 0x0b, 0x91, 0x00, 0xd7, 0x16, 0xa7, 0x08, 0x65, 0x00, 0xbd, 0x00, 
+0xd2, 0x00, 0xeb, 0x09, 0x8c, 0x0b, 0xfa, 0x13, 0x57, 0x00, 0x54, 0x11, 
+0xff, 0x15, 0x0c, 0x0c, 0xaf, 0x09, 0x61, 0x06, 0xde, 0x00, 0x6c, 0x0a, 
+0xfb, 0x00, 0xa9, 0x00, 0xc1, 0x07, 0x73, 0x0d, 0xc7, 0x09, 0x31, 0x01, 
+0x43, 0x0c, 0x7a, 0x00, 0xb1, 0x00, 0xb2, 0x06, 0xdb, 0x00, 0x3d, 0x00, 
+0x2a, 0x0a, 0xc6, 0x14, 0xa6, 0x10, 0xb0, 0x12, 0x70, 0x10, 0x15, 0x0a, 
+0xfa, 0x16, 0x2d, 0x0c, 0xdf, 0x15, 0xb4, 0x09, 0xa9, 0x16, 0x4f, 0x0c, 
+0x7d, 0x0b, 0x4b, 0x06, 0xb8, 0x00, 0x66, 0x0c, 0x06, 0x0c, 0x70, 0x00, 
+0xdc, 0x00, 0xd0, 0x00, 0xa2, 0x0b, 0xc8, 0x0c, 0xcb, 0x0e, 0x5f, 0x0b, 
+0xdd, 0x18, 0x50, 0x0c, 0x45, 0x0c, 0xa9, 0x00, 0xbd, 0x00, 0xf7, 0x10, 
+0x49, 0x0b, 0xd9, 0x0b, 0xc3, 0x00, 0x18, 0x1a, 0x28, 0x01, 0x2d, 0x09, 
+0x3e, 0x0a, 0xe1, 0x00, 0x18, 0x00, 0xcf, 0x08, 0x35, 0x0d, 0x3d, 0x0b, 
+0x8e, 0x0d, 0x10, 0x01, 0x69, 0x0b, 0x46, 0x0e, 0xd3, 0x00, 0xe9, 0x00, 
+0xdf, 0x00, 0x31, 0x01, 0x6c, 0x0c, 0x3c, 0x00, 0x2d, 0x0c, 0x24, 0x01, 
+0x6d, 0x00, 0x32, 0x18, 0x98, 0x0d, 0x40, 0x00, 0xe4, 0x0a, 0xc9, 0x00, 
+0xc7, 0x0a, 0xb5, 0x1a, 0xe2, 0x1a, 0x4d, 0x11, 0x65, 0x11, 0x87, 0x00, 
+0xfa, 0x16, 0x97, 0x07, 0x00, 0x01, 0xc0, 0x09, 0xd6, 0x0a, 0x19, 0x01, 
+0x03, 0x13, 0xde, 0x00, 0x2b, 0x09, 0x90, 0x0c, 0x7b, 0x00, 0x12, 0x01, 
+0xf6, 0x08, 0xb4, 0x09, 0xba, 0x00, 0x5a, 0x08, 0x8b, 0x0b, 0xed, 0x0e, 
+0xb8, 0x0e, 0x11, 0x00, 0xe5, 0x0b, 0x16, 0x01, 0x56, 0x18, 0xf4, 0x07, 
+0xde, 0x00, 0x25, 0x07, 0xc2, 0x0e, 0xbc, 0x09, 0x6e, 0x14, 0xe2, 0x08, 
+0xe2, 0x0f, 0xec, 0x0b, 0x10, 0x01, 0xf4, 0x14, 0xc3, 0x00, 0xf7, 0x00, 
+0x55, 0x06, 0x33, 0x01, 0xe8, 0x0b, 0x26, 0x0b, 0xc5, 0x00, 0xd5, 0x09, 
+0xb8, 0x0a, 0xc6, 0x09, 0xe8, 0x13, 0x5a, 0x06, 0x2f, 0x00, 0xeb, 0x00, 
+0xa9, 0x07, 0x13, 0x07, 0x0f, 0x01, 0xde, 0x06, 0x40, 0x16, 0xab, 0x00, 
+0xf2, 0x09, 0x93, 0x0c, 0x8f, 0x00, 0x82, 0x0a, 0xc2, 0x0a, 0xd6, 0x0b, 
+0x2d, 0x01, 0x8f, 0x06, 0xee, 0x0d, 0x4e, 0x17, 0x66, 0x09, 0xe5, 0x00, 
+0x04, 0x0c, 0xa7, 0x0f, 0x77, 0x09, 0xba, 0x00, 0xc3, 0x0b, 0x97, 0x0a, 
+0x02, 0x01, 0x5c, 0x0b, 0x03, 0x0c, 0xbb, 0x00, 0xc0, 0x0f, 0xa0, 0x0b, 
+0x14, 0x01, 0x16, 0x0b, 0x93, 0x06, 0x26, 0x00, 0x31, 0x0b, 0x40, 0x01, 
+0x89, 0x0b, 0x29, 0x01, 0xf1, 0x06, 0xd9, 0x00, 0x8f, 0x09, 0xca, 0x00, 
+0xc3, 0x10, 0x9b, 0x00, 0x2a, 0x01, 0xae, 0x11, 0x6c, 0x00, 0x4e, 0x06, 
+0x54, 0x11, 0xac, 0x0a, 0x70, 0x06, 0x96, 0x10, 0x27, 0x0b, 0x35, 0x0d, 
+0xea, 0x0c, 0xb6, 0x0b, 0x42, 0x14, 0x1b, 0x10, 0x08, 0x11, 0x90, 0x0c, 
+0x31, 0x00, 0xd8, 0x0b, 0x52, 0x0b, 0x85, 0x16, 0xe1, 0x13, 0x0e, 0x00, 
+0x4d, 0x09, 0x0d, 0x0a, 0xd3, 0x0c, 0xe7, 0x0b, 0x66, 0x18, 0x48, 0x06, 
+0x33, 0x01, 0x96, 0x10, 0x71, 0x17, 0x70, 0x0a, 0xfb, 0x00, 0x88, 0x00, 
+0x22, 0x0a, 0xc0, 0x0b, 0xe8, 0x00, 0x6c, 0x00, 0x48, 0x0e, 0x5f, 0x08, 
+0x1b, 0x00, 0xa9, 0x0c, 0xd6, 0x0c, 0x0e, 0x0c, 0x49, 0x00, 0x0b, 0x09, 
+0xec, 0x06, 0xda, 0x11, 0x51, 0x13, 0xfe, 0x00, 0x09, 0x01, 0x2a, 0x0a, 
+0x13, 0x0a, 0x5a, 0x08, 0x06, 0x0c, 0x0e, 0x01, 0x07, 0x0b, 0x0b, 0x00, 
+0x3d, 0x00, 0x69, 0x07, 0xce, 0x0a, 0x95, 0x07, 0x22, 0x10, 0x57, 0x18, 
+0xcd, 0x15, 0xd6, 0x0c, 0x03, 0x01, 0x04, 0x0b, 0x66, 0x12, 0x31, 0x0c, 
+0xb9, 0x06, 0x2a, 0x0c, 0xf5, 0x00, 0x12, 0x0a, 0xc5, 0x00, 0xac, 0x00, 
+0x5b, 0x08, 0x12, 0x07, 0x5e, 0x07, 0x21, 0x09, 0xf1, 0x09, 0xdc, 0x06, 
+0xe2, 0x00, 0x09, 0x0a, 0x05, 0x01, 0xad, 0x00, 0x19, 0x01, 0x65, 0x08, 
+0xf9, 0x0c, 0xa1, 0x0c, 0xaa, 0x16, 0xe6, 0x06, 0x84, 0x00, 0x0f, 0x13, 
+0xa5, 0x09, 0x19, 0x00, 0x6f, 0x0c, 0xa9, 0x0b, 0xc1, 0x0c, 0xd9, 0x0a, 
+0xa9, 0x15, 0x3a, 0x1a, 0x96, 0x0b, 0x82, 0x18, 0x96, 0x0b, 0x19, 0x01, 
+0x42, 0x06, 0x36, 0x08, 0x55, 0x0b, 0xd2, 0x10, 0x46, 0x13, 0x57, 0x07, 
+0x90, 0x0a, 0xba, 0x0f, 0x6f, 0x10, 0x99, 0x0a, 0x4b, 0x11, 0xad, 0x00, 
+0x04, 0x13, 0x0e, 0x0c, 0x54, 0x09, 0x74, 0x0b, 0xe0, 0x0b, 0x7d, 0x0a, 
+0xfd, 0x00, 0x55, 0x07, 0xd8, 0x17, 0x9b, 0x00, 0x02, 0x01, 0xe6, 0x00, 
+0xf4, 0x00, 0xc5, 0x00, 0x49, 0x06, 0x2a, 0x0b, 0x55, 0x00, 0x46, 0x06, 
+0x87, 0x17, 0x77, 0x00, 0xe5, 0x0a, 0x25, 0x09, 0x83, 0x00, 0xed, 0x06, 
+0x60, 0x00, 0xed, 0x0e, 0x33, 0x12, 0x17, 0x0c, 0x26, 0x12, 0x55, 0x0a, 
+0xd6, 0x07, 0x4c, 0x06, 0xb5, 0x00, 0xff, 0x09, 0xd5, 0x0b, 0xf8, 0x00, 
+0xb2, 0x10, 0x7a, 0x0a, 0xd0, 0x0a, 0xcd, 0x09, 0xd7, 0x00, 0xc0, 0x00, 
+0x69, 0x17, 0x09, 0x16, 0x0f, 0x01, 0x31, 0x0b, 0x2b, 0x18, 0x43, 0x06, 
+0x7b, 0x09, 0x2e, 0x01, 0xc5, 0x09, 0x4f, 0x0a, 0x12, 0x0d, 0xd9, 0x00, 
+0x32, 0x01, 0x7c, 0x16, 0x60, 0x00, 0x3f, 0x08, 0xf8, 0x00, 0x11, 0x01, 
+0xfd, 0x09, 0xf4, 0x00, 0xe7, 0x00, 0x30, 0x01, 0x7b, 0x13, 0x10, 0x0c, 
+0x10, 0x00, 0x68, 0x06, 0xd1, 0x0a, 0x48, 0x06, 0xd4, 0x0c, 0xc0, 0x0c, 
+0x6e, 0x1b, 0xd0, 0x0b, 0x9b, 0x07, 0xb9, 0x1b, 0x8d, 0x0b, 0x3f, 0x09, 
+0xe5, 0x00, 0x98, 0x15, 0x29, 0x19, 0x0b, 0x01, 0x15, 0x14, 0xf3, 0x0c, 
+0x95, 0x00, 0xfd, 0x07, 0x60, 0x0a, 0x47, 0x06, 0xd6, 0x00, 0x29, 0x0a, 
+0xde, 0x0c, 0xab, 0x00, 0x95, 0x00, 0x00, 0x10, 0x56, 0x00, 0xab, 0x0b, 
+0x18, 0x01, 0xc3, 0x0a, 0x49, 0x06, 0x55, 0x06, 0xf5, 0x00, 0x27, 0x12, 
+0xc6, 0x00, 0x6a, 0x0b, 0xfe, 0x09, 0x57, 0x0a, 0x03, 0x12, 0x6f, 0x0a, 
+0x8f, 0x09, 0xac, 0x0a, 0x5a, 0x0f, 0xe1, 0x0c, 0x79, 0x0a, 0x62, 0x0d, 
+0x42, 0x00, 0xb2, 0x09, 0x1b, 0x07, 0x0e, 0x01, 0x92, 0x00, 0x9e, 0x00, 
+0xad, 0x16, 0xd3, 0x11, 0x2c, 0x01, 0xa3, 0x0b, 0x1d, 0x0f, 0xd6, 0x07, 
+0xa6, 0x0c, 0x36, 0x01, 0xf8, 0x17, 0x04, 0x13, 0x59, 0x17, 0x97, 0x15, 
+0xfd, 0x00, 0xa2, 0x07, 0x38, 0x01, 0xbe, 0x0c, 0x99, 0x0a, 0x07, 0x0e, 
+0x13, 0x01, 0x07, 0x0b, 0xc4, 0x0a, 0xcf, 0x0a, 0xf8, 0x0b, 0xad, 0x00, 
+0xfe, 0x09, 0xae, 0x12, 0x87, 0x0c, 0x2e, 0x07, 0x97, 0x18, 0x14, 0x07, 
+0x63, 0x0a, 0x30, 0x0b, 0x2b, 0x0a, 0x60, 0x12, 0xb7, 0x0b, 0xb7, 0x00, 
+0xc4, 0x0a, 0xd2, 0x0a, 0x71, 0x0a, 0x24, 0x01, 0xde, 0x08, 0xc1, 0x07, 
+0xd8, 0x18, 0x2b, 0x18, 0xee, 0x0d, 0xc2, 0x0b, 0x70, 0x18, 0xe5, 0x0d, 
+0xf5, 0x07, 0x93, 0x00, 0x40, 0x06, 0x37, 0x0a, 0x13, 0x07, 0xc8, 0x00, 
+0x8d, 0x06, 0xfd, 0x16, 0xcc, 0x0b, 0x17, 0x13, 0x2d, 0x0a, 0x51, 0x0b, 
+0xf4, 0x08, 0x96, 0x0a, 0x13, 0x01, 0xf4, 0x0c, 0x0f, 0x0a, 0xea, 0x08, 
+0x40, 0x01, 0x2d, 0x01, 0x22, 0x15, 0xb6, 0x12, 0x2d, 0x0a, 0xe6, 0x09, 
+0xd6, 0x00, 0xa8, 0x00, 0x4c, 0x00, 0x43, 0x0c, 0xd7, 0x00, 0x8e, 0x06, 
+0x8f, 0x06, 0xb5, 0x00, 0x0c, 0x00, 0xf8, 0x00, 0x23, 0x01, 0x72, 0x06, 
+0x82, 0x10, 0x54, 0x0b, 0x15, 0x14, 0x34, 0x0a, 0xdc, 0x09, 0xe2, 0x0b, 
+0x0c, 0x00, 0x2b, 0x01, 0x7e, 0x16, 0x1b, 0x0f, 0x25, 0x01, 0x09, 0x15, 
+0xfc, 0x00, 0xf0, 0x0d, 0xdb, 0x07, 0x94, 0x0b, 0xce, 0x00, 0x16, 0x01, 
+0x8d, 0x0a, 0x97, 0x0b, 0xce, 0x06, 0xa4, 0x09, 0x58, 0x0a, 0xc5, 0x0b, 
+0x25, 0x07, 0xfa, 0x15, 0x7d, 0x00, 0x93, 0x0d, 0x03, 0x01, 0x0c, 0x00, 
+0x18, 0x0c, 0x56, 0x00, 0x2e, 0x0b, 0xe0, 0x08, 0x0f, 0x00, 0x27, 0x00, 
+0xee, 0x0a, 0x0a, 0x01, 0x43, 0x0c, 0xdb, 0x0a, 0xda, 0x0b, 0x9c, 0x0c, 
+0x3a, 0x0c, 0x46, 0x06, 0x19, 0x00, 0xf3, 0x13, 0x11, 0x0d, 0x46, 0x0b, 
+0xe7, 0x09, 0x02, 0x16, 0xcc, 0x0c, 0x03, 0x0c, 0x4b, 0x06, 0x42, 0x06, 
+0xa5, 0x09, 0xf3, 0x0c, 0x4b, 0x00, 0x4b, 0x11, 0xab, 0x16, 0xd5, 0x0f, 
+0xd0, 0x09, 0x8a, 0x0b, 0xa1, 0x0b, 0x9d, 0x0c, 0xf0, 0x00, 0xa8, 0x00, 
+0xf9, 0x0a, 0xd8, 0x00, 0xdb, 0x09, 0xf4, 0x00, 0xa3, 0x15, 0x98, 0x0a, 
+0xa7, 0x09, 0x72, 0x0b, 0x47, 0x00, 0xba, 0x14, 0xa7, 0x0f, 0xfb, 0x13, 
+0x26, 0x01, 0xa9, 0x0c, 0xa3, 0x17, 0x77, 0x0a, 0x3c, 0x00, 0xe8, 0x00, 
+0x9f, 0x08, 0x6d, 0x00, 0xbe, 0x09, 0x07, 0x0b, 0x36, 0x0b, 0x36, 0x01, 
+0xf1, 0x09, 0xa1, 0x0a, 0x00, 0x0d, 0x0f, 0x01, 0x80, 0x16, 0xa3, 0x00, 
+0xbc, 0x00, 0x13, 0x07, 0x2b, 0x00, 0xf9, 0x0a, 0xb0, 0x00, 0x15, 0x14, 
+0x77, 0x0a, 0x68, 0x06, 0x10, 0x01, 0x6c, 0x00, 0xcf, 0x14, 0x36, 0x01, 
+0x87, 0x08, 0xe0, 0x16, 0xce, 0x0a, 0x5c, 0x0f, 0xdf, 0x0c, 0x8a, 0x0b, 
+0xec, 0x08, 0xf7, 0x00, 0x56, 0x00, 0x92, 0x00, 0x8a, 0x0c, 0x92, 0x00, 
+0x2c, 0x01, 0x42, 0x14, 0x64, 0x09, 0x87, 0x00, 0xf8, 0x00, 0xf7, 0x06, 
+0x74, 0x10, 0xeb, 0x0c, 0xb4, 0x00, 0xd9, 0x06, 0xf9, 0x0c, 0x73, 0x0b, 
+0xee, 0x0a, 0xbc, 0x14, 0xa9, 0x09, 0x43, 0x14, 0x6d, 0x00, 0xe5, 0x08, 
+0xc2, 0x09, 0x20, 0x0d, 0xfd, 0x09, 0xf4, 0x0b, 0x32, 0x01, 0x9d, 0x0c, 
+0xbe, 0x0a, 0xaf, 0x00, 0xd4, 0x00, 0x1d, 0x01, 0xeb, 0x0b, 0xa9, 0x16, 
+0x78, 0x00, 0x49, 0x06, 0xf7, 0x0b, 0x87, 0x0c, 0x3f, 0x0f, 0x24, 0x00, 
+0x27, 0x01, 0x36, 0x0d, 0xaf, 0x0f, 0xe9, 0x08, 0xf0, 0x00, 0xe4, 0x0a, 
+0xd0, 0x09, 0xe0, 0x0b, 0xef, 0x11, 0xa4, 0x07, 0x42, 0x13, 0x24, 0x00, 
+0x62, 0x09, 0xde, 0x08, 0xd6, 0x0b, 0x7a, 0x0c, 0xc0, 0x00, 0x82, 0x0a, 
+0x92, 0x11, 0x4a, 0x0a, 0x31, 0x00, 0x51, 0x0b, 0x65, 0x08, 0xd1, 0x00, 
+0x36, 0x09, 0x2d, 0x14, 0xe4, 0x0b, 0xdd, 0x0c, 0x3e, 0x01, 0xb1, 0x00, 
+0x3c, 0x00, 0x2c, 0x09, 0xc8, 0x0a, 0x60, 0x07, 0x1c, 0x08, 0x8a, 0x0e, 
+0x9e, 0x00, 0x5f, 0x07, 0xb0, 0x00, 0x49, 0x0a, 0xea, 0x0c, 0x3e, 0x01, 
+0x44, 0x06, 0x34, 0x00, 0xf6, 0x00, 0xc0, 0x06, 0x8a, 0x11, 0xc9, 0x0f, 
+0x6b, 0x00, 0xde, 0x0d, 0xbc, 0x00, 0xb4, 0x00, 0xd2, 0x00, 0x2e, 0x07, 
+0x5d, 0x0b, 0x1b, 0x07, 0x7f, 0x07, 0xfa, 0x14, 0x02, 0x0d, 0xb1, 0x09, 
+0x7a, 0x0a, 0x88, 0x00, 0xfd, 0x0d, 0xbc, 0x07, 0x62, 0x09, 0x6a, 0x17, 
+0xce, 0x00, 0xa9, 0x07, 0xcf, 0x06, 0xc3, 0x00, 0x08, 0x0a, 0x70, 0x07, 
+0x44, 0x0c, 0x7c, 0x16, 0xfe, 0x0c, 0x22, 0x0c, 0xaa, 0x00, 0x3d, 0x01, 
+0xa9, 0x0b, 0x0b, 0x00, 0xa8, 0x09, 0x6a, 0x0a, 0xe8, 0x13, 0xfa, 0x00, 
+0xbe, 0x00, 0x81, 0x0b, 0x4a, 0x14, 0x7c, 0x00, 0x9e, 0x0f, 0xc3, 0x00, 
+0xc6, 0x0a, 0x37, 0x0d, 0xb5, 0x00, 0x44, 0x14, 0xe1, 0x0c, 0xeb, 0x08, 
+0x9c, 0x00, 0x80, 0x08, 0x35, 0x0d, 0x16, 0x0a, 0x31, 0x00, 0x8f, 0x0b, 
+0x88, 0x09, 0x7d, 0x16, 0x59, 0x0a, 0xfb, 0x0a, 0x5d, 0x07, 0xf9, 0x13, 
+0xab, 0x07, 0xf0, 0x00, 0x81, 0x10, 0x14, 0x0a, 0x14, 0x0d, 0xf8, 0x0b, 
+0x44, 0x14, 0x1a, 0x0c, 0xb5, 0x06, 0xeb, 0x00, 0xc7, 0x00, 0x90, 0x0a, 
+0x37, 0x00, 0x10, 0x15, 0x4d, 0x0a, 0xe3, 0x09, 0x6a, 0x0a, 0xe5, 0x07, 
+0x36, 0x09, 0x22, 0x14, 0x54, 0x0b, 0x8e, 0x0a, 0x35, 0x00, 0x7a, 0x0c, 
+0x0f, 0x01, 0xba, 0x00, 0x22, 0x01, 0x22, 0x01, 0x1f, 0x14, 0x8d, 0x16, 
+0x4d, 0x0b, 0x30, 0x12, 0x12, 0x0a, 0x45, 0x16, 0x3f, 0x00, 0xdb, 0x0d, 
+0xa7, 0x00, 0xb8, 0x00, 0x13, 0x07, 0xbc, 0x09, 0x00, 0x0a, 0x69, 0x0b, 
+0x0c, 0x00, 0xca, 0x00, 0xad, 0x0f, 0xdb, 0x00, 0xed, 0x0c, 0x02, 0x0a, 
+0x33, 0x18, 0x39, 0x12, 0x5c, 0x0c, 0xd4, 0x0c, 0x6b, 0x07, 0xb9, 0x00, 
+0xf6, 0x0e, 0x02, 0x01, 0x4c, 0x07, 0xd2, 0x0c, 0x1c, 0x0c, 0xb9, 0x00, 
+0xf8, 0x0f, 0xd2, 0x00, 0xc2, 0x00, 0x26, 0x01, 0x98, 0x07, 0xa7, 0x00, 
+0xbc, 0x0a, 0xac, 0x0c, 0x17, 0x01, 0x41, 0x01, 0xaf, 0x06, 0xfd, 0x0a, 
+0x64, 0x09, 0x07, 0x01, 0x4e, 0x17, 0xee, 0x0e, 0x13, 0x01, 0xe8, 0x0c, 
+0x10, 0x07, 0xb4, 0x09, 0xb4, 0x00, 0x02, 0x0a, 0xe6, 0x00, 0x82, 0x0a, 
+0xf7, 0x0e, 0x7b, 0x00, 0xed, 0x00, 0x53, 0x07, 0xaa, 0x00, 0xc3, 0x00, 
+0x77, 0x0a, 0x4b, 0x0f, 0x30, 0x01, 0x84, 0x00, 0xc8, 0x00, 0xb9, 0x15, 
+0xd8, 0x0b, 0xb3, 0x17, 0x2d, 0x01, 0x83, 0x00, 0x34, 0x0a, 0xf4, 0x0c, 
+0x29, 0x0a, 0xdc, 0x00, 0xca, 0x0f, 0xa4, 0x09, 0xf3, 0x13, 0x92, 0x06, 
+0x26, 0x0c, 0xde, 0x0c, 0x26, 0x00, 0x92, 0x17, 0x54, 0x0c, 0x65, 0x0c, 
+0x5a, 0x08, 0xc0, 0x00, 0xca, 0x09, 0x6c, 0x1a, 0x6c, 0x0a, 0xf1, 0x09, 
+0x12, 0x0b, 0xac, 0x15, 0xc3, 0x08, 0xa9, 0x0a, 0x48, 0x00, 0x78, 0x10, 
+0x2b, 0x09, 0x62, 0x09, 0x64, 0x0c, 0xce, 0x0a, 0x2d, 0x15, 0x35, 0x0d, 
+0xad, 0x06, 0x55, 0x11, 0x63, 0x0e, 0xfd, 0x00, 0xd1, 0x0e, 0x9c, 0x00, 
+0xc4, 0x0a, 0x33, 0x15, 0xe6, 0x0b, 0x77, 0x00, 0x17, 0x01, 0x4c, 0x0f, 
+0xd1, 0x09, 0x21, 0x01, 0xdd, 0x14, 0x82, 0x18, 0x4f, 0x17, 0x4e, 0x11, 
+0xee, 0x0d, 0x61, 0x00, 0xcc, 0x0f, 0x4f, 0x06, 0xf9, 0x0b, 0xe8, 0x0c, 
+0xdb, 0x0f, 0xd3, 0x00, 0xeb, 0x0c, 0x3d, 0x1a, 0xe3, 0x00, 0x06, 0x01, 
+0xf0, 0x00, 0xd8, 0x09, 0xe5, 0x00, 0xa6, 0x0b, 0xe2, 0x13, 0x6e, 0x10, 
+0x36, 0x01, 0x07, 0x01, 0xc5, 0x00, 0xe6, 0x15, 0xa2, 0x0a, 0x44, 0x06, 
+0x18, 0x0c, 0xf9, 0x0a, 0x60, 0x00, 0xec, 0x00, 0xd0, 0x00, 0x29, 0x01, 
+0x60, 0x0b, 0xd5, 0x0c, 0xf9, 0x13, 0xfa, 0x16, 0x53, 0x13, 0x1c, 0x08, 
+0xbe, 0x00, 0x5b, 0x0c, 0xee, 0x11, 0x54, 0x0c, 0xf3, 0x09, 0xda, 0x00, 
+0xc7, 0x14, 0xe7, 0x0a, 0x3d, 0x1b, 0xdc, 0x00, 0x3e, 0x01, 0x31, 0x00, 
+0x37, 0x0b, 0xba, 0x14, 0xb4, 0x00, 0xc5, 0x09, 0x81, 0x0b, 0x7b, 0x00, 
+0x27, 0x0b, 0x11, 0x00, 0x2b, 0x1a, 0x21, 0x09, 0x2a, 0x0a, 0x43, 0x06, 
+0x1b, 0x08, 0x58, 0x0a, 0x30, 0x12, 0x1c, 0x01, 0xe1, 0x07, 0x25, 0x13, 
+0x6d, 0x11, 0x52, 0x0b, 0x3e, 0x00, 0xfa, 0x15, 0x1d, 0x0c, 0x71, 0x08, 
+0x37, 0x01, 0xb0, 0x09, 0xb3, 0x07, 0xe9, 0x00, 0xb6, 0x00, 0x1d, 0x07, 
+0xca, 0x00, 0x41, 0x0d, 0xda, 0x0d, 0x57, 0x00, 0xac, 0x00, 0xe3, 0x07, 
+0xeb, 0x13, 0x2b, 0x01, 0x22, 0x01, 0x78, 0x0a, 0x47, 0x0f, 0x3e, 0x09, 
+0x48, 0x00, 0xef, 0x00, 0x32, 0x00, 0x32, 0x12, 0xaf, 0x0b, 0x95, 0x12, 
+0x74, 0x0c, 0xe7, 0x00, 0x28, 0x00, 0x70, 0x12, 0xd5, 0x12, 0xd2, 0x00, 
+0x98, 0x0c, 0x6a, 0x0e, 0x17, 0x0c, 0xf1, 0x13, 0xfd, 0x0a, 0xa4, 0x09, 
+0xe5, 0x0b, 0xfd, 0x09, 0xe4, 0x00, 0xb6, 0x0b, 0x5d, 0x0b, 0x7e, 0x0b, 
+0xb6, 0x00, 0xd7, 0x17, 0xd5, 0x10, 0xa6, 0x0f, 0x2a, 0x0a, 0xbc, 0x00, 
+0x6b, 0x0c, 0xc8, 0x00, 0x92, 0x12, 0x0c, 0x00, 0xeb, 0x00, 0xd6, 0x18, 
+0x0d, 0x00, 0x4f, 0x17, 0xd7, 0x07, 0xcc, 0x0b, 0x5a, 0x08, 0x0f, 0x0b, 
+0xd2, 0x0b, 0xf6, 0x00, 0x8d, 0x16, 0x7a, 0x0c, 0xf0, 0x0e, 0xcd, 0x00, 
+0x97, 0x06, 0xd0, 0x0a, 0x95, 0x0f, 0xd4, 0x09, 0x84, 0x00, 0xdc, 0x0a, 
+0xeb, 0x0b, 0xe8, 0x09, 0xac, 0x0a, 0x4e, 0x17, 0x98, 0x06, 0x2c, 0x00, 
+0x31, 0x00, 0xa1, 0x15, 0xe6, 0x0d, 0x75, 0x0b, 0xf3, 0x08, 0xc7, 0x07, 
+0x07, 0x01, 0xd8, 0x0a, 0xf5, 0x07, 0xed, 0x00, 0x18, 0x01, 0x04, 0x01, 
+0x9d, 0x00, 0x40, 0x01, 0x5d, 0x0b, 0x4d, 0x00, 0x2c, 0x0b, 0xec, 0x00, 
+0x6a, 0x06, 0xf7, 0x10, 0x58, 0x0a, 0x0c, 0x00, 0xdb, 0x09, 0x3b, 0x13, 
+0xf0, 0x00, 0x4a, 0x13, 0x30, 0x01, 0xb0, 0x11, 0xfc, 0x15, 0xb5, 0x08, 
+0x04, 0x16, 0xe6, 0x09, 0x71, 0x07, 0x0f, 0x01, 0x3c, 0x10, 0x9a, 0x07, 
+0xe2, 0x00, 0x0f, 0x01, 0x88, 0x0b, 0x7a, 0x10, 0xba, 0x17, 0x2b, 0x01, 
+0x25, 0x0b, 0xdd, 0x00, 0x49, 0x00, 0x60, 0x00, 0x20, 0x1a, 0x0e, 0x01, 
+0x5d, 0x0b, 0x29, 0x0a, 0xad, 0x00, 0xa9, 0x0c, 0x70, 0x17, 0x0b, 0x01, 
+0xb3, 0x1b, 0x12, 0x01, 0xaa, 0x07, 0x51, 0x0b, 0x1e, 0x01, 0x94, 0x0f, 
+0x42, 0x06, 0x71, 0x17, 0x3a, 0x12, 0x55, 0x06, 0xe0, 0x00, 0x83, 0x11, 
+0xef, 0x0e, 0x8c, 0x0a, 0x6c, 0x11, 0xc1, 0x12, 0xb3, 0x00, 0x05, 0x01, 
+0xe3, 0x06, 0xad, 0x00, 0xca, 0x12, 0xb3, 0x08, 0x3e, 0x0b, 0x23, 0x00, 
+0xf3, 0x0c, 0x61, 0x0a, 0x23, 0x08, 0xbc, 0x0c, 0xe3, 0x0a, 0x97, 0x18, 
+0xe1, 0x00, 0x1c, 0x01, 0x61, 0x00, 0xb0, 0x00, 0x0f, 0x01, 0xb2, 0x09, 
+0xc9, 0x00, 0xc5, 0x09, 0x12, 0x0d, 0x6b, 0x00, 0x53, 0x00, 0x1d, 0x0c, 
+0x25, 0x0b, 0xa0, 0x00, 0xc2, 0x07, 0xbd, 0x09, 0x9f, 0x07, 0x75, 0x0a, 
+0xb4, 0x0b, 0xbc, 0x00, 0x25, 0x08, 0x42, 0x06, 0x2f, 0x0a, 0x19, 0x08, 
+0xb1, 0x0c, 0x70, 0x07, 0x30, 0x11, 0xf2, 0x17, 0x7a, 0x0a, 0xc9, 0x00, 
+0xbb, 0x00, 0x6e, 0x10, 0xf9, 0x13, 0x70, 0x12, 0x9f, 0x00, 0xe4, 0x0a, 
+0x5f, 0x06, 0x62, 0x00, 0xba, 0x08, 0x3c, 0x01, 0xdc, 0x09, 0x00, 0x14, 
+0xf7, 0x00, 0x13, 0x01, 0x21, 0x15, 0x71, 0x0c, 0xc4, 0x00, 0x8a, 0x0b, 
+0x9e, 0x00, 0xcc, 0x11, 0x10, 0x07, 0xcb, 0x00, 0x5c, 0x08, 0xec, 0x0b, 
+0x3e, 0x01, 0x9b, 0x06, 0xa0, 0x1a, 0x53, 0x00, 0x9c, 0x0c, 0x40, 0x01, 
+0xb2, 0x0a, 0xdd, 0x0d, 0xba, 0x00, 0x67, 0x00, 0xf8, 0x17, 0xde, 0x16, 
+0x5f, 0x0a, 0xb0, 0x00, 0x21, 0x09, 0x3d, 0x01, 0xfe, 0x0c, 0xca, 0x00, 
+0x7d, 0x10, 0x83, 0x00, 0xae, 0x00, 0x41, 0x00, 0x85, 0x16, 0xba, 0x00, 
+0xfa, 0x14, 0x83, 0x0a, 0x32, 0x18, 0x63, 0x1b, 0x2c, 0x16, 0x0c, 0x13, 
+0x56, 0x11, 0x1e, 0x1a, 0xb1, 0x09, 0xbb, 0x08, 0x61, 0x0a, 0x24, 0x01, 
+0x46, 0x0b, 0x04, 0x0c, 0x3e, 0x0b, 0x10, 0x01, 0xd6, 0x07, 0xe9, 0x0c, 
+0x3b, 0x00, 0x72, 0x07, 0x05, 0x13, 0x0b, 0x15, 0x73, 0x00, 0xe0, 0x0c, 
+0x47, 0x0b, 0xdd, 0x00, 0xa6, 0x09, 0x2f, 0x01, 0xe6, 0x09, 0x13, 0x0e, 
+0x1e, 0x13, 0xb4, 0x17, 0x36, 0x0a, 0x1c, 0x07, 0xf1, 0x0d, 0x3b, 0x01, 
+0xcc, 0x00, 0x02, 0x01, 0x23, 0x00, 0xe0, 0x0c, 0x37, 0x07, 0x1c, 0x00, 
+0x46, 0x06, 0x51, 0x0b, 0x9e, 0x0c, 0x1c, 0x0b, 0x09, 0x16, 0x47, 0x06, 
+0x47, 0x00, 0x29, 0x0a, 0x57, 0x06, 0xf3, 0x08, 0xc1, 0x00, 0xe1, 0x0a, 
+0x52, 0x0b, 0xd2, 0x09, 0x16, 0x0a, 0xda, 0x0b, 0x67, 0x13, 0x4e, 0x0b, 
+0x5f, 0x12, 0x32, 0x00, 0x97, 0x0a, 0x45, 0x06, 0x95, 0x0b, 0x0d, 0x00, 
+0xac, 0x0a, 0xe4, 0x00, 0xa0, 0x00, 0x12, 0x07, 0x3f, 0x00, 0xbb, 0x0a, 
+0xaa, 0x00, 0xab, 0x00, 0xc4, 0x0a, 0x41, 0x01, 0x97, 0x06, 0x9d, 0x00, 
+0x10, 0x0b, 0xcf, 0x18, 0x84, 0x0b, 0x7b, 0x08, 0xee, 0x00, 0xc4, 0x00, 
+0xf8, 0x0c, 0x08, 0x01, 0x96, 0x15, 0x8f, 0x09, 0x8e, 0x0b, 0x9d, 0x0f, 
+0x9f, 0x07, 0xb3, 0x15, 0x16, 0x01, 0xc4, 0x10, 0x0c, 0x0f, 0x24, 0x00, 
+0x6e, 0x14, 0xc4, 0x00, 0xbf, 0x15, 0x10, 0x07, 0x26, 0x0b, 0xad, 0x0b, 
+0x18, 0x1a, 0x14, 0x01, 0xd3, 0x00, 0x70, 0x00, 0x17, 0x00, 0x23, 0x00, 
+0x26, 0x16, 0xb4, 0x0f, 0xe3, 0x00, 0x41, 0x01, 0x6b, 0x0a, 0x54, 0x11, 
+0xcc, 0x00, 0xe8, 0x00, 0xda, 0x11, 0x1a, 0x00, 0x45, 0x1c, 0x90, 0x00, 
+0x43, 0x06, 0x22, 0x0c, 0x97, 0x15, 0x1d, 0x0c, 0xb4, 0x0a, 0x79, 0x09, 
+0x10, 0x07, 0xff, 0x00, 0xd8, 0x00, 0x64, 0x0c, 0x85, 0x00, 0xe3, 0x06, 
+0xcd, 0x00, 0x1a, 0x1a, 0xdb, 0x00, 0xa2, 0x06, 0x3d, 0x00, 0x48, 0x00, 
+0x40, 0x06, 0xb7, 0x10, 0x5c, 0x0c, 0xb2, 0x10, 0x74, 0x16, 0x3d, 0x01, 
+0x74, 0x16, 0xa0, 0x08, 0x0a, 0x0a, 0x11, 0x0d, 0x51, 0x00, 0x67, 0x0c, 
+0xbb, 0x00, 0x31, 0x0b, 0xe7, 0x0a, 0xa9, 0x10, 0xb3, 0x09, 0x34, 0x01, 
+0x23, 0x00, 0xd6, 0x00, 0xd1, 0x0e, 0xd7, 0x00, 0xf0, 0x16, 0xda, 0x10, 
+0x41, 0x0b, 0x37, 0x01, 0x90, 0x0c, 0x4e, 0x17, 0xdf, 0x00, 0x46, 0x0d, 
+0x13, 0x07, 0xd2, 0x0c, 0x30, 0x0c, 0xb2, 0x0c, 0x02, 0x01, 0xab, 0x07, 
+0x40, 0x06, 0xda, 0x0a, 0xad, 0x00, 0xd7, 0x18, 0x6c, 0x00, 0x74, 0x0b, 
+0xfe, 0x0a, 0x63, 0x00, 0x4b, 0x00, 0x98, 0x0d, 0x70, 0x12, 0x4e, 0x09, 
+0x4a, 0x06, 0x5a, 0x0c, 0xec, 0x07, 0xd6, 0x07, 0xa1, 0x07, 0x5b, 0x08, 
+0xf2, 0x13, 0xe9, 0x08, 0xba, 0x09, 0xfb, 0x00, 0x0a, 0x01, 0xce, 0x00, 
+0x27, 0x0d, 0x8e, 0x0b, 0x9c, 0x00, 0xac, 0x18, 0xe4, 0x00, 0x81, 0x0a, 
+0x14, 0x0d, 0x6a, 0x00, 0x23, 0x00, 0x35, 0x01, 0x5c, 0x17, 0x88, 0x00, 
+0xdd, 0x0d, 0x89, 0x0b, 0xf4, 0x00, 0x55, 0x00, 0x32, 0x08, 0x59, 0x0c, 
+0xf9, 0x0a, 0x56, 0x1a, 0x41, 0x01, 0x1a, 0x0b, 0xd5, 0x00, 0xe4, 0x00, 
+0x40, 0x01, 0x03, 0x0c, 0x12, 0x0b, 0x40, 0x00, 0x79, 0x10, 0xe6, 0x00, 
+0x91, 0x0c, 0x75, 0x0a, 0x05, 0x01, 0x33, 0x00, 0x72, 0x16, 0xae, 0x0a, 
+0x7e, 0x00, 0xeb, 0x0b, 0xc0, 0x0b, 0x46, 0x0d, 0x2f, 0x00, 0xae, 0x00, 
+0x1b, 0x01, 0xc9, 0x0e, 0x68, 0x06, 0xc1, 0x09, 0x3b, 0x12, 0xe9, 0x0c, 
+0x42, 0x0b, 0x18, 0x0c, 0xaf, 0x09, 0xb3, 0x07, 0x2b, 0x09, 0xda, 0x00, 
+0xa7, 0x00, 0xa8, 0x00, 0xb2, 0x09, 0x1a, 0x00, 0xfa, 0x15, 0x42, 0x01, 
+0x4b, 0x0b, 0xc6, 0x14, 0xe5, 0x0d, 0x7d, 0x0b, 0x8f, 0x00, 0x8f, 0x00, 
+0xb1, 0x10, 0xc3, 0x0a, 0x49, 0x06, 0x9f, 0x12, 0xe5, 0x06, 0xfc, 0x00, 
+0xc1, 0x17, 0xaa, 0x16, 0x8d, 0x0a, 0xa8, 0x08, 0xdb, 0x00, 0x02, 0x01, 
+0x47, 0x0b, 0xda, 0x00, 0x5e, 0x0c, 0xaa, 0x0c, 0x00, 0x01, 0xe3, 0x0a, 
+0xd2, 0x09, 0x8c, 0x10, 0xd2, 0x06, 0x38, 0x08, 0x2e, 0x01, 0x60, 0x0c, 
+0xfd, 0x09, 0x8c, 0x0a, 0x82, 0x10, 0x50, 0x0a, 0x8f, 0x00, 0x95, 0x0e, 
+0x66, 0x11, 0x12, 0x0b, 0x4a, 0x00, 0x97, 0x07, 0x3f, 0x0d, 0x86, 0x17, 
+0x45, 0x06, 0x5b, 0x08, 0x67, 0x0c, 0x85, 0x17, 0x2a, 0x01, 0xdf, 0x00, 
+0x68, 0x06, 0x6e, 0x00, 0x70, 0x00, 0x71, 0x1b, 0x1a, 0x00, 0xb3, 0x08, 
+0xdd, 0x09, 0x6b, 0x0d, 0x9c, 0x0c, 0x36, 0x01, 0x3e, 0x01, 0x2a, 0x13, 
+0xb1, 0x00, 0xb0, 0x00, 0x8d, 0x08, 0xc4, 0x00, 0xc2, 0x08, 0xf1, 0x14, 
+0xa4, 0x09, 0xc7, 0x09, 0x46, 0x06, 0xc6, 0x09, 0x2e, 0x0c, 0xda, 0x0d, 
+0x43, 0x06, 0xa1, 0x08, 0xee, 0x0b, 0x65, 0x0c, 0xba, 0x00, 0x50, 0x17, 
+0x91, 0x06, 0xf3, 0x09, 0xdd, 0x0c, 0xed, 0x0e, 0x1a, 0x01, 0x1a, 0x00, 
+0x85, 0x0b, 0xc7, 0x00, 0x0d, 0x0c, 0x86, 0x00, 0xf6, 0x0d, 0xdb, 0x0a, 
+0xc2, 0x08, 0xf7, 0x00, 0xee, 0x00, 0x39, 0x0c, 0xae, 0x00, 0x13, 0x11, 
+0xc9, 0x09, 0x3a, 0x12, 0xb9, 0x0e, 0x39, 0x01, 0x89, 0x14, 0x1a, 0x0c, 
+0x19, 0x00, 0xde, 0x09, 0x96, 0x15, 0xfa, 0x13, 0xf6, 0x0c, 0xde, 0x08, 
+0x3a, 0x0c, 0xa7, 0x0a, 0x19, 0x0c, 0x11, 0x01, 0x3a, 0x01, 0x37, 0x00, 
+0xa3, 0x0a, 0x48, 0x06, 0x8d, 0x06, 0x3f, 0x0f, 0x14, 0x0a, 0xd3, 0x00, 
+0x52, 0x0b, 0xa5, 0x09, 0x30, 0x00, 0xa2, 0x0a, 0xa2, 0x15, 0xae, 0x0a, 
+0xa4, 0x17, 0x83, 0x00, 0x49, 0x00, 0xf9, 0x18, 0x9c, 0x00, 0x9e, 0x00, 
+0xe3, 0x0a, 0xb9, 0x00, 0xde, 0x13, 0xa8, 0x0c, 0xad, 0x0a, 0x5b, 0x0c, 
+0xc7, 0x09, 0xb3, 0x00, 0xf7, 0x00, 0xc7, 0x0c, 0xf0, 0x00, 0x2c, 0x01, 
+0x4c, 0x0a, 0x35, 0x09, 0xc9, 0x09, 0x7b, 0x13, 0xe4, 0x00, 0xcd, 0x14, 
+0x05, 0x13, 0xa5, 0x09, 0xeb, 0x07, 0xfa, 0x00, 0x66, 0x08, 0x1e, 0x01, 
+0xbc, 0x09, 0x4b, 0x0b, 0x46, 0x09, 0x29, 0x00, 0xb2, 0x16, 0x19, 0x01, 
+0xe6, 0x00, 0x18, 0x11, 0x65, 0x09, 0xd1, 0x00, 0x91, 0x0a, 0xb0, 0x18, 
+0x43, 0x06, 0xbc, 0x07, 0x4e, 0x17, 0xc2, 0x0a, 0x5f, 0x0b, 0x1b, 0x01, 
+0x41, 0x18, 0x67, 0x07, 0x56, 0x06, 0x7b, 0x0c, 0x13, 0x0a, 0x3b, 0x00, 
+0x3a, 0x1a, 0xdb, 0x00, 0x7c, 0x18, 0x3c, 0x16, 0x86, 0x0c, 0x1a, 0x00, 
+0xe9, 0x08, 0x6e, 0x09, 0xd2, 0x10, 0x1d, 0x07, 0x85, 0x16, 0x1d, 0x0a, 
+0x96, 0x07, 0x82, 0x0b, 0xfb, 0x0a, 0x01, 0x0d, 0x5f, 0x00, 0xd5, 0x0b, 
+0xaf, 0x09, 0x83, 0x0b, 0x12, 0x07, 0xb8, 0x00, 0x3b, 0x00, 0xae, 0x00, 
+0xec, 0x07, 0xb4, 0x0b, 0x5f, 0x0a, 0xc7, 0x0c, 0xf3, 0x0a, 0xc5, 0x00, 
+0xdf, 0x0c, 0x9e, 0x08, 0xd6, 0x18, 0x9f, 0x0b, 0xb7, 0x12, 0xed, 0x00, 
+0x72, 0x16, 0x46, 0x0c, 0x20, 0x0b, 0x5d, 0x0b, 0xa9, 0x00, 0x6f, 0x0c, 
+0xde, 0x08, 0x26, 0x00, 0xc0, 0x0e, 0x22, 0x0c, 0x28, 0x0a, 0xb8, 0x0a, 
+0x4e, 0x0a, 0xb4, 0x1b, 0x68, 0x0b, 0x6a, 0x06, 0x77, 0x06, 0x1e, 0x01, 
+0x77, 0x09, 0xd5, 0x0b, 0x4c, 0x0a, 0x9d, 0x08, 0x4c, 0x06, 0xca, 0x12, 
+0xb6, 0x00, 0xea, 0x13, 0xae, 0x00, 0x11, 0x07, 0x30, 0x00, 0x20, 0x01, 
+0x9b, 0x0a, 0xf3, 0x0c, 0xd3, 0x06, 0x6c, 0x00, 0x40, 0x00, 0x32, 0x01, 
+0x59, 0x00, 0x30, 0x00, 0x15, 0x13, 0xbc, 0x00, 0xd5, 0x00, 0x19, 0x00, 
+0xa9, 0x0c, 0x40, 0x08, 0x2e, 0x15, 0x97, 0x06, 0xee, 0x0e, 0x21, 0x15, 
+0x58, 0x00, 0x5a, 0x17, 0x1f, 0x0a, 0x6a, 0x17, 0xbf, 0x0c, 0xa2, 0x06, 
+0x88, 0x00, 0x16, 0x13, 0xaa, 0x15, 0xc7, 0x14, 0xe4, 0x08, 0x37, 0x0d, 
+0x7d, 0x00, 0x95, 0x0c, 0x18, 0x01, 0xcf, 0x14, 0xfa, 0x00, 0x9d, 0x0a, 
+0x3b, 0x16, 0xf2, 0x0b, 0x07, 0x18, 0x26, 0x0d, 0x9a, 0x0a, 0xcd, 0x00, 
+0x33, 0x01, 0xda, 0x06, 0x63, 0x0e, 0xd3, 0x10, 0x12, 0x0b, 0x24, 0x0c, 
+0x0a, 0x15, 0xe8, 0x00, 0x6f, 0x08, 0x30, 0x08, 0x68, 0x07, 0xb3, 0x06, 
+0xbd, 0x00, 0x47, 0x0d, 0xfb, 0x00, 0x43, 0x09, 0x3a, 0x0c, 0x7e, 0x1b, 
+0x04, 0x15, 0x47, 0x06, 0x47, 0x0b, 0xd3, 0x00, 0x40, 0x01, 0xc7, 0x16, 
+0x21, 0x10, 0x98, 0x06, 0xf4, 0x0c, 0x3d, 0x01, 0x1b, 0x01, 0x3d, 0x0b, 
+0x6b, 0x00, 0xa3, 0x0b, 0x4d, 0x0d, 0x3a, 0x01, 0xe2, 0x00, 0x9d, 0x08, 
+0xd1, 0x0e, 0xe0, 0x0b, 0xfd, 0x14, 0x89, 0x10, 0x66, 0x0c, 0x66, 0x00, 
+0xa5, 0x09, 0x3f, 0x0c, 0xce, 0x00, 0x16, 0x01, 0xf1, 0x14, 0x69, 0x07, 
+0x44, 0x06, 0x0b, 0x01, 0x66, 0x14, 0x26, 0x01, 0xa2, 0x0a, 0xec, 0x08, 
+0xff, 0x00, 0x12, 0x01, 0xab, 0x00, 0x09, 0x01, 0x4d, 0x11, 0x22, 0x0a, 
+0x0c, 0x0a, 0xeb, 0x0c, 0xd4, 0x00, 0x2b, 0x0a, 0x1a, 0x0e, 0x49, 0x06, 
+0x36, 0x0d, 0x0c, 0x00, 0x07, 0x17, 0x2d, 0x1b, 0x62, 0x00, 0x72, 0x0c, 
+0x3e, 0x07, 0xac, 0x15, 0xde, 0x16, 0xeb, 0x0c, 0xc8, 0x09, 0x55, 0x00, 
+0x3e, 0x01, 0xa0, 0x0b, 0xd2, 0x10, 0xb7, 0x0e, 0x41, 0x1a, 0x68, 0x0b, 
+0xd6, 0x00, 0xf4, 0x0c, 0xb6, 0x17, 0x05, 0x0f, 0x0e, 0x01, 0xd7, 0x16, 
+0xd4, 0x00, 0x86, 0x00, 0x94, 0x07, 0x5d, 0x07, 0x63, 0x17, 0x2a, 0x0b, 
+0x4a, 0x0d, 0xef, 0x00, 0x2a, 0x12, 0x46, 0x0d, 0x46, 0x0d, 0x0a, 0x01, 
+0x2b, 0x1a, 0x42, 0x0a, 0x93, 0x0d, 0x46, 0x06, 0xa7, 0x00, 0x2b, 0x17, 
+0x44, 0x0c, 0x2a, 0x0a, 0x52, 0x17, 0x6f, 0x10, 0x5d, 0x11, 0x74, 0x0d, 
+0x11, 0x07, 0x62, 0x00, 0x8c, 0x12, 0xfb, 0x00, 0x68, 0x06, 0x94, 0x0c, 
+0xf7, 0x09, 0xdd, 0x0c, 0x92, 0x0f, 0xc3, 0x0e, 0x2d, 0x01, 0x4a, 0x06, 
+0x83, 0x00, 0x76, 0x0a, 0x42, 0x01, 0xba, 0x07, 0x91, 0x0c, 0xb2, 0x17, 
+0xeb, 0x00, 0xc8, 0x09, 0xf9, 0x0e, 0x77, 0x17, 0x13, 0x0c, 0xcb, 0x0b, 
+0xdf, 0x09, 0xab, 0x07, 0x05, 0x01, 0x6c, 0x11, 0xd3, 0x00, 0xb5, 0x00, 
+0xd6, 0x14, 0x4a, 0x14, 0x79, 0x10, 0x8e, 0x0b, 0xd8, 0x00, 0xcf, 0x15, 
+0x47, 0x00, 0x39, 0x15, 0xd8, 0x0c, 0xf8, 0x00, 0x3a, 0x16, 0x57, 0x18, 
+0x14, 0x01, 0xe3, 0x18, 0x3d, 0x07, 0x8b, 0x0a, 0x86, 0x0c, 0x9f, 0x07, 
+0xee, 0x0a, 0x61, 0x00, 0xf8, 0x00, 0x46, 0x0f, 0xfc, 0x18, 0x72, 0x0b, 
+0x18, 0x0c, 0x65, 0x17, 0x0b, 0x00, 0xa7, 0x0c, 0xe8, 0x0c, 0x1a, 0x08, 
+0xd1, 0x00, 0xa4, 0x0b, 0xd8, 0x0c, 0x86, 0x00, 0x1c, 0x01, 0x98, 0x0b, 
+0xd0, 0x09, 0xfc, 0x06, 0x48, 0x00, 0x3e, 0x0a, 0x3d, 0x00, 0xdb, 0x00, 
+0x5f, 0x0b, 0x14, 0x01, 0xee, 0x0a, 0x0e, 0x01, 0x7a, 0x00, 0x20, 0x01, 
+0xd0, 0x06, 0x86, 0x16, 0xd4, 0x10, 0x43, 0x06, 0x8e, 0x16, 0x5f, 0x18, 
+0x26, 0x01, 0x4b, 0x06, 0x0c, 0x0d, 0xa9, 0x00, 0xce, 0x06, 0x3b, 0x01, 
+0x7f, 0x0b, 0xca, 0x0c, 0x55, 0x11, 0xa3, 0x0d, 0x85, 0x0c, 0x42, 0x0a, 
+0xb8, 0x00, 0x00, 0x0a, 0x20, 0x01, 0xd7, 0x09, 0x17, 0x00, 0x70, 0x17, 
+0x42, 0x06, 0x6f, 0x10, 0xed, 0x0d, 0x30, 0x0b, 0x69, 0x0c, 0x1b, 0x0b, 
+0x85, 0x0c, 0x48, 0x06, 0x58, 0x00, 0x9a, 0x0a, 0x1d, 0x0b, 0xde, 0x00, 
+0x4b, 0x00, 0x9b, 0x0c, 0xdf, 0x08, 0x16, 0x0a, 0xdb, 0x09, 0x3e, 0x01, 
+0xaa, 0x00, 0xa6, 0x0a, 0x3b, 0x0b, 0xbb, 0x14, 0x96, 0x15, 0xca, 0x0e, 
+0x43, 0x07, 0xb1, 0x0c, 0x82, 0x14, 0x1d, 0x0e, 0x80, 0x09, 0x26, 0x0d, 
+0x40, 0x06, 0x16, 0x01, 0xde, 0x15, 0xee, 0x07, 0x0c, 0x0c, 0x71, 0x0c, 
+0x03, 0x01, 0xba, 0x14, 0x13, 0x01, 0x66, 0x00, 0x60, 0x00, 0x19, 0x01, 
+0x33, 0x00, 0x12, 0x01, 0x6b, 0x1a, 0x75, 0x0f, 0xfc, 0x09, 0x72, 0x0c, 
+0xc7, 0x0a, 0xbe, 0x09, 0x19, 0x01, 0x3c, 0x1a, 0x63, 0x19, 0x90, 0x0c, 
+0xf4, 0x06, 0xc4, 0x00, 0x4a, 0x11, 0x1d, 0x0a, 0x0e, 0x00, 0x4a, 0x06, 
+0x19, 0x08, 0x15, 0x0d, 0x28, 0x12, 0x12, 0x0e, 0xa1, 0x0b, 0x5f, 0x08, 
+0xbb, 0x00, 0xf4, 0x0c, 0xe2, 0x0b, 0x42, 0x0e, 0x3e, 0x0e, 0x1e, 0x01, 
+0x4f, 0x0a, 0x77, 0x00, 0x41, 0x1a, 0xe1, 0x0d, 0x85, 0x16, 0xb7, 0x0b, 
+0x6c, 0x0a, 0x91, 0x00, 0x9f, 0x0f, 0x5b, 0x08, 0x11, 0x0d, 0x20, 0x07, 
+0x8f, 0x00, 0x90, 0x0c, 0xad, 0x00, 0x6b, 0x0a, 0x40, 0x0b, 0x23, 0x08, 
+0x16, 0x07, 0x5d, 0x12, 0x18, 0x01, 0x11, 0x09, 0x49, 0x0a, 0x67, 0x1b, 
+0x0e, 0x01, 0xe2, 0x00, 0x07, 0x01, 0x70, 0x10, 0x81, 0x11, 0x7c, 0x0a, 
+0xdc, 0x0d, 0x85, 0x17, 0x3d, 0x0b, 0x83, 0x0b, 0x5e, 0x11, 0xbb, 0x00, 
+0x60, 0x00, 0x93, 0x12, 0x89, 0x0b, 0xdf, 0x16, 0xd1, 0x06, 0x29, 0x0b, 
+0x21, 0x0b, 0x68, 0x00, 0x7d, 0x0b, 0x6e, 0x00, 0x90, 0x0c, 0x2d, 0x0c, 
+0xd3, 0x0c, 0x5e, 0x11, 0xb5, 0x00, 0x46, 0x0c, 0x47, 0x0c, 0x8b, 0x11, 
+0x15, 0x01, 0x2f, 0x00, 0xda, 0x0a, 0x2c, 0x0a, 0x90, 0x00, 0xdc, 0x0d, 
+0x63, 0x00, 0x1b, 0x0c, 0xa4, 0x07, 0xac, 0x0c, 0xa8, 0x00, 0x5e, 0x07, 
+0x34, 0x01, 0xc2, 0x0c, 0x29, 0x0a, 0x9b, 0x12, 0x59, 0x17, 0xa4, 0x0b, 
+0xae, 0x00, 0xe6, 0x0a, 0x3b, 0x16, 0x25, 0x0b, 0x04, 0x0b, 0xcf, 0x15, 
+0x2a, 0x01, 0x08, 0x0b, 0x3e, 0x00, 0x27, 0x09, 0xbc, 0x14, 0xfa, 0x16, 
+0xaf, 0x0a, 0x5f, 0x07, 0xde, 0x18, 0x73, 0x09, 0x28, 0x00, 0x40, 0x0f, 
+0xa9, 0x0c, 0xd0, 0x06, 0x1c, 0x01, 0xd0, 0x00, 0xc2, 0x08, 0x76, 0x12, 
+0xb2, 0x0b, 0x23, 0x01, 0x78, 0x08, 0x9a, 0x09, 0xdd, 0x00, 0xe9, 0x0c, 
+0x92, 0x0c, 0x71, 0x08, 0x04, 0x13, 0x70, 0x00, 0x61, 0x12, 0xe0, 0x12, 
+0xa5, 0x0a, 0xa8, 0x08, 0x4b, 0x15, 0x86, 0x00, 0xf3, 0x0c, 0x26, 0x01, 
+0xd2, 0x0a, 0x6a, 0x06, 0x20, 0x0a, 0x63, 0x09, 0xdd, 0x00, 0xcd, 0x0b, 
+0xa6, 0x0f, 0x34, 0x00, 0x19, 0x01, 0x31, 0x1c, 0x48, 0x0b, 0x22, 0x0e, 
+0x62, 0x09, 0xfc, 0x08, 0x39, 0x0c, 0x72, 0x0d, 0xc8, 0x0a, 0x26, 0x15, 
+0x18, 0x00, 0xe8, 0x13, 0x1f, 0x0a, 0x56, 0x00, 0x43, 0x0c, 0xea, 0x00, 
+0x2a, 0x01, 0x9e, 0x0b, 0x7b, 0x0c, 0x10, 0x01, 0xd1, 0x00, 0x4e, 0x0c, 
+0xc3, 0x17, 0x48, 0x00, 0x19, 0x00, 0x7a, 0x00, 0xe4, 0x00, 0x3c, 0x00, 
+0xd4, 0x0c, 0xbc, 0x09, 0x0e, 0x01, 0xdf, 0x13, 0xa9, 0x07, 0x18, 0x01, 
+0x14, 0x0d, 0x69, 0x0b, 0xf9, 0x0b, 0x4e, 0x11, 0x24, 0x01, 0xb4, 0x0c, 
+0x1c, 0x0b, 0x02, 0x0c, 0x85, 0x00, 0x22, 0x09, 0x73, 0x06, 0x3c, 0x00, 
+0x4c, 0x10, 0xd2, 0x09, 0xf2, 0x14, 0x3d, 0x0b, 0xc6, 0x00, 0x32, 0x12, 
+0x04, 0x19, 0xe5, 0x00, 0x99, 0x0a, 0x64, 0x0a, 0xfa, 0x00, 0x3d, 0x01, 
+0xfa, 0x10, 0x8b, 0x11, 0xa6, 0x0c, 0x91, 0x00, 0xdd, 0x0c, 0xe8, 0x0a, 
+0x2b, 0x09, 0xdd, 0x00, 0xc8, 0x0a, 0x4d, 0x00, 0xe5, 0x00, 0xb3, 0x0c, 
+0x4b, 0x14, 0x90, 0x17, 0x77, 0x00, 0x6b, 0x00, 0xd9, 0x00, 0x54, 0x16, 
+0xae, 0x0d, 0xd5, 0x0b, 0x2a, 0x0e, 0x61, 0x00, 0x8b, 0x11, 0xfe, 0x09, 
+0x25, 0x0b, 0xe4, 0x00, 0x3f, 0x08, 0xd2, 0x00, 0x43, 0x0a, 0x30, 0x00, 
+0x75, 0x12, 0xb8, 0x00, 0xba, 0x16, 0x6a, 0x0d, 0xe9, 0x00, 0xb1, 0x00, 
+0x09, 0x0d, 0xfe, 0x0f, 0x43, 0x07, 0x18, 0x00, 0x89, 0x0b, 0x73, 0x14, 
+0x3d, 0x00, 0x73, 0x00, 0x60, 0x18, 0x25, 0x01, 0xa5, 0x09, 0xef, 0x00, 
+0x35, 0x0d, 0xa4, 0x00, 0xb9, 0x0a, 0xfe, 0x00, 0xc9, 0x00, 0xa3, 0x15, 
+0x88, 0x00, 0xd6, 0x07, 0x6a, 0x0a, 0x10, 0x0b, 0xa9, 0x07, 0xa5, 0x09, 
+0x53, 0x0c, 0x82, 0x0b, 0x77, 0x00, 0x81, 0x07, 0x2a, 0x0a, 0x0c, 0x00, 
+0xf7, 0x00, 0x86, 0x17, 0xea, 0x00, 0xb0, 0x00, 0x77, 0x00, 0xc4, 0x14, 
+0xb2, 0x0a, 0x49, 0x12, 0x8e, 0x1a, 0x95, 0x07, 0x23, 0x0c, 0x96, 0x0a, 
+0x2b, 0x01, 0x9b, 0x06, 0xed, 0x0d, 0x18, 0x11, 0xf3, 0x14, 0x16, 0x01, 
+0xf7, 0x0c, 0xa9, 0x16, 0xe7, 0x09, 0xa3, 0x00, 0x27, 0x15, 0x05, 0x01, 
+0xf6, 0x0e, 0x9b, 0x00, 0x97, 0x15, 0x45, 0x06, 0x4a, 0x06, 0x9c, 0x0f, 
+0x8d, 0x06, 0xdc, 0x07, 0xde, 0x09, 0x44, 0x06, 0xbd, 0x08, 0x5d, 0x0b, 
+0xca, 0x0b, 0xf0, 0x00, 0x8b, 0x12, 0xaa, 0x16, 0x33, 0x00, 0x6d, 0x09, 
+0xb8, 0x00, 0x29, 0x0a, 0x9f, 0x07, 0xfb, 0x06, 0xe7, 0x10, 0xe4, 0x00, 
+0x98, 0x0b, 0x95, 0x00, 0x41, 0x06, 0x42, 0x06, 0xba, 0x00, 0xb0, 0x10, 
+0xfc, 0x00, 0x14, 0x0a, 0x4a, 0x06, 0x5d, 0x0c, 0x32, 0x00, 0xbc, 0x09, 
+0xea, 0x0c, 0x01, 0x0d, 0x6f, 0x12, 0x60, 0x1b, 0x70, 0x07, 0x9c, 0x00, 
+0xa6, 0x09, 0xe2, 0x00, 0x87, 0x1b, 0xe3, 0x0e, 0x9b, 0x00, 0x52, 0x07, 
+0xc8, 0x00, 0x02, 0x16, 0x8a, 0x0b, 0x5f, 0x0c, 0x72, 0x07, 0x14, 0x01, 
+0x93, 0x0b, 0x1e, 0x0b, 0xd5, 0x00, 0xf9, 0x00, 0xf6, 0x10, 0x50, 0x0a, 
+0x0f, 0x01, 0x01, 0x10, 0x84, 0x00, 0xfc, 0x14, 0x4c, 0x14, 0xa7, 0x0c, 
+0x2d, 0x0c, 0x73, 0x0b, 0xb5, 0x0b, 0xb1, 0x0c, 0x7e, 0x1a, 0xd6, 0x15, 
+0x45, 0x06, 0x2b, 0x1a, 0x55, 0x0a, 0x39, 0x01, 0xba, 0x17, 0xae, 0x0f, 
+0xda, 0x06, 0xe9, 0x0d, 0x47, 0x06, 0x44, 0x06, 0x79, 0x0a, 0xa5, 0x09, 
+0x7c, 0x00, 0xd7, 0x00, 0x16, 0x01, 0x1b, 0x07, 0x5d, 0x07, 0xe8, 0x09, 
+0xe4, 0x00, 0x1f, 0x00, 0xae, 0x00, 0xc1, 0x0b, 0xc5, 0x09, 0x17, 0x1a, 
+0x74, 0x00, 0x2f, 0x01, 0x29, 0x08, 0x0a, 0x01, 0x29, 0x0b, 0x1c, 0x00, 
+0x7c, 0x0c, 0x36, 0x08, 0xe4, 0x17, 0xbe, 0x10, 0x48, 0x06, 0x9e, 0x08, 
+0xcd, 0x0f, 0xa2, 0x0b, 0x23, 0x0d, 0x11, 0x0d, 0x13, 0x0a, 0xc7, 0x00, 
+0x33, 0x01, 0xbe, 0x0a, 0x1f, 0x16, 0x26, 0x07, 0xec, 0x1b, 0xe0, 0x00, 
+0xe1, 0x13, 0x79, 0x15, 0x38, 0x01, 0x27, 0x01, 0x6a, 0x0c, 0xc2, 0x07, 
+0xcd, 0x0a, 0xd2, 0x00, 0x32, 0x0b, 0x93, 0x0f, 0x38, 0x0c, 0xb8, 0x0a, 
+0x03, 0x0a, 0xab, 0x06, 0x3b, 0x18, 0xf5, 0x08, 0x94, 0x0b, 0x9f, 0x00, 
+0x17, 0x1a, 0x84, 0x00, 0x89, 0x00, 0x77, 0x00, 0x30, 0x01, 0xc7, 0x0c, 
+0xa8, 0x0d, 0x1e, 0x1a, 0x12, 0x07, 0xf9, 0x10, 0x6f, 0x00, 0x7b, 0x0c, 
+0x84, 0x00, 0x0a, 0x07, 0xcb, 0x00, 0x5e, 0x12, 0xb6, 0x08, 0xc5, 0x09, 
+0xbf, 0x00, 0xa2, 0x15, 0x4f, 0x17, 0xd7, 0x17, 0x78, 0x00, 0x95, 0x00, 
+0xba, 0x0f, 0x86, 0x0c, 0x33, 0x01, 0x34, 0x00, 0xe0, 0x11, 0xaf, 0x00, 
+0xdc, 0x0a, 0xac, 0x16, 0x90, 0x00, 0xef, 0x11, 0x16, 0x01, 0xeb, 0x00, 
+0x1b, 0x07, 0xb2, 0x16, 0x9f, 0x0b, 0x08, 0x0a, 0xe3, 0x0a, 0xdb, 0x0a, 
+0x47, 0x0d, 0xbc, 0x14, 0x62, 0x07, 0x20, 0x00, 0x3c, 0x00, 0xe1, 0x00, 
+0xc8, 0x0a, 0x85, 0x0a, 0xb2, 0x09, 0x97, 0x06, 0xd8, 0x0b, 0xf3, 0x09, 
+0xd6, 0x00, 0xa5, 0x0a, 0x25, 0x01, 0x40, 0x06, 0x4e, 0x0a, 0xff, 0x0e, 
+0x67, 0x07, 0x16, 0x01, 0xc5, 0x00, 0xb1, 0x09, 0xfa, 0x00, 0xad, 0x00, 
+0x35, 0x18, 0xb0, 0x09, 0x99, 0x0b, 0xef, 0x00, 0x8a, 0x00, 0xb8, 0x11, 
+0x1f, 0x15, 0x54, 0x00, 0x69, 0x08, 0x15, 0x01, 0xb8, 0x0a, 0x95, 0x0b, 
+0x09, 0x01, 0xa1, 0x0a, 0xa0, 0x08, 0xc0, 0x00, 0x5e, 0x15, 0x3a, 0x0a, 
+0x9c, 0x00, 0x0f, 0x01, 0x6e, 0x12, 0x45, 0x06, 0x4b, 0x13, 0xaa, 0x0b, 
+0x7b, 0x18, 0x62, 0x0d, 0xb8, 0x00, 0x3b, 0x00, 0x4a, 0x0b, 0xd5, 0x14, 
+0x0e, 0x00, 0x72, 0x17, 0x5d, 0x0b, 0xa1, 0x18, 0xac, 0x0a, 0xe0, 0x0c, 
+0x2b, 0x09, 0xf4, 0x06, 0xed, 0x00, 0x72, 0x09, 0x1f, 0x0a, 0x0e, 0x01, 
+0xe4, 0x07, 0x37, 0x01, 0x25, 0x0b, 0x04, 0x0b, 0x33, 0x00, 0x18, 0x08, 
+0xfa, 0x0a, 0x89, 0x09, 0xc8, 0x00, 0x35, 0x00, 0xfe, 0x00, 0x93, 0x00, 
+0x0b, 0x01, 0xc6, 0x0a, 0x46, 0x0b, 0xe8, 0x09, 0x3f, 0x00, 0xa8, 0x00, 
+0xbc, 0x08, 0x8d, 0x16, 0x35, 0x0a, 0x07, 0x01, 0x0f, 0x0b, 0xe6, 0x00, 
+0x23, 0x01, 0x2d, 0x08, 0x73, 0x09, 0x5f, 0x0a, 0xa4, 0x15, 0x85, 0x17, 
+0xb5, 0x16, 0x63, 0x09, 0xcd, 0x14, 0x33, 0x01, 0x88, 0x0b, 0x97, 0x00, 
+0xbb, 0x17, 0xf4, 0x17, 0x6c, 0x00, 0xb4, 0x0f, 0x84, 0x00, 0xb2, 0x09, 
+0xad, 0x0a, 0xdd, 0x00, 0xaa, 0x0b, 0xe3, 0x1a, 0xd0, 0x09, 0xd9, 0x0a, 
+0xec, 0x0b, 0x1a, 0x0c, 0xd8, 0x15, 0x35, 0x0b, 0x1e, 0x1a, 0x0d, 0x00, 
+0x32, 0x0b, 0x3b, 0x12, 0x1d, 0x0b, 0x2a, 0x00, 0xe8, 0x09, 0xda, 0x0a, 
+0x53, 0x00, 0xf1, 0x09, 0xda, 0x0d, 0x2c, 0x11, 0x36, 0x00, 0xbe, 0x00, 
+0xb9, 0x0e, 0xa2, 0x0d, 0xce, 0x00, 0x09, 0x01, 0x07, 0x01, 0x8f, 0x09, 
+0x25, 0x0c, 0x07, 0x01, 0x26, 0x0c, 0x3c, 0x00, 0xa9, 0x07, 0x31, 0x01, 
+0xcd, 0x15, 0xa4, 0x0c, 0x4a, 0x00, 0xa9, 0x00, 0xbb, 0x00, 0x18, 0x1a, 
+0x85, 0x0c, 0x49, 0x00, 0xd6, 0x18, 0xdc, 0x0d, 0xc3, 0x00, 0x4a, 0x06, 
+0x30, 0x00, 0x9f, 0x00, 0xec, 0x16, 0x2e, 0x0c, 0x9b, 0x16, 0xbb, 0x08, 
+0x06, 0x01, 0xa5, 0x0a, 0x18, 0x00, 0xf3, 0x0c, 0xbd, 0x16, 0x67, 0x0c, 
+0x74, 0x0c, 0x25, 0x0c, 0x08, 0x01, 0x79, 0x0b, 0x36, 0x09, 0xf3, 0x08, 
+0x36, 0x18, 0x36, 0x10, 0xbb, 0x14, 0xb5, 0x00, 0x1d, 0x00, 0x2a, 0x0b, 
+0x63, 0x09, 0xf1, 0x00, 0x3e, 0x08, 0x2d, 0x08, 0x6c, 0x00, 0xae, 0x00, 
+0xe8, 0x0c, 0x04, 0x0b, 0x02, 0x01, 0x01, 0x17, 0xde, 0x08, 0xb1, 0x0c, 
+0x69, 0x14, 0xfe, 0x14, 0x3e, 0x0c, 0x43, 0x06, 0xff, 0x00, 0x63, 0x00, 
+0x7c, 0x00, 0xb7, 0x00, 0xa5, 0x0a, 0xe9, 0x13, 0x2b, 0x09, 0xee, 0x00, 
+0x1d, 0x08, 0x64, 0x0c, 0xf3, 0x00, 0x3e, 0x0e, 0x95, 0x11, 0xcd, 0x00, 
+0x14, 0x19, 0xdc, 0x00, 0xbf, 0x19, 0x1c, 0x01, 0x36, 0x00, 0xaa, 0x09, 
+0x2a, 0x0a, 0xcd, 0x00, 0xc4, 0x00, 0x4f, 0x0d, 0x56, 0x00, 0x22, 0x01, 
+0x7b, 0x0c, 0xea, 0x13, 0x32, 0x0c, 0xf5, 0x09, 0x37, 0x09, 0xae, 0x00, 
+0xac, 0x00, 0x15, 0x01, 0x29, 0x0a, 0x77, 0x0d, 0x6a, 0x17, 0x52, 0x0b, 
+0xb7, 0x0a, 0xc1, 0x00, 0x26, 0x12, 0xa8, 0x09, 0xd6, 0x0c, 0x32, 0x0b, 
+0xd5, 0x0b, 0x1d, 0x0c, 0x54, 0x11, 0x1e, 0x0c, 0xde, 0x08, 0xd1, 0x00, 
+0xcc, 0x07, 0xc7, 0x07, 0x35, 0x01, 0x38, 0x0c, 0x33, 0x0a, 0xe1, 0x08, 
+0x87, 0x00, 0x77, 0x00, 0xc7, 0x09, 0x49, 0x0a, 0xac, 0x0a, 0x45, 0x06, 
+0x33, 0x0a, 0x8c, 0x11, 0x26, 0x15, 0x23, 0x08, 0x54, 0x00, 0xf9, 0x00, 
+0x7d, 0x16, 0x1b, 0x01, 0x9c, 0x0c, 0x6e, 0x09, 0xb4, 0x0b, 0x63, 0x0a, 
+0xc4, 0x0a, 0x25, 0x0c, 0x81, 0x0b, 0x1b, 0x10, 0xb5, 0x0b, 0x9b, 0x10, 
+0x15, 0x0b, 0x07, 0x01, 0x0d, 0x00, 0x6f, 0x08, 0x0b, 0x00, 0xa6, 0x0c, 
+0xe6, 0x0d, 0x23, 0x01, 0x68, 0x12, 0x3a, 0x01, 0x97, 0x00, 0xe7, 0x16, 
+0x44, 0x0c, 0x64, 0x00, 0x9a, 0x16, 0x59, 0x0c, 0xd1, 0x00, 0x10, 0x01, 
+0x19, 0x0c, 0xe8, 0x13, 0xd6, 0x07, 0x79, 0x00, 0x25, 0x0b, 0x41, 0x18, 
+0xe6, 0x0a, 0x2d, 0x0b, 0x80, 0x08, 0xc1, 0x00, 0x2e, 0x0c, 0x42, 0x06, 
+0xad, 0x00, 0x32, 0x01, 0xa9, 0x08, 0xd3, 0x09, 0x00, 0x19, 0x9c, 0x00, 
+0x47, 0x00, 0xdd, 0x00, 0x91, 0x00, 0x49, 0x0e, 0x6b, 0x0a, 0x6b, 0x0a, 
+0xd8, 0x0a, 0x26, 0x0b, 0x47, 0x00, 0xe0, 0x00, 0x4b, 0x0a, 0x15, 0x01, 
+0xfc, 0x00, 0x2a, 0x12, 0xa5, 0x0f, 0x70, 0x09, 0x0d, 0x0f, 0xe2, 0x1a, 
+0x29, 0x01, 0x2e, 0x0c, 0x93, 0x00, 0x41, 0x06, 0xf7, 0x0b, 0x67, 0x07, 
+0x0d, 0x01, 0x42, 0x01, 0xeb, 0x0b, 0xfc, 0x09, 0xb6, 0x00, 0xb4, 0x00, 
+0xa4, 0x15, 0xc6, 0x00, 0xe0, 0x0b, 0x4c, 0x11, 0x49, 0x0a, 0xa2, 0x07, 
+0x87, 0x16, 0x30, 0x0b, 0xca, 0x09, 0x7f, 0x0c, 0x42, 0x06, 0x9b, 0x06, 
+0x27, 0x00, 0xca, 0x09, 0x03, 0x01, 0xd7, 0x14, 0xa9, 0x16, 0x66, 0x13, 
+0x30, 0x0b, 0x49, 0x06, 0x7a, 0x18, 0xde, 0x18, 0xd8, 0x14, 0xa9, 0x07, 
+0x4f, 0x11, 0x3b, 0x01, 0xc0, 0x0e, 0xe4, 0x00, 0x83, 0x11, 0xd5, 0x0f, 
+0x9d, 0x0f, 0xa8, 0x0d, 0xb9, 0x0b, 0x97, 0x06, 0x5f, 0x00, 0x18, 0x11, 
+0xc5, 0x09, 0x5f, 0x12, 0x0c, 0x01, 0x4e, 0x17, 0x1a, 0x00, 0xa9, 0x00, 
+0x0d, 0x01, 0x85, 0x00, 0xa7, 0x0f, 0x6f, 0x00, 0xf4, 0x14, 0x86, 0x00, 
+0x03, 0x01, 0x51, 0x0b, 0x2c, 0x09, 0x6b, 0x0a, 0x23, 0x00, 0x60, 0x00, 
+0x97, 0x13, 0xab, 0x00, 0xb2, 0x16, 0xf4, 0x00, 0x2a, 0x01, 0xaf, 0x0a, 
+0x40, 0x06, 0x29, 0x01, 0xaa, 0x00, 0xe3, 0x06, 0xdb, 0x09, 0xb0, 0x0a, 
+0x16, 0x01, 0x8b, 0x1c, 0x2e, 0x01, 0xf6, 0x08, 0xb5, 0x17, 0x8f, 0x1a, 
+0x23, 0x01, 0xd1, 0x00, 0xed, 0x00, 0x22, 0x0c, 0xc4, 0x17, 0xf5, 0x06, 
+0xa9, 0x09, 0xe8, 0x0d, 0x2d, 0x0b, 0xa2, 0x0d, 0xf9, 0x0e, 0xa9, 0x16, 
+0x0b, 0x00, 0x0b, 0x0a, 0x2f, 0x15, 0x6f, 0x00, 0x9b, 0x15, 0x48, 0x06, 
+0x3a, 0x13, 0x47, 0x06, 0x0d, 0x0c, 0xed, 0x0d, 0x3b, 0x0c, 0xb6, 0x10, 
+0xda, 0x00, 0x13, 0x01, 0xe1, 0x10, 0xb7, 0x11, 0x5c, 0x0b, 0xef, 0x06, 
+0x36, 0x01, 0xdb, 0x0f, 0xd0, 0x0a, 0xeb, 0x00, 0x11, 0x0b, 0x1c, 0x01, 
+0xc3, 0x10, 0xaa, 0x0b, 0x0d, 0x00, 0xb3, 0x09, 0x61, 0x11, 0x3b, 0x01, 
+0xe9, 0x08, 0xb3, 0x08, 0x18, 0x14, 0x85, 0x0c, 0xbd, 0x17, 0xe2, 0x13, 
+0x44, 0x06, 0x65, 0x0c, 0x6a, 0x17, 0xa8, 0x00, 0x03, 0x15, 0x66, 0x08, 
+0x6a, 0x0d, 0xb7, 0x00, 0x98, 0x0b, 0xd1, 0x15, 0xb3, 0x17, 0xfa, 0x15, 
+0xaf, 0x00, 0xda, 0x00, 0x1b, 0x0b, 0xe6, 0x00, 0x8a, 0x0b, 0xcf, 0x06, 
+0x16, 0x14, 0x94, 0x0e, 0x13, 0x0a, 0x2a, 0x18, 0x4c, 0x13, 0xe6, 0x09, 
+0x82, 0x0b, 0xbd, 0x09, 0x16, 0x01, 0x25, 0x0b, 0x48, 0x0b, 0x58, 0x13, 
+0x1e, 0x01, 0xde, 0x00, 0x53, 0x07, 0x51, 0x14, 0xa6, 0x09, 0x04, 0x09, 
+0x1d, 0x07, 0x04, 0x01, 0xf4, 0x07, 0xd9, 0x0a, 0xad, 0x00, 0x83, 0x18, 
+0x43, 0x07, 0xca, 0x0c, 0x08, 0x11, 0x69, 0x08, 0x58, 0x17, 0xac, 0x07, 
+0xa4, 0x0a, 0x7e, 0x06, 0x31, 0x01, 0x7a, 0x00, 0x13, 0x01, 0x08, 0x13, 
+0xe0, 0x00, 0xca, 0x00, 0xba, 0x16, 0x48, 0x12, 0x35, 0x00, 0x8f, 0x00, 
+0x47, 0x06, 0xaa, 0x07, 0x41, 0x06, 0x18, 0x00, 0x36, 0x0a, 0x16, 0x00, 
+0x28, 0x0a, 0x0c, 0x00, 0xdf, 0x0d, 0x67, 0x0b, 0xab, 0x07, 0x3f, 0x00, 
+0xd8, 0x1b, 0xd4, 0x0e, 0x6f, 0x12, 0x53, 0x07, 0x45, 0x0c, 0xaf, 0x00, 
+0x3f, 0x0a, 0x72, 0x13, 0x57, 0x06, 0xcb, 0x0c, 0x1d, 0x01, 0x3b, 0x12, 
+0x62, 0x09, 0x40, 0x06, 0x20, 0x01, 0xd6, 0x0c, 0x18, 0x01, 0xa8, 0x09, 
+0xdf, 0x16, 0xde, 0x0c, 0x50, 0x0c, 0x84, 0x11, 0xb5, 0x0c, 0xf8, 0x0f, 
+0xdb, 0x1b, 0x4b, 0x06, 0xb0, 0x10, 0xaa, 0x15, 0xa0, 0x07, 0xa7, 0x00, 
+0x00, 0x01, 0x77, 0x0a, 0x3c, 0x01, 0x02, 0x01, 0x10, 0x01, 0x7d, 0x0b, 
+0x30, 0x0c, 0x6a, 0x13, 0x4d, 0x0a, 0x1a, 0x01, 0x75, 0x0b, 0x40, 0x01, 
+0xe4, 0x07, 0x49, 0x18, 0x46, 0x0b, 0x43, 0x06, 0xaf, 0x09, 0x3e, 0x0d, 
+0x55, 0x00, 0xc6, 0x00, 0x5d, 0x12, 0x4e, 0x0c, 0xe1, 0x00, 0x25, 0x1a, 
+0x42, 0x12, 0x1a, 0x00, 0x3e, 0x0d, 0xaf, 0x00, 0x67, 0x1b, 0xc3, 0x11, 
+0x25, 0x0c, 0xae, 0x00, 0x9f, 0x08, 0xbe, 0x09, 0xde, 0x0c, 0x3f, 0x01, 
+0xbb, 0x00, 0x04, 0x01, 0x68, 0x13, 0x0a, 0x01, 0xe0, 0x13, 0x64, 0x0c, 
+0xc7, 0x0c, 0xe5, 0x00, 0xfd, 0x00, 0x3d, 0x18, 0xb2, 0x17, 0xaf, 0x0b, 
+0xaf, 0x19, 0x35, 0x0a, 0xa1, 0x18, 0xba, 0x00, 0x35, 0x0a, 0x57, 0x13, 
+0x8a, 0x14, 0xfb, 0x00, 0x11, 0x00, 0xb3, 0x09, 0x27, 0x10, 0x27, 0x0b, 
+0xf1, 0x09, 0x55, 0x0a, 0x8a, 0x0b, 0x63, 0x09, 0x10, 0x01, 0xdc, 0x0d, 
+0x51, 0x14, 0xd9, 0x00, 0x03, 0x0c, 0xec, 0x1b, 0xda, 0x10, 0x1a, 0x01, 
+0x7b, 0x00, 0xdc, 0x00, 0x12, 0x0a, 0x62, 0x17, 0xe3, 0x00, 0x37, 0x08, 
+0xc9, 0x09, 0x1f, 0x0a, 0x3c, 0x01, 0x3e, 0x0d, 0x3c, 0x01, 0xcf, 0x0b, 
+0xd5, 0x10, 0xca, 0x08, 0x88, 0x0d, 0x90, 0x00, 0x33, 0x0b, 0x21, 0x01, 
+0x23, 0x00, 0x35, 0x01, 0x52, 0x0b, 0xf5, 0x00, 0xaa, 0x00, 0x61, 0x17, 
+0x97, 0x15, 0xde, 0x0c, 0xc2, 0x17, 0x35, 0x0a, 0xf4, 0x08, 0x0c, 0x0c, 
+0x3e, 0x0a, 0xd6, 0x18, 0xf5, 0x0c, 0x1c, 0x01, 0x53, 0x00, 0xcb, 0x00, 
+0x98, 0x0a, 0xeb, 0x00, 0xda, 0x00, 0xfa, 0x00, 0x58, 0x0b, 0x42, 0x01, 
+0x7e, 0x00, 0x16, 0x13, 0x3b, 0x1c, 0x0f, 0x13, 0xbf, 0x00, 0xd6, 0x15, 
+0x9f, 0x08, 0xc2, 0x08, 0xc7, 0x09, 0x48, 0x0e, 0xda, 0x00, 0xce, 0x06, 
+0x23, 0x08, 0xf0, 0x00, 0x8e, 0x0a, 0x43, 0x0c, 0xe9, 0x08, 0x66, 0x12, 
+0xfc, 0x0a, 0x77, 0x00, 0x9f, 0x12, 0xd6, 0x07, 0x12, 0x0a, 0x78, 0x0a, 
+0x30, 0x01, 0x54, 0x0a, 0x09, 0x01, 0x3c, 0x12, 0x02, 0x01, 0xda, 0x07, 
+0xeb, 0x07, 0x4b, 0x08, 0xf3, 0x09, 0x49, 0x0a, 0x7e, 0x0c, 0xc3, 0x0e, 
+0x78, 0x00, 0x28, 0x0b, 0xcf, 0x06, 0xcd, 0x14, 0xc3, 0x00, 0xed, 0x0d, 
+0x61, 0x08, 0x14, 0x01, 0x5a, 0x08, 0x71, 0x0a, 0xd2, 0x0b, 0x37, 0x01, 
+0x2e, 0x08, 0xa6, 0x09, 0xfe, 0x00, 0x9e, 0x0b, 0x6e, 0x09, 0x1f, 0x01, 
+0x26, 0x12, 0x52, 0x07, 0xa7, 0x0c, 0xce, 0x06, 0x4b, 0x0b, 0x1f, 0x13, 
+0x2b, 0x0c, 0x88, 0x14, 0x70, 0x0c, 0xd8, 0x0a, 0x2b, 0x09, 0x54, 0x0e, 
+0xa0, 0x0c, 0x2e, 0x09, 0xde, 0x0c, 0x44, 0x13, 0x67, 0x13, 0x94, 0x0b, 
+0xbe, 0x00, 0x3b, 0x18, 0x9f, 0x07, 0x3b, 0x00, 0x57, 0x00, 0xf3, 0x0c, 
+0xeb, 0x08, 0xaa, 0x00, 0x5a, 0x0c, 0xf7, 0x0b, 0x05, 0x0c, 0x78, 0x0b, 
+0xa1, 0x18, 0xa4, 0x09, 0x4a, 0x00, 0x6c, 0x00, 0xce, 0x0b, 0xb2, 0x00, 
+0x24, 0x08, 0x27, 0x0b, 0x38, 0x0c, 0x3a, 0x0c, 0x48, 0x0b, 0x62, 0x09, 
+0x9c, 0x00, 0xaa, 0x00, 0x70, 0x0c, 0xa4, 0x0a, 0xb2, 0x0c, 0x0e, 0x00, 
+0x62, 0x19, 0x2f, 0x00, 0x4a, 0x06, 0x99, 0x0b, 0xc5, 0x0a, 0xe3, 0x08, 
+0xcd, 0x17, 0x3f, 0x0a, 0x48, 0x0c, 0xae, 0x0a, 0x7d, 0x0b, 0xf9, 0x0a, 
+0xc4, 0x0a, 0x0f, 0x01, 0xd6, 0x00, 0x04, 0x01, 0x48, 0x06, 0x8c, 0x13, 
+0x62, 0x0e, 0x24, 0x08, 0xd8, 0x0a, 0x49, 0x06, 0xa8, 0x0d, 0x46, 0x06, 
+0x38, 0x0a, 0xe4, 0x13, 0xb3, 0x00, 0xc8, 0x09, 0x4c, 0x0b, 0xba, 0x07, 
+0x94, 0x07, 0x0c, 0x00, 0xbb, 0x0a, 0xb4, 0x08, 0x16, 0x14, 0xc9, 0x0e, 
+0xdb, 0x09, 0x60, 0x12, 0x49, 0x06, 0xd6, 0x0c, 0x00, 0x0d, 0xd2, 0x00, 
+0x27, 0x00, 0x2c, 0x0a, 0xad, 0x0b, 0x74, 0x1a, 0xd0, 0x0a, 0xc6, 0x0b, 
+0xd1, 0x0e, 0xef, 0x00, 0xfd, 0x0b, 0x83, 0x00, 0x30, 0x00, 0x29, 0x14, 
+0xff, 0x09, 0xbd, 0x00, 0x7f, 0x0b, 0x7d, 0x0b, 0x69, 0x1c, 0x2b, 0x0a, 
+0x27, 0x07, 0x29, 0x01, 0x49, 0x06, 0x58, 0x17, 0x19, 0x19, 0x28, 0x11, 
+0x1e, 0x14, 0xf5, 0x0a, 0xf0, 0x0a, 0x08, 0x0d, 0x33, 0x0a, 0x9e, 0x08, 
+0x35, 0x09, 0x3d, 0x00, 0x0e, 0x0c, 0x9f, 0x0f, 0xfb, 0x0a, 0x79, 0x09, 
+0x92, 0x00, 0xde, 0x16, 0x71, 0x0d, 0xb5, 0x1a, 0x48, 0x00, 0x7c, 0x00, 
+0x06, 0x01, 0xe0, 0x09, 0x14, 0x01, 0x04, 0x01, 0xf7, 0x0b, 0x18, 0x0c, 
+0xf3, 0x08, 0xba, 0x0a, 0xb6, 0x00, 0x96, 0x15, 0x17, 0x01, 0x1d, 0x17, 
+0xb7, 0x0a, 0x98, 0x15, 0x8c, 0x13, 0x81, 0x0b, 0xde, 0x00, 0x08, 0x0a, 
+0xb9, 0x15, 0xf7, 0x00, 0x9f, 0x07, 0xe3, 0x08, 0x48, 0x00, 0xbd, 0x11, 
+0xeb, 0x0a, 0xde, 0x00, 0x37, 0x09, 0x6d, 0x0d, 0xa4, 0x07, 0x34, 0x01, 
+0xa5, 0x0a, 0xa6, 0x0a, 0xc8, 0x17, 0x12, 0x0a, 0x4a, 0x06, 0xe8, 0x00, 
+0x1a, 0x10, 0xec, 0x0b, 0x30, 0x0b, 0xda, 0x0d, 0xd0, 0x09, 0x95, 0x07, 
+0xe9, 0x13, 0xa8, 0x00, 0xe2, 0x13, 0xb8, 0x0a, 0x66, 0x00, 0x27, 0x01, 
+0x25, 0x16, 0xc7, 0x00, 0x0e, 0x0c, 0xd1, 0x00, 0x6f, 0x0c, 0x57, 0x00, 
+0x32, 0x01, 0xf3, 0x09, 0xbb, 0x17, 0x1e, 0x0a, 0xe0, 0x00, 0x94, 0x0b, 
+0x08, 0x01, 0x0b, 0x01, 0x5b, 0x08, 0x86, 0x0c, 0x3b, 0x00, 0x6e, 0x0a, 
+0xcd, 0x0b, 0x96, 0x17, 0x01, 0x0c, 0xc7, 0x00, 0x0c, 0x01, 0x0a, 0x01, 
+0xcc, 0x0e, 0x94, 0x07, 0xbb, 0x16, 0x30, 0x07, 0xaf, 0x19, 0x1d, 0x0a, 
+0x28, 0x0a, 0x92, 0x00, 0xb4, 0x00, 0xed, 0x00, 0x88, 0x14, 0x6d, 0x09, 
+0x37, 0x09, 0xfa, 0x0b, 0x0d, 0x13, 0x95, 0x0b, 0x41, 0x01, 0xe7, 0x0b, 
+0x08, 0x01, 0xdf, 0x08, 0x85, 0x00, 0x07, 0x01, 0x5e, 0x0b, 0xa1, 0x06, 
+0x4e, 0x17, 0x3a, 0x01, 0x7a, 0x0c, 0xf0, 0x00, 0xe6, 0x00, 0x07, 0x0a, 
+0xd7, 0x07, 0x66, 0x09, 0x44, 0x06, 0xcd, 0x00, 0xd0, 0x09, 0x5e, 0x0b, 
+0xe1, 0x0b, 0xf6, 0x0f, 0x00, 0x01, 0x01, 0x0a, 0xaa, 0x07, 0x1d, 0x10, 
+0xd1, 0x0e, 0x20, 0x01, 0x03, 0x11, 0x54, 0x00, 0x16, 0x01, 0x97, 0x06, 
+0x0c, 0x01, 0x60, 0x0b, 0x5d, 0x0b, 0xdc, 0x0b, 0x67, 0x0c, 0x91, 0x0c, 
+0x0b, 0x00, 0x97, 0x0f, 0x2f, 0x01, 0x5b, 0x0c, 0x25, 0x0f, 0x65, 0x00, 
+0xdd, 0x0c, 0x69, 0x07, 0x3b, 0x1c, 0x6f, 0x09, 0x5b, 0x0c, 0xdd, 0x00, 
+0xf9, 0x18, 0xf3, 0x0c, 0x65, 0x09, 0xf1, 0x09, 0xa0, 0x00, 0xe3, 0x1a, 
+0xb3, 0x0c, 0xbe, 0x09, 0xe2, 0x00, 0x32, 0x12, 0xb0, 0x00, 0x65, 0x08, 
+0x1e, 0x01, 0x60, 0x00, 0xb8, 0x0a, 0x5d, 0x12, 0xfe, 0x0c, 0x1a, 0x0b, 
+0xd9, 0x00, 0x46, 0x0b, 0xc2, 0x0b, 0xea, 0x00, 0x15, 0x01, 0x26, 0x00, 
+0x9f, 0x00, 0x07, 0x0a, 0x25, 0x01, 0x42, 0x06, 0x3e, 0x0b, 0x4e, 0x09, 
+0xb2, 0x0c, 0xf7, 0x0e, 0xc5, 0x09, 0x29, 0x10, 0x25, 0x0b, 0x20, 0x09, 
+0xe8, 0x09, 0x49, 0x06, 0x36, 0x0a, 0xdc, 0x00, 0xcb, 0x00, 0x61, 0x17, 
+0x83, 0x0a, 0x20, 0x01, 0xfc, 0x0d, 0x5c, 0x0b, 0x52, 0x0b, 0x2b, 0x0b, 
+0xdd, 0x0c, 0x3a, 0x13, 0xd5, 0x0b, 0x12, 0x07, 0x59, 0x17, 0x1f, 0x07, 
+0xad, 0x0f, 0x19, 0x08, 0xca, 0x0b, 0x00, 0x0d, 0xe5, 0x00, 0xb2, 0x06, 
+0x14, 0x0c, 0x47, 0x19, 0xc0, 0x0e, 0x67, 0x09, 0x01, 0x0c, 0xf3, 0x08, 
+0x04, 0x09, 0xd5, 0x0a, 0x30, 0x00, 0xb1, 0x09, 0xcc, 0x0c, 0xdf, 0x09, 
+0xaf, 0x09, 0x8c, 0x06, 0xf7, 0x00, 0x8f, 0x00, 0xec, 0x00, 0xdb, 0x00, 
+0x2f, 0x09, 0x76, 0x12, 0x99, 0x06, 0x69, 0x17, 0xc9, 0x0c, 0x1d, 0x13, 
+0x3e, 0x01, 0x68, 0x07, 0xa2, 0x06, 0x01, 0x10, 0xca, 0x0b, 0x5f, 0x12, 
+0xfd, 0x09, 0x14, 0x0a, 0xe1, 0x15, 0xd6, 0x15, 0x78, 0x00, 0x2f, 0x07, 
+0xb4, 0x09, 0xa3, 0x0a, 0x31, 0x0b, 0x2d, 0x01, 0x02, 0x07, 0xab, 0x07, 
+0xac, 0x0a, 0xce, 0x00, 0x1d, 0x07, 0xac, 0x0a, 0x27, 0x12, 0x0b, 0x14, 
+0x42, 0x15, 0x45, 0x06, 0x60, 0x00, 0xd0, 0x00, 0xb4, 0x0f, 0xab, 0x00, 
+0xa8, 0x00, 0x7a, 0x10, 0x23, 0x01, 0x97, 0x0a, 0x76, 0x0a, 0x52, 0x07, 
+0xec, 0x07, 0xdf, 0x00, 0xcc, 0x00, 0x22, 0x0c, 0xb0, 0x09, 0x17, 0x18, 
+0x8a, 0x12, 0x23, 0x08, 0x00, 0x0d, 0x34, 0x0b, 0xf9, 0x0a, 0x85, 0x16, 
+0x41, 0x06, 0x48, 0x0b, 0x36, 0x07, 0x50, 0x0c, 0x06, 0x01, 0xd2, 0x0c, 
+0xae, 0x00, 0x12, 0x01, 0x17, 0x01, 0x0f, 0x0b, 0x75, 0x0a, 0x08, 0x01, 
+0xca, 0x0f, 0x31, 0x09, 0x17, 0x01, 0x40, 0x0f, 0xdb, 0x00, 0xb4, 0x09, 
+0xf6, 0x18, 0x24, 0x01, 0x36, 0x0d, 0xf4, 0x09, 0x41, 0x00, 0x50, 0x0a, 
+0x26, 0x0b, 0xfe, 0x15, 0x0f, 0x0c, 0x4c, 0x11, 0xce, 0x0a, 0xeb, 0x0b, 
+0xc9, 0x0c, 0x2f, 0x07, 0x04, 0x13, 0x10, 0x07, 0x55, 0x00, 0xe8, 0x13, 
+0x64, 0x00, 0xa1, 0x06, 0x37, 0x01, 0x29, 0x0b, 0x5b, 0x0a, 0xbb, 0x00, 
+0x46, 0x06, 0x52, 0x0b, 0x4c, 0x00, 0x6d, 0x00, 0xec, 0x13, 0xd3, 0x00, 
+0xd6, 0x00, 0xa7, 0x0a, 0x3b, 0x18, 0x98, 0x06, 0xda, 0x00, 0x94, 0x00, 
+0x83, 0x0a, 0xad, 0x0b, 0x7a, 0x00, 0xa8, 0x0c, 0x5a, 0x0c, 0x69, 0x0b, 
+0x26, 0x07, 0x07, 0x0a, 0xfc, 0x00, 0x89, 0x0e, 0xd1, 0x09, 0x0a, 0x01, 
+0x03, 0x01, 0x0f, 0x0b, 0xb5, 0x0b, 0xec, 0x07, 0x53, 0x00, 0xca, 0x0b, 
+0xc1, 0x00, 0xcc, 0x00, 0x34, 0x0b, 0xba, 0x09, 0xac, 0x0a, 0x6f, 0x13, 
+0x27, 0x01, 0x9b, 0x00, 0xeb, 0x08, 0x33, 0x01, 0xa3, 0x0a, 0xdf, 0x06, 
+0x26, 0x0c, 0x93, 0x0b, 0x04, 0x01, 0x20, 0x1a, 0xe6, 0x00, 0x84, 0x00, 
+0xee, 0x0c, 0xc3, 0x11, 0xa2, 0x0a, 0x09, 0x15, 0xcf, 0x18, 0x89, 0x0b, 
+0xba, 0x16, 0x57, 0x11, 0x5f, 0x00, 0xec, 0x06, 0xe7, 0x00, 0x6a, 0x0a, 
+0x6b, 0x0b, 0xef, 0x00, 0xa2, 0x15, 0xbf, 0x1b, 0x65, 0x08, 0x9e, 0x0b, 
+0x2c, 0x00, 0x6e, 0x00, 0xd1, 0x06, 0x8b, 0x0a, 0xcf, 0x0a, 0x96, 0x07, 
+0xc8, 0x0a, 0xd8, 0x00, 0xbf, 0x0b, 0x6c, 0x00, 0xa4, 0x09, 0xed, 0x00, 
+0x30, 0x12, 0xe1, 0x0c, 0x33, 0x0a, 0xdd, 0x00, 0x29, 0x0a, 0xde, 0x0a, 
+0xdb, 0x10, 0x2a, 0x01, 0xac, 0x00, 0x88, 0x00, 0xfe, 0x00, 0xc1, 0x0b, 
+0x9e, 0x0c, 0x3d, 0x01, 0xb9, 0x00, 0x0f, 0x01, 0xa9, 0x10, 0x49, 0x0b, 
+0x0b, 0x00, 0xd5, 0x00, 0x91, 0x00, 0xd5, 0x09, 0x1a, 0x0b, 0xd4, 0x0c, 
+0xaa, 0x0b, 0x61, 0x17, 0x6a, 0x12, 0xa7, 0x08, 0xe9, 0x0c, 0xab, 0x07, 
+0xa8, 0x0c, 0x46, 0x0b, 0xd3, 0x00, 0xc1, 0x0b, 0xf8, 0x00, 0x12, 0x07, 
+0x07, 0x0a, 0x91, 0x0a, 0xe4, 0x07, 0x53, 0x09, 0x92, 0x11, 0xb8, 0x11, 
+0xe0, 0x13, 0x89, 0x00, 0xe8, 0x0a, 0xa0, 0x19, 0x23, 0x00, 0xa3, 0x00, 
+0x67, 0x08, 0xb8, 0x0a, 0xfb, 0x13, 0xce, 0x00, 0x41, 0x0a, 0xaf, 0x0a, 
+0xd9, 0x06, 0xd2, 0x09, 0xba, 0x08, 0x53, 0x00, 0x82, 0x11, 0xde, 0x13, 
+0x58, 0x1a, 0x50, 0x0c, 0xc1, 0x0c, 0xc6, 0x09, 0xc5, 0x00, 0xd3, 0x09, 
+0x91, 0x0c, 0xfc, 0x09, 0x5c, 0x0c, 0x5c, 0x0a, 0x2e, 0x0c, 0x53, 0x07, 
+0xe2, 0x00, 0x8f, 0x06, 0x49, 0x0a, 0xc5, 0x00, 0x93, 0x00, 0x84, 0x18, 
+0x3b, 0x0c, 0xf6, 0x0f, 0xe0, 0x00, 0x28, 0x12, 0xf8, 0x00, 0x46, 0x0a, 
+0x35, 0x0d, 0x93, 0x0e, 0x8e, 0x17, 0xff, 0x09, 0x63, 0x09, 0xd8, 0x16, 
+0xfd, 0x00, 0x13, 0x0d, 0x48, 0x0d, 0xb2, 0x15, 0xac, 0x0a, 0x8b, 0x10, 
+0x2a, 0x12, 0x2d, 0x0c, 0xac, 0x1a, 0xb0, 0x00, 0xda, 0x00, 0xb7, 0x0a, 
+0xd4, 0x00, 0x65, 0x00, 0xa9, 0x15, 0x25, 0x08, 0xc1, 0x00, 0x9f, 0x00, 
+0x14, 0x01, 0xee, 0x00, 0x3d, 0x00, 0x02, 0x01, 0xeb, 0x13, 0xdd, 0x0c, 
+0xea, 0x08, 0xe0, 0x0b, 0x96, 0x0b, 0xc7, 0x00, 0x43, 0x15, 0xcf, 0x18, 
+0x80, 0x0b, 0x4a, 0x11, 0x59, 0x00, 0x61, 0x00, 0xc5, 0x0a, 0x26, 0x01, 
+0x59, 0x0c, 0xf7, 0x00, 0xa0, 0x15, 0xec, 0x00, 0x20, 0x09, 0x51, 0x06, 
+0x72, 0x0a, 0xd0, 0x0b, 0x02, 0x01, 0xcf, 0x00, 0x5a, 0x17, 0x55, 0x06, 
+0xb4, 0x06, 0x3f, 0x01, 0xca, 0x0f, 0x24, 0x09, 0x45, 0x0c, 0xc3, 0x00, 
+0xb6, 0x0b, 0x13, 0x01, 0xa5, 0x10, 0x12, 0x01, 0xaa, 0x09, 0x16, 0x01, 
+0x55, 0x00, 0x40, 0x0a, 0xaf, 0x11, 0xc4, 0x00, 0x35, 0x0a, 0x6d, 0x09, 
+0x41, 0x09, 0xf3, 0x00, 0x72, 0x16, 0xfe, 0x09, 0x28, 0x01, 0x1c, 0x01, 
+0x05, 0x01, 0xc7, 0x0b, 0x5b, 0x0c, 0xf5, 0x0d, 0xcd, 0x0b, 0x52, 0x0b, 
+0x2f, 0x01, 0x76, 0x0a, 0x9f, 0x08, 0xca, 0x00, 0xf0, 0x0b, 0x14, 0x00, 
+0x2d, 0x15, 0x76, 0x0b, 0xde, 0x00, 0xdf, 0x0a, 0x65, 0x18, 0x5a, 0x17, 
+0x6c, 0x00, 0x1e, 0x01, 0x3b, 0x00, 0xdc, 0x09, 0xdc, 0x10, 0x44, 0x06, 
+0xd4, 0x08, 0xe5, 0x06, 0x0c, 0x0c, 0x79, 0x0b, 0xba, 0x14, 0x2d, 0x08, 
+0xe9, 0x13, 0xeb, 0x08, 0x16, 0x14, 0xcd, 0x00, 0xdf, 0x0d, 0x26, 0x07, 
+0xcf, 0x00, 0xdf, 0x0c, 0xb4, 0x17, 0xf0, 0x0e, 0x74, 0x0b, 0xde, 0x0c, 
+0x9c, 0x0f, 0xed, 0x00, 0x34, 0x0b, 0x43, 0x14, 0x1d, 0x01, 0xc6, 0x0a, 
+0x81, 0x0a, 0xf4, 0x00, 0xd6, 0x15, 0x0a, 0x15, 0x0f, 0x15, 0xc2, 0x00, 
+0xe6, 0x07, 0xeb, 0x09, 0xf8, 0x00, 0x69, 0x13, 0xe0, 0x13, 0x9c, 0x00, 
+0x3b, 0x01, 0xe0, 0x00, 0x65, 0x00, 0x4c, 0x11, 0xa6, 0x13, 0x8f, 0x17, 
+0xb6, 0x0b, 0x34, 0x00, 0xe8, 0x1a, 0x36, 0x08, 0x92, 0x11, 0xd7, 0x19, 
+0xfa, 0x1b, 0x3e, 0x00, 0x9e, 0x00, 0xa8, 0x00, 0x54, 0x00, 0x48, 0x00, 
+0x92, 0x12, 0xde, 0x00, 0xce, 0x00, 0x3c, 0x00, 0xe1, 0x0c, 0x2f, 0x01, 
+0x3c, 0x0b, 0x55, 0x0a, 0x88, 0x00, 0x2b, 0x17, 0x54, 0x0b, 0x0e, 0x00, 
+0x53, 0x19, 0xc0, 0x0b, 0x34, 0x0a, 0x1e, 0x1a, 0x98, 0x0f, 0x13, 0x0a, 
+0x3d, 0x01, 0x65, 0x18, 0x81, 0x10, 0xb4, 0x09, 0x41, 0x06, 0x31, 0x0b, 
+0xba, 0x00, 0xdd, 0x00, 0x0d, 0x00, 0x41, 0x06, 0x15, 0x01, 0x05, 0x0f, 
+0xf9, 0x00, 0x0b, 0x00, 0x65, 0x00, 0x8d, 0x0a, 0xd8, 0x07, 0xa8, 0x10, 
+0x9d, 0x17, 0xb1, 0x08, 0x8f, 0x09, 0x87, 0x0c, 0x8d, 0x16, 0x45, 0x0f, 
+0x88, 0x0d, 0xbb, 0x0e, 0x4c, 0x11, 0xb3, 0x1b, 0xb8, 0x00, 0xdb, 0x00, 
+0x5f, 0x00, 0xa4, 0x0b, 0xa9, 0x13, 0x81, 0x0a, 0xe9, 0x08, 0xc9, 0x09, 
+0xef, 0x0a, 0xf0, 0x00, 0x6f, 0x0c, 0x63, 0x00, 0x80, 0x0c, 0x0f, 0x16, 
+0xb7, 0x0a, 0x9d, 0x08, 0xc6, 0x00, 0x40, 0x0a, 0xd5, 0x00, 0xef, 0x0a, 
+0x31, 0x00, 0xbb, 0x13, 0xaf, 0x0a, 0xbe, 0x15, 0xc7, 0x09, 0x4b, 0x08, 
+0xd4, 0x00, 0xde, 0x0c, 0xba, 0x09, 0x54, 0x00, 0xe4, 0x0a, 0x08, 0x0b, 
+0xf6, 0x00, 0xf4, 0x00, 0x86, 0x07, 0x5d, 0x11, 0x77, 0x00, 0x00, 0x0d, 
+0xa8, 0x00, 0x54, 0x00, 0x6d, 0x00, 0x5f, 0x0a, 0xfb, 0x00, 0x33, 0x01, 
+0xd1, 0x09, 0x60, 0x0a, 0xd3, 0x00, 0xdd, 0x00, 0x5e, 0x12, 0xcd, 0x0a, 
+0x4e, 0x12, 0xe0, 0x00, 0xab, 0x09, 0x93, 0x00, 0x26, 0x15, 0x44, 0x00, 
+0xa4, 0x09, 0xfc, 0x16, 0xcc, 0x0e, 0xfe, 0x09, 0xf6, 0x00, 0x9c, 0x08, 
+0x15, 0x13, 0x6b, 0x0a, 0x19, 0x00, 0xda, 0x18, 0xfa, 0x14, 0x15, 0x0a, 
+0x4e, 0x17, 0xda, 0x0a, 0x25, 0x0b, 0x85, 0x00, 0xd4, 0x10, 0xb5, 0x00, 
+0x21, 0x01, 0x1b, 0x0c, 0x33, 0x14, 0x4d, 0x0a, 0xe4, 0x00, 0xdd, 0x09, 
+0x02, 0x0b, 0x33, 0x0a, 0xb3, 0x17, 0xd6, 0x16, 0x63, 0x0d, 0xef, 0x00, 
+0xef, 0x00, 0xa7, 0x09, 0xe3, 0x0e, 0xc0, 0x00, 0x17, 0x00, 0xd7, 0x00, 
+0x5f, 0x0b, 0x18, 0x00, 0xb7, 0x0e, 0x55, 0x0c, 0x4d, 0x06, 0x34, 0x01, 
+0x0b, 0x00, 0x7b, 0x00, 0xd6, 0x07, 0x19, 0x0c, 0x0b, 0x00, 0xde, 0x17, 
+0x36, 0x01, 0x15, 0x01, 0xb7, 0x0c, 0x95, 0x07, 0xde, 0x00, 0xdd, 0x0d, 
+0x03, 0x13, 0x8b, 0x13, 0xb4, 0x00, 0xb5, 0x0b, 0x9d, 0x0c, 0x3f, 0x0b, 
+0x1c, 0x01, 0x17, 0x07, 0x42, 0x15, 0x41, 0x06, 0x09, 0x0a, 0x07, 0x0a, 
+0xba, 0x1b, 0x89, 0x00, 0x92, 0x00, 0x68, 0x0b, 0xdb, 0x09, 0xac, 0x08, 
+0x9e, 0x08, 0xa2, 0x06, 0x1e, 0x00, 0x2b, 0x09, 0xd9, 0x0a, 0x7b, 0x00, 
+0x37, 0x0a, 0x90, 0x06, 0x27, 0x0b, 0xb5, 0x00, 0x2d, 0x0c, 0xf1, 0x14, 
+0xe2, 0x07, 0xd6, 0x09, 0x63, 0x09, 0xf3, 0x09, 0x57, 0x0a, 0xde, 0x15, 
+0x44, 0x0c, 0xf9, 0x00, 0x6b, 0x0d, 0x1e, 0x0b, 0x54, 0x0f, 0x34, 0x01, 
+0x35, 0x00, 0x69, 0x00, 0x81, 0x0b, 0x83, 0x00, 0x0a, 0x01, 0x61, 0x00, 
+0xa6, 0x0a, 0xaa, 0x06, 0xc1, 0x17, 0x3c, 0x13, 0xab, 0x08, 0xf2, 0x18, 
+0xbb, 0x09, 0x4f, 0x0c, 0x9a, 0x15, 0x6f, 0x08, 0x07, 0x0a, 0xce, 0x18, 
+0x65, 0x0c, 0xe6, 0x00, 0x62, 0x09, 0xf5, 0x06, 0x4a, 0x0a, 0x53, 0x00, 
+0xeb, 0x08, 0x18, 0x0a, 0x4b, 0x11, 0x94, 0x16, 0x56, 0x06, 0x55, 0x06, 
+0x13, 0x01, 0xdb, 0x00, 0xc7, 0x0a, 0xb7, 0x0a, 0x3b, 0x00, 0x73, 0x11, 
+0x78, 0x15, 0x6e, 0x10, 0x1a, 0x0b, 0xc8, 0x09, 0x48, 0x06, 0x51, 0x0c, 
+0x9f, 0x00, 0xf5, 0x06, 0xd4, 0x0e, 0xcd, 0x0b, 0x1a, 0x0b, 0xbc, 0x13, 
+0xce, 0x06, 0x28, 0x0a, 0x0a, 0x16, 0xdf, 0x13, 0xba, 0x14, 0x1e, 0x0a, 
+0x2e, 0x01, 0x3e, 0x09, 0xc9, 0x00, 0x1f, 0x0c, 0x77, 0x0b, 0xf0, 0x00, 
+0x3f, 0x08, 0x1e, 0x01, 0x65, 0x0e, 0xb1, 0x00, 0xe3, 0x08, 0xd3, 0x00, 
+0x93, 0x11, 0x14, 0x01, 0x6a, 0x0a, 0x43, 0x0c, 0x1d, 0x01, 0xb6, 0x0f, 
+0x6f, 0x09, 0x45, 0x0c, 0x87, 0x00, 0x59, 0x0c, 0xed, 0x08, 0x61, 0x00, 
+0xf4, 0x0c, 0x15, 0x01, 0x55, 0x07, 0x9b, 0x0c, 0x3d, 0x00, 0xe1, 0x07, 
+0xb7, 0x11, 0xfa, 0x00, 0xd2, 0x06, 0xb3, 0x17, 0x3e, 0x09, 0xd8, 0x00, 
+0x1d, 0x01, 0xde, 0x08, 0xe3, 0x00, 0x13, 0x07, 0xd2, 0x0f, 0x2d, 0x01, 
+0xa0, 0x07, 0xbd, 0x0c, 0xd2, 0x11, 0x0d, 0x18, 0xb6, 0x0e, 0xec, 0x00, 
+0x30, 0x01, 0x7b, 0x08, 0xed, 0x00, 0xda, 0x06, 0x87, 0x18, 0x81, 0x11, 
+0x3b, 0x0b, 0xc0, 0x0c, 0x65, 0x11, 0xe0, 0x0b, 0xa1, 0x08, 0x47, 0x0c, 
+0xe8, 0x00, 0xc8, 0x10, 0xb5, 0x00, 0xfe, 0x0a, 0x55, 0x11, 0x94, 0x07, 
+0xcc, 0x0b, 0x83, 0x00, 0x22, 0x09, 0xac, 0x0b, 0x02, 0x01, 0x73, 0x16, 
+0x21, 0x01, 0x02, 0x0d, 0x9f, 0x0b, 0xaf, 0x00, 0x2d, 0x01, 0x26, 0x12, 
+0x54, 0x0a, 0x05, 0x01, 0x3e, 0x08, 0xf4, 0x09, 0x66, 0x13, 0xaa, 0x15, 
+0x3e, 0x12, 0x25, 0x0b, 0xae, 0x12, 0x03, 0x0c, 0x60, 0x0a, 0xcf, 0x0a, 
+0xda, 0x06, 0xb3, 0x0a, 0xe2, 0x00, 0x38, 0x01, 0xef, 0x00, 0xf7, 0x0c, 
+0x4f, 0x0c, 0x00, 0x0a, 0x8b, 0x12, 0x33, 0x01, 0xa4, 0x11, 0xab, 0x00, 
+0x22, 0x0f, 0x03, 0x01, 0x50, 0x0a, 0xbf, 0x0b, 0xb0, 0x0a, 0x05, 0x13, 
+0xe3, 0x0b, 0xff, 0x0c, 0xc6, 0x00, 0x40, 0x01, 0x09, 0x0d, 0xda, 0x00, 
+0x4a, 0x00, 0x89, 0x0e, 0xbe, 0x00, 0xbd, 0x0c, 0x28, 0x01, 0x9c, 0x0c, 
+0x16, 0x01, 0x6f, 0x10, 0xef, 0x00, 0xba, 0x0f, 0xf5, 0x0c, 0x0d, 0x00, 
+0x44, 0x0a, 0x8f, 0x1a, 0xd2, 0x0a, 0xe6, 0x0a, 0xdc, 0x09, 0xc9, 0x00, 
+0x75, 0x0a, 0xbf, 0x0c, 0xa3, 0x17, 0x67, 0x0c, 0xdd, 0x17, 0x46, 0x0b, 
+0xee, 0x0d, 0x7e, 0x0b, 0xdf, 0x0a, 0x98, 0x15, 0x22, 0x01, 0xe4, 0x0a, 
+0xc8, 0x00, 0x80, 0x0a, 0x93, 0x11, 0xaf, 0x10, 0xad, 0x0a, 0x60, 0x12, 
+0xdd, 0x00, 0x37, 0x01, 0x12, 0x01, 0x6f, 0x09, 0x1d, 0x01, 0xae, 0x0a, 
+0x96, 0x17, 0xc1, 0x0c, 0x25, 0x08, 0x38, 0x0a, 0x30, 0x00, 0xd8, 0x0e, 
+0xd0, 0x09, 0xa8, 0x00, 0x0f, 0x0c, 0xaa, 0x00, 0xdd, 0x00, 0x48, 0x06, 
+0xdf, 0x09, 0xa4, 0x0a, 0xdf, 0x16, 0xa1, 0x07, 0xac, 0x13, 0x3e, 0x0f, 
+0xf7, 0x0e, 0xf4, 0x18, 0xf3, 0x13, 0x79, 0x08, 0x83, 0x11, 0xb1, 0x0c, 
+0x95, 0x00, 0xff, 0x00, 0xba, 0x11, 0x43, 0x0a, 0xc4, 0x06, 0xc4, 0x0a, 
+0x19, 0x00, 0xad, 0x00, 0x1b, 0x0b, 0x91, 0x0c, 0xab, 0x07, 0xa3, 0x0a, 
+0x03, 0x07, 0xe7, 0x09, 0x27, 0x0c, 0x3f, 0x00, 0xb1, 0x0c, 0xe3, 0x0a, 
+0x86, 0x0c, 0x0e, 0x0c, 0xb7, 0x11, 0xb4, 0x0b, 0x3c, 0x0b, 0x0c, 0x01, 
+0x70, 0x18, 0x22, 0x0d, 0x45, 0x0c, 0x8f, 0x1a, 0xa4, 0x09, 0x9d, 0x00, 
+0xb2, 0x16, 0x64, 0x0d, 0xc2, 0x0a, 0xa7, 0x00, 0x32, 0x18, 0xab, 0x00, 
+0x3a, 0x01, 0x07, 0x14, 0x7c, 0x0c, 0x21, 0x01, 0x1b, 0x08, 0x9e, 0x08, 
+0x46, 0x0c, 0x33, 0x01, 0x85, 0x16, 0xb6, 0x0b, 0xbf, 0x0b, 0xc0, 0x00, 
+0x7c, 0x0a, 0x05, 0x01, 0x71, 0x10, 0x7f, 0x0b, 0xc7, 0x00, 0xcc, 0x00, 
+0x25, 0x1a, 0x25, 0x07, 0xa0, 0x15, 0xf2, 0x18, 0x3b, 0x0b, 0xed, 0x0d, 
+0xbe, 0x00, 0x06, 0x0c, 0xd3, 0x0f, 0x6f, 0x09, 0xb7, 0x0e, 0xd6, 0x09, 
+0x0f, 0x0c, 0xcf, 0x0a, 0xdb, 0x10, 0x78, 0x0a, 0xb1, 0x0c, 0xec, 0x0f, 
+0x65, 0x0c, 0xe0, 0x00, 0xb0, 0x11, 0x51, 0x17, 0x81, 0x16, 0x19, 0x08, 
+0xe1, 0x10, 0x3b, 0x16, 0xdc, 0x00, 0xe9, 0x13, 0x90, 0x00, 0xba, 0x08, 
+0x66, 0x0f, 0xb4, 0x0a, 0x96, 0x00, 0xfe, 0x0c, 0x22, 0x0b, 0x41, 0x01, 
+0x1d, 0x0b, 0x37, 0x01, 0x9f, 0x08, 0x8d, 0x0d, 0x34, 0x0a, 0x15, 0x13, 
+0xab, 0x00, 0xff, 0x0f, 0x22, 0x01, 0xde, 0x15, 0xa5, 0x06, 0xff, 0x00, 
+0x9c, 0x00, 0x00, 0x01, 0x5a, 0x08, 0xfe, 0x00, 0xd3, 0x0c, 0x9b, 0x00, 
+0xf2, 0x09, 0xde, 0x08, 0x95, 0x16, 0x76, 0x0c, 0x96, 0x00, 0xc1, 0x17, 
+0xc1, 0x0e, 0x7a, 0x00, 0xd6, 0x00, 0x51, 0x0c, 0xb9, 0x0a, 0x59, 0x14, 
+0xf5, 0x09, 0xf5, 0x00, 0xa7, 0x00, 0x9b, 0x00, 0xb4, 0x09, 0x96, 0x07, 
+0xf8, 0x08, 0xe0, 0x13, 0x9e, 0x0b, 0x3b, 0x0c, 0x0d, 0x0c, 0xff, 0x00, 
+0x9c, 0x10, 0x55, 0x0b, 0x06, 0x01, 0x3c, 0x0c, 0x09, 0x0d, 0xcf, 0x00, 
+0xee, 0x00, 0xa1, 0x11, 0x44, 0x06, 0x23, 0x00, 0xee, 0x00, 0x7d, 0x12, 
+0xf4, 0x00, 0x1b, 0x10, 0x54, 0x0b, 0xc0, 0x00, 0x03, 0x01, 0x5a, 0x0a, 
+0x9f, 0x07, 0x9f, 0x0f, 0x21, 0x01, 0x29, 0x01, 0xab, 0x0b, 0xee, 0x00, 
+0xe1, 0x00, 0x13, 0x0a, 0x89, 0x00, 0x5e, 0x07, 0x57, 0x18, 0x71, 0x0a, 
+0x46, 0x06, 0x05, 0x19, 0x28, 0x12, 0x97, 0x0a, 0x07, 0x0a, 0x65, 0x09, 
+0x13, 0x01, 0xec, 0x00, 0x78, 0x00, 0x7c, 0x18, 0xe0, 0x0b, 0x57, 0x00, 
+0xe5, 0x0d, 0x68, 0x0b, 0xd5, 0x14, 0x29, 0x11, 0x49, 0x0a, 0x8f, 0x00, 
+0x1c, 0x0b, 0x8c, 0x0a, 0x75, 0x00, 0xa0, 0x0f, 0x26, 0x0c, 0x3b, 0x0a, 
+0x2f, 0x00, 0x87, 0x08, 0xda, 0x17, 0xad, 0x0a, 0xf2, 0x13, 0xdd, 0x00, 
+0xd3, 0x09, 0x9b, 0x10, 0x25, 0x01, 0xb7, 0x10, 0xc8, 0x0c, 0x73, 0x0b, 
+0x78, 0x08, 0x7b, 0x0c, 0xe2, 0x00, 0x22, 0x0e, 0x2d, 0x09, 0xd6, 0x00, 
+0xde, 0x00, 0xf5, 0x09, 0x6a, 0x0d, 0xad, 0x00, 0xd7, 0x0c, 0xe5, 0x16, 
+0x3e, 0x0f, 0x89, 0x0b, 0xeb, 0x07, 0x00, 0x0d, 0x84, 0x00, 0x3a, 0x01, 
+0xc0, 0x00, 0x3d, 0x00, 0x43, 0x12, 0xa9, 0x08, 0x79, 0x00, 0xc6, 0x00, 
+0x03, 0x0c, 0x92, 0x10, 0x9e, 0x0b, 0xa8, 0x10, 0x63, 0x00, 0xc7, 0x0c, 
+0x47, 0x00, 0x8e, 0x1a, 0x55, 0x0a, 0x19, 0x0a, 0x9d, 0x08, 0x7f, 0x0c, 
+0x23, 0x14, 0xb6, 0x11, 0x1a, 0x14, 0x17, 0x1a, 0xca, 0x0b, 0x07, 0x0b, 
+0x37, 0x08, 0xde, 0x13, 0xa7, 0x09, 0xdb, 0x06, 0xc7, 0x0c, 0x9f, 0x00, 
+0x88, 0x09, 0x5d, 0x0b, 0xeb, 0x0c, 0x3f, 0x01, 0xc2, 0x0a, 0x17, 0x00, 
+0xa6, 0x0c, 0xae, 0x00, 0x26, 0x07, 0xf2, 0x0a, 0xc7, 0x00, 0xf4, 0x0c, 
+0xba, 0x00, 0x2d, 0x01, 0xd8, 0x15, 0x48, 0x06, 0x99, 0x12, 0x9d, 0x0f, 
+0x03, 0x13, 0xff, 0x0c, 0x47, 0x06, 0xcc, 0x0b, 0x17, 0x14, 0xde, 0x13, 
+0xbf, 0x1a, 0x54, 0x00, 0x90, 0x00, 0x2c, 0x0a, 0x6e, 0x09, 0xb0, 0x0a, 
+0x56, 0x00, 0xcb, 0x0b, 0x9c, 0x08, 0x4f, 0x17, 0x36, 0x1c, 0x69, 0x07, 
+0x47, 0x00, 0x74, 0x00, 0xe9, 0x09, 0x1d, 0x13, 0x00, 0x01, 0xe1, 0x0b, 
+0xbf, 0x09, 0xb1, 0x0a, 0x55, 0x1c, 0x38, 0x0c, 0xb7, 0x0a, 0x9a, 0x07, 
+0xc3, 0x0a, 0xba, 0x06, 0xce, 0x0b, 0xf1, 0x00, 0x31, 0x12, 0x4a, 0x0b, 
+0x94, 0x16, 0x11, 0x01, 0xe0, 0x0b, 0xbc, 0x09, 0xca, 0x00, 0x22, 0x0a, 
+0xb0, 0x00, 0xcd, 0x00, 0x44, 0x07, 0xd6, 0x07, 0x45, 0x13, 0xcf, 0x06, 
+0x40, 0x0a, 0x23, 0x01, 0xfe, 0x00, 0xe4, 0x09, 0x94, 0x00, 0x46, 0x06, 
+0xab, 0x07, 0x96, 0x1a, 0x6c, 0x0a, 0xeb, 0x0b, 0xd9, 0x0b, 0x9e, 0x0c, 
+0x1f, 0x0b, 0xb0, 0x12, 0x7e, 0x0b, 0x12, 0x01, 0xf9, 0x0a, 0xf4, 0x09, 
+0x0b, 0x01, 0x69, 0x17, 0x2a, 0x0f, 0x19, 0x00, 0x93, 0x0e, 0x54, 0x00, 
+0x34, 0x14, 0xd3, 0x09, 0xde, 0x09, 0x34, 0x0a, 0x30, 0x01, 0xe4, 0x06, 
+0x43, 0x0c, 0x21, 0x01, 0x1f, 0x00, 0xaa, 0x00, 0x71, 0x12, 0x22, 0x01, 
+0xd7, 0x15, 0x26, 0x01, 0xd4, 0x00, 0xb4, 0x00, 0x1c, 0x0f, 0x8d, 0x06, 
+0x38, 0x0b, 0x25, 0x00, 0xa8, 0x00, 0xfd, 0x09, 0xc9, 0x0e, 0x10, 0x07, 
+0x5d, 0x0b, 0xa7, 0x00, 0x6b, 0x00, 0xb7, 0x0e, 0x3a, 0x18, 0x17, 0x00, 
+0x94, 0x07, 0x26, 0x01, 0x78, 0x07, 0x8c, 0x00, 0x48, 0x0b, 0xf3, 0x08, 
+0xba, 0x09, 0xdd, 0x06, 0xe1, 0x0b, 0x37, 0x10, 0x55, 0x0a, 0xbb, 0x14, 
+0x16, 0x13, 0x1c, 0x08, 0xc3, 0x0a, 0xd1, 0x00, 0xcb, 0x09, 0x0c, 0x0c, 
+0xa0, 0x0c, 0x76, 0x0a, 0x2a, 0x0b, 0xb0, 0x00, 0xe4, 0x06, 0xec, 0x09, 
+0x0a, 0x1b, 0xd4, 0x00, 0xd7, 0x18, 0x70, 0x0c, 0x01, 0x0c, 0x34, 0x01, 
+0x41, 0x06, 0x59, 0x0c, 0x4d, 0x0e, 0xd2, 0x0f, 0xda, 0x00, 0x3e, 0x0d, 
+0xed, 0x00, 0xc2, 0x00, 0xeb, 0x0c, 0xca, 0x0a, 0xca, 0x0b, 0xe9, 0x09, 
+0x72, 0x08, 0xae, 0x00, 0x3e, 0x0a, 0x69, 0x0b, 0xc9, 0x00, 0x17, 0x14, 
+0x04, 0x08, 0x00, 0x01, 0x65, 0x0a, 0x95, 0x0f, 0xb1, 0x00, 0xd4, 0x11, 
+0x9d, 0x00, 0x1f, 0x01, 0x1e, 0x14, 0x3b, 0x00, 0x11, 0x01, 0x89, 0x00, 
+0x4f, 0x0c, 0x9f, 0x08, 0x1c, 0x07, 0x19, 0x00, 0xaa, 0x06, 0x21, 0x0a, 
+0xa1, 0x0a, 0x11, 0x0d, 0xf4, 0x1b, 0xc8, 0x0c, 0x7a, 0x00, 0xc1, 0x0b, 
+0xd3, 0x00, 0xe7, 0x16, 0x78, 0x10, 0x30, 0x01, 0x3e, 0x0e, 0x7c, 0x13, 
+0xc3, 0x00, 0x1d, 0x13, 0xee, 0x06, 0xd3, 0x0c, 0x26, 0x0b, 0x5f, 0x07, 
+0x22, 0x09, 0xbe, 0x00, 0x73, 0x0c, 0xa2, 0x0b, 0x46, 0x09, 0xfa, 0x0a, 
+0xb2, 0x07, 0xbc, 0x09, 0xf7, 0x0d, 0x12, 0x0b, 0xb1, 0x00, 0xa8, 0x0c, 
+0xe8, 0x0c, 0x11, 0x07, 0xd3, 0x0f, 0x69, 0x0b, 0x18, 0x0c, 0x84, 0x00, 
+0x76, 0x16, 0xd6, 0x14, 0x8b, 0x0a, 0xfa, 0x00, 0x12, 0x0a, 0xc2, 0x0e, 
+0xde, 0x00, 0x7d, 0x0c, 0xd8, 0x00, 0x9a, 0x11, 0xe5, 0x00, 0xef, 0x00, 
+0xb9, 0x00, 0x73, 0x16, 0xdf, 0x09, 0x7b, 0x0a, 0x3a, 0x0c, 0x71, 0x08, 
+0x19, 0x01, 0xf7, 0x09, 0x6b, 0x0a, 0x5b, 0x0c, 0x74, 0x16, 0xef, 0x11, 
+0x41, 0x06, 0x27, 0x0c, 0xd8, 0x00, 0xa8, 0x0c, 0x1d, 0x0a, 0x39, 0x0c, 
+0x8b, 0x0a, 0x1c, 0x00, 0xd7, 0x00, 0x04, 0x01, 0xba, 0x00, 0xf4, 0x00, 
+0x20, 0x15, 0xf0, 0x07, 0x72, 0x11, 0x49, 0x0a, 0x66, 0x13, 0x1a, 0x10, 
+0xbe, 0x00, 0x17, 0x0c, 0xf6, 0x0b, 0x84, 0x00, 0x47, 0x0b, 0xf9, 0x18, 
+0x48, 0x0b, 0x12, 0x00, 0x76, 0x0a, 0x21, 0x10, 0xfc, 0x09, 0x46, 0x0b, 
+0x00, 0x0a, 0x6b, 0x00, 0xf5, 0x0c, 0xa7, 0x0c, 0x1b, 0x0f, 0x5f, 0x07, 
+0xd6, 0x00, 0x1a, 0x0b, 0x8e, 0x0b, 0x73, 0x07, 0x3e, 0x00, 0x02, 0x01, 
+0xd8, 0x07, 0x01, 0x01, 0x41, 0x0b, 0x12, 0x0e, 0x53, 0x0b, 0xaa, 0x0c, 
+0x8e, 0x08, 0x63, 0x09, 0x96, 0x00, 0xa4, 0x0a, 0xd0, 0x00, 0xdf, 0x15, 
+0x48, 0x0c, 0x10, 0x07, 0x92, 0x0f, 0xb3, 0x00, 0x2d, 0x08, 0xdb, 0x0f, 
+0xe6, 0x00, 0xf3, 0x0b, 0x1e, 0x01, 0x4e, 0x17, 0xe2, 0x0c, 0x2b, 0x0a, 
+0x20, 0x01, 0x24, 0x00, 0x0f, 0x0c, 0xb7, 0x0e, 0xb0, 0x18, 0x95, 0x0f, 
+0x95, 0x09, 0x52, 0x0b, 0x52, 0x07, 0x3f, 0x0b, 0xd5, 0x0c, 0xc1, 0x0b, 
+0x80, 0x07, 0x74, 0x0b, 0x1d, 0x0b, 0xcd, 0x00, 0x36, 0x0b, 0x2b, 0x01, 
+0x1c, 0x00, 0x88, 0x0c, 0x1c, 0x0b, 0xae, 0x00, 0xdf, 0x00, 0x27, 0x01, 
+0xfb, 0x0b, 0x5d, 0x12, 0xb8, 0x10, 0xfc, 0x15, 0x3c, 0x16, 0xf6, 0x0e, 
+0x15, 0x0a, 0xa9, 0x09, 0xc4, 0x0a, 0x3f, 0x00, 0xa4, 0x0a, 0xa1, 0x0b, 
+0xa4, 0x0a, 0xcd, 0x00, 0x28, 0x08, 0x00, 0x01, 0x48, 0x06, 0xb2, 0x0c, 
+0x5a, 0x0c, 0x70, 0x09, 0xec, 0x09, 0x6d, 0x00, 0xde, 0x00, 0xa8, 0x17, 
+0xa2, 0x0d, 0xb2, 0x00, 0x60, 0x00, 0x63, 0x17, 0x12, 0x07, 0xcf, 0x06, 
+0xfd, 0x00, 0xb9, 0x00, 0x9f, 0x0c, 0x18, 0x00, 0x76, 0x0b, 0x31, 0x0c, 
+0x57, 0x0a, 0x93, 0x12, 0x68, 0x0b, 0x4d, 0x0a, 0x4c, 0x13, 0xb2, 0x09, 
+0xf6, 0x0b, 0x62, 0x09, 0xe0, 0x00, 0x8d, 0x13, 0x04, 0x01, 0x40, 0x0b, 
+0xba, 0x0a, 0xbc, 0x00, 0xfa, 0x00, 0x5b, 0x08, 0x84, 0x10, 0xd3, 0x0e, 
+0x0f, 0x0b, 0x8f, 0x1c, 0x7e, 0x16, 0x49, 0x00, 0xc0, 0x0c, 0xb0, 0x00, 
+0xd3, 0x09, 0xe3, 0x00, 0xec, 0x0b, 0x78, 0x00, 0xf9, 0x00, 0x7d, 0x00, 
+0x96, 0x15, 0xba, 0x09, 0x36, 0x09, 0xe3, 0x0b, 0xc8, 0x00, 0x1e, 0x14, 
+0xe3, 0x07, 0xde, 0x00, 0x13, 0x00, 0xd2, 0x09, 0x12, 0x01, 0x94, 0x0c, 
+0xf6, 0x00, 0x6d, 0x1b, 0x27, 0x14, 0x6e, 0x00, 0xe5, 0x0a, 0x1e, 0x16, 
+0x41, 0x0e, 0x9b, 0x19, 0x2f, 0x00, 0x60, 0x00, 0xdb, 0x0d, 0xc4, 0x0a, 
+0xdb, 0x00, 0xba, 0x00, 0xeb, 0x0b, 0xb5, 0x00, 0x2a, 0x01, 0x88, 0x0c, 
+0x01, 0x0c, 0xdd, 0x00, 0xed, 0x0b, 0x60, 0x11, 0x7a, 0x18, 0x88, 0x0b, 
+0xfc, 0x00, 0x11, 0x01, 0xee, 0x0b, 0x32, 0x01, 0xae, 0x10, 0x43, 0x06, 
+0x62, 0x17, 0xf6, 0x00, 0x73, 0x10, 0x40, 0x06, 0xe3, 0x0a, 0xaa, 0x16, 
+0xbd, 0x14, 0xff, 0x0a, 0xad, 0x15, 0x8f, 0x00, 0xf5, 0x13, 0xb8, 0x00, 
+0xad, 0x06, 0x92, 0x1b, 0xfe, 0x09, 0x65, 0x0c, 0x12, 0x00, 0x81, 0x07, 
+0xa5, 0x0a, 0x93, 0x0b, 0x7a, 0x0c, 0x38, 0x08, 0x92, 0x13, 0x61, 0x07, 
+0x7f, 0x0e, 0x2c, 0x09, 0xeb, 0x00, 0xa8, 0x00, 0xb0, 0x1a, 0x92, 0x0c, 
+0xa7, 0x0c, 0xec, 0x0b, 0x7c, 0x0c, 0x96, 0x16, 0x24, 0x00, 0xb4, 0x00, 
+0x55, 0x0a, 0xb6, 0x00, 0xc1, 0x00, 0x30, 0x01, 0xfc, 0x0d, 0xeb, 0x00, 
+0x14, 0x0a, 0xd6, 0x09, 0xc0, 0x0e, 0xa2, 0x0d, 0xd8, 0x0e, 0x2a, 0x01, 
+0x41, 0x0a, 0x69, 0x06, 0x67, 0x0b, 0x2c, 0x0a, 0x08, 0x01, 0x2d, 0x0c, 
+0x0a, 0x0a, 0x7f, 0x07, 0x88, 0x17, 0x18, 0x0c, 0x2f, 0x14, 0x7b, 0x00, 
+0x20, 0x01, 0x0f, 0x00, 0x45, 0x0f, 0xe1, 0x13, 0xf2, 0x00, 0x53, 0x00, 
+0x71, 0x00, 0x3e, 0x08, 0xa5, 0x09, 0x15, 0x01, 0xbf, 0x0c, 0xd9, 0x00, 
+0xf4, 0x00, 0xc7, 0x00, 0xcc, 0x00, 0xdb, 0x00, 0xf9, 0x0a, 0x78, 0x08, 
+0xf1, 0x15, 0xfd, 0x10, 0x24, 0x09, 0xcb, 0x00, 0xcd, 0x15, 0xfc, 0x00, 
+0x4e, 0x0a, 0x85, 0x0c, 0xa7, 0x08, 0xc1, 0x16, 0x1f, 0x0b, 0xf7, 0x0c, 
+0x02, 0x13, 0x11, 0x07, 0x7c, 0x00, 0xca, 0x00, 0x5a, 0x00, 0xca, 0x00, 
+0x1f, 0x0a, 0xd7, 0x16, 0x96, 0x00, 0x5d, 0x0c, 0xdd, 0x00, 0x18, 0x08, 
+0x3c, 0x00, 0x35, 0x01, 0x6f, 0x0c, 0x06, 0x01, 0x63, 0x09, 0x01, 0x0f, 
+0x1b, 0x07, 0x38, 0x0c, 0xb7, 0x0b, 0x3e, 0x01, 0xd0, 0x06, 0x8c, 0x06, 
+0x56, 0x06, 0xd9, 0x0e, 0x6b, 0x0e, 0x67, 0x0c, 0x3b, 0x18, 0x68, 0x0c, 
+0x30, 0x12, 0xc6, 0x09, 0xa0, 0x00, 0x02, 0x15, 0x23, 0x01, 0x56, 0x00, 
+0xb0, 0x0a, 0xd8, 0x00, 0x69, 0x0b, 0x79, 0x08, 0x6c, 0x00, 0xb4, 0x00, 
+0x95, 0x0c, 0xb0, 0x09, 0x3e, 0x00, 0xa1, 0x0f, 0x2b, 0x01, 0x7c, 0x00, 
+0x17, 0x0c, 0x29, 0x0a, 0x1c, 0x01, 0x25, 0x07, 0x82, 0x10, 0xc7, 0x00, 
+0x4e, 0x00, 0xfc, 0x0a, 0x06, 0x0b, 0x1e, 0x16, 0xa2, 0x0a, 0xd9, 0x00, 
+0x49, 0x0a, 0xca, 0x00, 0x1b, 0x01, 0x47, 0x00, 0x1a, 0x0b, 0xcf, 0x00, 
+0x67, 0x0a, 0xc7, 0x00, 0x4a, 0x13, 0x9e, 0x00, 0x41, 0x01, 0x29, 0x0a, 
+0x7a, 0x10, 0x33, 0x0a, 0xaf, 0x10, 0x6d, 0x14, 0x38, 0x01, 0x2a, 0x0a, 
+0xc9, 0x0f, 0x0d, 0x01, 0xcc, 0x09, 0x77, 0x00, 0xec, 0x0b, 0x4e, 0x0b, 
+0x0b, 0x01, 0xa3, 0x0a, 0x28, 0x01, 0x77, 0x00, 0x86, 0x16, 0xa1, 0x0c, 
+0xb5, 0x08, 0xab, 0x00, 0xdb, 0x15, 0xe0, 0x0c, 0x2f, 0x14, 0xcc, 0x00, 
+0x90, 0x10, 0x8b, 0x0a, 0xac, 0x0a, 0xb7, 0x0b, 0xad, 0x0d, 0xd6, 0x0b, 
+0x13, 0x0a, 0x24, 0x00, 0x30, 0x0b, 0xd1, 0x06, 0x24, 0x09, 0x04, 0x08, 
+0x1e, 0x0c, 0xf4, 0x00, 0x49, 0x09, 0xd7, 0x16, 0x20, 0x09, 0xfc, 0x1a, 
+0x5a, 0x06, 0xf9, 0x00, 0xb4, 0x0b, 0x92, 0x06, 0xcb, 0x00, 0xf6, 0x0c, 
+0x40, 0x00, 0x5a, 0x0c, 0x28, 0x0d, 0x40, 0x0e, 0x55, 0x00, 0xd5, 0x0c, 
+0x17, 0x00, 0xaa, 0x15, 0xac, 0x0a, 0xf0, 0x0e, 0x22, 0x01, 0xde, 0x08, 
+0x9b, 0x00, 0x39, 0x01, 0x75, 0x0a, 0x60, 0x15, 0xdb, 0x07, 0xe3, 0x06, 
+0xdb, 0x09, 0xb4, 0x00, 0x29, 0x00, 0xb8, 0x00, 0xb6, 0x00, 0xba, 0x00, 
+0xbf, 0x0b, 0xcf, 0x0b, 0xe9, 0x13, 0xfc, 0x0a, 0x33, 0x18, 0xda, 0x0a, 
+0x36, 0x08, 0xd0, 0x08, 0x47, 0x00, 0x21, 0x01, 0x0b, 0x01, 0xa5, 0x09, 
+0x4b, 0x0a, 0xfe, 0x0b, 0x56, 0x07, 0xa7, 0x00, 0x62, 0x09, 0xe8, 0x13, 
+0x42, 0x01, 0x70, 0x06, 0xe0, 0x00, 0x2a, 0x0c, 0xd9, 0x06, 0x36, 0x1c, 
+0xf7, 0x0b, 0x88, 0x16, 0xda, 0x0d, 0x25, 0x0b, 0x32, 0x00, 0xd8, 0x15, 
+0x91, 0x1b, 0xe8, 0x00, 0xf5, 0x0c, 0x8e, 0x1a, 0x7b, 0x10, 0x3f, 0x00, 
+0xec, 0x0b, 0x1e, 0x01, 0xfe, 0x00, 0x90, 0x17, 0x80, 0x0a, 0x70, 0x17, 
+0x78, 0x09, 0x36, 0x0a, 0x3f, 0x00, 0x36, 0x10, 0x36, 0x01, 0xae, 0x13, 
+0xdc, 0x06, 0xbc, 0x16, 0x47, 0x09, 0xbe, 0x00, 0x3b, 0x1a, 0x4d, 0x0a, 
+0xb5, 0x0b, 0x67, 0x18, 0x3b, 0x00, 0xa7, 0x00, 0xa1, 0x08, 0x67, 0x13, 
+0x56, 0x11, 0x2f, 0x09, 0xcd, 0x0a, 0x2b, 0x01, 0x6b, 0x0c, 0x39, 0x09, 
+0xf1, 0x14, 0x9f, 0x0b, 0xac, 0x00, 0xa2, 0x0a, 0x11, 0x0b, 0x53, 0x07, 
+0x7b, 0x09, 0x74, 0x0e, 0x67, 0x00, 0x21, 0x09, 0x7b, 0x0c, 0x16, 0x0a, 
+0x4a, 0x0a, 0xde, 0x08, 0x90, 0x00, 0xfd, 0x09, 0x2c, 0x09, 0xc8, 0x0c, 
+0xe8, 0x0b, 0x5c, 0x0f, 0x78, 0x00, 0xfa, 0x0a, 0x35, 0x0a, 0xdf, 0x00, 
+0xd2, 0x0c, 0xbe, 0x09, 0x3e, 0x0a, 0x14, 0x0a, 0x9b, 0x06, 0x3f, 0x0f, 
+0xe0, 0x0b, 0xa8, 0x0c, 0x4b, 0x00, 0xd5, 0x06, 0x1b, 0x0b, 0x12, 0x0d, 
+0x1a, 0x08, 0xf8, 0x00, 0x97, 0x0a, 0x37, 0x00, 0xfe, 0x00, 0x31, 0x01, 
+0x6b, 0x00, 0x26, 0x01, 0x14, 0x07, 0xb2, 0x00, 0x48, 0x00, 0x3b, 0x18, 
+0xba, 0x0a, 0xf5, 0x00, 0xa8, 0x0c, 0xf7, 0x00, 0x03, 0x0c, 0xc7, 0x0c, 
+0x01, 0x01, 0xbd, 0x00, 0x59, 0x18, 0xca, 0x00, 0x53, 0x12, 0x28, 0x0a, 
+0x64, 0x0d, 0xda, 0x0a, 0x2f, 0x00, 0xcd, 0x0a, 0xfb, 0x0a, 0x63, 0x0a, 
+0x74, 0x0b, 0xcd, 0x09, 0x66, 0x13, 0x37, 0x0b, 0x43, 0x06, 0x54, 0x00, 
+0xb3, 0x00, 0x76, 0x16, 0xab, 0x00, 0x61, 0x17, 0xad, 0x0a, 0x25, 0x00, 
+0xe3, 0x17, 0x89, 0x0b, 0x72, 0x16, 0xaa, 0x00, 0x53, 0x00, 0x06, 0x09, 
+0xff, 0x09, 0x6a, 0x0a, 0xbb, 0x14, 0xdd, 0x00, 0xc1, 0x00, 0xa5, 0x0f, 
+0x1e, 0x01, 0xd0, 0x00, 0xf5, 0x00, 0x5d, 0x0c, 0x10, 0x01, 0x3e, 0x0a, 
+0x66, 0x13, 0xf4, 0x0b, 0x4b, 0x06, 0xb9, 0x0a, 0x95, 0x0b, 0xaa, 0x00, 
+0xf6, 0x09, 0x2a, 0x18, 0xae, 0x10, 0xd4, 0x0c, 0xb7, 0x0b, 0x30, 0x08, 
+0x3b, 0x0c, 0xed, 0x06, 0x63, 0x0d, 0x44, 0x0c, 0xfd, 0x00, 0x99, 0x06, 
+0x83, 0x00, 0xc9, 0x00, 0xcc, 0x0b, 0xac, 0x06, 0x55, 0x00, 0xab, 0x1a, 
+0xaa, 0x00, 0x18, 0x00, 0xeb, 0x00, 0xf2, 0x0a, 0xe8, 0x0b, 0x90, 0x0c, 
+0x60, 0x0b, 0x34, 0x01, 0x46, 0x06, 0x83, 0x09, 0xf1, 0x0a, 0x15, 0x01, 
+0x87, 0x00, 0x15, 0x01, 0x0c, 0x01, 0x5a, 0x00, 0xf3, 0x00, 0x40, 0x06, 
+0x53, 0x0b, 0x89, 0x0b, 0x4e, 0x0c, 0xca, 0x00, 0x5e, 0x12, 0x91, 0x1b, 
+0x18, 0x00, 0x3b, 0x0b, 0xb5, 0x00, 0x1e, 0x15, 0x9b, 0x0c, 0xe8, 0x0c, 
+0xd1, 0x09, 0x43, 0x0c, 0xe4, 0x0a, 0x12, 0x01, 0x3e, 0x0f, 0x70, 0x00, 
+0xc1, 0x00, 0x8a, 0x0b, 0x24, 0x0c, 0x4c, 0x11, 0x19, 0x01, 0x54, 0x0a, 
+0x3b, 0x00, 0x68, 0x13, 0x4f, 0x0c, 0x3d, 0x01, 0xd4, 0x10, 0x15, 0x14, 
+0x6d, 0x14, 0xf6, 0x0b, 0x03, 0x01, 0x49, 0x00, 0x47, 0x1c, 0xaf, 0x12, 
+0xc0, 0x00, 0xc3, 0x0a, 0x5e, 0x0b, 0xab, 0x00, 0x56, 0x00, 0x58, 0x18, 
+0x6e, 0x13, 0x9d, 0x0f, 0x19, 0x0c, 0x72, 0x00, 0x3b, 0x12, 0x99, 0x15, 
+0x93, 0x00, 0x32, 0x12, 0xbb, 0x09, 0x3b, 0x0c, 0x76, 0x0b, 0xb5, 0x0b, 
+0x00, 0x0f, 0x95, 0x0f, 0xb8, 0x00, 0x61, 0x0a, 0x9c, 0x08, 0x59, 0x0a, 
+0x5e, 0x18, 0x2f, 0x00, 0x9f, 0x0b, 0xc7, 0x00, 0x20, 0x01, 0xf9, 0x0f, 
+0x3f, 0x0d, 0x08, 0x0a, 0xbe, 0x00, 0xbc, 0x09, 0xd9, 0x00, 0x7c, 0x16, 
+0x60, 0x0a, 0xe6, 0x0a, 0xd9, 0x0b, 0xaf, 0x00, 0x0b, 0x0a, 0x62, 0x00, 
+0x96, 0x00, 0x3b, 0x01, 0x33, 0x0a, 0xe2, 0x0b, 0x66, 0x08, 0x60, 0x0b, 
+0xb3, 0x07, 0xf5, 0x0a, 0x24, 0x13, 0x96, 0x0b, 0xfa, 0x00, 0x32, 0x18, 
+0xe9, 0x00, 0x0b, 0x0f, 0x41, 0x0a, 0x7d, 0x0c, 0xa6, 0x09, 0xce, 0x00, 
+0x01, 0x01, 0x3f, 0x00, 0x3a, 0x16, 0xd6, 0x17, 0xc5, 0x09, 0xa0, 0x00, 
+0x6d, 0x0a, 0x38, 0x01, 0x7c, 0x16, 0x25, 0x00, 0xcc, 0x00, 0xfc, 0x14, 
+0xac, 0x00, 0x8f, 0x16, 0xee, 0x00, 0x3b, 0x00, 0xb3, 0x08, 0xd8, 0x0b, 
+0xb2, 0x16, 0x47, 0x06, 0x2c, 0x11, 0x5d, 0x08, 0x89, 0x17, 0x05, 0x01, 
+0xe7, 0x00, 0x39, 0x01, 0x21, 0x0a, 0xa1, 0x0b, 0x84, 0x0b, 0xf9, 0x0a, 
+0xd2, 0x09, 0x72, 0x0b, 0xb7, 0x00, 0x1a, 0x0c, 0xdb, 0x0a, 0xdb, 0x11, 
+0x3b, 0x00, 0x3c, 0x00, 0x08, 0x01, 0x35, 0x0a, 0x18, 0x01, 0x5d, 0x0c, 
+0xbe, 0x00, 0x5f, 0x0b, 0x5d, 0x0b, 0xde, 0x00, 0x2d, 0x17, 0xee, 0x0a, 
+0xa0, 0x07, 0x11, 0x07, 0x6e, 0x0a, 0x05, 0x01, 0x5c, 0x0b, 0x48, 0x00, 
+0x03, 0x08, 0xb7, 0x00, 0x3b, 0x13, 0x39, 0x08, 0x41, 0x1a, 0xb1, 0x0a, 
+0x5c, 0x0b, 0xed, 0x00, 0x28, 0x12, 0x33, 0x00, 0x4a, 0x0a, 0xe8, 0x00, 
+0x08, 0x0a, 0x55, 0x0a, 0x98, 0x18, 0x08, 0x0b, 0xe2, 0x08, 0xa9, 0x00, 
+0x83, 0x1b, 0xe2, 0x13, 0xa7, 0x0c, 0xb1, 0x09, 0x09, 0x08, 0x34, 0x0b, 
+0xfb, 0x0a, 0xd8, 0x07, 0x50, 0x0f, 0x62, 0x0d, 0x56, 0x00, 0xc7, 0x17, 
+0x0a, 0x0a, 0x09, 0x0a, 0x7f, 0x0b, 0xb5, 0x00, 0x61, 0x0b, 0x70, 0x08, 
+0xdc, 0x09, 0xa9, 0x00, 0x3f, 0x0a, 0xb6, 0x00, 0x7e, 0x18, 0x46, 0x09, 
+0x4b, 0x00, 0x18, 0x0c, 0xe0, 0x00, 0x85, 0x0c, 0x4c, 0x06, 0xbf, 0x0a, 
+0x68, 0x07, 0x96, 0x15, 0xba, 0x14, 0x1d, 0x13, 0x9b, 0x00, 0xe8, 0x09, 
+0xff, 0x00, 0x51, 0x0b, 0x0b, 0x00, 0x71, 0x0c, 0xff, 0x09, 0xba, 0x00, 
+0x3d, 0x0c, 0x67, 0x1b, 0x47, 0x06, 0x79, 0x0a, 0x8a, 0x12, 0x3d, 0x00, 
+0xe3, 0x0b, 0x37, 0x11, 0xe4, 0x0b, 0x82, 0x18, 0x38, 0x09, 0x0b, 0x01, 
+0xd0, 0x15, 0x7f, 0x00, 0x73, 0x14, 0x86, 0x16, 0x8a, 0x0b, 0x49, 0x06, 
+0x4a, 0x00, 0x12, 0x0b, 0xdb, 0x06, 0x4e, 0x0e, 0x4c, 0x0b, 0x42, 0x18, 
+0x85, 0x00, 0x24, 0x01, 0x2a, 0x0a, 0xa7, 0x06, 0xd1, 0x00, 0xf1, 0x0a, 
+0xf6, 0x0b, 0x13, 0x11, 0xe6, 0x09, 0xa5, 0x0a, 0xa2, 0x0a, 0x7a, 0x0c, 
+0xce, 0x00, 0xfe, 0x0a, 0xc3, 0x00, 0x9f, 0x07, 0x0c, 0x13, 0x53, 0x00, 
+0x02, 0x0c, 0x4e, 0x11, 0xe3, 0x07, 0xee, 0x07, 0x1a, 0x0b, 0x25, 0x0b, 
+0x0e, 0x01, 0x38, 0x01, 0xe8, 0x0a, 0x3c, 0x1a, 0xe0, 0x00, 0x18, 0x01, 
+0x12, 0x01, 0xcf, 0x0a, 0xef, 0x00, 0x3b, 0x00, 0x76, 0x12, 0x3b, 0x13, 
+0xa6, 0x09, 0xd7, 0x07, 0xad, 0x00, 0x3b, 0x0b, 0x73, 0x16, 0x12, 0x01, 
+0x01, 0x01, 0x59, 0x0c, 0xb8, 0x00, 0x73, 0x08, 0x1c, 0x01, 0xc7, 0x00, 
+0x9a, 0x09, 0x2a, 0x01, 0x57, 0x00, 0x19, 0x0c, 0x44, 0x06, 0xe7, 0x06, 
+0xbc, 0x00, 0xd3, 0x09, 0xae, 0x16, 0x33, 0x01, 0x3a, 0x01, 0xc2, 0x0a, 
+0x15, 0x07, 0x10, 0x00, 0x40, 0x06, 0xff, 0x00, 0x07, 0x0f, 0xc1, 0x0e, 
+0x0d, 0x0c, 0xbb, 0x00, 0x69, 0x15, 0x53, 0x0b, 0x26, 0x00, 0x2c, 0x18, 
+0xc1, 0x00, 0x03, 0x16, 0x2e, 0x01, 0xaa, 0x00, 0x2a, 0x13, 0xa3, 0x0a, 
+0x18, 0x08, 0xe3, 0x06, 0x3a, 0x01, 0x70, 0x09, 0xd8, 0x00, 0xb6, 0x11, 
+0xca, 0x0c, 0x51, 0x17, 0xf6, 0x08, 0xcf, 0x14, 0xba, 0x00, 0x14, 0x0a, 
+0x28, 0x01, 0xd9, 0x00, 0x04, 0x0a, 0x7e, 0x0e, 0xa8, 0x00, 0x60, 0x00, 
+0x9c, 0x0f, 0x2a, 0x0b, 0xb2, 0x08, 0x09, 0x16, 0xd4, 0x0c, 0xea, 0x00, 
+0x1b, 0x01, 0xfd, 0x00, 0x00, 0x0d, 0xc0, 0x0b, 0x7f, 0x0c, 0x23, 0x00, 
+0xf1, 0x14, 0xe8, 0x09, 0xdd, 0x09, 0xd6, 0x17, 0x97, 0x0f, 0x0c, 0x01, 
+0xb0, 0x00, 0xf3, 0x08, 0x6d, 0x09, 0xc9, 0x0c, 0xdb, 0x00, 0xb7, 0x00, 
+0xba, 0x00, 0x9e, 0x00, 0x3e, 0x00, 0x72, 0x0c, 0x99, 0x0a, 0xaa, 0x0c, 
+0x40, 0x08, 0xed, 0x0e, 0xd2, 0x00, 0x4d, 0x13, 0x15, 0x01, 0x80, 0x09, 
+0x92, 0x0c, 0xb0, 0x09, 0x6b, 0x00, 0x90, 0x00, 0x0c, 0x00, 0x70, 0x06, 
+0xf5, 0x00, 0x2f, 0x00, 0x45, 0x0c, 0x35, 0x01, 0x9d, 0x17, 0xe6, 0x09, 
+0x09, 0x01, 0xe7, 0x00, 0xbc, 0x0a, 0x15, 0x14, 0x07, 0x0b, 0x24, 0x00, 
+0xc7, 0x09, 0x6f, 0x13, 0xab, 0x07, 0xed, 0x00, 0x07, 0x0a, 0x20, 0x09, 
+0xd0, 0x09, 0xa0, 0x08, 0x1b, 0x0e, 0x58, 0x0b, 0x28, 0x0b, 0x17, 0x00, 
+0x75, 0x0a, 0x0c, 0x01, 0x88, 0x0b, 0x77, 0x0d, 0xea, 0x00, 0x0c, 0x09, 
+0xda, 0x06, 0x92, 0x11, 0x66, 0x09, 0x36, 0x1c, 0x25, 0x0b, 0xac, 0x13, 
+0xaf, 0x0f, 0x27, 0x01, 0x72, 0x06, 0x46, 0x06, 0x48, 0x0e, 0xf5, 0x07, 
+0xcc, 0x17, 0x69, 0x09, 0xc1, 0x0b, 0x6e, 0x12, 0x1f, 0x0a, 0x73, 0x0b, 
+0x74, 0x0c, 0x11, 0x07, 0xab, 0x00, 0x63, 0x0e, 0x21, 0x01, 0xe1, 0x07, 
+0x30, 0x01, 0x25, 0x01, 0xca, 0x00, 0xaa, 0x00, 0xa2, 0x0a, 0x55, 0x06, 
+0x64, 0x0c, 0x55, 0x0a, 0xf0, 0x0e, 0x02, 0x17, 0xfc, 0x00, 0xf0, 0x0a, 
+0x2b, 0x01, 0x8d, 0x06, 0x03, 0x0c, 0x39, 0x0a, 0x50, 0x0e, 0x19, 0x00, 
+0x44, 0x13, 0xe2, 0x00, 0x06, 0x01, 0x19, 0x00, 0x88, 0x0c, 0x0a, 0x01, 
+0x86, 0x00, 0x1f, 0x0a, 0xa4, 0x06, 0x17, 0x00, 0x41, 0x00, 0x48, 0x06, 
+0xee, 0x08, 0x66, 0x13, 0x9e, 0x00, 0x5e, 0x08, 0x46, 0x0b, 0x22, 0x01, 
+0x1a, 0x01, 0x0b, 0x00, 0xae, 0x00, 0x20, 0x07, 0x74, 0x10, 0x54, 0x0a, 
+0x15, 0x0a, 0xe8, 0x13, 0xc7, 0x09, 0x21, 0x01, 0x8c, 0x06, 0x97, 0x15, 
+0x60, 0x0a, 0x62, 0x0c, 0x62, 0x00, 0xb2, 0x09, 0x27, 0x07, 0x3e, 0x0a, 
+0x87, 0x16, 0x8b, 0x0c, 0xf0, 0x00, 0x2c, 0x01, 0x04, 0x0b, 0x28, 0x00, 
+0x9d, 0x08, 0xd9, 0x0b, 0xcf, 0x00, 0xb7, 0x0a, 0x72, 0x0c, 0xd8, 0x0b, 
+0xda, 0x00, 0xc8, 0x00, 0x65, 0x11, 0xe5, 0x0a, 0xcb, 0x09, 0x04, 0x01, 
+0x32, 0x01, 0xd6, 0x07, 0x98, 0x15, 0xde, 0x09, 0x32, 0x00, 0x62, 0x00, 
+0xe5, 0x00, 0xae, 0x10, 0xd9, 0x00, 0xfd, 0x0b, 0x6e, 0x12, 0xd5, 0x09, 
+0x4d, 0x0a, 0x48, 0x06, 0xb5, 0x00, 0xd7, 0x00, 0x77, 0x09, 0xb5, 0x07, 
+0x69, 0x0b, 0x8c, 0x11, 0xf6, 0x07, 0x35, 0x0a, 0x0e, 0x01, 0xf3, 0x00, 
+0xac, 0x0a, 0x77, 0x0a, 0x7a, 0x10, 0xa5, 0x07, 0xb1, 0x08, 0x19, 0x00, 
+0xf0, 0x00, 0x17, 0x0a, 0x69, 0x12, 0xe5, 0x00, 0x3c, 0x00, 0xa2, 0x0b, 
+0x30, 0x00, 0x3b, 0x01, 0x40, 0x0e, 0x9b, 0x00, 0x6d, 0x09, 0x50, 0x0c, 
+0xbb, 0x07, 0x03, 0x08, 0x13, 0x01, 0x15, 0x14, 0xc7, 0x0c, 0xf2, 0x00, 
+0x59, 0x00, 0x67, 0x14, 0x60, 0x00, 0x0e, 0x0c, 0x11, 0x07, 0xdb, 0x00, 
+0xdb, 0x06, 0x0d, 0x0c, 0xbb, 0x09, 0x57, 0x0a, 0x7e, 0x00, 0x60, 0x00, 
+0x37, 0x0a, 0x90, 0x06, 0x30, 0x00, 0x5e, 0x0c, 0xdf, 0x0c, 0x5f, 0x00, 
+0x1e, 0x16, 0x7a, 0x00, 0x4d, 0x11, 0xd6, 0x0b, 0xd5, 0x0f, 0x84, 0x00, 
+0x7d, 0x16, 0xd9, 0x0a, 0x5b, 0x17, 0x33, 0x00, 0x9f, 0x08, 0xd9, 0x0a, 
+0x08, 0x0b, 0xd2, 0x0c, 0xe5, 0x00, 0xe6, 0x0d, 0x55, 0x0a, 0xb4, 0x08, 
+0xb1, 0x0c, 0x8b, 0x0b, 0xe2, 0x0b, 0x72, 0x0c, 0x46, 0x0b, 0x0a, 0x0a, 
+0xd8, 0x18, 0x5d, 0x0b, 0x01, 0x0c, 0x97, 0x07, 0x09, 0x16, 0x51, 0x0b, 
+0x92, 0x12, 0xd7, 0x0b, 0x1a, 0x01, 0x96, 0x12, 0x99, 0x15, 0xab, 0x07, 
+0xd8, 0x00, 0xbc, 0x00, 0x75, 0x0a, 0xaf, 0x00, 0x7c, 0x08, 0xae, 0x11, 
+0xde, 0x16, 0x1b, 0x0b, 0xaa, 0x07, 0xd8, 0x00, 0x91, 0x0c, 0xca, 0x09, 
+0x87, 0x07, 0xd1, 0x09, 0x21, 0x01, 0x47, 0x1a, 0x68, 0x0b, 0xf2, 0x0b, 
+0x04, 0x15, 0x18, 0x00, 0xfe, 0x1b, 0x7f, 0x16, 0x27, 0x01, 0xbe, 0x00, 
+0x11, 0x0e, 0x2c, 0x0b, 0xaa, 0x0b, 0x53, 0x00, 0x30, 0x0b, 0xf8, 0x0e, 
+0xe2, 0x07, 0xde, 0x15, 0xa2, 0x0b, 0xfe, 0x15, 0xe3, 0x0a, 0x18, 0x00, 
+0xe6, 0x09, 0x58, 0x0e, 0xc6, 0x00, 0x5e, 0x0b, 0x6c, 0x0a, 0x01, 0x0c, 
+0xda, 0x0f, 0x9f, 0x0b, 0x1a, 0x01, 0x61, 0x0a, 0x40, 0x06, 0xde, 0x09, 
+0x44, 0x15, 0x6d, 0x0a, 0x3b, 0x00, 0x36, 0x08, 0x4a, 0x0a, 0x22, 0x0c, 
+0xbc, 0x14, 0x2a, 0x18, 0xf0, 0x0f, 0x49, 0x0a, 0xbb, 0x00, 0xe8, 0x00, 
+0x88, 0x17, 0x11, 0x01, 0x80, 0x0a, 0x80, 0x0c, 0x8a, 0x11, 0x82, 0x0a, 
+0x48, 0x06, 0x02, 0x01, 0xc5, 0x00, 0xcb, 0x00, 0xc0, 0x09, 0xce, 0x0a, 
+0xb9, 0x00, 0xc2, 0x08, 0x25, 0x01, 0x68, 0x00, 0x37, 0x07, 0x1b, 0x01, 
+0x3f, 0x01, 0x65, 0x0a, 0xa3, 0x06, 0x7f, 0x0e, 0x3e, 0x01, 0x6a, 0x0a, 
+0x6c, 0x1a, 0x34, 0x0a, 0x89, 0x10, 0x1b, 0x07, 0x44, 0x06, 0xe5, 0x07, 
+0x34, 0x12, 0x0f, 0x01, 0x51, 0x0b, 0x5e, 0x0b, 0x47, 0x0c, 0xa0, 0x15, 
+0x75, 0x15, 0x59, 0x0a, 0x5f, 0x0b, 0x5c, 0x08, 0xf1, 0x00, 0x0e, 0x00, 
+0x16, 0x13, 0xa2, 0x00, 0xac, 0x0a, 0x70, 0x0a, 0xf8, 0x00, 0x97, 0x15, 
+0x3e, 0x00, 0x24, 0x0c, 0xc7, 0x0c, 0x78, 0x10, 0xda, 0x00, 0xd2, 0x00, 
+0x21, 0x01, 0x71, 0x10, 0x61, 0x0a, 0xe9, 0x08, 0x78, 0x09, 0x81, 0x0a, 
+0x13, 0x01, 0x3b, 0x01, 0x1e, 0x15, 0xd6, 0x0c, 0xbc, 0x0c, 0x22, 0x0c, 
+0xf0, 0x00, 0x22, 0x0c, 0xf4, 0x0c, 0x33, 0x18, 0xf6, 0x00, 0xe4, 0x0d, 
+0x9c, 0x0c, 0x4f, 0x0c, 0x9c, 0x08, 0x61, 0x00, 0x9f, 0x00, 0xd2, 0x00, 
+0x28, 0x0b, 0xa7, 0x10, 0x15, 0x13, 0xbf, 0x0b, 0xd7, 0x15, 0xb9, 0x0a, 
+0x1e, 0x11, 0xee, 0x06, 0x6b, 0x00, 0x93, 0x0c, 0x92, 0x11, 0x97, 0x0a, 
+0xd9, 0x00, 0xa8, 0x00, 0x0e, 0x00, 0xf6, 0x00, 0x27, 0x00, 0xae, 0x0a, 
+0xb7, 0x09, 0x80, 0x07, 0xc7, 0x17, 0xa0, 0x0b, 0x01, 0x0a, 0x9b, 0x00, 
+0x65, 0x11, 0x38, 0x0c, 0x3d, 0x00, 0x1c, 0x00, 0x12, 0x0b, 0xbb, 0x00, 
+0xc5, 0x14, 0xf7, 0x0b, 0x4b, 0x0a, 0x41, 0x06, 0xe7, 0x09, 0xd5, 0x09, 
+0x07, 0x09, 0x33, 0x01, 0x49, 0x06, 0x87, 0x00, 0xb7, 0x0b, 0x78, 0x0d, 
+0xc8, 0x16, 0xe2, 0x00, 0x1e, 0x01, 0x50, 0x17, 0xdf, 0x15, 0x4a, 0x00, 
+0x6f, 0x0c, 0x4d, 0x0a, 0xd8, 0x07, 0x6e, 0x10, 0xe6, 0x0c, 0x35, 0x0a, 
+0x1b, 0x01, 0x13, 0x01, 0xaf, 0x0a, 0xcb, 0x0c, 0xa1, 0x15, 0xb2, 0x0c, 
+0x7b, 0x00, 0x4b, 0x0a, 0x6c, 0x07, 0x3e, 0x00, 0xb4, 0x1b, 0x07, 0x0c, 
+0x2d, 0x0c, 0xc8, 0x00, 0xdf, 0x00, 0xd1, 0x15, 0xf1, 0x09, 0x9c, 0x08, 
+0xff, 0x00, 0x39, 0x12, 0x64, 0x0c, 0x18, 0x14, 0x2a, 0x0a, 0x46, 0x0c, 
+0x30, 0x0b, 0x8d, 0x16, 0xf5, 0x09, 0x83, 0x00, 0x6f, 0x12, 0x7f, 0x07, 
+0xbd, 0x00, 0x12, 0x01, 0x9b, 0x00, 0xd1, 0x09, 0x3e, 0x1b, 0xeb, 0x00, 
+0x4f, 0x00, 0x03, 0x01, 0xb2, 0x07, 0xa0, 0x08, 0xc5, 0x0a, 0xd7, 0x07, 
+0x88, 0x00, 0x40, 0x01, 0x78, 0x10, 0x1f, 0x01, 0x43, 0x0c, 0xe4, 0x0d, 
+0x96, 0x0a, 0x2a, 0x01, 0x0d, 0x00, 0x73, 0x0b, 0xae, 0x0a, 0xb4, 0x1b, 
+0x24, 0x00, 0x40, 0x06, 0xd1, 0x00, 0x38, 0x08, 0xd9, 0x17, 0x38, 0x0c, 
+0x5f, 0x0a, 0x34, 0x1a, 0xba, 0x14, 0xca, 0x00, 0x8e, 0x0a, 0x9a, 0x06, 
+0x5b, 0x0c, 0xf7, 0x00, 0x61, 0x07, 0x6b, 0x0b, 0x4f, 0x00, 0x3d, 0x00, 
+0xc7, 0x00, 0xed, 0x00, 0x1f, 0x0a, 0xe4, 0x00, 0xa0, 0x0b, 0x35, 0x09, 
+0xb3, 0x00, 0xc2, 0x00, 0xc2, 0x0a, 0x19, 0x01, 0x43, 0x12, 0xa8, 0x00, 
+0xe5, 0x0a, 0x73, 0x15, 0x29, 0x0a, 0x77, 0x00, 0xcd, 0x0a, 0x2d, 0x09, 
+0x0e, 0x01, 0x30, 0x15, 0xc8, 0x0c, 0xe7, 0x09, 0xb1, 0x00, 0x1f, 0x01, 
+0xb3, 0x17, 0x71, 0x00, 0xc4, 0x00, 0x11, 0x0b, 0xc9, 0x00, 0xbb, 0x14, 
+0x25, 0x0b, 0xd0, 0x0a, 0xf8, 0x0b, 0x07, 0x0a, 0x13, 0x01, 0x5b, 0x0c, 
+0x78, 0x09, 0xee, 0x0a, 0xde, 0x0c, 0xe7, 0x09, 0x7b, 0x0c, 0x20, 0x01, 
+0x2a, 0x0a, 0x11, 0x07, 0x73, 0x00, 0x12, 0x01, 0xf4, 0x00, 0x6b, 0x00, 
+0xbd, 0x00, 0xf3, 0x00, 0x8f, 0x0a, 0x69, 0x1c, 0xe7, 0x09, 0xf1, 0x14, 
+0x06, 0x01, 0x17, 0x17, 0xe3, 0x00, 0xae, 0x11, 0x39, 0x13, 0x88, 0x0b, 
+0x0b, 0x00, 0xf4, 0x07, 0xd9, 0x17, 0x7a, 0x00, 0x49, 0x0b, 0xfc, 0x00, 
+0xca, 0x0e, 0xa7, 0x09, 0xc0, 0x0e, 0x8a, 0x13, 0x1a, 0x13, 0xfa, 0x0a, 
+0xf6, 0x0c, 0x37, 0x10, 0xe9, 0x13, 0x34, 0x0a, 0x4e, 0x17, 0xf6, 0x00, 
+0x7a, 0x0c, 0x5d, 0x0b, 0xeb, 0x0c, 0x3b, 0x01, 0x13, 0x00, 0xda, 0x06, 
+0x93, 0x0d, 0x02, 0x0c, 0x3d, 0x07, 0x33, 0x01, 0x1e, 0x01, 0xd2, 0x00, 
+0x1e, 0x0b, 0x00, 0x0a, 0x47, 0x00, 0xa9, 0x08, 0xee, 0x00, 0xf0, 0x0a, 
+0xf9, 0x0b, 0x15, 0x14, 0x81, 0x0a, 0x04, 0x0a, 0x8a, 0x00, 0xe5, 0x00, 
+0xe3, 0x0a, 0x8c, 0x06, 0xae, 0x00, 0xfe, 0x00, 0x47, 0x00, 0xa1, 0x0b, 
+0xfe, 0x09, 0x20, 0x01, 0x25, 0x01, 0x02, 0x01, 0x6a, 0x0d, 0xdb, 0x0b, 
+0x6b, 0x0a, 0x91, 0x0c, 0xa2, 0x06, 0x6a, 0x0a, 0xac, 0x00, 0x78, 0x09, 
+0x6f, 0x0e, 0x8b, 0x0a, 0xb4, 0x0b, 0x2f, 0x09, 0xaa, 0x0b, 0x2f, 0x09, 
+0xeb, 0x08, 0xf4, 0x0c, 0xef, 0x07, 0x47, 0x0b, 0xd3, 0x09, 0x3e, 0x0b, 
+0xb3, 0x00, 0x4e, 0x19, 0x32, 0x0b, 0xdb, 0x07, 0x43, 0x0a, 0xfa, 0x09, 
+0xdc, 0x00, 0x62, 0x00, 0xe3, 0x0b, 0x52, 0x0c, 0x77, 0x12, 0x1f, 0x13, 
+0x86, 0x0a, 0xf0, 0x00, 0x05, 0x09, 0xed, 0x00, 0xaf, 0x09, 0xd5, 0x12, 
+0xd2, 0x00, 0xb0, 0x00, 0x2a, 0x00, 0xaf, 0x09, 0xf5, 0x08, 0x11, 0x01, 
+0xf7, 0x0b, 0x86, 0x00, 0x8c, 0x06, 0x7c, 0x12, 0xae, 0x00, 0x50, 0x17, 
+0x79, 0x07, 0xa9, 0x00, 0xae, 0x00, 0x40, 0x06, 0xf4, 0x00, 0xb2, 0x07, 
+0xd8, 0x09, 0x4f, 0x09, 0xa5, 0x0a, 0x2d, 0x0c, 0x12, 0x01, 0xa9, 0x0c, 
+0x8d, 0x0b, 0xdc, 0x09, 0xc5, 0x00, 0xda, 0x00, 0x24, 0x09, 0x1d, 0x01, 
+0xa9, 0x0d, 0xf3, 0x09, 0x51, 0x17, 0x12, 0x07, 0x8a, 0x00, 0x26, 0x12, 
+0x4e, 0x0a, 0xfc, 0x13, 0x08, 0x18, 0x04, 0x09, 0x8f, 0x00, 0x14, 0x01, 
+0x85, 0x00, 0xc6, 0x09, 0x9b, 0x00, 0x73, 0x0b, 0xe0, 0x00, 0x77, 0x0a, 
+0x17, 0x0c, 0xf8, 0x0b, 0x44, 0x0a, 0x5e, 0x07, 0xfc, 0x09, 0x24, 0x0c, 
+0xd2, 0x0a, 0x78, 0x10, 0x1b, 0x0b, 0x76, 0x0a, 0xa7, 0x08, 0xd0, 0x06, 
+0x17, 0x01, 0x25, 0x09, 0x3e, 0x0e, 0x60, 0x0a, 0xa0, 0x15, 0xde, 0x09, 
+0x9f, 0x0b, 0x26, 0x01, 0x40, 0x06, 0xd2, 0x00, 0xe9, 0x18, 0x0d, 0x0c, 
+0x09, 0x0a, 0x15, 0x0e, 0x3f, 0x07, 0xe3, 0x14, 0x53, 0x00, 0xad, 0x00, 
+0xea, 0x00, 0xea, 0x0c, 0x2f, 0x0c, 0x23, 0x0f, 0xce, 0x00, 0x0c, 0x0c, 
+0x45, 0x0c, 0x9c, 0x08, 0x42, 0x06, 0x09, 0x0a, 0xe6, 0x09, 0xc2, 0x0a, 
+0x62, 0x11, 0xdf, 0x13, 0xf2, 0x13, 0x4b, 0x10, 0x53, 0x07, 0x90, 0x0c, 
+0x91, 0x13, 0x4a, 0x13, 0xad, 0x0c, 0xe0, 0x08, 0x39, 0x12, 0xb2, 0x09, 
+0x30, 0x00, 0xa2, 0x0c, 0xe2, 0x13, 0xd2, 0x0f, 0xb3, 0x00, 0x12, 0x01, 
+0xd7, 0x0b, 0x90, 0x1b, 0xcd, 0x0b, 0xfe, 0x08, 0xfb, 0x00, 0x2a, 0x01, 
+0x53, 0x00, 0xdc, 0x09, 0xe1, 0x0b, 0x21, 0x01, 0x3c, 0x0b, 0x7d, 0x0b, 
+0x50, 0x0f, 0x1b, 0x0b, 0x2c, 0x01, 0x31, 0x00, 0x32, 0x01, 0x53, 0x0b, 
+0xa5, 0x0f, 0x8b, 0x11, 0x24, 0x08, 0xe1, 0x0c, 0xf9, 0x00, 0xb0, 0x00, 
+0x5f, 0x00, 0xc5, 0x00, 0x3b, 0x0c, 0x8c, 0x00, 0x84, 0x0a, 0x67, 0x07, 
+0xa2, 0x07, 0xfc, 0x00, 0x09, 0x01, 0xb0, 0x12, 0x20, 0x01, 0x31, 0x0c, 
+0x27, 0x08, 0xa6, 0x19, 0x9b, 0x00, 0x88, 0x0c, 0xad, 0x07, 0xe2, 0x00, 
+0x4c, 0x00, 0x55, 0x0c, 0x26, 0x0c, 0xfb, 0x06, 0x42, 0x15, 0x1f, 0x0e, 
+0x14, 0x0a, 0x6b, 0x0a, 0x05, 0x13, 0x6f, 0x0c, 0x06, 0x0f, 0x4a, 0x0a, 
+0x0b, 0x00, 0x43, 0x14, 0xe3, 0x0a, 0x0a, 0x0b, 0x2d, 0x09, 0xd4, 0x00, 
+0x3f, 0x0a, 0xbd, 0x17, 0x2b, 0x09, 0x18, 0x00, 0x6c, 0x00, 0x19, 0x08, 
+0x03, 0x13, 0xa7, 0x00, 0x82, 0x1b, 0x1e, 0x16, 0x48, 0x06, 0x78, 0x07, 
+0xce, 0x00, 0xe3, 0x06, 0xb1, 0x08, 0x3e, 0x07, 0xd2, 0x06, 0x91, 0x00, 
+0xfe, 0x00, 0xb3, 0x0a, 0xb2, 0x00, 0xce, 0x00, 0x92, 0x11, 0x18, 0x01, 
+0x85, 0x17, 0x65, 0x08, 0xec, 0x0b, 0x3c, 0x10, 0x49, 0x07, 0x8c, 0x0a, 
+0x82, 0x11, 0xce, 0x06, 0x85, 0x0a, 0x44, 0x06, 0xa3, 0x0d, 0xb2, 0x15, 
+0x8f, 0x17, 0x18, 0x14, 0x3f, 0x01, 0x4b, 0x0a, 0x68, 0x0c, 0x94, 0x07, 
+0x75, 0x12, 0x18, 0x08, 0xdf, 0x0a, 0x05, 0x10, 0xa1, 0x15, 0x2b, 0x01, 
+0xf0, 0x0a, 0xeb, 0x0b, 0xf5, 0x00, 0xe1, 0x0b, 0x47, 0x00, 0xd8, 0x00, 
+0x8c, 0x06, 0x54, 0x00, 0x7b, 0x0c, 0xf2, 0x14, 0x3d, 0x01, 0x5a, 0x0f, 
+0x9e, 0x0b, 0xb0, 0x00, 0x96, 0x18, 0xce, 0x14, 0x44, 0x13, 0x23, 0x01, 
+0x33, 0x12, 0x46, 0x0c, 0xd7, 0x00, 0x72, 0x09, 0xc8, 0x00, 0xef, 0x0c, 
+0x78, 0x00, 0xa1, 0x0a, 0x6b, 0x00, 0x62, 0x0b, 0xcd, 0x0a, 0xf4, 0x14, 
+0x56, 0x18, 0x1b, 0x10, 0xc3, 0x00, 0xa2, 0x0d, 0x0b, 0x01, 0x77, 0x00, 
+0x1d, 0x11, 0x42, 0x1a, 0x89, 0x0b, 0xc4, 0x0a, 0xb6, 0x00, 0x58, 0x17, 
+0x04, 0x0b, 0x78, 0x0a, 0x2d, 0x09, 0x0c, 0x18, 0x26, 0x0b, 0xa3, 0x07, 
+0xf2, 0x13, 0x08, 0x0d, 0xc7, 0x09, 0x44, 0x13, 0xa6, 0x0c, 0xfe, 0x0e, 
+0xe0, 0x16, 0x13, 0x0a, 0x30, 0x0b, 0xa1, 0x06, 0xb0, 0x0a, 0x44, 0x14, 
+0x0b, 0x01, 0xdf, 0x16, 0xdd, 0x0b, 0xed, 0x0e, 0xcd, 0x14, 0xbf, 0x00, 
+0x2b, 0x0a, 0x9b, 0x0c, 0x1b, 0x08, 0x9e, 0x0c, 0x59, 0x0c, 0x44, 0x06, 
+0x38, 0x01, 0x1f, 0x00, 0xa9, 0x07, 0x26, 0x16, 0x74, 0x00, 0x3f, 0x0e, 
+0xef, 0x0a, 0xc3, 0x0e, 0xe8, 0x13, 0xaf, 0x09, 0x04, 0x01, 0x04, 0x01, 
+0x28, 0x0b, 0xf7, 0x00, 0x69, 0x08, 0x28, 0x08, 0x0c, 0x01, 0x2f, 0x0c, 
+0x72, 0x16, 0x88, 0x17, 0x0c, 0x0a, 0x2b, 0x09, 0x1a, 0x01, 0x4b, 0x0b, 
+0xf7, 0x0b, 0xf8, 0x00, 0x1d, 0x0b, 0xbf, 0x1a, 0xa1, 0x06, 0xb1, 0x0c, 
+0x16, 0x14, 0xc8, 0x00, 0x0c, 0x00, 0x13, 0x01, 0xc2, 0x00, 0x9c, 0x08, 
+0x82, 0x18, 0x5a, 0x18, 0xd4, 0x0f, 0xc3, 0x00, 0x2b, 0x0b, 0x9d, 0x0f, 
+0x26, 0x16, 0xb8, 0x00, 0x8b, 0x0a, 0xd6, 0x09, 0x31, 0x01, 0x94, 0x0c, 
+0xc0, 0x00, 0x2f, 0x08, 0x6a, 0x06, 0x39, 0x13, 0xe8, 0x00, 0x15, 0x0e, 
+0x95, 0x0c, 0xb3, 0x07, 0x65, 0x09, 0xc0, 0x0b, 0xc4, 0x00, 0xb6, 0x0e, 
+0xf1, 0x13, 0xec, 0x0c, 0x42, 0x13, 0xd9, 0x09, 0x94, 0x0c, 0xe1, 0x0c, 
+0xa4, 0x0a, 0xe0, 0x0c, 0xab, 0x0b, 0x13, 0x00, 0xeb, 0x0c, 0x0c, 0x00, 
+0xde, 0x0c, 0x79, 0x00, 0x83, 0x00, 0x5b, 0x0c, 0xa4, 0x09, 0xee, 0x0b, 
+0xf9, 0x0a, 0xa7, 0x00, 0x6e, 0x14, 0xfa, 0x14, 0x36, 0x07, 0x74, 0x07, 
+0xff, 0x00, 0xfa, 0x00, 0xb9, 0x0e, 0x11, 0x01, 0x1e, 0x01, 0xee, 0x0a, 
+0xde, 0x00, 0x6b, 0x0d, 0xd4, 0x00, 0x82, 0x0a, 0xc8, 0x0c, 0xb5, 0x0b, 
+0x55, 0x06, 0x71, 0x0c, 0xac, 0x00, 0x9b, 0x00, 0x19, 0x01, 0x33, 0x0a, 
+0xbc, 0x00, 0x11, 0x01, 0x1d, 0x13, 0x49, 0x06, 0x6c, 0x00, 0x65, 0x0a, 
+0x02, 0x13, 0x41, 0x0a, 0x3c, 0x0b, 0xdc, 0x00, 0x3a, 0x01, 0x2c, 0x00, 
+0xd6, 0x0b, 0xd7, 0x00, 0x71, 0x0c, 0x79, 0x00, 0x1b, 0x0c, 0x8f, 0x09, 
+0xe4, 0x00, 0x2f, 0x08, 0x10, 0x07, 0xa7, 0x09, 0x20, 0x01, 0x9e, 0x0f, 
+0x71, 0x00, 0xfb, 0x0a, 0x2d, 0x0a, 0xe4, 0x0b, 0xca, 0x0b, 0x4d, 0x14, 
+0x7b, 0x09, 0xe8, 0x13, 0x27, 0x0c, 0xe1, 0x0b, 0xaf, 0x00, 0xe9, 0x0a, 
+0xeb, 0x07, 0xf9, 0x08, 0x04, 0x01, 0x89, 0x00, 0xee, 0x0d, 0x65, 0x0c, 
+0x6a, 0x0e, 0xf2, 0x14, 0x3b, 0x01, 0xa2, 0x07, 0x3b, 0x09, 0x98, 0x06, 
+0xb7, 0x0b, 0xd3, 0x00, 0x42, 0x00, 0x39, 0x01, 0x6b, 0x0a, 0xf3, 0x0c, 
+0x09, 0x01, 0xd2, 0x11, 0x7c, 0x13, 0xdd, 0x0c, 0xf4, 0x0a, 0x33, 0x0a, 
+0xce, 0x00, 0x21, 0x0a, 0x40, 0x00, 0x0b, 0x0b, 0xdd, 0x06, 0x9c, 0x08, 
+0x97, 0x0a, 0xf4, 0x13, 0xf9, 0x0e, 0x6a, 0x08, 0x5f, 0x08, 0xf9, 0x00, 
+0x49, 0x09, 0x04, 0x11, 0xa2, 0x00, 0x4f, 0x0a, 0x78, 0x08, 0xd4, 0x0c, 
+0x55, 0x0b, 0xf1, 0x00, 0x50, 0x0c, 0x57, 0x06, 0x8b, 0x12, 0x47, 0x00, 
+0xca, 0x00, 0x96, 0x0f, 0x35, 0x0c, 0xc6, 0x0a, 0xfa, 0x16, 0xe8, 0x0c, 
+0x52, 0x17, 0x86, 0x17, 0xba, 0x09, 0x60, 0x00, 0x13, 0x0e, 0x9e, 0x08, 
+0xfa, 0x13, 0xbc, 0x09, 0x8c, 0x0b, 0x76, 0x13, 0x4e, 0x0a, 0x0e, 0x0c, 
+0x05, 0x01, 0x23, 0x01, 0xed, 0x0d, 0x09, 0x0d, 0xf8, 0x00, 0x8d, 0x06, 
+0x3a, 0x01, 0x20, 0x0a, 0xd6, 0x07, 0x42, 0x01, 0x00, 0x01, 0xf7, 0x0e, 
+0xfc, 0x00, 0xab, 0x16, 0xe2, 0x08, 0xdf, 0x00, 0x72, 0x10, 0xa6, 0x0f, 
+0x46, 0x0b, 0xd1, 0x0b, 0x75, 0x00, 0xfe, 0x08, 0x41, 0x09, 0x70, 0x0c, 
+0xe8, 0x18, 0x1f, 0x0a, 0x2f, 0x00, 0x93, 0x0b, 0xcd, 0x09, 0xa0, 0x08, 
+0xdc, 0x0a, 0xb4, 0x06, 0xed, 0x00, 0xf9, 0x00, 0x51, 0x0a, 0x89, 0x0b, 
+0x6b, 0x17, 0xcf, 0x14, 0xc8, 0x09, 0xd9, 0x00, 0x8e, 0x0a, 0x0c, 0x01, 
+0xd3, 0x00, 0xdc, 0x10, 0xd6, 0x00, 0xd4, 0x09, 0x16, 0x01, 0x68, 0x09, 
+0xda, 0x10, 0x75, 0x0a, 0x53, 0x0d, 0x95, 0x09, 0xf3, 0x00, 0x9e, 0x0f, 
+0x6f, 0x0e, 0x26, 0x07, 0x7a, 0x00, 0x40, 0x06, 0xf1, 0x13, 0xd9, 0x07, 
+0x96, 0x0a, 0x5d, 0x12, 0xe9, 0x13, 0xbd, 0x00, 0xf0, 0x0d, 0x19, 0x0c, 
+0xe2, 0x00, 0xad, 0x0d, 0x38, 0x01, 0x42, 0x14, 0x18, 0x01, 0x77, 0x09, 
+0x6a, 0x0b, 0x77, 0x00, 0xcf, 0x06, 0xec, 0x0c, 0xc5, 0x0a, 0xfd, 0x0a, 
+0x04, 0x0c, 0x4a, 0x00, 0x4e, 0x0c, 0x36, 0x01, 0xd6, 0x00, 0x16, 0x14, 
+0x36, 0x07, 0xf3, 0x1c, 0x68, 0x12, 0xbb, 0x18, 0x3c, 0x01, 0xa0, 0x15, 
+0xdf, 0x13, 0x31, 0x12, 0x39, 0x12, 0x43, 0x0c, 0x54, 0x0b, 0x48, 0x00, 
+0x0a, 0x01, 0xd9, 0x0a, 0x1c, 0x07, 0x69, 0x06, 0x0f, 0x00, 0xc6, 0x0a, 
+0x17, 0x1a, 0xec, 0x06, 0x44, 0x07, 0xad, 0x08, 0x21, 0x01, 0x11, 0x01, 
+0xbb, 0x13, 0xb9, 0x00, 0x53, 0x09, 0x82, 0x14, 0xe2, 0x0b, 0x28, 0x0b, 
+0x85, 0x0a, 0xa6, 0x0c, 0xe1, 0x00, 0xf6, 0x00, 0x70, 0x0c, 0x0c, 0x0c, 
+0x97, 0x15, 0x1a, 0x00, 0x9c, 0x00, 0x18, 0x0c, 0xb9, 0x0a, 0xb1, 0x0c, 
+0x72, 0x16, 0x07, 0x17, 0xbc, 0x0d, 0xa8, 0x09, 0x48, 0x06, 0x21, 0x01, 
+0xe7, 0x06, 0xe3, 0x13, 0x97, 0x15, 0x83, 0x00, 0xc4, 0x14, 0x6b, 0x00, 
+0x69, 0x06, 0x44, 0x06, 0xdf, 0x00, 0x56, 0x11, 0x19, 0x01, 0x19, 0x00, 
+0x4a, 0x13, 0xcf, 0x00, 0xeb, 0x08, 0x70, 0x0c, 0x9c, 0x00, 0x24, 0x19, 
+0xa4, 0x09, 0x8d, 0x0b, 0xd6, 0x00, 0xf9, 0x00, 0x72, 0x0b, 0x10, 0x00, 
+0x08, 0x12, 0xd3, 0x0c, 0xee, 0x09, 0xee, 0x00, 0xff, 0x0e, 0x1b, 0x10, 
+0x5e, 0x11, 0xbe, 0x09, 0x0d, 0x01, 0xf5, 0x00, 0xc3, 0x0a, 0x7d, 0x16, 
+0x67, 0x00, 0x01, 0x01, 0x1b, 0x01, 0x88, 0x14, 0x4c, 0x0a, 0xda, 0x00, 
+0xea, 0x00, 0xfc, 0x0b, 0x12, 0x01, 0x6b, 0x07, 0x9c, 0x00, 0x7c, 0x1b, 
+0x42, 0x18, 0x21, 0x1a, 0xf6, 0x0b, 0xa7, 0x00, 0x53, 0x07, 0x5d, 0x12, 
+0x41, 0x0a, 0x34, 0x00, 0x18, 0x0c, 0xb4, 0x0c, 0x9d, 0x08, 0x24, 0x00, 
+0xc7, 0x00, 0x79, 0x09, 0x11, 0x01, 0xb8, 0x00, 0xab, 0x06, 0xab, 0x08, 
+0x8f, 0x0a, 0xde, 0x13, 0x4e, 0x12, 0x3f, 0x0d, 0x07, 0x0e, 0x23, 0x00, 
+0x8b, 0x0a, 0xe8, 0x0c, 0xc4, 0x00, 0x43, 0x13, 0xad, 0x0f, 0xc6, 0x00, 
+0xb8, 0x00, 0x28, 0x01, 0xe5, 0x0b, 0x04, 0x01, 0x5e, 0x08, 0xef, 0x00, 
+0x53, 0x0b, 0xb7, 0x0a, 0x1c, 0x01, 0x5f, 0x06, 0x94, 0x11, 0x71, 0x0c, 
+0x88, 0x0b, 0x23, 0x00, 0x41, 0x01, 0x72, 0x08, 0xc4, 0x00, 0x07, 0x0a, 
+0x7f, 0x0b, 0x39, 0x01, 0x8e, 0x06, 0x79, 0x00, 0xf7, 0x0b, 0x01, 0x01, 
+0x39, 0x01, 0x0f, 0x0c, 0x07, 0x01, 0xde, 0x0d, 0x55, 0x0a, 0x5f, 0x06, 
+0x95, 0x0b, 0xd4, 0x11, 0xbd, 0x0c, 0xbf, 0x14, 0x64, 0x0c, 0xfe, 0x0a, 
+0x66, 0x0d, 0x6a, 0x0b, 0x24, 0x08, 0xad, 0x13, 0xbe, 0x09, 0x64, 0x0e, 
+0x65, 0x09, 0x6f, 0x08, 0x6a, 0x0a, 0x41, 0x00, 0x5c, 0x08, 0x41, 0x00, 
+0x83, 0x0b, 0x77, 0x00, 0x61, 0x0a, 0x7b, 0x0b, 0x29, 0x01, 0x79, 0x08, 
+0xf1, 0x00, 0x42, 0x0a, 0x9a, 0x06, 0x71, 0x00, 0x2e, 0x08, 0x38, 0x01, 
+0x0c, 0x00, 0x35, 0x01, 0xbc, 0x00, 0xc3, 0x00, 0xd6, 0x00, 0xd5, 0x00, 
+0xde, 0x09, 0xfb, 0x15, 0xf8, 0x00, 0xca, 0x0b, 0xdd, 0x0d, 0x4d, 0x00, 
+0xa6, 0x0c, 0x91, 0x10, 0xe5, 0x00, 0x27, 0x07, 0xba, 0x00, 0xec, 0x07, 
+0x77, 0x00, 0xb6, 0x00, 0xf2, 0x13, 0x91, 0x06, 0xb3, 0x15, 0x29, 0x12, 
+0xed, 0x0d, 0xa2, 0x15, 0x27, 0x12, 0xe6, 0x00, 0x06, 0x0b, 0x4f, 0x0d, 
+0x9b, 0x00, 0x8c, 0x06, 0x3c, 0x00, 0x36, 0x01, 0xac, 0x0a, 0xa0, 0x08, 
+0x61, 0x00, 0x26, 0x01, 0x17, 0x14, 0x39, 0x01, 0x44, 0x0c, 0x06, 0x01, 
+0x8e, 0x17, 0xb2, 0x00, 0xaa, 0x07, 0xea, 0x09, 0x1f, 0x0a, 0x57, 0x14, 
+0xda, 0x0f, 0x49, 0x0a, 0x85, 0x00, 0x59, 0x07, 0x4b, 0x0a, 0xe1, 0x08, 
+0xfe, 0x00, 0xbf, 0x09, 0xa9, 0x00, 0xc4, 0x00, 0xcc, 0x0b, 0x71, 0x09, 
+0x5e, 0x12, 0x38, 0x0c, 0xd6, 0x0b, 0x37, 0x0a, 0xde, 0x00, 0xd7, 0x14, 
+0xae, 0x00, 0x88, 0x18, 0x8a, 0x0b, 0x61, 0x0a, 0xa8, 0x0c, 0xa1, 0x0a, 
+0x37, 0x01, 0x59, 0x0a, 0xd8, 0x16, 0xc2, 0x0a, 0x54, 0x00, 0xf5, 0x00, 
+0x1a, 0x01, 0x5f, 0x0a, 0xa9, 0x0b, 0xe1, 0x07, 0xf4, 0x06, 0xa6, 0x09, 
+0x86, 0x0c, 0x31, 0x01, 0xf0, 0x0d, 0x9b, 0x00, 0x25, 0x00, 0x24, 0x00, 
+0x3d, 0x01, 0xc9, 0x0f, 0x23, 0x0f, 0x91, 0x0c, 0x1b, 0x0b, 0xe2, 0x08, 
+0x23, 0x0c, 0x36, 0x00, 0xa2, 0x0f, 0xa1, 0x00, 0x35, 0x00, 0xcc, 0x0c, 
+0x0d, 0x00, 0x81, 0x10, 0xb9, 0x0e, 0xee, 0x06, 0xb1, 0x0c, 0x46, 0x06, 
+0x8b, 0x0a, 0x75, 0x0a, 0xa6, 0x13, 0x61, 0x07, 0xf9, 0x00, 0x74, 0x0b, 
+0xe9, 0x0c, 0xda, 0x0b, 0xd6, 0x16, 0xd1, 0x14, 0xad, 0x16, 0x1c, 0x00, 
+0x8f, 0x00, 0xb3, 0x08, 0x1a, 0x01, 0x70, 0x10, 0x8c, 0x0c, 0xf1, 0x14, 
+0x5d, 0x08, 0x8c, 0x00, 0x18, 0x00, 0xe1, 0x08, 0x25, 0x1a, 0x1c, 0x0f, 
+0x30, 0x09, 0xb7, 0x0b, 0x1d, 0x0a, 0xae, 0x10, 0xb6, 0x00, 0x66, 0x1b, 
+0xea, 0x0a, 0xea, 0x09, 0x04, 0x0b, 0x66, 0x00, 0x30, 0x01, 0xd4, 0x00, 
+0x0e, 0x01, 0x33, 0x0a, 0xf2, 0x0a, 0x9a, 0x12, 0xd7, 0x0b, 0x72, 0x0b, 
+0xe6, 0x0b, 0xa9, 0x0b, 0x3f, 0x13, 0x1a, 0x01, 0x82, 0x0b, 0xc7, 0x0c, 
+0xa7, 0x10, 0x7d, 0x0b, 0x44, 0x0a, 0x7d, 0x0b, 0xb0, 0x00, 0x71, 0x0c, 
+0x49, 0x0c, 0x1a, 0x0c, 0x2a, 0x0a, 0x3f, 0x00, 0x4e, 0x17, 0xf2, 0x00, 
+0xac, 0x0a, 0xab, 0x0c, 0x65, 0x00, 0x38, 0x0c, 0x36, 0x01, 0xda, 0x00, 
+0xf6, 0x00, 0xbd, 0x10, 0x54, 0x0a, 0xca, 0x0b, 0x79, 0x00, 0x31, 0x01, 
+0xfc, 0x09, 0x3d, 0x01, 0x77, 0x16, 0xaa, 0x00, 0x38, 0x01, 0xba, 0x16, 
+0xda, 0x06, 0x94, 0x0b, 0x61, 0x00, 0xa5, 0x0b, 0x1b, 0x10, 0xcd, 0x14, 
+0x31, 0x01, 0x2a, 0x01, 0xa5, 0x0a, 0x14, 0x0b, 0xcc, 0x00, 0x0b, 0x00, 
+0x8f, 0x1a, 0xb7, 0x0c, 0x6f, 0x10, 0x1b, 0x00, 0x7f, 0x0b, 0x17, 0x0c, 
+0xb6, 0x10, 0xda, 0x0e, 0x2b, 0x18, 0x95, 0x07, 0x23, 0x00, 0xfa, 0x0b, 
+0x2d, 0x01, 0x11, 0x0c, 0x51, 0x14, 0xb2, 0x07, 0x5d, 0x0b, 0x7f, 0x16, 
+0xa1, 0x0c, 0xa1, 0x15, 0xa7, 0x00, 0x9f, 0x0b, 0x70, 0x09, 0xe1, 0x16, 
+0xb8, 0x00, 0xd4, 0x00, 0x32, 0x18, 0xf3, 0x08, 0x8c, 0x06, 0x2b, 0x18, 
+0xdf, 0x13, 0x1e, 0x14, 0x3f, 0x01, 0x22, 0x0c, 0x09, 0x0d, 0x24, 0x0c, 
+0xbb, 0x00, 0x1d, 0x01, 0x86, 0x00, 0x97, 0x10, 0x67, 0x12, 0xfb, 0x0b, 
+0x31, 0x01, 0x2b, 0x0d, 0xc8, 0x09, 0x86, 0x17, 0x6c, 0x00, 0xfe, 0x00, 
+0xb4, 0x06, 0x33, 0x01, 0x3e, 0x08, 0x47, 0x0b, 0x87, 0x0d, 0xcc, 0x00, 
+0xc0, 0x0e, 0x2d, 0x0c, 0x92, 0x00, 0x49, 0x12, 0x9d, 0x00, 0xe0, 0x00, 
+0xa4, 0x07, 0x69, 0x06, 0x1c, 0x00, 0xaf, 0x09, 0xad, 0x00, 0x3c, 0x01, 
+0xb0, 0x09, 0x3e, 0x09, 0x9f, 0x07, 0x14, 0x15, 0xe2, 0x07, 0x3b, 0x00, 
+0xcc, 0x0f, 0x40, 0x00, 0xdb, 0x0f, 0x7c, 0x12, 0x7a, 0x0c, 0x36, 0x0a, 
+0xdd, 0x0b, 0xf2, 0x09, 0xb7, 0x00, 0xb3, 0x09, 0x47, 0x1a, 0x6f, 0x10, 
+0x2b, 0x01, 0xbf, 0x0c, 0x6f, 0x08, 0x6e, 0x10, 0x0b, 0x01, 0x75, 0x08, 
+0xe8, 0x00, 0x45, 0x06, 0xc7, 0x00, 0x8b, 0x0a, 0x6c, 0x0a, 0x9d, 0x00, 
+0x99, 0x0a, 0x49, 0x15, 0x54, 0x00, 0xdb, 0x09, 0xcc, 0x00, 0xde, 0x15, 
+0xdc, 0x07, 0xef, 0x0d, 0x61, 0x06, 0xaa, 0x0a, 0xe0, 0x00, 0xf7, 0x0f, 
+0x35, 0x0a, 0x23, 0x09, 0xc9, 0x00, 0x6e, 0x00, 0x1f, 0x0c, 0xdd, 0x0a, 
+0x18, 0x08, 0xe5, 0x00, 0x70, 0x07, 0x2d, 0x0c, 0x34, 0x15, 0xa1, 0x0a, 
+0x5f, 0x08, 0x93, 0x00, 0x08, 0x01, 0x71, 0x00, 0xe5, 0x0a, 0xd9, 0x15, 
+0xc4, 0x00, 0x11, 0x01, 0x8a, 0x11, 0x53, 0x0d, 0x6b, 0x00, 0xd8, 0x16, 
+0xc1, 0x0e, 0x4f, 0x11, 0x03, 0x01, 0xa6, 0x0c, 0x92, 0x12, 0x4f, 0x17, 
+0x41, 0x06, 0xd0, 0x0a, 0x5b, 0x00, 0x75, 0x13, 0x2b, 0x0a, 0x78, 0x00, 
+0x0f, 0x13, 0xd2, 0x0c, 0xc5, 0x08, 0x3c, 0x01, 0x35, 0x01, 0xef, 0x0b, 
+0x21, 0x00, 0xe2, 0x00, 0xa7, 0x00, 0x54, 0x07, 0x6d, 0x09, 0x16, 0x01, 
+0x88, 0x08, 0x70, 0x08, 0x1b, 0x0b, 0x4c, 0x00, 0x61, 0x1a, 0x4e, 0x0d, 
+0x39, 0x0d, 0x96, 0x18, 0x80, 0x08, 0x58, 0x00, 0x3e, 0x00, 0x0e, 0x01, 
+0x15, 0x00, 0xb6, 0x00, 0x24, 0x00, 0xdc, 0x14, 0x40, 0x00, 0x4b, 0x14, 
+0x03, 0x01, 0x26, 0x0b, 0xc9, 0x0c, 0xab, 0x0b, 0x5d, 0x07, 0xaa, 0x06, 
+0xd0, 0x00, 0xd7, 0x15, 0x8e, 0x0a, 0xc4, 0x00, 0xdb, 0x09, 0xf4, 0x14, 
+0xca, 0x00, 0xb1, 0x15, 0x44, 0x0c, 0x3e, 0x0b, 0x38, 0x09, 0xb8, 0x00, 
+0xfd, 0x00, 0x05, 0x01, 0x79, 0x00, 0x25, 0x01, 0x02, 0x16, 0xef, 0x06, 
+0x17, 0x01, 0x7a, 0x00, 0xf2, 0x00, 0xc9, 0x0c, 0x01, 0x01, 0x1c, 0x0b, 
+0x66, 0x11, 0xf2, 0x00, 0xf0, 0x0a, 0x94, 0x0d, 0xb6, 0x10, 0xfd, 0x08, 
+0x5f, 0x0a, 0xac, 0x00, 0x94, 0x0b, 0x4b, 0x0a, 0x37, 0x01, 0x67, 0x12, 
+0xc5, 0x14, 0x53, 0x00, 0x62, 0x0a, 0xbf, 0x0c, 0x47, 0x1a, 0x4a, 0x0a, 
+0x29, 0x01, 0xd6, 0x0b, 0xd8, 0x0b, 0xcd, 0x0a, 0x1e, 0x14, 0x75, 0x0c, 
+0xaa, 0x16, 0x25, 0x07, 0xf5, 0x00, 0x85, 0x0c, 0x3b, 0x00, 0x4a, 0x11, 
+0x7e, 0x00, 0x34, 0x00, 0x2d, 0x0c, 0xfa, 0x15, 0x26, 0x14, 0xbf, 0x0b, 
+0x25, 0x01, 0xce, 0x0b, 0xab, 0x18, 0x39, 0x01, 0x96, 0x0a, 0xfe, 0x08, 
+0xeb, 0x13, 0x2c, 0x09, 0x12, 0x0a, 0x59, 0x06, 0x0e, 0x00, 0x3e, 0x0e, 
+0x86, 0x0a, 0xde, 0x00, 0xdd, 0x0f, 0xc6, 0x09, 0xd8, 0x00, 0xa8, 0x00, 
+0xcd, 0x15, 0xcb, 0x0b, 0x3d, 0x01, 0x5b, 0x08, 0x60, 0x12, 0x57, 0x14, 
+0x41, 0x06, 0x58, 0x00, 0x40, 0x01, 0xb7, 0x0e, 0x2f, 0x01, 0xe0, 0x0b, 
+0xea, 0x00, 0x45, 0x06, 0x3f, 0x00, 0xc3, 0x0b, 0xb2, 0x0c, 0x59, 0x17, 
+0x11, 0x00, 0x61, 0x06, 0xde, 0x17, 0x5a, 0x08, 0xeb, 0x00, 0x21, 0x09, 
+0x39, 0x01, 0xe0, 0x12, 0x64, 0x00, 0x8b, 0x0a, 0x0d, 0x09, 0xf4, 0x00, 
+0x3f, 0x00, 0x1b, 0x01, 0xf7, 0x0f, 0xb2, 0x09, 0xee, 0x0e, 0x40, 0x06, 
+0x82, 0x10, 0x31, 0x00, 0x66, 0x13, 0x97, 0x18, 0xf2, 0x00, 0xc9, 0x14, 
+0xf3, 0x00, 0x05, 0x0b, 0x05, 0x0c, 0xaa, 0x0b, 0xe3, 0x0a, 0x97, 0x0a, 
+0xa5, 0x0f, 0xe7, 0x00, 0x0f, 0x00, 0x12, 0x09, 0x14, 0x0f, 0x2f, 0x0c, 
+0x88, 0x0c, 0x28, 0x01, 0x30, 0x0b, 0x11, 0x0c, 0x4e, 0x17, 0x21, 0x09, 
+0x5f, 0x06, 0xe8, 0x00, 0x79, 0x09, 0x08, 0x0b, 0x17, 0x00, 0x28, 0x01, 
+0x28, 0x0c, 0x62, 0x17, 0x47, 0x13, 0xdd, 0x18, 0xf9, 0x00, 0x8d, 0x06, 
+0x9e, 0x0c, 0xbf, 0x00, 0x77, 0x1c, 0x30, 0x01, 0xc2, 0x00, 0x1f, 0x0b, 
+0xa4, 0x09, 0x21, 0x09, 0x61, 0x00, 0xb7, 0x0a, 0x22, 0x0b, 0xe0, 0x0b, 
+0x53, 0x19, 0x47, 0x00, 0xbd, 0x09, 0xc8, 0x00, 0xa8, 0x00, 0xe6, 0x00, 
+0xdb, 0x00, 0xad, 0x00, 0x8b, 0x12, 0x07, 0x01, 0x2a, 0x13, 0x6a, 0x07, 
+0xf7, 0x00, 0xb2, 0x00, 0x97, 0x06, 0x2c, 0x09, 0xb3, 0x0a, 0xaf, 0x09, 
+0x00, 0x10, 0x25, 0x07, 0xef, 0x0a, 0x43, 0x14, 0x54, 0x00, 0x71, 0x1b, 
+0xb2, 0x16, 0x77, 0x00, 0x59, 0x0c, 0x47, 0x18, 0xcd, 0x14, 0xcd, 0x00, 
+0xe8, 0x09, 0x47, 0x00, 0x25, 0x0b, 0xbd, 0x00, 0x35, 0x0a, 0x14, 0x01, 
+0xa7, 0x0a, 0x64, 0x0a, 0x2d, 0x08, 0x26, 0x07, 0xa9, 0x0b, 0x46, 0x0c, 
+0x83, 0x10, 0x2c, 0x0f, 0x7f, 0x0b, 0x9f, 0x00, 0x46, 0x06, 0xd8, 0x17, 
+0x46, 0x0c, 0xad, 0x07, 0x06, 0x08, 0xae, 0x00, 0xa9, 0x10, 0x53, 0x00, 
+0x57, 0x11, 0x1b, 0x0f, 0xa2, 0x08, 0x2e, 0x01, 0x1a, 0x0a, 0xd0, 0x09, 
+0xbd, 0x07, 0xaf, 0x19, 0xc1, 0x00, 0x2d, 0x00, 0x97, 0x17, 0x14, 0x19, 
+0x31, 0x0b, 0xb4, 0x00, 0xae, 0x12, 0x71, 0x10, 0x67, 0x07, 0xf2, 0x00, 
+0x30, 0x00, 0x33, 0x0b, 0xe2, 0x09, 0xc1, 0x0b, 0x52, 0x07, 0xe7, 0x06, 
+0x2f, 0x0c, 0xea, 0x0c, 0xc3, 0x00, 0xe4, 0x0a, 0x00, 0x0a, 0x1a, 0x0c, 
+0x6e, 0x0a, 0x28, 0x00, 0x4b, 0x0a, 0x8f, 0x00, 0x0b, 0x09, 0x96, 0x1a, 
+0x21, 0x14, 0x7b, 0x09, 0xea, 0x09, 0xc2, 0x00, 0xd9, 0x11, 0xf5, 0x08, 
+0xc9, 0x09, 0xe1, 0x07, 0x34, 0x01, 0xcb, 0x0b, 0x8f, 0x0a, 0xc0, 0x00, 
+0x82, 0x06, 0xad, 0x00, 0x96, 0x0f, 0x7d, 0x0b, 0x3a, 0x18, 0x66, 0x0c, 
+0x77, 0x06, 0x37, 0x0a, 0xa9, 0x0c, 0x35, 0x09, 0x3b, 0x0b, 0x27, 0x0b, 
+0x28, 0x01, 0x12, 0x07, 0x61, 0x00, 0x30, 0x0c, 0xfc, 0x08, 0xbc, 0x0a, 
+0x30, 0x0c, 0x79, 0x08, 0xdb, 0x00, 0xac, 0x00, 0x3f, 0x09, 0x0c, 0x01, 
+0x8c, 0x0a, 0xae, 0x0a, 0x0a, 0x0b, 0x2c, 0x0a, 0x24, 0x01, 0x13, 0x0b, 
+0x9c, 0x0c, 0xb4, 0x0b, 0x32, 0x01, 0x59, 0x00, 0xe3, 0x07, 0xa7, 0x0c, 
+0xa0, 0x08, 0x3a, 0x01, 0x5c, 0x0b, 0x38, 0x01, 0x50, 0x17, 0x2a, 0x12, 
+0x28, 0x0a, 0x0a, 0x0a, 0x23, 0x01, 0xde, 0x06, 0x81, 0x0a, 0x26, 0x01, 
+0x07, 0x01, 0xbf, 0x06, 0x1f, 0x14, 0x1e, 0x15, 0xe6, 0x09, 0xdd, 0x09, 
+0x04, 0x19, 0xdd, 0x00, 0x26, 0x1a, 0xce, 0x06, 0xfa, 0x13, 0xd0, 0x09, 
+0x0b, 0x00, 0x06, 0x01, 0x68, 0x0b, 0x0e, 0x08, 0x43, 0x18, 0x96, 0x0b, 
+0x02, 0x15, 0xba, 0x0b, 0xdc, 0x00, 0xc0, 0x09, 0xcd, 0x0b, 0x49, 0x00, 
+0x90, 0x0c, 0x7c, 0x16, 0xd6, 0x07, 0xf0, 0x0a, 0xb7, 0x0a, 0xa9, 0x16, 
+0xb4, 0x07, 0xf6, 0x14, 0xdb, 0x00, 0x85, 0x00, 0x8d, 0x06, 0x21, 0x01, 
+0xf3, 0x17, 0xdb, 0x0d, 0xaf, 0x00, 0xb7, 0x0b, 0x49, 0x0e, 0x91, 0x00, 
+0xaf, 0x00, 0x30, 0x0c, 0x32, 0x12, 0xba, 0x0a, 0x09, 0x08, 0x19, 0x08, 
+0xf0, 0x00, 0x43, 0x15, 0x94, 0x0f, 0xa4, 0x0a, 0xa9, 0x0b, 0x5f, 0x00, 
+0xdf, 0x09, 0x1b, 0x00, 0x2d, 0x01, 0x04, 0x0c, 0xb7, 0x00, 0x35, 0x09, 
+0x32, 0x01, 0xcb, 0x00, 0x0a, 0x01, 0x0e, 0x01, 0x13, 0x0b, 0xab, 0x07, 
+0x8d, 0x0a, 0x62, 0x17, 0x18, 0x01, 0x61, 0x17, 0xd0, 0x0c, 0x25, 0x0b, 
+0xba, 0x15, 0x78, 0x10, 0x4a, 0x09, 0xb9, 0x0b, 0x5a, 0x0c, 0xa5, 0x17, 
+0x5f, 0x00, 0xb1, 0x0b, 0xf3, 0x00, 0x38, 0x0d, 0x37, 0x01, 0x77, 0x0a, 
+0xac, 0x00, 0xd1, 0x09, 0x78, 0x0a, 0xf2, 0x00, 0x5f, 0x0a, 0x3e, 0x0a, 
+0xbe, 0x00, 0x71, 0x09, 0xe0, 0x16, 0x70, 0x07, 0x46, 0x0b, 0x13, 0x11, 
+0x8d, 0x0b, 0xd9, 0x0e, 0x63, 0x00, 0xd7, 0x0c, 0x92, 0x00, 0xfc, 0x16, 
+0x44, 0x13, 0x1a, 0x0b, 0x21, 0x09, 0xe8, 0x0b, 0xee, 0x0d, 0x2e, 0x0c, 
+0xcd, 0x0a, 0xb7, 0x0a, 0x41, 0x0b, 0x1e, 0x00, 0xcf, 0x0a, 0x84, 0x0a, 
+0xb2, 0x08, 0x55, 0x06, 0x1d, 0x0a, 0xb0, 0x10, 0x8f, 0x17, 0x54, 0x0a, 
+0x03, 0x13, 0x72, 0x0b, 0x64, 0x09, 0x20, 0x09, 0x34, 0x0e, 0x42, 0x06, 
+0x66, 0x0d, 0x2c, 0x01, 0x78, 0x00, 0x5d, 0x11, 0xc4, 0x00, 0xe4, 0x0d, 
+0x17, 0x0c, 0xdc, 0x00, 0xa7, 0x00, 0x39, 0x12, 0xd0, 0x09, 0x3f, 0x01, 
+0x23, 0x0c, 0x8f, 0x00, 0xbd, 0x09, 0x66, 0x08, 0x5f, 0x06, 0xc1, 0x1a, 
+0x3b, 0x00, 0x37, 0x0a, 0x5b, 0x0c, 0x24, 0x01, 0x03, 0x01, 0x93, 0x0b, 
+0x15, 0x07, 0x24, 0x00, 0xba, 0x00, 0xd3, 0x0f, 0xba, 0x0a, 0xac, 0x0b, 
+0xe0, 0x00, 0xba, 0x09, 0xc4, 0x14, 0xd6, 0x0b, 0xfc, 0x09, 0xa9, 0x0b, 
+0xdc, 0x00, 0x31, 0x01, 0x9b, 0x15, 0xe1, 0x07, 0x31, 0x0b, 0x12, 0x01, 
+0xb8, 0x0b, 0x84, 0x14, 0x3f, 0x08, 0xc7, 0x0c, 0xb6, 0x0b, 0xaf, 0x00, 
+0x3f, 0x0a, 0xa1, 0x0a, 0xf4, 0x09, 0xc3, 0x00, 0xfd, 0x00, 0xf9, 0x0a, 
+0x20, 0x1a, 0x3c, 0x18, 0x09, 0x01, 0xc9, 0x00, 0x84, 0x0e, 0xd5, 0x0c, 
+0x3c, 0x00, 0x9e, 0x0b, 0x81, 0x10, 0x04, 0x01, 0x31, 0x0c, 0x4f, 0x17, 
+0x3b, 0x0b, 0x52, 0x0b, 0x7a, 0x10, 0xcc, 0x16, 0xaf, 0x10, 0xcf, 0x00, 
+0x18, 0x01, 0x9b, 0x19, 0x26, 0x00, 0xbb, 0x17, 0xc1, 0x00, 0xd3, 0x0f, 
+0x13, 0x0b, 0x1e, 0x0a, 0x19, 0x0c, 0x62, 0x0d, 0x50, 0x17, 0x1d, 0x00, 
+0xfb, 0x14, 0x25, 0x01, 0xf9, 0x00, 0x1e, 0x01, 0x62, 0x00, 0x07, 0x14, 
+0x10, 0x0b, 0x05, 0x01, 0x34, 0x0c, 0xd2, 0x00, 0xc4, 0x11, 0xaa, 0x0b, 
+0x45, 0x06, 0x0d, 0x0c, 0x65, 0x00, 0x29, 0x00, 0xcc, 0x0e, 0xca, 0x0e, 
+0x62, 0x0a, 0x5f, 0x00, 0x85, 0x0c, 0xda, 0x0d, 0xbc, 0x00, 0x01, 0x0c, 
+0xee, 0x0d, 0x61, 0x00, 0x81, 0x0a, 0x0f, 0x0b, 0x1e, 0x0a, 0x49, 0x0a, 
+0xe3, 0x06, 0x9c, 0x0c, 0xa9, 0x00, 0xf5, 0x00, 0x40, 0x06, 0xcf, 0x08, 
+0xea, 0x08, 0x68, 0x06, 0x4b, 0x00, 0xaa, 0x09, 0xb6, 0x00, 0xb3, 0x06, 
+0x11, 0x00, 0x85, 0x00, 0x1a, 0x0b, 0x60, 0x07, 0x92, 0x0f, 0xab, 0x06, 
+0x96, 0x17, 0x26, 0x08, 0xee, 0x00, 0xdf, 0x0c, 0x22, 0x01, 0x71, 0x13, 
+0x6a, 0x0b, 0x02, 0x0c, 0x3e, 0x00, 0x26, 0x0c, 0x0c, 0x13, 0x03, 0x13, 
+0x4a, 0x00, 0xe2, 0x00, 0xb4, 0x00, 0x43, 0x0c, 0x69, 0x0b, 0x34, 0x0a, 
+0x90, 0x00, 0x17, 0x00, 0x3e, 0x13, 0x17, 0x01, 0xca, 0x00, 0xeb, 0x0b, 
+0xb9, 0x0a, 0x70, 0x08, 0xe7, 0x00, 0xcd, 0x0a, 0x33, 0x0a, 0xfb, 0x14, 
+0x2e, 0x07, 0x48, 0x00, 0x29, 0x0a, 0xef, 0x00, 0x77, 0x06, 0x8e, 0x0a, 
+0x52, 0x13, 0xda, 0x11, 0xf5, 0x06, 0x3d, 0x0b, 0x49, 0x06, 0x22, 0x01, 
+0x4c, 0x06, 0x60, 0x07, 0xde, 0x0c, 0xb1, 0x08, 0xdb, 0x06, 0x0d, 0x00, 
+0xf8, 0x09, 0x65, 0x00, 0x09, 0x0d, 0x15, 0x01, 0xc9, 0x00, 0x40, 0x06, 
+0xb1, 0x09, 0xe5, 0x00, 0x82, 0x14, 0xac, 0x0b, 0x5a, 0x08, 0x17, 0x01, 
+0x82, 0x0b, 0x3e, 0x07, 0x54, 0x0a, 0x19, 0x01, 0xf6, 0x00, 0xb5, 0x0b, 
+0x46, 0x0f, 0xbf, 0x00, 0x5b, 0x0c, 0x78, 0x08, 0x23, 0x0c, 0xef, 0x00, 
+0x3c, 0x00, 0x74, 0x00, 0x02, 0x17, 0x8c, 0x0a, 0x48, 0x00, 0x70, 0x14, 
+0x0e, 0x19, 0xd9, 0x0b, 0xf6, 0x0f, 0xc7, 0x0c, 0x90, 0x0c, 0x18, 0x00, 
+0xcd, 0x14, 0xb4, 0x00, 0x08, 0x01, 0xd3, 0x11, 0x0f, 0x00, 0x6b, 0x0a, 
+0x0b, 0x00, 0x8f, 0x00, 0xca, 0x09, 0x1b, 0x01, 0x17, 0x14, 0xb6, 0x10, 
+0xb2, 0x09, 0x57, 0x14, 0x32, 0x0c, 0x97, 0x06, 0x61, 0x00, 0xea, 0x00, 
+0xbf, 0x0b, 0xf3, 0x09, 0xf6, 0x00, 0xc8, 0x0c, 0x1e, 0x0b, 0x29, 0x07, 
+0x6c, 0x0a, 0x01, 0x01, 0x37, 0x0d, 0xf4, 0x11, 0x1e, 0x00, 0xcb, 0x0b, 
+0x1c, 0x01, 0xd3, 0x15, 0x31, 0x0c, 0x26, 0x0b, 0xbb, 0x00, 0x01, 0x0c, 
+0x67, 0x0b, 0x63, 0x09, 0xd2, 0x0c, 0x50, 0x0c, 0x72, 0x16, 0x39, 0x13, 
+0xdb, 0x09, 0x8c, 0x0a, 0xe0, 0x08, 0x1e, 0x01, 0x5e, 0x0b, 0x5c, 0x0b, 
+0x02, 0x0c, 0x10, 0x0c, 0x7f, 0x0b, 0xfc, 0x00, 0x1f, 0x01, 0x20, 0x01, 
+0xb2, 0x0a, 0x2d, 0x0b, 0x6f, 0x0c, 0x62, 0x00, 0x6d, 0x0b, 0xbf, 0x00, 
+0xad, 0x00, 0x6c, 0x00, 0xe4, 0x0d, 0x59, 0x00, 0x7a, 0x0a, 0x04, 0x01, 
+0x08, 0x0d, 0x12, 0x11, 0xc5, 0x09, 0x0b, 0x00, 0x77, 0x09, 0xeb, 0x07, 
+0x58, 0x17, 0x94, 0x0c, 0xb8, 0x10, 0xf4, 0x07, 0xce, 0x06, 0xe1, 0x0b, 
+0xed, 0x09, 0x72, 0x00, 0x3b, 0x0c, 0x0e, 0x00, 0x4a, 0x06, 0x6d, 0x00, 
+0xd3, 0x00, 0xbb, 0x0e, 0x23, 0x09, 0xd2, 0x0f, 0x89, 0x0c, 0x62, 0x0d, 
+0x55, 0x0d, 0x0f, 0x01, 0xe4, 0x0d, 0xbc, 0x09, 0xa7, 0x00, 0xfa, 0x18, 
+0x4d, 0x00, 0xb5, 0x16, 0x30, 0x0b, 0x11, 0x01, 0x31, 0x12, 0xc4, 0x0a, 
+0x54, 0x11, 0xf4, 0x09, 0x02, 0x16, 0xc5, 0x09, 0xfb, 0x00, 0x54, 0x00, 
+0x70, 0x10, 0xd7, 0x19, 0x55, 0x06, 0xfa, 0x0a, 0xed, 0x0d, 0x0b, 0x0d, 
+0x1c, 0x01, 0x55, 0x0b, 0x69, 0x07, 0x99, 0x14, 0x25, 0x08, 0x79, 0x00, 
+0xf6, 0x00, 0x78, 0x0a, 0xa6, 0x09, 0x8b, 0x13, 0x4a, 0x0b, 0xc4, 0x00, 
+0xf9, 0x0a, 0xbd, 0x0a, 0x42, 0x01, 0xaf, 0x09, 0xc0, 0x00, 0xeb, 0x0c, 
+0xae, 0x0b, 0x11, 0x0b, 0x3f, 0x0a, 0x91, 0x0b, 0x6e, 0x12, 0xa9, 0x00, 
+0x69, 0x15, 0x4b, 0x06, 0xa5, 0x09, 0xb2, 0x0c, 0x7a, 0x0a, 0x1b, 0x0c, 
+0x67, 0x13, 0x93, 0x0b, 0x91, 0x0c, 0xe3, 0x00, 0x1d, 0x0a, 0x2d, 0x0c, 
+0x85, 0x09, 0xe9, 0x00, 0x8b, 0x0a, 0xf7, 0x07, 0x42, 0x1a, 0x61, 0x15, 
+0x94, 0x07, 0xe0, 0x00, 0x5f, 0x11, 0xde, 0x0d, 0x8c, 0x06, 0x28, 0x0a, 
+0x25, 0x13, 0x16, 0x01, 0x91, 0x00, 0xcf, 0x0b, 0x63, 0x0b, 0x09, 0x0d, 
+0x3e, 0x00, 0x2b, 0x01, 0x35, 0x01, 0xd4, 0x0f, 0xdb, 0x09, 0x3a, 0x01, 
+0xdc, 0x09, 0xcb, 0x00, 0xdb, 0x0d, 0xec, 0x06, 0xe2, 0x00, 0x83, 0x10, 
+0xc3, 0x00, 0xed, 0x0b, 0xa3, 0x17, 0x1c, 0x07, 0x5d, 0x07, 0x2a, 0x01, 
+0x08, 0x01, 0x14, 0x0b, 0xd5, 0x0f, 0xf6, 0x0c, 0x1c, 0x10, 0x38, 0x01, 
+0xb2, 0x06, 0x45, 0x08, 0x35, 0x00, 0xbe, 0x00, 0x5a, 0x00, 0x0e, 0x0c, 
+0xaa, 0x08, 0xe3, 0x00, 0xe0, 0x00, 0x94, 0x0c, 0xe0, 0x0b, 0x9b, 0x0b, 
+0x3a, 0x1a, 0xa9, 0x0a, 0x3f, 0x01, 0xee, 0x0a, 0x05, 0x01, 0xe1, 0x0b, 
+0xaa, 0x0b, 0xa9, 0x0f, 0xfb, 0x0a, 0x75, 0x16, 0xde, 0x13, 0xa3, 0x0a, 
+0x3c, 0x00, 0xaf, 0x0a, 0x9c, 0x0c, 0x57, 0x07, 0x94, 0x07, 0x67, 0x00, 
+0x3c, 0x00, 0x56, 0x0a, 0x9d, 0x00, 0xd0, 0x00, 0x79, 0x00, 0x43, 0x06, 
+0xe9, 0x09, 0x7e, 0x0b, 0xe1, 0x0f, 0x11, 0x0b, 0x9d, 0x1a, 0x2e, 0x09, 
+0x0a, 0x16, 0xee, 0x00, 0xe2, 0x0c, 0x32, 0x01, 0xb2, 0x00, 0xf2, 0x00, 
+0xbf, 0x0a, 0x42, 0x13, 0x3e, 0x0b, 0x8f, 0x00, 0xbc, 0x00, 0x1d, 0x07, 
+0xd3, 0x00, 0x9a, 0x07, 0xe1, 0x00, 0x28, 0x01, 0xa4, 0x0a, 0x6b, 0x0b, 
+0x47, 0x09, 0xb9, 0x10, 0x26, 0x00, 0x57, 0x0a, 0x97, 0x15, 0x78, 0x0a, 
+0x30, 0x00, 0x24, 0x0a, 0x1f, 0x00, 0x27, 0x01, 0x43, 0x0a, 0x68, 0x07, 
+0x73, 0x16, 0x18, 0x01, 0xdb, 0x00, 0x37, 0x00, 0x5d, 0x11, 0x22, 0x0e, 
+0xd6, 0x18, 0x06, 0x0b, 0x69, 0x0b, 0x08, 0x0e, 0x2c, 0x09, 0xf7, 0x00, 
+0x0f, 0x00, 0x05, 0x08, 0xad, 0x00, 0x08, 0x0d, 0x8f, 0x00, 0xb4, 0x08, 
+0xc1, 0x13, 0x2f, 0x00, 0xde, 0x09, 0xba, 0x07, 0x43, 0x00, 0xfd, 0x00, 
+0x48, 0x0b, 0x2a, 0x01, 0xb8, 0x00, 0x0c, 0x13, 0x5a, 0x0c, 0x82, 0x14, 
+0xd3, 0x0c, 0xe8, 0x00, 0x2b, 0x01, 0xef, 0x00, 0x37, 0x00, 0xe9, 0x0b, 
+0xc0, 0x00, 0xdd, 0x09, 0x56, 0x06, 0xf6, 0x08, 0xaf, 0x09, 0x48, 0x06, 
+0x4b, 0x11, 0x70, 0x08, 0x11, 0x0e, 0xdd, 0x09, 0xe1, 0x00, 0x11, 0x0b, 
+0x5f, 0x15, 0xbe, 0x00, 0x2c, 0x01, 0xed, 0x06, 0xd1, 0x0e, 0xfc, 0x00, 
+0x7d, 0x0c, 0xd7, 0x14, 0x62, 0x0b, 0x70, 0x00, 0xe7, 0x00, 0x71, 0x0c, 
+0x78, 0x00, 0x67, 0x0b, 0x57, 0x06, 0xae, 0x08, 0xe4, 0x00, 0x40, 0x01, 
+0x87, 0x0a, 0xe2, 0x0b, 0xf0, 0x0a, 0xdb, 0x09, 0x09, 0x01, 0xa8, 0x0c, 
+0xd9, 0x0c, 0x49, 0x0b, 0xf6, 0x09, 0xa4, 0x0a, 0xff, 0x0f, 0x41, 0x00, 
+0x11, 0x0d, 0x4b, 0x11, 0x17, 0x00, 0x82, 0x11, 0x12, 0x01, 0x9f, 0x0b, 
+0xdd, 0x00, 0xaf, 0x11, 0x1a, 0x0f, 0xca, 0x0b, 0x4f, 0x0e, 0x63, 0x0e, 
+0x28, 0x08, 0xeb, 0x09, 0x97, 0x07, 0x95, 0x0b, 0x4a, 0x14, 0xc1, 0x00, 
+0xab, 0x06, 0x02, 0x01, 0x50, 0x17, 0xa4, 0x00, 0x60, 0x06, 0x30, 0x00, 
+0x70, 0x09, 0x66, 0x13, 0x39, 0x12, 0x4d, 0x09, 0x19, 0x01, 0x70, 0x09, 
+0x13, 0x00, 0xb2, 0x15, 0xf1, 0x00, 0x38, 0x0d, 0x43, 0x15, 0x45, 0x06, 
+0x33, 0x01, 0xcb, 0x00, 0xcb, 0x00, 0x47, 0x0f, 0x23, 0x00, 0x09, 0x01, 
+0x62, 0x0a, 0x79, 0x0a, 0x8d, 0x12, 0x72, 0x0d, 0xb3, 0x09, 0x09, 0x0b, 
+0x71, 0x17, 0x59, 0x0c, 0xf5, 0x09, 0xb1, 0x09, 0xd4, 0x00, 0xb6, 0x0b, 
+0x21, 0x16, 0x30, 0x0f, 0xec, 0x00, 0x31, 0x01, 0xe0, 0x08, 0x0a, 0x0d, 
+0x0a, 0x01, 0x87, 0x0c, 0x3b, 0x01, 0xfe, 0x00, 0xdf, 0x17, 0x48, 0x0d, 
+0x5a, 0x0c, 0x03, 0x01, 0xbb, 0x00, 0x68, 0x08, 0x89, 0x0c, 0x83, 0x00, 
+0xd3, 0x09, 0x1a, 0x0b, 0x49, 0x0b, 0x63, 0x0e, 0xab, 0x0c, 0xa9, 0x15, 
+0x43, 0x0c, 0x82, 0x0a, 0x37, 0x0a, 0x70, 0x06, 0x9b, 0x00, 0x8d, 0x0a, 
+0x3c, 0x12, 0x17, 0x00, 0x4f, 0x17, 0x40, 0x0a, 0x9f, 0x00, 0xde, 0x0a, 
+0x26, 0x00, 0xfa, 0x14, 0xdf, 0x00, 0x51, 0x0b, 0xe1, 0x09, 0x01, 0x14, 
+0x05, 0x16, 0x48, 0x12, 0xb3, 0x07, 0x42, 0x1a, 0x44, 0x06, 0x5f, 0x12, 
+0xd6, 0x0b, 0xee, 0x0a, 0x89, 0x0b, 0x0d, 0x01, 0xa9, 0x0a, 0x17, 0x0c, 
+0x49, 0x0b, 0x6d, 0x00, 0xee, 0x07, 0xd9, 0x07, 0x10, 0x01, 0xbd, 0x14, 
+0x4e, 0x06, 0xae, 0x00, 0x86, 0x00, 0x53, 0x00, 0x44, 0x06, 0x05, 0x01, 
+0x75, 0x16, 0x8f, 0x1a, 0xd7, 0x15, 0x84, 0x0a, 0x81, 0x0b, 0x39, 0x0a, 
+0x5e, 0x11, 0xc9, 0x00, 0x86, 0x00, 0xe3, 0x06, 0xb0, 0x00, 0xc0, 0x0c, 
+0xdf, 0x13, 0x63, 0x09, 0xc2, 0x0a, 0x29, 0x0a, 0x1a, 0x01, 0x2f, 0x0c, 
+0x10, 0x01, 0x56, 0x0a, 0x0d, 0x0c, 0x87, 0x00, 0xed, 0x0b, 0xe8, 0x10, 
+0x71, 0x0c, 0x9a, 0x19, 0xbe, 0x0c, 0x39, 0x01, 0x73, 0x0c, 0x5d, 0x0c, 
+0xd6, 0x15, 0xc3, 0x00, 0x4a, 0x0b, 0x3b, 0x01, 0xa2, 0x07, 0x55, 0x06, 
+0x43, 0x0c, 0x07, 0x0b, 0x68, 0x08, 0x93, 0x0a, 0xc1, 0x0e, 0x6e, 0x10, 
+0xe3, 0x0b, 0xbc, 0x09, 0x5d, 0x0a, 0xe4, 0x0a, 0x42, 0x01, 0x47, 0x0b, 
+0xb4, 0x19, 0x45, 0x08, 0xba, 0x0a, 0xa6, 0x0c, 0x53, 0x07, 0x59, 0x11, 
+0xb0, 0x1a, 0xb0, 0x00, 0x2b, 0x01, 0xaa, 0x0b, 0x26, 0x0b, 0x2a, 0x01, 
+0x27, 0x01, 0xb3, 0x0c, 0xd1, 0x0a, 0x3b, 0x00, 0xcc, 0x0f, 0x85, 0x0b, 
+0xf2, 0x00, 0xf9, 0x0a, 0x7a, 0x0a, 0x0d, 0x09, 0xcf, 0x06, 0xc8, 0x14, 
+0x3f, 0x01, 0x53, 0x00, 0x3e, 0x0a, 0x2e, 0x0c, 0x0f, 0x00, 0xf2, 0x09, 
+0x98, 0x07, 0xb4, 0x00, 0xb7, 0x0a, 0x0f, 0x01, 0x35, 0x00, 0x14, 0x01, 
+0xd7, 0x00, 0x17, 0x13, 0xc1, 0x00, 0xf9, 0x0a, 0x3d, 0x0b, 0x66, 0x09, 
+0xa9, 0x0b, 0xf8, 0x00, 0xc7, 0x0c, 0x45, 0x06, 0xa6, 0x09, 0x6b, 0x00, 
+0x90, 0x10, 0xac, 0x0a, 0x02, 0x13, 0xcf, 0x00, 0x03, 0x0c, 0x4d, 0x13, 
+0xd0, 0x09, 0xd8, 0x0a, 0xf3, 0x13, 0x8d, 0x12, 0x18, 0x00, 0x00, 0x01, 
+0x46, 0x09, 0xa3, 0x0a, 0x12, 0x01, 0x70, 0x00, 0xd6, 0x00, 0x61, 0x0b, 
+0xe2, 0x00, 0x27, 0x00, 0x57, 0x00, 0xba, 0x17, 0x08, 0x17, 0xe8, 0x0d, 
+0x61, 0x17, 0x83, 0x00, 0xd7, 0x00, 0x05, 0x0b, 0xbe, 0x14, 0xbe, 0x09, 
+0x57, 0x0a, 0xa7, 0x00, 0xd6, 0x07, 0x4a, 0x06, 0xa4, 0x0a, 0xfe, 0x0f, 
+0x00, 0x0a, 0x19, 0x01, 0x14, 0x00, 0xb7, 0x0a, 0x1a, 0x0c, 0xaa, 0x1a, 
+0xe5, 0x00, 0x0b, 0x01, 0xc6, 0x0a, 0xc6, 0x09, 0xbf, 0x00, 0x0d, 0x01, 
+0x9f, 0x0b, 0x46, 0x0b, 0xbc, 0x0c, 0x08, 0x01, 0x4e, 0x19, 0xe7, 0x09, 
+0x48, 0x06, 0xb2, 0x17, 0x6e, 0x0a, 0xba, 0x00, 0xb5, 0x00, 0xe2, 0x00, 
+0x63, 0x00, 0x41, 0x06, 0x89, 0x0b, 0x85, 0x0a, 0x28, 0x12, 0xb6, 0x0c, 
+0x85, 0x00, 0xdb, 0x0d, 0x99, 0x06, 0xdd, 0x0d, 0xa4, 0x06, 0x4f, 0x0a, 
+0x86, 0x0c, 0xc9, 0x0f, 0x44, 0x06, 0x85, 0x00, 0xbf, 0x00, 0x6c, 0x1b, 
+0x80, 0x09, 0xc2, 0x0e, 0x91, 0x0c, 0xf9, 0x00, 0xb9, 0x0a, 0xc9, 0x00, 
+0xb3, 0x16, 0x9d, 0x08, 0xe1, 0x09, 0x1d, 0x0a, 0x14, 0x01, 0x6f, 0x0c, 
+0x4c, 0x11, 0x35, 0x0f, 0x21, 0x0a, 0x19, 0x01, 0xf7, 0x0c, 0x15, 0x01, 
+0xc6, 0x00, 0x61, 0x1b, 0xf3, 0x00, 0x24, 0x08, 0x11, 0x0e, 0x0c, 0x13, 
+0xdb, 0x0a, 0x76, 0x0a, 0xd1, 0x00, 0x1b, 0x01, 0x1e, 0x08, 0x27, 0x01, 
+0x20, 0x16, 0x30, 0x12, 0xdc, 0x00, 0x37, 0x07, 0xbe, 0x11, 0x54, 0x00, 
+0xd4, 0x06, 0xab, 0x00, 0x99, 0x07, 0x77, 0x00, 0xee, 0x00, 0xeb, 0x00, 
+0x1a, 0x0b, 0x35, 0x01, 0x06, 0x0c, 0xb3, 0x0c, 0x50, 0x00, 0xe8, 0x13, 
+0x58, 0x13, 0xa1, 0x15, 0xf2, 0x00, 0x3e, 0x0b, 0x86, 0x00, 0xe4, 0x06, 
+0x65, 0x08, 0xdf, 0x08, 0x1b, 0x01, 0x08, 0x01, 0xf2, 0x14, 0x4b, 0x11, 
+0xf1, 0x13, 0xee, 0x0a, 0x9d, 0x0c, 0x61, 0x0a, 0x36, 0x01, 0x41, 0x01, 
+0x9b, 0x0c, 0x18, 0x08, 0xa3, 0x06, 0xeb, 0x0b, 0xaf, 0x00, 0xe7, 0x06, 
+0xc4, 0x00, 0x55, 0x00, 0x30, 0x12, 0x89, 0x0c, 0x49, 0x06, 0xf5, 0x00, 
+0x8a, 0x0b, 0x30, 0x0b, 0x3d, 0x00, 0x82, 0x09, 0x08, 0x18, 0xe2, 0x0b, 
+0x15, 0x01, 0x83, 0x00, 0xcd, 0x00, 0xed, 0x0b, 0x70, 0x06, 0x09, 0x0a, 
+0xe4, 0x0d, 0x45, 0x0c, 0xc9, 0x1b, 0xf1, 0x00, 0xd7, 0x0b, 0x3e, 0x01, 
+0x76, 0x0a, 0xdb, 0x0d, 0xd6, 0x00, 0xa6, 0x10, 0x2f, 0x01, 0x62, 0x0d, 
+0x77, 0x0b, 0x52, 0x0b, 0xb9, 0x06, 0x17, 0x0c, 0x53, 0x00, 0xe3, 0x0a, 
+0x3d, 0x01, 0x67, 0x09, 0xfd, 0x00, 0x08, 0x0a, 0x15, 0x13, 0xa4, 0x17, 
+0xb5, 0x00, 0xcc, 0x0b, 0x8b, 0x12, 0x85, 0x0c, 0xd6, 0x07, 0x38, 0x0c, 
+0x25, 0x0b, 0xa5, 0x15, 0x61, 0x12, 0xf9, 0x11, 0xbb, 0x00, 0xd2, 0x06, 
+0x18, 0x00, 0xed, 0x00, 0x1b, 0x0d, 0x23, 0x09, 0xbc, 0x00, 0x32, 0x0c, 
+0xe7, 0x00, 0xe3, 0x00, 0x7d, 0x0c, 0xde, 0x13, 0x1f, 0x01, 0x09, 0x0b, 
+0x3b, 0x0a, 0x67, 0x14, 0xd2, 0x00, 0x4f, 0x0a, 0xd4, 0x09, 0xcf, 0x06, 
+0xfa, 0x0e, 0x11, 0x0e, 0xee, 0x00, 0x22, 0x10, 0x54, 0x00, 0xf0, 0x0d, 
+0x33, 0x0a, 0x34, 0x0c, 0x04, 0x01, 0xf1, 0x09, 0xe9, 0x13, 0x48, 0x0b, 
+0xa6, 0x09, 0x9f, 0x07, 0xcd, 0x15, 0x36, 0x09, 0xac, 0x16, 0x18, 0x00, 
+0x2b, 0x18, 0x69, 0x17, 0x40, 0x0a, 0xc0, 0x00, 0x27, 0x11, 0x9e, 0x08, 
+0xfd, 0x08, 0x63, 0x0a, 0xed, 0x06, 0xa5, 0x06, 0x17, 0x01, 0xcd, 0x14, 
+0x67, 0x0c, 0xac, 0x0b, 0x32, 0x01, 0xa6, 0x10, 0x33, 0x0a, 0xc6, 0x14, 
+0x02, 0x0a, 0x01, 0x17, 0x17, 0x13, 0x32, 0x00, 0x48, 0x06, 0x43, 0x13, 
+0x14, 0x01, 0x8b, 0x09, 0x51, 0x0c, 0xfe, 0x0c, 0x0b, 0x01, 0x65, 0x11, 
+0x5b, 0x0f, 0x84, 0x00, 0xed, 0x06, 0x3a, 0x1a, 0x9c, 0x00, 0x8b, 0x0a, 
+0x0e, 0x01, 0x04, 0x09, 0xb0, 0x09, 0x53, 0x17, 0xbb, 0x09, 0x5c, 0x0b, 
+0xd5, 0x14, 0x22, 0x01, 0xe8, 0x0c, 0x76, 0x13, 0x83, 0x00, 0x2f, 0x00, 
+0x9c, 0x0c, 0xc4, 0x1a, 0x93, 0x0b, 0x2f, 0x00, 0xd7, 0x07, 0xab, 0x00, 
+0x77, 0x00, 0x46, 0x09, 0x2a, 0x0a, 0x3e, 0x0f, 0x47, 0x18, 0x36, 0x08, 
+0xc6, 0x09, 0x3b, 0x01, 0xd7, 0x00, 0x59, 0x0c, 0x29, 0x15, 0x12, 0x0d, 
+0x96, 0x0b, 0xaa, 0x16, 0x9f, 0x00, 0xdb, 0x09, 0x95, 0x0f, 0xf6, 0x0f, 
+0xd5, 0x0b, 0x4c, 0x0a, 0x0f, 0x0b, 0xae, 0x0b, 0x05, 0x01, 0xdf, 0x00, 
+0x20, 0x09, 0x1a, 0x0b, 0x35, 0x09, 0xa7, 0x00, 0x39, 0x12, 0x28, 0x00, 
+0x3f, 0x01, 0x43, 0x06, 0x35, 0x09, 0xd1, 0x09, 0xe2, 0x0b, 0xc4, 0x10, 
+0x50, 0x17, 0xc3, 0x0b, 0x4f, 0x0c, 0x2d, 0x09, 0xfe, 0x09, 0x4e, 0x0c, 
+0xc2, 0x00, 0xaf, 0x09, 0xeb, 0x00, 0x7d, 0x0b, 0xeb, 0x00, 0x62, 0x09, 
+0xda, 0x00, 0xe8, 0x0a, 0x2a, 0x12, 0x1b, 0x01, 0xec, 0x07, 0xfb, 0x15, 
+0xaf, 0x0a, 0x77, 0x00, 0x41, 0x06, 0x54, 0x0e, 0xf6, 0x00, 0xb2, 0x0c, 
+0x54, 0x07, 0xae, 0x0a, 0xce, 0x15, 0x7a, 0x00, 0x80, 0x0a, 0x7e, 0x0a, 
+0x52, 0x07, 0xdc, 0x0d, 0x78, 0x0b, 0x90, 0x1a, 0x48, 0x00, 0x59, 0x17, 
+0x24, 0x0a, 0xae, 0x00, 0x99, 0x0c, 0x95, 0x1b, 0xe1, 0x0b, 0x0f, 0x0b, 
+0x8c, 0x06, 0x05, 0x10, 0x69, 0x09, 0x7e, 0x00, 0x26, 0x12, 0x4a, 0x06, 
+0x9f, 0x0b, 0x6f, 0x0c, 0xbd, 0x1c, 0xe8, 0x17, 0x48, 0x06, 0x6f, 0x0f, 
+0x0f, 0x15, 0x14, 0x0b, 0x06, 0x14, 0x19, 0x00, 0x2e, 0x01, 0x2d, 0x0c, 
+0x79, 0x00, 0x67, 0x07, 0x15, 0x0b, 0xa7, 0x00, 0xc7, 0x0c, 0xfb, 0x14, 
+0x2b, 0x01, 0x0a, 0x01, 0x26, 0x12, 0x3e, 0x0d, 0x7f, 0x00, 0xd2, 0x0e, 
+0x83, 0x00, 0xfd, 0x09, 0xad, 0x00, 0x0b, 0x01, 0x4a, 0x0a, 0xff, 0x00, 
+0x1c, 0x01, 0xcb, 0x00, 0x6e, 0x10, 0x77, 0x00, 0xf9, 0x09, 0x77, 0x00, 
+0xc1, 0x00, 0x92, 0x10, 0x9f, 0x08, 0x28, 0x12, 0x6f, 0x00, 0x5e, 0x08, 
+0x9c, 0x08, 0xe4, 0x1a, 0x5e, 0x08, 0x4d, 0x00, 0x17, 0x0a, 0xbd, 0x14, 
+0xb2, 0x0a, 0x79, 0x0a, 0x1d, 0x0a, 0xbf, 0x00, 0xf6, 0x0c, 0x24, 0x00, 
+0xb5, 0x08, 0xf7, 0x00, 0x48, 0x00, 0x2f, 0x00, 0x72, 0x0c, 0x4f, 0x17, 
+0xa4, 0x0a, 0x12, 0x01, 0xf8, 0x09, 0xbf, 0x0b, 0xc4, 0x00, 0xee, 0x07, 
+0xf1, 0x09, 0xe0, 0x09, 0xae, 0x00, 0x58, 0x00, 0xcf, 0x00, 0x78, 0x08, 
+0x21, 0x01, 0xbc, 0x17, 0x88, 0x0b, 0xcf, 0x0b, 0x6c, 0x00, 0xd6, 0x00, 
+0x66, 0x13, 0x64, 0x07, 0xdb, 0x09, 0x64, 0x0a, 0x3f, 0x0b, 0x9f, 0x0b, 
+0xe9, 0x08, 0x94, 0x12, 0xdc, 0x06, 0x46, 0x06, 0x1d, 0x0c, 0x8a, 0x13, 
+0xc3, 0x0a, 0xc0, 0x14, 0x2d, 0x0c, 0xc7, 0x0c, 0x2c, 0x01, 0x18, 0x08, 
+0x34, 0x0a, 0x2d, 0x0a, 0xbf, 0x0c, 0xac, 0x0a, 0xc8, 0x09, 0x71, 0x17, 
+0x08, 0x01, 0x00, 0x01, 0x08, 0x01, 0x0a, 0x0a, 0x42, 0x12, 0xb0, 0x0a, 
+0xca, 0x0f, 0xae, 0x00, 0xab, 0x0b, 0xea, 0x00, 0x30, 0x0e, 0xc2, 0x00, 
+0xf8, 0x0c, 0x13, 0x0a, 0x36, 0x0a, 0xa1, 0x0c, 0xd6, 0x18, 0xd1, 0x18, 
+0xcf, 0x00, 0x3f, 0x07, 0xe1, 0x00, 0x11, 0x0b, 0x11, 0x07, 0xa7, 0x0c, 
+0x8a, 0x10, 0xeb, 0x13, 0x38, 0x01, 0x8c, 0x0b, 0x27, 0x00, 0x02, 0x13, 
+0x3c, 0x0c, 0xf5, 0x00, 0xf4, 0x0a, 0x5d, 0x07, 0xda, 0x10, 0x3e, 0x01, 
+0x3b, 0x00, 0xf4, 0x08, 0xa7, 0x09, 0x3a, 0x01, 0xca, 0x0b, 0x3b, 0x01, 
+0x7c, 0x00, 0x60, 0x0a, 0x5b, 0x0c, 0x4f, 0x15, 0x56, 0x0a, 0xa4, 0x09, 
+0x6a, 0x0b, 0xbc, 0x14, 0x83, 0x00, 0x61, 0x0c, 0xc7, 0x0c, 0xb4, 0x0a, 
+0x8d, 0x06, 0x17, 0x01, 0x4b, 0x06, 0x88, 0x17, 0xdc, 0x0b, 0x78, 0x00, 
+0xf2, 0x00, 0x28, 0x12, 0xc7, 0x0d, 0xa6, 0x06, 0x86, 0x17, 0x42, 0x06, 
+0x26, 0x00, 0xbf, 0x00, 0xb9, 0x00, 0x55, 0x0a, 0x06, 0x01, 0xfd, 0x00, 
+0x2a, 0x18, 0x9c, 0x00, 0x6e, 0x0a, 0xda, 0x0d, 0x0c, 0x0a, 0xd4, 0x00, 
+0xf2, 0x00, 0x2a, 0x00, 0x11, 0x01, 0x19, 0x00, 0x40, 0x0a, 0xf4, 0x06, 
+0x25, 0x00, 0x36, 0x09, 0xc8, 0x0c, 0x3d, 0x0b, 0x36, 0x07, 0xf1, 0x00, 
+0xde, 0x08, 0xc2, 0x00, 0x46, 0x0e, 0x67, 0x12, 0x28, 0x01, 0x32, 0x01, 
+0xb1, 0x0c, 0xb7, 0x00, 0x8a, 0x13, 0x52, 0x0b, 0x40, 0x00, 0xde, 0x10, 
+0xfc, 0x09, 0x87, 0x16, 0xf7, 0x00, 0x54, 0x0a, 0x29, 0x14, 0xbe, 0x14, 
+0xb2, 0x08, 0x18, 0x00, 0xde, 0x08, 0xc2, 0x09, 0x05, 0x0f, 0xef, 0x0e, 
+0x85, 0x0c, 0x32, 0x00, 0x4e, 0x0c, 0x27, 0x15, 0xb8, 0x0a, 0xc5, 0x09, 
+0x2f, 0x01, 0xb8, 0x0e, 0x46, 0x0d, 0x4e, 0x00, 0xa1, 0x15, 0xef, 0x0a, 
+0x12, 0x07, 0x24, 0x0c, 0x36, 0x0a, 0xaa, 0x16, 0x1c, 0x01, 0xa6, 0x0c, 
+0x85, 0x00, 0x61, 0x12, 0x69, 0x0c, 0x18, 0x01, 0xf6, 0x0b, 0x3e, 0x0a, 
+0xc5, 0x00, 0xd6, 0x16, 0x0c, 0x10, 0x69, 0x0e, 0x2a, 0x00, 0xf2, 0x00, 
+0x2e, 0x01, 0xf0, 0x0a, 0x23, 0x00, 0x20, 0x01, 0xf6, 0x00, 0x4b, 0x0c, 
+0x2b, 0x01, 0xf9, 0x0b, 0x53, 0x07, 0x5c, 0x1a, 0x2b, 0x09, 0x33, 0x01, 
+0x80, 0x0a, 0xe1, 0x00, 0x9d, 0x00, 0x41, 0x0a, 0xd6, 0x0b, 0x15, 0x16, 
+0xc2, 0x0a, 0x49, 0x0a, 0x44, 0x06, 0x27, 0x01, 0x20, 0x00, 0x7a, 0x10, 
+0x31, 0x0c, 0x05, 0x13, 0x5b, 0x08, 0x6f, 0x0f, 0xb4, 0x07, 0xe6, 0x0a, 
+0x8b, 0x0a, 0xf6, 0x0f, 0x7e, 0x0b, 0xbe, 0x09, 0x42, 0x06, 0xf4, 0x11, 
+0xbe, 0x1b, 0xea, 0x09, 0x86, 0x00, 0x63, 0x0d, 0xb1, 0x09, 0xa9, 0x0b, 
+0x48, 0x00, 0xc2, 0x0a, 0x91, 0x0c, 0xd2, 0x12, 0x69, 0x12, 0x7a, 0x00, 
+0xa4, 0x09, 0xfd, 0x08, 0x35, 0x0a, 0xcd, 0x00, 0xfd, 0x00, 0x39, 0x07, 
+0xbb, 0x12, 0x98, 0x0a, 0x71, 0x17, 0x5f, 0x00, 0x06, 0x01, 0xee, 0x0c, 
+0xe5, 0x0a, 0xa7, 0x0c, 0x1d, 0x01, 0x2f, 0x08, 0x78, 0x07, 0x89, 0x0d, 
+0x6c, 0x0a, 0x0f, 0x19, 0xc7, 0x09, 0xa9, 0x10, 0x7f, 0x0b, 0x46, 0x1c, 
+0xd1, 0x00, 0xf1, 0x0a, 0xd3, 0x0e, 0xab, 0x0b, 0xa7, 0x09, 0x35, 0x01, 
+0xd2, 0x0e, 0x0c, 0x0c, 0xd9, 0x0a, 0x46, 0x06, 0x47, 0x00, 0x97, 0x0a, 
+0xc5, 0x14, 0x01, 0x01, 0xab, 0x06, 0x7c, 0x19, 0x37, 0x0a, 0x1c, 0x07, 
+0x51, 0x14, 0x06, 0x09, 0xed, 0x0b, 0x47, 0x00, 0xcb, 0x00, 0x94, 0x00, 
+0xb3, 0x09, 0x61, 0x12, 0x79, 0x0a, 0x53, 0x0c, 0x2f, 0x00, 0xb4, 0x00, 
+0xf5, 0x18, 0x6d, 0x00, 0x02, 0x0e, 0x25, 0x0b, 0xad, 0x08, 0x75, 0x0a, 
+0x2d, 0x01, 0xc4, 0x11, 0x25, 0x0b, 0xb7, 0x00, 0xfb, 0x00, 0xf1, 0x0a, 
+0x47, 0x0d, 0x26, 0x0b, 0x53, 0x00, 0xff, 0x00, 0x70, 0x0f, 0x45, 0x06, 
+0x6b, 0x0e, 0x1e, 0x14, 0xfc, 0x00, 0x01, 0x01, 0x5d, 0x11, 0x4e, 0x0a, 
+0x21, 0x09, 0xa4, 0x0a, 0xe9, 0x09, 0x9e, 0x0b, 0x50, 0x0c, 0x2c, 0x0a, 
+0x3b, 0x0b, 0x97, 0x06, 0x7d, 0x0b, 0x3b, 0x01, 0x61, 0x17, 0x43, 0x15, 
+0xcb, 0x0b, 0xf7, 0x08, 0xfa, 0x00, 0xb4, 0x00, 0xf3, 0x0c, 0x8a, 0x0b, 
+0x5c, 0x14, 0xa3, 0x00, 0xea, 0x08, 0xfd, 0x00, 0x85, 0x0c, 0x3a, 0x01, 
+0x67, 0x0b, 0xdd, 0x0c, 0x06, 0x14, 0xf0, 0x00, 0x7a, 0x07, 0x3b, 0x13, 
+0x23, 0x00, 0x93, 0x11, 0xa6, 0x0a, 0x6e, 0x00, 0xe1, 0x00, 0x63, 0x09, 
+0xff, 0x00, 0x77, 0x00, 0x20, 0x09, 0x32, 0x00, 0x4a, 0x00, 0x95, 0x11, 
+0x54, 0x0a, 0xd7, 0x07, 0x70, 0x0c, 0xcb, 0x12, 0xe8, 0x13, 0x72, 0x16, 
+0x07, 0x1b, 0x00, 0x0d, 0x22, 0x01, 0x48, 0x00, 0x79, 0x00, 0x38, 0x0a, 
+0xef, 0x0a, 0x59, 0x0c, 0xaa, 0x0b, 0xbf, 0x00, 0xba, 0x0e, 0x35, 0x09, 
+0x20, 0x09, 0x6e, 0x10, 0x77, 0x0b, 0x1e, 0x01, 0x2c, 0x01, 0x70, 0x0c, 
+0x6c, 0x0b, 0xbb, 0x14, 0xbd, 0x00, 0xbd, 0x09, 0x03, 0x13, 0xfa, 0x14, 
+0xc1, 0x0e, 0x8b, 0x0b, 0xc5, 0x0e, 0x42, 0x00, 0xe1, 0x08, 0xd2, 0x09, 
+0x5c, 0x0b, 0xf3, 0x00, 0x15, 0x07, 0xe9, 0x08, 0xb8, 0x00, 0xee, 0x00, 
+0x44, 0x06, 0x42, 0x13, 0x9d, 0x00, 0xae, 0x10, 0xa6, 0x10, 0x30, 0x12, 
+0x36, 0x0d, 0x06, 0x0b, 0xf4, 0x18, 0x23, 0x01, 0xdf, 0x00, 0x6b, 0x00, 
+0xc0, 0x0b, 0xf0, 0x00, 0xc2, 0x00, 0xda, 0x06, 0x8f, 0x00, 0xdb, 0x00, 
+0xba, 0x00, 0x3e, 0x0a, 0xb5, 0x0b, 0x7e, 0x0b, 0x5a, 0x00, 0x23, 0x01, 
+0x78, 0x10, 0x98, 0x00, 0xdf, 0x00, 0xb4, 0x00, 0xd0, 0x00, 0x10, 0x01, 
+0x0b, 0x01, 0x1a, 0x08, 0x19, 0x01, 0x3b, 0x12, 0xdc, 0x0a, 0x27, 0x0b, 
+0x55, 0x06, 0xc1, 0x00, 0xb1, 0x0c, 0xb7, 0x0a, 0x48, 0x00, 0xc7, 0x16, 
+0x54, 0x0a, 0xe8, 0x09, 0x47, 0x00, 0xfc, 0x00, 0xb0, 0x00, 0x33, 0x0a, 
+0x00, 0x14, 0x6b, 0x0a, 0x4b, 0x0a, 0xb2, 0x09, 0xee, 0x00, 0x9b, 0x07, 
+0xfd, 0x07, 0x17, 0x0c, 0xd2, 0x00, 0xad, 0x0f, 0x4a, 0x00, 0xb8, 0x00, 
+0xdd, 0x09, 0x35, 0x01, 0xbf, 0x00, 0xcb, 0x0e, 0x4e, 0x0a, 0x26, 0x00, 
+0xc7, 0x09, 0xda, 0x0d, 0x00, 0x01, 0x74, 0x0b, 0xba, 0x00, 0xa0, 0x07, 
+0xb4, 0x00, 0x88, 0x0a, 0xc5, 0x00, 0x92, 0x12, 0x6c, 0x0a, 0x0b, 0x0a, 
+0xa1, 0x0b, 0x40, 0x06, 0xd6, 0x0f, 0x2d, 0x18, 0x51, 0x14, 0x56, 0x0b, 
+0x22, 0x01, 0x29, 0x0a, 0x6e, 0x10, 0x4b, 0x11, 0x4d, 0x0e, 0x52, 0x0b, 
+0x2c, 0x01, 0x1f, 0x1a, 0xd1, 0x15, 0x31, 0x00, 0xf9, 0x0b, 0x0c, 0x00, 
+0x08, 0x0d, 0xe1, 0x00, 0x5e, 0x12, 0xd9, 0x00, 0x23, 0x0c, 0xec, 0x00, 
+0xca, 0x0c, 0x13, 0x01, 0x44, 0x06, 0x03, 0x01, 0x4f, 0x0c, 0xb3, 0x0a, 
+0xe6, 0x15, 0x5d, 0x0c, 0x75, 0x13, 0x98, 0x07, 0xea, 0x00, 0xad, 0x00, 
+0xc9, 0x0f, 0xb1, 0x15, 0xf8, 0x00, 0x59, 0x0a, 0x20, 0x0a, 0xdf, 0x13, 
+0x6f, 0x0a, 0xb5, 0x0b, 0x1a, 0x0b, 0xe1, 0x08, 0xeb, 0x08, 0xaa, 0x06, 
+0xc0, 0x0e, 0x1c, 0x0f, 0x1d, 0x0a, 0x72, 0x16, 0x2c, 0x09, 0x38, 0x01, 
+0xab, 0x00, 0x06, 0x0b, 0x6d, 0x0c, 0xd1, 0x00, 0x22, 0x01, 0xcb, 0x00, 
+0x18, 0x01, 0xaa, 0x08, 0xf3, 0x00, 0xb6, 0x0c, 0x1d, 0x10, 0xc1, 0x0e, 
+0xcb, 0x00, 0x79, 0x09, 0x5e, 0x12, 0xcd, 0x0f, 0x74, 0x0b, 0xd6, 0x00, 
+0x23, 0x00, 0x82, 0x0b, 0xbf, 0x0b, 0x3a, 0x08, 0xaa, 0x0f, 0xce, 0x00, 
+0xc3, 0x00, 0xa9, 0x08, 0x76, 0x0a, 0xf3, 0x0c, 0x95, 0x07, 0xc8, 0x00, 
+0x03, 0x0e, 0x5f, 0x08, 0x8c, 0x06, 0x24, 0x00, 0x54, 0x16, 0x36, 0x07, 
+0x67, 0x07, 0x09, 0x01, 0x0e, 0x01, 0x82, 0x10, 0x1f, 0x0a, 0x50, 0x10, 
+0xe7, 0x00, 0x51, 0x0c, 0xfb, 0x13, 0x11, 0x00, 0x94, 0x00, 0xbf, 0x0b, 
+0x9c, 0x08, 0xa4, 0x09, 0x77, 0x09, 0x79, 0x00, 0x72, 0x0b, 0x8f, 0x00, 
+0xd7, 0x07, 0xd2, 0x00, 0xed, 0x00, 0x66, 0x08, 0xdb, 0x09, 0xfe, 0x00, 
+0x36, 0x0a, 0x7f, 0x0b, 0x79, 0x00, 0xd7, 0x0b, 0xd7, 0x00, 0x36, 0x09, 
+0x86, 0x17, 0x60, 0x0a, 0xd2, 0x09, 0xf2, 0x18, 0x37, 0x0d, 0xb2, 0x0c, 
+0x15, 0x01, 0x1f, 0x15, 0xdd, 0x0c, 0x89, 0x17, 0x1a, 0x00, 0x4d, 0x00, 
+0xca, 0x0f, 0x9d, 0x00, 0xad, 0x13, 0x23, 0x08, 0x3d, 0x0b, 0x3c, 0x01, 
+0x0f, 0x01, 0xa0, 0x07, 0x1f, 0x01, 0xa2, 0x0a, 0xdd, 0x0c, 0xca, 0x0b, 
+0x4b, 0x00, 0x25, 0x0b, 0x73, 0x16, 0xa5, 0x10, 0x71, 0x0d, 0xa0, 0x00, 
+0x30, 0x00, 0x0c, 0x01, 0xb9, 0x06, 0x70, 0x10, 0x1c, 0x00, 0x3f, 0x01, 
+0x09, 0x01, 0xd8, 0x15, 0xdc, 0x00, 0x32, 0x01, 0x0f, 0x1c, 0x31, 0x12, 
+0xf3, 0x00, 0x82, 0x10, 0xf7, 0x0b, 0x0f, 0x0c, 0xaa, 0x00, 0xd2, 0x0f, 
+0x4e, 0x0e, 0xf8, 0x00, 0x59, 0x0a, 0x24, 0x01, 0x19, 0x08, 0x30, 0x13, 
+0xba, 0x08, 0x69, 0x0b, 0xcd, 0x0a, 0xa9, 0x10, 0xc8, 0x00, 0x31, 0x00, 
+0xd5, 0x0c, 0x33, 0x0a, 0xd2, 0x0e, 0x41, 0x06, 0xf3, 0x0c, 0x97, 0x17, 
+0x90, 0x09, 0xf1, 0x14, 0x5b, 0x17, 0x94, 0x07, 0xcd, 0x14, 0xf5, 0x07, 
+0x70, 0x07, 0x90, 0x10, 0xb6, 0x0e, 0x5f, 0x06, 0x1b, 0x0f, 0x77, 0x0a, 
+0xca, 0x0c, 0xf5, 0x07, 0xda, 0x00, 0x95, 0x0b, 0xe2, 0x0b, 0x0c, 0x00, 
+0xe3, 0x00, 0x60, 0x0a, 0x36, 0x0a, 0xa3, 0x00, 0x97, 0x15, 0x65, 0x09, 
+0x25, 0x0f, 0x72, 0x07, 0x5d, 0x0b, 0x5f, 0x12, 0x89, 0x0b, 0x8c, 0x18, 
+0xc7, 0x0c, 0x67, 0x0b, 0x5e, 0x0b, 0x35, 0x0b, 0x6f, 0x10, 0x8f, 0x00, 
+0xfc, 0x00, 0x6c, 0x0b, 0xad, 0x00, 0xa9, 0x00, 0x3f, 0x01, 0xf7, 0x00, 
+0x02, 0x0e, 0x94, 0x0b, 0xfe, 0x09, 0x18, 0x00, 0xb9, 0x00, 0x11, 0x0b, 
+0x33, 0x01, 0xe2, 0x07, 0xe4, 0x0a, 0xac, 0x0c, 0xec, 0x0c, 0xde, 0x0d, 
+0x41, 0x01, 0x2e, 0x07, 0xeb, 0x00, 0xbc, 0x00, 0x85, 0x00, 0xe9, 0x0b, 
+0x74, 0x0b, 0xaa, 0x00, 0x46, 0x0d, 0x85, 0x16, 0x65, 0x08, 0xa9, 0x0c, 
+0x48, 0x00, 0x8f, 0x00, 0x97, 0x0b, 0xa3, 0x06, 0x34, 0x0f, 0xf7, 0x00, 
+0x54, 0x11, 0x0c, 0x00, 0x8f, 0x00, 0xb6, 0x1a, 0x4d, 0x0d, 0x40, 0x0d, 
+0x7c, 0x16, 0xd2, 0x0c, 0x0f, 0x0b, 0x8e, 0x0d, 0x4c, 0x0a, 0x24, 0x09, 
+0x03, 0x13, 0x18, 0x08, 0x11, 0x01, 0x08, 0x01, 0x24, 0x0e, 0x1e, 0x13, 
+0xba, 0x16, 0xcf, 0x18, 0x59, 0x0e, 0x40, 0x06, 0xe8, 0x1a, 0xc5, 0x00, 
+0xec, 0x09, 0x19, 0x0c, 0xa2, 0x07, 0x2b, 0x01, 0xae, 0x00, 0x21, 0x0d, 
+0x6b, 0x1a, 0x72, 0x0b, 0x8d, 0x16, 0xff, 0x08, 0x1c, 0x07, 0x62, 0x00, 
+0x98, 0x0b, 0x78, 0x0a, 0x68, 0x13, 0xe1, 0x0b, 0x56, 0x11, 0xcc, 0x08, 
+0x7f, 0x07, 0x08, 0x0b, 0xfe, 0x07, 0x95, 0x0b, 0xe5, 0x00, 0x19, 0x01, 
+0xc1, 0x00, 0xd6, 0x14, 0x04, 0x01, 0xaa, 0x00, 0x91, 0x1b, 0x54, 0x15, 
+0x68, 0x0b, 0x60, 0x1b, 0x65, 0x08, 0x80, 0x0a, 0x57, 0x0a, 0x7a, 0x00, 
+0x72, 0x00, 0x44, 0x06, 0xe5, 0x15, 0x78, 0x16, 0x61, 0x0f, 0x34, 0x01, 
+0xcc, 0x00, 0xda, 0x06, 0xe9, 0x00, 0xd8, 0x00, 0x55, 0x0a, 0x32, 0x07, 
+0xb6, 0x17, 0x06, 0x13, 0x68, 0x0c, 0xa5, 0x09, 0x12, 0x07, 0x61, 0x07, 
+0x00, 0x10, 0xc2, 0x00, 0xcd, 0x14, 0x86, 0x0c, 0xc3, 0x07, 0xf8, 0x00, 
+0xeb, 0x00, 0x95, 0x16, 0x3c, 0x0b, 0x48, 0x06, 0x8c, 0x11, 0xd8, 0x0a, 
+0x78, 0x0d, 0x06, 0x01, 0x39, 0x0c, 0xe2, 0x0b, 0xf5, 0x07, 0x0d, 0x01, 
+0xd4, 0x00, 0xf1, 0x00, 0x5a, 0x08, 0x67, 0x0b, 0xa9, 0x16, 0xd1, 0x0e, 
+0x95, 0x0f, 0x7b, 0x0c, 0x19, 0x08, 0xee, 0x00, 0xf2, 0x14, 0xd6, 0x18, 
+0x48, 0x00, 0x90, 0x00, 0xda, 0x10, 0xef, 0x0a, 0xa1, 0x0f, 0xa4, 0x12, 
+0x9d, 0x08, 0xa0, 0x07, 0xb6, 0x10, 0xae, 0x00, 0x17, 0x14, 0xf6, 0x09, 
+0x55, 0x06, 0xed, 0x00, 0xd6, 0x00, 0xcc, 0x00, 0x06, 0x01, 0xf8, 0x00, 
+0x48, 0x0b, 0xf8, 0x08, 0xae, 0x00, 0x53, 0x0b, 0xfe, 0x00, 0xc1, 0x0e, 
+0x5e, 0x0b, 0x97, 0x07, 0x45, 0x06, 0x09, 0x0a, 0x17, 0x00, 0x81, 0x0b, 
+0xb9, 0x00, 0xaf, 0x10, 0x3e, 0x01, 0x11, 0x0d, 0xa6, 0x09, 0xd7, 0x16, 
+0x69, 0x0c, 0x3a, 0x01, 0x60, 0x1b, 0xc0, 0x0b, 0x27, 0x00, 0xca, 0x00, 
+0x04, 0x09, 0x00, 0x01, 0x21, 0x01, 0x45, 0x06, 0x43, 0x0a, 0x16, 0x0a, 
+0xf3, 0x14, 0x9d, 0x08, 0x64, 0x00, 0x7a, 0x00, 0xb1, 0x00, 0x5b, 0x0c, 
+0xe8, 0x13, 0x2f, 0x01, 0x9e, 0x0c, 0xdb, 0x10, 0xeb, 0x00, 0x0c, 0x00, 
+0xf7, 0x0e, 0x0e, 0x01, 0xa9, 0x07, 0x01, 0x0a, 0xef, 0x0a, 0x6c, 0x0e, 
+0xf4, 0x08, 0x54, 0x00, 0xd2, 0x0e, 0xed, 0x0b, 0xcb, 0x00, 0x3a, 0x18, 
+0x9d, 0x17, 0x48, 0x0b, 0x3e, 0x0b, 0x02, 0x01, 0xb9, 0x0a, 0x26, 0x12, 
+0x7b, 0x0c, 0x65, 0x18, 0xc0, 0x00, 0xbe, 0x00, 0x1b, 0x07, 0x2d, 0x01, 
+0x00, 0x01, 0x4a, 0x06, 0x63, 0x00, 0xce, 0x15, 0xce, 0x0b, 0x6b, 0x00, 
+0x9f, 0x00, 0x52, 0x07, 0xc8, 0x09, 0xcd, 0x0a, 0xf8, 0x0e, 0x42, 0x15, 
+0x8a, 0x07, 0x62, 0x00, 0xce, 0x15, 0x8b, 0x00, 0xb2, 0x0c, 0xb6, 0x0b, 
+0x2f, 0x0c, 0xa9, 0x09, 0x1b, 0x0b, 0xc7, 0x00, 0x9d, 0x00, 0x7b, 0x0c, 
+0x3c, 0x00, 0xe1, 0x0b, 0x1d, 0x08, 0x99, 0x07, 0x2b, 0x01, 0x05, 0x01, 
+0x85, 0x00, 0x51, 0x17, 0x7b, 0x0c, 0xd0, 0x00, 0x49, 0x06, 0x2c, 0x01, 
+0x4e, 0x0c, 0xae, 0x0a, 0x2e, 0x01, 0x70, 0x0c, 0x32, 0x12, 0xfb, 0x0a, 
+0xc9, 0x0c, 0xa3, 0x0a, 0x10, 0x00, 0xaa, 0x00, 0x08, 0x0a, 0x7d, 0x18, 
+0x91, 0x00, 0x35, 0x0b, 0x8e, 0x0a, 0x7b, 0x09, 0xf1, 0x14, 0x49, 0x0b, 
+0x43, 0x0b, 0xfa, 0x0a, 0xf8, 0x00, 0x8b, 0x0a, 0x8a, 0x00, 0xb2, 0x06, 
+0x3a, 0x0c, 0xe1, 0x0f, 0xa0, 0x0b, 0x88, 0x0d, 0x3a, 0x16, 0x41, 0x18, 
+0x34, 0x12, 0x46, 0x06, 0xac, 0x09, 0xba, 0x09, 0x2e, 0x01, 0x27, 0x15, 
+0x1d, 0x0b, 0xd0, 0x00, 0x41, 0x0d, 0x40, 0x00, 0x0b, 0x00, 0x70, 0x0a, 
+0xf6, 0x00, 0x69, 0x17, 0x1c, 0x01, 0x96, 0x18, 0x1d, 0x01, 0x1a, 0x0c, 
+0x7b, 0x18, 0xe2, 0x00, 0x45, 0x06, 0x3e, 0x0a, 0xe5, 0x15, 0x1e, 0x01, 
+0xe7, 0x09, 0xaa, 0x0c, 0x38, 0x0c, 0x48, 0x06, 0x3b, 0x12, 0x20, 0x01, 
+0xb2, 0x00, 0x2f, 0x0f, 0x31, 0x01, 0x96, 0x0a, 0x70, 0x08, 0xba, 0x0a, 
+0x6f, 0x13, 0x29, 0x0a, 0x22, 0x0e, 0x70, 0x0c, 0x1b, 0x01, 0x8d, 0x08, 
+0x3d, 0x13, 0x99, 0x11, 0x7f, 0x0c, 0xba, 0x15, 0x22, 0x0c, 0x3b, 0x01, 
+0x42, 0x01, 0xdf, 0x0c, 0x28, 0x0a, 0x39, 0x0a, 0xfe, 0x0f, 0xb2, 0x17, 
+0x8c, 0x06, 0x86, 0x16, 0xd6, 0x16, 0xb8, 0x00, 0xbf, 0x0b, 0x5d, 0x00, 
+0x1e, 0x0b, 0x9e, 0x00, 0x7e, 0x0b, 0x01, 0x0d, 0x63, 0x0a, 0x05, 0x13, 
+0x86, 0x17, 0xb8, 0x0e, 0xc2, 0x0a, 0x03, 0x01, 0xab, 0x09, 0xbf, 0x00, 
+0x21, 0x15, 0x6d, 0x00, 0x91, 0x13, 0x37, 0x00, 0x03, 0x16, 0x9f, 0x00, 
+0xcf, 0x00, 0xeb, 0x0c, 0xce, 0x14, 0x3b, 0x01, 0x48, 0x12, 0x16, 0x14, 
+0x35, 0x01, 0x22, 0x09, 0xaf, 0x0a, 0xf2, 0x00, 0xb6, 0x00, 0x9d, 0x0f, 
+0xda, 0x16, 0xf7, 0x09, 0x93, 0x0f, 0x23, 0x0b, 0xc9, 0x10, 0x43, 0x14, 
+0x4b, 0x06, 0x62, 0x12, 0xf5, 0x19, 0x07, 0x01, 0xb4, 0x00, 0x77, 0x0b, 
+0x5c, 0x17, 0xb8, 0x0b, 0x58, 0x0a, 0x54, 0x0a, 0x98, 0x06, 0x96, 0x0b, 
+0xdc, 0x09, 0x36, 0x10, 0x29, 0x01, 0x82, 0x0b, 0x0b, 0x00, 0x44, 0x0a, 
+0x48, 0x00, 0xd9, 0x00, 0xe5, 0x0a, 0x4c, 0x0b, 0xcd, 0x15, 0xee, 0x00, 
+0x08, 0x0d, 0xaa, 0x16, 0xd9, 0x06, 0xf4, 0x00, 0x7c, 0x00, 0xf2, 0x09, 
+0xd1, 0x09, 0x73, 0x0b, 0xce, 0x06, 0x66, 0x0c, 0x8f, 0x00, 0xd3, 0x09, 
+0x6f, 0x08, 0x86, 0x0c, 0x8f, 0x00, 0x1b, 0x0c, 0x60, 0x11, 0x6d, 0x0a, 
+0x1b, 0x0f, 0xf2, 0x18, 0x71, 0x0c, 0xa3, 0x06, 0x3c, 0x01, 0x96, 0x15, 
+0xb6, 0x00, 0x50, 0x06, 0xdb, 0x0d, 0x39, 0x0c, 0xa6, 0x10, 0xfb, 0x0b, 
+0x4d, 0x06, 0x5e, 0x08, 0x10, 0x07, 0x1e, 0x01, 0x69, 0x07, 0x2c, 0x17, 
+0xe4, 0x0a, 0x84, 0x0b, 0xd5, 0x00, 0xda, 0x0a, 0x1a, 0x0e, 0x2d, 0x01, 
+0xb2, 0x17, 0xb5, 0x00, 0x08, 0x12, 0x1b, 0x0f, 0xc7, 0x00, 0x84, 0x00, 
+0x2f, 0x00, 0xac, 0x06, 0x81, 0x08, 0xb5, 0x0c, 0x01, 0x14, 0x88, 0x0c, 
+0x20, 0x15, 0xa6, 0x09, 0xb2, 0x0c, 0xe3, 0x0a, 0xba, 0x09, 0xa2, 0x06, 
+0x30, 0x0b, 0xd7, 0x0b, 0xad, 0x0a, 0x98, 0x15, 0xb9, 0x0a, 0x4e, 0x06, 
+0x17, 0x00, 0x57, 0x07, 0x4d, 0x06, 0x8f, 0x00, 0x86, 0x19, 0x15, 0x15, 
+0x66, 0x0a, 0xb6, 0x0e, 0xe9, 0x00, 0x4f, 0x0a, 0xfe, 0x08, 0x8e, 0x0b, 
+0xa6, 0x09, 0xf1, 0x09, 0x6e, 0x00, 0x11, 0x0e, 0xf6, 0x00, 0x1b, 0x00, 
+0x4e, 0x09, 0x95, 0x0b, 0x30, 0x01, 0x49, 0x00, 0xaa, 0x1a, 0xac, 0x09, 
+0xec, 0x0b, 0x61, 0x06, 0x84, 0x00, 0x75, 0x16, 0xe8, 0x00, 0x5b, 0x0c, 
+0xf7, 0x0c, 0x4b, 0x11, 0x75, 0x0a, 0x0b, 0x00, 0x06, 0x01, 0x8f, 0x06, 
+0x44, 0x06, 0x9b, 0x00, 0xdd, 0x17, 0x0c, 0x01, 0xbc, 0x14, 0xeb, 0x07, 
+0xb5, 0x08, 0x0d, 0x13, 0x30, 0x0b, 0x4e, 0x0b, 0x75, 0x0a, 0x02, 0x0e, 
+0x65, 0x08, 0xef, 0x0a, 0xd9, 0x0b, 0xcd, 0x0a, 0x26, 0x12, 0x61, 0x0a, 
+0xd8, 0x00, 0x2c, 0x01, 0xe9, 0x09, 0x12, 0x01, 0x1c, 0x0b, 0x75, 0x0a, 
+0xec, 0x00, 0x62, 0x0a, 0xb0, 0x0a, 0x10, 0x01, 0x8a, 0x12, 0x7a, 0x0a, 
+0xe0, 0x00, 0xd3, 0x00, 0xdb, 0x00, 0x40, 0x09, 0xa3, 0x0a, 0xb4, 0x00, 
+0x1c, 0x01, 0x49, 0x06, 0x16, 0x0b, 0x2a, 0x0c, 0x54, 0x0d, 0x7d, 0x16, 
+0x77, 0x11, 0x22, 0x0c, 0x6c, 0x00, 0x28, 0x0a, 0xbd, 0x0c, 0x01, 0x01, 
+0xbb, 0x07, 0xa4, 0x0a, 0xaa, 0x08, 0x08, 0x0a, 0xf4, 0x0a, 0x05, 0x0c, 
+0x44, 0x06, 0x9c, 0x00, 0x35, 0x0a, 0x7f, 0x0b, 0x30, 0x00, 0xbf, 0x00, 
+0xfc, 0x08, 0x26, 0x00, 0xbd, 0x10, 0xe7, 0x10, 0x1f, 0x0b, 0x85, 0x0c, 
+0x07, 0x0a, 0x9e, 0x00, 0xc7, 0x00, 0xc3, 0x0a, 0x6a, 0x0a, 0x29, 0x0a, 
+0xbc, 0x14, 0xb1, 0x15, 0xb4, 0x0f, 0x4f, 0x0c, 0x2f, 0x01, 0x13, 0x0a, 
+0xc3, 0x00, 0x06, 0x14, 0xd9, 0x0b, 0x29, 0x01, 0xa9, 0x00, 0x64, 0x00, 
+0x2e, 0x0c, 0x0b, 0x00, 0x35, 0x09, 0xbb, 0x09, 0x3b, 0x01, 0x44, 0x06, 
+0x0d, 0x01, 0xe1, 0x0b, 0xa1, 0x07, 0x3f, 0x0e, 0xc8, 0x00, 0xfa, 0x1b, 
+0x95, 0x00, 0xc3, 0x00, 0x99, 0x15, 0xcf, 0x00, 0x92, 0x0a, 0x07, 0x01, 
+0x61, 0x00, 0xac, 0x00, 0x1b, 0x0d, 0x1b, 0x01, 0xe5, 0x00, 0x62, 0x19, 
+0x7d, 0x06, 0x9a, 0x0a, 0x78, 0x10, 0x3b, 0x01, 0x0f, 0x0b, 0xe4, 0x12, 
+0x1f, 0x14, 0xce, 0x0b, 0x62, 0x0a, 0x92, 0x0f, 0xf5, 0x08, 0xd4, 0x06, 
+0xcc, 0x09, 0x38, 0x01, 0xe3, 0x06, 0x16, 0x01, 0xde, 0x08, 0xb4, 0x00, 
+0xd8, 0x00, 0xf1, 0x14, 0xdd, 0x14, 0xc7, 0x0c, 0x10, 0x0c, 0xb2, 0x09, 
+0xd1, 0x0b, 0x09, 0x01, 0xa7, 0x08, 0xae, 0x07, 0x4b, 0x11, 0xde, 0x08, 
+0x3e, 0x0a, 0x3e, 0x01, 0xb6, 0x10, 0x92, 0x00, 0xa7, 0x00, 0xa2, 0x15, 
+0xc6, 0x09, 0x96, 0x07, 0xcf, 0x18, 0xd0, 0x00, 0x83, 0x14, 0xae, 0x00, 
+0x5f, 0x12, 0xa5, 0x11, 0x97, 0x06, 0xee, 0x0c, 0x2f, 0x01, 0x3c, 0x00, 
+0x96, 0x15, 0x7c, 0x16, 0x0c, 0x01, 0xd7, 0x16, 0xb6, 0x00, 0x1a, 0x00, 
+0x61, 0x0b, 0xe2, 0x00, 0x71, 0x09, 0xcd, 0x00, 0x6f, 0x0c, 0x2a, 0x0a, 
+0x2f, 0x01, 0xc0, 0x00, 0xd1, 0x09, 0xfd, 0x00, 0x17, 0x17, 0x5e, 0x0b, 
+0x02, 0x01, 0xdc, 0x0f, 0xc1, 0x16, 0x92, 0x00, 0xa9, 0x0b, 0x20, 0x09, 
+0xc3, 0x00, 0x62, 0x00, 0x15, 0x0a, 0xb3, 0x09, 0xf6, 0x0b, 0x22, 0x09, 
+0x7f, 0x07, 0x41, 0x18, 0x85, 0x00, 0x2d, 0x01, 0xfb, 0x00, 0x86, 0x0c, 
+0xce, 0x06, 0x30, 0x01, 0x02, 0x16, 0x98, 0x07, 0xfb, 0x00, 0xea, 0x0c, 
+0xf2, 0x00, 0x0e, 0x01, 0x33, 0x0a, 0x26, 0x00, 0xa1, 0x0a, 0xb6, 0x0c, 
+0x7e, 0x16, 0x11, 0x0e, 0x55, 0x00, 0x15, 0x07, 0x5e, 0x0b, 0x70, 0x0e, 
+0x41, 0x01, 0x42, 0x01, 0xbb, 0x08, 0xc7, 0x09, 0x30, 0x0b, 0x58, 0x0a, 
+0x8e, 0x06, 0x39, 0x0a, 0xa7, 0x00, 0xdf, 0x0e, 0xe6, 0x0a, 0x17, 0x14, 
+0xd2, 0x09, 0x20, 0x01, 0xb3, 0x09, 0x0a, 0x01, 0x4a, 0x00, 0xcf, 0x15, 
+0x63, 0x0d, 0x11, 0x01, 0xd7, 0x0c, 0xa7, 0x00, 0x4c, 0x0a, 0x86, 0x00, 
+0x6e, 0x12, 0x9a, 0x15, 0x93, 0x0b, 0xb1, 0x08, 0xbf, 0x09, 0x0b, 0x14, 
+0x2d, 0x16, 0x4d, 0x0a, 0x04, 0x0b, 0x0b, 0x00, 0xc2, 0x07, 0xe5, 0x00, 
+0xe3, 0x08, 0xfa, 0x0a, 0x11, 0x00, 0x11, 0x01, 0xca, 0x0b, 0x0d, 0x0c, 
+0xd4, 0x00, 0xe7, 0x00, 0x6a, 0x0a, 0x3d, 0x0c, 0xc2, 0x00, 0xc1, 0x00, 
+0xa3, 0x06, 0x3e, 0x00, 0x9c, 0x0a, 0x05, 0x13, 0x07, 0x01, 0xd4, 0x0c, 
+0x83, 0x00, 0x18, 0x01, 0xc6, 0x09, 0x5f, 0x00, 0x01, 0x01, 0x61, 0x0a, 
+0x49, 0x00, 0x0f, 0x01, 0xec, 0x10, 0x44, 0x06, 0x84, 0x0a, 0x62, 0x0a, 
+0xa3, 0x06, 0xd3, 0x00, 0x3b, 0x00, 0x41, 0x12, 0x1b, 0x07, 0x57, 0x18, 
+0x3c, 0x00, 0xfc, 0x0b, 0xa8, 0x09, 0x76, 0x0b, 0x7c, 0x16, 0xc9, 0x00, 
+0x5c, 0x0b, 0xb6, 0x0f, 0x5c, 0x1a, 0xc0, 0x0c, 0xb6, 0x00, 0xb6, 0x0c, 
+0x2a, 0x01, 0xd9, 0x0b, 0x19, 0x0c, 0xfe, 0x00, 0xf4, 0x11, 0xd6, 0x00, 
+0x03, 0x0c, 0x6a, 0x0c, 0x27, 0x12, 0xf9, 0x0a, 0xe4, 0x0a, 0xf3, 0x0c, 
+0x3a, 0x18, 0x11, 0x0e, 0xfd, 0x00, 0xee, 0x07, 0xb3, 0x00, 0xd6, 0x16, 
+0x9c, 0x00, 0x56, 0x00, 0xc6, 0x00, 0xed, 0x0d, 0x7d, 0x0b, 0xd6, 0x16, 
+0x71, 0x06, 0xbc, 0x0d, 0x1a, 0x01, 0x21, 0x07, 0x88, 0x00, 0xe3, 0x06, 
+0xd9, 0x07, 0x8c, 0x11, 0xf7, 0x00, 0x3b, 0x13, 0xaa, 0x07, 0xa7, 0x00, 
+0xac, 0x00, 0x46, 0x0e, 0x8f, 0x17, 0x78, 0x0a, 0xa9, 0x0f, 0x72, 0x07, 
+0x82, 0x09, 0x46, 0x06, 0x80, 0x00, 0x5b, 0x0c, 0x8e, 0x16, 0x98, 0x1a, 
+0x34, 0x01, 0x8c, 0x06, 0x2c, 0x01, 0x2f, 0x0c, 0x31, 0x01, 0x67, 0x08, 
+0x13, 0x0b, 0x02, 0x01, 0x78, 0x09, 0x2a, 0x12, 0x25, 0x0c, 0x54, 0x0a, 
+0x16, 0x0a, 0xba, 0x09, 0x77, 0x00, 0x3f, 0x0c, 0xab, 0x09, 0x9b, 0x00, 
+0x51, 0x0b, 0xa5, 0x0f, 0xe1, 0x0b, 0xcb, 0x0b, 0x58, 0x06, 0x76, 0x12, 
+0x9b, 0x00, 0x71, 0x07, 0x43, 0x0c, 0xc4, 0x0c, 0x35, 0x01, 0xf1, 0x13, 
+0x67, 0x13, 0xc0, 0x0b, 0x76, 0x0a, 0xb0, 0x11, 0x49, 0x06, 0x66, 0x08, 
+0xdd, 0x18, 0x3b, 0x00, 0x86, 0x16, 0xed, 0x00, 0x29, 0x0b, 0xb0, 0x09, 
+0xf0, 0x00, 0x07, 0x0c, 0x1b, 0x0b, 0xf7, 0x06, 0x11, 0x01, 0x05, 0x01, 
+0xe4, 0x08, 0x3b, 0x0a, 0x2d, 0x0c, 0x36, 0x0a, 0x41, 0x01, 0xbc, 0x09, 
+0xce, 0x0a, 0x6a, 0x0b, 0x8e, 0x17, 0x8f, 0x16, 0x1d, 0x01, 0x8a, 0x00, 
+0x34, 0x01, 0xa4, 0x0b, 0xae, 0x12, 0x8f, 0x00, 0x99, 0x11, 0x02, 0x13, 
+0x2b, 0x01, 0x47, 0x06, 0xbc, 0x00, 0x19, 0x01, 0x05, 0x0c, 0x27, 0x01, 
+0x5c, 0x08, 0x08, 0x01, 0x76, 0x0a, 0x1b, 0x07, 0x53, 0x00, 0x24, 0x0c, 
+0x4b, 0x06, 0x00, 0x10, 0xec, 0x07, 0x5f, 0x00, 0x71, 0x09, 0xbb, 0x0e, 
+0x6f, 0x12, 0x12, 0x0e, 0xd1, 0x09, 0x49, 0x06, 0xfe, 0x00, 0x9e, 0x0b, 
+0x79, 0x06, 0xba, 0x09, 0xa0, 0x0b, 0xd5, 0x0b, 0x5d, 0x12, 0x72, 0x0b, 
+0xb2, 0x00, 0x60, 0x00, 0xf8, 0x1a, 0x23, 0x08, 0x56, 0x18, 0x50, 0x0c, 
+0xe7, 0x0d, 0xb0, 0x00, 0x28, 0x0b, 0x65, 0x08, 0xea, 0x0c, 0x2f, 0x01, 
+0x99, 0x0a, 0xe0, 0x13, 0xc1, 0x00, 0x43, 0x13, 0x0f, 0x00, 0x7d, 0x0c, 
+0xcf, 0x00, 0xe0, 0x15, 0xe5, 0x00, 0x52, 0x07, 0x14, 0x01, 0x02, 0x13, 
+0x26, 0x0c, 0x32, 0x00, 0x5f, 0x00, 0x5f, 0x08, 0xdc, 0x0a, 0xed, 0x00, 
+0x9d, 0x0c, 0x3a, 0x01, 0xcd, 0x14, 0xaf, 0x12, 0x3e, 0x01, 0x6a, 0x07, 
+0x63, 0x0b, 0xc5, 0x09, 0xaf, 0x09, 0xa9, 0x00, 0x14, 0x0e, 0xe4, 0x00, 
+0x20, 0x01, 0xb0, 0x09, 0x26, 0x12, 0x2e, 0x0a, 0xda, 0x00, 0x10, 0x0b, 
+0x2f, 0x07, 0x42, 0x01, 0xc4, 0x00, 0xb3, 0x07, 0xb6, 0x00, 0x06, 0x01, 
+0x42, 0x0b, 0x3d, 0x01, 0xea, 0x08, 0xbe, 0x0c, 0x04, 0x09, 0xaf, 0x10, 
+0x52, 0x07, 0x2e, 0x15, 0x27, 0x15, 0x31, 0x00, 0xe9, 0x00, 0x94, 0x07, 
+0x3b, 0x13, 0x7d, 0x06, 0xb5, 0x0b, 0x17, 0x00, 0xad, 0x00, 0x9e, 0x00, 
+0xd8, 0x09, 0x4d, 0x11, 0xab, 0x16, 0x0d, 0x01, 0xc9, 0x08, 0x80, 0x0a, 
+0xac, 0x07, 0x23, 0x0a, 0x5c, 0x0b, 0x96, 0x0c, 0x31, 0x00, 0xb4, 0x08, 
+0xf3, 0x00, 0x11, 0x01, 0xf8, 0x0b, 0x1c, 0x0b, 0x30, 0x00, 0xce, 0x06, 
+0x6e, 0x0a, 0xa7, 0x09, 0x47, 0x06, 0xcc, 0x0b, 0x2e, 0x01, 0x27, 0x01, 
+0x3a, 0x13, 0xd6, 0x09, 0x6c, 0x00, 0x4a, 0x14, 0x57, 0x0a, 0x43, 0x06, 
+0xdc, 0x0a, 0x12, 0x0c, 0xe9, 0x0c, 0x9c, 0x00, 0x1c, 0x0b, 0x85, 0x0a, 
+0x65, 0x08, 0x32, 0x01, 0xd4, 0x00, 0xb0, 0x09, 0xbf, 0x00, 0x30, 0x12, 
+0x20, 0x09, 0x49, 0x06, 0x20, 0x01, 0x74, 0x0e, 0x43, 0x06, 0xaa, 0x08, 
+0xc7, 0x00, 0x47, 0x06, 0x71, 0x08, 0xa9, 0x00, 0x97, 0x07, 0x82, 0x11, 
+0x31, 0x01, 0x23, 0x00, 0xd8, 0x15, 0x02, 0x07, 0xf5, 0x00, 0xdf, 0x08, 
+0xfc, 0x08, 0x07, 0x0b, 0x43, 0x0e, 0xc9, 0x0c, 0x2d, 0x08, 0xf6, 0x00, 
+0x38, 0x01, 0xc4, 0x14, 0x3f, 0x0b, 0xea, 0x00, 0x5f, 0x00, 0x1e, 0x0b, 
+0x94, 0x07, 0x26, 0x00, 0xe5, 0x00, 0x0a, 0x15, 0x2c, 0x17, 0x41, 0x06, 
+0x75, 0x13, 0xd3, 0x10, 0x3c, 0x12, 0xe7, 0x09, 0xd3, 0x06, 0x29, 0x0a, 
+0x16, 0x0d, 0xe5, 0x07, 0x8d, 0x06, 0xba, 0x00, 0x55, 0x0a, 0xde, 0x0a, 
+0x87, 0x18, 0x92, 0x00, 0x77, 0x09, 0xcb, 0x00, 0x7a, 0x0c, 0xcf, 0x00, 
+0xde, 0x00, 0x04, 0x13, 0x3c, 0x0b, 0x4c, 0x00, 0x35, 0x01, 0x67, 0x0b, 
+0x47, 0x0c, 0xfc, 0x00, 0x96, 0x0a, 0x4a, 0x11, 0xb3, 0x07, 0xc9, 0x0f, 
+0x85, 0x0c, 0x17, 0x01, 0xd8, 0x00, 0x61, 0x0a, 0xd2, 0x09, 0x9c, 0x00, 
+0xb1, 0x1a, 0xe9, 0x00, 0x0c, 0x01, 0xe5, 0x16, 0x1c, 0x0e, 0xf4, 0x00, 
+0xdd, 0x0a, 0xbe, 0x00, 0x5e, 0x0c, 0xb2, 0x00, 0x66, 0x0c, 0xde, 0x0c, 
+0xcc, 0x00, 0x20, 0x09, 0x89, 0x0b, 0x78, 0x07, 0xda, 0x00, 0xbc, 0x09, 
+0xdb, 0x09, 0x57, 0x0a, 0xca, 0x0b, 0x48, 0x00, 0xe9, 0x00, 0x0e, 0x01, 
+0x03, 0x01, 0xe6, 0x00, 0x60, 0x06, 0x8e, 0x06, 0xc0, 0x00, 0x61, 0x0a, 
+0xd0, 0x06, 0x2f, 0x08, 0x35, 0x01, 0x3b, 0x13, 0x0b, 0x00, 0x82, 0x11, 
+0x02, 0x0e, 0xbd, 0x09, 0xcd, 0x00, 0x79, 0x00, 0xec, 0x0b, 0x7e, 0x0b, 
+0xc8, 0x09, 0x82, 0x11, 0x9c, 0x0c, 0xfe, 0x0d, 0x68, 0x13, 0x16, 0x13, 
+0x94, 0x07, 0x34, 0x0a, 0xf0, 0x00, 0x3a, 0x12, 0x56, 0x0a, 0xf3, 0x00, 
+0x11, 0x01, 0x97, 0x0a, 0x41, 0x08, 0xd9, 0x06, 0x91, 0x0c, 0xd1, 0x09, 
+0x10, 0x07, 0x1b, 0x01, 0xad, 0x00, 0xaa, 0x00, 0xab, 0x00, 0x2d, 0x09, 
+0xe5, 0x0a, 0x28, 0x15, 0xdb, 0x07, 0xa3, 0x15, 0xb6, 0x00, 0xd4, 0x0c, 
+0x75, 0x0a, 0xe8, 0x0a, 0x5f, 0x00, 0x10, 0x0b, 0x81, 0x0a, 0xbe, 0x09, 
+0x75, 0x0a, 0x61, 0x00, 0x3b, 0x00, 0x35, 0x01, 0x5c, 0x08, 0x57, 0x00, 
+0x22, 0x01, 0xd9, 0x06, 0xf3, 0x13, 0x88, 0x00, 0xa0, 0x0b, 0x19, 0x00, 
+0xc7, 0x00, 0xf6, 0x0b, 0xff, 0x0e, 0xcf, 0x0a, 0x87, 0x00, 0xe3, 0x0c, 
+0x13, 0x01, 0xb1, 0x00, 0xa9, 0x00, 0xd9, 0x00, 0xc3, 0x00, 0x4f, 0x17, 
+0x02, 0x0d, 0x1b, 0x0b, 0xfe, 0x00, 0x77, 0x00, 0x0d, 0x01, 0xb1, 0x15, 
+0xdc, 0x06, 0x4a, 0x13, 0x0d, 0x01, 0x91, 0x00, 0xfe, 0x0a, 0xfb, 0x16, 
+0x84, 0x00, 0xbf, 0x0c, 0xaa, 0x16, 0x70, 0x00, 0x3b, 0x0a, 0x85, 0x00, 
+0x19, 0x00, 0x49, 0x00, 0xf3, 0x0c, 0x2c, 0x01, 0xee, 0x0a, 0xc8, 0x09, 
+0x4f, 0x12, 0x54, 0x15, 0x97, 0x17, 0x28, 0x00, 0xf7, 0x08, 0xd8, 0x0a, 
+0x26, 0x14, 0x47, 0x00, 0x1c, 0x0f, 0x10, 0x07, 0x13, 0x0e, 0x73, 0x0b, 
+0x67, 0x07, 0x47, 0x06, 0x4a, 0x00, 0x3f, 0x01, 0x2f, 0x0c, 0x09, 0x0a, 
+0xe7, 0x00, 0x03, 0x13, 0xdc, 0x00, 0x3b, 0x0c, 0x22, 0x0c, 0xce, 0x00, 
+0x7a, 0x08, 0x40, 0x0d, 0xbc, 0x0a, 0xa6, 0x09, 0x39, 0x00, 0x40, 0x06, 
+0x10, 0x01, 0xcb, 0x09, 0xe6, 0x00, 0x5f, 0x0a, 0xf1, 0x00, 0xa1, 0x06, 
+0xaf, 0x00, 0xde, 0x13, 0x10, 0x19, 0x49, 0x00, 0x99, 0x06, 0xd7, 0x18, 
+0xf7, 0x0b, 0xf0, 0x0a, 0x4d, 0x0a, 0xd3, 0x0e, 0xf2, 0x00, 0xc0, 0x09, 
+0x35, 0x00, 0x98, 0x07, 0x72, 0x0b, 0x4a, 0x11, 0x41, 0x06, 0x41, 0x0a, 
+0x27, 0x0b, 0xc7, 0x09, 0x43, 0x06, 0xac, 0x00, 0x0d, 0x13, 0x84, 0x00, 
+0x0c, 0x18, 0x03, 0x13, 0x6a, 0x0a, 0x64, 0x00, 0xdf, 0x11, 0xbf, 0x06, 
+0xa8, 0x13, 0xec, 0x0b, 0xb4, 0x0b, 0x97, 0x06, 0x0d, 0x00, 0x49, 0x0a, 
+0xf4, 0x14, 0x5a, 0x08, 0x0b, 0x00, 0xe8, 0x13, 0x27, 0x01, 0x2f, 0x0e, 
+0xd9, 0x0a, 0xb6, 0x0e, 0x0f, 0x01, 0xea, 0x08, 0x26, 0x07, 0x0f, 0x00, 
+0xc5, 0x0a, 0x34, 0x01, 0xa7, 0x08, 0xbc, 0x00, 0xd5, 0x00, 0xb7, 0x00, 
+0x8e, 0x06, 0xf6, 0x00, 0xa5, 0x0a, 0x86, 0x00, 0xfa, 0x0c, 0x8b, 0x11, 
+0x97, 0x0b, 0x35, 0x0b, 0x4f, 0x15, 0x27, 0x01, 0x5b, 0x08, 0x64, 0x00, 
+0x65, 0x0c, 0x58, 0x17, 0x68, 0x07, 0xe5, 0x15, 0x62, 0x0e, 0xfd, 0x0a, 
+0xb3, 0x09, 0x9b, 0x00, 0x49, 0x00, 0x23, 0x00, 0x6b, 0x0a, 0x1c, 0x00, 
+0x9f, 0x00, 0x5f, 0x0b, 0x09, 0x01, 0x50, 0x10, 0x1d, 0x0b, 0x24, 0x01, 
+0x89, 0x00, 0x08, 0x0c, 0x9b, 0x00, 0x1e, 0x15, 0x25, 0x0b, 0x5f, 0x00, 
+0x18, 0x01, 0x80, 0x0a, 0xf6, 0x0e, 0xf1, 0x14, 0x94, 0x07, 0x1e, 0x00, 
+0x35, 0x0b, 0x86, 0x07, 0x4c, 0x00, 0xa1, 0x0a, 0x4f, 0x16, 0x29, 0x01, 
+0x67, 0x12, 0x79, 0x00, 0x3d, 0x0b, 0xe1, 0x00, 0x05, 0x10, 0xcb, 0x00, 
+0xe1, 0x12, 0xbc, 0x09, 0xac, 0x0b, 0x8b, 0x12, 0x0a, 0x0a, 0xee, 0x00, 
+0x73, 0x0b, 0x45, 0x10, 0x66, 0x08, 0x28, 0x00, 0x90, 0x17, 0x93, 0x0b, 
+0x89, 0x14, 0x47, 0x00, 0x22, 0x0e, 0x0d, 0x0c, 0x20, 0x0a, 0x4b, 0x0b, 
+0x57, 0x00, 0x53, 0x0b, 0x27, 0x12, 0xa0, 0x08, 0x2d, 0x01, 0x3b, 0x13, 
+0x1e, 0x01, 0xf4, 0x08, 0x48, 0x0b, 0xde, 0x15, 0x17, 0x01, 0xf8, 0x00, 
+0x5d, 0x08, 0x0e, 0x01, 0xfa, 0x00, 0xf8, 0x0c, 0xbd, 0x00, 0x15, 0x01, 
+0x35, 0x0a, 0x12, 0x01, 0xea, 0x0d, 0xee, 0x0b, 0x22, 0x01, 0xf3, 0x0c, 
+0x46, 0x19, 0x82, 0x08, 0x63, 0x19, 0x2d, 0x0a, 0x00, 0x01, 0x8d, 0x06, 
+0x18, 0x00, 0xeb, 0x08, 0xce, 0x00, 0x42, 0x06, 0x38, 0x10, 0xf3, 0x09, 
+0x58, 0x0a, 0x68, 0x12, 0x5b, 0x08, 0x62, 0x09, 0xbf, 0x0b, 0x6e, 0x0b, 
+0x6f, 0x12, 0x55, 0x00, 0xe2, 0x00, 0x81, 0x0a, 0x0e, 0x13, 0x09, 0x0a, 
+0x00, 0x01, 0x1a, 0x01, 0x9c, 0x0f, 0x47, 0x09, 0x90, 0x00, 0x26, 0x15, 
+0x93, 0x0f, 0xf7, 0x00, 0x98, 0x0a, 0x4e, 0x0a, 0x93, 0x0b, 0x7a, 0x08, 
+0x23, 0x00, 0xdf, 0x00, 0xba, 0x09, 0x44, 0x06, 0x2d, 0x01, 0x12, 0x0b, 
+0xfb, 0x00, 0xc8, 0x09, 0xbc, 0x07, 0x9f, 0x0b, 0x38, 0x0a, 0x5b, 0x08, 
+0xc6, 0x00, 0xa7, 0x0c, 0xbd, 0x00, 0x1b, 0x07, 0x65, 0x1a, 0xa3, 0x06, 
+0x6a, 0x0d, 0xdf, 0x08, 0x11, 0x0e, 0x63, 0x17, 0x5d, 0x07, 0xb2, 0x09, 
+0xf2, 0x00, 0x12, 0x01, 0xa9, 0x16, 0x2b, 0x01, 0x96, 0x15, 0x39, 0x01, 
+0x85, 0x0a, 0x0e, 0x01, 0x1e, 0x1a, 0x07, 0x0b, 0x32, 0x19, 0x1b, 0x01, 
+0xaf, 0x12, 0x41, 0x01, 0xf0, 0x0a, 0x68, 0x0c, 0x85, 0x00, 0x3c, 0x00, 
+0x65, 0x0a, 0xa1, 0x15, 0x9b, 0x06, 0xd6, 0x15, 0x46, 0x08, 0xad, 0x00, 
+0x79, 0x00, 0x80, 0x0b, 0x1a, 0x01, 0x86, 0x17, 0x82, 0x08, 0x08, 0x01, 
+0x07, 0x0a, 0x64, 0x00, 0xe3, 0x00, 0xea, 0x00, 0x04, 0x0b, 0xcc, 0x00, 
+0x4e, 0x11, 0x52, 0x0c, 0xc5, 0x0b, 0x4b, 0x00, 0x45, 0x0a, 0x29, 0x00, 
+0xc5, 0x09, 0x57, 0x18, 0x19, 0x0d, 0xed, 0x0d, 0xf5, 0x09, 0xc7, 0x00, 
+0x72, 0x0b, 0xe2, 0x07, 0xd2, 0x06, 0xda, 0x10, 0x80, 0x0b, 0xad, 0x00, 
+0x1d, 0x0b, 0x1a, 0x08, 0x32, 0x00, 0x94, 0x00, 0x37, 0x0d, 0x0f, 0x0b, 
+0x2a, 0x0b, 0xa2, 0x06, 0xfa, 0x0a, 0xf0, 0x0a, 0x4a, 0x00, 0x1a, 0x0d, 
+0x48, 0x19, 0x4c, 0x00, 0x92, 0x0a, 0x01, 0x01, 0x1e, 0x01, 0x6a, 0x07, 
+0xaf, 0x0a, 0x4b, 0x06, 0xfe, 0x0c, 0x61, 0x18, 0x27, 0x01, 0xb6, 0x00, 
+0xae, 0x0a, 0x91, 0x0c, 0x70, 0x10, 0x75, 0x0a, 0xd6, 0x00, 0x9d, 0x00, 
+0x82, 0x10, 0x22, 0x01, 0x48, 0x00, 0xc8, 0x00, 0xba, 0x17, 0x64, 0x00, 
+0x49, 0x06, 0xaa, 0x06, 0x85, 0x0c, 0xf9, 0x00, 0xe8, 0x06, 0x1c, 0x01, 
+0xdd, 0x0c, 0xd2, 0x06, 0xcc, 0x00, 0x7e, 0x16, 0x95, 0x07, 0x91, 0x10, 
+0x12, 0x0e, 0x1a, 0x0e, 0x1c, 0x07, 0x10, 0x07, 0xcc, 0x0b, 0xae, 0x00, 
+0x12, 0x0a, 0xf3, 0x08, 0x66, 0x12, 0x33, 0x00, 0x09, 0x0d, 0x01, 0x0c, 
+0xc2, 0x0b, 0xd1, 0x0a, 0x5e, 0x18, 0x29, 0x0c, 0x33, 0x00, 0x1a, 0x01, 
+0xb0, 0x0b, 0xc3, 0x0e, 0x86, 0x16, 0x30, 0x00, 0x19, 0x08, 0x8b, 0x11, 
+0x0d, 0x01, 0xd7, 0x07, 0x7a, 0x09, 0x21, 0x01, 0x8f, 0x0a, 0x95, 0x0f, 
+0x0c, 0x0a, 0x1e, 0x01, 0x22, 0x09, 0xb5, 0x00, 0x23, 0x0c, 0x8c, 0x0a, 
+0x40, 0x01, 0x33, 0x01, 0x3f, 0x01, 0x32, 0x0b, 0xad, 0x00, 0xa8, 0x17, 
+0x43, 0x06, 0x99, 0x18, 0xd2, 0x0c, 0x41, 0x00, 0x31, 0x01, 0x89, 0x00, 
+0x51, 0x13, 0x56, 0x00, 0x07, 0x01, 0x67, 0x09, 0x1d, 0x0b, 0x35, 0x00, 
+0x6f, 0x0c, 0x1c, 0x01, 0x12, 0x0b, 0x1c, 0x10, 0x58, 0x17, 0xdd, 0x00, 
+0x6c, 0x0d, 0x87, 0x17, 0xfb, 0x0a, 0x29, 0x0e, 0x4a, 0x0b, 0xdc, 0x0d, 
+0xea, 0x00, 0xd4, 0x09, 0x23, 0x0e, 0x14, 0x0e, 0xd4, 0x0c, 0x0b, 0x00, 
+0x4f, 0x12, 0x17, 0x00, 0x07, 0x0a, 0xed, 0x00, 0x73, 0x0b, 0xaa, 0x1a, 
+0x7b, 0x10, 0xbd, 0x0c, 0xbf, 0x0b, 0xa1, 0x0b, 0x6f, 0x0e, 0xed, 0x0d, 
+0xcb, 0x0e, 0xf2, 0x17, 0x48, 0x09, 0x8f, 0x00, 0xb4, 0x0b, 0xa0, 0x11, 
+0x3d, 0x00, 0xc4, 0x14, 0x5c, 0x00, 0xc8, 0x00, 0x8e, 0x17, 0x6d, 0x0a, 
+0x3e, 0x01, 0xb6, 0x0b, 0xb0, 0x11, 0xac, 0x00, 0x82, 0x0a, 0xed, 0x0b, 
+0x0d, 0x00, 0xa0, 0x0c, 0x55, 0x0a, 0x45, 0x06, 0x1c, 0x10, 0x0c, 0x13, 
+0x3d, 0x16, 0x29, 0x0b, 0x18, 0x00, 0x15, 0x07, 0x8b, 0x0a, 0x74, 0x16, 
+0xdf, 0x13, 0x1b, 0x00, 0x29, 0x01, 0x42, 0x14, 0x27, 0x0b, 0xe2, 0x00, 
+0xc1, 0x16, 0x7d, 0x0b, 0x3b, 0x0c, 0xdb, 0x00, 0x8d, 0x0b, 0x19, 0x01, 
+0x38, 0x01, 0x24, 0x09, 0xe9, 0x0c, 0xb4, 0x0c, 0x1d, 0x01, 0xaf, 0x0a, 
+0x96, 0x07, 0xb5, 0x09, 0x65, 0x0e, 0xf5, 0x09, 0x30, 0x00, 0xaa, 0x15, 
+0x05, 0x01, 0x93, 0x0b, 0xe5, 0x16, 0x3b, 0x0b, 0x96, 0x15, 0x9b, 0x0b, 
+0x7e, 0x00, 0x41, 0x01, 0x64, 0x09, 0xc2, 0x00, 0x3e, 0x01, 0xa3, 0x0a, 
+0x18, 0x08, 0xce, 0x06, 0x47, 0x06, 0x3a, 0x1a, 0x5c, 0x1a, 0x0c, 0x13, 
+0xb3, 0x06, 0xc7, 0x17, 0x2f, 0x0f, 0x93, 0x11, 0x96, 0x15, 0x51, 0x0b, 
+0x50, 0x15, 0x54, 0x07, 0xa0, 0x0b, 0xf0, 0x1b, 0xee, 0x00, 0xcb, 0x0e, 
+0x74, 0x16, 0xd5, 0x09, 0x03, 0x01, 0x28, 0x01, 0x0b, 0x01, 0x4a, 0x0b, 
+0x3e, 0x00, 0x42, 0x01, 0xc7, 0x0c, 0x4b, 0x00, 0x20, 0x0d, 0x6e, 0x10, 
+0x2a, 0x0a, 0xd6, 0x16, 0xbe, 0x00, 0x1f, 0x15, 0x9c, 0x00, 0x12, 0x0d, 
+0x84, 0x00, 0xb2, 0x16, 0xd1, 0x09, 0xf4, 0x09, 0xf1, 0x09, 0xcb, 0x0b, 
+0x17, 0x01, 0x03, 0x16, 0x90, 0x1a, 0x33, 0x0b, 0x85, 0x07, 0x6c, 0x00, 
+0x24, 0x01, 0x9f, 0x0c, 0xac, 0x0a, 0x46, 0x06, 0xf7, 0x00, 0x7a, 0x06, 
+0x01, 0x14, 0x28, 0x01, 0xd8, 0x0c, 0xfa, 0x15, 0xd5, 0x0b, 0x0d, 0x13, 
+0xae, 0x0b, 0xc5, 0x00, 0x6e, 0x0b, 0xc0, 0x00, 0x26, 0x0b, 0x39, 0x0c, 
+0xc7, 0x00, 0x59, 0x0c, 0x47, 0x09, 0x47, 0x00, 0xd6, 0x00, 0x25, 0x00, 
+0xc7, 0x0c, 0xed, 0x00, 0xa9, 0x0b, 0x92, 0x0c, 0xa9, 0x00, 0xd5, 0x0b, 
+0x40, 0x0d, 0xd0, 0x12, 0x4a, 0x13, 0x05, 0x01, 0x11, 0x0e, 0x90, 0x06, 
+0x8a, 0x0b, 0xf8, 0x00, 0x06, 0x01, 0xc0, 0x0e, 0x1f, 0x16, 0xc0, 0x00, 
+0xe0, 0x0b, 0x83, 0x00, 0xbb, 0x14, 0xe6, 0x00, 0x54, 0x11, 0xeb, 0x07, 
+0x7d, 0x00, 0xce, 0x00, 0x80, 0x0c, 0xeb, 0x13, 0xb0, 0x0f, 0x4b, 0x00, 
+0xdc, 0x14, 0x80, 0x12, 0xc6, 0x00, 0xbc, 0x08, 0xb6, 0x00, 0x60, 0x06, 
+0x3d, 0x0b, 0xbe, 0x0c, 0x49, 0x12, 0xd0, 0x09, 0x2c, 0x18, 0x3f, 0x01, 
+0xb3, 0x09, 0xcc, 0x0c, 0x26, 0x16, 0x43, 0x06, 0x5e, 0x12, 0x28, 0x0a, 
+0xd4, 0x0a, 0x19, 0x01, 0x9e, 0x00, 0x35, 0x0a, 0xd4, 0x0f, 0xa0, 0x15, 
+0x40, 0x08, 0x82, 0x09, 0xfc, 0x09, 0x73, 0x0b, 0x24, 0x01, 0x04, 0x08, 
+0x55, 0x0a, 0x02, 0x13, 0x5a, 0x07, 0x4b, 0x14, 0x3f, 0x09, 0xcb, 0x0b, 
+0xba, 0x00, 0xa0, 0x15, 0xbd, 0x00, 0x23, 0x00, 0xf2, 0x14, 0xd7, 0x18, 
+0x2b, 0x09, 0x45, 0x0c, 0x38, 0x0a, 0xc5, 0x0e, 0x9c, 0x00, 0x12, 0x01, 
+0xcc, 0x00, 0xb5, 0x17, 0x02, 0x0c, 0xd7, 0x07, 0xaa, 0x00, 0xcc, 0x00, 
+0x8a, 0x11, 0xd4, 0x0c, 0xc5, 0x00, 0x72, 0x16, 0x37, 0x0d, 0x21, 0x09, 
+0x44, 0x13, 0xe2, 0x0c, 0xd1, 0x00, 0x3f, 0x01, 0x6f, 0x0c, 0xcc, 0x0f, 
+0xd1, 0x00, 0xd7, 0x0b, 0x71, 0x0a, 0xb3, 0x00, 0x3f, 0x01, 0x1b, 0x0b, 
+0x15, 0x0b, 0x30, 0x0b, 0x42, 0x0a, 0x8c, 0x12, 0x95, 0x1a, 0x3f, 0x0e, 
+0xa9, 0x16, 0xcd, 0x00, 0xd8, 0x07, 0xf2, 0x09, 0x4b, 0x0b, 0x09, 0x01, 
+0xa9, 0x08, 0x97, 0x15, 0x30, 0x01, 0xd1, 0x0a, 0x68, 0x14, 0x2a, 0x1b, 
+0xe0, 0x09, 0xe3, 0x00, 0xd9, 0x0b, 0x31, 0x0b, 0xbb, 0x00, 0xca, 0x0b, 
+0xcd, 0x15, 0xb7, 0x00, 0xc9, 0x0e, 0x0f, 0x0b, 0x7e, 0x0b, 0x48, 0x06, 
+0x74, 0x0b, 0x55, 0x07, 0xe6, 0x09, 0x49, 0x06, 0x0e, 0x01, 0xdc, 0x0a, 
+0xd4, 0x00, 0x15, 0x0b, 0xf8, 0x0b, 0xcd, 0x0a, 0x9b, 0x0c, 0x89, 0x0c, 
+0x84, 0x14, 0x81, 0x0a, 0xd3, 0x0c, 0x96, 0x0a, 0x60, 0x12, 0x95, 0x07, 
+0x2f, 0x00, 0x3c, 0x00, 0xd1, 0x00, 0x5d, 0x12, 0x7b, 0x13, 0xea, 0x00, 
+0x37, 0x10, 0x6a, 0x0e, 0x83, 0x0b, 0x50, 0x0c, 0x92, 0x0c, 0xec, 0x0b, 
+0x27, 0x01, 0x53, 0x00, 0x2e, 0x0a, 0x28, 0x14, 0x72, 0x0c, 0x70, 0x0c, 
+0xfa, 0x16, 0xae, 0x12, 0x19, 0x00, 0x1e, 0x0a, 0x61, 0x00, 0x66, 0x00, 
+0x9f, 0x0b, 0x34, 0x00, 0x25, 0x01, 0xba, 0x08, 0xc7, 0x09, 0xb4, 0x0b, 
+0x62, 0x09, 0x8d, 0x0d, 0x4b, 0x0a, 0x2a, 0x0a, 0xca, 0x0f, 0x6e, 0x13, 
+0x0f, 0x00, 0xa1, 0x08, 0x38, 0x00, 0xa1, 0x06, 0xa5, 0x09, 0xe6, 0x09, 
+0xe0, 0x0c, 0xeb, 0x0b, 0xda, 0x15, 0x1c, 0x0b, 0xe7, 0x10, 0xd7, 0x0b, 
+0xa0, 0x15, 0x24, 0x01, 0xb9, 0x00, 0x68, 0x07, 0xb1, 0x00, 0xcd, 0x0a, 
+0xed, 0x13, 0xa7, 0x00, 0x8a, 0x0b, 0x11, 0x07, 0x14, 0x0e, 0xc9, 0x0e, 
+0xb8, 0x10, 0x81, 0x11, 0x54, 0x00, 0x0d, 0x0c, 0x2d, 0x0c, 0xc1, 0x00, 
+0xa6, 0x0c, 0xc5, 0x00, 0xd9, 0x0c, 0xbc, 0x16, 0x42, 0x12, 0x4a, 0x06, 
+0x31, 0x00, 0x60, 0x0b, 0xca, 0x09, 0x73, 0x00, 0x09, 0x01, 0xca, 0x00, 
+0x5c, 0x0b, 0x38, 0x01, 0x70, 0x08, 0xa9, 0x00, 0x81, 0x11, 0x99, 0x07, 
+0x11, 0x0c, 0x38, 0x01, 0xec, 0x0b, 0x32, 0x0c, 0x82, 0x14, 0x60, 0x0b, 
+0xfa, 0x00, 0x32, 0x01, 0xcf, 0x06, 0x40, 0x01, 0x92, 0x0f, 0xb4, 0x0c, 
+0x0b, 0x0a, 0x9b, 0x00, 0xb6, 0x00, 0x7c, 0x16, 0x5c, 0x08, 0x70, 0x07, 
+0x47, 0x0b, 0x46, 0x0b, 0xce, 0x18, 0xc9, 0x00, 0xe4, 0x00, 0x6c, 0x00, 
+0x1a, 0x08, 0xb2, 0x09, 0xb3, 0x00, 0xc3, 0x17, 0x30, 0x0b, 0x1b, 0x07, 
+0xf6, 0x0b, 0xcf, 0x00, 0x54, 0x19, 0xbd, 0x0c, 0x5d, 0x07, 0x4e, 0x00, 
+0xa8, 0x09, 0xb4, 0x0b, 0xe6, 0x09, 0x3a, 0x18, 0xf4, 0x14, 0xb6, 0x0b, 
+0x94, 0x0f, 0x75, 0x13, 0xb2, 0x00, 0x9a, 0x0a, 0xea, 0x00, 0x39, 0x14, 
+0xa1, 0x08, 0xbf, 0x00, 0x3a, 0x18, 0x0e, 0x00, 0xc2, 0x0e, 0x02, 0x16, 
+0xe6, 0x06, 0xe5, 0x0a, 0x82, 0x06, 0x2f, 0x01, 0x19, 0x0c, 0xab, 0x00, 
+0x3e, 0x0d, 0x7a, 0x09, 0xf3, 0x09, 0x28, 0x01, 0xe1, 0x07, 0xab, 0x00, 
+0x97, 0x07, 0xd4, 0x0c, 0x46, 0x06, 0x48, 0x00, 0xa7, 0x00, 0x8f, 0x06, 
+0x7d, 0x0c, 0xef, 0x0e, 0x41, 0x01, 0x63, 0x00, 0x77, 0x06, 0x10, 0x0b, 
+0x1f, 0x0a, 0x53, 0x07, 0x19, 0x08, 0x23, 0x01, 0x02, 0x0c, 0xd1, 0x00, 
+0xd2, 0x11, 0x04, 0x09, 0x1d, 0x0a, 0xd2, 0x00, 0xa1, 0x0a, 0x96, 0x07, 
+0x10, 0x0c, 0xf5, 0x0c, 0xd3, 0x00, 0x6c, 0x0a, 0x76, 0x0a, 0x96, 0x07, 
+0x85, 0x00, 0x56, 0x07, 0x9b, 0x00, 0xb6, 0x11, 0xd3, 0x0a, 0x9f, 0x0c, 
+0x85, 0x00, 0xf6, 0x08, 0x96, 0x15, 0x56, 0x00, 0x5b, 0x0c, 0xec, 0x08, 
+0xed, 0x00, 0x19, 0x00, 0x91, 0x06, 0xa8, 0x08, 0xf8, 0x00, 0x5b, 0x08, 
+0x98, 0x0b, 0xae, 0x10, 0x85, 0x17, 0x6f, 0x10, 0x2d, 0x01, 0xa1, 0x00, 
+0xf7, 0x10, 0x8d, 0x14, 0x54, 0x11, 0x98, 0x15, 0x27, 0x07, 0x1d, 0x07, 
+0x19, 0x0a, 0xdf, 0x0a, 0xc4, 0x10, 0x93, 0x0f, 0x3e, 0x13, 0xb1, 0x0c, 
+0x40, 0x0b, 0xb6, 0x07, 0x16, 0x01, 0x0a, 0x0a, 0xee, 0x00, 0xab, 0x00, 
+0x55, 0x11, 0x36, 0x09, 0xfc, 0x16, 0x9f, 0x0c, 0xa4, 0x09, 0x6d, 0x09, 
+0xb0, 0x10, 0x08, 0x0c, 0x05, 0x0b, 0xba, 0x00, 0xb3, 0x00, 0xc0, 0x0e, 
+0xbd, 0x10, 0x3b, 0x00, 0xe1, 0x07, 0x3b, 0x01, 0x9f, 0x00, 0x49, 0x0c, 
+0x01, 0x01, 0x9b, 0x11, 0x73, 0x0b, 0xb7, 0x0a, 0xe8, 0x0a, 0x44, 0x0c, 
+0x9f, 0x00, 0xa1, 0x0b, 0x13, 0x0a, 0x61, 0x0a, 0xa2, 0x06, 0xac, 0x00, 
+0xa3, 0x00, 0x5f, 0x06, 0xea, 0x13, 0xce, 0x00, 0xfc, 0x09, 0x93, 0x0c, 
+0xb2, 0x08, 0x66, 0x08, 0x5a, 0x00, 0x4a, 0x00, 0xf9, 0x0a, 0xed, 0x07, 
+0x88, 0x0b, 0xb6, 0x0c, 0xfd, 0x0d, 0x40, 0x01, 0x6e, 0x09, 0x1c, 0x01, 
+0xa0, 0x19, 0x2e, 0x01, 0xdb, 0x00, 0x31, 0x0b, 0x55, 0x11, 0x24, 0x08, 
+0xd0, 0x09, 0x97, 0x07, 0x2d, 0x15, 0xa3, 0x0b, 0x49, 0x0a, 0xf1, 0x14, 
+0x27, 0x01, 0xd8, 0x00, 0x4c, 0x18, 0x9d, 0x0c, 0x3a, 0x01, 0x0e, 0x00, 
+0x83, 0x00, 0xb0, 0x0a, 0x92, 0x0c, 0xfe, 0x07, 0x0b, 0x01, 0xd9, 0x07, 
+0x23, 0x0c, 0x3d, 0x0b, 0x04, 0x0b, 0xb0, 0x09, 0xf9, 0x07, 0x27, 0x01, 
+0x1a, 0x01, 0xfd, 0x0a, 0xd1, 0x00, 0x41, 0x01, 0x74, 0x0b, 0x13, 0x07, 
+0x1d, 0x0b, 0x9e, 0x08, 0x40, 0x0b, 0x34, 0x01, 0x37, 0x09, 0x02, 0x0c, 
+0x98, 0x06, 0xc1, 0x00, 0x20, 0x0a, 0xff, 0x00, 0x1e, 0x0e, 0xd2, 0x0c, 
+0x53, 0x00, 0x54, 0x07, 0x93, 0x00, 0xfd, 0x10, 0xba, 0x07, 0x97, 0x17, 
+0xaf, 0x09, 0x34, 0x01, 0x04, 0x0c, 0x9c, 0x08, 0x48, 0x06, 0xba, 0x0e, 
+0x2c, 0x18, 0x20, 0x0b, 0x03, 0x01, 0x91, 0x06, 0x21, 0x10, 0x3c, 0x01, 
+0xa2, 0x0a, 0x2b, 0x0a, 0xf9, 0x0f, 0xe8, 0x0c, 0x2e, 0x08, 0x26, 0x12, 
+0x25, 0x07, 0x10, 0x01, 0x20, 0x16, 0xad, 0x0a, 0x1f, 0x01, 0x80, 0x0a, 
+0x17, 0x00, 0x3f, 0x01, 0x81, 0x0a, 0xe2, 0x13, 0x18, 0x14, 0xa6, 0x0c, 
+0xfc, 0x08, 0x0c, 0x0c, 0x17, 0x14, 0x8a, 0x13, 0x45, 0x06, 0x41, 0x06, 
+0x0a, 0x0a, 0x66, 0x08, 0x6d, 0x00, 0x7e, 0x16, 0x29, 0x0c, 0x65, 0x0c, 
+0x90, 0x0c, 0xe2, 0x00, 0xc5, 0x09, 0xef, 0x0b, 0xfe, 0x09, 0xc0, 0x09, 
+0x1b, 0x07, 0xdc, 0x0d, 0xa9, 0x15, 0xf6, 0x14, 0xe7, 0x00, 0xd6, 0x00, 
+0x0a, 0x01, 0xd6, 0x00, 0x17, 0x0c, 0xd6, 0x18, 0x3b, 0x01, 0x3d, 0x01, 
+0x42, 0x06, 0xe4, 0x0d, 0xc3, 0x00, 0xcb, 0x00, 0xdb, 0x00, 0x2b, 0x09, 
+0x2c, 0x1a, 0x5d, 0x07, 0x8a, 0x10, 0x59, 0x0c, 0x76, 0x0b, 0xc1, 0x00, 
+0x36, 0x0d, 0xda, 0x00, 0xfc, 0x08, 0x47, 0x06, 0x31, 0x1a, 0xb0, 0x10, 
+0xe9, 0x0c, 0x19, 0x08, 0x38, 0x15, 0x28, 0x12, 0x62, 0x09, 0x33, 0x0b, 
+0x30, 0x01, 0xed, 0x1a, 0xb1, 0x00, 0xd3, 0x0f, 0xd1, 0x09, 0xad, 0x0f, 
+0x2e, 0x01, 0xce, 0x14, 0x86, 0x17, 0x2f, 0x01, 0xe1, 0x07, 0xb0, 0x09, 
+0xb1, 0x00, 0xa2, 0x0d, 0x18, 0x0c, 0xad, 0x00, 0x12, 0x00, 0xdc, 0x0b, 
+0xa7, 0x09, 0x1d, 0x01, 0x53, 0x00, 0xbc, 0x1c, 0xc3, 0x00, 0xd5, 0x00, 
+0x18, 0x00, 0x50, 0x0c, 0xa9, 0x0b, 0x61, 0x0a, 0x9b, 0x0c, 0xe1, 0x00, 
+0xc4, 0x00, 0x7a, 0x0c, 0xac, 0x00, 0x5e, 0x08, 0x34, 0x01, 0x2d, 0x18, 
+0xb5, 0x00, 0x1e, 0x0a, 0x78, 0x10, 0x42, 0x01, 0x78, 0x0a, 0x25, 0x00, 
+0xc2, 0x00, 0x8d, 0x12, 0xc9, 0x00, 0x1d, 0x01, 0x80, 0x00, 0xd9, 0x0a, 
+0xf1, 0x00, 0xc0, 0x0b, 0xa3, 0x07, 0xf2, 0x00, 0xb9, 0x0a, 0x02, 0x13, 
+0xe8, 0x0a, 0x91, 0x00, 0xa0, 0x00, 0xc2, 0x0a, 0x13, 0x01, 0xd7, 0x15, 
+0x5a, 0x0c, 0x98, 0x0b, 0xa5, 0x09, 0xec, 0x07, 0xf6, 0x0e, 0x6f, 0x09, 
+0x85, 0x00, 0x6e, 0x0a, 0xbb, 0x00, 0x99, 0x0a, 0x1f, 0x15, 0x59, 0x17, 
+0xaa, 0x09, 0x53, 0x0d, 0x60, 0x00, 0x91, 0x00, 0xbe, 0x11, 0x23, 0x00, 
+0x5f, 0x00, 0xc0, 0x0e, 0x8d, 0x0a, 0x45, 0x15, 0x79, 0x00, 0xda, 0x06, 
+0x3d, 0x00, 0x7d, 0x0b, 0x3f, 0x0a, 0x3e, 0x0c, 0xd0, 0x0a, 0x65, 0x00, 
+0xfc, 0x00, 0x77, 0x0a, 0x5b, 0x0c, 0x25, 0x00, 0x91, 0x17, 0x72, 0x16, 
+0x4c, 0x0a, 0x1d, 0x01, 0xa9, 0x07, 0x1a, 0x00, 0x46, 0x0e, 0x88, 0x0b, 
+0xa6, 0x0c, 0x3d, 0x12, 0x05, 0x0c, 0x1e, 0x01, 0xc3, 0x0b, 0xd7, 0x1b, 
+0xb2, 0x08, 0x9a, 0x0b, 0x46, 0x19, 0x90, 0x00, 0xb4, 0x0b, 0x4c, 0x06, 
+0xa9, 0x07, 0xae, 0x00, 0x8c, 0x06, 0xe1, 0x0b, 0xe0, 0x0c, 0x76, 0x0a, 
+0x0e, 0x00, 0x0f, 0x0c, 0x03, 0x0c, 0xef, 0x00, 0x65, 0x09, 0x42, 0x06, 
+0xed, 0x07, 0xae, 0x11, 0x08, 0x0b, 0x54, 0x1c, 0xb5, 0x09, 0xf8, 0x0b, 
+0x65, 0x0a, 0xea, 0x00, 0x0c, 0x0c, 0x2a, 0x0a, 0xa4, 0x09, 0x25, 0x16, 
+0xc2, 0x0b, 0xb6, 0x16, 0xc7, 0x00, 0x49, 0x00, 0x2a, 0x18, 0x41, 0x01, 
+0xec, 0x06, 0x6c, 0x0a, 0xd1, 0x00, 0x24, 0x01, 0x3b, 0x01, 0x11, 0x01, 
+0x4c, 0x00, 0x70, 0x00, 0x2a, 0x0b, 0x9f, 0x08, 0x34, 0x18, 0x34, 0x01, 
+0x18, 0x01, 0xf4, 0x09, 0x52, 0x0b, 0xf6, 0x00, 0x20, 0x0a, 0xda, 0x0a, 
+0x53, 0x17, 0x36, 0x0d, 0x6c, 0x06, 0x99, 0x0a, 0x32, 0x0b, 0x9c, 0x00, 
+0x46, 0x06, 0x6a, 0x0a, 0xe0, 0x16, 0x18, 0x17, 0x32, 0x01, 0xb1, 0x00, 
+0xf2, 0x17, 0xd8, 0x07, 0x18, 0x01, 0xd7, 0x00, 0x89, 0x0a, 0x2c, 0x0a, 
+0x3c, 0x00, 0xa9, 0x00, 0x48, 0x06, 0x29, 0x07, 0x20, 0x01, 0x41, 0x00, 
+0x1e, 0x0b, 0xa4, 0x06, 0x0a, 0x0a, 0xe7, 0x09, 0x34, 0x01, 0x41, 0x01, 
+0x0a, 0x0a, 0x48, 0x00, 0xb9, 0x10, 0xd0, 0x07, 0x63, 0x09, 0x66, 0x09, 
+0x57, 0x06, 0x28, 0x0b, 0x56, 0x0a, 0x54, 0x0a, 0x6b, 0x0a, 0x2b, 0x0b, 
+0xb4, 0x17, 0x24, 0x09, 0x49, 0x0a, 0x5f, 0x00, 0xe5, 0x0d, 0xf3, 0x00, 
+0xff, 0x0b, 0xb2, 0x00, 0x43, 0x0c, 0x01, 0x01, 0x7d, 0x0a, 0xc6, 0x00, 
+0xda, 0x00, 0x48, 0x07, 0x55, 0x0a, 0xd5, 0x09, 0x18, 0x0c, 0xef, 0x00, 
+0xe3, 0x00, 0x95, 0x0b, 0xb8, 0x0b, 0x27, 0x14, 0xd0, 0x09, 0xd3, 0x00, 
+0xe8, 0x0d, 0xfc, 0x00, 0x0d, 0x01, 0x38, 0x09, 0x1a, 0x0b, 0x68, 0x06, 
+0xbd, 0x00, 0x96, 0x0b, 0x58, 0x0d, 0xca, 0x0b, 0xfc, 0x0a, 0x6c, 0x06, 
+0x17, 0x01, 0x9d, 0x0c, 0xcb, 0x09, 0x5d, 0x08, 0x2f, 0x0c, 0xaf, 0x00, 
+0xa5, 0x0a, 0xd1, 0x0e, 0x31, 0x01, 0xb4, 0x00, 0xbb, 0x08, 0xb9, 0x00, 
+0x86, 0x00, 0x83, 0x00, 0xc9, 0x08, 0x1f, 0x14, 0x35, 0x0a, 0x0a, 0x0d, 
+0x02, 0x0c, 0x90, 0x06, 0x2d, 0x15, 0x59, 0x06, 0xc2, 0x0b, 0x73, 0x0b, 
+0xcb, 0x0c, 0x1b, 0x07, 0xd5, 0x0b, 0x36, 0x0b, 0x6d, 0x19, 0x52, 0x07, 
+0xbf, 0x09, 0x3a, 0x0d, 0x8f, 0x17, 0xad, 0x00, 0x73, 0x0b, 0xec, 0x00, 
+0xff, 0x0c, 0x5d, 0x07, 0x62, 0x0a, 0xfd, 0x09, 0xb4, 0x0b, 0xf6, 0x0e, 
+0x71, 0x09, 0x07, 0x01, 0xc2, 0x00, 0xf5, 0x00, 0xfb, 0x06, 0xee, 0x06, 
+0x25, 0x0b, 0x64, 0x0a, 0x3c, 0x01, 0x55, 0x0b, 0xfa, 0x15, 0x1b, 0x10, 
+0x88, 0x0b, 0xe6, 0x00, 0x9b, 0x0c, 0xaf, 0x12, 0xf4, 0x00, 0x16, 0x09, 
+0x06, 0x0c, 0xa4, 0x0a, 0x5d, 0x11, 0x45, 0x15, 0xf5, 0x0d, 0x56, 0x00, 
+0x03, 0x01, 0x39, 0x07, 0x44, 0x0c, 0x37, 0x01, 0xda, 0x06, 0x39, 0x13, 
+0x37, 0x0b, 0x91, 0x0c, 0xf9, 0x13, 0x54, 0x0a, 0xfd, 0x09, 0xf0, 0x0a, 
+0xfa, 0x0b, 0x08, 0x0a, 0x02, 0x0d, 0xee, 0x0b, 0xcc, 0x00, 0xf1, 0x0e, 
+0x9b, 0x06, 0x86, 0x16, 0xda, 0x00, 0xaf, 0x11, 0x72, 0x0b, 0x09, 0x0d, 
+0x86, 0x00, 0x65, 0x08, 0x02, 0x0c, 0xbc, 0x00, 0xc4, 0x14, 0xbe, 0x0c, 
+0xbb, 0x0a, 0x36, 0x01, 0x23, 0x00, 0x93, 0x12, 0x22, 0x0c, 0x28, 0x0c, 
+0x64, 0x09, 0x00, 0x01, 0x49, 0x0b, 0x53, 0x07, 0x4a, 0x11, 0x2e, 0x09, 
+0x26, 0x07, 0x02, 0x01, 0xe7, 0x09, 0xe0, 0x0b, 0x1b, 0x07, 0xc4, 0x00, 
+0x34, 0x01, 0x1a, 0x1a, 0x2d, 0x09, 0xc9, 0x0c, 0xe0, 0x09, 0x73, 0x15, 
+0x37, 0x07, 0x51, 0x0b, 0xa9, 0x09, 0x39, 0x01, 0xd8, 0x07, 0xb8, 0x0e, 
+0xb5, 0x07, 0x12, 0x0e, 0x3f, 0x0a, 0x08, 0x01, 0xab, 0x16, 0x19, 0x01, 
+0x66, 0x12, 0xb2, 0x08, 0xcd, 0x00, 0xdf, 0x11, 0xa8, 0x10, 0x1b, 0x0c, 
+0xd7, 0x00, 0x88, 0x0b, 0xa1, 0x0b, 0xd3, 0x00, 0x12, 0x01, 0xc9, 0x11, 
+0x31, 0x0b, 0xe6, 0x06, 0x9d, 0x08, 0xd6, 0x00, 0x8f, 0x00, 0x14, 0x0e, 
+0xc6, 0x00, 0x7a, 0x0b, 0xc6, 0x00, 0x46, 0x0c, 0x2a, 0x01, 0xbc, 0x0c, 
+0x76, 0x0b, 0x80, 0x0a, 0x28, 0x01, 0xd7, 0x0b, 0xeb, 0x00, 0xdf, 0x15, 
+0x2b, 0x0a, 0x09, 0x15, 0xf3, 0x00, 0x37, 0x0a, 0xc2, 0x0b, 0xb9, 0x00, 
+0x70, 0x0c, 0xde, 0x09, 0x78, 0x00, 0x06, 0x01, 0xc3, 0x0a, 0x10, 0x07, 
+0x04, 0x01, 0x57, 0x06, 0x2a, 0x01, 0x16, 0x01, 0xad, 0x00, 0x50, 0x0a, 
+0x03, 0x11, 0x83, 0x0a, 0xb6, 0x12, 0x15, 0x0d, 0x49, 0x06, 0x59, 0x00, 
+0x23, 0x0f, 0xdc, 0x0a, 0x99, 0x0b, 0xd4, 0x10, 0x69, 0x14, 0x08, 0x0a, 
+0x32, 0x01, 0xb0, 0x0b, 0x40, 0x01, 0x45, 0x06, 0x67, 0x07, 0x7b, 0x0a, 
+0xf0, 0x1b, 0xae, 0x00, 0x41, 0x06, 0xdb, 0x16, 0xda, 0x06, 0x62, 0x19, 
+0xb5, 0x00, 0xed, 0x0b, 0x5c, 0x0e, 0x98, 0x0a, 0xab, 0x00, 0xb5, 0x0c, 
+0xd0, 0x1c, 0x77, 0x00, 0xd5, 0x0c, 0x1f, 0x14, 0x6f, 0x09, 0x42, 0x01, 
+0x39, 0x13, 0xb0, 0x0a, 0x6a, 0x0a, 0xba, 0x0e, 0xad, 0x0a, 0x7d, 0x16, 
+0xee, 0x00, 0x93, 0x0c, 0x9c, 0x1a, 0x70, 0x10, 0xe3, 0x0e, 0x1d, 0x08, 
+0xd4, 0x00, 0xac, 0x0a, 0xa3, 0x0a, 0xde, 0x13, 0xb4, 0x16, 0xf9, 0x0a, 
+0x1d, 0x01, 0x0d, 0x13, 0x63, 0x09, 0xa1, 0x0a, 0x5f, 0x12, 0x41, 0x0d, 
+0xca, 0x0b, 0x14, 0x01, 0x58, 0x18, 0xde, 0x16, 0xda, 0x07, 0x31, 0x00, 
+0xea, 0x08, 0x55, 0x00, 0x06, 0x0b, 0x09, 0x0c, 0xfe, 0x09, 0xa2, 0x00, 
+0xdf, 0x13, 0xe9, 0x08, 0x75, 0x13, 0xe3, 0x0a, 0xe1, 0x09, 0x02, 0x01, 
+0xe0, 0x0b, 0x73, 0x0b, 0xbd, 0x08, 0x49, 0x06, 0xc9, 0x09, 0xea, 0x0c, 
+0x2b, 0x01, 0x02, 0x15, 0x0f, 0x0b, 0x6f, 0x10, 0x8b, 0x12, 0x84, 0x09, 
+0xcc, 0x07, 0x5f, 0x00, 0x24, 0x01, 0xc9, 0x17, 0xd8, 0x0a, 0x5b, 0x0c, 
+0x41, 0x06, 0x36, 0x01, 0x9d, 0x00, 0x52, 0x14, 0xd0, 0x14, 0x02, 0x01, 
+0xe8, 0x0d, 0xc7, 0x00, 0x81, 0x08, 0x1f, 0x14, 0xd9, 0x11, 0xfd, 0x09, 
+0x98, 0x0e, 0x02, 0x16, 0x15, 0x01, 0xaf, 0x0a, 0x6f, 0x0c, 0xce, 0x0a, 
+0x55, 0x00, 0x4d, 0x0e, 0x27, 0x0b, 0x36, 0x0a, 0xdc, 0x00, 0x18, 0x1a, 
+0xfb, 0x00, 0x40, 0x06, 0x46, 0x0b, 0x72, 0x12, 0x4e, 0x0c, 0xf2, 0x09, 
+0xe9, 0x0c, 0xde, 0x09, 0xa4, 0x15, 0x52, 0x07, 0xd9, 0x0a, 0x67, 0x00, 
+0x82, 0x08, 0x84, 0x00, 0x13, 0x11, 0x40, 0x0b, 0xf4, 0x0c, 0x61, 0x00, 
+0xbb, 0x09, 0x4e, 0x17, 0x12, 0x07, 0x11, 0x01, 0x8f, 0x0a, 0x0f, 0x01, 
+0x24, 0x00, 0x12, 0x0a, 0x5f, 0x00, 0x34, 0x01, 0xf8, 0x09, 0xe8, 0x0c, 
+0x0a, 0x01, 0xd2, 0x09, 0xfc, 0x07, 0xed, 0x0e, 0xd3, 0x10, 0xb0, 0x09, 
+0x64, 0x09, 0xe9, 0x00, 0xdd, 0x0a, 0x1f, 0x13, 0xad, 0x0a, 0x62, 0x06, 
+0x09, 0x01, 0xaf, 0x11, 0x22, 0x01, 0xe1, 0x15, 0x5e, 0x11, 0x2a, 0x18, 
+0xfc, 0x0b, 0x36, 0x0d, 0x67, 0x12, 0x9e, 0x0c, 0xd0, 0x00, 0x4b, 0x0f, 
+0xc3, 0x0a, 0x4c, 0x0b, 0x46, 0x14, 0xde, 0x00, 0xf4, 0x09, 0xf3, 0x13, 
+0x96, 0x15, 0x52, 0x14, 0xed, 0x0d, 0xd1, 0x09, 0x95, 0x0b, 0x8d, 0x0b, 
+0x48, 0x09, 0xed, 0x15, 0x11, 0x01, 0x08, 0x14, 0x26, 0x0b, 0xc9, 0x00, 
+0x04, 0x01, 0xe0, 0x08, 0xa1, 0x00, 0x74, 0x08, 0x3b, 0x00, 0xd0, 0x0a, 
+0x21, 0x01, 0x97, 0x0a, 0xbe, 0x00, 0xaf, 0x00, 0x08, 0x01, 0xb4, 0x09, 
+0x96, 0x07, 0x67, 0x13, 0x4d, 0x0a, 0x01, 0x01, 0x47, 0x00, 0xe5, 0x00, 
+0x6b, 0x17, 0xcc, 0x0e, 0xbb, 0x16, 0x25, 0x0b, 0xf2, 0x00, 0x3a, 0x01, 
+0xd0, 0x06, 0xc8, 0x0c, 0xbe, 0x07, 0x57, 0x00, 0x3e, 0x00, 0xed, 0x0b, 
+0x4d, 0x00, 0xc2, 0x00, 0x14, 0x0d, 0x4e, 0x11, 0x42, 0x06, 0xf8, 0x0c, 
+0x62, 0x0d, 0x62, 0x0d, 0x46, 0x06, 0xb6, 0x17, 0xe3, 0x06, 0xf6, 0x0b, 
+0x69, 0x06, 0xeb, 0x09, 0xe9, 0x08, 0x80, 0x09, 0xd7, 0x00, 0xf2, 0x09, 
+0x5e, 0x00, 0xc7, 0x00, 0xbb, 0x08, 0x86, 0x0d, 0x68, 0x00, 0xf6, 0x0f, 
+0x44, 0x06, 0xfa, 0x00, 0xfb, 0x14, 0x20, 0x0a, 0x58, 0x0b, 0x2f, 0x00, 
+0xee, 0x00, 0x33, 0x00, 0xe9, 0x09, 0x16, 0x17, 0x25, 0x19, 0x0e, 0x01, 
+0x7c, 0x10, 0x5e, 0x12, 0xd1, 0x0b, 0xe0, 0x08, 0xd2, 0x10, 0xbe, 0x11, 
+0xda, 0x00, 0xd6, 0x0b, 0x17, 0x00, 0x45, 0x14, 0xf3, 0x00, 0xa1, 0x07, 
+0xac, 0x00, 0x19, 0x19, 0x27, 0x01, 0x6c, 0x00, 0x9b, 0x00, 0x3a, 0x13, 
+0xb7, 0x00, 0x2c, 0x09, 0xa6, 0x09, 0x10, 0x0b, 0x46, 0x0a, 0x9b, 0x15, 
+0xa9, 0x00, 0x3c, 0x01, 0x2f, 0x08, 0xba, 0x07, 0xda, 0x06, 0x43, 0x07, 
+0x23, 0x01, 0x4c, 0x0b, 0xaa, 0x00, 0x2e, 0x14, 0x9f, 0x0b, 0x0a, 0x01, 
+0x42, 0x16, 0x40, 0x01, 0xf7, 0x0b, 0x20, 0x09, 0x05, 0x01, 0x84, 0x0a, 
+0x69, 0x0c, 0x7e, 0x0b, 0xaf, 0x0a, 0x30, 0x00, 0xa8, 0x00, 0x01, 0x0d, 
+0xe4, 0x0d, 0x35, 0x0b, 0x80, 0x00, 0x73, 0x07, 0x26, 0x01, 0x5e, 0x0b, 
+0xe0, 0x18, 0x60, 0x00, 0x16, 0x17, 0xfe, 0x07, 0x28, 0x01, 0x34, 0x18, 
+0xf1, 0x09, 0xdf, 0x16, 0x3e, 0x0a, 0x94, 0x00, 0x90, 0x00, 0x7d, 0x16, 
+0x0f, 0x0c, 0x27, 0x0b, 0x2b, 0x01, 0x43, 0x06, 0xe5, 0x0a, 0xb8, 0x0b, 
+0x36, 0x00, 0xb6, 0x00, 0x13, 0x0e, 0x93, 0x0c, 0xdc, 0x06, 0xac, 0x00, 
+0xf9, 0x0b, 0xb2, 0x06, 0x77, 0x00, 0x9b, 0x11, 0xe6, 0x09, 0x94, 0x0b, 
+0x8f, 0x00, 0x00, 0x01, 0x0f, 0x16, 0x48, 0x06, 0xbd, 0x00, 0xf7, 0x0b, 
+0x7e, 0x0c, 0x03, 0x0a, 0x30, 0x00, 0x44, 0x0c, 0xab, 0x00, 0x8f, 0x06, 
+0x7f, 0x0b, 0x0f, 0x00, 0xbc, 0x09, 0x1b, 0x0e, 0x05, 0x01, 0x49, 0x06, 
+0x62, 0x09, 0x68, 0x09, 0xd3, 0x0f, 0x9b, 0x0c, 0x4b, 0x11, 0xae, 0x00, 
+0x68, 0x07, 0x2c, 0x0a, 0xab, 0x00, 0xbb, 0x0b, 0x33, 0x00, 0x4f, 0x0c, 
+0x38, 0x01, 0x1c, 0x07, 0x86, 0x10, 0x93, 0x0b, 0x07, 0x01, 0x93, 0x0e, 
+0x3e, 0x01, 0xcd, 0x0b, 0x2e, 0x00, 0x41, 0x06, 0x74, 0x0b, 0x03, 0x13, 
+0x56, 0x11, 0x44, 0x0a, 0x7a, 0x0c, 0xa8, 0x09, 0xb5, 0x09, 0x04, 0x01, 
+0xf8, 0x00, 0xaa, 0x00, 0x8c, 0x00, 0xbb, 0x0a, 0x03, 0x13, 0xb6, 0x00, 
+0xbc, 0x14, 0x55, 0x07, 0x80, 0x13, 0xc7, 0x09, 0xef, 0x00, 0x94, 0x0b, 
+0x25, 0x00, 0x0a, 0x01, 0xdf, 0x08, 0xfc, 0x07, 0x1b, 0x0f, 0xc5, 0x00, 
+0xd8, 0x00, 0xae, 0x00, 0x3b, 0x0d, 0xf9, 0x11, 0x72, 0x0d, 0x31, 0x01, 
+0x94, 0x0c, 0xb2, 0x11, 0xed, 0x0d, 0xc8, 0x00, 0x9c, 0x00, 0x25, 0x00, 
+0xfa, 0x15, 0xda, 0x00, 0x97, 0x06, 0x8f, 0x06, 0xa9, 0x15, 0x18, 0x13, 
+0x1d, 0x11, 0x0b, 0x09, 0x0e, 0x13, 0x95, 0x0c, 0x25, 0x13, 0xce, 0x00, 
+0x1e, 0x0b, 0x1b, 0x08, 0x61, 0x00, 0xee, 0x0a, 0x14, 0x01, 0x60, 0x06, 
+0x83, 0x00, 0xc0, 0x09, 0xed, 0x09, 0xc6, 0x00, 0x24, 0x00, 0xbb, 0x09, 
+0x4e, 0x06, 0xbb, 0x0b, 0x65, 0x0a, 0x6b, 0x00, 0x5d, 0x0f, 0x57, 0x18, 
+0xbd, 0x00, 0x52, 0x07, 0x8f, 0x16, 0xdc, 0x10, 0xb0, 0x00, 0x43, 0x06, 
+0xf9, 0x00, 0x6c, 0x00, 0xe6, 0x0d, 0xc5, 0x00, 0x37, 0x01, 0x93, 0x0c, 
+0x97, 0x0a, 0x72, 0x16, 0x43, 0x06, 0xb7, 0x00, 0xed, 0x09, 0xd9, 0x0b, 
+0x17, 0x00, 0x31, 0x0c, 0xf8, 0x00, 0xcf, 0x06, 0x40, 0x00, 0x88, 0x00, 
+0x6f, 0x0c, 0x28, 0x0a, 0x72, 0x0c, 0x4e, 0x17, 0x70, 0x06, 0x28, 0x08, 
+0x1f, 0x01, 0xa0, 0x07, 0xdd, 0x06, 0xb3, 0x16, 0x64, 0x0c, 0xd1, 0x06, 
+0x07, 0x01, 0xff, 0x09, 0xd1, 0x09, 0x85, 0x0c, 0x86, 0x00, 0x31, 0x12, 
+0x7f, 0x0b, 0xe3, 0x07, 0xc9, 0x0f, 0x8c, 0x0a, 0x48, 0x0b, 0x31, 0x0c, 
+0x87, 0x00, 0x07, 0x01, 0xb2, 0x15, 0x4c, 0x0a, 0xd1, 0x06, 0x6d, 0x09, 
+0xf9, 0x13, 0xd5, 0x00, 0x40, 0x01, 0xc2, 0x08, 0x41, 0x09, 0x77, 0x0a, 
+0xe1, 0x07, 0x15, 0x14, 0xaf, 0x00, 0xbb, 0x07, 0x1b, 0x0b, 0x2e, 0x0c, 
+0x88, 0x0c, 0xea, 0x00, 0xe3, 0x0b, 0x36, 0x10, 0x8e, 0x0a, 0xa5, 0x10, 
+0xbf, 0x06, 0xfc, 0x0b, 0x48, 0x06, 0x00, 0x0a, 0xe4, 0x0b, 0xcb, 0x0f, 
+0xe5, 0x00, 0xbb, 0x00, 0x41, 0x0e, 0x2b, 0x0a, 0x4b, 0x00, 0xf9, 0x00, 
+0xa7, 0x09, 0xb6, 0x0b, 0x16, 0x01, 0x46, 0x08, 0xaf, 0x00, 0x22, 0x09, 
+0x8c, 0x06, 0xa7, 0x0a, 0xb0, 0x09, 0xd5, 0x00, 0x22, 0x0c, 0xcb, 0x0b, 
+0x42, 0x18, 0x23, 0x08, 0xbb, 0x08, 0xca, 0x08, 0x14, 0x01, 0xd2, 0x10, 
+0x48, 0x06, 0x48, 0x06, 0x88, 0x08, 0x46, 0x06, 0xe3, 0x0a, 0xd5, 0x09, 
+0x5f, 0x0a, 0xfe, 0x0f, 0x59, 0x0c, 0x7a, 0x0a, 0x8d, 0x0b, 0xa6, 0x09, 
+0xff, 0x00, 0xbb, 0x00, 0xcb, 0x0c, 0x10, 0x13, 0x1a, 0x08, 0xe8, 0x0c, 
+0xb6, 0x00, 0x87, 0x00, 0xdc, 0x00, 0x80, 0x09, 0xe4, 0x0d, 0x24, 0x01, 
+0x88, 0x08, 0xdc, 0x09, 0x35, 0x0a, 0x61, 0x00, 0xec, 0x00, 0x5f, 0x00, 
+0xea, 0x00, 0x09, 0x0a, 0x04, 0x0b, 0x32, 0x18, 0x8b, 0x00, 0x15, 0x01, 
+0x64, 0x0c, 0xd5, 0x09, 0x8a, 0x0c, 0x46, 0x06, 0x4d, 0x00, 0x3b, 0x16, 
+0xb1, 0x00, 0xf6, 0x0b, 0xd1, 0x09, 0x75, 0x16, 0xe3, 0x0a, 0xde, 0x17, 
+0x0b, 0x0a, 0xe9, 0x08, 0x78, 0x0e, 0x6d, 0x1c, 0x31, 0x08, 0x08, 0x13, 
+0xc4, 0x00, 0x0b, 0x09, 0x11, 0x0d, 0xf3, 0x00, 0x70, 0x00, 0x15, 0x01, 
+0xfc, 0x14, 0x23, 0x01, 0xac, 0x0b, 0x35, 0x00, 0xb3, 0x17, 0x7e, 0x0b, 
+0x84, 0x10, 0x5e, 0x12, 0x7a, 0x07, 0xe1, 0x07, 0x49, 0x06, 0x83, 0x0a, 
+0xbd, 0x09, 0xff, 0x00, 0x33, 0x00, 0x43, 0x06, 0x95, 0x0b, 0x3c, 0x0b, 
+0x06, 0x01, 0xfc, 0x0a, 0xef, 0x00, 0x95, 0x00, 0x2e, 0x01, 0x83, 0x00, 
+0x69, 0x0e, 0x9d, 0x08, 0xae, 0x00, 0xbf, 0x0c, 0x9b, 0x00, 0x00, 0x01, 
+0x8e, 0x0b, 0x28, 0x0b, 0xb2, 0x09, 0xb7, 0x00, 0x93, 0x0c, 0x77, 0x0a, 
+0x33, 0x00, 0x05, 0x01, 0xad, 0x0a, 0xba, 0x0a, 0x3f, 0x00, 0x10, 0x07, 
+0xe3, 0x00, 0xd3, 0x19, 0x62, 0x00, 0x75, 0x0c, 0x8c, 0x12, 0x2e, 0x01, 
+0x6c, 0x0b, 0x5b, 0x08, 0xb5, 0x00, 0xee, 0x00, 0x2b, 0x01, 0xf7, 0x0f, 
+0x03, 0x01, 0xcf, 0x00, 0x08, 0x0d, 0xb4, 0x0c, 0x40, 0x06, 0x32, 0x00, 
+0xa0, 0x07, 0xdb, 0x0a, 0x78, 0x00, 0xc7, 0x17, 0x42, 0x14, 0xdd, 0x00, 
+0x5b, 0x0c, 0xd7, 0x0b, 0x03, 0x01, 0xf3, 0x0c, 0xee, 0x0a, 0x18, 0x1a, 
+0xe6, 0x09, 0xed, 0x07, 0x79, 0x10, 0xc2, 0x0b, 0xa7, 0x00, 0xff, 0x07, 
+0x79, 0x00, 0x7a, 0x00, 0x58, 0x07, 0x3c, 0x13, 0xea, 0x0c, 0xcb, 0x0b, 
+0x5f, 0x00, 0x9d, 0x00, 0x5c, 0x0b, 0xcf, 0x06, 0x1e, 0x01, 0x17, 0x01, 
+0xdd, 0x00, 0x37, 0x0b, 0x51, 0x0c, 0xbc, 0x0a, 0x1a, 0x0b, 0x88, 0x0b, 
+0xf3, 0x0c, 0xcd, 0x00, 0x48, 0x1a, 0x36, 0x01, 0x62, 0x00, 0x16, 0x17, 
+0x56, 0x06, 0x31, 0x01, 0x15, 0x00, 0x3f, 0x01, 0x13, 0x0a, 0xab, 0x18, 
+0xaf, 0x09, 0xdf, 0x0c, 0xd2, 0x09, 0xe7, 0x0b, 0x55, 0x0a, 0x12, 0x00, 
+0x17, 0x00, 0x8c, 0x06, 0x3f, 0x01, 0x44, 0x0a, 0x28, 0x01, 0xc9, 0x00, 
+0x9e, 0x0b, 0xfe, 0x00, 0x36, 0x00, 0x26, 0x07, 0xcb, 0x0c, 0x98, 0x0a, 
+0x46, 0x06, 0x1f, 0x0a, 0x68, 0x0c, 0xc5, 0x09, 0x43, 0x06, 0x6d, 0x14, 
+0x0c, 0x0c, 0x8e, 0x17, 0x40, 0x01, 0x39, 0x01, 0x27, 0x08, 0x49, 0x0a, 
+0xc2, 0x00, 0x6f, 0x10, 0x41, 0x0a, 0x72, 0x08, 0x90, 0x00, 0x3f, 0x07, 
+0x6e, 0x00, 0x77, 0x0a, 0xf2, 0x00, 0x41, 0x06, 0xdf, 0x12, 0x18, 0x0c, 
+0x10, 0x0b, 0x91, 0x0c, 0x67, 0x14, 0xb4, 0x0f, 0x96, 0x17, 0xd5, 0x00, 
+0xfd, 0x08, 0x72, 0x0b, 0x68, 0x0b, 0xdc, 0x14, 0xe6, 0x0b, 0x5d, 0x1a, 
+0xcf, 0x00, 0xca, 0x0c, 0x91, 0x00, 0x41, 0x18, 0x9b, 0x0c, 0x07, 0x0a, 
+0xba, 0x00, 0x06, 0x0b, 0xcc, 0x00, 0xd5, 0x00, 0x9b, 0x0c, 0x27, 0x12, 
+0x64, 0x09, 0xdc, 0x10, 0x92, 0x00, 0xcf, 0x15, 0x73, 0x16, 0xe0, 0x0b, 
+0xeb, 0x15, 0x86, 0x0a, 0xc0, 0x12, 0x1a, 0x00, 0x13, 0x0a, 0xf1, 0x00, 
+0xe1, 0x00, 0xf8, 0x00, 0x52, 0x07, 0xfd, 0x0d, 0x5e, 0x15, 0xd5, 0x14, 
+0x45, 0x06, 0x47, 0x00, 0x81, 0x08, 0xde, 0x00, 0xf9, 0x00, 0x3f, 0x0a, 
+0x6e, 0x09, 0xaf, 0x00, 0x3e, 0x0f, 0x40, 0x06, 0x66, 0x0a, 0xd5, 0x0c, 
+0x28, 0x0a, 0x6d, 0x00, 0x14, 0x01, 0x04, 0x13, 0x24, 0x13, 0xc4, 0x0a, 
+0x3b, 0x01, 0xb3, 0x17, 0x3e, 0x00, 0xc3, 0x0b, 0xf4, 0x07, 0x35, 0x01, 
+0xaf, 0x00, 0xa1, 0x15, 0xee, 0x0d, 0xfc, 0x08, 0xd5, 0x0c, 0x0f, 0x0b, 
+0x96, 0x0f, 0xff, 0x00, 0xe0, 0x00, 0xe6, 0x00, 0x1a, 0x0b, 0x68, 0x09, 
+0xd0, 0x09, 0x02, 0x01, 0xfd, 0x0d, 0x4a, 0x0a, 0x71, 0x00, 0x36, 0x08, 
+0x26, 0x00, 0xca, 0x13, 0x1e, 0x15, 0x64, 0x0c, 0x72, 0x0c, 0xd3, 0x0a, 
+0xdd, 0x00, 0xb7, 0x0a, 0x3c, 0x01, 0x18, 0x00, 0xd1, 0x08, 0x1c, 0x00, 
+0x1c, 0x17, 0x41, 0x10, 0xa5, 0x09, 0x11, 0x0b, 0x3f, 0x09, 0x3e, 0x0d, 
+0xf6, 0x0b, 0xf2, 0x13, 0xfc, 0x16, 0x35, 0x01, 0x63, 0x19, 0x9b, 0x00, 
+0x3a, 0x0c, 0x85, 0x00, 0x45, 0x0c, 0xe7, 0x00, 0x96, 0x0a, 0xe3, 0x00, 
+0xc6, 0x00, 0x31, 0x0b, 0x8f, 0x00, 0x32, 0x18, 0xe0, 0x00, 0x7f, 0x0b, 
+0x48, 0x12, 0xdf, 0x06, 0xaf, 0x00, 0x1f, 0x01, 0xb9, 0x00, 0x45, 0x06, 
+0xed, 0x00, 0xfc, 0x09, 0xa2, 0x0a, 0xca, 0x0b, 0x6e, 0x0a, 0x71, 0x00, 
+0xdd, 0x10, 0xc0, 0x00, 0xc8, 0x00, 0xf6, 0x0d, 0x12, 0x0b, 0x5d, 0x0c, 
+0x66, 0x0c, 0xa5, 0x19, 0x79, 0x0a, 0x68, 0x13, 0x5a, 0x00, 0x11, 0x01, 
+0x24, 0x0c, 0xbc, 0x00, 0xc2, 0x0b, 0x99, 0x12, 0x0e, 0x0a, 0x02, 0x0d, 
+0x74, 0x07, 0x91, 0x00, 0x79, 0x10, 0xfd, 0x11, 0x70, 0x00, 0x47, 0x06, 
+0x78, 0x09, 0xe1, 0x00, 0x25, 0x01, 0x36, 0x0d, 0x61, 0x06, 0x0c, 0x13, 
+0xc1, 0x0b, 0x19, 0x01, 0xde, 0x0c, 0x72, 0x16, 0x44, 0x06, 0x42, 0x14, 
+0xed, 0x1b, 0x02, 0x15, 0x26, 0x12, 0x9f, 0x0b, 0x71, 0x12, 0x35, 0x01, 
+0x5f, 0x00, 0x54, 0x00, 0x74, 0x16, 0x86, 0x0c, 0xcf, 0x00, 0xc6, 0x09, 
+0x86, 0x0c, 0xdc, 0x06, 0xb5, 0x00, 0x47, 0x0c, 0x60, 0x07, 0xd0, 0x09, 
+0xb3, 0x00, 0xe0, 0x08, 0x4a, 0x14, 0x6f, 0x0c, 0xad, 0x00, 0x2b, 0x01, 
+0xd8, 0x0b, 0xf3, 0x09, 0x32, 0x12, 0x75, 0x0c, 0x42, 0x01, 0x98, 0x14, 
+0x60, 0x08, 0xfa, 0x0a, 0x26, 0x00, 0x25, 0x01, 0xc5, 0x00, 0x38, 0x0d, 
+0xc6, 0x00, 0x19, 0x08, 0x00, 0x0d, 0xfc, 0x0a, 0x69, 0x00, 0xa5, 0x09, 
+0x1b, 0x00, 0x18, 0x00, 0x1a, 0x01, 0x2b, 0x01, 0x14, 0x01, 0x11, 0x07, 
+0xa6, 0x10, 0xc7, 0x0a, 0xe2, 0x14, 0x19, 0x01, 0x1e, 0x01, 0xc4, 0x06, 
+0xd8, 0x0a, 0x08, 0x01, 0xec, 0x16, 0x11, 0x01, 0x66, 0x12, 0x1e, 0x0a, 
+0x4b, 0x0a, 0x2b, 0x00, 0x56, 0x00, 0x31, 0x00, 0x2a, 0x0a, 0x19, 0x08, 
+0x09, 0x01, 0x6b, 0x00, 0xba, 0x16, 0xe6, 0x09, 0x99, 0x06, 0x5d, 0x0b, 
+0x35, 0x01, 0xbc, 0x00, 0x79, 0x07, 0x00, 0x01, 0x39, 0x0c, 0x27, 0x08, 
+0x3e, 0x00, 0x0f, 0x01, 0xf6, 0x00, 0x53, 0x00, 0x0a, 0x01, 0xb9, 0x1b, 
+0xf2, 0x00, 0xab, 0x00, 0x52, 0x13, 0xa8, 0x00, 0xfb, 0x00, 0x9b, 0x00, 
+0x31, 0x08, 0x71, 0x0c, 0xc7, 0x00, 0x15, 0x0e, 0xe0, 0x0b, 0x04, 0x0b, 
+0x49, 0x06, 0xf6, 0x0b, 0xec, 0x07, 0xb1, 0x0b, 0xd3, 0x06, 0xcf, 0x00, 
+0x24, 0x13, 0x3e, 0x0a, 0xb1, 0x00, 0x7a, 0x1a, 0x6d, 0x0c, 0x54, 0x0b, 
+0xfd, 0x0b, 0xe4, 0x07, 0x4c, 0x06, 0x7b, 0x12, 0xa5, 0x09, 0x20, 0x0b, 
+0x2f, 0x00, 0xa0, 0x15, 0x47, 0x09, 0x1c, 0x07, 0x15, 0x01, 0x57, 0x0a, 
+0xd5, 0x00, 0x90, 0x09, 0x21, 0x09, 0xbe, 0x08, 0x31, 0x08, 0x17, 0x01, 
+0x1b, 0x0b, 0xe8, 0x00, 0xac, 0x00, 0x97, 0x0b, 0xd0, 0x0a, 0xd0, 0x06, 
+0x78, 0x00, 0x5d, 0x0b, 0x3b, 0x0b, 0x69, 0x0b, 0xc6, 0x09, 0x40, 0x0a, 
+0x85, 0x0c, 0xbb, 0x0e, 0xb3, 0x00, 0x31, 0x0c, 0xa7, 0x00, 0x29, 0x01, 
+0xbc, 0x00, 0x43, 0x06, 0x33, 0x0b, 0x58, 0x00, 0x44, 0x0c, 0x77, 0x0d, 
+0x0f, 0x01, 0xcf, 0x00, 0x24, 0x19, 0x64, 0x0c, 0x0b, 0x01, 0x25, 0x01, 
+0xee, 0x0a, 0xeb, 0x00, 0xd1, 0x0b, 0x9e, 0x0b, 0x3b, 0x00, 0xc6, 0x00, 
+0x9c, 0x00, 0xe3, 0x0a, 0xb4, 0x00, 0x46, 0x0e, 0xff, 0x00, 0xe9, 0x00, 
+0x17, 0x1a, 0xf8, 0x07, 0x2f, 0x0c, 0x38, 0x07, 0xe5, 0x06, 0xd3, 0x10, 
+0xf4, 0x00, 0x97, 0x07, 0xaf, 0x10, 0x24, 0x00, 0xf0, 0x00, 0xbf, 0x15, 
+0x16, 0x12, 0x88, 0x0c, 0x66, 0x13, 0x62, 0x0d, 0xeb, 0x08, 0xd8, 0x0a, 
+0x65, 0x08, 0x44, 0x06, 0x1c, 0x08, 0xe2, 0x10, 0xfc, 0x15, 0x4e, 0x1c, 
+0xe4, 0x06, 0x19, 0x01, 0xda, 0x00, 0x24, 0x00, 0x4f, 0x06, 0xa9, 0x0c, 
+0x7e, 0x0e, 0xaf, 0x09, 0x60, 0x00, 0x77, 0x0a, 0xe7, 0x07, 0x4c, 0x00, 
+0x28, 0x0a, 0xd3, 0x11, 0xd2, 0x00, 0xf7, 0x0f, 0x9d, 0x0c, 0xb2, 0x00, 
+0x33, 0x15, 0xf5, 0x00, 0xfe, 0x09, 0xf5, 0x00, 0xf3, 0x09, 0xff, 0x00, 
+0x29, 0x0f, 0x77, 0x0d, 0x26, 0x0b, 0x34, 0x0e, 0x89, 0x10, 0x10, 0x0b, 
+0x81, 0x0a, 0x85, 0x0e, 0xbb, 0x09, 0xa6, 0x0c, 0xb5, 0x0c, 0x29, 0x12, 
+0xe5, 0x07, 0x6e, 0x10, 0x0d, 0x01, 0x64, 0x0c, 0x27, 0x1a, 0x1f, 0x01, 
+0x17, 0x01, 0x57, 0x06, 0x0d, 0x01, 0x7a, 0x0c, 0x1a, 0x0c, 0xf8, 0x0d, 
+0xa5, 0x06, 0x3e, 0x00, 0xdf, 0x00, 0xd7, 0x09, 0xa9, 0x0b, 0xf2, 0x00, 
+0x8b, 0x10, 0xa1, 0x0a, 0x79, 0x00, 0xfc, 0x00, 0x58, 0x06, 0x2b, 0x0a, 
+0xeb, 0x0b, 0x06, 0x13, 0x45, 0x06, 0x60, 0x00, 0xc0, 0x1a, 0x3f, 0x0a, 
+0x98, 0x06, 0xb0, 0x00, 0x85, 0x00, 0x01, 0x0c, 0x6f, 0x09, 0x40, 0x06, 
+0x90, 0x0c, 0x6d, 0x09, 0x35, 0x0c, 0x54, 0x07, 0x73, 0x16, 0xbf, 0x00, 
+0xb7, 0x00, 0xb7, 0x0a, 0xcd, 0x15, 0x80, 0x0a, 0x07, 0x0b, 0xc1, 0x00, 
+0xcb, 0x00, 0xb6, 0x00, 0x3a, 0x13, 0x98, 0x0e, 0x44, 0x15, 0xf5, 0x06, 
+0xb3, 0x00, 0x9b, 0x0c, 0x6f, 0x00, 0x73, 0x0b, 0x21, 0x01, 0x49, 0x00, 
+0x44, 0x0c, 0xe7, 0x0f, 0x1f, 0x14, 0xeb, 0x16, 0x20, 0x09, 0x67, 0x08, 
+0xbb, 0x00, 0xd8, 0x00, 0x2a, 0x0e, 0xac, 0x0a, 0x62, 0x0f, 0xf1, 0x0a, 
+0x96, 0x07, 0xda, 0x0d, 0xbc, 0x00, 0x3b, 0x12, 0x7a, 0x10, 0xca, 0x00, 
+0xf2, 0x0b, 0xc7, 0x0a, 0xe9, 0x0c, 0xeb, 0x15, 0x9f, 0x0b, 0x1b, 0x00, 
+0xaa, 0x00, 0x45, 0x08, 0x98, 0x0a, 0xf2, 0x18, 0xdf, 0x15, 0xbe, 0x00, 
+0x1a, 0x08, 0x98, 0x07, 0x3e, 0x00, 0xe5, 0x12, 0x4d, 0x06, 0xe0, 0x0e, 
+0x5c, 0x0b, 0x95, 0x16, 0x15, 0x0b, 0x89, 0x09, 0xab, 0x06, 0x02, 0x16, 
+0xd0, 0x08, 0x17, 0x00, 0xe1, 0x00, 0xb1, 0x15, 0x92, 0x0c, 0xce, 0x14, 
+0xc9, 0x0e, 0xc8, 0x0c, 0x5f, 0x06, 0x04, 0x01, 0x57, 0x0a, 0xe3, 0x0a, 
+0x94, 0x11, 0x62, 0x00, 0x53, 0x0b, 0x84, 0x00, 0xec, 0x09, 0x74, 0x16, 
+0xd5, 0x09, 0x77, 0x0a, 0x24, 0x00, 0x20, 0x07, 0xb0, 0x09, 0x65, 0x09, 
+0xe7, 0x00, 0x78, 0x00, 0x37, 0x01, 0x6d, 0x00, 0x8d, 0x0b, 0x0c, 0x13, 
+0x43, 0x15, 0x8a, 0x13, 0xb3, 0x07, 0x26, 0x14, 0xfa, 0x0e, 0x04, 0x0b, 
+0xaf, 0x00, 0x69, 0x06, 0x4a, 0x13, 0x1b, 0x01, 0x25, 0x0b, 0x0e, 0x0c, 
+0x77, 0x0b, 0xba, 0x14, 0xd1, 0x06, 0x32, 0x09, 0xc0, 0x0e, 0x56, 0x00, 
+0x87, 0x17, 0x30, 0x01, 0xe9, 0x00, 0xe5, 0x0a, 0x0f, 0x0b, 0x05, 0x01, 
+0xdb, 0x00, 0xcb, 0x0f, 0x90, 0x0c, 0x23, 0x00, 0x6e, 0x13, 0xcd, 0x00, 
+0x33, 0x0b, 0x0c, 0x00, 0x30, 0x0b, 0x47, 0x0b, 0x1c, 0x0e, 0x47, 0x0b, 
+0x90, 0x1a, 0x1b, 0x0b, 0x96, 0x15, 0x46, 0x00, 0xfd, 0x00, 0x16, 0x07, 
+0x83, 0x11, 0xbd, 0x09, 0x4b, 0x0b, 0xa5, 0x10, 0x23, 0x01, 0xd1, 0x00, 
+0xa9, 0x16, 0x25, 0x13, 0xb3, 0x00, 0x60, 0x0a, 0x54, 0x0a, 0x31, 0x00, 
+0x61, 0x00, 0xb6, 0x00, 0x0e, 0x19, 0x3e, 0x08, 0x24, 0x01, 0x45, 0x0a, 
+0x77, 0x06, 0x10, 0x07, 0x31, 0x01, 0x2b, 0x0b, 0xce, 0x00, 0xd6, 0x18, 
+0x6a, 0x0e, 0xa7, 0x08, 0x35, 0x0a, 0xeb, 0x0b, 0x6e, 0x12, 0x3c, 0x0b, 
+0x45, 0x0a, 0x9c, 0x0f, 0x4e, 0x06, 0xe1, 0x00, 0xe1, 0x07, 0x8b, 0x0a, 
+0xd6, 0x0b, 0x48, 0x00, 0x19, 0x08, 0x71, 0x06, 0xc4, 0x14, 0xb1, 0x15, 
+0x02, 0x01, 0x19, 0x08, 0xb0, 0x09, 0x42, 0x06, 0x1b, 0x07, 0xe9, 0x00, 
+0xa9, 0x0b, 0x94, 0x0b, 0x54, 0x0b, 0x69, 0x0c, 0x86, 0x00, 0x19, 0x08, 
+0x49, 0x06, 0xf3, 0x14, 0x70, 0x00, 0x1a, 0x0f, 0x60, 0x06, 0x72, 0x00, 
+0x70, 0x0c, 0x99, 0x12, 0x1a, 0x19, 0x42, 0x12, 0xbe, 0x00, 0x97, 0x00, 
+0xad, 0x0a, 0xf1, 0x09, 0xb9, 0x00, 0xb1, 0x08, 0x71, 0x00, 0x42, 0x0a, 
+0xc1, 0x16, 0x45, 0x1c, 0xca, 0x0b, 0x2a, 0x0b, 0xaa, 0x08, 0x19, 0x01, 
+0x1e, 0x0b, 0xa7, 0x00, 0xef, 0x00, 0x49, 0x06, 0xc5, 0x09, 0x2b, 0x00, 
+0x46, 0x09, 0x97, 0x0f, 0x61, 0x17, 0x33, 0x0a, 0xf2, 0x0a, 0x03, 0x13, 
+0x39, 0x13, 0xb3, 0x00, 0x4b, 0x14, 0x7b, 0x00, 0xb4, 0x00, 0x8f, 0x17, 
+0x4a, 0x00, 0xe3, 0x08, 0xd6, 0x17, 0x89, 0x0c, 0xa1, 0x06, 0xdf, 0x15, 
+0x6b, 0x0b, 0x61, 0x0a, 0xc2, 0x0e, 0x6f, 0x0c, 0xaf, 0x10, 0x9b, 0x00, 
+0x38, 0x0f, 0xe6, 0x00, 0x98, 0x0b, 0xf9, 0x09, 0xd6, 0x00, 0x3e, 0x0f, 
+0xe9, 0x0c, 0xe4, 0x00, 0x55, 0x06, 0x3c, 0x0c, 0x72, 0x00, 0xd9, 0x00, 
+0x0c, 0x09, 0x5a, 0x0c, 0xf9, 0x0a, 0xec, 0x00, 0xd4, 0x00, 0x25, 0x09, 
+0x28, 0x0a, 0x20, 0x0b, 0xb7, 0x0a, 0xd2, 0x00, 0x8d, 0x06, 0xab, 0x00, 
+0x13, 0x01, 0x1d, 0x0a, 0x63, 0x06, 0xfd, 0x07, 0xee, 0x00, 0xa4, 0x09, 
+0x8a, 0x09, 0xf3, 0x00, 0xc1, 0x0b, 0xe9, 0x0a, 0x9c, 0x00, 0xe5, 0x00, 
+0xe0, 0x00, 0x17, 0x14, 0x28, 0x0c, 0xdf, 0x11, 0x3b, 0x0c, 0x15, 0x14, 
+0xbb, 0x16, 0x96, 0x0a, 0x92, 0x0c, 0x87, 0x17, 0xf1, 0x09, 0x47, 0x06, 
+0x88, 0x09, 0x4c, 0x06, 0x02, 0x01, 0x53, 0x00, 0x01, 0x0c, 0x0b, 0x10, 
+0xdb, 0x00, 0xdf, 0x08, 0x7a, 0x09, 0xec, 0x06, 0xf0, 0x11, 0xd1, 0x00, 
+0x81, 0x0a, 0xba, 0x00, 0xf8, 0x09, 0x39, 0x01, 0xfc, 0x07, 0x8c, 0x0a, 
+0x8d, 0x0a, 0x6d, 0x0a, 0x60, 0x08, 0xaf, 0x11, 0xf8, 0x08, 0x7b, 0x1b, 
+0x5f, 0x0a, 0x9d, 0x00, 0x24, 0x08, 0xa9, 0x0b, 0xff, 0x0c, 0xd2, 0x10, 
+0xd3, 0x0f, 0x6c, 0x0c, 0xe7, 0x00, 0x65, 0x0b, 0xb9, 0x11, 0x3b, 0x0b, 
+0xb0, 0x09, 0x52, 0x0b, 0x8c, 0x00, 0x29, 0x0a, 0x94, 0x0a, 0x0e, 0x01, 
+0xb9, 0x1b, 0xd3, 0x0a, 0x91, 0x13, 0xa9, 0x0b, 0x84, 0x00, 0x67, 0x08, 
+0x6f, 0x12, 0xe3, 0x09, 0x10, 0x10, 0xfa, 0x00, 0x1e, 0x0b, 0xb3, 0x00, 
+0x45, 0x08, 0x38, 0x0c, 0xe0, 0x12, 0x26, 0x12, 0xb4, 0x0f, 0xfc, 0x0a, 
+0x36, 0x01, 0x9c, 0x0c, 0xd8, 0x14, 0x3a, 0x08, 0xb2, 0x07, 0xdd, 0x0c, 
+0xc1, 0x0b, 0xa8, 0x0f, 0x3f, 0x01, 0xe6, 0x0a, 0x76, 0x16, 0x46, 0x0e, 
+0x8b, 0x0a, 0x49, 0x0a, 0x44, 0x06, 0x3d, 0x00, 0x0f, 0x00, 0x40, 0x01, 
+0x6e, 0x10, 0x44, 0x14, 0xcc, 0x00, 0x50, 0x0c, 0xcb, 0x00, 0x1c, 0x01, 
+0x7c, 0x00, 0x56, 0x00, 0xcf, 0x00, 0x40, 0x00, 0xad, 0x0a, 0x6b, 0x00, 
+0xfd, 0x00, 0x9c, 0x18, 0xed, 0x0b, 0xba, 0x09, 0x29, 0x0b, 0xa8, 0x00, 
+0xf5, 0x08, 0xc2, 0x08, 0xc9, 0x0c, 0x5a, 0x0c, 0x8d, 0x06, 0x7c, 0x18, 
+0x4d, 0x0a, 0xab, 0x07, 0xc8, 0x0c, 0x57, 0x00, 0xe1, 0x00, 0x05, 0x0c, 
+0xea, 0x00, 0xd8, 0x0e, 0xfe, 0x0a, 0xcb, 0x0f, 0x14, 0x07, 0xe6, 0x00, 
+0xe1, 0x00, 0xe4, 0x00, 0x5a, 0x00, 0x62, 0x1b, 0x27, 0x0b, 0xf2, 0x13, 
+0x19, 0x00, 0xd0, 0x09, 0x5e, 0x0b, 0x39, 0x01, 0xd1, 0x00, 0x76, 0x0b, 
+0x27, 0x01, 0x94, 0x00, 0x22, 0x0f, 0x2e, 0x01, 0xcd, 0x00, 0xaf, 0x09, 
+0x7d, 0x16, 0x59, 0x0c, 0x11, 0x13, 0x41, 0x01, 0xd1, 0x00, 0x90, 0x00, 
+0xb0, 0x1a, 0x91, 0x09, 0xe0, 0x0d, 0x0a, 0x01, 0xea, 0x00, 0x67, 0x0b, 
+0xf5, 0x00, 0x89, 0x10, 0x4e, 0x0c, 0xae, 0x00, 0x0f, 0x01, 0x78, 0x09, 
+0xb3, 0x00, 0x92, 0x09, 0x9c, 0x0c, 0x23, 0x0c, 0x11, 0x07, 0xe3, 0x0a, 
+0x08, 0x0d, 0x92, 0x00, 0x2d, 0x0c, 0xed, 0x0b, 0xf5, 0x0c, 0xb1, 0x0c, 
+0x32, 0x0c, 0xfa, 0x0a, 0xaf, 0x0b, 0x32, 0x00, 0x29, 0x07, 0x4c, 0x0a, 
+0xfb, 0x00, 0x05, 0x09, 0xd9, 0x0a, 0xbf, 0x10, 0x67, 0x13, 0xba, 0x08, 
+0x07, 0x07, 0x18, 0x08, 0xdd, 0x09, 0x3a, 0x1a, 0x96, 0x0a, 0x1e, 0x01, 
+0x7a, 0x18, 0x96, 0x10, 0x88, 0x00, 0x64, 0x00, 0xd3, 0x00, 0x52, 0x17, 
+0x7f, 0x16, 0x66, 0x1b, 0x62, 0x00, 0xc8, 0x0c, 0x81, 0x09, 0x94, 0x0c, 
+0xf4, 0x0a, 0xb1, 0x0c, 0xe0, 0x0c, 0xe1, 0x07, 0xff, 0x00, 0xd4, 0x09, 
+0xf6, 0x0c, 0xa9, 0x00, 0x40, 0x0a, 0x22, 0x10, 0x1c, 0x01, 0x49, 0x0b, 
+0x82, 0x0a, 0xd7, 0x0b, 0xbe, 0x09, 0xf9, 0x18, 0x39, 0x0c, 0xeb, 0x08, 
+0x9b, 0x00, 0xad, 0x15, 0x52, 0x07, 0x71, 0x10, 0x4f, 0x17, 0x65, 0x08, 
+0x5a, 0x10, 0x2a, 0x15, 0x63, 0x0d, 0x07, 0x0c, 0x1e, 0x0b, 0xe5, 0x0a, 
+0xcf, 0x0b, 0xed, 0x00, 0x4c, 0x00, 0xa4, 0x06, 0x1e, 0x0a, 0xf5, 0x17, 
+0x46, 0x0b, 0xc1, 0x09, 0x34, 0x00, 0x2d, 0x01, 0x94, 0x07, 0x09, 0x0c, 
+0xe4, 0x00, 0xee, 0x0a, 0xb8, 0x0b, 0x68, 0x06, 0x19, 0x01, 0x0b, 0x01, 
+0x2f, 0x0e, 0x65, 0x11, 0xde, 0x00, 0x66, 0x0a, 0xd3, 0x06, 0xd7, 0x15, 
+0x02, 0x13, 0x60, 0x12, 0xe1, 0x00, 0x36, 0x01, 0x2b, 0x01, 0xf6, 0x00, 
+0x25, 0x00, 0x92, 0x0c, 0xa5, 0x09, 0x10, 0x0b, 0x76, 0x0b, 0xb5, 0x00, 
+0x5c, 0x08, 0x0f, 0x19, 0x1e, 0x0b, 0x3e, 0x01, 0x15, 0x0a, 0xc8, 0x09, 
+0x4f, 0x17, 0xe4, 0x00, 0x02, 0x10, 0x4c, 0x0a, 0xa4, 0x09, 0x0b, 0x0f, 
+0x2e, 0x0c, 0x48, 0x0b, 0xcb, 0x00, 0xa3, 0x0a, 0x12, 0x01, 0x4b, 0x06, 
+0x3c, 0x18, 0x99, 0x06, 0x07, 0x01, 0xec, 0x0c, 0xe0, 0x00, 0x1a, 0x0b, 
+0x36, 0x01, 0x65, 0x0c, 0x22, 0x0c, 0xdf, 0x08, 0xa8, 0x00, 0xb6, 0x0e, 
+0xfd, 0x10, 0x84, 0x0a, 0x80, 0x0a, 0xcd, 0x0b, 0x1f, 0x15, 0x2b, 0x0b, 
+0xea, 0x0c, 0x25, 0x09, 0x0b, 0x00, 0xf4, 0x00, 0x23, 0x00, 0x5e, 0x12, 
+0xc3, 0x00, 0x13, 0x01, 0x9c, 0x00, 0x34, 0x18, 0x29, 0x00, 0xf3, 0x0c, 
+0x44, 0x0c, 0xa9, 0x00, 0x3f, 0x0a, 0xc9, 0x00, 0xb2, 0x1a, 0xe8, 0x13, 
+0x04, 0x01, 0x0c, 0x00, 0x11, 0x09, 0xb4, 0x1b, 0xac, 0x08, 0x27, 0x0b, 
+0x99, 0x06, 0x9f, 0x00, 0xe6, 0x0b, 0x31, 0x00, 0xe4, 0x06, 0xfb, 0x0b, 
+0x32, 0x12, 0x9c, 0x06, 0x85, 0x17, 0x63, 0x09, 0x1f, 0x01, 0x35, 0x0d, 
+0xfe, 0x00, 0x5f, 0x0a, 0xeb, 0x09, 0x1d, 0x0a, 0x0b, 0x01, 0x81, 0x10, 
+0xee, 0x09, 0x59, 0x00, 0xbb, 0x0a, 0xf0, 0x0a, 0xcd, 0x0f, 0xd2, 0x09, 
+0x5e, 0x18, 0x48, 0x06, 0xd5, 0x00, 0x3d, 0x00, 0xfb, 0x15, 0xe6, 0x09, 
+0xb5, 0x0f, 0xdb, 0x00, 0xa9, 0x08, 0x12, 0x0b, 0x1e, 0x01, 0x06, 0x09, 
+0xef, 0x0b, 0x5f, 0x06, 0x3d, 0x00, 0xf9, 0x00, 0xa2, 0x0d, 0x5d, 0x07, 
+0x1e, 0x14, 0xca, 0x12, 0xca, 0x00, 0x5d, 0x11, 0xdf, 0x0c, 0xd2, 0x00, 
+0x37, 0x01, 0xbb, 0x09, 0x6e, 0x00, 0xf7, 0x00, 0x09, 0x0a, 0xf1, 0x00, 
+0xd9, 0x16, 0xcc, 0x09, 0x29, 0x00, 0x59, 0x0b, 0x42, 0x14, 0x85, 0x16, 
+0xb7, 0x10, 0x42, 0x01, 0x1e, 0x00, 0xb0, 0x09, 0xc7, 0x17, 0x3b, 0x18, 
+0x28, 0x0b, 0xff, 0x08, 0x10, 0x16, 0xec, 0x0f, 0xc2, 0x0e, 0x46, 0x09, 
+0xaa, 0x06, 0x47, 0x06, 0xb3, 0x17, 0xe4, 0x0a, 0x7e, 0x0b, 0x84, 0x00, 
+0x3b, 0x0b, 0xba, 0x14, 0x49, 0x0a, 0x9c, 0x00, 0x60, 0x08, 0x28, 0x14, 
+0x4c, 0x00, 0x1d, 0x01, 0x25, 0x0b, 0xf3, 0x0c, 0x49, 0x0a, 0x82, 0x09, 
+0x4b, 0x11, 0x35, 0x0a, 0xc6, 0x09, 0x33, 0x0b, 0xb3, 0x0c, 0x9a, 0x11, 
+0x8a, 0x0b, 0xa8, 0x00, 0xef, 0x0d, 0x39, 0x00, 0x37, 0x09, 0xa4, 0x07, 
+0x85, 0x00, 0x05, 0x01, 0x66, 0x0c, 0xb2, 0x00, 0x2f, 0x0c, 0xa7, 0x00, 
+0x9c, 0x08, 0x6f, 0x0c, 0x8e, 0x17, 0x57, 0x11, 0x1a, 0x00, 0x01, 0x0c, 
+0xe9, 0x00, 0x60, 0x0a, 0xe1, 0x00, 0x5a, 0x0b, 0xae, 0x0a, 0x11, 0x0c, 
+0x6c, 0x1b, 0x32, 0x01, 0x08, 0x0d, 0xc4, 0x0b, 0xa7, 0x10, 0x68, 0x07, 
+0xa7, 0x00, 0x93, 0x0d, 0x6a, 0x17, 0x0f, 0x01, 0xe8, 0x00, 0xe3, 0x0f, 
+0x19, 0x0c, 0xc0, 0x0b, 0x07, 0x18, 0xc5, 0x09, 0x9e, 0x00, 0x36, 0x0a, 
+0x34, 0x01, 0x29, 0x00, 0x5f, 0x0c, 0x1b, 0x0c, 0xe7, 0x07, 0xa9, 0x0b, 
+0x33, 0x0a, 0xdd, 0x00, 0xb3, 0x00, 0x0e, 0x0c, 0x25, 0x1a, 0x60, 0x0f, 
+0x0f, 0x01, 0x5c, 0x0b, 0x3e, 0x0f, 0xc8, 0x00, 0x89, 0x17, 0xee, 0x0e, 
+0xc0, 0x0b, 0x24, 0x08, 0x4e, 0x19, 0x07, 0x01, 0x34, 0x00, 0xa1, 0x15, 
+0xa0, 0x07, 0xc1, 0x0b, 0x19, 0x00, 0xd6, 0x07, 0x13, 0x07, 0xe7, 0x09, 
+0x6f, 0x09, 0x1f, 0x0a, 0xd9, 0x06, 0x4e, 0x0c, 0x48, 0x00, 0x17, 0x00, 
+0x79, 0x00, 0xca, 0x00, 0xf9, 0x0a, 0x17, 0x00, 0xd3, 0x06, 0x20, 0x09, 
+0x40, 0x06, 0x64, 0x0c, 0x32, 0x01, 0x7d, 0x0c, 0x17, 0x01, 0x39, 0x01, 
+0xf0, 0x00, 0x6e, 0x12, 0xfa, 0x15, 0x5f, 0x00, 0xa6, 0x0a, 0x31, 0x0b, 
+0x5b, 0x0a, 0x81, 0x0a, 0x4b, 0x0a, 0xcb, 0x00, 0xcd, 0x0a, 0x08, 0x01, 
+0x6a, 0x17, 0x4e, 0x0c, 0xdf, 0x08, 0x30, 0x00, 0xd0, 0x00, 0xbe, 0x0c, 
+0xce, 0x00, 0xa8, 0x08, 0xfc, 0x13, 0x4c, 0x06, 0xee, 0x08, 0xf7, 0x00, 
+0x64, 0x0c, 0x02, 0x0c, 0x57, 0x0a, 0xe0, 0x08, 0x25, 0x09, 0x23, 0x00, 
+0xac, 0x0a, 0x71, 0x08, 0xf9, 0x00, 0x81, 0x0a, 0xfa, 0x13, 0xa1, 0x0b, 
+0x9e, 0x0b, 0x4e, 0x0c, 0x07, 0x01, 0x30, 0x00, 0x3c, 0x01, 0x3e, 0x0a, 
+0x4b, 0x06, 0xb6, 0x00, 0x24, 0x00, 0xc7, 0x00, 0x32, 0x18, 0x10, 0x0b, 
+0x1e, 0x0b, 0x21, 0x01, 0x83, 0x00, 0x7b, 0x00, 0xbf, 0x0c, 0xb4, 0x0b, 
+0x41, 0x0a, 0x6e, 0x10, 0x35, 0x0d, 0x23, 0x00, 0x37, 0x01, 0x72, 0x0c, 
+0x10, 0x01, 0x65, 0x00, 0x1d, 0x13, 0x1f, 0x0c, 0x86, 0x0c, 0x16, 0x01, 
+0x27, 0x0b, 0x36, 0x09, 0x31, 0x0b, 0x6c, 0x08, 0xce, 0x00, 0xe7, 0x0a, 
+0xa9, 0x08, 0x97, 0x0a, 0x25, 0x0c, 0x38, 0x0c, 0x26, 0x07, 0xaa, 0x16, 
+0xe9, 0x00, 0xa9, 0x0b, 0x9b, 0x00, 0x1a, 0x0f, 0x6b, 0x0c, 0x53, 0x0b, 
+0x18, 0x00, 0xee, 0x0a, 0xf6, 0x00, 0x60, 0x0a, 0x4f, 0x0c, 0x9c, 0x0c, 
+0xa6, 0x0c, 0x5a, 0x18, 0x96, 0x07, 0x27, 0x07, 0x40, 0x06, 0x36, 0x08, 
+0xa8, 0x00, 0x12, 0x0a, 0xb6, 0x00, 0xbb, 0x0a, 0x88, 0x00, 0xf8, 0x0b, 
+0x3f, 0x0e, 0x6c, 0x00, 0x75, 0x16, 0x0e, 0x0c, 0x36, 0x08, 0xd8, 0x0b, 
+0xf0, 0x0e, 0xb0, 0x10, 0x61, 0x00, 0xa3, 0x0b, 0x1b, 0x01, 0xb9, 0x0a, 
+0x14, 0x01, 0xf1, 0x09, 0x82, 0x11, 0xf1, 0x0a, 0xb6, 0x09, 0x1d, 0x0a, 
+0x0b, 0x16, 0x5a, 0x08, 0x1a, 0x11, 0x5b, 0x08, 0x85, 0x10, 0x11, 0x0e, 
+0x61, 0x07, 0x86, 0x0c, 0x59, 0x00, 0x3e, 0x0a, 0xb2, 0x00, 0xbb, 0x00, 
+0xe9, 0x00, 0x18, 0x0c, 0xbc, 0x0c, 0x19, 0x00, 0xa9, 0x0b, 0x18, 0x08, 
+0xd2, 0x0e, 0x7f, 0x00, 0x9a, 0x0b, 0x1c, 0x0b, 0x61, 0x0b, 0x68, 0x06, 
+0x65, 0x18, 0x90, 0x0c, 0xa8, 0x09, 0xca, 0x0b, 0x0f, 0x01, 0xe2, 0x10, 
+0xcf, 0x0b, 0x32, 0x01, 0xc8, 0x0c, 0xed, 0x00, 0x00, 0x01, 0xec, 0x00, 
+0xf4, 0x00, 0xa5, 0x19, 0x12, 0x0b, 0xdf, 0x08, 0xe5, 0x00, 0x0d, 0x13, 
+0x04, 0x0c, 0x7f, 0x0b, 0xdc, 0x00, 0x86, 0x00, 0xc3, 0x00, 0x43, 0x1a, 
+0x38, 0x00, 0x46, 0x0b, 0x2a, 0x01, 0x10, 0x01, 0x20, 0x01, 0xcd, 0x0a, 
+0xd5, 0x0b, 0x32, 0x0b, 0x0b, 0x15, 0xa1, 0x0a, 0xa2, 0x06, 0x68, 0x0b, 
+0xf1, 0x14, 0x74, 0x0b, 0x8d, 0x10, 0x97, 0x06, 0xa5, 0x10, 0x39, 0x0a, 
+0x49, 0x06, 0x41, 0x0a, 0xaa, 0x00, 0x23, 0x09, 0x82, 0x11, 0x2b, 0x0a, 
+0x6f, 0x00, 0xe5, 0x07, 0xbc, 0x0c, 0xd5, 0x14, 0x08, 0x01, 0x9f, 0x00, 
+0xe9, 0x00, 0xb0, 0x11, 0x64, 0x0a, 0xfd, 0x00, 0x4a, 0x0a, 0xc5, 0x00, 
+0x5e, 0x0b, 0x3f, 0x01, 0x3a, 0x0c, 0xb4, 0x1b, 0x9a, 0x06, 0x0e, 0x00, 
+0x4a, 0x11, 0x18, 0x00, 0x77, 0x0a, 0x86, 0x0d, 0x3f, 0x01, 0xfa, 0x00, 
+0xdc, 0x07, 0x3a, 0x01, 0x1f, 0x0a, 0x35, 0x0d, 0x4e, 0x12, 0x00, 0x01, 
+0x1c, 0x00, 0x2d, 0x0a, 0xc9, 0x0c, 0x8e, 0x17, 0xe1, 0x0b, 0x3f, 0x0d, 
+0xb1, 0x08, 0x33, 0x0b, 0xf9, 0x00, 0x25, 0x07, 0xb2, 0x1c, 0x7e, 0x00, 
+0x72, 0x16, 0x71, 0x07, 0x1e, 0x0f, 0x0c, 0x00, 0xd1, 0x09, 0x81, 0x11, 
+0xbc, 0x00, 0x5d, 0x17, 0x5f, 0x0a, 0xc0, 0x06, 0x25, 0x01, 0xb2, 0x0b, 
+0x3e, 0x0a, 0xf1, 0x0a, 0x9e, 0x00, 0xde, 0x00, 0x2d, 0x0c, 0x29, 0x12, 
+0x96, 0x07, 0xcf, 0x0b, 0x98, 0x0b, 0xf4, 0x00, 0xfe, 0x00, 0x53, 0x00, 
+0xe3, 0x10, 0x14, 0x07, 0xec, 0x0b, 0x5d, 0x11, 0xbd, 0x0a, 0x93, 0x0f, 
+0x65, 0x0c, 0x72, 0x16, 0x47, 0x00, 0x1b, 0x00, 0xd5, 0x0b, 0xf6, 0x0f, 
+0x3d, 0x00, 0x7b, 0x1c, 0x16, 0x01, 0xe5, 0x0a, 0x8e, 0x06, 0xbd, 0x09, 
+0xae, 0x1c, 0x30, 0x09, 0xba, 0x09, 0x91, 0x0c, 0x3d, 0x10, 0xeb, 0x07, 
+0x48, 0x00, 0xfd, 0x08, 0x31, 0x0b, 0x79, 0x10, 0xf7, 0x1a, 0x16, 0x0b, 
+0x61, 0x00, 0xe7, 0x0a, 0x5e, 0x0b, 0xf2, 0x09, 0x9c, 0x19, 0xcb, 0x0b, 
+0x55, 0x06, 0x26, 0x0b, 0xf7, 0x0b, 0x72, 0x00, 0xce, 0x14, 0x55, 0x0a, 
+0xdf, 0x16, 0xf8, 0x00, 0x6d, 0x0a, 0x43, 0x1a, 0x59, 0x18, 0x2e, 0x08, 
+0x45, 0x06, 0x95, 0x0f, 0x9a, 0x12, 0xe6, 0x00, 0xf5, 0x00, 0xfc, 0x14, 
+0x4d, 0x0a, 0xfc, 0x00, 0x3f, 0x09, 0xb3, 0x09, 0xec, 0x13, 0x61, 0x0a, 
+0x10, 0x0c, 0xd5, 0x0b, 0x82, 0x0a, 0xb8, 0x00, 0x2e, 0x0c, 0xfe, 0x0c, 
+0x36, 0x08, 0x64, 0x0c, 0x30, 0x00, 0x1d, 0x01, 0xf6, 0x00, 0x8a, 0x13, 
+0x40, 0x0c, 0x20, 0x01, 0x8c, 0x0b, 0x62, 0x09, 0x2e, 0x0a, 0x3f, 0x0a, 
+0x6d, 0x0a, 0x1b, 0x00, 0x30, 0x12, 0x12, 0x0a, 0x96, 0x07, 0x8e, 0x17, 
+0xd6, 0x17, 0x97, 0x06, 0xe3, 0x0a, 0x1b, 0x0c, 0xb1, 0x00, 0xd7, 0x17, 
+0x41, 0x06, 0x02, 0x01, 0xfb, 0x06, 0xd0, 0x00, 0x66, 0x13, 0x20, 0x00, 
+0xbc, 0x0a, 0xd5, 0x0c, 0x36, 0x0d, 0xcf, 0x00, 0x95, 0x1a, 0x65, 0x0c, 
+0xf9, 0x0b, 0x1f, 0x16, 0xa7, 0x08, 0xe1, 0x13, 0x86, 0x11, 0x9a, 0x0b, 
+0x85, 0x0b, 0x30, 0x10, 0x4b, 0x08, 0xb2, 0x00, 0x2b, 0x01, 0xe5, 0x0a, 
+0xc5, 0x00, 0x56, 0x07, 0xb7, 0x0e, 0x5f, 0x07, 0xe2, 0x00, 0xc5, 0x00, 
+0x36, 0x0d, 0xbf, 0x00, 0x18, 0x0c, 0x21, 0x01, 0x6f, 0x00, 0xd3, 0x0c, 
+0xe5, 0x00, 0x55, 0x09, 0xf7, 0x0e, 0xda, 0x0a, 0x7c, 0x18, 0x9f, 0x11, 
+0xd2, 0x00, 0xde, 0x08, 0x43, 0x06, 0x4f, 0x17, 0x90, 0x00, 0x35, 0x0a, 
+0x23, 0x0c, 0xf5, 0x09, 0xfc, 0x00, 0xe2, 0x08, 0xea, 0x00, 0x44, 0x1a, 
+0x8a, 0x11, 0xbc, 0x09, 0xbc, 0x00, 0x85, 0x00, 0x8c, 0x0a, 0x20, 0x01, 
+0x53, 0x0d, 0xb3, 0x00, 0xd5, 0x0b, 0x3f, 0x01, 0xa1, 0x00, 0x3f, 0x08, 
+0x10, 0x10, 0x6c, 0x0b, 0x59, 0x11, 0x15, 0x14, 0x5f, 0x00, 0xf6, 0x0f, 
+0x83, 0x0a, 0xaa, 0x0b, 0x20, 0x16, 0x29, 0x01, 0x11, 0x01, 0x28, 0x0b, 
+0x62, 0x09, 0x0c, 0x00, 0x41, 0x0a, 0xb0, 0x0a, 0x59, 0x18, 0xf6, 0x00, 
+0xbe, 0x09, 0x85, 0x16, 0x9c, 0x0c, 0x46, 0x06, 0x89, 0x00, 0x79, 0x0b, 
+0xd7, 0x0b, 0x8d, 0x0c, 0x5f, 0x07, 0x3a, 0x0c, 0xaf, 0x00, 0xdd, 0x0c, 
+0xac, 0x16, 0x10, 0x01, 0x33, 0x0a, 0xce, 0x06, 0x81, 0x08, 0x51, 0x0b, 
+0xc2, 0x00, 0x24, 0x01, 0x0f, 0x0b, 0xa7, 0x09, 0x52, 0x0c, 0x2f, 0x09, 
+0x69, 0x07, 0x7d, 0x00, 0x23, 0x00, 0xb0, 0x09, 0xd3, 0x0c, 0xdc, 0x09, 
+0x57, 0x0a, 0x54, 0x00, 0x07, 0x0a, 0xd1, 0x0b, 0x7d, 0x0c, 0x34, 0x01, 
+0x40, 0x0a, 0xdd, 0x0a, 0x9b, 0x0a, 0x11, 0x07, 0x6f, 0x00, 0x46, 0x06, 
+0x7d, 0x06, 0xca, 0x09, 0x49, 0x0a, 0x57, 0x06, 0x5e, 0x18, 0xcb, 0x0b, 
+0xf4, 0x09, 0x57, 0x13, 0x18, 0x00, 0x1c, 0x01, 0x2e, 0x07, 0x0b, 0x16, 
+0x6f, 0x08, 0x52, 0x07, 0xf6, 0x0c, 0xaa, 0x00, 0xb3, 0x16, 0x7a, 0x0c, 
+0x2f, 0x14, 0x3b, 0x13, 0xeb, 0x0c, 0xfe, 0x0d, 0x35, 0x01, 0x59, 0x17, 
+0x32, 0x12, 0x78, 0x0a, 0x92, 0x12, 0xd9, 0x00, 0x53, 0x07, 0xc1, 0x0b, 
+0xa2, 0x0a, 0x8a, 0x0b, 0x46, 0x0e, 0xe2, 0x00, 0xaf, 0x12, 0x23, 0x19, 
+0x42, 0x13, 0x17, 0x01, 0xc7, 0x0c, 0xc2, 0x00, 0xcd, 0x00, 0x94, 0x0e, 
+0x40, 0x00, 0xd2, 0x06, 0xeb, 0x0b, 0x05, 0x13, 0x78, 0x10, 0xbd, 0x00, 
+0xa0, 0x0b, 0xeb, 0x0b, 0x83, 0x14, 0xe9, 0x00, 0x94, 0x0f, 0x49, 0x00, 
+0xcd, 0x0a, 0xb2, 0x06, 0x05, 0x01, 0xeb, 0x00, 0x4b, 0x06, 0xc5, 0x09, 
+0x58, 0x0a, 0xa5, 0x0a, 0xa2, 0x08, 0xc1, 0x0b, 0x9c, 0x0c, 0x65, 0x0d, 
+0xe6, 0x00, 0xbc, 0x0c, 0x54, 0x0a, 0x5f, 0x00, 0x49, 0x0e, 0xd1, 0x09, 
+0x7b, 0x0c, 0x0f, 0x01, 0x0f, 0x00, 0xe8, 0x00, 0x4e, 0x0a, 0x60, 0x1b, 
+0x90, 0x0a, 0x64, 0x09, 0xbb, 0x09, 0xf1, 0x00, 0x8f, 0x00, 0x25, 0x01, 
+0x77, 0x0c, 0xd7, 0x07, 0xf6, 0x0b, 0x0a, 0x0a, 0xb7, 0x00, 0x91, 0x09, 
+0xda, 0x0f, 0xea, 0x08, 0x62, 0x08, 0x01, 0x17, 0x2c, 0x0a, 0x45, 0x0c, 
+0xac, 0x0a, 0x09, 0x0a, 0x53, 0x0e, 0x70, 0x08, 0x9d, 0x17, 0xdf, 0x17, 
+0xc0, 0x0b, 0x64, 0x0c, 0xf9, 0x00, 0xf9, 0x13, 0xf4, 0x00, 0x35, 0x0b, 
+0xb4, 0x16, 0x76, 0x0b, 0xce, 0x18, 0xaf, 0x09, 0x1d, 0x0a, 0x0b, 0x0a, 
+0xd7, 0x0b, 0x70, 0x0c, 0x3b, 0x0b, 0x14, 0x01, 0x46, 0x06, 0x60, 0x08, 
+0xe6, 0x00, 0x7d, 0x0b, 0xa6, 0x10, 0x04, 0x01, 0x6b, 0x00, 0x35, 0x0a, 
+0x47, 0x00, 0x03, 0x08, 0xb4, 0x0c, 0x9c, 0x00, 0xbf, 0x00, 0xbc, 0x14, 
+0x41, 0x0d, 0xb3, 0x06, 0xce, 0x00, 0xa7, 0x00, 0x89, 0x0b, 0x2d, 0x12, 
+0x46, 0x1c, 0xa9, 0x08, 0x2c, 0x01, 0x62, 0x18, 0xc6, 0x14, 0xf7, 0x0f, 
+0x71, 0x09, 0x7a, 0x0b, 0x17, 0x0c, 0x95, 0x0b, 0x11, 0x01, 0x85, 0x0c, 
+0xe7, 0x16, 0x92, 0x0c, 0x76, 0x0a, 0xab, 0x09, 0xd1, 0x00, 0xc8, 0x09, 
+0x5f, 0x08, 0xca, 0x00, 0xe3, 0x06, 0xa9, 0x15, 0x39, 0x13, 0x00, 0x14, 
+0x44, 0x0c, 0x64, 0x09, 0x59, 0x0c, 0x0d, 0x0c, 0x88, 0x0b, 0x67, 0x0c, 
+0x06, 0x01, 0x25, 0x1a, 0xb2, 0x09, 0x2a, 0x01, 0xdc, 0x09, 0x23, 0x10, 
+0x88, 0x0c, 0xfa, 0x0a, 0x11, 0x0e, 0x34, 0x0a, 0x6c, 0x00, 0x4a, 0x15, 
+0xe3, 0x18, 0x9f, 0x07, 0xa1, 0x0c, 0x72, 0x10, 0x48, 0x00, 0x5a, 0x08, 
+0xd2, 0x0f, 0x6b, 0x0a, 0x42, 0x06, 0x18, 0x01, 0x8c, 0x0a, 0xcf, 0x0b, 
+0xc4, 0x14, 0xa0, 0x07, 0x50, 0x17, 0x18, 0x08, 0x68, 0x08, 0x5a, 0x0a, 
+0x64, 0x00, 0xb8, 0x0e, 0x49, 0x06, 0x56, 0x00, 0x00, 0x0a, 0xdc, 0x10, 
+0x5e, 0x07, 0x92, 0x13, 0xdd, 0x00, 0xf2, 0x0a, 0xd3, 0x0c, 0x49, 0x0a, 
+0xdd, 0x09, 0x16, 0x01, 0x9f, 0x00, 0x6e, 0x09, 0xa1, 0x07, 0x53, 0x14, 
+0x1d, 0x00, 0xc7, 0x00, 0xd0, 0x09, 0x3b, 0x12, 0xb2, 0x08, 0x64, 0x17, 
+0xc3, 0x0e, 0x43, 0x15, 0xc9, 0x00, 0x7e, 0x0b, 0x44, 0x06, 0xf7, 0x0b, 
+0x58, 0x0d, 0x48, 0x0b, 0x48, 0x06, 0x37, 0x00, 0x04, 0x12, 0x96, 0x0c, 
+0xca, 0x0b, 0x25, 0x0b, 0x21, 0x15, 0xea, 0x08, 0x41, 0x06, 0x99, 0x0e, 
+0x1d, 0x01, 0xba, 0x09, 0x1f, 0x01, 0xa6, 0x0c, 0x96, 0x15, 0xaf, 0x00, 
+0xf3, 0x00, 0x3d, 0x01, 0xc3, 0x00, 0x2c, 0x0a, 0xce, 0x06, 0xd6, 0x00, 
+0x22, 0x01, 0xa0, 0x12, 0x2c, 0x09, 0x89, 0x09, 0xdb, 0x00, 0xbc, 0x14, 
+0x6b, 0x13, 0xa9, 0x00, 0xa6, 0x0c, 0x27, 0x12, 0x5b, 0x08, 0xb1, 0x0c, 
+0x41, 0x0a, 0xf5, 0x09, 0xba, 0x00, 0x99, 0x07, 0xb4, 0x00, 0x45, 0x06, 
+0xba, 0x17, 0x0d, 0x17, 0x5e, 0x07, 0xc2, 0x0a, 0x65, 0x0a, 0xdc, 0x00, 
+0x17, 0x01, 0xb9, 0x00, 0xa8, 0x00, 0x1b, 0x07, 0xdb, 0x0b, 0x73, 0x0c, 
+0xb2, 0x07, 0x26, 0x15, 0x2b, 0x01, 0x8c, 0x0a, 0x17, 0x0a, 0x67, 0x09, 
+0x25, 0x07, 0x29, 0x01, 0x62, 0x07, 0x41, 0x01, 0xf2, 0x00, 0x54, 0x0a, 
+0x03, 0x01, 0xe6, 0x00, 0x77, 0x00, 0xc2, 0x0a, 0xc0, 0x0c, 0x27, 0x10, 
+0xc9, 0x08, 0xd8, 0x00, 0xe2, 0x1a, 0x1a, 0x01, 0xec, 0x0a, 0x01, 0x0c, 
+0x6f, 0x0b, 0xb8, 0x00, 0xbf, 0x00, 0x08, 0x01, 0xd0, 0x18, 0xde, 0x13, 
+0x47, 0x0e, 0x56, 0x0a, 0x0f, 0x13, 0x9c, 0x0f, 0x90, 0x00, 0xe9, 0x00, 
+0xbf, 0x0b, 0xb4, 0x16, 0x6a, 0x06, 0x2a, 0x0a, 0x78, 0x09, 0x2e, 0x08, 
+0xda, 0x00, 0x21, 0x09, 0xc0, 0x00, 0xeb, 0x13, 0x77, 0x00, 0x10, 0x0a, 
+0x28, 0x01, 0xf4, 0x0c, 0x3d, 0x01, 0x02, 0x0a, 0xc8, 0x09, 0x59, 0x00, 
+0xa2, 0x0a, 0x7d, 0x06, 0x19, 0x01, 0x4e, 0x0c, 0xbe, 0x00, 0x22, 0x17, 
+0xee, 0x0a, 0x42, 0x01, 0x5e, 0x07, 0x08, 0x0a, 0xd8, 0x16, 0x79, 0x00, 
+0xfd, 0x0a, 0xe3, 0x00, 0xaa, 0x00, 0xf6, 0x09, 0x84, 0x00, 0xbf, 0x09, 
+0x23, 0x09, 0xf6, 0x0d, 0xb5, 0x17, 0x1d, 0x01, 0x1b, 0x01, 0x9f, 0x0f, 
+0xde, 0x00, 0xf3, 0x00, 0xb7, 0x00, 0x90, 0x00, 0x20, 0x0a, 0xc4, 0x00, 
+0x1f, 0x14, 0xa6, 0x0f, 0xb5, 0x07, 0x87, 0x17, 0xcc, 0x00, 0xcf, 0x00, 
+0xac, 0x1a, 0xdf, 0x0c, 0xaa, 0x06, 0xde, 0x09, 0x20, 0x09, 0x8f, 0x00, 
+0xf6, 0x0b, 0xd0, 0x00, 0x9b, 0x00, 0x85, 0x0c, 0x5c, 0x0f, 0x41, 0x0a, 
+0xce, 0x06, 0x15, 0x0b, 0x3b, 0x0b, 0x21, 0x01, 0xd6, 0x07, 0xb5, 0x09, 
+0xec, 0x08, 0xe4, 0x00, 0xf4, 0x09, 0xda, 0x07, 0xb2, 0x16, 0x56, 0x06, 
+0xfd, 0x09, 0xba, 0x0a, 0x95, 0x0b, 0xf7, 0x10, 0x32, 0x00, 0x45, 0x0a, 
+0xf4, 0x08, 0x14, 0x0b, 0xff, 0x09, 0x56, 0x06, 0xe5, 0x15, 0x46, 0x0c, 
+0x3d, 0x01, 0xdf, 0x12, 0xc1, 0x00, 0xf1, 0x00, 0xfb, 0x00, 0x99, 0x06, 
+0x05, 0x0b, 0x45, 0x08, 0xf1, 0x0a, 0x8e, 0x0b, 0xd4, 0x00, 0x47, 0x19, 
+0x53, 0x0b, 0xb5, 0x0c, 0x47, 0x08, 0x15, 0x0a, 0x25, 0x08, 0x49, 0x06, 
+0xc0, 0x00, 0xc5, 0x14, 0x56, 0x00, 0x5f, 0x0a, 0x30, 0x0a, 0x82, 0x09, 
+0x18, 0x0c, 0x11, 0x0d, 0x4a, 0x00, 0x6a, 0x0a, 0x66, 0x00, 0xd9, 0x06, 
+0x0d, 0x01, 0xd0, 0x14, 0xe3, 0x0a, 0x46, 0x0b, 0x6d, 0x09, 0xc5, 0x09, 
+0xf6, 0x07, 0x0b, 0x00, 0xab, 0x15, 0x26, 0x08, 0x2f, 0x01, 0x41, 0x06, 
+0x1d, 0x00, 0xac, 0x0a, 0xac, 0x06, 0x3f, 0x01, 0xab, 0x0c, 0x53, 0x0b, 
+0xc9, 0x0c, 0x07, 0x0f, 0xb3, 0x16, 0xbb, 0x09, 0xd0, 0x00, 0x91, 0x00, 
+0x75, 0x16, 0xb8, 0x00, 0xa9, 0x00, 0x34, 0x00, 0xe3, 0x07, 0x43, 0x07, 
+0x4f, 0x17, 0x94, 0x0c, 0xb1, 0x00, 0x81, 0x0b, 0x4d, 0x00, 0xf4, 0x0a, 
+0xd5, 0x14, 0x30, 0x00, 0x08, 0x0d, 0xaf, 0x00, 0x9c, 0x0c, 0x54, 0x11, 
+0xb2, 0x12, 0x11, 0x0d, 0xb0, 0x10, 0x74, 0x15, 0xde, 0x18, 0x70, 0x0a, 
+0x97, 0x14, 0x63, 0x0a, 0x24, 0x0e, 0x54, 0x11, 0x17, 0x01, 0x79, 0x00, 
+0xe7, 0x10, 0x5e, 0x11, 0xb1, 0x09, 0x6e, 0x09, 0x19, 0x00, 0x50, 0x11, 
+0xee, 0x0a, 0x13, 0x01, 0x1c, 0x0e, 0x4f, 0x19, 0xd8, 0x00, 0x94, 0x0b, 
+0xd5, 0x0b, 0xec, 0x09, 0x37, 0x08, 0x17, 0x0c, 0x90, 0x00, 0xbd, 0x10, 
+0xbb, 0x0a, 0x26, 0x0b, 0xb6, 0x00, 0xbf, 0x00, 0xcc, 0x0c, 0xf2, 0x0e, 
+0xe9, 0x00, 0x1a, 0x01, 0xbf, 0x00, 0x0e, 0x1c, 0x9b, 0x00, 0x2e, 0x0c, 
+0x27, 0x07, 0x27, 0x00, 0x44, 0x0a, 0xe9, 0x0f, 0xbc, 0x0a, 0x66, 0x09, 
+0x36, 0x00, 0x17, 0x00, 0x48, 0x19, 0xdb, 0x0b, 0x0e, 0x01, 0xcb, 0x0b, 
+0xd5, 0x00, 0x31, 0x00, 0xb2, 0x0c, 0x3b, 0x0b, 0xc1, 0x07, 0x61, 0x00, 
+0x92, 0x00, 0x8e, 0x06, 0x06, 0x01, 0xc8, 0x09, 0xaa, 0x07, 0xf9, 0x00, 
+0xdb, 0x09, 0x04, 0x01, 0x10, 0x19, 0x29, 0x0b, 0x35, 0x09, 0x2c, 0x01, 
+0x47, 0x00, 0xbb, 0x16, 0xfc, 0x00, 0x9d, 0x00, 0x96, 0x15, 0x43, 0x00, 
+0xff, 0x00, 0xc5, 0x14, 0xbf, 0x0c, 0xe4, 0x00, 0x5f, 0x00, 0xa1, 0x07, 
+0x09, 0x01, 0xc9, 0x00, 0x10, 0x01, 0xf3, 0x0c, 0xa6, 0x0a, 0x9e, 0x0f, 
+0xd8, 0x0a, 0xde, 0x13, 0x23, 0x08, 0xcd, 0x0b, 0x2a, 0x0a, 0x88, 0x0b, 
+0x14, 0x0a, 0xdf, 0x0a, 0xa7, 0x00, 0xfe, 0x00, 0x1e, 0x14, 0x77, 0x0a, 
+0xb9, 0x00, 0x49, 0x0b, 0x8b, 0x0a, 0xc6, 0x00, 0x02, 0x17, 0xfb, 0x0a, 
+0x0c, 0x01, 0x0f, 0x01, 0x5c, 0x0c, 0x4f, 0x0c, 0xb8, 0x0d, 0x62, 0x09, 
+0xa9, 0x15, 0x26, 0x08, 0x90, 0x00, 0xc3, 0x0a, 0x7b, 0x00, 0x8c, 0x06, 
+0x8d, 0x0a, 0x06, 0x01, 0x9f, 0x17, 0xc0, 0x00, 0xcd, 0x00, 0xeb, 0x0b, 
+0x47, 0x00, 0x6b, 0x00, 0x7d, 0x0b, 0xb8, 0x15, 0xa3, 0x0b, 0xd8, 0x00, 
+0xdd, 0x0c, 0x17, 0x01, 0xb3, 0x09, 0xdc, 0x0b, 0xe0, 0x09, 0x1a, 0x10, 
+0xa1, 0x06, 0xa3, 0x06, 0xda, 0x06, 0xf4, 0x14, 0x21, 0x0a, 0xed, 0x00, 
+0x48, 0x00, 0xfa, 0x0a, 0xc4, 0x14, 0x4f, 0x0c, 0xc6, 0x09, 0xf8, 0x0b, 
+0x74, 0x14, 0x41, 0x01, 0xa7, 0x10, 0x60, 0x0a, 0x81, 0x0a, 0x96, 0x16, 
+0xcd, 0x0a, 0xfa, 0x14, 0x34, 0x00, 0x8e, 0x06, 0x38, 0x0c, 0xd5, 0x0b, 
+0x2e, 0x0c, 0xc2, 0x0a, 0x6a, 0x06, 0x81, 0x10, 0x7e, 0x0b, 0xd9, 0x06, 
+0x17, 0x01, 0x20, 0x0b, 0x04, 0x0b, 0x21, 0x0b, 0xc9, 0x00, 0xcd, 0x1b, 
+0x3e, 0x01, 0xeb, 0x00, 0x77, 0x09, 0x7b, 0x1b, 0x55, 0x11, 0x7a, 0x00, 
+0x75, 0x0e, 0xab, 0x06, 0x9c, 0x00, 0x2d, 0x01, 0x8c, 0x0a, 0xc4, 0x0a, 
+0xf5, 0x07, 0x62, 0x0b, 0x71, 0x0d, 0x82, 0x10, 0xde, 0x13, 0x60, 0x08, 
+0xb2, 0x09, 0x31, 0x01, 0xcf, 0x00, 0x20, 0x14, 0xf7, 0x00, 0x1a, 0x1a, 
+0xce, 0x0b, 0x2a, 0x01, 0x09, 0x0a, 0x62, 0x00, 0xce, 0x0b, 0x96, 0x15, 
+0xda, 0x00, 0x40, 0x06, 0x78, 0x07, 0x02, 0x01, 0x5a, 0x17, 0xe4, 0x0a, 
+0xfd, 0x00, 0xd8, 0x0b, 0xd8, 0x00, 0x86, 0x00, 0x64, 0x17, 0xbb, 0x07, 
+0x3e, 0x0a, 0x71, 0x06, 0xd6, 0x0b, 0x6d, 0x0d, 0xb7, 0x10, 0x0e, 0x01, 
+0xa6, 0x13, 0x86, 0x17, 0x59, 0x00, 0xaf, 0x0a, 0x3d, 0x00, 0x76, 0x0b, 
+0x67, 0x0b, 0x7b, 0x00, 0x2b, 0x0f, 0x18, 0x0c, 0x62, 0x0a, 0x09, 0x15, 
+0xf5, 0x00, 0xf1, 0x09, 0xe4, 0x06, 0x35, 0x0d, 0x4a, 0x06, 0x0f, 0x0b, 
+0x28, 0x00, 0xfe, 0x06, 0x32, 0x01, 0x3e, 0x0a, 0xb8, 0x00, 0xe3, 0x08, 
+0x53, 0x00, 0x28, 0x01, 0xba, 0x0a, 0xaf, 0x00, 0x3e, 0x00, 0x78, 0x00, 
+0x3c, 0x01, 0x62, 0x00, 0x56, 0x00, 0xce, 0x00, 0xfa, 0x0e, 0xb8, 0x0b, 
+0x55, 0x00, 0x15, 0x14, 0x62, 0x06, 0xae, 0x00, 0x02, 0x13, 0x48, 0x06, 
+0x16, 0x14, 0x90, 0x00, 0xa8, 0x00, 0x10, 0x01, 0x60, 0x00, 0xf5, 0x00, 
+0xce, 0x15, 0xe3, 0x07, 0x2c, 0x01, 0x1d, 0x01, 0x9f, 0x0b, 0xe2, 0x14, 
+0x56, 0x07, 0xf5, 0x00, 0xe3, 0x00, 0xef, 0x0c, 0xbc, 0x09, 0x37, 0x0d, 
+0x0c, 0x00, 0xa4, 0x11, 0x3f, 0x00, 0xbf, 0x00, 0xf1, 0x13, 0xef, 0x06, 
+0xf9, 0x0a, 0xb9, 0x00, 0x27, 0x12, 0xc9, 0x0e, 0x3f, 0x0a, 0x06, 0x13, 
+0xf1, 0x09, 0xa5, 0x09, 0x54, 0x11, 0xdb, 0x09, 0x6a, 0x07, 0xee, 0x00, 
+0x52, 0x07, 0x72, 0x06, 0x1e, 0x01, 0x62, 0x00, 0x54, 0x0a, 0x9e, 0x0b, 
+0xda, 0x0f, 0xf2, 0x00, 0xb0, 0x09, 0xf8, 0x00, 0x3b, 0x01, 0x77, 0x09, 
+0x43, 0x06, 0x3f, 0x01, 0x3c, 0x00, 0x62, 0x0d, 0x3e, 0x01, 0x3e, 0x0a, 
+0x40, 0x01, 0x2f, 0x0c, 0x37, 0x01, 0x78, 0x0b, 0x85, 0x17, 0x55, 0x11, 
+0x9e, 0x0c, 0xdf, 0x13, 0x71, 0x08, 0x20, 0x01, 0x5d, 0x07, 0x03, 0x01, 
+0x46, 0x0f, 0xd0, 0x00, 0x78, 0x10, 0xb9, 0x00, 0xd6, 0x07, 0xa8, 0x00, 
+0x46, 0x06, 0x8c, 0x0a, 0x99, 0x0b, 0xd5, 0x0a, 0xf1, 0x14, 0x97, 0x0a, 
+0xa4, 0x09, 0xed, 0x0b, 0xa0, 0x0b, 0xd7, 0x15, 0x95, 0x07, 0xec, 0x09, 
+0x1a, 0x0b, 0xf4, 0x0c, 0x6e, 0x00, 0x79, 0x00, 0xc0, 0x00, 0x12, 0x0e, 
+0x0b, 0x00, 0x66, 0x14, 0x18, 0x00, 0x1f, 0x15, 0x76, 0x17, 0xa4, 0x09, 
+0xdf, 0x13, 0xce, 0x18, 0xa8, 0x00, 0x5f, 0x18, 0x8f, 0x06, 0x36, 0x0b, 
+0x4d, 0x19, 0x45, 0x06, 0x65, 0x0d, 0x80, 0x09, 0x5d, 0x12, 0xb0, 0x00, 
+0xe3, 0x00, 0x13, 0x0a, 0xcb, 0x0b, 0xe5, 0x00, 0xcf, 0x0b, 0xf0, 0x0a, 
+0x31, 0x01, 0xfd, 0x09, 0x01, 0x0c, 0x3a, 0x01, 0xdd, 0x00, 0x72, 0x0b, 
+0x65, 0x0a, 0xf2, 0x00, 0xb1, 0x09, 0x68, 0x0c, 0xf4, 0x0a, 0x3d, 0x1a, 
+0x26, 0x0b, 0x8d, 0x08, 0x97, 0x06, 0x27, 0x0c, 0x49, 0x0d, 0xd3, 0x0f, 
+0xdf, 0x08, 0x37, 0x07, 0xc3, 0x0b, 0x79, 0x10, 0x84, 0x0e, 0xc8, 0x00, 
+0x71, 0x0d, 0xf0, 0x0a, 0xa8, 0x0c, 0x94, 0x07, 0xa8, 0x09, 0x06, 0x01, 
+0x74, 0x0b, 0xb7, 0x00, 0x3c, 0x0b, 0xac, 0x00, 0x99, 0x00, 0x56, 0x07, 
+0x45, 0x06, 0x0f, 0x0b, 0x39, 0x0c, 0x64, 0x00, 0x8e, 0x0b, 0x59, 0x17, 
+0xe9, 0x0c, 0x5b, 0x08, 0xb5, 0x0b, 0x68, 0x0b, 0xe8, 0x00, 0xf2, 0x09, 
+0x62, 0x09, 0xc9, 0x0c, 0x75, 0x0b, 0xf1, 0x09, 0xb0, 0x0a, 0x93, 0x0b, 
+0x98, 0x06, 0x9a, 0x11, 0x8f, 0x0a, 0x3e, 0x0d, 0x09, 0x0a, 0xcf, 0x0a, 
+0xd2, 0x0f, 0xe2, 0x00, 0x28, 0x01, 0xc6, 0x00, 0xe6, 0x06, 0xbd, 0x08, 
+0x06, 0x09, 0x33, 0x0a, 0x21, 0x0b, 0x29, 0x0a, 0x37, 0x0a, 0x1f, 0x01, 
+0x29, 0x0f, 0xc8, 0x00, 0x0b, 0x10, 0x29, 0x01, 0xf1, 0x0e, 0x1e, 0x07, 
+0x0f, 0x00, 0x4a, 0x06, 0x69, 0x07, 0x9c, 0x0c, 0x6b, 0x00, 0xcd, 0x0a, 
+0x31, 0x0b, 0xeb, 0x08, 0xcb, 0x09, 0x89, 0x0c, 0xbb, 0x09, 0x46, 0x14, 
+0x26, 0x0c, 0x13, 0x01, 0x12, 0x0b, 0xb7, 0x0e, 0x03, 0x13, 0x9e, 0x0c, 
+0x8e, 0x06, 0x1d, 0x07, 0xa3, 0x07, 0xfc, 0x06, 0x39, 0x12, 0x6c, 0x0c, 
+0xcf, 0x18, 0xd2, 0x0c, 0x7d, 0x0b, 0xb5, 0x0f, 0x2d, 0x08, 0x88, 0x09, 
+0xb7, 0x0e, 0xe2, 0x0b, 0x8e, 0x06, 0x79, 0x00, 0x06, 0x0c, 0x2c, 0x01, 
+0xea, 0x08, 0x1b, 0x10, 0x62, 0x09, 0x89, 0x10, 0x57, 0x06, 0x89, 0x10, 
+0x08, 0x0a, 0x1a, 0x01, 0x97, 0x07, 0x92, 0x00, 0xeb, 0x00, 0x73, 0x00, 
+0xbb, 0x00, 0x55, 0x06, 0xb4, 0x00, 0x9d, 0x08, 0x60, 0x0a, 0x8a, 0x0d, 
+0x0c, 0x00, 0x97, 0x0a, 0xb8, 0x09, 0x9f, 0x07, 0xe1, 0x00, 0x53, 0x14, 
+0x53, 0x0b, 0xd4, 0x00, 0x0d, 0x00, 0x8d, 0x0b, 0x1f, 0x01, 0xf1, 0x09, 
+0x42, 0x00, 0x4a, 0x06, 0x09, 0x0a, 0xad, 0x0f, 0xc6, 0x00, 0x36, 0x00, 
+0xeb, 0x07, 0x87, 0x00, 0xf3, 0x08, 0xce, 0x06, 0xd6, 0x00, 0x9f, 0x0b, 
+0x33, 0x0c, 0x47, 0x06, 0xde, 0x0d, 0x0e, 0x13, 0x8f, 0x00, 0x2b, 0x12, 
+0xd1, 0x09, 0x9b, 0x0c, 0x1b, 0x01, 0x78, 0x08, 0xde, 0x00, 0x68, 0x0b, 
+0x9f, 0x0b, 0xd3, 0x00, 0xe0, 0x13, 0xb1, 0x10, 0x37, 0x09, 0x11, 0x07, 
+0x87, 0x11, 0x46, 0x06, 0xd3, 0x10, 0x39, 0x0c, 0x7a, 0x00, 0x5b, 0x17, 
+0xb2, 0x16, 0x74, 0x0b, 0xc0, 0x00, 0xe5, 0x00, 0x4b, 0x14, 0x24, 0x0e, 
+0x56, 0x18, 0x59, 0x0c, 0xea, 0x0c, 0x0e, 0x01, 0xe8, 0x0c, 0xbd, 0x00, 
+0xa0, 0x15, 0xc3, 0x09, 0xdf, 0x00, 0xeb, 0x08, 0x65, 0x0c, 0xde, 0x00, 
+0x66, 0x08, 0xe0, 0x13, 0x14, 0x00, 0x15, 0x0b, 0xc0, 0x0c, 0x95, 0x0b, 
+0x8f, 0x00, 0xed, 0x00, 0x0c, 0x0c, 0x69, 0x0b, 0xc3, 0x0a, 0xc5, 0x00, 
+0x9a, 0x06, 0x75, 0x0a, 0xb3, 0x09, 0xce, 0x00, 0x92, 0x0f, 0x05, 0x0c, 
+0xb3, 0x00, 0x43, 0x18, 0xed, 0x0c, 0x38, 0x09, 0x11, 0x01, 0x00, 0x0b, 
+0xb3, 0x09, 0x25, 0x0f, 0x41, 0x18, 0x94, 0x0b, 0xa3, 0x15, 0xdd, 0x0c, 
+0x50, 0x0c, 0xb8, 0x00, 0xd7, 0x07, 0x53, 0x0b, 0xc1, 0x07, 0x87, 0x00, 
+0xab, 0x00, 0x43, 0x06, 0xcb, 0x08, 0xa7, 0x0c, 0x9b, 0x1a, 0x12, 0x01, 
+0xaa, 0x0c, 0xdd, 0x09, 0x99, 0x06, 0x76, 0x0a, 0x44, 0x06, 0x52, 0x07, 
+0xc2, 0x0a, 0xc3, 0x0a, 0x29, 0x01, 0x0e, 0x0c, 0x8f, 0x09, 0x92, 0x0f, 
+0x58, 0x07, 0x26, 0x0c, 0x21, 0x0a, 0x7b, 0x00, 0x6b, 0x18, 0x3b, 0x1c, 
+0xe6, 0x06, 0xfa, 0x17, 0x0f, 0x00, 0x55, 0x00, 0x69, 0x06, 0x7e, 0x16, 
+0x27, 0x00, 0xc3, 0x0a, 0x61, 0x0c, 0x97, 0x0a, 0xd4, 0x00, 0x3e, 0x0d, 
+0xc4, 0x00, 0x51, 0x0c, 0x77, 0x0b, 0x53, 0x00, 0x44, 0x06, 0x32, 0x18, 
+0x80, 0x0b, 0x0f, 0x01, 0x36, 0x01, 0xb3, 0x11, 0x1d, 0x13, 0xee, 0x0b, 
+0x44, 0x06, 0x86, 0x0d, 0x44, 0x0c, 0x72, 0x16, 0xb3, 0x09, 0x46, 0x0b, 
+0x70, 0x06, 0xf8, 0x00, 0xf6, 0x00, 0x4e, 0x11, 0x46, 0x19, 0x23, 0x00, 
+0x26, 0x01, 0x1a, 0x0b, 0xf7, 0x0b, 0xf5, 0x0d, 0x96, 0x1a, 0x10, 0x0b, 
+0x41, 0x01, 0xe5, 0x00, 0x30, 0x00, 0xc6, 0x09, 0x4e, 0x11, 0x4f, 0x0c, 
+0x88, 0x14, 0x13, 0x0d, 0xe7, 0x00, 0x97, 0x06, 0x71, 0x0a, 0xa1, 0x0a, 
+0x36, 0x01, 0xf3, 0x08, 0x1e, 0x0a, 0xec, 0x00, 0x02, 0x17, 0x5f, 0x0a, 
+0xd0, 0x00, 0x36, 0x01, 0xab, 0x16, 0x45, 0x06, 0xc1, 0x00, 0xf5, 0x0c, 
+0xb4, 0x16, 0xcd, 0x15, 0x21, 0x14, 0xce, 0x14, 0xf7, 0x08, 0x1b, 0x01, 
+0xb7, 0x00, 0xfc, 0x09, 0x98, 0x0d, 0xb2, 0x16, 0x05, 0x01, 0x29, 0x0a, 
+0xea, 0x00, 0xc5, 0x09, 0x23, 0x01, 0x4b, 0x06, 0x41, 0x00, 0x55, 0x0a, 
+0x1d, 0x01, 0xe4, 0x0b, 0x40, 0x0b, 0x5f, 0x00, 0x3f, 0x0b, 0xe5, 0x0b, 
+0x45, 0x15, 0x72, 0x0b, 0x81, 0x0a, 0x77, 0x0a, 0x95, 0x16, 0x70, 0x0c, 
+0x4a, 0x0a, 0x54, 0x07, 0x1c, 0x07, 0x3b, 0x0b, 0xb4, 0x0b, 0x1c, 0x07, 
+0xba, 0x07, 0x43, 0x06, 0x58, 0x0a, 0xd0, 0x00, 0x8a, 0x00, 0x61, 0x00, 
+0xe0, 0x08, 0xa6, 0x0c, 0x22, 0x0c, 0xaa, 0x00, 0x3f, 0x0a, 0xb4, 0x09, 
+0x1d, 0x0b, 0x46, 0x06, 0x10, 0x0c, 0xea, 0x0c, 0x0d, 0x01, 0x88, 0x0b, 
+0x55, 0x07, 0x52, 0x17, 0xb4, 0x09, 0x6e, 0x0a, 0xa0, 0x07, 0x2f, 0x00, 
+0xde, 0x13, 0xa1, 0x07, 0x1f, 0x1a, 0xb3, 0x1b, 0x33, 0x18, 0x03, 0x16, 
+0x6a, 0x0d, 0xa7, 0x0b, 0x20, 0x1b, 0x17, 0x01, 0x94, 0x16, 0x60, 0x00, 
+0x55, 0x06, 0x64, 0x0c, 0x2b, 0x09, 0xa7, 0x08, 0x35, 0x0b, 0x29, 0x00, 
+0x1b, 0x00, 0x20, 0x0d, 0x5d, 0x11, 0x3b, 0x01, 0xf1, 0x09, 0x34, 0x0b, 
+0x00, 0x0b, 0xb8, 0x00, 0x1c, 0x0e, 0xf6, 0x08, 0x3e, 0x01, 0xeb, 0x0b, 
+0x2d, 0x08, 0x20, 0x01, 0x78, 0x0f, 0x1d, 0x01, 0xc6, 0x0a, 0x61, 0x00, 
+0xe2, 0x00, 0x75, 0x0b, 0x01, 0x0d, 0xb4, 0x0c, 0x54, 0x11, 0x72, 0x0b, 
+0x09, 0x0a, 0xb0, 0x00, 0x46, 0x0c, 0xe5, 0x00, 0xa0, 0x07, 0x1a, 0x0b, 
+0x8d, 0x0b, 0xbb, 0x00, 0xc9, 0x08, 0xc4, 0x14, 0xa1, 0x06, 0x6b, 0x17, 
+0x1a, 0x0b, 0xa6, 0x0f, 0x38, 0x08, 0xfd, 0x00, 0xcc, 0x0b, 0x7c, 0x00, 
+0xec, 0x06, 0x45, 0x0c, 0xd8, 0x0b, 0x58, 0x18, 0x11, 0x07, 0xec, 0x07, 
+0x95, 0x0b, 0xe1, 0x13, 0xfe, 0x0f, 0x93, 0x11, 0xb4, 0x00, 0xc8, 0x14, 
+0x62, 0x0d, 0xde, 0x00, 0xf2, 0x00, 0x03, 0x13, 0xb2, 0x08, 0x31, 0x0c, 
+0x94, 0x0f, 0xab, 0x1a, 0x1f, 0x01, 0xd3, 0x0c, 0xd8, 0x07, 0x0c, 0x01, 
+0x9c, 0x08, 0x9c, 0x00, 0x9f, 0x0f, 0x0e, 0x01, 0x06, 0x01, 0x08, 0x0b, 
+0xee, 0x00, 0x46, 0x06, 0x94, 0x00, 0x05, 0x0b, 0xb8, 0x00, 0xea, 0x00, 
+0x38, 0x14, 0x2c, 0x01, 0xb2, 0x07, 0x86, 0x0c, 0x11, 0x0d, 0x4c, 0x0b, 
+0x18, 0x0c, 0xa7, 0x08, 0x92, 0x12, 0x84, 0x11, 0x17, 0x00, 0xba, 0x00, 
+0x49, 0x06, 0xb9, 0x0b, 0x1a, 0x01, 0xfe, 0x00, 0xe2, 0x0b, 0xa1, 0x15, 
+0x44, 0x06, 0xbc, 0x00, 0x53, 0x00, 0x65, 0x11, 0xc8, 0x00, 0x44, 0x0c, 
+0x84, 0x11, 0x03, 0x17, 0x8b, 0x0a, 0x26, 0x12, 0x33, 0x0b, 0x29, 0x0b, 
+0x48, 0x06, 0x26, 0x0b, 0x32, 0x01, 0x49, 0x06, 0xdb, 0x00, 0xfb, 0x0a, 
+0x9d, 0x0f, 0x41, 0x01, 0xf3, 0x00, 0xcd, 0x00, 0x16, 0x13, 0x7f, 0x06, 
+0xcd, 0x00, 0xba, 0x00, 0x54, 0x0b, 0x6c, 0x00, 0x52, 0x0b, 0xd6, 0x00, 
+0x97, 0x18, 0xa1, 0x00, 0xf4, 0x00, 0x23, 0x00, 0x0d, 0x00, 0xf3, 0x0a, 
+0x60, 0x00, 0x88, 0x0b, 0x8e, 0x06, 0xf4, 0x00, 0x91, 0x13, 0x19, 0x01, 
+0x0c, 0x00, 0x79, 0x10, 0x85, 0x17, 0x93, 0x12, 0xff, 0x00, 0x41, 0x06, 
+0x0b, 0x0d, 0xc6, 0x09, 0x73, 0x0c, 0x82, 0x0b, 0x2c, 0x09, 0x11, 0x07, 
+0xd1, 0x00, 0x26, 0x1a, 0xcb, 0x0b, 0x10, 0x00, 0x2f, 0x0c, 0x07, 0x0c, 
+0xd5, 0x06, 0x9c, 0x1a, 0xea, 0x00, 0x3e, 0x0a, 0x5d, 0x0b, 0xb1, 0x09, 
+0x27, 0x14, 0x2f, 0x00, 0xf6, 0x0b, 0x21, 0x10, 0xb9, 0x00, 0xf9, 0x17, 
+0xd3, 0x10, 0x6c, 0x0a, 0xc2, 0x00, 0x1c, 0x01, 0x10, 0x01, 0x24, 0x00, 
+0xa6, 0x09, 0x30, 0x00, 0xa6, 0x09, 0xaa, 0x07, 0x9c, 0x0f, 0xf6, 0x00, 
+0x47, 0x0c, 0x50, 0x17, 0xa8, 0x0c, 0x7c, 0x0c, 0x8c, 0x06, 0x23, 0x00, 
+0x52, 0x0c, 0xb3, 0x0c, 0x6b, 0x00, 0x67, 0x07, 0x89, 0x0b, 0xea, 0x00, 
+0x2a, 0x07, 0x62, 0x12, 0x5c, 0x08, 0xc5, 0x14, 0x65, 0x09, 0xc9, 0x00, 
+0xbf, 0x00, 0xb7, 0x0a, 0x96, 0x0a, 0xa9, 0x15, 0x3c, 0x01, 0xe7, 0x09, 
+0x3e, 0x0e, 0xdf, 0x13, 0x12, 0x01, 0xdc, 0x06, 0x32, 0x00, 0x8e, 0x17, 
+0xc0, 0x00, 0x36, 0x01, 0xc4, 0x13, 0xfb, 0x0a, 0xfd, 0x00, 0x96, 0x0c, 
+0xe3, 0x06, 0x30, 0x01, 0x34, 0x01, 0xe2, 0x00, 0xe8, 0x09, 0x21, 0x07, 
+0x97, 0x15, 0x48, 0x19, 0x3a, 0x13, 0x63, 0x09, 0x04, 0x01, 0xdf, 0x09, 
+0xae, 0x00, 0x8f, 0x00, 0x0c, 0x0c, 0x4d, 0x0a, 0x0d, 0x00, 0x67, 0x08, 
+0xb6, 0x09, 0xc0, 0x00, 0x1d, 0x00, 0x43, 0x18, 0x17, 0x01, 0x65, 0x09, 
+0x2c, 0x18, 0x04, 0x01, 0x1b, 0x0e, 0xd4, 0x0c, 0xce, 0x15, 0x5b, 0x17, 
+0x1b, 0x01, 0xad, 0x0f, 0xe1, 0x13, 0xb2, 0x08, 0x1d, 0x01, 0xcb, 0x0f, 
+0x41, 0x06, 0x5f, 0x0a, 0xd3, 0x0c, 0x27, 0x10, 0x9b, 0x00, 0x7c, 0x16, 
+0x1b, 0x0b, 0x47, 0x0b, 0x3b, 0x00, 0x3b, 0x1a, 0x3c, 0x12, 0x49, 0x12, 
+0x52, 0x0c, 0xcb, 0x00, 0x13, 0x01, 0x88, 0x0a, 0xab, 0x09, 0x87, 0x0c, 
+0x64, 0x0c, 0x9a, 0x0a, 0xb9, 0x06, 0xe9, 0x13, 0x8f, 0x00, 0xcb, 0x0b, 
+0x86, 0x0d, 0xba, 0x0a, 0x1a, 0x00, 0x3f, 0x01, 0xb5, 0x0f, 0x79, 0x09, 
+0xd9, 0x07, 0xce, 0x06, 0x62, 0x0d, 0xd1, 0x06, 0x04, 0x13, 0xa9, 0x00, 
+0xf2, 0x13, 0x46, 0x09, 0x46, 0x0b, 0xe4, 0x06, 0x04, 0x13, 0x4c, 0x0a, 
+0x6c, 0x00, 0x4a, 0x0a, 0xb5, 0x0b, 0xf2, 0x09, 0x40, 0x06, 0xf1, 0x00, 
+0x4c, 0x06, 0x8e, 0x0a, 0xdd, 0x09, 0x05, 0x0b, 0x27, 0x0b, 0xdc, 0x0d, 
+0x69, 0x0c, 0x77, 0x12, 0x8d, 0x0a, 0xfa, 0x0a, 0x24, 0x01, 0xfd, 0x00, 
+0x4e, 0x00, 0x7f, 0x0b, 0xc7, 0x09, 0xca, 0x0b, 0x30, 0x00, 0x78, 0x00, 
+0x1f, 0x1b, 0x1d, 0x0b, 0xf3, 0x08, 0x1b, 0x01, 0x39, 0x0c, 0xd8, 0x0a, 
+0x6c, 0x0a, 0xdc, 0x00, 0x60, 0x0a, 0xbb, 0x08, 0xd0, 0x09, 0xa4, 0x0a, 
+0xbc, 0x0e, 0xcf, 0x0b, 0x3b, 0x0c, 0x06, 0x01, 0x42, 0x0b, 0x02, 0x01, 
+0x6b, 0x00, 0x2f, 0x07, 0xbe, 0x09, 0x3c, 0x0c, 0xf9, 0x0b, 0xaa, 0x10, 
+0xd0, 0x00, 0x78, 0x0a, 0xbe, 0x09, 0xfc, 0x00, 0xde, 0x0b, 0x97, 0x0b, 
+0x64, 0x00, 0x51, 0x0c, 0xf7, 0x0e, 0x26, 0x00, 0x3e, 0x01, 0x19, 0x0c, 
+0x1a, 0x01, 0x56, 0x0b, 0x78, 0x00, 0x0d, 0x0c, 0xd9, 0x06, 0x67, 0x0b, 
+0xaa, 0x00, 0x3a, 0x16, 0xcf, 0x00, 0xe2, 0x07, 0x8a, 0x0c, 0xd6, 0x0c, 
+0x0d, 0x13, 0xd5, 0x0b, 0xc1, 0x0c, 0x3b, 0x01, 0xd0, 0x18, 0xbb, 0x14, 
+0x19, 0x0c, 0x3e, 0x0d, 0x40, 0x0a, 0x37, 0x01, 0xe7, 0x15, 0xd4, 0x00, 
+0x12, 0x0a, 0xbb, 0x14, 0xc2, 0x00, 0x84, 0x00, 0x88, 0x0b, 0xbd, 0x0e, 
+0xc7, 0x07, 0x44, 0x06, 0xde, 0x00, 0xaa, 0x16, 0xef, 0x0b, 0x96, 0x15, 
+0x6d, 0x11, 0x38, 0x00, 0x0c, 0x0a, 0x9c, 0x08, 0x8f, 0x0a, 0xa8, 0x00, 
+0x0c, 0x13, 0xac, 0x0b, 0xbc, 0x00, 0xc9, 0x0e, 0xa3, 0x15, 0xab, 0x18, 
+0xe7, 0x0f, 0x91, 0x00, 0xa4, 0x07, 0xc8, 0x00, 0xb2, 0x00, 0x52, 0x0c, 
+0x0c, 0x13, 0xa2, 0x06, 0x0a, 0x0d, 0xdb, 0x06, 0xda, 0x0d, 0x0e, 0x01, 
+0x48, 0x00, 0x53, 0x13, 0x11, 0x07, 0x4e, 0x14, 0x2b, 0x12, 0xff, 0x0c, 
+0xb0, 0x10, 0x5f, 0x15, 0x2a, 0x01, 0x17, 0x00, 0xd6, 0x00, 0x52, 0x17, 
+0x0c, 0x00, 0x1e, 0x1a, 0x16, 0x14, 0x14, 0x0b, 0xe4, 0x00, 0xb5, 0x00, 
+0x29, 0x0a, 0x92, 0x00, 0xf1, 0x14, 0xf2, 0x00, 0x84, 0x00, 0x1e, 0x0b, 
+0xf6, 0x00, 0x96, 0x07, 0x40, 0x06, 0xcc, 0x10, 0xa2, 0x0a, 0xac, 0x0b, 
+0xea, 0x00, 0x59, 0x1a, 0xba, 0x00, 0x6a, 0x0c, 0x17, 0x0c, 0x88, 0x09, 
+0x6c, 0x1b, 0xf9, 0x0a, 0x0f, 0x00, 0x42, 0x0a, 0x7c, 0x10, 0xc6, 0x00, 
+0x8f, 0x17, 0xdf, 0x0d, 0x41, 0x18, 0x78, 0x14, 0x35, 0x09, 0xd2, 0x00, 
+0x6c, 0x0c, 0xe6, 0x00, 0xa7, 0x13, 0x46, 0x0b, 0x7c, 0x10, 0x32, 0x00, 
+0xf8, 0x10, 0x9c, 0x00, 0xb3, 0x00, 0xb0, 0x09, 0x29, 0x0a, 0xf2, 0x00, 
+0x97, 0x0b, 0x5c, 0x08, 0xfb, 0x00, 0x6f, 0x10, 0xd9, 0x18, 0xaf, 0x09, 
+0x45, 0x14, 0xb3, 0x00, 0x9b, 0x00, 0x2b, 0x0a, 0xab, 0x18, 0xbb, 0x09, 
+0x3a, 0x01, 0x25, 0x07, 0xd6, 0x00, 0x5f, 0x07, 0xb1, 0x09, 0x79, 0x00, 
+0x29, 0x0a, 0x51, 0x16, 0x25, 0x0e, 0x64, 0x0c, 0x33, 0x00, 0xf6, 0x0b, 
+0x1f, 0x01, 0xce, 0x0a, 0x12, 0x0a, 0xbc, 0x00, 0xd9, 0x06, 0x1c, 0x01, 
+0xb0, 0x00, 0xeb, 0x07, 0x6b, 0x00, 0xf5, 0x00, 0x5e, 0x0b, 0x08, 0x0d, 
+0xb3, 0x09, 0xac, 0x00, 0xae, 0x00, 0x55, 0x0a, 0x15, 0x0a, 0x1f, 0x01, 
+0x53, 0x00, 0xaa, 0x0b, 0x88, 0x17, 0x9f, 0x07, 0xfe, 0x0e, 0x4c, 0x11, 
+0x29, 0x01, 0xc5, 0x00, 0x90, 0x0c, 0x55, 0x07, 0x7b, 0x0c, 0x7e, 0x0b, 
+0xc3, 0x0c, 0x52, 0x07, 0xa0, 0x1a, 0xdd, 0x0a, 0xf9, 0x00, 0xd9, 0x00, 
+0x32, 0x0b, 0x1d, 0x0f, 0x03, 0x0c, 0x61, 0x11, 0x8d, 0x11, 0xd5, 0x0b, 
+0x08, 0x01, 0x4a, 0x00, 0x35, 0x01, 0xaf, 0x0a, 0x29, 0x01, 0x3c, 0x10, 
+0x67, 0x07, 0xcd, 0x0b, 0xdb, 0x0d, 0x3f, 0x0d, 0xdb, 0x09, 0x81, 0x0b, 
+0xcb, 0x0c, 0x2a, 0x18, 0x64, 0x0c, 0x56, 0x00, 0xdb, 0x09, 0x56, 0x07, 
+0x29, 0x0e, 0xab, 0x16, 0x22, 0x0f, 0xbb, 0x14, 0x9d, 0x0f, 0x3b, 0x18, 
+0x93, 0x12, 0x27, 0x14, 0xb3, 0x0c, 0x24, 0x0c, 0xa7, 0x00, 0x68, 0x12, 
+0x11, 0x00, 0xf8, 0x0d, 0x39, 0x13, 0x48, 0x0b, 0xd9, 0x0a, 0xc8, 0x0a, 
+0x6d, 0x14, 0x34, 0x0b, 0x18, 0x00, 0xd8, 0x00, 0xe9, 0x00, 0xe8, 0x00, 
+0xae, 0x00, 0x12, 0x01, 0xc9, 0x00, 0x16, 0x0a, 0x42, 0x15, 0x3b, 0x1a, 
+0x5a, 0x08, 0xba, 0x18, 0x22, 0x01, 0x96, 0x0a, 0xc7, 0x0a, 0x3e, 0x0b, 
+0xaa, 0x00, 0xbb, 0x09, 0x58, 0x0a, 0x8a, 0x13, 0xc4, 0x14, 0x5d, 0x08, 
+0x71, 0x00, 0xb6, 0x0e, 0x90, 0x0a, 0x80, 0x0a, 0x21, 0x15, 0xa3, 0x0a, 
+0x74, 0x14, 0x18, 0x01, 0x6e, 0x00, 0xb4, 0x15, 0xa0, 0x15, 0x48, 0x00, 
+0xb4, 0x08, 0xdb, 0x0f, 0x90, 0x1b, 0xb7, 0x10, 0x42, 0x15, 0xf9, 0x00, 
+0xb4, 0x16, 0xc8, 0x09, 0x75, 0x00, 0xb8, 0x00, 0x41, 0x08, 0x50, 0x0c, 
+0x9f, 0x11, 0x96, 0x15, 0xe1, 0x00, 0xd5, 0x0b, 0x33, 0x01, 0x0f, 0x13, 
+0x79, 0x00, 0x40, 0x0b, 0x87, 0x0d, 0x61, 0x17, 0xbb, 0x12, 0xf3, 0x14, 
+0xe3, 0x0b, 0xc0, 0x00, 0x9c, 0x0f, 0x3e, 0x08, 0x07, 0x01, 0xca, 0x00, 
+0xcb, 0x0e, 0x4a, 0x00, 0x92, 0x00, 0x1a, 0x00, 0x6a, 0x0a, 0xfc, 0x00, 
+0x0a, 0x01, 0xb4, 0x09, 0x5d, 0x08, 0xeb, 0x08, 0xb2, 0x06, 0xf8, 0x0f, 
+0x62, 0x0a, 0x7d, 0x16, 0xc2, 0x00, 0xc0, 0x00, 0xd9, 0x06, 0x65, 0x0c, 
+0x90, 0x0c, 0xb2, 0x00, 0xaf, 0x12, 0xf0, 0x0a, 0x69, 0x17, 0x2b, 0x0a, 
+0x25, 0x0b, 0x86, 0x0c, 0x3d, 0x01, 0xce, 0x18, 0xe5, 0x07, 0xe6, 0x0a, 
+0xcf, 0x06, 0x83, 0x00, 0x77, 0x06, 0x93, 0x0f, 0x1a, 0x01, 0x2f, 0x0c, 
+0x62, 0x17, 0x40, 0x06, 0xe8, 0x0f, 0xab, 0x16, 0xae, 0x00, 0xda, 0x06, 
+0x47, 0x00, 0x3f, 0x09, 0xd3, 0x0c, 0x16, 0x01, 0xb9, 0x00, 0xa2, 0x0c, 
+0xf0, 0x0b, 0xcf, 0x0a, 0x11, 0x01, 0x48, 0x00, 0x88, 0x0c, 0x6e, 0x10, 
+0x91, 0x0c, 0x9a, 0x19, 0x5e, 0x07, 0xb9, 0x00, 0x45, 0x00, 0xb7, 0x00, 
+0x90, 0x0c, 0xfb, 0x16, 0x55, 0x0c, 0x2c, 0x0a, 0xfb, 0x0a, 0xc2, 0x0b, 
+0xe3, 0x0a, 0xee, 0x00, 0x8f, 0x00, 0x37, 0x0d, 0xa2, 0x07, 0xd1, 0x0a, 
+0x73, 0x0a, 0x3b, 0x00, 0x56, 0x0a, 0x47, 0x06, 0xc7, 0x0c, 0xad, 0x00, 
+0xb6, 0x09, 0x17, 0x0a, 0x36, 0x01, 0x6a, 0x07, 0x14, 0x01, 0x97, 0x09, 
+0x46, 0x06, 0xc5, 0x0c, 0x99, 0x17, 0xc2, 0x16, 0xe1, 0x19, 0x1b, 0x0b, 
+0x0c, 0x01, 0x55, 0x0a, 0xd0, 0x00, 0xf6, 0x0c, 0xe4, 0x0d, 0x5c, 0x0b, 
+0x29, 0x00, 0x10, 0x0b, 0xe2, 0x0b, 0xf9, 0x09, 0x97, 0x15, 0x29, 0x01, 
+0xc5, 0x14, 0x04, 0x0c, 0x2a, 0x13, 0x49, 0x0a, 0x6f, 0x0c, 0x1b, 0x0b, 
+0xd2, 0x10, 0xa9, 0x15, 0x31, 0x01, 0x12, 0x12, 0xcf, 0x06, 0x92, 0x08, 
+0x56, 0x18, 0xdb, 0x00, 0x35, 0x0b, 0xfc, 0x08, 0xf6, 0x0b, 0x0e, 0x01, 
+0x66, 0x12, 0x22, 0x0f, 0x5f, 0x0a, 0x88, 0x09, 0x95, 0x00, 0x94, 0x07, 
+0x69, 0x06, 0x2d, 0x0c, 0x0e, 0x08, 0x0d, 0x00, 0x27, 0x01, 0x39, 0x0d, 
+0xb8, 0x0b, 0x2d, 0x01, 0x85, 0x0e, 0xf6, 0x0e, 0xec, 0x00, 0x32, 0x00, 
+0x1d, 0x01, 0xbe, 0x00, 0x62, 0x09, 0x23, 0x01, 0xc6, 0x09, 0xfc, 0x00, 
+0x19, 0x08, 0x35, 0x0d, 0x1d, 0x0e, 0x0d, 0x01, 0xa2, 0x06, 0x67, 0x0c, 
+0x85, 0x0e, 0x23, 0x00, 0x2e, 0x0c, 0x47, 0x00, 0x16, 0x07, 0xdb, 0x00, 
+0x88, 0x00, 0x2d, 0x08, 0xdf, 0x00, 0xed, 0x0e, 0xf9, 0x0b, 0x40, 0x01, 
+0x2f, 0x07, 0xfe, 0x00, 0xdf, 0x13, 0xe8, 0x00, 0x6d, 0x19, 0xf2, 0x00, 
+0x92, 0x0c, 0x09, 0x16, 0xdf, 0x09, 0x14, 0x01, 0xa0, 0x0c, 0xdb, 0x0a, 
+0xc4, 0x00, 0xba, 0x18, 0xd8, 0x00, 0x3c, 0x0b, 0xdb, 0x0a, 0x4b, 0x14, 
+0xe8, 0x0c, 0xd0, 0x09, 0x96, 0x0c, 0xf1, 0x00, 0x40, 0x16, 0xa9, 0x15, 
+0xb5, 0x13, 0xc4, 0x00, 0x5c, 0x0b, 0x1d, 0x01, 0x00, 0x0b, 0xa7, 0x00, 
+0x95, 0x07, 0x11, 0x0c, 0x35, 0x0d, 0x2e, 0x0a, 0x39, 0x01, 0xf0, 0x0a, 
+0xba, 0x17, 0xf1, 0x13, 0xe3, 0x0f, 0xb5, 0x09, 0xc0, 0x0c, 0x86, 0x0a, 
+0x22, 0x0a, 0xfc, 0x0d, 0xe1, 0x13, 0x31, 0x00, 0xac, 0x16, 0x8c, 0x0b, 
+0x4c, 0x10, 0x4d, 0x0d, 0x37, 0x01, 0x34, 0x01, 0xb6, 0x00, 0xe1, 0x08, 
+0xc9, 0x00, 0x64, 0x0e, 0x57, 0x00, 0xf5, 0x00, 0xf7, 0x00, 0x3b, 0x00, 
+0xca, 0x0c, 0x48, 0x00, 0xb8, 0x10, 0x5b, 0x08, 0x6f, 0x0c, 0x66, 0x11, 
+0x03, 0x15, 0x25, 0x00, 0xe3, 0x08, 0x61, 0x06, 0x0f, 0x16, 0x7d, 0x00, 
+0x28, 0x01, 0x32, 0x00, 0xe5, 0x11, 0xf6, 0x0e, 0x6f, 0x12, 0x0c, 0x00, 
+0x02, 0x01, 0xcf, 0x0b, 0xb0, 0x00, 0xc5, 0x09, 0xef, 0x00, 0xe1, 0x08, 
+0x8d, 0x0b, 0xd1, 0x06, 0x11, 0x01, 0x04, 0x01, 0xe9, 0x00, 0x9b, 0x15, 
+0x15, 0x13, 0x9b, 0x0a, 0x0f, 0x0c, 0xdc, 0x09, 0x2a, 0x0b, 0x45, 0x06, 
+0x26, 0x08, 0x96, 0x0a, 0xdc, 0x00, 0x4b, 0x0a, 0xc6, 0x00, 0x10, 0x0b, 
+0x7d, 0x00, 0x18, 0x00, 0xc8, 0x00, 0x92, 0x0c, 0x92, 0x0f, 0xe4, 0x0b, 
+0xa5, 0x00, 0xae, 0x10, 0xc6, 0x0a, 0xfe, 0x0c, 0xe3, 0x00, 0x80, 0x0a, 
+0xdd, 0x14, 0xfa, 0x0b, 0xad, 0x0f, 0xda, 0x10, 0xce, 0x15, 0x23, 0x09, 
+0x9d, 0x08, 0x33, 0x18, 0x2e, 0x01, 0xad, 0x0a, 0x78, 0x09, 0xdc, 0x0a, 
+0x56, 0x07, 0xac, 0x0a, 0xf4, 0x13, 0x71, 0x10, 0x2a, 0x01, 0x6e, 0x00, 
+0xf3, 0x00, 0xda, 0x06, 0xcb, 0x00, 0x28, 0x0c, 0xce, 0x00, 0x67, 0x11, 
+0x95, 0x1a, 0x4a, 0x00, 0x4f, 0x09, 0x3d, 0x01, 0x99, 0x07, 0x7d, 0x0b, 
+0x20, 0x14, 0xdf, 0x0e, 0x8b, 0x0a, 0x29, 0x0b, 0x4a, 0x00, 0xe4, 0x0d, 
+0xcf, 0x06, 0x2b, 0x0a, 0x5d, 0x08, 0x59, 0x06, 0xaf, 0x00, 0xd1, 0x12, 
+0xc0, 0x0c, 0x8e, 0x06, 0x72, 0x0c, 0x17, 0x01, 0xed, 0x09, 0x86, 0x00, 
+0x24, 0x09, 0x21, 0x09, 0x21, 0x01, 0x09, 0x01, 0xb9, 0x00, 0x3c, 0x13, 
+0x84, 0x00, 0x70, 0x0c, 0xa8, 0x0a, 0x06, 0x0c, 0xda, 0x07, 0x7b, 0x00, 
+0xad, 0x0b, 0xc9, 0x0c, 0xe7, 0x0b, 0xe0, 0x0c, 0x00, 0x01, 0x59, 0x0b, 
+0x70, 0x10, 0x15, 0x01, 0x38, 0x01, 0x70, 0x07, 0x58, 0x00, 0x05, 0x0f, 
+0x36, 0x01, 0x4f, 0x11, 0xd2, 0x00, 0xd7, 0x18, 0xd3, 0x06, 0xb9, 0x0a, 
+0x29, 0x12, 0xd1, 0x06, 0xea, 0x00, 0x6b, 0x0a, 0x81, 0x1b, 0x4c, 0x0a, 
+0xda, 0x06, 0x01, 0x01, 0x08, 0x01, 0x6a, 0x0d, 0x53, 0x07, 0x3d, 0x01, 
+0xf8, 0x00, 0x9c, 0x00, 0x30, 0x00, 0x49, 0x00, 0x07, 0x17, 0xdd, 0x17, 
+0x24, 0x13, 0xc3, 0x08, 0x46, 0x0b, 0xde, 0x00, 0x96, 0x1a, 0x10, 0x0b, 
+0x6b, 0x00, 0x24, 0x00, 0x1e, 0x0c, 0xa5, 0x09, 0x48, 0x00, 0xef, 0x07, 
+0x5e, 0x12, 0xc1, 0x00, 0xcc, 0x00, 0xb1, 0x0b, 0x37, 0x08, 0x45, 0x1c, 
+0x56, 0x18, 0x28, 0x0b, 0x23, 0x08, 0x0c, 0x0c, 0xd7, 0x14, 0x43, 0x06, 
+0xf3, 0x14, 0x38, 0x08, 0x4a, 0x00, 0x77, 0x09, 0x27, 0x0c, 0xe1, 0x08, 
+0x83, 0x11, 0x81, 0x18, 0x05, 0x09, 0x21, 0x10, 0x30, 0x1a, 0x72, 0x08, 
+0xbd, 0x00, 0x2e, 0x01, 0x9a, 0x0a, 0x79, 0x07, 0x64, 0x0c, 0xe7, 0x09, 
+0xc4, 0x0b, 0xf5, 0x00, 0x46, 0x19, 0x8f, 0x00, 0x1e, 0x01, 0x13, 0x0c, 
+0xeb, 0x09, 0xc8, 0x0c, 0x38, 0x0a, 0x5d, 0x0b, 0xdb, 0x0d, 0x1f, 0x1a, 
+0x71, 0x08, 0x1e, 0x15, 0x4b, 0x11, 0xf4, 0x0c, 0x74, 0x0b, 0xe9, 0x0c, 
+0xaa, 0x08, 0x76, 0x0a, 0x1b, 0x00, 0xbb, 0x14, 0xd8, 0x07, 0x5d, 0x07, 
+0x3e, 0x01, 0xe4, 0x0a, 0x7d, 0x16, 0x78, 0x00, 0xbb, 0x00, 0xa5, 0x0f, 
+0x43, 0x06, 0x2e, 0x01, 0xc2, 0x0b, 0xaa, 0x00, 0xfd, 0x00, 0x9f, 0x00, 
+0xb4, 0x07, 0x78, 0x00, 0xdb, 0x00, 0xa6, 0x0a, 0x41, 0x06, 0x13, 0x00, 
+0x05, 0x01, 0xda, 0x07, 0x9b, 0x0a, 0xc8, 0x00, 0xfb, 0x00, 0xef, 0x00, 
+0x05, 0x10, 0x36, 0x0a, 0xda, 0x0d, 0x3d, 0x01, 0x3b, 0x00, 0x65, 0x08, 
+0xac, 0x06, 0x25, 0x0e, 0xc3, 0x0c, 0xd9, 0x17, 0x47, 0x18, 0xba, 0x14, 
+0x0d, 0x00, 0xc1, 0x00, 0xf4, 0x07, 0xfa, 0x00, 0x5f, 0x00, 0x15, 0x07, 
+0xae, 0x0f, 0x59, 0x0c, 0xa4, 0x09, 0x47, 0x0c, 0x0d, 0x00, 0x78, 0x10, 
+0x58, 0x11, 0x1a, 0x0c, 0x8a, 0x10, 0x30, 0x0a, 0xa0, 0x07, 0x43, 0x0b, 
+0xd7, 0x18, 0x4a, 0x13, 0x06, 0x0b, 0xfb, 0x15, 0x38, 0x0c, 0x26, 0x0b, 
+0x8b, 0x0a, 0x47, 0x0b, 0x58, 0x1a, 0x40, 0x0a, 0xa5, 0x0f, 0xcb, 0x0f, 
+0x76, 0x17, 0xf4, 0x00, 0xc8, 0x00, 0xe1, 0x0c, 0xda, 0x0a, 0x4b, 0x06, 
+0x02, 0x13, 0x87, 0x17, 0x56, 0x07, 0x00, 0x01, 0xd3, 0x0f, 0x96, 0x0f, 
+0x97, 0x15, 0x21, 0x00, 0x60, 0x00, 0x0c, 0x13, 0x9e, 0x0c, 0xe4, 0x00, 
+0x35, 0x18, 0xa7, 0x0c, 0xfc, 0x14, 0xda, 0x07, 0x6d, 0x09, 0x0f, 0x0b, 
+0x38, 0x0a, 0x12, 0x0b, 0xd8, 0x00, 0x6c, 0x0b, 0xb0, 0x00, 0xd6, 0x11, 
+0xc6, 0x0a, 0x0f, 0x08, 0x6b, 0x0b, 0xc4, 0x0b, 0x06, 0x01, 0xd7, 0x09, 
+0x68, 0x13, 0x5c, 0x0b, 0x64, 0x0c, 0x06, 0x07, 0x9d, 0x00, 0xce, 0x14, 
+0x01, 0x0c, 0x43, 0x0a, 0x42, 0x0a, 0xf9, 0x00, 0x8a, 0x10, 0xbc, 0x00, 
+0x10, 0x10, 0xf7, 0x0b, 0xe8, 0x00, 0x8c, 0x0a, 0x5c, 0x0c, 0x5a, 0x0f, 
+0x6e, 0x13, 0xb5, 0x00, 0xdb, 0x09, 0x05, 0x01, 0xb2, 0x08, 0x11, 0x07, 
+0xe2, 0x09, 0x87, 0x0a, 0xee, 0x0a, 0xc1, 0x00, 0x1a, 0x0e, 0xb1, 0x08, 
+0xed, 0x06, 0x1e, 0x0a, 0xab, 0x00, 0x30, 0x00, 0xcc, 0x0f, 0x45, 0x07, 
+0xa8, 0x00, 0xd9, 0x0a, 0xb1, 0x09, 0x37, 0x01, 0x4a, 0x0c, 0xc8, 0x1b, 
+0xde, 0x13, 0x0d, 0x0c, 0x1d, 0x01, 0xd5, 0x00, 0x21, 0x0b, 0x03, 0x01, 
+0x07, 0x01, 0xc0, 0x00, 0xbe, 0x11, 0x23, 0x0c, 0xf8, 0x09, 0x45, 0x0c, 
+0xb3, 0x09, 0xd9, 0x06, 0x3a, 0x01, 0x3a, 0x13, 0x98, 0x0a, 0xd5, 0x00, 
+0x46, 0x0d, 0x14, 0x01, 0x54, 0x0a, 0xd9, 0x0b, 0xd2, 0x0c, 0xf3, 0x0c, 
+0x55, 0x0a, 0x77, 0x0a, 0x6b, 0x0a, 0xa1, 0x0b, 0xe4, 0x0a, 0xd7, 0x00, 
+0x19, 0x08, 0xbc, 0x00, 0x9c, 0x0b, 0xaa, 0x00, 0x4a, 0x13, 0x62, 0x19, 
+0x1b, 0x01, 0xf6, 0x07, 0x6f, 0x00, 0xd3, 0x00, 0x3d, 0x0c, 0xfb, 0x00, 
+0x79, 0x00, 0x96, 0x18, 0x20, 0x0a, 0x81, 0x0a, 0x47, 0x00, 0xd2, 0x00, 
+0x24, 0x08, 0xf0, 0x07, 0xbc, 0x0e, 0xb6, 0x00, 0xe0, 0x0b, 0x23, 0x00, 
+0x90, 0x00, 0xc7, 0x00, 0xa9, 0x00, 0xde, 0x08, 0x62, 0x00, 0xf3, 0x18, 
+0xf9, 0x1a, 0xe2, 0x00, 0x55, 0x11, 0xf6, 0x00, 0x0d, 0x0a, 0x3c, 0x00, 
+0x6b, 0x06, 0xcb, 0x00, 0x64, 0x00, 0x66, 0x08, 0xce, 0x0a, 0x3c, 0x01, 
+0x30, 0x01, 0xa8, 0x0f, 0x1e, 0x16, 0xf4, 0x09, 0xa7, 0x10, 0xaf, 0x0a, 
+0x46, 0x06, 0xcf, 0x00, 0x3d, 0x13, 0xb2, 0x08, 0xd3, 0x10, 0xb7, 0x0c, 
+0x56, 0x0a, 0x3f, 0x01, 0x35, 0x01, 0x23, 0x01, 0x19, 0x01, 0xd1, 0x0b, 
+0xfe, 0x0a, 0xc8, 0x17, 0xfe, 0x00, 0xcd, 0x00, 0xbf, 0x09, 0x2c, 0x11, 
+0x3d, 0x01, 0xd0, 0x00, 0x03, 0x01, 0x89, 0x0c, 0x10, 0x07, 0xcf, 0x15, 
+0xea, 0x0a, 0x82, 0x10, 0x19, 0x01, 0x36, 0x01, 0x27, 0x00, 0x1c, 0x0b, 
+0xcf, 0x00, 0x8a, 0x0b, 0xe9, 0x00, 0x3c, 0x0c, 0x0c, 0x01, 0xe3, 0x0a, 
+0x4f, 0x06, 0x51, 0x0c, 0x63, 0x0a, 0x3b, 0x0b, 0x72, 0x00, 0xc5, 0x0a, 
+0x35, 0x0a, 0x75, 0x0b, 0x8e, 0x0d, 0xe9, 0x0d, 0xcf, 0x00, 0xa8, 0x10, 
+0x3f, 0x0a, 0x70, 0x10, 0x0f, 0x00, 0xc7, 0x0a, 0x59, 0x17, 0x41, 0x06, 
+0x38, 0x0c, 0xb7, 0x00, 0xe8, 0x00, 0xd3, 0x0e, 0x36, 0x0a, 0x23, 0x0e, 
+0x60, 0x1b, 0xd6, 0x00, 0xfc, 0x0a, 0xf6, 0x0c, 0x58, 0x00, 0x90, 0x00, 
+0x70, 0x06, 0x01, 0x0a, 0xe2, 0x16, 0x3c, 0x00, 0x3e, 0x09, 0x65, 0x08, 
+0xf0, 0x0a, 0x96, 0x09, 0xd8, 0x07, 0x43, 0x0a, 0xb5, 0x0b, 0xed, 0x00, 
+0x61, 0x00, 0x36, 0x01, 0x4b, 0x11, 0x0f, 0x01, 0xd0, 0x0a, 0x73, 0x0b, 
+0x34, 0x0b, 0xb6, 0x0b, 0x76, 0x0b, 0x70, 0x0c, 0x12, 0x09, 0xf2, 0x14, 
+0x28, 0x0c, 0x78, 0x07, 0xc7, 0x0a, 0x71, 0x00, 0x1a, 0x00, 0xe6, 0x06, 
+0xc1, 0x00, 0x26, 0x0d, 0x85, 0x00, 0x67, 0x09, 0x3e, 0x0a, 0xef, 0x08, 
+0x9b, 0x00, 0x21, 0x15, 0x48, 0x06, 0x9b, 0x0c, 0x34, 0x14, 0xf9, 0x0d, 
+0xdd, 0x06, 0x95, 0x0b, 0x62, 0x07, 0x02, 0x0e, 0x35, 0x01, 0x85, 0x0c, 
+0x27, 0x11, 0x71, 0x0c, 0xb4, 0x00, 0x05, 0x01, 0xef, 0x00, 0xab, 0x08, 
+0xea, 0x00, 0x17, 0x1a, 0x4a, 0x11, 0x11, 0x01, 0x79, 0x10, 0x06, 0x01, 
+0x75, 0x12, 0xec, 0x16, 0x86, 0x0c, 0xe3, 0x06, 0x0a, 0x01, 0xc5, 0x0a, 
+0xe8, 0x09, 0x94, 0x12, 0x0a, 0x0d, 0x66, 0x0a, 0x61, 0x00, 0xc2, 0x0a, 
+0x4b, 0x13, 0x8d, 0x06, 0x7c, 0x08, 0xfa, 0x16, 0xe0, 0x13, 0x24, 0x01, 
+0x4d, 0x06, 0x09, 0x01, 0xf0, 0x00, 0x64, 0x0c, 0xc1, 0x00, 0x54, 0x00, 
+0x46, 0x06, 0xfd, 0x00, 0xb5, 0x00, 0x4f, 0x0c, 0x3c, 0x1a, 0x39, 0x01, 
+0x30, 0x00, 0x1a, 0x0b, 0xb6, 0x16, 0x99, 0x15, 0xba, 0x09, 0x34, 0x00, 
+0x17, 0x0c, 0x4e, 0x17, 0x1c, 0x07, 0x2d, 0x08, 0xf6, 0x0e, 0x17, 0x14, 
+0xf6, 0x0d, 0x48, 0x07, 0xff, 0x00, 0xe1, 0x00, 0x1d, 0x01, 0xce, 0x18, 
+0x20, 0x14, 0x12, 0x01, 0xab, 0x1a, 0x33, 0x01, 0x4d, 0x06, 0x61, 0x00, 
+0x83, 0x18, 0x5d, 0x07, 0x1f, 0x01, 0x48, 0x12, 0x3a, 0x01, 0xd3, 0x0c, 
+0x1d, 0x01, 0x00, 0x0d, 0xed, 0x0e, 0xab, 0x08, 0x6b, 0x0d, 0xe4, 0x0a, 
+0x4d, 0x09, 0x8f, 0x00, 0xca, 0x09, 0xc9, 0x00, 0xee, 0x0b, 0x62, 0x09, 
+0xe5, 0x0d, 0xa7, 0x00, 0x9a, 0x0b, 0x0b, 0x00, 0xbd, 0x0c, 0xf7, 0x00, 
+0xa9, 0x07, 0x6c, 0x11, 0xf1, 0x0b, 0x1d, 0x01, 0x24, 0x00, 0x00, 0x01, 
+0x72, 0x16, 0x04, 0x09, 0x40, 0x06, 0x5f, 0x00, 0x21, 0x14, 0x37, 0x01, 
+0xc3, 0x00, 0x46, 0x0c, 0xda, 0x06, 0x4e, 0x0c, 0xae, 0x0b, 0xde, 0x08, 
+0x04, 0x08, 0xb8, 0x10, 0x31, 0x00, 0xa9, 0x08, 0x60, 0x08, 0xd6, 0x0b, 
+0x08, 0x01, 0x32, 0x12, 0xa9, 0x00, 0xe1, 0x0b, 0x27, 0x12, 0x83, 0x00, 
+0xa9, 0x00, 0xda, 0x0d, 0x7b, 0x18, 0xaf, 0x0a, 0xe9, 0x13, 0x7e, 0x0b, 
+0xd7, 0x0b, 0xb2, 0x08, 0x9c, 0x15, 0x0f, 0x01, 0xb4, 0x00, 0x28, 0x0a, 
+0x36, 0x00, 0xa5, 0x09, 0x34, 0x0b, 0x2d, 0x10, 0xdf, 0x13, 0xff, 0x0c, 
+0x3e, 0x00, 0xd5, 0x0b, 0x0b, 0x10, 0x51, 0x0c, 0x59, 0x0c, 0x5c, 0x17, 
+0xe2, 0x00, 0xb6, 0x0c, 0xd5, 0x0b, 0x42, 0x06, 0xed, 0x08, 0x49, 0x06, 
+0xfd, 0x06, 0xad, 0x0a, 0xda, 0x00, 0x24, 0x01, 0xc0, 0x0b, 0xe2, 0x14, 
+0x56, 0x06, 0xae, 0x00, 0xc1, 0x16, 0x09, 0x0a, 0x61, 0x0a, 0xe8, 0x00, 
+0xf6, 0x0c, 0x0b, 0x01, 0xb6, 0x0e, 0xac, 0x00, 0x30, 0x12, 0xc3, 0x10, 
+0x17, 0x01, 0xe9, 0x00, 0x1d, 0x0b, 0xa4, 0x0a, 0x5d, 0x12, 0x3d, 0x00, 
+0x37, 0x01, 0x40, 0x01, 0xe8, 0x09, 0x97, 0x0f, 0x37, 0x07, 0x36, 0x0a, 
+0x8a, 0x0b, 0x7a, 0x18, 0xb2, 0x0c, 0x88, 0x0b, 0x08, 0x01, 0x02, 0x0c, 
+0x42, 0x15, 0x47, 0x06, 0x1b, 0x08, 0x05, 0x0b, 0xac, 0x15, 0x07, 0x01, 
+0x86, 0x17, 0x3e, 0x0b, 0x55, 0x0a, 0xa6, 0x09, 0xdb, 0x09, 0x01, 0x0c, 
+0xaa, 0x06, 0x54, 0x07, 0xba, 0x09, 0x6f, 0x0a, 0x61, 0x00, 0xdc, 0x06, 
+0x7f, 0x0b, 0x1c, 0x07, 0x6a, 0x0a, 0xc3, 0x00, 0xf7, 0x00, 0xd7, 0x07, 
+0xd9, 0x16, 0xcb, 0x09, 0x3d, 0x01, 0xaa, 0x0c, 0x3e, 0x01, 0xee, 0x0b, 
+0xad, 0x0a, 0x49, 0x06, 0x86, 0x0d, 0x19, 0x00, 0xcb, 0x00, 0x65, 0x08, 
+0xa9, 0x15, 0x43, 0x06, 0xc4, 0x14, 0xb8, 0x0b, 0x91, 0x00, 0xaa, 0x0b, 
+0xe7, 0x00, 0x52, 0x07, 0xee, 0x0e, 0xba, 0x16, 0x27, 0x12, 0xba, 0x08, 
+0x49, 0x00, 0x3c, 0x13, 0x1c, 0x01, 0x6b, 0x00, 0x13, 0x0e, 0xaa, 0x0b, 
+0xb9, 0x00, 0xf4, 0x09, 0x20, 0x01, 0xb5, 0x00, 0xf1, 0x09, 0x39, 0x0c, 
+0xd6, 0x00, 0xfa, 0x09, 0xd7, 0x07, 0x2c, 0x0a, 0x59, 0x17, 0xd0, 0x00, 
+0x30, 0x01, 0xd7, 0x07, 0xe6, 0x0a, 0xa4, 0x06, 0xba, 0x09, 0xa9, 0x00, 
+0x4e, 0x00, 0x5d, 0x0c, 0xf7, 0x09, 0xbc, 0x0a, 0x00, 0x10, 0x7c, 0x00, 
+0x0e, 0x01, 0x47, 0x08, 0xd0, 0x00, 0x72, 0x16, 0xae, 0x00, 0x0b, 0x00, 
+0x73, 0x0b, 0xa1, 0x0a, 0x25, 0x00, 0x86, 0x00, 0x3d, 0x13, 0xd1, 0x00, 
+0xbe, 0x0c, 0xd1, 0x09, 0x61, 0x0b, 0x29, 0x0a, 0xbd, 0x0a, 0xc7, 0x09, 
+0x0e, 0x0a, 0x77, 0x0b, 0x66, 0x12, 0x0c, 0x0c, 0xbd, 0x09, 0xfb, 0x0b, 
+0xbf, 0x0b, 0xcd, 0x00, 0x6b, 0x06, 0x82, 0x0b, 0xca, 0x12, 0xb3, 0x0c, 
+0x86, 0x00, 0xba, 0x00, 0xec, 0x0b, 0xe8, 0x00, 0x54, 0x11, 0xde, 0x13, 
+0x2d, 0x0c, 0x6d, 0x1b, 0x23, 0x00, 0x8c, 0x0a, 0x85, 0x0c, 0x71, 0x07, 
+0x9b, 0x0c, 0xf2, 0x0a, 0x17, 0x01, 0xe4, 0x0a, 0xb5, 0x0b, 0x83, 0x00, 
+0x82, 0x0a, 0x03, 0x15, 0xbd, 0x09, 0x66, 0x18, 0xcb, 0x0c, 0xe6, 0x09, 
+0xf2, 0x00, 0x02, 0x01, 0xe1, 0x10, 0xac, 0x09, 0xbe, 0x17, 0x78, 0x00, 
+0x7c, 0x09, 0x64, 0x00, 0x3b, 0x13, 0x49, 0x0b, 0xec, 0x07, 0xad, 0x0a, 
+0xda, 0x0a, 0x43, 0x0c, 0xe0, 0x09, 0xfc, 0x00, 0xd2, 0x00, 0xab, 0x06, 
+0x16, 0x14, 0xd0, 0x09, 0x45, 0x0c, 0x07, 0x0c, 0xd7, 0x0c, 0xad, 0x0b, 
+0x4b, 0x00, 0x78, 0x00, 0x3e, 0x01, 0xd6, 0x0b, 0xd7, 0x0b, 0x82, 0x1b, 
+0x9a, 0x06, 0xc6, 0x0b, 0xd1, 0x06, 0xc6, 0x0a, 0xe9, 0x13, 0x64, 0x15, 
+0xa9, 0x0a, 0xf2, 0x00, 0xe8, 0x0c, 0x89, 0x09, 0x9e, 0x08, 0x53, 0x00, 
+0xa5, 0x09, 0x10, 0x01, 0xa7, 0x00, 0x5b, 0x0a, 0x23, 0x08, 0xb6, 0x00, 
+0xb3, 0x0c, 0x3b, 0x16, 0xee, 0x0a, 0x6e, 0x00, 0xa2, 0x0a, 0xc2, 0x0b, 
+0x6b, 0x00, 0xc0, 0x00, 0x13, 0x0e, 0xda, 0x0b, 0x17, 0x00, 0x30, 0x12, 
+0xf8, 0x00, 0xce, 0x06, 0x25, 0x07, 0xfa, 0x13, 0x62, 0x09, 0xf8, 0x0e, 
+0xfb, 0x00, 0x00, 0x10, 0x50, 0x17, 0xbd, 0x11, 0x44, 0x15, 0xf6, 0x0b, 
+0x74, 0x0b, 0xf4, 0x07, 0xcd, 0x14, 0x79, 0x14, 0x1d, 0x08, 0x2f, 0x08, 
+0x3c, 0x0b, 0x33, 0x00, 0x73, 0x10, 0x5c, 0x0c, 0xa4, 0x0a, 0x30, 0x00, 
+0xc3, 0x00, 0x60, 0x0a, 0xc8, 0x09, 0x77, 0x0a, 0x6b, 0x00, 0xdf, 0x00, 
+0xe0, 0x00, 0xc4, 0x14, 0xdd, 0x09, 0x87, 0x16, 0x78, 0x00, 0x94, 0x07, 
+0xdb, 0x09, 0x0e, 0x0c, 0xeb, 0x0b, 0xbb, 0x00, 0xcd, 0x15, 0x82, 0x11, 
+0xa6, 0x0c, 0xe4, 0x0a, 0x4d, 0x0d, 0x53, 0x00, 0x17, 0x00, 0x83, 0x00, 
+0xe0, 0x0b, 0x0c, 0x00, 0xdf, 0x0a, 0xd5, 0x14, 0xb6, 0x0e, 0xec, 0x09, 
+0xfc, 0x0d, 0x54, 0x07, 0x8b, 0x00, 0x84, 0x11, 0x54, 0x07, 0xba, 0x00, 
+0x10, 0x01, 0xb9, 0x0a, 0x5f, 0x11, 0xca, 0x09, 0x1c, 0x0b, 0x7a, 0x0c, 
+0x69, 0x12, 0x3c, 0x00, 0xa0, 0x0b, 0x2b, 0x09, 0x46, 0x06, 0xac, 0x15, 
+0x34, 0x01, 0x03, 0x0a, 0xa3, 0x15, 0xda, 0x0a, 0x4e, 0x0e, 0x17, 0x00, 
+0xdc, 0x00, 0x2f, 0x0f, 0x61, 0x17, 0xa0, 0x0b, 0x90, 0x0c, 0xed, 0x00, 
+0xd3, 0x11, 0x1f, 0x01, 0x03, 0x0c, 0x36, 0x00, 0x5a, 0x17, 0x88, 0x0c, 
+0xbd, 0x00, 0x91, 0x06, 0x96, 0x0f, 0xb2, 0x08, 0xe1, 0x0a, 0xe1, 0x0c, 
+0x21, 0x0a, 0xbd, 0x00, 0xfc, 0x0a, 0xc6, 0x08, 0x57, 0x0a, 0xd8, 0x0a, 
+0x36, 0x01, 0xdd, 0x0c, 0xe2, 0x0f, 0xe9, 0x0c, 0x54, 0x00, 0xdf, 0x0c, 
+0x89, 0x0b, 0x7a, 0x0c, 0xbf, 0x00, 0x22, 0x01, 0x83, 0x00, 0x3e, 0x0d, 
+0xc3, 0x00, 0x70, 0x07, 0x12, 0x01, 0xb0, 0x0a, 0x66, 0x0c, 0xcd, 0x0c, 
+0xf8, 0x00, 0x05, 0x01, 0x7e, 0x00, 0x49, 0x0a, 0x42, 0x00, 0x0e, 0x01, 
+0x40, 0x09, 0xee, 0x0a, 0xfc, 0x0a, 0x2b, 0x00, 0x6e, 0x09, 0x6f, 0x10, 
+0x4d, 0x11, 0xd5, 0x08, 0x77, 0x0a, 0xc6, 0x00, 0x65, 0x0c, 0xc2, 0x00, 
+0xe3, 0x0b, 0x4b, 0x08, 0xb3, 0x16, 0x24, 0x00, 0xed, 0x06, 0x5e, 0x07, 
+0xb8, 0x00, 0xd1, 0x09, 0x9c, 0x00, 0x28, 0x0a, 0xee, 0x00, 0xe6, 0x09, 
+0xef, 0x12, 0xf1, 0x13, 0x0b, 0x01, 0xae, 0x12, 0xd7, 0x00, 0x1a, 0x0c, 
+0x99, 0x15, 0xba, 0x09, 0x2d, 0x01, 0x80, 0x08, 0xf4, 0x09, 0x42, 0x06, 
+0xca, 0x0b, 0xac, 0x08, 0xdb, 0x09, 0x81, 0x11, 0x9d, 0x08, 0x3d, 0x07, 
+0xb1, 0x15, 0x24, 0x01, 0x25, 0x00, 0xa1, 0x06, 0xdf, 0x00, 0x78, 0x13, 
+0x3f, 0x01, 0xf6, 0x06, 0xbc, 0x0c, 0x34, 0x00, 0x40, 0x06, 0xba, 0x00, 
+0x72, 0x0c, 0xe8, 0x00, 0xd2, 0x0a, 0xcf, 0x0a, 0xd8, 0x16, 0xd3, 0x0a, 
+0x9d, 0x0f, 0x3f, 0x0a, 0x72, 0x16, 0xb1, 0x00, 0xfb, 0x0b, 0x6d, 0x09, 
+0x34, 0x0c, 0x34, 0x0a, 0x97, 0x07, 0x8c, 0x0c, 0xf7, 0x0a, 0x79, 0x00, 
+0x0f, 0x01, 0x46, 0x06, 0xea, 0x0c, 0x0e, 0x00, 0xa5, 0x10, 0x50, 0x1a, 
+0xc5, 0x00, 0x5a, 0x0a, 0x48, 0x00, 0x30, 0x01, 0x07, 0x0f, 0x44, 0x06, 
+0xfc, 0x07, 0x58, 0x00, 0x62, 0x00, 0x31, 0x0b, 0x4b, 0x1c, 0x3a, 0x01, 
+0x3e, 0x0f, 0x3a, 0x16, 0x55, 0x00, 0x30, 0x01, 0x3f, 0x0a, 0xfb, 0x00, 
+0xa7, 0x09, 0x5a, 0x0c, 0x9c, 0x00, 0x97, 0x17, 0x6b, 0x18, 0x2e, 0x0a, 
+0xed, 0x00, 0x2a, 0x01, 0x78, 0x0b, 0x45, 0x16, 0xe4, 0x00, 0xea, 0x13, 
+0xb7, 0x12, 0x3f, 0x0a, 0x3c, 0x01, 0x2f, 0x08, 0xa7, 0x09, 0x9d, 0x08, 
+0x88, 0x16, 0x1d, 0x0e, 0x35, 0x01, 0x6d, 0x09, 0x48, 0x06, 0x12, 0x0d, 
+0xc1, 0x0b, 0x5b, 0x00, 0x3f, 0x01, 0xf1, 0x09, 0x13, 0x0a, 0x12, 0x0a, 
+0x0a, 0x0d, 0xfb, 0x0a, 0x07, 0x0a, 0x9d, 0x00, 0x5f, 0x00, 0x26, 0x12, 
+0x1a, 0x0e, 0xe1, 0x07, 0x1b, 0x0b, 0x04, 0x01, 0x38, 0x0c, 0xa4, 0x09, 
+0x56, 0x11, 0xb1, 0x00, 0xe6, 0x00, 0x9c, 0x06, 0x13, 0x01, 0xf6, 0x0c, 
+0x54, 0x19, 0x95, 0x07, 0x39, 0x01, 0xb8, 0x0b, 0x2d, 0x01, 0x69, 0x09, 
+0x24, 0x09, 0x7f, 0x0b, 0xc0, 0x00, 0xcf, 0x00, 0x26, 0x08, 0xf3, 0x08, 
+0xcd, 0x15, 0xf5, 0x00, 0xf4, 0x07, 0xaa, 0x00, 0x86, 0x0c, 0x21, 0x09, 
+0x5c, 0x1a, 0x7b, 0x13, 0x84, 0x0a, 0x43, 0x06, 0x18, 0x01, 0x95, 0x0b, 
+0x3e, 0x00, 0xa9, 0x0b, 0x48, 0x0d, 0x4e, 0x0c, 0x55, 0x00, 0x3e, 0x01, 
+0xf6, 0x00, 0x33, 0x0b, 0x48, 0x06, 0x4b, 0x00, 0x1f, 0x07, 0xbb, 0x09, 
+0x11, 0x01, 0x8c, 0x06, 0xa9, 0x00, 0x6f, 0x10, 0x5a, 0x0f, 0x49, 0x06, 
+0x3d, 0x00, 0x1a, 0x01, 0x09, 0x16, 0xc6, 0x09, 0x11, 0x0b, 0x33, 0x08, 
+0xfe, 0x10, 0x1d, 0x01, 0x6d, 0x00, 0x05, 0x0f, 0xb5, 0x0b, 0x56, 0x06, 
+0x51, 0x17, 0x18, 0x01, 0x13, 0x01, 0xc4, 0x0a, 0xed, 0x0d, 0xbd, 0x08, 
+0xd3, 0x00, 0xc1, 0x09, 0xeb, 0x08, 0xb7, 0x00, 0x14, 0x0a, 0xfc, 0x00, 
+0xd5, 0x14, 0xab, 0x16, 0x34, 0x01, 0xd0, 0x06, 0xd7, 0x0b, 0x3d, 0x0b, 
+0xe5, 0x18, 0xc3, 0x09, 0x34, 0x0b, 0x92, 0x0f, 0x5d, 0x0b, 0xd8, 0x0a, 
+0x9d, 0x00, 0xe4, 0x18, 0x11, 0x0d, 0xef, 0x00, 0x2b, 0x09, 0xbe, 0x00, 
+0x09, 0x01, 0xba, 0x0a, 0x87, 0x00, 0xec, 0x07, 0x7b, 0x0c, 0x80, 0x0a, 
+0xdf, 0x00, 0x22, 0x0f, 0x9c, 0x0f, 0xeb, 0x00, 0x37, 0x01, 0x5d, 0x08, 
+0x66, 0x14, 0x04, 0x11, 0xd2, 0x06, 0xc1, 0x00, 0x7e, 0x0a, 0x9b, 0x00, 
+0xb5, 0x07, 0x6f, 0x11, 0x53, 0x00, 0xe9, 0x13, 0x0d, 0x00, 0x1e, 0x1a, 
+0xf8, 0x10, 0xfa, 0x00, 0x71, 0x18, 0xd2, 0x00, 0xb3, 0x06, 0x47, 0x00, 
+0x5c, 0x0c, 0x48, 0x06, 0xb9, 0x00, 0xf6, 0x0e, 0x3d, 0x18, 0x2d, 0x18, 
+0xa0, 0x00, 0xfb, 0x13, 0xf3, 0x0c, 0xb8, 0x00, 0x29, 0x01, 0x49, 0x0d, 
+0xa5, 0x0f, 0xbd, 0x14, 0x02, 0x01, 0xc7, 0x16, 0x3a, 0x12, 0xdd, 0x0c, 
+0x83, 0x0b, 0x33, 0x18, 0x62, 0x06, 0xbe, 0x0c, 0x14, 0x01, 0x54, 0x00, 
+0xd0, 0x00, 0x87, 0x00, 0xce, 0x15, 0xfc, 0x14, 0x9e, 0x0f, 0xd3, 0x09, 
+0x40, 0x06, 0x70, 0x0a, 0x2c, 0x09, 0x59, 0x0c, 0xdb, 0x00, 0xad, 0x00, 
+0x32, 0x0b, 0xd6, 0x15, 0xb5, 0x00, 0xfe, 0x0c, 0xa8, 0x17, 0x2a, 0x08, 
+0xed, 0x00, 0x63, 0x0d, 0xfd, 0x17, 0xa0, 0x0b, 0x66, 0x0c, 0x94, 0x00, 
+0x72, 0x11, 0xfb, 0x00, 0xc0, 0x0e, 0xf4, 0x09, 0x95, 0x07, 0x6e, 0x19, 
+0xce, 0x0f, 0xa8, 0x0c, 0xe5, 0x08, 0x4b, 0x06, 0xfe, 0x00, 0x18, 0x01, 
+0xb6, 0x00, 0x4f, 0x17, 0xe7, 0x07, 0xc1, 0x0b, 0xc8, 0x1b, 0x06, 0x0c, 
+0x83, 0x0a, 0xe0, 0x0b, 0xba, 0x07, 0x97, 0x06, 0x4c, 0x00, 0x53, 0x00, 
+0x02, 0x01, 0xfc, 0x00, 0x30, 0x0c, 0xcc, 0x00, 0xf4, 0x08, 0xa8, 0x09, 
+0xdb, 0x07, 0xf7, 0x09, 0xcd, 0x0a, 0x2a, 0x01, 0x67, 0x0b, 0x4a, 0x1c, 
+0x4b, 0x00, 0xed, 0x07, 0xa3, 0x0a, 0x99, 0x06, 0x2a, 0x01, 0xe9, 0x09, 
+0x0f, 0x0b, 0xb5, 0x0c, 0x35, 0x0a, 0xd4, 0x0c, 0xaf, 0x00, 0xf0, 0x0e, 
+0xe5, 0x00, 0xe7, 0x00, 0x7a, 0x08, 0x86, 0x17, 0x64, 0x0c, 0x84, 0x0b, 
+0x1c, 0x0b, 0xcb, 0x0b, 0x54, 0x07, 0x55, 0x00, 0x9c, 0x0f, 0xf4, 0x00, 
+0x0e, 0x00, 0x3c, 0x01, 0xfb, 0x15, 0xde, 0x17, 0x38, 0x01, 0xdf, 0x15, 
+0xc0, 0x00, 0xf5, 0x00, 0xf1, 0x00, 0x9b, 0x0c, 0x17, 0x01, 0x38, 0x01, 
+0x81, 0x0a, 0x42, 0x0d, 0xda, 0x0a, 0x66, 0x0d, 0x58, 0x17, 0x4e, 0x11, 
+0xaa, 0x06, 0xa0, 0x00, 0x8f, 0x16, 0x4c, 0x06, 0x39, 0x01, 0x46, 0x0e, 
+0xc6, 0x09, 0xf5, 0x00, 0xac, 0x00, 0x2a, 0x0a, 0x5f, 0x07, 0x94, 0x12, 
+0x64, 0x17, 0xa9, 0x00, 0x68, 0x0c, 0x99, 0x0a, 0x6e, 0x10, 0x61, 0x17, 
+0x19, 0x00, 0x78, 0x0b, 0xf3, 0x09, 0x1a, 0x0f, 0x5f, 0x00, 0x02, 0x0c, 
+0xbb, 0x00, 0x2c, 0x09, 0x44, 0x0c, 0xde, 0x18, 0x6d, 0x0a, 0xe4, 0x00, 
+0x59, 0x0a, 0x22, 0x01, 0x7a, 0x18, 0x16, 0x07, 0xdd, 0x00, 0x65, 0x09, 
+0xfa, 0x14, 0x10, 0x01, 0x54, 0x00, 0xce, 0x00, 0x33, 0x18, 0x61, 0x17, 
+0x62, 0x09, 0x7a, 0x00, 0x89, 0x08, 0x84, 0x00, 0x53, 0x07, 0xa5, 0x07, 
+0x81, 0x0a, 0xe1, 0x00, 0xb5, 0x09, 0xf4, 0x0c, 0x96, 0x00, 0xdb, 0x0d, 
+0x0a, 0x01, 0xf3, 0x09, 0x94, 0x0e, 0x99, 0x07, 0xdf, 0x09, 0x2d, 0x0c, 
+0xf8, 0x00, 0x7d, 0x0c, 0xe4, 0x06, 0x89, 0x0d, 0x1b, 0x00, 0x24, 0x08, 
+0x02, 0x13, 0xb9, 0x1a, 0x4a, 0x00, 0x4b, 0x0a, 0x79, 0x00, 0x7a, 0x0c, 
+0xdf, 0x00, 0xb1, 0x00, 0xe8, 0x09, 0x6e, 0x00, 0xa6, 0x09, 0x4a, 0x00, 
+0x22, 0x01, 0x21, 0x09, 0x56, 0x00, 0xf3, 0x0a, 0xcd, 0x0a, 0x78, 0x00, 
+0xdc, 0x00, 0x39, 0x01, 0x0c, 0x13, 0x4f, 0x17, 0xc9, 0x00, 0x13, 0x0b, 
+0xf2, 0x14, 0xe4, 0x0a, 0x52, 0x0a, 0x5f, 0x0a, 0x96, 0x0b, 0x9a, 0x07, 
+0x36, 0x0a, 0x43, 0x13, 0xa9, 0x0b, 0x89, 0x0c, 0x56, 0x0a, 0x6f, 0x0c, 
+0xe9, 0x00, 0xdf, 0x00, 0x1e, 0x07, 0x30, 0x12, 0x40, 0x0f, 0xaa, 0x08, 
+0x9f, 0x11, 0x0c, 0x00, 0x4a, 0x06, 0x3f, 0x01, 0x94, 0x0c, 0xc1, 0x07, 
+0x94, 0x0f, 0x31, 0x01, 0x9b, 0x0c, 0xd4, 0x00, 0x5b, 0x17, 0xc5, 0x14, 
+0xec, 0x1b, 0xcc, 0x00, 0xb5, 0x17, 0xfe, 0x00, 0x34, 0x0a, 0xfd, 0x09, 
+0x26, 0x12, 0x29, 0x0b, 0xa0, 0x0f, 0xab, 0x06, 0x5b, 0x08, 0x4b, 0x0a, 
+0x90, 0x0c, 0x98, 0x06, 0x60, 0x13, 0xcf, 0x00, 0x05, 0x0b, 0x91, 0x0c, 
+0xc3, 0x00, 0x0e, 0x09, 0x16, 0x0b, 0xbf, 0x0b, 0xc8, 0x1a, 0x14, 0x0b, 
+0xc0, 0x0b, 0x88, 0x0b, 0x64, 0x0c, 0xbf, 0x11, 0xc6, 0x00, 0xf0, 0x00, 
+0x57, 0x00, 0x33, 0x18, 0x87, 0x0d, 0x10, 0x0b, 0xb8, 0x15, 0x70, 0x0a, 
+0x15, 0x01, 0x71, 0x00, 0x12, 0x11, 0x8b, 0x12, 0x93, 0x00, 0x78, 0x00, 
+0x6b, 0x00, 0xb5, 0x00, 0xc3, 0x10, 0xed, 0x00, 0x49, 0x15, 0x94, 0x0b, 
+0x89, 0x0b, 0x0e, 0x00, 0xa2, 0x00, 0x97, 0x13, 0x86, 0x00, 0x2a, 0x12, 
+0x4b, 0x06, 0xcb, 0x0e, 0x50, 0x00, 0x3a, 0x0d, 0x7a, 0x00, 0xc0, 0x00, 
+0x66, 0x08, 0x31, 0x0b, 0xd1, 0x00, 0xf4, 0x18, 0x40, 0x01, 0x3f, 0x01, 
+0x6d, 0x00, 0xe8, 0x00, 0x77, 0x0a, 0x27, 0x12, 0x30, 0x08, 0xbe, 0x0c, 
+0xa0, 0x0c, 0x0a, 0x0b, 0xba, 0x00, 0xab, 0x00, 0x87, 0x00, 0x42, 0x0a, 
+0x79, 0x08, 0xfd, 0x00, 0x7b, 0x0c, 0x0c, 0x01, 0xae, 0x11, 0x48, 0x0b, 
+0x25, 0x01, 0xa8, 0x12, 0x10, 0x00, 0x7a, 0x0b, 0x22, 0x0c, 0xbc, 0x09, 
+0x2d, 0x0a, 0xeb, 0x00, 0xca, 0x0b, 0xf7, 0x00, 0xcb, 0x0f, 0x0d, 0x01, 
+0xf7, 0x0d, 0xa2, 0x0b, 0x88, 0x18, 0x1e, 0x0a, 0x42, 0x01, 0xb4, 0x16, 
+0x54, 0x0a, 0xb1, 0x09, 0xbb, 0x0a, 0xe7, 0x0d, 0xbc, 0x09, 0x39, 0x0c, 
+0x02, 0x0c, 0x08, 0x0d, 0xe4, 0x07, 0xb2, 0x00, 0xdf, 0x08, 0x69, 0x0e, 
+0xbd, 0x00, 0x0e, 0x00, 0xba, 0x14, 0x75, 0x0b, 0xe8, 0x00, 0x97, 0x07, 
+0xeb, 0x0b, 0xf5, 0x0d, 0x3f, 0x0f, 0x23, 0x01, 0x4a, 0x06, 0x10, 0x00, 
+0x68, 0x11, 0x7d, 0x0c, 0x6e, 0x00, 0x0d, 0x13, 0x99, 0x11, 0x54, 0x07, 
+0x14, 0x0a, 0x2d, 0x08, 0x3b, 0x01, 0xd2, 0x0f, 0x90, 0x00, 0xc6, 0x00, 
+0xbe, 0x10, 0x0d, 0x00, 0x6b, 0x0a, 0xdb, 0x0a, 0xe0, 0x00, 0x54, 0x11, 
+0x6a, 0x0b, 0xb1, 0x0c, 0xe8, 0x11, 0xb2, 0x0a, 0xd3, 0x00, 0x3b, 0x00, 
+0xd3, 0x11, 0xb6, 0x0e, 0x6f, 0x0a, 0xf5, 0x09, 0x72, 0x07, 0x01, 0x01, 
+0xb2, 0x0c, 0x78, 0x07, 0x9a, 0x0a, 0x25, 0x08, 0x33, 0x14, 0x25, 0x01, 
+0x22, 0x0e, 0x92, 0x0f, 0x41, 0x00, 0x64, 0x0a, 0xd4, 0x11, 0x97, 0x00, 
+0x5f, 0x0a, 0x4b, 0x0b, 0x17, 0x1a, 0x03, 0x13, 0xba, 0x08, 0xa1, 0x0a, 
+0x5b, 0x08, 0x01, 0x17, 0x53, 0x00, 0xfd, 0x08, 0x0b, 0x0d, 0x31, 0x01, 
+0x46, 0x09, 0x2a, 0x0a, 0xf2, 0x0a, 0x62, 0x0a, 0xfd, 0x00, 0x0c, 0x13, 
+0x16, 0x13, 0xb2, 0x0c, 0xa5, 0x09, 0xc7, 0x00, 0x10, 0x01, 0xab, 0x00, 
+0xc3, 0x00, 0x6f, 0x00, 0x4e, 0x0e, 0x6c, 0x00, 0x3e, 0x0a, 0x79, 0x08, 
+0x6a, 0x0a, 0x75, 0x0b, 0x92, 0x00, 0xa7, 0x08, 0x18, 0x00, 0xe1, 0x07, 
+0xfd, 0x09, 0x0b, 0x00, 0xee, 0x0b, 0x1b, 0x0d, 0xce, 0x06, 0xa6, 0x09, 
+0x1e, 0x0b, 0x1b, 0x14, 0x26, 0x00, 0xb4, 0x0b, 0x36, 0x08, 0x3a, 0x0c, 
+0x71, 0x00, 0x1f, 0x0b, 0xb7, 0x00, 0x2c, 0x09, 0xee, 0x00, 0xcc, 0x00, 
+0x2a, 0x0a, 0xa2, 0x08, 0x04, 0x0b, 0x4a, 0x06, 0x8e, 0x17, 0x1a, 0x0b, 
+0xbb, 0x09, 0x51, 0x0b, 0x70, 0x17, 0x26, 0x15, 0xfc, 0x0a, 0x40, 0x01, 
+0xb8, 0x0a, 0x5f, 0x06, 0xb5, 0x0b, 0x35, 0x01, 0xa1, 0x07, 0x0c, 0x13, 
+0xd2, 0x0c, 0x23, 0x01, 0xad, 0x0a, 0xde, 0x0a, 0xde, 0x00, 0xe5, 0x16, 
+0xbc, 0x0c, 0xe5, 0x16, 0x25, 0x00, 0xcf, 0x0a, 0x38, 0x09, 0xe3, 0x07, 
+0xae, 0x00, 0x20, 0x09, 0xf3, 0x17, 0x80, 0x0a, 0x71, 0x00, 0xb6, 0x0e, 
+0xae, 0x10, 0x7a, 0x10, 0xcb, 0x0a, 0xd6, 0x00, 0x14, 0x00, 0xbb, 0x14, 
+0xd8, 0x14, 0xe7, 0x09, 0x12, 0x11, 0x2c, 0x01, 0x96, 0x0a, 0xb1, 0x0c, 
+0xfa, 0x00, 0x20, 0x0c, 0x3c, 0x01, 0xb5, 0x0b, 0x54, 0x07, 0x04, 0x0b, 
+0xa2, 0x08, 0x88, 0x0b, 0xb6, 0x11, 0xd7, 0x00, 0xf5, 0x18, 0x9e, 0x0b, 
+0x34, 0x0c, 0x0b, 0x01, 0x7b, 0x1b, 0x6b, 0x00, 0x49, 0x0b, 0x9f, 0x07, 
+0x84, 0x00, 0x39, 0x01, 0xbc, 0x0c, 0x70, 0x08, 0x3b, 0x00, 0x13, 0x07, 
+0xc6, 0x09, 0xf2, 0x09, 0x32, 0x0b, 0x9f, 0x07, 0x80, 0x0a, 0x70, 0x08, 
+0x55, 0x00, 0xc6, 0x00, 0x70, 0x10, 0x26, 0x01, 0xd2, 0x19, 0x2c, 0x01, 
+0x22, 0x01, 0x8b, 0x0c, 0x46, 0x06, 0x14, 0x0a, 0x31, 0x0f, 0xba, 0x08, 
+0x24, 0x01, 0xa5, 0x09, 0xf8, 0x00, 0xdd, 0x06, 0x84, 0x11, 0xb8, 0x0b, 
+0xd8, 0x07, 0x3f, 0x01, 0x84, 0x00, 0xd5, 0x0c, 0x78, 0x10, 0xb8, 0x00, 
+0x59, 0x00, 0xf5, 0x09, 0x11, 0x07, 0xe0, 0x08, 0xb6, 0x0e, 0x2b, 0x0a, 
+0x01, 0x0a, 0xf8, 0x00, 0x1d, 0x0a, 0x47, 0x19, 0xcf, 0x15, 0x94, 0x07, 
+0x4a, 0x0a, 0x30, 0x14, 0xd7, 0x09, 0x3d, 0x00, 0xb2, 0x09, 0x62, 0x06, 
+0xb4, 0x09, 0xad, 0x00, 0x0a, 0x01, 0xe4, 0x0a, 0xa7, 0x00, 0xf5, 0x00, 
+0xab, 0x0c, 0x03, 0x01, 0xc0, 0x00, 0x2e, 0x01, 0x2d, 0x08, 0x8f, 0x0b, 
+0x0e, 0x13, 0xba, 0x09, 0x1e, 0x0e, 0xea, 0x08, 0x62, 0x12, 0xce, 0x00, 
+0x48, 0x0b, 0x22, 0x01, 0xe7, 0x00, 0x7d, 0x06, 0x77, 0x09, 0x09, 0x0a, 
+0x2c, 0x09, 0x05, 0x0b, 0x57, 0x00, 0xc1, 0x07, 0x39, 0x0c, 0x43, 0x00, 
+0xd7, 0x00, 0x33, 0x0a, 0x43, 0x0c, 0xc4, 0x0c, 0x72, 0x0b, 0xff, 0x18, 
+0x42, 0x06, 0xb2, 0x00, 0x68, 0x12, 0x47, 0x0c, 0xef, 0x0e, 0x45, 0x06, 
+0x67, 0x1b, 0x43, 0x06, 0xd8, 0x0b, 0x86, 0x16, 0xb1, 0x0c, 0xbc, 0x08, 
+0x3c, 0x00, 0x63, 0x09, 0xc9, 0x0c, 0xd6, 0x0b, 0x0c, 0x0f, 0x53, 0x00, 
+0xe4, 0x00, 0x12, 0x0a, 0x57, 0x11, 0xe6, 0x0a, 0x4e, 0x17, 0x1c, 0x08, 
+0xa6, 0x09, 0x02, 0x0c, 0x8a, 0x11, 0x66, 0x12, 0x33, 0x12, 0xf3, 0x0b, 
+0x24, 0x08, 0x90, 0x00, 0xed, 0x07, 0xbf, 0x00, 0x13, 0x01, 0x2f, 0x0a, 
+0x06, 0x0f, 0x69, 0x0e, 0x21, 0x01, 0x86, 0x00, 0x2c, 0x01, 0xbf, 0x0b, 
+0x5e, 0x12, 0x40, 0x01, 0x3f, 0x0a, 0x68, 0x13, 0x10, 0x0b, 0x88, 0x09, 
+0xd4, 0x00, 0xb4, 0x15, 0x49, 0x0a, 0x18, 0x14, 0x15, 0x0a, 0xa7, 0x08, 
+0x8f, 0x1a, 0x1b, 0x01, 0xc6, 0x00, 0xc4, 0x07, 0x08, 0x0a, 0x3a, 0x01, 
+0xf1, 0x09, 0x30, 0x00, 0x12, 0x0b, 0xcc, 0x00, 0xd1, 0x00, 0x2f, 0x01, 
+0x46, 0x0b, 0xbf, 0x00, 0x07, 0x0a, 0xb8, 0x00, 0xb1, 0x0a, 0xa8, 0x0c, 
+0x19, 0x00, 0x6f, 0x0c, 0xe5, 0x06, 0xd6, 0x15, 0x64, 0x0d, 0x96, 0x0a, 
+0x03, 0x0d, 0x5f, 0x00, 0x52, 0x0b, 0xdb, 0x0a, 0x0f, 0x0b, 0xdc, 0x00, 
+0xbf, 0x15, 0xa1, 0x06, 0x03, 0x0c, 0xac, 0x0a, 0xcb, 0x00, 0x78, 0x10, 
+0x26, 0x08, 0x60, 0x11, 0xba, 0x14, 0xf7, 0x00, 0xd5, 0x00, 0x0c, 0x00, 
+0x3f, 0x0b, 0x88, 0x00, 0xfe, 0x09, 0x28, 0x01, 0x13, 0x0a, 0x2a, 0x0a, 
+0x14, 0x11, 0x6d, 0x09, 0xa9, 0x00, 0x08, 0x01, 0x7c, 0x16, 0x0f, 0x01, 
+0xec, 0x00, 0xaf, 0x11, 0x38, 0x0c, 0x2d, 0x08, 0xde, 0x09, 0x6f, 0x08, 
+0xd9, 0x07, 0xcf, 0x0a, 0xaa, 0x06, 0x85, 0x17, 0x2d, 0x0a, 0x71, 0x10, 
+0x72, 0x07, 0xee, 0x0b, 0xbd, 0x00, 0x53, 0x00, 0x31, 0x00, 0xe9, 0x09, 
+0x13, 0x07, 0xca, 0x00, 0xaa, 0x00, 0xdc, 0x00, 0x33, 0x18, 0x1c, 0x01, 
+0x1a, 0x00, 0xfd, 0x09, 0xce, 0x0a, 0x2c, 0x01, 0x37, 0x01, 0xe3, 0x0c, 
+0x43, 0x14, 0xed, 0x0b, 0x59, 0x0a, 0xd6, 0x00, 0xc9, 0x0c, 0x4f, 0x00, 
+0xb1, 0x0a, 0x9e, 0x08, 0x29, 0x0a, 0x1a, 0x0b, 0x1b, 0x00, 0x2e, 0x08, 
+0x76, 0x0b, 0x2d, 0x0a, 0x3e, 0x08, 0x3a, 0x1a, 0x2e, 0x09, 0x94, 0x0f, 
+0x76, 0x13, 0xa8, 0x0c, 0x8f, 0x00, 0xe9, 0x00, 0x9c, 0x08, 0x34, 0x0b, 
+0x68, 0x19, 0x92, 0x12, 0xba, 0x00, 0xaa, 0x08, 0x05, 0x01, 0x3e, 0x0a, 
+0x6f, 0x08, 0xf0, 0x07, 0xc9, 0x07, 0xe4, 0x00, 0xe0, 0x00, 0x44, 0x06, 
+0x8b, 0x11, 0x31, 0x12, 0x89, 0x00, 0xb4, 0x00, 0x66, 0x0c, 0x98, 0x0a, 
+0xe6, 0x0d, 0xbd, 0x00, 0xd3, 0x00, 0xa9, 0x00, 0xad, 0x06, 0xa9, 0x0c, 
+0xa2, 0x06, 0xb5, 0x0c, 0x66, 0x00, 0x22, 0x15, 0xb1, 0x09, 0x1d, 0x11, 
+0x56, 0x06, 0x9f, 0x0b, 0x4b, 0x11, 0xba, 0x06, 0xd4, 0x00, 0x41, 0x06, 
+0x19, 0x00, 0xfb, 0x00, 0xde, 0x0c, 0xc2, 0x08, 0x10, 0x01, 0xc3, 0x0a, 
+0xe9, 0x08, 0x05, 0x08, 0x72, 0x0a, 0xd9, 0x00, 0x0b, 0x00, 0x12, 0x0e, 
+0x61, 0x0f, 0xab, 0x00, 0xc3, 0x00, 0xdc, 0x0b, 0x14, 0x0b, 0xbf, 0x14, 
+0x66, 0x13, 0x6d, 0x00, 0xd3, 0x0c, 0x9b, 0x00, 0xbd, 0x00, 0xad, 0x18, 
+0x69, 0x08, 0x90, 0x0c, 0x47, 0x0d, 0x50, 0x0c, 0x40, 0x09, 0x2e, 0x15, 
+0x93, 0x0b, 0xec, 0x06, 0xaf, 0x09, 0xb2, 0x16, 0xce, 0x0a, 0xc3, 0x00, 
+0x28, 0x00, 0xba, 0x0a, 0x40, 0x01, 0x4b, 0x0a, 0xcf, 0x18, 0x1f, 0x0f, 
+0x36, 0x01, 0x33, 0x18, 0x23, 0x01, 0x02, 0x13, 0xc3, 0x16, 0xd4, 0x10, 
+0xe0, 0x00, 0xbf, 0x14, 0x9e, 0x0b, 0x23, 0x0a, 0xd0, 0x09, 0x40, 0x00, 
+0xbe, 0x14, 0x5c, 0x0a, 0xff, 0x18, 0xe5, 0x0c, 0x7c, 0x0c, 0xf1, 0x0b, 
+0xdb, 0x09, 0xa8, 0x0f, 0x65, 0x08, 0xd1, 0x09, 0xe3, 0x06, 0xec, 0x08, 
+0x72, 0x0c, 0x8b, 0x0a, 0x1d, 0x00, 0x1f, 0x00, 0xc0, 0x0e, 0xf9, 0x00, 
+0xbb, 0x00, 0xe9, 0x08, 0x42, 0x15, 0x0b, 0x0f, 0xe5, 0x00, 0xa0, 0x0f, 
+0xcc, 0x00, 0x3f, 0x0a, 0xf3, 0x0c, 0xe4, 0x06, 0x04, 0x15, 0xe5, 0x00, 
+0xe5, 0x06, 0xda, 0x0d, 0xd1, 0x0a, 0x86, 0x16, 0xb2, 0x07, 0x22, 0x09, 
+0x21, 0x09, 0x25, 0x00, 0x42, 0x01, 0x9e, 0x0f, 0x41, 0x12, 0x18, 0x0a, 
+0x99, 0x11, 0x9f, 0x00, 0x67, 0x12, 0x74, 0x16, 0x9b, 0x00, 0xbf, 0x0b, 
+0x9e, 0x0c, 0x93, 0x0b, 0x94, 0x0b, 0x58, 0x0a, 0x96, 0x17, 0x3a, 0x16, 
+0xc7, 0x09, 0xa5, 0x09, 0x03, 0x08, 0xe0, 0x13, 0x36, 0x0d, 0x2a, 0x13, 
+0x3e, 0x0e, 0xc5, 0x0a, 0x3f, 0x08, 0x74, 0x0b, 0x27, 0x15, 0x09, 0x01, 
+0x64, 0x0e, 0x62, 0x0a, 0x02, 0x13, 0x68, 0x0b, 0x7c, 0x1b, 0x40, 0x01, 
+0xf5, 0x00, 0x30, 0x01, 0x42, 0x0f, 0x42, 0x06, 0xd1, 0x00, 0xe5, 0x0a, 
+0x0e, 0x00, 0x8c, 0x0b, 0x5d, 0x11, 0x02, 0x0d, 0xec, 0x10, 0xb5, 0x00, 
+0x36, 0x0a, 0x40, 0x01, 0x35, 0x01, 0xc3, 0x00, 0xcb, 0x00, 0x50, 0x0a, 
+0x2f, 0x00, 0xa0, 0x00, 0x9d, 0x00, 0x2f, 0x0a, 0xb6, 0x10, 0xe4, 0x06, 
+0x39, 0x13, 0x70, 0x0a, 0xef, 0x00, 0xee, 0x0a, 0x78, 0x19, 0x92, 0x0f, 
+0xbc, 0x09, 0x39, 0x01, 0xba, 0x00, 0x8d, 0x06, 0xa8, 0x08, 0xe1, 0x0b, 
+0x39, 0x01, 0x68, 0x06, 0xc2, 0x09, 0x3a, 0x01, 0x48, 0x0c, 0xde, 0x00, 
+0x2d, 0x01, 0x02, 0x13, 0x4c, 0x0a, 0x78, 0x16, 0xba, 0x08, 0x60, 0x07, 
+0xfd, 0x06, 0x66, 0x14, 0xd5, 0x0c, 0x02, 0x14, 0x96, 0x15, 0x73, 0x16, 
+0x0c, 0x01, 0x24, 0x00, 0x96, 0x07, 0x02, 0x0c, 0x5c, 0x0a, 0x50, 0x17, 
+0xce, 0x0a, 0xc8, 0x00, 0xbf, 0x00, 0x9a, 0x06, 0x5f, 0x18, 0xae, 0x0b, 
+0x05, 0x01, 0xe2, 0x07, 0x99, 0x0a, 0x99, 0x0b, 0xdd, 0x0f, 0x18, 0x1a, 
+0x40, 0x0e, 0xf9, 0x13, 0x45, 0x00, 0x96, 0x1a, 0x0b, 0x0a, 0x4d, 0x06, 
+0x3b, 0x0b, 0xf4, 0x00, 0x9d, 0x0c, 0x1a, 0x00, 0xbb, 0x07, 0x30, 0x0b, 
+0x3b, 0x0b, 0x17, 0x13, 0x58, 0x17, 0xb7, 0x0e, 0x26, 0x08, 0xfd, 0x0a, 
+0xb0, 0x09, 0x2f, 0x0c, 0x40, 0x06, 0x67, 0x08, 0xdc, 0x06, 0xbc, 0x00, 
+0x5e, 0x07, 0x25, 0x08, 0xc6, 0x09, 0x29, 0x0a, 0xe6, 0x09, 0x83, 0x00, 
+0x52, 0x0b, 0x1b, 0x00, 0xd8, 0x07, 0x35, 0x09, 0x4d, 0x09, 0xba, 0x0a, 
+0xe8, 0x00, 0x66, 0x0a, 0x04, 0x13, 0x3c, 0x00, 0x94, 0x0b, 0x73, 0x08, 
+0x42, 0x06, 0xc9, 0x09, 0xcc, 0x0b, 0xca, 0x0c, 0xd7, 0x16, 0x4d, 0x06, 
+0x35, 0x0a, 0x2a, 0x01, 0x12, 0x07, 0x54, 0x0b, 0xe6, 0x09, 0xd1, 0x0a, 
+0x02, 0x01, 0x70, 0x09, 0x5a, 0x0c, 0x37, 0x01, 0x3c, 0x1a, 0xe0, 0x0c, 
+0x26, 0x12, 0x96, 0x0a, 0xdf, 0x0a, 0x42, 0x06, 0xf9, 0x0e, 0x4b, 0x0a, 
+0xe9, 0x09, 0xfa, 0x0a, 0xa2, 0x08, 0xc3, 0x0b, 0x5f, 0x0b, 0x99, 0x06, 
+0x6b, 0x00, 0x34, 0x0a, 0xeb, 0x0b, 0xb3, 0x0c, 0x07, 0x01, 0x3c, 0x13, 
+0x28, 0x0a, 0x7c, 0x00, 0x10, 0x0b, 0x22, 0x0c, 0xeb, 0x0c, 0x2a, 0x0b, 
+0x40, 0x06, 0x70, 0x00, 0x31, 0x00, 0xac, 0x0a, 0x39, 0x01, 0x4c, 0x13, 
+0x31, 0x00, 0x86, 0x0c, 0x78, 0x00, 0x46, 0x0b, 0x86, 0x0c, 0x03, 0x01, 
+0x86, 0x16, 0xd9, 0x00, 0x59, 0x11, 0x93, 0x0b, 0x0c, 0x01, 0x32, 0x01, 
+0x67, 0x0b, 0x4b, 0x11, 0x9f, 0x08, 0x47, 0x00, 0x5f, 0x0a, 0xec, 0x06, 
+0xb2, 0x00, 0x72, 0x16, 0xfb, 0x00, 0x9e, 0x0b, 0x03, 0x0c, 0xe9, 0x08, 
+0x4a, 0x06, 0x32, 0x18, 0xf7, 0x0d, 0xe9, 0x13, 0x50, 0x16, 0xae, 0x00, 
+0xda, 0x00, 0x64, 0x0c, 0xf3, 0x14, 0x30, 0x00, 0x03, 0x13, 0x0a, 0x08, 
+0x1d, 0x01, 0xa9, 0x09, 0xac, 0x0a, 0x8d, 0x0a, 0x0f, 0x01, 0x22, 0x0c, 
+0x4a, 0x11, 0x99, 0x06, 0xfa, 0x0a, 0x47, 0x00, 0x7b, 0x10, 0x38, 0x01, 
+0xde, 0x09, 0x5a, 0x08, 0x67, 0x13, 0x0d, 0x0c, 0x05, 0x01, 0xdb, 0x00, 
+0xfc, 0x00, 0x41, 0x06, 0x3b, 0x01, 0xaf, 0x0f, 0x63, 0x17, 0x25, 0x00, 
+0x5e, 0x11, 0xe8, 0x0c, 0x2e, 0x07, 0xcf, 0x0f, 0x42, 0x1a, 0x03, 0x15, 
+0xa6, 0x0f, 0x0f, 0x01, 0x0d, 0x0a, 0x14, 0x0a, 0x4c, 0x0a, 0x31, 0x00, 
+0x23, 0x09, 0x53, 0x0b, 0xde, 0x16, 0x10, 0x01, 0xcc, 0x00, 0x78, 0x06, 
+0xb5, 0x12, 0xb2, 0x0a, 0x71, 0x06, 0x8c, 0x0a, 0x78, 0x00, 0x31, 0x0b, 
+0x45, 0x14, 0x48, 0x00, 0x6c, 0x0a, 0xbb, 0x0a, 0xca, 0x00, 0x8f, 0x00, 
+0xbe, 0x00, 0x8d, 0x0b, 0x8c, 0x0a, 0xc6, 0x0d, 0x1e, 0x00, 0xfd, 0x00, 
+0x43, 0x12, 0x4a, 0x11, 0x3b, 0x00, 0x03, 0x13, 0x55, 0x00, 0x8f, 0x00, 
+0x40, 0x06, 0xf2, 0x0a, 0x1e, 0x16, 0xcd, 0x00, 0x1c, 0x01, 0x22, 0x0f, 
+0x6b, 0x07, 0x6f, 0x13, 0x42, 0x01, 0x9e, 0x0f, 0xd1, 0x1c, 0x93, 0x0f, 
+0xa4, 0x09, 0x1b, 0x0f, 0x5f, 0x07, 0xec, 0x00, 0x6d, 0x00, 0x6e, 0x09, 
+0x99, 0x11, 0x03, 0x12, 0x80, 0x13, 0xa8, 0x08, 0x46, 0x09, 0x4a, 0x11, 
+0xb0, 0x00, 0x4e, 0x0e, 0x67, 0x0c, 0x4d, 0x09, 0xdf, 0x09, 0xa7, 0x0f, 
+0xe2, 0x0c, 0x70, 0x0c, 0x46, 0x19, 0xce, 0x15, 0x8c, 0x0b, 0x0a, 0x16, 
+0x83, 0x00, 0x1b, 0x0e, 0x49, 0x06, 0x3b, 0x13, 0xdb, 0x09, 0x39, 0x01, 
+0x70, 0x09, 0xfd, 0x00, 0xff, 0x0c, 0xe7, 0x0d, 0x5b, 0x0c, 0x94, 0x06, 
+0xcb, 0x00, 0x9c, 0x08, 0x68, 0x0b, 0xa1, 0x00, 0xbb, 0x09, 0xa6, 0x10, 
+0x6b, 0x00, 0x98, 0x06, 0x48, 0x18, 0x10, 0x01, 0xf3, 0x08, 0x3c, 0x0c, 
+0x23, 0x0c, 0xde, 0x00, 0xc3, 0x0c, 0xb1, 0x00, 0x5a, 0x08, 0xb0, 0x19, 
+0x84, 0x11, 0x4e, 0x17, 0x91, 0x00, 0x60, 0x0b, 0x8a, 0x13, 0x1a, 0x0b, 
+0xce, 0x00, 0x7c, 0x0c, 0x5e, 0x07, 0x51, 0x06, 0x90, 0x06, 0xd4, 0x09, 
+0x33, 0x1c, 0x5c, 0x0c, 0xfe, 0x09, 0x51, 0x0b, 0x67, 0x0c, 0x3b, 0x00, 
+0xc5, 0x0b, 0x70, 0x00, 0x0d, 0x0c, 0x07, 0x01, 0x07, 0x0a, 0x00, 0x01, 
+0x9d, 0x13, 0x10, 0x0c, 0x63, 0x12, 0xbc, 0x17, 0x38, 0x0d, 0x9a, 0x19, 
+0xbb, 0x0a, 0x9d, 0x00, 0x9c, 0x18, 0x10, 0x00, 0x0b, 0x01, 0x30, 0x0b, 
+0x83, 0x00, 0xa5, 0x00, 0xd2, 0x15, 0x55, 0x06, 0x97, 0x0a, 0x4d, 0x06, 
+0xd7, 0x00, 0x79, 0x0a, 0xa9, 0x0b, 0xda, 0x07, 0x62, 0x0a, 0x54, 0x0b, 
+0xf6, 0x07, 0x48, 0x0e, 0xfa, 0x00, 0x23, 0x01, 0x53, 0x00, 0x12, 0x0e, 
+0xfe, 0x00, 0xc9, 0x00, 0x90, 0x00, 0xcb, 0x09, 0xc8, 0x0c, 0x13, 0x0a, 
+0x6c, 0x00, 0x8f, 0x00, 0x28, 0x0a, 0x3e, 0x00, 0x9c, 0x11, 0x94, 0x00, 
+0x43, 0x13, 0x20, 0x0a, 0x30, 0x01, 0x93, 0x0f, 0xde, 0x0c, 0xff, 0x00, 
+0x31, 0x12, 0x7c, 0x0d, 0x81, 0x11, 0x82, 0x0b, 0x7d, 0x00, 0xe3, 0x00, 
+0x0a, 0x01, 0x1c, 0x01, 0xb8, 0x0e, 0x7e, 0x0b, 0xe0, 0x0b, 0xef, 0x0a, 
+0xe9, 0x0c, 0xd7, 0x0b, 0x89, 0x0e, 0x56, 0x00, 0x06, 0x0b, 0x2f, 0x0a, 
+0x94, 0x0b, 0x4f, 0x00, 0x87, 0x0c, 0x99, 0x06, 0xbc, 0x09, 0x2e, 0x14, 
+0xf5, 0x18, 0xca, 0x0e, 0xa8, 0x0d, 0xf8, 0x07, 0x15, 0x13, 0x1c, 0x0b, 
+0xfe, 0x0f, 0xb4, 0x0c, 0x49, 0x06, 0xbd, 0x0c, 0xd6, 0x07, 0xa3, 0x0a, 
+0x0d, 0x0c, 0x1a, 0x01, 0x19, 0x0d, 0x8d, 0x06, 0x15, 0x0a, 0xce, 0x00, 
+0x2f, 0x00, 0xa7, 0x0c, 0x23, 0x08, 0xd9, 0x00, 0x46, 0x0b, 0xb4, 0x00, 
+0xf2, 0x09, 0xa0, 0x15, 0xb6, 0x0b, 0x63, 0x09, 0x9e, 0x0c, 0xe7, 0x09, 
+0xee, 0x00, 0xc7, 0x00, 0x44, 0x00, 0x23, 0x09, 0x72, 0x10, 0x19, 0x14, 
+0x3d, 0x00, 0x95, 0x09, 0x37, 0x08, 0xcb, 0x12, 0xf1, 0x00, 0x19, 0x13, 
+0xdb, 0x0d, 0xae, 0x0f, 0x0b, 0x0a, 0x01, 0x01, 0x47, 0x14, 0x11, 0x0e, 
+0x18, 0x01, 0x2c, 0x01, 0x12, 0x0e, 0x3a, 0x01, 0xba, 0x00, 0xb3, 0x0a, 
+0x3f, 0x0a, 0x2d, 0x14, 0xb4, 0x0c, 0xe8, 0x00, 0x89, 0x16, 0xdb, 0x0d, 
+0x7b, 0x0a, 0x25, 0x01, 0x44, 0x13, 0x96, 0x18, 0xa1, 0x0a, 0xeb, 0x00, 
+0x46, 0x0b, 0xab, 0x00, 0x42, 0x18, 0x14, 0x0e, 0xab, 0x09, 0xbe, 0x10, 
+0x50, 0x0c, 0xe2, 0x09, 0xb4, 0x0b, 0x54, 0x11, 0x0d, 0x00, 0xdc, 0x09, 
+0x81, 0x0a, 0x95, 0x1a, 0x51, 0x0a, 0x3e, 0x0b, 0x12, 0x01, 0xe0, 0x00, 
+0x51, 0x15, 0x95, 0x1a, 0x0b, 0x01, 0x49, 0x06, 0xb2, 0x00, 0x1f, 0x01, 
+0x7c, 0x16, 0x3c, 0x0b, 0x28, 0x0a, 0xde, 0x0c, 0x00, 0x01, 0x76, 0x0a, 
+0x46, 0x0c, 0xdb, 0x09, 0x13, 0x01, 0x38, 0x01, 0x81, 0x0b, 0x67, 0x0b, 
+0xf6, 0x0a, 0xd2, 0x00, 0xfc, 0x00, 0x4f, 0x0c, 0x2f, 0x01, 0xd8, 0x00, 
+0x70, 0x0c, 0xb5, 0x0b, 0x24, 0x01, 0xb7, 0x00, 0xc8, 0x06, 0x9a, 0x0b, 
+0x82, 0x08, 0xf6, 0x00, 0x06, 0x0b, 0xcf, 0x0a, 0x32, 0x00, 0x0c, 0x0a, 
+0xe0, 0x0b, 0xd0, 0x00, 0xb7, 0x0b, 0x67, 0x12, 0x41, 0x12, 0x51, 0x0c, 
+0x69, 0x0b, 0x93, 0x00, 0xb2, 0x10, 0xef, 0x0a, 0x53, 0x17, 0x64, 0x09, 
+0x61, 0x07, 0xf9, 0x0c, 0xcc, 0x0b, 0xb2, 0x17, 0xba, 0x17, 0xac, 0x16, 
+0x99, 0x0f, 0x40, 0x06, 0x18, 0x13, 0x9a, 0x0a, 0x10, 0x01, 0xf7, 0x00, 
+0xa9, 0x00, 0x0c, 0x0c, 0x1f, 0x01, 0x5d, 0x07, 0x7d, 0x0c, 0x46, 0x06, 
+0x11, 0x0d, 0x11, 0x0b, 0x3e, 0x0f, 0xd7, 0x18, 0x86, 0x0c, 0x0f, 0x00, 
+0x1e, 0x01, 0x9e, 0x0c, 0x94, 0x13, 0xcb, 0x09, 0x2f, 0x01, 0xe0, 0x0b, 
+0x8d, 0x00, 0xc7, 0x0c, 0xf7, 0x0b, 0xc4, 0x00, 0x47, 0x0b, 0x38, 0x0c, 
+0x43, 0x15, 0x58, 0x00, 0x75, 0x12, 0x71, 0x0c, 0xcf, 0x00, 0x5d, 0x08, 
+0xf5, 0x00, 0xe1, 0x07, 0x96, 0x0a, 0x40, 0x06, 0x4e, 0x0c, 0xa7, 0x09, 
+0x49, 0x0a, 0x2d, 0x09, 0xc4, 0x00, 0xe0, 0x08, 0x94, 0x00, 0x28, 0x01, 
+0x62, 0x0b, 0x68, 0x13, 0x74, 0x11, 0x28, 0x0b, 0x77, 0x0b, 0x86, 0x0d, 
+0x37, 0x0a, 0xda, 0x0e, 0xaa, 0x08, 0x87, 0x0d, 0xa0, 0x00, 0x1b, 0x0e, 
+0x06, 0x10, 0xa3, 0x0a, 0xc2, 0x00, 0x21, 0x01, 0x43, 0x0c, 0xbb, 0x00, 
+0x25, 0x00, 0xfa, 0x0a, 0xb7, 0x00, 0x02, 0x13, 0x1e, 0x00, 0x24, 0x08, 
+0xa9, 0x16, 0x32, 0x12, 0x72, 0x10, 0xd6, 0x09, 0x08, 0x0f, 0xb6, 0x0e, 
+0x2c, 0x09, 0x52, 0x0a, 0x47, 0x06, 0x01, 0x0a, 0x83, 0x10, 0x20, 0x01, 
+0xb5, 0x1a, 0xf9, 0x0a, 0x92, 0x11, 0xbd, 0x00, 0x64, 0x0b, 0x04, 0x0b, 
+0x42, 0x06, 0x10, 0x0b, 0x82, 0x0b, 0x73, 0x10, 0xbf, 0x00, 0x90, 0x00, 
+0x60, 0x1b, 0xe1, 0x07, 0xa5, 0x12, 0x4c, 0x11, 0xb2, 0x09, 0xb2, 0x00, 
+0xc2, 0x00, 0x7a, 0x00, 0x3b, 0x00, 0x8b, 0x0a, 0xb1, 0x08, 0x2d, 0x08, 
+0x72, 0x08, 0xb4, 0x0b, 0x91, 0x13, 0xbf, 0x14, 0xb4, 0x0b, 0x47, 0x00, 
+0x36, 0x01, 0x0b, 0x00, 0x63, 0x0d, 0x39, 0x12, 0x34, 0x00, 0x94, 0x0b, 
+0x47, 0x00, 0x67, 0x07, 0xf2, 0x0a, 0x2b, 0x0a, 0xc6, 0x09, 0x2b, 0x09, 
+0x5b, 0x00, 0x1b, 0x1a, 0x5f, 0x00, 0xf1, 0x13, 0x9b, 0x0b, 0x15, 0x01, 
+0x99, 0x06, 0x58, 0x06, 0x65, 0x0c, 0x27, 0x15, 0x9e, 0x0f, 0x63, 0x0b, 
+0xe9, 0x0c, 0x0a, 0x01, 0xa3, 0x07, 0x4a, 0x00, 0x86, 0x0c, 0xd0, 0x0b, 
+0x0b, 0x01, 0x78, 0x00, 0x5a, 0x0a, 0x3c, 0x0b, 0x2e, 0x0c, 0x57, 0x00, 
+0x61, 0x00, 0xde, 0x00, 0xa4, 0x09, 0xe1, 0x00, 0xf1, 0x0a, 0x2b, 0x18, 
+0x68, 0x12, 0x07, 0x13, 0xe6, 0x09, 0xc9, 0x00, 0xca, 0x0c, 0xbb, 0x0a, 
+0xb0, 0x00, 0x48, 0x0b, 0xa3, 0x0a, 0x88, 0x0d, 0xb9, 0x00, 0x80, 0x09, 
+0x23, 0x10, 0x35, 0x0b, 0xb5, 0x16, 0x06, 0x01, 0xdb, 0x00, 0x28, 0x12, 
+0xea, 0x0c, 0x6a, 0x0a, 0x4a, 0x06, 0x6b, 0x18, 0x9e, 0x0f, 0xe8, 0x13, 
+0xce, 0x00, 0x97, 0x0c, 0xee, 0x09, 0xef, 0x0b, 0x38, 0x0a, 0x0d, 0x13, 
+0x27, 0x01, 0x8a, 0x17, 0x41, 0x12, 0xf3, 0x0c, 0xeb, 0x13, 0x2b, 0x01, 
+0xd5, 0x00, 0x7a, 0x0c, 0xe2, 0x07, 0x19, 0x08, 0x80, 0x09, 0x3e, 0x09, 
+0x50, 0x00, 0x4b, 0x0f, 0xd0, 0x00, 0x29, 0x01, 0x82, 0x10, 0xe7, 0x00, 
+0xfb, 0x0a, 0x9a, 0x16, 0xe8, 0x00, 0x85, 0x00, 0x4a, 0x11, 0xab, 0x00, 
+0x29, 0x01, 0x6a, 0x08, 0xb8, 0x15, 0x35, 0x0a, 0x30, 0x01, 0xaa, 0x00, 
+0xd9, 0x00, 0xf3, 0x00, 0xf2, 0x09, 0x5f, 0x06, 0xf2, 0x09, 0x48, 0x06, 
+0xbe, 0x00, 0x6d, 0x0a, 0xac, 0x0b, 0x48, 0x0b, 0xf8, 0x0b, 0x68, 0x19, 
+0x6f, 0x0c, 0x01, 0x0c, 0xb9, 0x15, 0x28, 0x00, 0x70, 0x0c, 0xd8, 0x0a, 
+0x78, 0x00, 0xf1, 0x0d, 0x93, 0x00, 0x97, 0x0a, 0x81, 0x1b, 0x8e, 0x11, 
+0x25, 0x07, 0xea, 0x09, 0xdd, 0x0d, 0xf5, 0x0c, 0x45, 0x0f, 0x30, 0x0b, 
+0x90, 0x0b, 0x54, 0x00, 0x88, 0x00, 0x30, 0x12, 0x33, 0x00, 0x7a, 0x0b, 
+0x28, 0x01, 0xce, 0x00, 0x52, 0x0b, 0x1a, 0x08, 0xc2, 0x00, 0xc9, 0x0a, 
+0x1b, 0x0c, 0xd1, 0x09, 0x40, 0x06, 0xd9, 0x0a, 0x1b, 0x0f, 0x54, 0x14, 
+0x5f, 0x00, 0x96, 0x0c, 0x23, 0x01, 0x46, 0x0c, 0xaf, 0x09, 0x22, 0x01, 
+0xf6, 0x09, 0x79, 0x0b, 0x45, 0x06, 0x71, 0x1c, 0x5c, 0x0c, 0x71, 0x07, 
+0x65, 0x08, 0xc2, 0x0a, 0xe2, 0x00, 0x09, 0x0a, 0xcd, 0x0a, 0xae, 0x00, 
+0x6b, 0x00, 0x40, 0x06, 0x67, 0x07, 0x9c, 0x14, 0x77, 0x00, 0x2e, 0x0c, 
+0x23, 0x00, 0x55, 0x0b, 0xde, 0x00, 0xce, 0x0b, 0xfc, 0x06, 0x1a, 0x08, 
+0x72, 0x0b, 0x11, 0x0b, 0x78, 0x08, 0x76, 0x0c, 0xa9, 0x08, 0xa9, 0x00, 
+0x56, 0x0a, 0x0a, 0x01, 0x20, 0x01, 0xdc, 0x06, 0x7a, 0x0c, 0xd7, 0x09, 
+0xfd, 0x0a, 0x3b, 0x00, 0x0e, 0x01, 0xbb, 0x09, 0x4c, 0x1a, 0xde, 0x00, 
+0x41, 0x0a, 0xd1, 0x00, 0x05, 0x0b, 0xc4, 0x0a, 0xe8, 0x0c, 0xdc, 0x0f, 
+0xa0, 0x0b, 0xc1, 0x16, 0x0d, 0x00, 0x22, 0x0c, 0x17, 0x01, 0x3d, 0x0b, 
+0xeb, 0x00, 0xcf, 0x15, 0x34, 0x00, 0xf5, 0x09, 0x1b, 0x00, 0x85, 0x17, 
+0x0f, 0x00, 0x4e, 0x0d, 0xd6, 0x17, 0x8e, 0x0b, 0x03, 0x01, 0x08, 0x0a, 
+0x4c, 0x0b, 0x90, 0x0c, 0xd0, 0x0b, 0x77, 0x06, 0xb1, 0x0a, 0x37, 0x08, 
+0xbd, 0x0c, 0xa6, 0x09, 0x24, 0x01, 0x71, 0x10, 0xe1, 0x00, 0x71, 0x0c, 
+0xb3, 0x0c, 0xf2, 0x18, 0x29, 0x14, 0xb2, 0x09, 0xdd, 0x0c, 0xca, 0x0b, 
+0x48, 0x00, 0x64, 0x09, 0x3c, 0x0b, 0x30, 0x07, 0x31, 0x00, 0xa8, 0x00, 
+0x09, 0x15, 0xec, 0x13, 0x1f, 0x01, 0x0e, 0x01, 0x18, 0x00, 0xc2, 0x00, 
+0x58, 0x06, 0x15, 0x01, 0x81, 0x0a, 0x46, 0x06, 0x5a, 0x0a, 0xd9, 0x0b, 
+0x77, 0x00, 0xab, 0x15, 0x99, 0x06, 0xb6, 0x00, 0x33, 0x01, 0xab, 0x00, 
+0x99, 0x17, 0x2b, 0x01, 0xf7, 0x09, 0xd7, 0x0b, 0x49, 0x00, 0x03, 0x0c, 
+0x3a, 0x01, 0xd3, 0x00, 0x64, 0x09, 0x4a, 0x13, 0x2e, 0x0b, 0x74, 0x00, 
+0x3d, 0x00, 0xcd, 0x0b, 0x1c, 0x0b, 0x4a, 0x11, 0xab, 0x00, 0x0b, 0x00, 
+0xf3, 0x09, 0xc7, 0x0c, 0x13, 0x01, 0xeb, 0x0b, 0x2a, 0x01, 0x99, 0x06, 
+0xe7, 0x10, 0xc0, 0x0c, 0x71, 0x07, 0x22, 0x0b, 0xe2, 0x07, 0xc1, 0x00, 
+0x7c, 0x19, 0x16, 0x14, 0x5f, 0x08, 0x42, 0x00, 0xd8, 0x15, 0xbd, 0x0c, 
+0x69, 0x0b, 0x30, 0x00, 0xa7, 0x08, 0xb0, 0x0b, 0xb9, 0x00, 0xf4, 0x00, 
+0xaa, 0x15, 0x9c, 0x14, 0x22, 0x16, 0xf2, 0x18, 0x3f, 0x01, 0xaf, 0x09, 
+0xfa, 0x14, 0x41, 0x01, 0xd6, 0x16, 0xb2, 0x09, 0x73, 0x0c, 0x2b, 0x0a, 
+0xb2, 0x17, 0x45, 0x06, 0x5d, 0x08, 0x51, 0x0b, 0x44, 0x00, 0xe5, 0x16, 
+0xa6, 0x10, 0xcb, 0x00, 0x10, 0x07, 0x13, 0x01, 0x54, 0x07, 0xf4, 0x11, 
+0xd6, 0x07, 0x18, 0x08, 0xd4, 0x0a, 0x62, 0x09, 0xfb, 0x0a, 0x75, 0x0a, 
+0x91, 0x0a, 0x0a, 0x01, 0xfe, 0x0e, 0xf0, 0x15, 0x5e, 0x07, 0x5f, 0x0a, 
+0xa5, 0x09, 0xa6, 0x0f, 0x38, 0x0d, 0x8a, 0x07, 0xf9, 0x13, 0xba, 0x09, 
+0xfb, 0x14, 0xad, 0x0f, 0x55, 0x06, 0xe7, 0x0a, 0x09, 0x0a, 0x20, 0x0b, 
+0xb1, 0x11, 0x19, 0x00, 0x8e, 0x0d, 0x5e, 0x18, 0x7d, 0x0b, 0x31, 0x01, 
+0x68, 0x07, 0x88, 0x18, 0x4d, 0x09, 0x1c, 0x0b, 0xa7, 0x08, 0xb3, 0x17, 
+0x51, 0x0b, 0xc7, 0x16, 0xaf, 0x00, 0x66, 0x0c, 0x39, 0x0c, 0xca, 0x0f, 
+0x82, 0x11, 0xba, 0x14, 0xca, 0x0a, 0xbd, 0x09, 0xf1, 0x09, 0xb3, 0x10, 
+0x4a, 0x0e, 0x29, 0x0c, 0xe7, 0x0d, 0x6e, 0x12, 0x1a, 0x0c, 0xd4, 0x00, 
+0xd4, 0x0c, 0x7b, 0x00, 0xb1, 0x15, 0x30, 0x00, 0x1a, 0x10, 0xcb, 0x0c, 
+0xd8, 0x0b, 0x4a, 0x1c, 0xd8, 0x00, 0x0e, 0x00, 0xd5, 0x0b, 0xa1, 0x0a, 
+0x5d, 0x0b, 0x93, 0x0c, 0x79, 0x00, 0x13, 0x01, 0x42, 0x01, 0x99, 0x0a, 
+0xde, 0x08, 0x23, 0x00, 0xed, 0x0c, 0x3d, 0x01, 0x8f, 0x00, 0x18, 0x0a, 
+0x20, 0x01, 0xa9, 0x09, 0xb9, 0x0e, 0x89, 0x17, 0x39, 0x01, 0xdd, 0x0d, 
+0x3e, 0x0e, 0x0b, 0x00, 0xff, 0x0c, 0x03, 0x13, 0x4e, 0x00, 0xe1, 0x10, 
+0x9a, 0x0a, 0x43, 0x13, 0x54, 0x00, 0x9e, 0x00, 0x0d, 0x0c, 0x6e, 0x00, 
+0xdf, 0x08, 0x36, 0x0b, 0xec, 0x00, 0x6b, 0x0a, 0xf8, 0x09, 0x60, 0x06, 
+0xc6, 0x00, 0x97, 0x0a, 0x54, 0x00, 0xb9, 0x0a, 0xda, 0x00, 0xbd, 0x17, 
+0x98, 0x07, 0xe3, 0x0a, 0x7f, 0x16, 0x87, 0x0a, 0x92, 0x06, 0x6b, 0x0d, 
+0x27, 0x0c, 0x40, 0x06, 0x17, 0x13, 0xb7, 0x0b, 0xe7, 0x09, 0xbe, 0x1b, 
+0xc3, 0x00, 0x8e, 0x17, 0xc3, 0x17, 0xd2, 0x00, 0x77, 0x0a, 0xe7, 0x0f, 
+0xbd, 0x09, 0xe3, 0x0c, 0x43, 0x0c, 0x6e, 0x12, 0xb5, 0x12, 0xf7, 0x0b, 
+0x03, 0x01, 0x62, 0x1a, 0x8c, 0x18, 0x02, 0x15, 0xb3, 0x0c, 0x23, 0x00, 
+0xce, 0x0a, 0xc4, 0x0a, 0x68, 0x0b, 0xdf, 0x09, 0xda, 0x15, 0xf8, 0x0c, 
+0xed, 0x08, 0x8d, 0x08, 0x1a, 0x0b, 0xdf, 0x00, 0x3d, 0x0b, 0x8c, 0x06, 
+0x68, 0x07, 0x27, 0x00, 0x26, 0x0b, 0xc8, 0x17, 0xd7, 0x07, 0x97, 0x0a, 
+0xca, 0x08, 0x04, 0x11, 0xdd, 0x00, 0x8a, 0x11, 0xff, 0x10, 0x19, 0x00, 
+0x71, 0x00, 0x96, 0x0b, 0x04, 0x01, 0x02, 0x16, 0x04, 0x01, 0xa8, 0x0c, 
+0x09, 0x01, 0xc1, 0x0e, 0x38, 0x0d, 0xe1, 0x10, 0xf7, 0x00, 0x4c, 0x11, 
+0x0d, 0x01, 0xef, 0x0a, 0x83, 0x11, 0x6e, 0x10, 0xbc, 0x0c, 0x54, 0x08, 
+0x9d, 0x14, 0x15, 0x01, 0x99, 0x07, 0xfa, 0x00, 0x4a, 0x00, 0x54, 0x0a, 
+0x65, 0x0d, 0x7a, 0x10, 0xbd, 0x00, 0x40, 0x0e, 0x5c, 0x08, 0xa4, 0x06, 
+0x16, 0x01, 0xf5, 0x0c, 0xb7, 0x09, 0x2e, 0x08, 0xef, 0x0b, 0x0e, 0x01, 
+0x0f, 0x00, 0x65, 0x0c, 0xad, 0x00, 0xe2, 0x0b, 0x8f, 0x00, 0x39, 0x09, 
+0xea, 0x00, 0x73, 0x0b, 0x7c, 0x1b, 0x9d, 0x19, 0xe3, 0x18, 0x0c, 0x13, 
+0xc1, 0x00, 0xdf, 0x09, 0xae, 0x0a, 0x3e, 0x01, 0x21, 0x0a, 0x87, 0x0c, 
+0x7b, 0x0c, 0xb2, 0x06, 0xcf, 0x00, 0x04, 0x0b, 0x5f, 0x06, 0x27, 0x01, 
+0xc8, 0x09, 0x53, 0x00, 0x03, 0x01, 0x3e, 0x01, 0xf6, 0x10, 0x94, 0x00, 
+0xb8, 0x0a, 0x93, 0x00, 0x0d, 0x00, 0x44, 0x0c, 0x1d, 0x0c, 0x0c, 0x00, 
+0xe4, 0x0a, 0x8a, 0x12, 0x06, 0x0b, 0xe8, 0x0a, 0xc1, 0x0b, 0x21, 0x01, 
+0x46, 0x06, 0x0e, 0x0c, 0xc0, 0x0a, 0xf6, 0x0f, 0xca, 0x0f, 0x15, 0x13, 
+0xce, 0x06, 0xd9, 0x0a, 0x5e, 0x1a, 0x5f, 0x0b, 0x3c, 0x16, 0xb2, 0x06, 
+0x95, 0x0d, 0xf5, 0x0d, 0x21, 0x01, 0x02, 0x13, 0xac, 0x00, 0x19, 0x08, 
+0x7f, 0x0b, 0x0b, 0x0d, 0xc7, 0x0c, 0xfe, 0x14, 0xc1, 0x0e, 0xbd, 0x09, 
+0x3c, 0x00, 0x96, 0x0a, 0x37, 0x09, 0x51, 0x0b, 0x0f, 0x0b, 0xe8, 0x0c, 
+0x3e, 0x09, 0x9e, 0x0b, 0x13, 0x01, 0x77, 0x00, 0xf7, 0x0b, 0x3a, 0x13, 
+0xec, 0x00, 0x5f, 0x06, 0xdf, 0x08, 0x77, 0x00, 0xb1, 0x15, 0xcb, 0x00, 
+0x22, 0x01, 0xb2, 0x17, 0x1a, 0x00, 0x89, 0x09, 0x4d, 0x19, 0xbc, 0x17, 
+0x5e, 0x0c, 0x24, 0x0c, 0x47, 0x0e, 0x6f, 0x0a, 0xd1, 0x00, 0x0a, 0x0a, 
+0x5d, 0x07, 0x3b, 0x01, 0xa4, 0x11, 0x06, 0x0b, 0xcf, 0x00, 0x3e, 0x00, 
+0x54, 0x07, 0x9c, 0x00, 0xb5, 0x09, 0x58, 0x07, 0x46, 0x06, 0x22, 0x14, 
+0x7c, 0x16, 0xd9, 0x18, 0x47, 0x0e, 0x36, 0x0d, 0xf4, 0x0c, 0x54, 0x0a, 
+0x60, 0x00, 0x4c, 0x14, 0xde, 0x15, 0xe4, 0x0c, 0xf2, 0x18, 0x97, 0x0b, 
+0x4b, 0x00, 0x83, 0x00, 0xe7, 0x14, 0xfe, 0x0c, 0x47, 0x06, 0xe4, 0x00, 
+0xe0, 0x08, 0x37, 0x08, 0x03, 0x16, 0x63, 0x09, 0x84, 0x10, 0x6c, 0x00, 
+0xcd, 0x0c, 0x4d, 0x00, 0x4b, 0x0a, 0x10, 0x00, 0x5d, 0x07, 0xd8, 0x18, 
+0x51, 0x0c, 0xe4, 0x00, 0xd0, 0x09, 0x19, 0x00, 0xf7, 0x1a, 0x23, 0x01, 
+0xed, 0x0e, 0x25, 0x0b, 0x58, 0x17, 0x54, 0x00, 0xd5, 0x0b, 0xec, 0x00, 
+0xbe, 0x00, 0x42, 0x06, 0x5a, 0x08, 0xf9, 0x00, 0x82, 0x11, 0x2d, 0x08, 
+0xe3, 0x0a, 0xcf, 0x00, 0xa7, 0x00, 0x5c, 0x08, 0x31, 0x01, 0x26, 0x14, 
+0x34, 0x01, 0xb0, 0x00, 0x3d, 0x01, 0xa4, 0x09, 0x4d, 0x00, 0xdd, 0x0a, 
+0xa2, 0x0b, 0xbb, 0x09, 0xc7, 0x00, 0x73, 0x16, 0xed, 0x00, 0xbf, 0x0a, 
+0x0a, 0x01, 0xc0, 0x0c, 0x39, 0x0c, 0x02, 0x0f, 0x69, 0x1c, 0x70, 0x0f, 
+0x85, 0x00, 0x58, 0x17, 0xd4, 0x09, 0x54, 0x0a, 0x8c, 0x06, 0x44, 0x12, 
+0xd2, 0x06, 0x03, 0x0d, 0x43, 0x0c, 0x82, 0x0b, 0xa8, 0x00, 0x4e, 0x0a, 
+0x8c, 0x06, 0x83, 0x0a, 0xe8, 0x00, 0x66, 0x00, 0x19, 0x0c, 0xd0, 0x09, 
+0x0e, 0x00, 0x41, 0x06, 0x88, 0x0b, 0x66, 0x08, 0xd9, 0x06, 0x1c, 0x01, 
+0x52, 0x0b, 0x62, 0x0a, 0x63, 0x0d, 0xec, 0x09, 0x1c, 0x01, 0x7f, 0x0e, 
+0x2f, 0x09, 0x26, 0x12, 0x85, 0x0c, 0x25, 0x07, 0xff, 0x07, 0x31, 0x12, 
+0xed, 0x0b, 0xfe, 0x0e, 0xaa, 0x00, 0x79, 0x09, 0x08, 0x01, 0xfa, 0x13, 
+0x99, 0x15, 0x2d, 0x08, 0x25, 0x00, 0x41, 0x0a, 0xce, 0x1a, 0x3a, 0x17, 
+0x2d, 0x01, 0x9c, 0x0c, 0xbc, 0x0c, 0xe7, 0x09, 0xf3, 0x13, 0x82, 0x08, 
+0x17, 0x01, 0xce, 0x06, 0x12, 0x0e, 0x14, 0x0e, 0x48, 0x0b, 0xc7, 0x0a, 
+0xb4, 0x00, 0x86, 0x0c, 0x42, 0x13, 0xe9, 0x00, 0x60, 0x0a, 0xeb, 0x16, 
+0xf5, 0x0d, 0x90, 0x00, 0x77, 0x00, 0xec, 0x00, 0x2d, 0x01, 0x92, 0x00, 
+0xeb, 0x0b, 0x2c, 0x0a, 0xaa, 0x00, 0x09, 0x01, 0x5f, 0x0a, 0xb5, 0x00, 
+0x82, 0x0a, 0xd5, 0x0a, 0xf8, 0x0f, 0xa7, 0x08, 0xcf, 0x18, 0x01, 0x07, 
+0xc4, 0x00, 0xe7, 0x0a, 0x47, 0x00, 0x52, 0x07, 0x8e, 0x0b, 0x4c, 0x0a, 
+0x54, 0x0a, 0x0c, 0x0d, 0xf1, 0x13, 0x6d, 0x00, 0x17, 0x0c, 0x15, 0x01, 
+0xbd, 0x0c, 0x6b, 0x00, 0x47, 0x06, 0xbb, 0x14, 0x9c, 0x18, 0x45, 0x06, 
+0xb5, 0x1b, 0x15, 0x14, 0x3a, 0x01, 0x07, 0x0b, 0xe9, 0x1a, 0x5d, 0x07, 
+0xd0, 0x00, 0xdb, 0x00, 0xf2, 0x00, 0x2e, 0x15, 0xa9, 0x07, 0x5c, 0x0b, 
+0x1a, 0x0b, 0x6d, 0x00, 0x5f, 0x06, 0xc6, 0x00, 0x09, 0x0d, 0x25, 0x00, 
+0x62, 0x00, 0x68, 0x08, 0xf5, 0x00, 0x93, 0x0b, 0xfd, 0x0a, 0x74, 0x06, 
+0x30, 0x12, 0xa1, 0x06, 0x48, 0x1a, 0xa1, 0x07, 0x1e, 0x01, 0xbb, 0x0a, 
+0x78, 0x07, 0xc8, 0x09, 0x09, 0x0a, 0x15, 0x0a, 0x2c, 0x01, 0x1a, 0x0b, 
+0xfc, 0x00, 0xf7, 0x0b, 0x61, 0x06, 0xc0, 0x0b, 0x64, 0x0d, 0x3a, 0x18, 
+0xdd, 0x18, 0xcd, 0x09, 0x62, 0x0c, 0x6d, 0x00, 0xdb, 0x0b, 0xf4, 0x00, 
+0x35, 0x09, 0xd1, 0x09, 0x3d, 0x00, 0x1b, 0x01, 0x85, 0x00, 0x4c, 0x11, 
+0xe1, 0x00, 0xf6, 0x0f, 0x2a, 0x00, 0x30, 0x00, 0x49, 0x06, 0xe9, 0x00, 
+0xb2, 0x0c, 0xb1, 0x10, 0xeb, 0x07, 0x66, 0x1b, 0x16, 0x17, 0x04, 0x0b, 
+0x66, 0x09, 0x23, 0x01, 0x3b, 0x1a, 0xd4, 0x00, 0x42, 0x00, 0x1f, 0x0b, 
+0x17, 0x0a, 0x1a, 0x08, 0x08, 0x01, 0xcb, 0x0b, 0xdf, 0x0c, 0x41, 0x0b, 
+0x26, 0x15, 0x2a, 0x0f, 0xbb, 0x09, 0x5a, 0x00, 0xc4, 0x00, 0x28, 0x07, 
+0x57, 0x0a, 0x30, 0x0c, 0xad, 0x0b, 0xca, 0x09, 0xf1, 0x00, 0x1c, 0x0c, 
+0x2e, 0x01, 0x42, 0x01, 0x30, 0x01, 0xf3, 0x08, 0xdd, 0x0c, 0x70, 0x10, 
+0x21, 0x10, 0xcb, 0x0c, 0x0f, 0x01, 0x9f, 0x00, 0xeb, 0x0b, 0x6c, 0x00, 
+0xbc, 0x0c, 0x02, 0x0c, 0xd6, 0x15, 0xab, 0x00, 0xc2, 0x00, 0x05, 0x01, 
+0x61, 0x00, 0xee, 0x0a, 0xbf, 0x09, 0x70, 0x00, 0x79, 0x09, 0x91, 0x00, 
+0x25, 0x0b, 0xdb, 0x09, 0x91, 0x0c, 0x46, 0x06, 0x7b, 0x00, 0x5f, 0x0a, 
+0x49, 0x0a, 0x19, 0x0c, 0x2b, 0x09, 0x61, 0x0c, 0x67, 0x09, 0x12, 0x0c, 
+0x18, 0x0b, 0xda, 0x0f, 0xa0, 0x15, 0xd2, 0x0c, 0xa2, 0x0a, 0xe5, 0x00, 
+0x2e, 0x0c, 0xd8, 0x00, 0xd7, 0x00, 0xdb, 0x00, 0x24, 0x13, 0x3e, 0x0e, 
+0xec, 0x00, 0x01, 0x01, 0x98, 0x0a, 0xf1, 0x09, 0x35, 0x01, 0xe2, 0x07, 
+0x26, 0x12, 0x70, 0x17, 0x44, 0x18, 0x28, 0x01, 0x31, 0x12, 0x5c, 0x13, 
+0xa3, 0x08, 0x76, 0x0b, 0xbd, 0x00, 0x23, 0x0c, 0x4c, 0x0a, 0x9f, 0x0b, 
+0xac, 0x0a, 0x90, 0x00, 0x9d, 0x0c, 0x3d, 0x01, 0xde, 0x13, 0xe6, 0x09, 
+0x25, 0x00, 0x36, 0x01, 0x53, 0x07, 0x4b, 0x00, 0xe5, 0x06, 0xf7, 0x00, 
+0xb7, 0x0a, 0xc3, 0x0c, 0xd7, 0x00, 0x52, 0x07, 0xd4, 0x0f, 0x44, 0x12, 
+0xe0, 0x00, 0x6e, 0x00, 0x53, 0x0b, 0x0b, 0x0d, 0xe8, 0x00, 0xa8, 0x08, 
+0xb4, 0x00, 0x1e, 0x08, 0xed, 0x0e, 0x05, 0x01, 0xf7, 0x1a, 0xa9, 0x0b, 
+0x45, 0x06, 0xc2, 0x00, 0x69, 0x06, 0x40, 0x09, 0xaa, 0x0c, 0x6f, 0x0f, 
+0x02, 0x01, 0x4d, 0x0b, 0xea, 0x00, 0x02, 0x0a, 0x03, 0x0e, 0x0d, 0x01, 
+0x55, 0x07, 0xd9, 0x00, 0xae, 0x10, 0x41, 0x00, 0x4a, 0x0a, 0xd8, 0x07, 
+0xa0, 0x08, 0x6e, 0x09, 0x47, 0x0b, 0xb2, 0x0c, 0xfd, 0x14, 0xc7, 0x09, 
+0x24, 0x0c, 0x0d, 0x0c, 0x9b, 0x12, 0x24, 0x00, 0x0b, 0x01, 0xc5, 0x09, 
+0x20, 0x0a, 0xab, 0x08, 0xfa, 0x0a, 0x69, 0x0b, 0xc5, 0x09, 0xe5, 0x06, 
+0xb0, 0x0a, 0x55, 0x06, 0xda, 0x07, 0x94, 0x0b, 0x21, 0x01, 0x2e, 0x01, 
+0xa9, 0x07, 0x45, 0x14, 0x25, 0x1a, 0x41, 0x01, 0x70, 0x0c, 0x23, 0x08, 
+0x37, 0x01, 0xb4, 0x0c, 0x7f, 0x0f, 0xaa, 0x00, 0x47, 0x06, 0x4e, 0x0c, 
+0xb3, 0x0c, 0x0c, 0x0d, 0x04, 0x01, 0xeb, 0x00, 0xda, 0x0f, 0xa7, 0x00, 
+0x5a, 0x0c, 0x54, 0x11, 0x2f, 0x0f, 0xc7, 0x00, 0x3e, 0x0d, 0x10, 0x01, 
+0x15, 0x14, 0xe0, 0x00, 0xaa, 0x00, 0xb4, 0x00, 0x8a, 0x10, 0xe4, 0x00, 
+0x4c, 0x11, 0x31, 0x07, 0x19, 0x08, 0xfb, 0x00, 0xc8, 0x00, 0x8e, 0x1a, 
+0x51, 0x0b, 0xb2, 0x08, 0xb4, 0x00, 0x25, 0x00, 0x44, 0x06, 0xd8, 0x00, 
+0xdb, 0x0b, 0x15, 0x19, 0x8b, 0x0a, 0x7c, 0x1b, 0x5e, 0x0b, 0xc2, 0x00, 
+0xb1, 0x09, 0x9d, 0x0f, 0x57, 0x18, 0x3d, 0x00, 0x16, 0x13, 0xa9, 0x0b, 
+0x74, 0x0b, 0xe1, 0x00, 0x72, 0x16, 0xa1, 0x19, 0x55, 0x06, 0xac, 0x18, 
+0x37, 0x10, 0xfa, 0x00, 0x12, 0x07, 0xb2, 0x0c, 0xd9, 0x00, 0x04, 0x09, 
+0xa5, 0x0f, 0xab, 0x06, 0xe6, 0x09, 0x2c, 0x01, 0xb7, 0x0a, 0x5e, 0x0c, 
+0x8b, 0x0a, 0x53, 0x07, 0x8e, 0x06, 0x0c, 0x01, 0xb8, 0x0a, 0xfd, 0x00, 
+0xc4, 0x00, 0x71, 0x0a, 0x28, 0x15, 0xe9, 0x00, 0xa5, 0x0f, 0x8b, 0x0b, 
+0x35, 0x0a, 0x89, 0x0b, 0x60, 0x07, 0x84, 0x00, 0xdd, 0x00, 0x0e, 0x0a, 
+0x2b, 0x12, 0x81, 0x07, 0x54, 0x11, 0xee, 0x0e, 0x69, 0x17, 0x27, 0x0b, 
+0x86, 0x17, 0x34, 0x18, 0xe3, 0x0a, 0x57, 0x06, 0x28, 0x01, 0xd8, 0x15, 
+0x12, 0x00, 0x0c, 0x0c, 0x58, 0x0a, 0x18, 0x08, 0xbb, 0x09, 0x3c, 0x01, 
+0xa7, 0x0f, 0x94, 0x07, 0xb2, 0x17, 0x96, 0x11, 0xdf, 0x00, 0x4a, 0x00, 
+0x96, 0x0b, 0x12, 0x11, 0xbb, 0x00, 0x49, 0x15, 0x5d, 0x0b, 0x94, 0x11, 
+0x26, 0x01, 0xdd, 0x00, 0x21, 0x14, 0xf7, 0x14, 0x85, 0x16, 0xba, 0x09, 
+0x35, 0x01, 0x12, 0x00, 0xd5, 0x09, 0x23, 0x01, 0xc7, 0x0a, 0xc1, 0x16, 
+0xba, 0x07, 0xa2, 0x00, 0x24, 0x01, 0xb3, 0x0c, 0x1c, 0x08, 0xb6, 0x00, 
+0xfb, 0x15, 0xa7, 0x13, 0x36, 0x10, 0xd8, 0x0a, 0xd3, 0x00, 0xfd, 0x17, 
+0xfe, 0x10, 0xec, 0x0c, 0x51, 0x0a, 0x00, 0x0d, 0xaa, 0x0b, 0xbf, 0x10, 
+0xc8, 0x00, 0x88, 0x09, 0x89, 0x00, 0xd1, 0x0a, 0xfd, 0x09, 0xb5, 0x08, 
+0x4f, 0x16, 0xb4, 0x00, 0xd1, 0x0a, 0x27, 0x01, 0x59, 0x18, 0xc5, 0x00, 
+0x54, 0x0d, 0x9a, 0x15, 0x36, 0x0b, 0x5e, 0x11, 0x52, 0x07, 0xf0, 0x00, 
+0x4b, 0x00, 0xc5, 0x00, 0x28, 0x01, 0xa3, 0x17, 0x3e, 0x08, 0xbb, 0x17, 
+0x7a, 0x00, 0x13, 0x0a, 0x1f, 0x07, 0xd6, 0x07, 0x44, 0x06, 0xd6, 0x15, 
+0xb7, 0x0a, 0xc8, 0x09, 0x64, 0x00, 0xf9, 0x0c, 0xd6, 0x0c, 0xdb, 0x00, 
+0x28, 0x0a, 0x6e, 0x0b, 0x20, 0x14, 0x26, 0x07, 0x6c, 0x06, 0xe3, 0x13, 
+0xb8, 0x00, 0x2b, 0x09, 0x10, 0x07, 0xaf, 0x09, 0x5f, 0x07, 0xe8, 0x13, 
+0xd7, 0x16, 0xa8, 0x08, 0xf2, 0x00, 0xd6, 0x0b, 0x02, 0x16, 0xce, 0x0a, 
+0xcf, 0x0a, 0xb7, 0x0a, 0xc3, 0x00, 0x86, 0x00, 0xe3, 0x0b, 0x6f, 0x12, 
+0xc6, 0x00, 0x1f, 0x01, 0x1a, 0x0c, 0xc7, 0x0c, 0xe9, 0x0a, 0xf3, 0x00, 
+0x6d, 0x0a, 0x29, 0x01, 0x48, 0x18, 0xb2, 0x00, 0x94, 0x07, 0xc5, 0x09, 
+0x9c, 0x08, 0xba, 0x17, 0x8c, 0x12, 0x70, 0x10, 0x55, 0x0b, 0x60, 0x00, 
+0x9e, 0x0b, 0x40, 0x0a, 0x1a, 0x0b, 0x2e, 0x01, 0xa8, 0x0a, 0x2b, 0x0a, 
+0xca, 0x00, 0xa2, 0x19, 0x88, 0x0b, 0x31, 0x00, 0x6a, 0x0a, 0x6a, 0x0a, 
+0x2b, 0x01, 0x8d, 0x0a, 0x3d, 0x00, 0xc5, 0x0a, 0x71, 0x07, 0xfb, 0x16, 
+0xa8, 0x00, 0x40, 0x00, 0x54, 0x0a, 0xad, 0x16, 0x57, 0x0b, 0x3a, 0x01, 
+0x4f, 0x09, 0x6f, 0x0a, 0x4f, 0x0c, 0x5a, 0x0c, 0x96, 0x0a, 0x45, 0x0c, 
+0xb2, 0x00, 0x20, 0x0d, 0xd2, 0x0e, 0x32, 0x00, 0xd0, 0x15, 0xa0, 0x15, 
+0xa4, 0x09, 0x31, 0x12, 0x9f, 0x00, 0xcb, 0x12, 0x92, 0x0c, 0x18, 0x11, 
+0xc5, 0x09, 0xbf, 0x0b, 0xa8, 0x0a, 0xb5, 0x1b, 0x6a, 0x0c, 0x10, 0x0b, 
+0x54, 0x0a, 0x5b, 0x0c, 0x95, 0x1b, 0xc7, 0x09, 0x0d, 0x0c, 0x1c, 0x00, 
+0x7f, 0x0b, 0x04, 0x0c, 0xdd, 0x06, 0x3c, 0x00, 0x5e, 0x0c, 0x04, 0x0c, 
+0xe0, 0x00, 0x35, 0x00, 0x5d, 0x0c, 0x94, 0x0c, 0x71, 0x10, 0x78, 0x10, 
+0x36, 0x0a, 0xb4, 0x00, 0xa0, 0x1b, 0xa6, 0x0c, 0xa6, 0x0a, 0xbc, 0x0c, 
+0xa8, 0x08, 0x18, 0x01, 0xea, 0x0c, 0xf5, 0x00, 0x0d, 0x00, 0x50, 0x10, 
+0x3f, 0x0a, 0xfc, 0x09, 0x35, 0x0d, 0xbe, 0x00, 0x6d, 0x0b, 0xef, 0x0a, 
+0xd9, 0x0e, 0x23, 0x0c, 0x98, 0x18, 0xda, 0x00, 0x90, 0x06, 0x0b, 0x01, 
+0x1c, 0x00, 0x8e, 0x0d, 0xce, 0x06, 0x1f, 0x0a, 0x5d, 0x1a, 0x71, 0x0c, 
+0x19, 0x11, 0x5c, 0x0c, 0x97, 0x06, 0x1f, 0x0b, 0x7b, 0x10, 0xbd, 0x0c, 
+0x0e, 0x01, 0x80, 0x0a, 0xca, 0x0c, 0xa3, 0x0a, 0x25, 0x08, 0xee, 0x0a, 
+0x0b, 0x09, 0xa5, 0x09, 0x7a, 0x18, 0x29, 0x01, 0xe1, 0x19, 0x1a, 0x01, 
+0x1b, 0x01, 0x2d, 0x01, 0xe4, 0x1a, 0x1a, 0x01, 0xeb, 0x07, 0xf4, 0x06, 
+0xa8, 0x08, 0x04, 0x01, 0x98, 0x06, 0x27, 0x0b, 0x85, 0x00, 0xbd, 0x00, 
+0x13, 0x0b, 0x0d, 0x0a, 0xf3, 0x00, 0xb4, 0x00, 0x03, 0x01, 0xe5, 0x0a, 
+0xc0, 0x00, 0x47, 0x0b, 0x2e, 0x01, 0x98, 0x06, 0xdb, 0x00, 0x85, 0x00, 
+0xb9, 0x00, 0x1a, 0x0b, 0xc3, 0x0a, 0x3e, 0x0a, 0x3b, 0x01, 0x49, 0x06, 
+0x27, 0x01, 0x9f, 0x0b, 0x41, 0x06, 0xbb, 0x00, 0xe8, 0x00, 0xe5, 0x0a, 
+0xe4, 0x0b, 0xcb, 0x0b, 0xb3, 0x00, 0xb0, 0x00, 0x92, 0x0f, 0xf7, 0x0b, 
+0x47, 0x0a, 0xbe, 0x00, 0x5f, 0x0a, 0x02, 0x0d, 0x17, 0x0a, 0x9a, 0x06, 
+0x45, 0x06, 0x01, 0x0c, 0x93, 0x0b, 0xb1, 0x0c, 0x5f, 0x00, 0xfc, 0x07, 
+0x11, 0x01, 0x47, 0x06, 0x1e, 0x01, 0x33, 0x00, 0xfb, 0x00, 0xdf, 0x00, 
+0x2d, 0x0c, 0xeb, 0x07, 0xd9, 0x0c, 0x66, 0x14, 0x38, 0x07, 0x58, 0x00, 
+0x93, 0x0f, 0xd3, 0x0b, 0x21, 0x17, 0xed, 0x08, 0xcd, 0x14, 0x09, 0x0d, 
+0x61, 0x07, 0x34, 0x0a, 0x1e, 0x1a, 0x7d, 0x1b, 0xb8, 0x0e, 0x01, 0x01, 
+0xe6, 0x0a, 0x2d, 0x01, 0xee, 0x0b, 0x92, 0x11, 0xf2, 0x17, 0xe3, 0x0b, 
+0x28, 0x14, 0x47, 0x06, 0xd7, 0x00, 0xc4, 0x00, 0x1c, 0x08, 0x31, 0x01, 
+0xd4, 0x00, 0x5f, 0x11, 0xeb, 0x00, 0x39, 0x10, 0xe3, 0x0a, 0xc3, 0x11, 
+0xae, 0x16, 0x2d, 0x15, 0xdf, 0x0d, 0xba, 0x08, 0x3f, 0x09, 0x48, 0x00, 
+0xaf, 0x0f, 0xc5, 0x14, 0x17, 0x0c, 0x76, 0x0a, 0xda, 0x0d, 0x1f, 0x01, 
+0x31, 0x01, 0xd2, 0x1a, 0x10, 0x01, 0xca, 0x00, 0x41, 0x01, 0x2f, 0x01, 
+0x72, 0x07, 0x3f, 0x0a, 0x85, 0x17, 0xe8, 0x0c, 0x1d, 0x0a, 0x26, 0x14, 
+0x1a, 0x0b, 0xd0, 0x09, 0x9d, 0x0f, 0xfd, 0x09, 0x95, 0x00, 0xf8, 0x00, 
+0x78, 0x00, 0x9e, 0x0b, 0x3e, 0x0a, 0xec, 0x0b, 0xe8, 0x13, 0xc6, 0x09, 
+0x1d, 0x07, 0xfa, 0x0a, 0x4a, 0x13, 0xd2, 0x09, 0xb7, 0x0b, 0xab, 0x07, 
+0x08, 0x0d, 0x91, 0x0b, 0xdb, 0x09, 0x46, 0x06, 0x4a, 0x00, 0x70, 0x0a, 
+0xba, 0x09, 0x3d, 0x00, 0xc3, 0x16, 0xbd, 0x09, 0x81, 0x0a, 0x1e, 0x08, 
+0xfd, 0x00, 0x14, 0x19, 0x25, 0x08, 0xc3, 0x0a, 0xdb, 0x0a, 0xd8, 0x00, 
+0x83, 0x10, 0x6b, 0x00, 0x8b, 0x11, 0xe8, 0x00, 0x70, 0x0c, 0xb1, 0x08, 
+0x16, 0x17, 0x82, 0x0a, 0xf5, 0x00, 0xea, 0x09, 0xe9, 0x0c, 0xd9, 0x0a, 
+0x96, 0x15, 0x2a, 0x0a, 0x5d, 0x00, 0x8f, 0x0d, 0xe5, 0x0b, 0xbd, 0x0c, 
+0x6b, 0x08, 0xec, 0x00, 0x8d, 0x0a, 0xd0, 0x00, 0x43, 0x0a, 0xba, 0x08, 
+0x7c, 0x00, 0xb4, 0x00, 0xde, 0x0c, 0x05, 0x0b, 0x5b, 0x0f, 0xac, 0x00, 
+0xdb, 0x11, 0xd6, 0x07, 0x67, 0x12, 0xa1, 0x0b, 0xb1, 0x0c, 0x3e, 0x0f, 
+0xda, 0x0a, 0x15, 0x0e, 0x77, 0x0c, 0x3b, 0x01, 0xd6, 0x17, 0xbc, 0x09, 
+0x93, 0x00, 0x0c, 0x00, 0x12, 0x01, 0xcc, 0x0b, 0x45, 0x0c, 0x47, 0x08, 
+0x38, 0x01, 0x2d, 0x08, 0x50, 0x0c, 0xa1, 0x15, 0x3c, 0x0b, 0xa4, 0x0b, 
+0xf9, 0x0a, 0x1c, 0x01, 0xba, 0x17, 0x36, 0x07, 0xd0, 0x06, 0xd0, 0x06, 
+0x0e, 0x00, 0xaa, 0x09, 0xb9, 0x0a, 0xab, 0x09, 0xfa, 0x14, 0xb2, 0x00, 
+0x94, 0x00, 0x41, 0x06, 0xa8, 0x09, 0x9e, 0x0b, 0xfc, 0x08, 0x32, 0x0b, 
+0x2e, 0x08, 0xf8, 0x0c, 0x91, 0x10, 0xed, 0x00, 0x4b, 0x13, 0x97, 0x0c, 
+0x30, 0x0c, 0x42, 0x06, 0x09, 0x01, 0xb7, 0x0a, 0xaa, 0x06, 0x13, 0x07, 
+0xcf, 0x0a, 0xb1, 0x09, 0x16, 0x0a, 0x11, 0x01, 0xcb, 0x13, 0xc9, 0x00, 
+0xbb, 0x09, 0xb0, 0x09, 0xa1, 0x07, 0x4f, 0x0c, 0x0b, 0x00, 0x25, 0x0b, 
+0x83, 0x0a, 0x15, 0x0b, 0x10, 0x0a, 0xbc, 0x00, 0xa2, 0x15, 0xb0, 0x19, 
+0x5f, 0x0c, 0xaf, 0x09, 0xad, 0x00, 0x89, 0x00, 0xbd, 0x09, 0xa3, 0x07, 
+0xae, 0x0a, 0x61, 0x0b, 0xc6, 0x00, 0x93, 0x00, 0x8a, 0x09, 0x03, 0x01, 
+0x0f, 0x00, 0xda, 0x00, 0xad, 0x00, 0x89, 0x0b, 0xf0, 0x00, 0x9f, 0x0f, 
+0x26, 0x01, 0x3b, 0x00, 0xfe, 0x0c, 0xcb, 0x0b, 0xc6, 0x0b, 0xe9, 0x00, 
+0xd5, 0x0b, 0xcc, 0x00, 0x83, 0x0a, 0x46, 0x0b, 0x3a, 0x0c, 0x13, 0x01, 
+0xef, 0x0e, 0x4a, 0x06, 0xe8, 0x0a, 0x0c, 0x13, 0x79, 0x10, 0xb4, 0x00, 
+0x96, 0x15, 0x53, 0x00, 0xde, 0x13, 0x00, 0x19, 0xee, 0x00, 0x44, 0x13, 
+0xf4, 0x11, 0xdd, 0x09, 0x29, 0x0a, 0x1b, 0x0d, 0x47, 0x0c, 0x8b, 0x12, 
+0xaf, 0x09, 0xff, 0x09, 0xa3, 0x0b, 0x47, 0x06, 0x8c, 0x06, 0xd5, 0x06, 
+0x5f, 0x12, 0x4a, 0x0a, 0xf1, 0x00, 0x40, 0x0c, 0xe1, 0x08, 0x72, 0x16, 
+0xd9, 0x11, 0x12, 0x0e, 0x21, 0x01, 0x17, 0x14, 0x64, 0x09, 0x71, 0x08, 
+0xb7, 0x09, 0x1e, 0x01, 0xed, 0x08, 0x13, 0x01, 0x25, 0x0b, 0x2c, 0x13, 
+0x8a, 0x0b, 0xce, 0x15, 0xf3, 0x18, 0x83, 0x00, 0xd5, 0x0b, 0x5f, 0x0a, 
+0x94, 0x0f, 0xd6, 0x17, 0xd5, 0x00, 0xb7, 0x0f, 0x3d, 0x00, 0x46, 0x0e, 
+0x44, 0x0c, 0x59, 0x0c, 0x2f, 0x07, 0x9b, 0x00, 0x06, 0x10, 0xaf, 0x09, 
+0xfb, 0x0a, 0x9e, 0x0f, 0xf9, 0x0b, 0xef, 0x11, 0xcb, 0x00, 0x58, 0x17, 
+0x56, 0x18, 0x19, 0x0c, 0x5a, 0x11, 0xd4, 0x0c, 0xff, 0x00, 0xb8, 0x00, 
+0x75, 0x0c, 0x19, 0x0c, 0x2b, 0x16, 0x1a, 0x08, 0x69, 0x0c, 0x34, 0x18, 
+0xed, 0x0e, 0x04, 0x0d, 0x80, 0x07, 0xf8, 0x00, 0x2c, 0x01, 0xd0, 0x0a, 
+0xd0, 0x0b, 0xbe, 0x09, 0x45, 0x06, 0xd3, 0x11, 0x2d, 0x01, 0x97, 0x0a, 
+0xdf, 0x00, 0xab, 0x1a, 0xa5, 0x09, 0xd7, 0x07, 0xd4, 0x00, 0xa2, 0x0d, 
+0xca, 0x00, 0xc4, 0x00, 0xcd, 0x0b, 0x43, 0x06, 0xff, 0x00, 0x33, 0x12, 
+0x47, 0x06, 0x31, 0x01, 0x32, 0x0b, 0x68, 0x14, 0xb8, 0x00, 0xbd, 0x09, 
+0x5a, 0x17, 0xa9, 0x0b, 0x66, 0x08, 0x85, 0x17, 0xf7, 0x00, 0x19, 0x01, 
+0x52, 0x0c, 0x25, 0x00, 0x49, 0x06, 0x24, 0x01, 0x70, 0x0c, 0xe6, 0x09, 
+0x67, 0x0c, 0x87, 0x0c, 0x80, 0x0a, 0x07, 0x01, 0x2d, 0x0c, 0x94, 0x0f, 
+0x00, 0x01, 0xfc, 0x08, 0xf2, 0x09, 0x0f, 0x01, 0x4b, 0x11, 0x4a, 0x14, 
+0x04, 0x01, 0xf4, 0x09, 0xb3, 0x16, 0xaa, 0x09, 0x5f, 0x0b, 0xe3, 0x00, 
+0xbd, 0x00, 0xc9, 0x0a, 0xff, 0x00, 0xd2, 0x10, 0xe0, 0x0b, 0x23, 0x08, 
+0x7c, 0x0c, 0x26, 0x00, 0x82, 0x0a, 0x1e, 0x01, 0x93, 0x0e, 0x21, 0x01, 
+0x64, 0x09, 0x78, 0x07, 0xb3, 0x00, 0xc5, 0x0b, 0x61, 0x1b, 0xf8, 0x0b, 
+0x5b, 0x0a, 0xc5, 0x14, 0xf2, 0x00, 0x18, 0x01, 0xae, 0x00, 0xbf, 0x00, 
+0xe6, 0x09, 0x25, 0x00, 0xc2, 0x0a, 0xc0, 0x12, 0xda, 0x16, 0x7b, 0x00, 
+0x32, 0x01, 0x1d, 0x01, 0x87, 0x17, 0xcf, 0x00, 0xf7, 0x0c, 0x4f, 0x00, 
+0xb4, 0x19, 0x3e, 0x0a, 0x05, 0x01, 0x5d, 0x07, 0x26, 0x01, 0x3e, 0x00, 
+0xc8, 0x09, 0x42, 0x13, 0x4a, 0x0a, 0xb3, 0x00, 0x66, 0x0c, 0x62, 0x00, 
+0xf6, 0x10, 0xd8, 0x0e, 0x7c, 0x00, 0x92, 0x11, 0xe6, 0x0d, 0xee, 0x07, 
+0xdb, 0x0f, 0x02, 0x13, 0xe2, 0x00, 0x29, 0x0a, 0xb8, 0x0d, 0xa0, 0x0f, 
+0x37, 0x08, 0xca, 0x0f, 0xd4, 0x00, 0x58, 0x11, 0xd2, 0x00, 0xc2, 0x0a, 
+0x04, 0x0b, 0x09, 0x01, 0x8d, 0x0d, 0xaa, 0x0c, 0x62, 0x00, 0xbd, 0x00, 
+0x77, 0x00, 0x36, 0x18, 0x5f, 0x00, 0x99, 0x12, 0xc4, 0x00, 0x7d, 0x00, 
+0xb9, 0x00, 0xf0, 0x06, 0x70, 0x10, 0xdc, 0x0a, 0xc3, 0x0e, 0x9e, 0x0f, 
+0x56, 0x0b, 0xe6, 0x09, 0xeb, 0x0b, 0x30, 0x00, 0xfb, 0x00, 0x58, 0x19, 
+0x8c, 0x10, 0x0a, 0x01, 0x9b, 0x09, 0x76, 0x17, 0x01, 0x01, 0xa9, 0x07, 
+0x08, 0x0d, 0xd6, 0x18, 0x5a, 0x0c, 0x7e, 0x0e, 0x5f, 0x07, 0xbf, 0x00, 
+0x13, 0x0b, 0x5f, 0x06, 0xc6, 0x0a, 0x3f, 0x00, 0xba, 0x17, 0xce, 0x0a, 
+0xc7, 0x16, 0x6c, 0x0a, 0x88, 0x17, 0xf7, 0x0e, 0xd2, 0x0c, 0xc9, 0x0f, 
+0x6a, 0x0b, 0x2a, 0x01, 0xbe, 0x10, 0xae, 0x0a, 0xf6, 0x0b, 0x08, 0x0a, 
+0xab, 0x10, 0x07, 0x01, 0xdc, 0x00, 0xfe, 0x0f, 0xf4, 0x00, 0x9b, 0x0b, 
+0xa4, 0x0c, 0xca, 0x0c, 0xe0, 0x08, 0x1a, 0x00, 0xca, 0x0f, 0x0d, 0x00, 
+0x4f, 0x15, 0x40, 0x0a, 0xb5, 0x00, 0xd4, 0x00, 0x08, 0x0d, 0x0b, 0x00, 
+0xfd, 0x00, 0x9c, 0x00, 0x0c, 0x18, 0x86, 0x00, 0xd5, 0x00, 0xe4, 0x0d, 
+0xa0, 0x1a, 0xd2, 0x18, 0x19, 0x01, 0x1c, 0x01, 0x36, 0x0a, 0xe0, 0x0b, 
+0x30, 0x09, 0x18, 0x08, 0x12, 0x0c, 0x07, 0x09, 0x9f, 0x00, 0xd3, 0x00, 
+0x3e, 0x0a, 0x9d, 0x00, 0x06, 0x10, 0xd6, 0x00, 0x25, 0x08, 0xb8, 0x10, 
+0xeb, 0x00, 0x25, 0x01, 0x30, 0x17, 0x8d, 0x06, 0x33, 0x0b, 0xda, 0x0d, 
+0x35, 0x0d, 0xae, 0x10, 0x1c, 0x0b, 0xf9, 0x0a, 0xf3, 0x09, 0x90, 0x17, 
+0x94, 0x0c, 0x6b, 0x1a, 0xfd, 0x09, 0x32, 0x07, 0x38, 0x01, 0xb1, 0x15, 
+0xb9, 0x15, 0x6c, 0x11, 0x47, 0x00, 0xfa, 0x11, 0x89, 0x00, 0x5f, 0x00, 
+0x02, 0x01, 0x17, 0x0c, 0x57, 0x00, 0x83, 0x00, 0xc5, 0x00, 0x19, 0x01, 
+0xd5, 0x10, 0xcc, 0x0f, 0x1a, 0x01, 0xe8, 0x09, 0x33, 0x01, 0x2b, 0x12, 
+0xcf, 0x0b, 0x5c, 0x0b, 0x66, 0x08, 0x59, 0x0c, 0x2e, 0x01, 0x14, 0x01, 
+0x43, 0x06, 0xd3, 0x0a, 0xca, 0x0f, 0xc5, 0x00, 0x04, 0x01, 0x04, 0x01, 
+0x36, 0x07, 0xda, 0x0d, 0x49, 0x00, 0xa9, 0x0b, 0x40, 0x06, 0xd9, 0x07, 
+0xf4, 0x06, 0x2a, 0x18, 0x70, 0x0c, 0x6b, 0x00, 0x5e, 0x11, 0x2e, 0x0c, 
+0xbc, 0x0c, 0xbd, 0x09, 0x39, 0x12, 0x84, 0x0a, 0x85, 0x00, 0x1f, 0x00, 
+0x72, 0x0b, 0x9b, 0x00, 0x73, 0x0c, 0x81, 0x08, 0xe6, 0x0d, 0x59, 0x0a, 
+0x5c, 0x08, 0x03, 0x01, 0x06, 0x10, 0xeb, 0x00, 0x9a, 0x19, 0x00, 0x01, 
+0x81, 0x0b, 0x65, 0x09, 0x04, 0x0b, 0x78, 0x11, 0xbc, 0x14, 0xd7, 0x00, 
+0x62, 0x0a, 0x06, 0x0b, 0xb1, 0x08, 0x56, 0x0a, 0x0e, 0x01, 0x2c, 0x01, 
+0xd3, 0x10, 0x62, 0x07, 0x94, 0x0b, 0xc8, 0x09, 0xe7, 0x09, 0x74, 0x0b, 
+0x24, 0x00, 0x4c, 0x1a, 0xc7, 0x07, 0x10, 0x0b, 0xce, 0x06, 0x6d, 0x00, 
+0x17, 0x00, 0x31, 0x07, 0x2e, 0x01, 0xd4, 0x09, 0xb1, 0x09, 0x03, 0x01, 
+0xc9, 0x09, 0x46, 0x06, 0xe2, 0x08, 0xf3, 0x09, 0x19, 0x01, 0xee, 0x0a, 
+0x2f, 0x01, 0x9e, 0x0f, 0x32, 0x01, 0xba, 0x09, 0x3c, 0x00, 0xf9, 0x00, 
+0x1b, 0x08, 0xaf, 0x0c, 0xd5, 0x00, 0x91, 0x06, 0x65, 0x11, 0x58, 0x17, 
+0xc7, 0x09, 0x51, 0x0b, 0x0b, 0x01, 0xf2, 0x12, 0xfe, 0x00, 0xb8, 0x0a, 
+0x09, 0x0d, 0x11, 0x00, 0xce, 0x00, 0x2a, 0x01, 0x17, 0x0c, 0x96, 0x0a, 
+0xc5, 0x09, 0x68, 0x0b, 0x71, 0x10, 0xaf, 0x00, 0xc9, 0x00, 0xf1, 0x09, 
+0xaf, 0x0b, 0xc6, 0x00, 0x47, 0x06, 0xed, 0x0d, 0x26, 0x14, 0xdd, 0x0a, 
+0x05, 0x12, 0x03, 0x01, 0xe0, 0x0b, 0x76, 0x17, 0x5e, 0x07, 0xdc, 0x00, 
+0xa7, 0x00, 0x92, 0x13, 0x05, 0x13, 0x67, 0x07, 0x8b, 0x11, 0x1f, 0x01, 
+0x64, 0x0c, 0x38, 0x0a, 0xcc, 0x0b, 0xdb, 0x0a, 0xc5, 0x00, 0x3e, 0x0c, 
+0xe9, 0x09, 0x95, 0x07, 0x78, 0x0a, 0xa1, 0x0a, 0x40, 0x01, 0xba, 0x00, 
+0xea, 0x00, 0xa7, 0x08, 0x34, 0x12, 0x6d, 0x00, 0x8e, 0x0a, 0x83, 0x11, 
+0xff, 0x09, 0x80, 0x0c, 0xaf, 0x11, 0x54, 0x11, 0x88, 0x17, 0x23, 0x00, 
+0x8c, 0x0b, 0x0b, 0x0a, 0x27, 0x07, 0x25, 0x00, 0x2b, 0x0a, 0x52, 0x07, 
+0xfd, 0x16, 0x68, 0x0a, 0xc4, 0x0a, 0xa7, 0x0a, 0xfb, 0x15, 0x33, 0x14, 
+0x05, 0x01, 0x1c, 0x01, 0x4c, 0x0a, 0x86, 0x0e, 0xce, 0x18, 0x40, 0x0a, 
+0x7d, 0x08, 0x63, 0x00, 0x15, 0x13, 0xbb, 0x08, 0xd9, 0x00, 0xb2, 0x08, 
+0xd9, 0x0a, 0x4e, 0x0c, 0xc0, 0x0e, 0x3f, 0x01, 0x07, 0x01, 0x12, 0x0b, 
+0x05, 0x0b, 0x6c, 0x0b, 0xde, 0x0d, 0x00, 0x0d, 0x42, 0x06, 0xd6, 0x00, 
+0x0b, 0x01, 0x5d, 0x07, 0x1a, 0x0f, 0x80, 0x09, 0x0f, 0x01, 0x2f, 0x00, 
+0x7e, 0x0c, 0x44, 0x06, 0xda, 0x06, 0xb7, 0x08, 0x2a, 0x01, 0xb9, 0x0b, 
+0x66, 0x18, 0x27, 0x07, 0xf7, 0x00, 0xe5, 0x0a, 0x52, 0x17, 0x2a, 0x01, 
+0xe8, 0x0c, 0xf2, 0x00, 0x7d, 0x16, 0xa2, 0x15, 0x22, 0x01, 0xf3, 0x0c, 
+0xe1, 0x0c, 0x62, 0x00, 0x65, 0x00, 0x6e, 0x12, 0xaf, 0x00, 0xda, 0x16, 
+0x90, 0x06, 0xd3, 0x09, 0xa4, 0x15, 0x47, 0x0b, 0xf5, 0x00, 0x12, 0x01, 
+0xfe, 0x00, 0xe6, 0x00, 0x64, 0x0c, 0xd8, 0x19, 0x0c, 0x01, 0xd2, 0x0c, 
+0x02, 0x01, 0x17, 0x0c, 0x49, 0x06, 0x59, 0x00, 0x27, 0x01, 0xaa, 0x00, 
+0xbd, 0x00, 0x40, 0x0a, 0x32, 0x01, 0x5c, 0x0b, 0xd8, 0x0a, 0x53, 0x00, 
+0xb1, 0x10, 0x78, 0x09, 0xaa, 0x16, 0x62, 0x17, 0xfd, 0x09, 0x16, 0x01, 
+0x46, 0x0b, 0xd0, 0x0b, 0x05, 0x01, 0x3f, 0x0a, 0x3f, 0x09, 0xa1, 0x0a, 
+0x64, 0x00, 0x77, 0x00, 0xfb, 0x00, 0x0a, 0x0d, 0xa1, 0x07, 0xb7, 0x0b, 
+0x55, 0x0a, 0x99, 0x0d, 0x36, 0x01, 0x21, 0x01, 0x37, 0x01, 0x71, 0x0a, 
+0x8a, 0x0b, 0xe4, 0x07, 0xb5, 0x0b, 0x72, 0x10, 0xc8, 0x0c, 0x16, 0x0a, 
+0x60, 0x18, 0x72, 0x08, 0x5d, 0x11, 0x4e, 0x0c, 0x36, 0x07, 0x5a, 0x08, 
+0x22, 0x0c, 0xd7, 0x0b, 0xf5, 0x00, 0xe6, 0x00, 0x76, 0x13, 0x1f, 0x01, 
+0xa2, 0x0a, 0xc8, 0x0c, 0x2d, 0x0c, 0xb6, 0x00, 0x25, 0x07, 0xd0, 0x00, 
+0xbe, 0x00, 0x3c, 0x0c, 0x2d, 0x08, 0x39, 0x0c, 0xba, 0x1b, 0x8f, 0x00, 
+0xed, 0x00, 0xbe, 0x0a, 0xfe, 0x00, 0xc7, 0x0c, 0xc7, 0x00, 0xc9, 0x00, 
+0x61, 0x00, 0xee, 0x13, 0x1c, 0x10, 0x82, 0x00, 0xcc, 0x0b, 0x3f, 0x0a, 
+0x41, 0x01, 0x21, 0x01, 0xd4, 0x00, 0xcb, 0x00, 0x77, 0x16, 0xa7, 0x10, 
+0xb8, 0x00, 0xd7, 0x0c, 0x58, 0x14, 0xd1, 0x18, 0x53, 0x07, 0xaf, 0x00, 
+0x60, 0x00, 0xe8, 0x00, 0xd3, 0x00, 0x49, 0x00, 0x6c, 0x0b, 0xe4, 0x00, 
+0xea, 0x13, 0x59, 0x06, 0x0b, 0x01, 0x2b, 0x12, 0x3e, 0x01, 0x40, 0x06, 
+0x4c, 0x11, 0xb5, 0x12, 0x40, 0x01, 0x0c, 0x0a, 0x0c, 0x00, 0x06, 0x0b, 
+0xea, 0x09, 0x4b, 0x12, 0x47, 0x00, 0xeb, 0x00, 0x57, 0x13, 0xb8, 0x00, 
+0xd1, 0x0b, 0x44, 0x06, 0x5e, 0x12, 0x96, 0x0a, 0xd3, 0x0a, 0x1c, 0x0c, 
+0xb5, 0x17, 0x31, 0x16, 0xb8, 0x0b, 0xdf, 0x18, 0xb6, 0x0c, 0x60, 0x1b, 
+0xb6, 0x11, 0xa7, 0x08, 0x66, 0x1b, 0x90, 0x0a, 0x73, 0x0c, 0x6e, 0x09, 
+0xf6, 0x0b, 0xa4, 0x12, 0x1b, 0x08, 0x15, 0x01, 0x56, 0x06, 0x93, 0x0b, 
+0x47, 0x06, 0x5e, 0x11, 0x92, 0x0f, 0x77, 0x0a, 0xee, 0x11, 0xd9, 0x18, 
+0x39, 0x01, 0xf1, 0x0b, 0x05, 0x0b, 0x0a, 0x01, 0x01, 0x0d, 0x8c, 0x0b, 
+0xb2, 0x00, 0x44, 0x06, 0x7a, 0x00, 0x67, 0x12, 0x1e, 0x0c, 0x37, 0x01, 
+0xcb, 0x0b, 0x93, 0x0b, 0x9f, 0x0b, 0xaf, 0x09, 0xcd, 0x0a, 0x0e, 0x00, 
+0xba, 0x09, 0xa7, 0x0c, 0xf6, 0x0e, 0xfc, 0x00, 0x8c, 0x06, 0x88, 0x17, 
+0x3b, 0x01, 0x4e, 0x0d, 0x46, 0x06, 0x23, 0x00, 0x0f, 0x01, 0x29, 0x0b, 
+0x28, 0x0b, 0x03, 0x11, 0xe7, 0x00, 0x24, 0x01, 0xfd, 0x09, 0x54, 0x0a, 
+0xa9, 0x16, 0xa0, 0x08, 0xa1, 0x0b, 0x0b, 0x0a, 0x63, 0x09, 0xac, 0x00, 
+0x2c, 0x01, 0xcd, 0x15, 0xaf, 0x00, 0x47, 0x06, 0x47, 0x0c, 0x9c, 0x00, 
+0x59, 0x0c, 0xb2, 0x0c, 0xb8, 0x00, 0x33, 0x01, 0xc9, 0x0a, 0x96, 0x0b, 
+0x53, 0x00, 0x32, 0x00, 0x25, 0x08, 0xd7, 0x0b, 0x18, 0x01, 0x25, 0x00, 
+0x6b, 0x0a, 0x56, 0x0a, 0x62, 0x00, 0x46, 0x06, 0xe5, 0x0d, 0x55, 0x00, 
+0xf4, 0x00, 0xe9, 0x08, 0x30, 0x01, 0xde, 0x16, 0x36, 0x0b, 0x5d, 0x0b, 
+0xf2, 0x14, 0xea, 0x0c, 0x2c, 0x01, 0x43, 0x0c, 0x7b, 0x10, 0x3f, 0x01, 
+0x79, 0x00, 0x71, 0x00, 0x79, 0x08, 0xc6, 0x00, 0x20, 0x0a, 0xa4, 0x0d, 
+0x17, 0x0c, 0x44, 0x06, 0x48, 0x0e, 0x88, 0x09, 0xaa, 0x00, 0xe9, 0x13, 
+0xc2, 0x16, 0x56, 0x06, 0xaa, 0x0d, 0xc8, 0x0c, 0x31, 0x01, 0xa8, 0x00, 
+0x19, 0x00, 0xdc, 0x00, 0x5a, 0x00, 0xbd, 0x00, 0xbb, 0x0a, 0x30, 0x0b, 
+0x5e, 0x07, 0x9f, 0x1b, 0x47, 0x0d, 0xcf, 0x00, 0x2d, 0x0a, 0x10, 0x07, 
+0x65, 0x11, 0x05, 0x01, 0xdc, 0x06, 0xb4, 0x1b, 0x63, 0x00, 0xe4, 0x00, 
+0x2e, 0x08, 0xcd, 0x0a, 0x4b, 0x11, 0x3d, 0x01, 0xb2, 0x15, 0x0b, 0x00, 
+0x14, 0x0a, 0x26, 0x15, 0x0f, 0x01, 0xb7, 0x0a, 0xb7, 0x0a, 0xda, 0x00, 
+0xf6, 0x13, 0x75, 0x0a, 0xfd, 0x13, 0x77, 0x00, 0x65, 0x08, 0x4f, 0x0a, 
+0x7c, 0x0c, 0x60, 0x18, 0x1b, 0x07, 0x3c, 0x0b, 0x24, 0x00, 0x4b, 0x0a, 
+0x3b, 0x00, 0x3c, 0x0c, 0xd3, 0x0c, 0xf6, 0x09, 0xbc, 0x08, 0xb1, 0x0c, 
+0x8c, 0x0a, 0x3f, 0x0e, 0x37, 0x01, 0x00, 0x01, 0x22, 0x0d, 0xef, 0x00, 
+0x0a, 0x01, 0xf7, 0x00, 0xad, 0x13, 0x93, 0x0f, 0xf5, 0x0c, 0x9c, 0x0a, 
+0x10, 0x0b, 0x20, 0x01, 0x95, 0x0c, 0xce, 0x0a, 0xfd, 0x15, 0x41, 0x0d, 
+0x81, 0x11, 0x79, 0x07, 0x74, 0x14, 0x72, 0x0c, 0x0e, 0x0c, 0xb0, 0x09, 
+0xd4, 0x06, 0xba, 0x08, 0xa7, 0x0a, 0x78, 0x0a, 0xe3, 0x0a, 0x71, 0x13, 
+0x54, 0x0c, 0x72, 0x10, 0x02, 0x01, 0x9d, 0x17, 0x2b, 0x01, 0xf3, 0x00, 
+0xf6, 0x0e, 0xa7, 0x13, 0x00, 0x01, 0xe0, 0x09, 0xaf, 0x10, 0xfc, 0x00, 
+0xf3, 0x0a, 0x12, 0x0d, 0x6e, 0x09, 0x5a, 0x0f, 0xc1, 0x00, 0xb3, 0x00, 
+0x3e, 0x0d, 0xb7, 0x00, 0xa9, 0x00, 0xf7, 0x00, 0xd0, 0x00, 0x03, 0x01, 
+0x19, 0x11, 0x7e, 0x0b, 0x12, 0x07, 0x7b, 0x0c, 0x13, 0x0d, 0x97, 0x06, 
+0xf4, 0x07, 0xae, 0x0a, 0xd0, 0x00, 0xcd, 0x00, 0x47, 0x06, 0xfc, 0x0a, 
+0x3d, 0x0c, 0x0c, 0x17, 0x9b, 0x00, 0xf6, 0x0b, 0x12, 0x13, 0xe5, 0x0d, 
+0xf6, 0x00, 0xd7, 0x00, 0xe1, 0x0b, 0xc4, 0x0e, 0x7a, 0x0a, 0xd8, 0x17, 
+0x1f, 0x0b, 0x60, 0x06, 0x24, 0x00, 0xe1, 0x10, 0x2b, 0x01, 0x1a, 0x0e, 
+0x7e, 0x0c, 0x63, 0x0e, 0xd6, 0x07, 0x90, 0x00, 0xc2, 0x0a, 0x32, 0x01, 
+0x06, 0x01, 0x7d, 0x0b, 0x12, 0x01, 0x93, 0x0f, 0xdc, 0x00, 0x94, 0x0b, 
+0xca, 0x0c, 0xd3, 0x09, 0xd5, 0x0b, 0x32, 0x0c, 0x0f, 0x01, 0xbc, 0x09, 
+0xe2, 0x07, 0x30, 0x01, 0x9c, 0x0f, 0xb3, 0x06, 0xb7, 0x00, 0xdd, 0x00, 
+0x24, 0x0f, 0x22, 0x09, 0xbc, 0x00, 0x24, 0x00, 0x60, 0x07, 0xe1, 0x00, 
+0xbd, 0x10, 0x44, 0x0c, 0xf7, 0x08, 0x62, 0x09, 0xdd, 0x17, 0xa7, 0x09, 
+0xd5, 0x00, 0x17, 0x14, 0x8c, 0x0a, 0x35, 0x0d, 0xfe, 0x09, 0x28, 0x07, 
+0x70, 0x0e, 0x17, 0x0c, 0x71, 0x0d, 0x41, 0x0a, 0xde, 0x08, 0xf1, 0x00, 
+0xdf, 0x09, 0x25, 0x01, 0x39, 0x12, 0x54, 0x00, 0xb9, 0x0b, 0xd2, 0x0f, 
+0x4b, 0x06, 0x23, 0x00, 0xc2, 0x00, 0xd9, 0x0a, 0x2d, 0x11, 0x67, 0x0b, 
+0x14, 0x01, 0xc5, 0x00, 0xfe, 0x00, 0xd4, 0x0a, 0x43, 0x13, 0xe3, 0x06, 
+0x89, 0x0b, 0xbe, 0x0c, 0xdc, 0x09, 0x56, 0x0a, 0x32, 0x00, 0xd3, 0x0c, 
+0xf6, 0x0b, 0xa1, 0x0a, 0xce, 0x15, 0xf1, 0x00, 0x14, 0x01, 0xa6, 0x09, 
+0x18, 0x01, 0xc8, 0x0c, 0xa7, 0x09, 0xf3, 0x00, 0x31, 0x0b, 0x43, 0x13, 
+0x45, 0x06, 0xf7, 0x0a, 0x4c, 0x00, 0x2e, 0x07, 0x3c, 0x0b, 0x22, 0x01, 
+0x3b, 0x00, 0x1d, 0x00, 0xff, 0x0c, 0xa8, 0x09, 0x43, 0x12, 0x09, 0x0d, 
+0x65, 0x15, 0x57, 0x13, 0x95, 0x07, 0x17, 0x01, 0xa9, 0x09, 0x3a, 0x0c, 
+0xea, 0x00, 0x47, 0x0c, 0x5f, 0x00, 0x62, 0x0e, 0x96, 0x07, 0x91, 0x00, 
+0xd4, 0x09, 0xbb, 0x09, 0x63, 0x0e, 0xf6, 0x0c, 0xd0, 0x06, 0xd7, 0x16, 
+0x49, 0x0c, 0xad, 0x0a, 0xd2, 0x00, 0x31, 0x00, 0x80, 0x0b, 0xb2, 0x07, 
+0xeb, 0x00, 0xa2, 0x0a, 0x62, 0x0a, 0x2b, 0x01, 0x1a, 0x0d, 0x31, 0x01, 
+0xef, 0x00, 0xa1, 0x0a, 0xc4, 0x00, 0xd0, 0x06, 0xab, 0x00, 0x3c, 0x0b, 
+0x9b, 0x00, 0xd6, 0x18, 0x94, 0x0b, 0xfc, 0x09, 0xb7, 0x0a, 0x86, 0x00, 
+0x47, 0x06, 0x1e, 0x0b, 0x4b, 0x06, 0xc3, 0x0b, 0x24, 0x00, 0x82, 0x10, 
+0x6d, 0x00, 0xec, 0x00, 0xde, 0x00, 0x67, 0x07, 0xb9, 0x0a, 0x6f, 0x10, 
+0x92, 0x13, 0x6e, 0x12, 0xd1, 0x00, 0x4e, 0x0b, 0xb5, 0x12, 0x56, 0x1a, 
+0xf4, 0x00, 0xb4, 0x0b, 0x44, 0x06, 0xd4, 0x0c, 0x38, 0x01, 0x0c, 0x0c, 
+0x66, 0x0c, 0xa3, 0x0b, 0x75, 0x13, 0x3b, 0x01, 0xa4, 0x09, 0x2a, 0x0f, 
+0x1f, 0x01, 0xd7, 0x0b, 0x56, 0x1a, 0xe5, 0x00, 0x9c, 0x08, 0x17, 0x1a, 
+0x41, 0x0f, 0x67, 0x0c, 0x30, 0x0c, 0x18, 0x00, 0x9d, 0x0f, 0x2c, 0x0e, 
+0xbe, 0x15, 0x59, 0x17, 0x8b, 0x11, 0x11, 0x0b, 0x64, 0x17, 0x37, 0x01, 
+0x48, 0x00, 0x85, 0x00, 0x90, 0x00, 0xf0, 0x0b, 0xdd, 0x00, 0xa9, 0x0b, 
+0x8f, 0x17, 0x65, 0x15, 0x81, 0x18, 0xb1, 0x09, 0x48, 0x07, 0xf9, 0x0a, 
+0xb1, 0x00, 0x5e, 0x0b, 0xca, 0x0b, 0xb4, 0x0b, 0x04, 0x0d, 0x86, 0x17, 
+0x62, 0x0a, 0xc1, 0x17, 0x4c, 0x0f, 0x67, 0x07, 0xd8, 0x00, 0x20, 0x01, 
+0x14, 0x0b, 0xab, 0x0c, 0x32, 0x00, 0xe2, 0x00, 0x42, 0x0a, 0x10, 0x01, 
+0x66, 0x0c, 0xc3, 0x0a, 0xe4, 0x0b, 0x20, 0x01, 0x86, 0x0d, 0x18, 0x0c, 
+0xcd, 0x00, 0x18, 0x01, 0xc8, 0x0a, 0xb4, 0x0b, 0xcf, 0x15, 0xdb, 0x0d, 
+0xdc, 0x09, 0xa8, 0x00, 0x90, 0x00, 0x6f, 0x0c, 0xeb, 0x00, 0x8b, 0x0b, 
+0x23, 0x00, 0xb7, 0x10, 0x18, 0x14, 0x75, 0x0b, 0x06, 0x16, 0x0f, 0x00, 
+0xb0, 0x00, 0x90, 0x00, 0xaf, 0x0a, 0xff, 0x00, 0xf8, 0x0b, 0x0d, 0x01, 
+0x46, 0x0d, 0x9e, 0x0b, 0xea, 0x00, 0x8e, 0x16, 0x22, 0x0c, 0x82, 0x0a, 
+0xdc, 0x0d, 0xda, 0x0b, 0xa8, 0x00, 0x72, 0x0c, 0x55, 0x11, 0xfe, 0x0b, 
+0x6e, 0x00, 0x99, 0x0a, 0x88, 0x00, 0x33, 0x0a, 0x3a, 0x13, 0x67, 0x0b, 
+0x28, 0x15, 0xdd, 0x18, 0xc1, 0x09, 0x48, 0x06, 0xeb, 0x00, 0xc3, 0x0a, 
+0x35, 0x12, 0x6a, 0x0a, 0x1f, 0x08, 0xa8, 0x10, 0x2f, 0x00, 0xca, 0x0f, 
+0x66, 0x14, 0xad, 0x00, 0xbb, 0x14, 0xe4, 0x08, 0xb6, 0x00, 0x18, 0x00, 
+0xb0, 0x00, 0x06, 0x01, 0xe1, 0x15, 0x96, 0x0b, 0xa8, 0x00, 0x2f, 0x01, 
+0x97, 0x06, 0x3c, 0x10, 0x81, 0x10, 0x14, 0x0e, 0x23, 0x00, 0x94, 0x07, 
+0xde, 0x08, 0xd0, 0x00, 0x66, 0x13, 0xce, 0x0a, 0xbd, 0x0c, 0xef, 0x0b, 
+0x37, 0x01, 0xbe, 0x0c, 0xc1, 0x00, 0x5c, 0x08, 0xb3, 0x00, 0xa9, 0x0c, 
+0xe5, 0x15, 0x07, 0x18, 0xf2, 0x09, 0x1c, 0x0e, 0x97, 0x0c, 0x11, 0x07, 
+0x1b, 0x10, 0xa6, 0x0c, 0x48, 0x06, 0xa9, 0x16, 0x03, 0x16, 0x0f, 0x0b, 
+0xb4, 0x06, 0x17, 0x0b, 0x30, 0x01, 0x68, 0x06, 0x23, 0x0a, 0xbc, 0x00, 
+0xaa, 0x00, 0x35, 0x09, 0xe8, 0x0c, 0xf8, 0x0e, 0x1e, 0x16, 0x62, 0x17, 
+0x40, 0x01, 0x4d, 0x06, 0xc4, 0x0a, 0x09, 0x0b, 0xb1, 0x0c, 0x86, 0x00, 
+0xa0, 0x00, 0x07, 0x0b, 0x19, 0x01, 0xd8, 0x0b, 0xc4, 0x0e, 0x2b, 0x01, 
+0x75, 0x16, 0x75, 0x0a, 0xd4, 0x00, 0x43, 0x00, 0x54, 0x00, 0x31, 0x0b, 
+0xd1, 0x09, 0xef, 0x0b, 0xb2, 0x09, 0x8e, 0x1a, 0xc3, 0x07, 0xdb, 0x09, 
+0xbc, 0x09, 0xdb, 0x09, 0x3b, 0x01, 0x83, 0x0a, 0x86, 0x0a, 0x05, 0x01, 
+0xe2, 0x0b, 0x10, 0x0c, 0x85, 0x17, 0x2f, 0x00, 0x67, 0x08, 0x83, 0x00, 
+0x37, 0x0a, 0x51, 0x06, 0x5a, 0x0c, 0xc9, 0x00, 0x2b, 0x0b, 0x06, 0x0b, 
+0xe7, 0x0b, 0x15, 0x01, 0xba, 0x16, 0x35, 0x00, 0xff, 0x00, 0x92, 0x11, 
+0xce, 0x00, 0xe4, 0x0c, 0x13, 0x0e, 0x46, 0x0d, 0x31, 0x0b, 0x95, 0x07, 
+0xfe, 0x09, 0xb1, 0x11, 0x04, 0x0c, 0x0c, 0x01, 0x5f, 0x07, 0x79, 0x09, 
+0xc8, 0x00, 0x1f, 0x01, 0xe5, 0x0d, 0x21, 0x0a, 0x28, 0x0a, 0xd3, 0x0a, 
+0x2f, 0x0c, 0x0f, 0x01, 0xcd, 0x14, 0x6c, 0x0a, 0x47, 0x1a, 0xa9, 0x15, 
+0xb1, 0x0c, 0x20, 0x01, 0xb4, 0x09, 0x64, 0x0c, 0x8e, 0x06, 0x5f, 0x12, 
+0x27, 0x07, 0xc1, 0x0c, 0x67, 0x0b, 0xf6, 0x07, 0x12, 0x00, 0xed, 0x0b, 
+0x08, 0x01, 0x26, 0x01, 0x2c, 0x10, 0x47, 0x0e, 0x95, 0x0b, 0x87, 0x00, 
+0x56, 0x0b, 0xc5, 0x00, 0xf4, 0x0c, 0xca, 0x0b, 0x57, 0x00, 0xa2, 0x07, 
+0xdb, 0x0b, 0xb7, 0x0e, 0xb6, 0x00, 0x14, 0x01, 0xd4, 0x00, 0x35, 0x01, 
+0xb3, 0x0c, 0x7f, 0x00, 0xfb, 0x00, 0x22, 0x0c, 0x32, 0x0b, 0x3e, 0x08, 
+0x1b, 0x08, 0xaa, 0x0c, 0xbd, 0x11, 0x93, 0x0f, 0xd6, 0x09, 0x71, 0x0c, 
+0x48, 0x06, 0xf5, 0x13, 0xef, 0x0b, 0xf4, 0x08, 0x3a, 0x18, 0x67, 0x0b, 
+0x22, 0x09, 0x24, 0x08, 0x66, 0x0c, 0xa1, 0x06, 0x88, 0x14, 0xf5, 0x0c, 
+0x36, 0x01, 0x0d, 0x01, 0x3d, 0x0b, 0xda, 0x00, 0xb6, 0x11, 0xcd, 0x15, 
+0xe5, 0x0a, 0x8c, 0x06, 0x3a, 0x09, 0x88, 0x08, 0x48, 0x06, 0x0b, 0x09, 
+0x4d, 0x0a, 0xfd, 0x10, 0x7a, 0x00, 0x5c, 0x1a, 0x80, 0x0b, 0xed, 0x0c, 
+0x58, 0x00, 0x83, 0x00, 0x86, 0x0d, 0x06, 0x01, 0xde, 0x15, 0x52, 0x0c, 
+0x19, 0x01, 0xad, 0x07, 0x32, 0x0c, 0x37, 0x01, 0x91, 0x0c, 0x53, 0x0e, 
+0xc5, 0x00, 0x71, 0x0d, 0xba, 0x07, 0x12, 0x01, 0x4a, 0x14, 0xfc, 0x15, 
+0x9b, 0x0c, 0x78, 0x0d, 0x6e, 0x10, 0x89, 0x0b, 0x39, 0x0c, 0x6d, 0x09, 
+0xc0, 0x00, 0x81, 0x10, 0xac, 0x00, 0x8a, 0x11, 0x75, 0x0a, 0x5b, 0x08, 
+0x8a, 0x11, 0xa2, 0x0a, 0xa2, 0x0a, 0x54, 0x17, 0xb9, 0x00, 0x34, 0x01, 
+0x1d, 0x0a, 0x46, 0x06, 0x68, 0x0b, 0xbe, 0x19, 0x94, 0x0f, 0x53, 0x13, 
+0x1d, 0x07, 0x53, 0x00, 0x0f, 0x01, 0x62, 0x09, 0xa2, 0x15, 0x81, 0x0a, 
+0x0f, 0x01, 0xfb, 0x0a, 0x40, 0x06, 0xe2, 0x0b, 0xe7, 0x0b, 0x9b, 0x0c, 
+0xd5, 0x00, 0xca, 0x00, 0xd2, 0x0c, 0x7d, 0x16, 0x06, 0x14, 0x07, 0x0a, 
+0x56, 0x18, 0x28, 0x19, 0x60, 0x00, 0x23, 0x19, 0x8b, 0x13, 0x9c, 0x00, 
+0x0a, 0x01, 0x73, 0x00, 0xd2, 0x0c, 0xed, 0x0b, 0xab, 0x00, 0xf5, 0x00, 
+0xfa, 0x0a, 0xdd, 0x00, 0x2b, 0x01, 0xfa, 0x15, 0x04, 0x09, 0xb2, 0x11, 
+0x4a, 0x0c, 0xb7, 0x0b, 0xf4, 0x14, 0x20, 0x14, 0xa8, 0x08, 0x1a, 0x0d, 
+0x29, 0x0c, 0x95, 0x07, 0x64, 0x09, 0x7d, 0x16, 0x54, 0x07, 0xe7, 0x09, 
+0xdc, 0x14, 0x47, 0x00, 0xcf, 0x06, 0xf8, 0x00, 0xea, 0x0c, 0xca, 0x0f, 
+0x18, 0x08, 0xfd, 0x08, 0xf3, 0x00, 0x53, 0x12, 0x0d, 0x00, 0xac, 0x00, 
+0xe5, 0x0b, 0xb3, 0x07, 0x92, 0x00, 0x11, 0x01, 0x22, 0x14, 0xe1, 0x00, 
+0x07, 0x0a, 0x5d, 0x13, 0x02, 0x17, 0x5e, 0x18, 0xac, 0x00, 0x74, 0x16, 
+0x9d, 0x08, 0x77, 0x1c, 0x69, 0x17, 0xe1, 0x00, 0x81, 0x09, 0x46, 0x06, 
+0xaa, 0x0b, 0x7a, 0x10, 0x7b, 0x0c, 0x9b, 0x0c, 0x54, 0x0a, 0x34, 0x0a, 
+0x2f, 0x01, 0x64, 0x0a, 0x84, 0x00, 0xd9, 0x0a, 0xe3, 0x0a, 0xac, 0x00, 
+0x23, 0x00, 0x8a, 0x00, 0x93, 0x06, 0x8c, 0x06, 0xa1, 0x08, 0xe5, 0x0b, 
+0xe5, 0x00, 0x43, 0x14, 0x2b, 0x0e, 0x30, 0x01, 0x2d, 0x01, 0xc1, 0x00, 
+0xdc, 0x09, 0x94, 0x0f, 0x87, 0x0c, 0xbf, 0x0b, 0xfd, 0x00, 0x49, 0x0b, 
+0x52, 0x0c, 0x21, 0x01, 0x2a, 0x01, 0xff, 0x09, 0x3c, 0x01, 0xf4, 0x00, 
+0x08, 0x01, 0xc1, 0x0b, 0xe8, 0x00, 0xa9, 0x07, 0x8a, 0x0b, 0x7f, 0x0c, 
+0xba, 0x14, 0x44, 0x0c, 0x96, 0x15, 0x28, 0x0a, 0x37, 0x01, 0x98, 0x0e, 
+0x47, 0x00, 0xf9, 0x0a, 0x41, 0x01, 0x66, 0x1b, 0x1c, 0x0b, 0xcf, 0x0a, 
+0x89, 0x0b, 0x65, 0x06, 0x25, 0x16, 0x10, 0x19, 0xd6, 0x07, 0x46, 0x13, 
+0xff, 0x00, 0xb7, 0x00, 0x86, 0x00, 0x21, 0x14, 0x2a, 0x0a, 0x3c, 0x0b, 
+0xe8, 0x00, 0x47, 0x00, 0x00, 0x09, 0x45, 0x06, 0x40, 0x01, 0xd9, 0x0a, 
+0x79, 0x00, 0x26, 0x01, 0x4b, 0x06, 0x9e, 0x0c, 0xe3, 0x00, 0x3c, 0x01, 
+0xa0, 0x0b, 0x5a, 0x08, 0xe7, 0x0d, 0xb7, 0x00, 0xa8, 0x0a, 0xff, 0x00, 
+0xb0, 0x00, 0xa5, 0x09, 0x7e, 0x00, 0x65, 0x08, 0x3b, 0x01, 0x23, 0x01, 
+0x0f, 0x00, 0x28, 0x0a, 0x7c, 0x08, 0xda, 0x0b, 0xeb, 0x00, 0x3f, 0x0b, 
+0x46, 0x0e, 0xd1, 0x12, 0x7e, 0x0e, 0x5e, 0x18, 0x80, 0x0a, 0x39, 0x0c, 
+0xdc, 0x00, 0xf1, 0x13, 0x0c, 0x13, 0x3e, 0x0a, 0xc9, 0x00, 0x59, 0x0a, 
+0xa7, 0x00, 0x06, 0x13, 0xe9, 0x00, 0x8a, 0x0b, 0x8c, 0x13, 0xbd, 0x00, 
+0x3b, 0x01, 0xe0, 0x0c, 0x6a, 0x0a, 0xb7, 0x0e, 0x1c, 0x0b, 0x29, 0x0a, 
+0x29, 0x0b, 0xf5, 0x00, 0x60, 0x0a, 0x2a, 0x0a, 0xb4, 0x0b, 0x60, 0x00, 
+0x49, 0x0c, 0x67, 0x11, 0xc0, 0x00, 0x35, 0x00, 0xf8, 0x0b, 0xa7, 0x0f, 
+0x4a, 0x00, 0xfe, 0x0e, 0xf3, 0x0c, 0xcc, 0x00, 0x51, 0x13, 0x15, 0x14, 
+0xce, 0x1a, 0x90, 0x0c, 0xef, 0x08, 0x2d, 0x09, 0x8d, 0x06, 0x4a, 0x11, 
+0x2a, 0x0a, 0x01, 0x0c, 0x9d, 0x18, 0x57, 0x0b, 0xe8, 0x00, 0x16, 0x14, 
+0x2b, 0x1a, 0x14, 0x01, 0x28, 0x19, 0x6a, 0x0a, 0xdb, 0x10, 0xc1, 0x00, 
+0x11, 0x0b, 0x28, 0x01, 0xc5, 0x0a, 0xc6, 0x00, 0x13, 0x0b, 0x22, 0x0e, 
+0xe6, 0x09, 0x2f, 0x0c, 0x96, 0x07, 0xa9, 0x08, 0xfa, 0x00, 0xff, 0x0c, 
+0x29, 0x00, 0x82, 0x0a, 0x60, 0x0b, 0x10, 0x00, 0x00, 0x01, 0xc9, 0x00, 
+0xdc, 0x0b, 0x0e, 0x19, 0xde, 0x00, 0x4f, 0x16, 0x56, 0x18, 0x23, 0x0e, 
+0x43, 0x06, 0xff, 0x00, 0x71, 0x0d, 0xdf, 0x09, 0xf1, 0x14, 0x29, 0x08, 
+0x02, 0x15, 0x65, 0x0e, 0xdc, 0x09, 0x02, 0x0c, 0xb1, 0x1a, 0x8b, 0x0b, 
+0xc5, 0x0a, 0x27, 0x0b, 0x1b, 0x0c, 0x40, 0x0a, 0x2f, 0x08, 0xb2, 0x00, 
+0x43, 0x0c, 0x4c, 0x06, 0x3d, 0x01, 0x1d, 0x07, 0x63, 0x17, 0x25, 0x08, 
+0x30, 0x00, 0x1e, 0x0b, 0x7e, 0x0e, 0xbd, 0x09, 0x03, 0x01, 0xb9, 0x00, 
+0x3b, 0x0b, 0xb7, 0x0e, 0xfc, 0x0d, 0xe8, 0x13, 0xa3, 0x0a, 0x7a, 0x18, 
+0x03, 0x01, 0xa7, 0x08, 0x57, 0x18, 0x09, 0x13, 0x3c, 0x01, 0xde, 0x08, 
+0x57, 0x1a, 0x1a, 0x0f, 0x08, 0x01, 0x6f, 0x0c, 0x6d, 0x09, 0x13, 0x0b, 
+0x3c, 0x01, 0x66, 0x1b, 0x54, 0x0a, 0x4c, 0x11, 0x18, 0x08, 0xab, 0x0b, 
+0xcf, 0x15, 0x9c, 0x00, 0x28, 0x19, 0x79, 0x0a, 0x56, 0x07, 0xc5, 0x06, 
+0x01, 0x0a, 0xea, 0x00, 0x2d, 0x09, 0xd1, 0x0e, 0xcf, 0x1a, 0x95, 0x07, 
+0x29, 0x01, 0x18, 0x08, 0x05, 0x01, 0xef, 0x0e, 0x91, 0x17, 0x57, 0x0a, 
+0x99, 0x0a, 0x9f, 0x16, 0xbd, 0x00, 0x2d, 0x0c, 0x8f, 0x09, 0xbb, 0x07, 
+0x66, 0x08, 0x3b, 0x13, 0xc5, 0x00, 0xad, 0x0c, 0x95, 0x0b, 0xb7, 0x00, 
+0x77, 0x00, 0x3b, 0x01, 0x87, 0x08, 0x0b, 0x0a, 0x06, 0x0b, 0x6b, 0x00, 
+0x44, 0x0c, 0x4d, 0x00, 0xe3, 0x17, 0xa1, 0x00, 0xa5, 0x06, 0xde, 0x08, 
+0x98, 0x18, 0xc1, 0x0e, 0x0d, 0x0a, 0x26, 0x14, 0x09, 0x0d, 0x31, 0x01, 
+0xe1, 0x13, 0xda, 0x0b, 0xd9, 0x06, 0x78, 0x09, 0xf6, 0x0c, 0x86, 0x0a, 
+0xfe, 0x0c, 0x98, 0x0c, 0xd7, 0x16, 0x1e, 0x13, 0x94, 0x0b, 0xe4, 0x0b, 
+0x8e, 0x0a, 0x97, 0x15, 0x57, 0x00, 0xc9, 0x00, 0x1c, 0x0b, 0x25, 0x08, 
+0xb6, 0x0b, 0xde, 0x13, 0xff, 0x08, 0x31, 0x0b, 0x25, 0x07, 0x5d, 0x11, 
+0xf3, 0x00, 0xde, 0x00, 0xb0, 0x0a, 0x14, 0x01, 0x0c, 0x14, 0x27, 0x0c, 
+0x5c, 0x0b, 0xb4, 0x00, 0x2c, 0x01, 0xda, 0x00, 0xab, 0x0c, 0x07, 0x01, 
+0x01, 0x01, 0xe5, 0x0b, 0xfb, 0x00, 0x55, 0x0a, 0xec, 0x0b, 0x49, 0x00, 
+0xc8, 0x00, 0x72, 0x0b, 0xb2, 0x07, 0x3d, 0x0c, 0x34, 0x01, 0x1a, 0x01, 
+0x6a, 0x0e, 0x37, 0x1c, 0xc6, 0x09, 0x41, 0x00, 0xc8, 0x10, 0x48, 0x06, 
+0x98, 0x0a, 0x19, 0x14, 0x4d, 0x06, 0x01, 0x01, 0x0f, 0x01, 0xa3, 0x0e, 
+0x95, 0x07, 0x45, 0x0c, 0x6f, 0x0c, 0x19, 0x13, 0x15, 0x01, 0x6e, 0x10, 
+0x2f, 0x00, 0x0f, 0x00, 0x75, 0x12, 0x60, 0x00, 0x1c, 0x0f, 0x47, 0x0b, 
+0xc3, 0x0b, 0xc9, 0x0e, 0x24, 0x0e, 0x15, 0x13, 0x80, 0x0b, 0x4f, 0x0c, 
+0x8b, 0x0b, 0x81, 0x18, 0x40, 0x00, 0x59, 0x0a, 0x28, 0x07, 0xd3, 0x0c, 
+0x21, 0x10, 0xec, 0x0b, 0x65, 0x0a, 0x48, 0x06, 0xe0, 0x15, 0x9c, 0x00, 
+0xbd, 0x09, 0xb4, 0x09, 0x88, 0x16, 0x22, 0x0c, 0xf5, 0x00, 0x30, 0x00, 
+0xac, 0x0b, 0xcb, 0x12, 0x2c, 0x0b, 0xec, 0x00, 0xe6, 0x09, 0xef, 0x00, 
+0xcf, 0x00, 0x7b, 0x0c, 0xf1, 0x09, 0xa2, 0x07, 0xa3, 0x06, 0xfc, 0x08, 
+0x83, 0x00, 0xf4, 0x0c, 0x9f, 0x0b, 0x8f, 0x00, 0x8f, 0x00, 0xe3, 0x07, 
+0x9a, 0x12, 0x84, 0x08, 0x49, 0x06, 0xf1, 0x0a, 0xa8, 0x08, 0x46, 0x06, 
+0x53, 0x00, 0x88, 0x0c, 0x39, 0x01, 0xb6, 0x09, 0xaa, 0x0b, 0x20, 0x0b, 
+0x54, 0x00, 0x5d, 0x0c, 0x9e, 0x00, 0x9e, 0x0b, 0x13, 0x0a, 0xf9, 0x00, 
+0x46, 0x0b, 0x70, 0x08, 0xca, 0x0e, 0x30, 0x12, 0x42, 0x13, 0x10, 0x0b, 
+0x4d, 0x00, 0xbd, 0x0c, 0x05, 0x09, 0x89, 0x0d, 0xd0, 0x14, 0xdd, 0x17, 
+0xfd, 0x10, 0x87, 0x00, 0x3e, 0x0a, 0x8c, 0x0a, 0xad, 0x0b, 0xaf, 0x11, 
+0xf4, 0x07, 0x46, 0x06, 0xe1, 0x07, 0x09, 0x0b, 0x9c, 0x19, 0x3a, 0x01, 
+0x8a, 0x12, 0x49, 0x0a, 0xca, 0x0a, 0x6f, 0x08, 0xca, 0x00, 0x20, 0x00, 
+0xb6, 0x12, 0x25, 0x00, 0xba, 0x09, 0xcf, 0x18, 0xde, 0x0d, 0x74, 0x16, 
+0x56, 0x0b, 0xfc, 0x09, 0xb9, 0x00, 0x07, 0x0a, 0xb8, 0x0a, 0x05, 0x09, 
+0xff, 0x00, 0xc6, 0x0a, 0xbc, 0x00, 0xb9, 0x0a, 0x94, 0x07, 0x5f, 0x0a, 
+0x99, 0x0b, 0x92, 0x12, 0x45, 0x06, 0x47, 0x0b, 0xd6, 0x09, 0x03, 0x01, 
+0x5f, 0x00, 0x0c, 0x0c, 0xa0, 0x15, 0x47, 0x00, 0x1a, 0x0e, 0x99, 0x11, 
+0x14, 0x16, 0x0a, 0x08, 0x43, 0x15, 0xfa, 0x00, 0xfa, 0x00, 0xf7, 0x08, 
+0xa1, 0x0a, 0x11, 0x07, 0x5f, 0x00, 0x1e, 0x01, 0x81, 0x0a, 0xfc, 0x00, 
+0xd7, 0x15, 0xa0, 0x0b, 0xc2, 0x0a, 0x9c, 0x0c, 0x2c, 0x0a, 0xb9, 0x00, 
+0x18, 0x01, 0x10, 0x13, 0x8e, 0x06, 0xe9, 0x00, 0x36, 0x09, 0x40, 0x00, 
+0x30, 0x01, 0x5e, 0x0c, 0xa5, 0x09, 0x99, 0x15, 0xb8, 0x0a, 0x1e, 0x13, 
+0x32, 0x01, 0x3c, 0x00, 0x1e, 0x01, 0x51, 0x13, 0x95, 0x07, 0x26, 0x01, 
+0x3e, 0x00, 0x5d, 0x12, 0x1e, 0x0a, 0x0d, 0x01, 0x33, 0x01, 0x27, 0x01, 
+0x50, 0x17, 0xc6, 0x14, 0xfd, 0x08, 0xbc, 0x17, 0x42, 0x08, 0xb8, 0x0a, 
+0x5f, 0x16, 0xad, 0x00, 0x54, 0x00, 0x5a, 0x00, 0x0d, 0x00, 0x72, 0x0b, 
+0x3d, 0x01, 0xca, 0x0b, 0x8d, 0x08, 0xe3, 0x00, 0xd0, 0x15, 0x91, 0x00, 
+0xc2, 0x00, 0x19, 0x01, 0x25, 0x00, 0x4e, 0x06, 0xdc, 0x0b, 0xeb, 0x00, 
+0x81, 0x10, 0xa8, 0x00, 0xbb, 0x09, 0x49, 0x0b, 0x7b, 0x0c, 0x9e, 0x00, 
+0x66, 0x00, 0x9d, 0x00, 0x8b, 0x00, 0x9e, 0x0b, 0x1c, 0x00, 0xd3, 0x00, 
+0x3e, 0x07, 0x77, 0x00, 0x1d, 0x07, 0x2f, 0x0c, 0xc9, 0x14, 0x0c, 0x0c, 
+0xe5, 0x00, 0x52, 0x0c, 0xf6, 0x00, 0x13, 0x01, 0x35, 0x0d, 0x6f, 0x0a, 
+0x94, 0x07, 0xaf, 0x00, 0x10, 0x07, 0x62, 0x06, 0xfa, 0x0b, 0x9e, 0x08, 
+0x75, 0x1b, 0x77, 0x00, 0x96, 0x0f, 0xa8, 0x00, 0x3a, 0x12, 0x78, 0x08, 
+0x9b, 0x1a, 0x1a, 0x01, 0xe3, 0x06, 0xcc, 0x00, 0x99, 0x0a, 0x19, 0x01, 
+0x82, 0x0a, 0xb9, 0x0e, 0x83, 0x0b, 0x6a, 0x0a, 0x17, 0x00, 0x59, 0x0a, 
+0x2d, 0x0a, 0x26, 0x00, 0xff, 0x0c, 0x84, 0x00, 0xfa, 0x0b, 0x12, 0x01, 
+0x84, 0x00, 0xd7, 0x07, 0x3f, 0x0a, 0x75, 0x0a, 0xe0, 0x13, 0xa2, 0x07, 
+0x43, 0x0c, 0x4a, 0x11, 0xf2, 0x09, 0xd1, 0x00, 0xee, 0x0a, 0xb1, 0x0c, 
+0x75, 0x0a, 0xcd, 0x0a, 0x32, 0x01, 0x14, 0x0e, 0xad, 0x06, 0xcb, 0x0e, 
+0xf1, 0x13, 0x2c, 0x01, 0x0e, 0x01, 0x18, 0x08, 0x21, 0x01, 0x32, 0x01, 
+0x01, 0x17, 0xad, 0x16, 0x2e, 0x01, 0xb0, 0x0a, 0x46, 0x06, 0x59, 0x17, 
+0x42, 0x0e, 0x30, 0x08, 0xc5, 0x0a, 0x54, 0x00, 0x1a, 0x1b, 0x5e, 0x08, 
+0x84, 0x00, 0xc3, 0x00, 0xfb, 0x00, 0x2e, 0x0a, 0xba, 0x16, 0x68, 0x06, 
+0xb1, 0x0a, 0x9c, 0x0f, 0x04, 0x0b, 0xb4, 0x00, 0x07, 0x0a, 0x18, 0x0c, 
+0x9f, 0x00, 0x03, 0x11, 0x98, 0x15, 0xeb, 0x0b, 0xcd, 0x0a, 0xae, 0x0f, 
+0xd0, 0x0a, 0x8a, 0x00, 0xb0, 0x00, 0xaa, 0x06, 0x49, 0x0a, 0x4c, 0x00, 
+0x27, 0x0b, 0x55, 0x06, 0x3f, 0x0e, 0xa0, 0x07, 0x91, 0x00, 0x9b, 0x00, 
+0x2d, 0x00, 0x51, 0x0c, 0x7a, 0x00, 0xde, 0x13, 0x14, 0x01, 0xdc, 0x0d, 
+0xb4, 0x00, 0x25, 0x08, 0xb7, 0x00, 0x68, 0x09, 0xdf, 0x08, 0x55, 0x07, 
+0x03, 0x13, 0x24, 0x00, 0x57, 0x00, 0x07, 0x01, 0xb2, 0x0c, 0xff, 0x09, 
+0xa9, 0x15, 0x9f, 0x08, 0xa0, 0x07, 0xb4, 0x0b, 0xf5, 0x00, 0x4a, 0x0b, 
+0x44, 0x06, 0xde, 0x00, 0xb3, 0x08, 0x29, 0x0a, 0xc6, 0x14, 0x97, 0x00, 
+0x90, 0x06, 0x10, 0x00, 0x59, 0x00, 0xa0, 0x0b, 0x1c, 0x0b, 0xa3, 0x1b, 
+0x6b, 0x0c, 0x4b, 0x00, 0x03, 0x01, 0x6e, 0x09, 0xed, 0x07, 0x4e, 0x0a, 
+0x47, 0x0b, 0x08, 0x0d, 0xbd, 0x14, 0x0f, 0x00, 0x64, 0x0d, 0x2e, 0x0c, 
+0xf3, 0x14, 0x9d, 0x08, 0xb2, 0x17, 0xac, 0x0b, 0x02, 0x13, 0xb4, 0x00, 
+0xf4, 0x0c, 0xb2, 0x0c, 0xfe, 0x09, 0xeb, 0x00, 0x5a, 0x0c, 0x0c, 0x09, 
+0xd8, 0x07, 0x9d, 0x08, 0x9e, 0x0b, 0x9b, 0x00, 0x37, 0x0d, 0xdb, 0x00, 
+0x1b, 0x0b, 0x80, 0x0c, 0xbd, 0x00, 0x11, 0x01, 0x12, 0x01, 0x5a, 0x08, 
+0xaf, 0x0a, 0x2f, 0x0a, 0x4d, 0x11, 0xf4, 0x0c, 0x7a, 0x09, 0x48, 0x0c, 
+0x91, 0x06, 0x53, 0x07, 0xb9, 0x06, 0x2a, 0x01, 0x29, 0x01, 0x08, 0x0a, 
+0x45, 0x06, 0x2a, 0x01, 0x6c, 0x00, 0x6f, 0x0c, 0xf3, 0x08, 0x5e, 0x15, 
+0x93, 0x0d, 0x22, 0x09, 0x93, 0x00, 0x32, 0x0b, 0x17, 0x0c, 0x5d, 0x0b, 
+0xd9, 0x07, 0xd0, 0x09, 0x0c, 0x01, 0x64, 0x0c, 0x53, 0x00, 0x30, 0x12, 
+0xf1, 0x14, 0xdd, 0x09, 0x15, 0x13, 0x77, 0x00, 0x41, 0x0a, 0xb1, 0x0c, 
+0x23, 0x08, 0x44, 0x0c, 0xd2, 0x15, 0x13, 0x11, 0xf1, 0x09, 0x97, 0x06, 
+0x37, 0x01, 0x5e, 0x08, 0xda, 0x0d, 0xf3, 0x00, 0xfb, 0x16, 0xae, 0x11, 
+0x93, 0x0f, 0x0b, 0x01, 0x9f, 0x0c, 0xca, 0x0b, 0xf1, 0x09, 0xdc, 0x0b, 
+0xf9, 0x0c, 0x47, 0x00, 0xce, 0x0b, 0xcf, 0x15, 0xf5, 0x00, 0x03, 0x01, 
+0xdd, 0x00, 0xad, 0x0a, 0x70, 0x08, 0x09, 0x01, 0x21, 0x01, 0x0a, 0x0d, 
+0x1c, 0x1b, 0xc1, 0x00, 0xe0, 0x09, 0xda, 0x07, 0x72, 0x0b, 0xdc, 0x09, 
+0x32, 0x0b, 0x43, 0x13, 0x55, 0x00, 0x42, 0x01, 0xdd, 0x17, 0x1d, 0x0a, 
+0x1a, 0x0c, 0xda, 0x00, 0x41, 0x06, 0xa0, 0x0b, 0x44, 0x0c, 0x08, 0x0a, 
+0xaa, 0x09, 0x70, 0x00, 0xeb, 0x00, 0x8d, 0x0a, 0x91, 0x0c, 0xf3, 0x00, 
+0x27, 0x0c, 0xf4, 0x08, 0xe1, 0x00, 0xb6, 0x00, 0xd1, 0x0a, 0x0d, 0x13, 
+0x79, 0x00, 0x8c, 0x0a, 0xea, 0x0c, 0xb1, 0x09, 0x60, 0x06, 0x0b, 0x00, 
+0x78, 0x10, 0xd9, 0x00, 0x24, 0x01, 0x4e, 0x0d, 0xb7, 0x0e, 0xa4, 0x06, 
+0x29, 0x01, 0x8f, 0x0a, 0xd1, 0x09, 0x1d, 0x0b, 0x4e, 0x12, 0x57, 0x06, 
+0x36, 0x09, 0xcc, 0x17, 0xf6, 0x00, 0xe8, 0x0f, 0x03, 0x01, 0x86, 0x07, 
+0xf9, 0x0a, 0x12, 0x07, 0x69, 0x07, 0xe3, 0x0f, 0x42, 0x14, 0x46, 0x06, 
+0x18, 0x01, 0xdf, 0x08, 0x07, 0x0b, 0xd7, 0x00, 0x36, 0x0a, 0x13, 0x0d, 
+0xc9, 0x16, 0xe1, 0x09, 0x0f, 0x0c, 0xab, 0x15, 0x64, 0x0d, 0x29, 0x01, 
+0x34, 0x01, 0x54, 0x0a, 0x49, 0x0a, 0x8b, 0x10, 0x67, 0x07, 0x92, 0x06, 
+0xe1, 0x00, 0xee, 0x00, 0x81, 0x09, 0x5a, 0x08, 0x46, 0x0a, 0x51, 0x17, 
+0x1c, 0x00, 0xae, 0x0b, 0x54, 0x0b, 0x79, 0x0a, 0x20, 0x01, 0x0f, 0x01, 
+0x9d, 0x00, 0xeb, 0x07, 0x2d, 0x08, 0x4b, 0x11, 0x2d, 0x01, 0xd6, 0x00, 
+0xf6, 0x00, 0x64, 0x09, 0xb4, 0x0f, 0xcf, 0x06, 0x47, 0x0b, 0x54, 0x00, 
+0xa9, 0x0b, 0xe9, 0x08, 0xce, 0x18, 0x39, 0x0c, 0x96, 0x0b, 0xac, 0x18, 
+0x60, 0x0a, 0x7f, 0x16, 0xbb, 0x14, 0x63, 0x09, 0xfa, 0x0a, 0x4e, 0x0c, 
+0x42, 0x01, 0x22, 0x01, 0xdb, 0x10, 0xb5, 0x12, 0xa9, 0x07, 0xbd, 0x0a, 
+0x12, 0x07, 0x90, 0x0a, 0x03, 0x0c, 0x70, 0x07, 0xb1, 0x0a, 0x7d, 0x00, 
+0xf6, 0x09, 0xbd, 0x0a, 0x97, 0x0b, 0xb1, 0x09, 0x53, 0x00, 0x38, 0x0c, 
+0x66, 0x08, 0x17, 0x01, 0x39, 0x13, 0x3a, 0x01, 0xff, 0x0c, 0x90, 0x0b, 
+0xb0, 0x0a, 0xd2, 0x10, 0x23, 0x01, 0x21, 0x09, 0x04, 0x15, 0x5c, 0x08, 
+0x83, 0x0a, 0xee, 0x0b, 0xdd, 0x00, 0xdd, 0x00, 0xb8, 0x00, 0x9b, 0x00, 
+0x20, 0x09, 0x43, 0x06, 0xd3, 0x09, 0xba, 0x09, 0x2d, 0x0b, 0x2b, 0x17, 
+0x23, 0x01, 0xa3, 0x06, 0x9f, 0x08, 0xdc, 0x00, 0xb7, 0x0e, 0xc4, 0x00, 
+0x31, 0x0b, 0x80, 0x0a, 0xdd, 0x09, 0xbd, 0x0a, 0x94, 0x16, 0x0d, 0x0c, 
+0x48, 0x19, 0x48, 0x00, 0x4a, 0x0b, 0x1e, 0x01, 0xa9, 0x08, 0xf7, 0x00, 
+0x42, 0x12, 0xfe, 0x00, 0x16, 0x01, 0xd8, 0x0b, 0x90, 0x00, 0x29, 0x01, 
+0xb0, 0x09, 0x1a, 0x1b, 0x68, 0x0b, 0x06, 0x1b, 0xb6, 0x00, 0xa5, 0x10, 
+0x67, 0x0b, 0x81, 0x09, 0x7b, 0x18, 0x24, 0x01, 0x47, 0x19, 0xca, 0x0e, 
+0x88, 0x0c, 0xdf, 0x0c, 0xc8, 0x00, 0x21, 0x01, 0x33, 0x0c, 0xd0, 0x09, 
+0x1d, 0x08, 0xd7, 0x00, 0xfd, 0x07, 0x70, 0x0c, 0x93, 0x12, 0xa1, 0x15, 
+0xc7, 0x00, 0xd7, 0x00, 0x73, 0x0d, 0x75, 0x0b, 0x10, 0x01, 0x27, 0x08, 
+0xa3, 0x0c, 0xe1, 0x0b, 0x22, 0x10, 0x24, 0x00, 0x4a, 0x15, 0xee, 0x0a, 
+0xf4, 0x0c, 0x4d, 0x19, 0xc5, 0x09, 0x29, 0x12, 0xf0, 0x00, 0x5f, 0x0a, 
+0xa0, 0x00, 0x58, 0x17, 0x28, 0x01, 0xc4, 0x12, 0xd4, 0x06, 0x0d, 0x01, 
+0xc2, 0x00, 0xe3, 0x1a, 0xa8, 0x00, 0x87, 0x0d, 0x48, 0x19, 0xce, 0x0a, 
+0x08, 0x0d, 0x22, 0x09, 0x2e, 0x01, 0x65, 0x0a, 0x9c, 0x00, 0xb6, 0x08, 
+0xe5, 0x0d, 0xab, 0x08, 0x08, 0x0b, 0xf6, 0x0e, 0x77, 0x0b, 0xa6, 0x09, 
+0x4c, 0x00, 0xf0, 0x0e, 0xdd, 0x00, 0x53, 0x00, 0xa3, 0x0a, 0x77, 0x19, 
+0xab, 0x00, 0x28, 0x10, 0x77, 0x09, 0xc4, 0x0a, 0x16, 0x0a, 0x26, 0x01, 
+0x73, 0x16, 0xb7, 0x00, 0x4c, 0x11, 0x37, 0x00, 0xd4, 0x00, 0x1a, 0x01, 
+0x23, 0x0e, 0x75, 0x0b, 0x57, 0x06, 0xf3, 0x00, 0xb5, 0x00, 0xf3, 0x0c, 
+0xfe, 0x09, 0x36, 0x0d, 0xc7, 0x00, 0x41, 0x06, 0x2f, 0x0c, 0x91, 0x10, 
+0xe9, 0x13, 0x6c, 0x00, 0xf0, 0x0a, 0xd8, 0x16, 0xcf, 0x0a, 0xd8, 0x00, 
+0xc6, 0x00, 0xea, 0x00, 0xd7, 0x00, 0x0c, 0x11, 0x02, 0x01, 0xc9, 0x00, 
+0xb7, 0x00, 0xcd, 0x0b, 0xdb, 0x10, 0x30, 0x01, 0x09, 0x01, 0x29, 0x01, 
+0x60, 0x00, 0x9c, 0x0c, 0x46, 0x06, 0x1b, 0x00, 0xa7, 0x10, 0xe6, 0x16, 
+0xf2, 0x13, 0x39, 0x01, 0x1b, 0x0b, 0x4f, 0x17, 0xfd, 0x15, 0xca, 0x0b, 
+0x70, 0x10, 0xae, 0x12, 0x47, 0x06, 0x28, 0x00, 0x75, 0x0a, 0xc7, 0x09, 
+0xfc, 0x00, 0x3e, 0x09, 0xcb, 0x00, 0x0f, 0x00, 0xbb, 0x16, 0x5c, 0x0a, 
+0xaa, 0x0b, 0x85, 0x00, 0xab, 0x00, 0x27, 0x00, 0xed, 0x0c, 0xda, 0x0b, 
+0x2d, 0x08, 0x4b, 0x0a, 0x4e, 0x09, 0xf0, 0x00, 0xe6, 0x00, 0x48, 0x00, 
+0x33, 0x01, 0xd0, 0x0b, 0xc6, 0x00, 0xb6, 0x07, 0x23, 0x0e, 0x42, 0x00, 
+0xc7, 0x00, 0x19, 0x01, 0x36, 0x01, 0x12, 0x0a, 0x27, 0x15, 0x9e, 0x0b, 
+0x2f, 0x01, 0xaf, 0x00, 0x91, 0x00, 0xb1, 0x0c, 0x30, 0x09, 0x67, 0x08, 
+0xc8, 0x14, 0x53, 0x17, 0xfd, 0x17, 0xec, 0x15, 0x2f, 0x07, 0x3c, 0x13, 
+0xcd, 0x0a, 0x2d, 0x01, 0x67, 0x0c, 0x97, 0x0a, 0x9e, 0x0c, 0xe0, 0x09, 
+0xc3, 0x08, 0xf7, 0x0b, 0x55, 0x0b, 0xd1, 0x1b, 0xee, 0x0a, 0x32, 0x00, 
+0xe1, 0x00, 0xff, 0x0f, 0xdb, 0x06, 0xdd, 0x0c, 0x5d, 0x0b, 0x2f, 0x00, 
+0xbc, 0x09, 0x70, 0x09, 0x86, 0x16, 0xb0, 0x00, 0x60, 0x06, 0x88, 0x0b, 
+0xc8, 0x09, 0x84, 0x00, 0xcd, 0x0b, 0x93, 0x00, 0x8b, 0x13, 0xd4, 0x09, 
+0xc9, 0x00, 0xa8, 0x09, 0xef, 0x0b, 0x4b, 0x11, 0x41, 0x0c, 0x3b, 0x00, 
+0x58, 0x00, 0xb2, 0x17, 0x22, 0x01, 0x6c, 0x0a, 0x3d, 0x0c, 0xc2, 0x00, 
+0x75, 0x0b, 0xff, 0x0f, 0xe7, 0x09, 0xbd, 0x00, 0xeb, 0x0c, 0x5b, 0x0f, 
+0x1e, 0x1a, 0x3d, 0x00, 0x34, 0x01, 0xb3, 0x15, 0x4b, 0x0a, 0x30, 0x00, 
+0xae, 0x12, 0xdf, 0x00, 0xe3, 0x09, 0x19, 0x01, 0x21, 0x1a, 0xe7, 0x0d, 
+0xd6, 0x18, 0x6f, 0x08, 0x1c, 0x08, 0x07, 0x01, 0xf2, 0x00, 0x4b, 0x00, 
+0xb3, 0x16, 0x74, 0x0b, 0x75, 0x0a, 0x6c, 0x11, 0x17, 0x0c, 0xc8, 0x00, 
+0xb5, 0x09, 0xd5, 0x10, 0x92, 0x0c, 0x76, 0x0a, 0x8a, 0x00, 0x14, 0x19, 
+0xb7, 0x0a, 0x06, 0x01, 0x12, 0x0b, 0xd2, 0x09, 0xee, 0x00, 0xa4, 0x09, 
+0xaa, 0x07, 0xba, 0x14, 0x6e, 0x09, 0x41, 0x06, 0xdf, 0x08, 0x26, 0x12, 
+0x9a, 0x16, 0x02, 0x01, 0x38, 0x0a, 0xe7, 0x00, 0x9e, 0x08, 0x97, 0x17, 
+0x1e, 0x0b, 0x26, 0x0d, 0xa7, 0x09, 0x9a, 0x06, 0x2b, 0x0d, 0x00, 0x01, 
+0x31, 0x0b, 0xa7, 0x00, 0xf4, 0x09, 0x14, 0x01, 0xcf, 0x0b, 0xb9, 0x06, 
+0x05, 0x10, 0x97, 0x06, 0x30, 0x0b, 0xd4, 0x0c, 0x1b, 0x0d, 0x21, 0x1a, 
+0x9f, 0x12, 0xc5, 0x00, 0xfe, 0x0e, 0x0a, 0x0d, 0x5d, 0x12, 0xd0, 0x14, 
+0x93, 0x00, 0x59, 0x0c, 0xcf, 0x0a, 0x29, 0x01, 0x3e, 0x07, 0xf9, 0x00, 
+0xae, 0x00, 0xd4, 0x00, 0xb3, 0x00, 0xc5, 0x09, 0x55, 0x11, 0xd6, 0x09, 
+0x23, 0x0c, 0x29, 0x12, 0x5e, 0x18, 0xb3, 0x15, 0xe7, 0x06, 0x12, 0x0b, 
+0x0b, 0x01, 0x44, 0x06, 0xde, 0x09, 0x2c, 0x09, 0xbf, 0x00, 0x7b, 0x00, 
+0xaf, 0x09, 0x49, 0x15, 0x1e, 0x0a, 0xd2, 0x18, 0xde, 0x18, 0x12, 0x01, 
+0x4e, 0x0c, 0xb6, 0x11, 0x3c, 0x0c, 0xd1, 0x00, 0xeb, 0x00, 0x4e, 0x17, 
+0x35, 0x01, 0x60, 0x00, 0xec, 0x06, 0x74, 0x0c, 0xe4, 0x0d, 0xe7, 0x0a, 
+0x13, 0x01, 0x30, 0x00, 0x64, 0x0a, 0x30, 0x12, 0x2b, 0x01, 0x43, 0x06, 
+0x37, 0x0a, 0xf1, 0x0d, 0xa2, 0x0a, 0xb8, 0x0e, 0x60, 0x1b, 0xe2, 0x0b, 
+0x5e, 0x11, 0xd7, 0x14, 0xb8, 0x0a, 0x92, 0x1a, 0x02, 0x13, 0xf2, 0x0a, 
+0x22, 0x0c, 0xad, 0x00, 0xe1, 0x00, 0xe9, 0x0c, 0xe3, 0x00, 0x6e, 0x1c, 
+0xf4, 0x07, 0xb0, 0x00, 0xa0, 0x07, 0x0b, 0x00, 0xb3, 0x00, 0x1a, 0x01, 
+0x83, 0x00, 0x39, 0x0c, 0x22, 0x01, 0x51, 0x0b, 0xc5, 0x00, 0x23, 0x00, 
+0xb7, 0x00, 0xb2, 0x0c, 0xdb, 0x09, 0xd6, 0x07, 0x09, 0x01, 0x97, 0x15, 
+0xce, 0x00, 0xcb, 0x00, 0xcf, 0x15, 0x6b, 0x18, 0x66, 0x00, 0xb4, 0x00, 
+0xb7, 0x0a, 0x19, 0x00, 0x2e, 0x14, 0x41, 0x1a, 0x5e, 0x08, 0x3e, 0x0b, 
+0x58, 0x00, 0xb7, 0x0e, 0xb2, 0x16, 0xd6, 0x15, 0x9a, 0x19, 0xba, 0x14, 
+0xe0, 0x00, 0x37, 0x09, 0x17, 0x0c, 0x8f, 0x00, 0x75, 0x16, 0xc6, 0x0a, 
+0x8f, 0x00, 0x3d, 0x07, 0xe7, 0x0d, 0x71, 0x09, 0x89, 0x0a, 0x19, 0x0a, 
+0x24, 0x00, 0xdc, 0x00, 0x63, 0x06, 0xe2, 0x07, 0x3a, 0x13, 0xe3, 0x06, 
+0x3f, 0x01, 0x81, 0x12, 0x55, 0x00, 0xfd, 0x00, 0xf0, 0x00, 0x4e, 0x06, 
+0xca, 0x0b, 0x23, 0x0f, 0xfe, 0x00, 0xe0, 0x00, 0x85, 0x17, 0x49, 0x0a, 
+0xd8, 0x07, 0x40, 0x0a, 0x65, 0x08, 0x17, 0x00, 0x5a, 0x08, 0x98, 0x17, 
+0x28, 0x07, 0x9f, 0x00, 0xd2, 0x00, 0xcb, 0x00, 0x2b, 0x0a, 0xfd, 0x14, 
+0xdb, 0x06, 0x1a, 0x0c, 0x38, 0x08, 0x9a, 0x0b, 0x76, 0x0a, 0xdb, 0x06, 
+0xd2, 0x11, 0xa9, 0x00, 0xee, 0x0a, 0x43, 0x0c, 0x58, 0x06, 0x9e, 0x0b, 
+0x48, 0x06, 0x77, 0x00, 0xf6, 0x10, 0x73, 0x10, 0x9e, 0x00, 0x01, 0x01, 
+0x6a, 0x0c, 0x17, 0x0b, 0x28, 0x01, 0xac, 0x00, 0xb3, 0x08, 0xb7, 0x10, 
+0x39, 0x0c, 0x6c, 0x0a, 0xa5, 0x06, 0x1d, 0x0a, 0x42, 0x01, 0xb3, 0x16, 
+0x42, 0x13, 0xef, 0x0a, 0xbc, 0x14, 0x56, 0x1a, 0x93, 0x17, 0x5d, 0x11, 
+0xb8, 0x00, 0x5d, 0x08, 0x4e, 0x0c, 0x3a, 0x0a, 0x27, 0x0b, 0xb5, 0x00, 
+0x21, 0x0d, 0x24, 0x08, 0x68, 0x0b, 0x3c, 0x0b, 0x2a, 0x18, 0x94, 0x07, 
+0xe4, 0x0b, 0x8d, 0x16, 0xd6, 0x0b, 0xcf, 0x14, 0x28, 0x0a, 0x26, 0x13, 
+0xc6, 0x0a, 0xf6, 0x00, 0xd2, 0x00, 0xd5, 0x00, 0x26, 0x00, 0xa8, 0x00, 
+0x6f, 0x09, 0x58, 0x0a, 0x57, 0x11, 0x0e, 0x0c, 0xd9, 0x0b, 0x2b, 0x01, 
+0x79, 0x0b, 0x47, 0x06, 0xaf, 0x00, 0x44, 0x06, 0x58, 0x11, 0x6e, 0x00, 
+0x59, 0x06, 0xdd, 0x09, 0x63, 0x0a, 0x11, 0x0b, 0xc4, 0x14, 0x21, 0x0b, 
+0x19, 0x0c, 0xb2, 0x06, 0x85, 0x0c, 0x9b, 0x0b, 0x85, 0x0c, 0x2d, 0x0c, 
+0x4e, 0x17, 0xea, 0x00, 0x72, 0x00, 0xec, 0x00, 0xce, 0x00, 0x19, 0x01, 
+0xa0, 0x0c, 0xd2, 0x0c, 0xdf, 0x00, 0xf5, 0x0d, 0xee, 0x00, 0xc2, 0x0b, 
+0x33, 0x01, 0x23, 0x01, 0x79, 0x0a, 0x83, 0x14, 0x5e, 0x15, 0x28, 0x0a, 
+0x5e, 0x08, 0x8b, 0x0c, 0x58, 0x17, 0x6c, 0x1b, 0x8a, 0x08, 0x47, 0x06, 
+0xf3, 0x00, 0x20, 0x13, 0x88, 0x00, 0x8c, 0x0a, 0xa1, 0x08, 0x55, 0x0a, 
+0x2e, 0x14, 0xd5, 0x14, 0x1b, 0x01, 0xd5, 0x00, 0x0b, 0x0a, 0x33, 0x0a, 
+0x5f, 0x0c, 0x7d, 0x0b, 0x21, 0x01, 0xc5, 0x00, 0x51, 0x17, 0xd5, 0x09, 
+0xc4, 0x11, 0x1d, 0x01, 0x9e, 0x00, 0x87, 0x00, 0xc0, 0x0e, 0x32, 0x12, 
+0x23, 0x01, 0x2f, 0x09, 0x44, 0x06, 0xe1, 0x0b, 0x76, 0x13, 0x05, 0x01, 
+0xd0, 0x18, 0x12, 0x01, 0x6a, 0x0a, 0xca, 0x0c, 0xbf, 0x14, 0x4f, 0x00, 
+0x04, 0x12, 0x1d, 0x01, 0x1e, 0x00, 0xdd, 0x0c, 0x06, 0x0b, 0x5f, 0x07, 
+0xdc, 0x00, 0x02, 0x07, 0xf4, 0x0c, 0x0c, 0x01, 0xf7, 0x00, 0x11, 0x00, 
+0x45, 0x0f, 0x58, 0x18, 0x0f, 0x15, 0xe9, 0x00, 0xe9, 0x08, 0x18, 0x01, 
+0xd9, 0x00, 0x83, 0x0a, 0xba, 0x14, 0x2b, 0x01, 0x09, 0x01, 0xcd, 0x15, 
+0x8e, 0x14, 0x3c, 0x00, 0x5f, 0x0a, 0x80, 0x0a, 0xd7, 0x07, 0x1f, 0x15, 
+0x04, 0x0b, 0xbb, 0x00, 0x99, 0x07, 0x31, 0x00, 0xfe, 0x0c, 0x29, 0x07, 
+0x60, 0x0c, 0x20, 0x01, 0x31, 0x00, 0x1a, 0x0c, 0x0f, 0x01, 0xfb, 0x0a, 
+0x3d, 0x00, 0x78, 0x00, 0xb9, 0x11, 0xfd, 0x0a, 0x67, 0x12, 0xf6, 0x0c, 
+0xce, 0x00, 0x23, 0x00, 0x80, 0x0a, 0x55, 0x07, 0x65, 0x0c, 0xe9, 0x08, 
+0x2f, 0x01, 0x46, 0x06, 0x7a, 0x0c, 0xbf, 0x00, 0x70, 0x0c, 0xc0, 0x0b, 
+0x1d, 0x07, 0x10, 0x0b, 0xfc, 0x09, 0x0a, 0x01, 0x2b, 0x09, 0xce, 0x06, 
+0xe1, 0x08, 0xe9, 0x08, 0x41, 0x16, 0x83, 0x0b, 0xe2, 0x0b, 0x7a, 0x00, 
+0xf7, 0x00, 0x57, 0x0a, 0xec, 0x06, 0xd7, 0x0b, 0xef, 0x0d, 0x90, 0x17, 
+0xf4, 0x07, 0xd9, 0x0e, 0x13, 0x0b, 0x67, 0x0b, 0xe0, 0x15, 0xd1, 0x00, 
+0x2f, 0x01, 0xc5, 0x14, 0xf2, 0x00, 0xd1, 0x00, 0xa0, 0x15, 0x61, 0x1a, 
+0xa5, 0x15, 0xcf, 0x00, 0x1e, 0x07, 0x54, 0x0a, 0xcb, 0x0f, 0x26, 0x01, 
+0xd8, 0x0e, 0xef, 0x00, 0x11, 0x07, 0x15, 0x01, 0xce, 0x0a, 0x56, 0x07, 
+0x29, 0x0e, 0xa4, 0x0a, 0xfb, 0x0e, 0x77, 0x00, 0xe8, 0x09, 0x32, 0x01, 
+0x2c, 0x01, 0xd8, 0x00, 0x18, 0x0a, 0x5f, 0x0a, 0xf0, 0x00, 0x59, 0x0c, 
+0xfa, 0x15, 0x47, 0x0b, 0x5c, 0x0c, 0xe4, 0x08, 0x39, 0x0c, 0xb3, 0x17, 
+0xc8, 0x00, 0xde, 0x0a, 0x05, 0x01, 0x47, 0x06, 0x07, 0x01, 0xcd, 0x00, 
+0x26, 0x15, 0xcb, 0x00, 0x5e, 0x11, 0x3e, 0x09, 0xd7, 0x0b, 0x11, 0x0b, 
+0x8d, 0x0b, 0x60, 0x00, 0x83, 0x11, 0xd0, 0x09, 0x9b, 0x0c, 0x14, 0x0a, 
+0x62, 0x0e, 0x39, 0x0c, 0xd6, 0x09, 0xbf, 0x09, 0x07, 0x0a, 0x04, 0x01, 
+0x1f, 0x15, 0x96, 0x00, 0x0e, 0x00, 0xcd, 0x0f, 0xa6, 0x0a, 0xa9, 0x0b, 
+0x40, 0x01, 0x30, 0x12, 0x4c, 0x0a, 0xfe, 0x0e, 0xd2, 0x0f, 0xbb, 0x0a, 
+0x70, 0x00, 0x60, 0x0c, 0xdd, 0x00, 0xda, 0x0a, 0x96, 0x0a, 0x72, 0x09, 
+0xac, 0x00, 0x1b, 0x0b, 0xf4, 0x18, 0xe9, 0x00, 0x13, 0x07, 0x8d, 0x0a, 
+0x19, 0x08, 0xb6, 0x12, 0x6e, 0x00, 0x9f, 0x07, 0xac, 0x00, 0x20, 0x1a, 
+0x48, 0x08, 0x47, 0x19, 0x0b, 0x00, 0x27, 0x00, 0xbc, 0x09, 0x9e, 0x0b, 
+0xab, 0x00, 0x93, 0x0c, 0x2f, 0x01, 0xcd, 0x15, 0xf2, 0x00, 0x43, 0x0c, 
+0x29, 0x00, 0x4e, 0x0c, 0x24, 0x0c, 0x76, 0x0b, 0x6e, 0x11, 0x0d, 0x0d, 
+0x85, 0x00, 0x18, 0x0c, 0xb1, 0x09, 0x72, 0x0b, 0x13, 0x0b, 0xce, 0x06, 
+0x0c, 0x0a, 0xd7, 0x00, 0xae, 0x0a, 0x49, 0x15, 0xdd, 0x00, 0x07, 0x13, 
+0x3a, 0x0a, 0x6b, 0x0d, 0x04, 0x0b, 0x3b, 0x0c, 0xd5, 0x0b, 0x98, 0x17, 
+0x7a, 0x0c, 0x2d, 0x0c, 0x79, 0x10, 0x48, 0x0b, 0xca, 0x00, 0x3a, 0x0c, 
+0x2f, 0x01, 0xf9, 0x00, 0x6c, 0x0d, 0x63, 0x19, 0x0b, 0x00, 0xbe, 0x00, 
+0xe7, 0x0a, 0xbd, 0x07, 0x0e, 0x00, 0xa1, 0x07, 0xba, 0x0a, 0x90, 0x0b, 
+0x63, 0x0d, 0x1a, 0x0f, 0x8d, 0x13, 0x94, 0x07, 0x42, 0x13, 0x30, 0x07, 
+0x34, 0x0a, 0xd0, 0x0a, 0x39, 0x12, 0x2d, 0x09, 0x5f, 0x18, 0x91, 0x10, 
+0x47, 0x0c, 0x8f, 0x00, 0x9a, 0x0a, 0xde, 0x08, 0x59, 0x0a, 0x1d, 0x0a, 
+0xb2, 0x06, 0xcf, 0x00, 0xbb, 0x06, 0x58, 0x18, 0x2b, 0x0a, 0x23, 0x0c, 
+0x45, 0x13, 0xff, 0x0c, 0x52, 0x07, 0xb4, 0x17, 0x5c, 0x0b, 0xde, 0x17, 
+0x2b, 0x16, 0xb2, 0x11, 0x7d, 0x16, 0xb3, 0x07, 0xa0, 0x00, 0xd2, 0x00, 
+0xbd, 0x0c, 0xe8, 0x0c, 0x2f, 0x01, 0x42, 0x01, 0xfe, 0x00, 0x20, 0x09, 
+0xd7, 0x15, 0x57, 0x1a, 0xdf, 0x08, 0xe8, 0x00, 0xc0, 0x14, 0x7e, 0x0e, 
+0x83, 0x00, 0x04, 0x0a, 0x11, 0x01, 0x30, 0x01, 0x76, 0x0a, 0x03, 0x0a, 
+0x10, 0x07, 0xa4, 0x09, 0xe2, 0x0c, 0xa3, 0x15, 0x9b, 0x00, 0x02, 0x14, 
+0xd7, 0x07, 0x04, 0x12, 0xb5, 0x00, 0x85, 0x17, 0x68, 0x14, 0xb2, 0x16, 
+0xfe, 0x00, 0xd9, 0x15, 0xee, 0x11, 0xd3, 0x00, 0x47, 0x00, 0xef, 0x00, 
+0x3b, 0x00, 0x53, 0x00, 0x3c, 0x00, 0xa3, 0x00, 0xca, 0x00, 0xdd, 0x09, 
+0xf8, 0x09, 0x27, 0x0b, 0xa7, 0x0c, 0xb4, 0x0b, 0x30, 0x01, 0x32, 0x08, 
+0x63, 0x09, 0x34, 0x0a, 0x18, 0x08, 0xf7, 0x00, 0xa7, 0x00, 0x59, 0x0a, 
+0xe3, 0x00, 0x56, 0x06, 0xbe, 0x00, 0xf9, 0x0a, 0xad, 0x0b, 0x3a, 0x0a, 
+0x64, 0x0a, 0xfc, 0x00, 0x1f, 0x01, 0xf9, 0x00, 0x4d, 0x06, 0x32, 0x00, 
+0x21, 0x09, 0xd8, 0x0b, 0xbb, 0x00, 0xa8, 0x00, 0x90, 0x0d, 0x69, 0x08, 
+0xa1, 0x0a, 0x1b, 0x01, 0x29, 0x01, 0xe3, 0x08, 0x49, 0x00, 0x19, 0x0c, 
+0x08, 0x0a, 0x1b, 0x01, 0xa3, 0x0a, 0x63, 0x0a, 0xdd, 0x0b, 0x94, 0x0b, 
+0x34, 0x0b, 0x13, 0x0a, 0x33, 0x0c, 0x34, 0x0a, 0xe8, 0x09, 0x27, 0x0d, 
+0x80, 0x07, 0x90, 0x00, 0x56, 0x0a, 0xbf, 0x0c, 0x5f, 0x11, 0xb3, 0x00, 
+0xce, 0x14, 0x09, 0x01, 0x08, 0x0a, 0xac, 0x08, 0xa8, 0x0a, 0xb2, 0x00, 
+0x7c, 0x00, 0x23, 0x0a, 0x6e, 0x10, 0xb0, 0x00, 0xa9, 0x09, 0x9b, 0x0a, 
+0xee, 0x0b, 0x2a, 0x01, 0xcf, 0x18, 0x60, 0x0a, 0x25, 0x0c, 0x76, 0x0b, 
+0x9c, 0x00, 0x64, 0x0c, 0xbb, 0x00, 0xc0, 0x0b, 0xb5, 0x00, 0x8a, 0x00, 
+0x6f, 0x09, 0x29, 0x0b, 0x1e, 0x01, 0xc1, 0x09, 0xbb, 0x13, 0xd7, 0x16, 
+0x04, 0x01, 0x6a, 0x07, 0x14, 0x15, 0x30, 0x08, 0x89, 0x0b, 0x51, 0x0b, 
+0xd5, 0x0c, 0x7f, 0x00, 0x52, 0x06, 0xe4, 0x00, 0x3b, 0x0b, 0x17, 0x01, 
+0xb0, 0x09, 0x84, 0x0b, 0xf9, 0x17, 0x43, 0x06, 0xf0, 0x10, 0x60, 0x12, 
+0x99, 0x0c, 0x93, 0x0f, 0x31, 0x07, 0x29, 0x01, 0x82, 0x11, 0xeb, 0x0b, 
+0xdd, 0x0d, 0xbb, 0x00, 0xe5, 0x0a, 0x34, 0x00, 0xa2, 0x00, 0x54, 0x0c, 
+0x97, 0x07, 0x23, 0x08, 0x0c, 0x01, 0xe7, 0x00, 0x1f, 0x14, 0xab, 0x15, 
+0x6d, 0x0a, 0xf8, 0x0b, 0xb0, 0x00, 0xd0, 0x06, 0x1d, 0x0b, 0x75, 0x0b, 
+0x19, 0x01, 0x8f, 0x17, 0xf9, 0x0a, 0x13, 0x01, 0x66, 0x0e, 0xeb, 0x13, 
+0xe5, 0x15, 0x17, 0x14, 0x0b, 0x0d, 0x85, 0x0c, 0x90, 0x10, 0x4b, 0x06, 
+0xae, 0x00, 0x4e, 0x13, 0xd1, 0x0a, 0xfb, 0x0a, 0xe2, 0x10, 0x4e, 0x0c, 
+0x03, 0x0c, 0x1e, 0x07, 0xd4, 0x0f, 0x00, 0x01, 0xf9, 0x0a, 0x14, 0x0a, 
+0x18, 0x00, 0x65, 0x0c, 0x2b, 0x0b, 0xbe, 0x00, 0x72, 0x16, 0xb0, 0x09, 
+0x6b, 0x0a, 0x26, 0x01, 0x1e, 0x0c, 0xc4, 0x14, 0x5b, 0x00, 0xd8, 0x00, 
+0x31, 0x00, 0xb2, 0x17, 0xaf, 0x07, 0x40, 0x0a, 0x41, 0x00, 0x8e, 0x07, 
+0x53, 0x0b, 0xb9, 0x00, 0xf4, 0x07, 0x18, 0x08, 0x97, 0x15, 0x6d, 0x18, 
+0x22, 0x0c, 0x69, 0x0c, 0xfb, 0x18, 0x5e, 0x08, 0xa3, 0x0a, 0xd4, 0x0c, 
+0xd2, 0x0c, 0x03, 0x08, 0xa7, 0x0a, 0x67, 0x0c, 0x4f, 0x0c, 0x65, 0x11, 
+0x80, 0x0a, 0x1d, 0x00, 0x0f, 0x01, 0x60, 0x1b, 0xe0, 0x06, 0xf5, 0x0c, 
+0xde, 0x08, 0x40, 0x06, 0xaa, 0x00, 0x2c, 0x1a, 0x1b, 0x00, 0xa1, 0x15, 
+0x61, 0x00, 0x24, 0x08, 0x4e, 0x0a, 0x35, 0x0b, 0x97, 0x0a, 0xbf, 0x00, 
+0x0d, 0x0c, 0xaf, 0x00, 0xc4, 0x1a, 0xab, 0x0b, 0xfb, 0x15, 0x5a, 0x08, 
+0xe8, 0x00, 0x15, 0x0a, 0xa6, 0x09, 0xae, 0x10, 0x79, 0x06, 0x92, 0x0f, 
+0x70, 0x00, 0xab, 0x00, 0xcd, 0x0c, 0x69, 0x0b, 0x7a, 0x0c, 0x1e, 0x00, 
+0x2f, 0x00, 0x15, 0x14, 0xda, 0x07, 0x63, 0x0d, 0x02, 0x0a, 0x81, 0x10, 
+0xd6, 0x17, 0xe4, 0x0a, 0x42, 0x12, 0x61, 0x11, 0x12, 0x01, 0xb3, 0x00, 
+0xdf, 0x00, 0x2a, 0x0f, 0xce, 0x00, 0x2c, 0x09, 0x78, 0x16, 0x1c, 0x01, 
+0x3c, 0x18, 0x78, 0x10, 0x99, 0x0b, 0x41, 0x08, 0x37, 0x0a, 0xd2, 0x09, 
+0x04, 0x0b, 0x5d, 0x08, 0x11, 0x16, 0x6e, 0x09, 0xba, 0x06, 0xc4, 0x14, 
+0x24, 0x00, 0xc1, 0x00, 0x88, 0x16, 0x24, 0x09, 0x26, 0x08, 0x32, 0x1c, 
+0x88, 0x0b, 0xf0, 0x11, 0x24, 0x01, 0xd9, 0x06, 0xc5, 0x09, 0xf7, 0x1a, 
+0x12, 0x0e, 0x1a, 0x01, 0x27, 0x0b, 0x9e, 0x0c, 0xf4, 0x13, 0x62, 0x0b, 
+0x34, 0x0c, 0xef, 0x0e, 0xd2, 0x0a, 0xc1, 0x00, 0xf5, 0x07, 0xeb, 0x07, 
+0x97, 0x00, 0x8b, 0x0b, 0x49, 0x06, 0x15, 0x13, 0x33, 0x0a, 0xc3, 0x0a, 
+0x43, 0x06, 0x4c, 0x06, 0x1f, 0x0b, 0xbc, 0x12, 0x05, 0x0b, 0xf0, 0x00, 
+0x7c, 0x0c, 0x9b, 0x00, 0xca, 0x0b, 0x1c, 0x07, 0x3c, 0x00, 0xd1, 0x00, 
+0x19, 0x08, 0x41, 0x0b, 0x58, 0x18, 0xa6, 0x09, 0xd5, 0x00, 0x67, 0x0c, 
+0xde, 0x13, 0x7c, 0x10, 0x39, 0x13, 0x89, 0x00, 0x5a, 0x0f, 0xe1, 0x07, 
+0xe0, 0x0b, 0x26, 0x16, 0x1a, 0x0e, 0xb6, 0x00, 0x26, 0x01, 0x2f, 0x0c, 
+0x75, 0x0a, 0x33, 0x0a, 0x5e, 0x18, 0x0a, 0x01, 0x0f, 0x0a, 0x6e, 0x13, 
+0xfa, 0x16, 0xbc, 0x00, 0xcf, 0x0a, 0xad, 0x00, 0x37, 0x08, 0x4e, 0x0a, 
+0x01, 0x0c, 0xef, 0x00, 0x67, 0x0c, 0x25, 0x01, 0xf5, 0x06, 0xb6, 0x10, 
+0x07, 0x17, 0xfc, 0x06, 0xe1, 0x09, 0x5c, 0x06, 0x9d, 0x00, 0x3c, 0x00, 
+0x7c, 0x16, 0x8e, 0x1a, 0x3f, 0x0b, 0x13, 0x01, 0x54, 0x07, 0x06, 0x01, 
+0x89, 0x0b, 0x1a, 0x08, 0x77, 0x0d, 0x1c, 0x08, 0x4a, 0x11, 0xed, 0x0c, 
+0xb3, 0x0c, 0x5a, 0x0c, 0x3d, 0x00, 0xdd, 0x0a, 0xd7, 0x1b, 0xf1, 0x00, 
+0xba, 0x09, 0x56, 0x0a, 0xfa, 0x00, 0x39, 0x0c, 0x66, 0x0c, 0xf9, 0x0f, 
+0xda, 0x0d, 0x69, 0x0b, 0xc3, 0x0e, 0xf3, 0x09, 0x26, 0x01, 0xa5, 0x10, 
+0x9c, 0x08, 0x6f, 0x00, 0x00, 0x14, 0x1e, 0x1a, 0x4a, 0x00, 0x5b, 0x18, 
+0xb1, 0x00, 0x3b, 0x0b, 0xf4, 0x08, 0xa5, 0x0b, 0x9e, 0x17, 0xab, 0x0b, 
+0x3c, 0x0c, 0x15, 0x19, 0x0b, 0x10, 0xc7, 0x0c, 0x63, 0x07, 0x50, 0x0c, 
+0x8c, 0x06, 0x21, 0x09, 0x12, 0x00, 0xc4, 0x0a, 0xe4, 0x0b, 0xa2, 0x0a, 
+0xb4, 0x00, 0xfc, 0x08, 0x17, 0x00, 0xcd, 0x00, 0x57, 0x0a, 0xe7, 0x09, 
+0x82, 0x09, 0xaa, 0x06, 0xea, 0x08, 0x56, 0x06, 0x60, 0x18, 0xc1, 0x00, 
+0x8f, 0x0a, 0xb7, 0x0a, 0x21, 0x0d, 0x41, 0x06, 0x05, 0x0b, 0xbe, 0x00, 
+0x05, 0x01, 0x25, 0x16, 0x9f, 0x0b, 0xb6, 0x11, 0x57, 0x07, 0x20, 0x0a, 
+0x16, 0x14, 0x14, 0x0d, 0x13, 0x0a, 0x25, 0x07, 0xbe, 0x00, 0x4b, 0x14, 
+0x0e, 0x13, 0xb7, 0x0e, 0xc3, 0x00, 0x5d, 0x07, 0x72, 0x0b, 0x87, 0x00, 
+0x34, 0x12, 0x0d, 0x00, 0x18, 0x0c, 0x9d, 0x00, 0x85, 0x00, 0xd3, 0x00, 
+0x8c, 0x0a, 0x9b, 0x00, 0xc7, 0x07, 0xeb, 0x00, 0x19, 0x00, 0xd8, 0x0b, 
+0x47, 0x1a, 0x35, 0x01, 0x22, 0x09, 0x0d, 0x13, 0xc3, 0x0b, 0x91, 0x00, 
+0x3c, 0x00, 0x24, 0x0c, 0x1f, 0x0b, 0xd7, 0x00, 0x2b, 0x0b, 0xa4, 0x09, 
+0xb9, 0x00, 0xb4, 0x0b, 0xf1, 0x00, 0xc9, 0x09, 0x96, 0x07, 0xac, 0x13, 
+0x38, 0x0c, 0x40, 0x01, 0x9c, 0x08, 0x2a, 0x0a, 0xbf, 0x0b, 0xf3, 0x00, 
+0xc2, 0x0b, 0xe0, 0x00, 0x59, 0x00, 0xb6, 0x0f, 0xf1, 0x09, 0x3a, 0x0c, 
+0xb5, 0x00, 0xee, 0x0b, 0x3c, 0x01, 0xec, 0x00, 0xbb, 0x00, 0xb4, 0x00, 
+0x3f, 0x00, 0x0c, 0x0c, 0xa4, 0x09, 0x55, 0x00, 0xf8, 0x00, 0x7d, 0x0c, 
+0xbe, 0x00, 0xc9, 0x09, 0x5f, 0x0c, 0xc9, 0x0f, 0x5a, 0x08, 0x2e, 0x0c, 
+0x9c, 0x08, 0x78, 0x10, 0x7c, 0x0c, 0xb6, 0x00, 0x55, 0x0b, 0x62, 0x0e, 
+0x26, 0x08, 0xb3, 0x09, 0xde, 0x09, 0xd9, 0x0e, 0xaf, 0x12, 0x7c, 0x0c, 
+0xb6, 0x0b, 0x12, 0x0e, 0x09, 0x15, 0x77, 0x16, 0x0c, 0x10, 0x7d, 0x0b, 
+0xe7, 0x00, 0x37, 0x0d, 0xd8, 0x00, 0x18, 0x01, 0xb9, 0x00, 0x60, 0x00, 
+0xa6, 0x0c, 0x25, 0x0c, 0x73, 0x16, 0xdf, 0x09, 0xfc, 0x09, 0xba, 0x0f, 
+0x0d, 0x01, 0x17, 0x00, 0x25, 0x08, 0xca, 0x00, 0x30, 0x00, 0x2a, 0x01, 
+0x5a, 0x08, 0xcd, 0x1b, 0xba, 0x00, 0xf8, 0x0b, 0x8a, 0x11, 0x3e, 0x01, 
+0x5d, 0x07, 0x31, 0x0e, 0x39, 0x01, 0x45, 0x1c, 0xe4, 0x18, 0x16, 0x01, 
+0x88, 0x0b, 0x10, 0x01, 0x89, 0x0b, 0x38, 0x08, 0x96, 0x0a, 0xc6, 0x00, 
+0xd0, 0x0a, 0xd9, 0x11, 0x3e, 0x0c, 0xd8, 0x00, 0x78, 0x0a, 0x76, 0x12, 
+0x18, 0x00, 0xfc, 0x14, 0xf1, 0x09, 0x25, 0x0c, 0x1c, 0x00, 0x69, 0x0e, 
+0x3a, 0x01, 0xf9, 0x00, 0x4b, 0x10, 0xf7, 0x00, 0xdb, 0x06, 0x47, 0x0f, 
+0x95, 0x00, 0x68, 0x08, 0xda, 0x0a, 0xc8, 0x11, 0x18, 0x08, 0x3c, 0x01, 
+0x1a, 0x00, 0xc6, 0x09, 0xac, 0x07, 0xf1, 0x00, 0x97, 0x06, 0x75, 0x0c, 
+0xf5, 0x00, 0x40, 0x01, 0x1b, 0x0e, 0xcb, 0x00, 0x41, 0x06, 0x72, 0x11, 
+0xb8, 0x0a, 0xfc, 0x00, 0xce, 0x0a, 0x17, 0x0c, 0xdc, 0x09, 0xf2, 0x0e, 
+0x3e, 0x01, 0x86, 0x17, 0x4c, 0x06, 0x7f, 0x0c, 0x6d, 0x00, 0x40, 0x0a, 
+0xbe, 0x00, 0x69, 0x0e, 0x29, 0x0f, 0x70, 0x00, 0x3e, 0x01, 0x72, 0x11, 
+0xbf, 0x0b, 0x67, 0x09, 0x6a, 0x0b, 0x37, 0x0a, 0x96, 0x0a, 0x72, 0x08, 
+0x21, 0x01, 0xf8, 0x00, 0xc0, 0x11, 0x3b, 0x0b, 0xe0, 0x13, 0x65, 0x08, 
+0xd8, 0x00, 0xcd, 0x0a, 0x60, 0x00, 0x2a, 0x00, 0x40, 0x0d, 0x16, 0x14, 
+0x0f, 0x13, 0x6f, 0x10, 0x39, 0x01, 0x73, 0x11, 0x42, 0x06, 0xac, 0x0c, 
+0x87, 0x0c, 0xac, 0x00, 0x16, 0x01, 0xd7, 0x0b, 0x6a, 0x08, 0x95, 0x0b, 
+0x0c, 0x0c, 0x7b, 0x1a, 0xae, 0x0a, 0x69, 0x12, 0xd6, 0x0c, 0x0c, 0x01, 
+0x67, 0x0b, 0xa7, 0x10, 0x56, 0x0a, 0x0d, 0x00, 0xbc, 0x00, 0xd8, 0x0a, 
+0x55, 0x00, 0x36, 0x01, 0x15, 0x01, 0xa3, 0x0a, 0x49, 0x00, 0xf0, 0x00, 
+0xd0, 0x06, 0x88, 0x08, 0x75, 0x0a, 0xbb, 0x00, 0xfe, 0x08, 0x20, 0x09, 
+0xcd, 0x0b, 0xcd, 0x00, 0x02, 0x0c, 0x41, 0x06, 0x0c, 0x0c, 0xf7, 0x0c, 
+0xdc, 0x09, 0xba, 0x09, 0x83, 0x10, 0x0a, 0x08, 0x28, 0x00, 0x0d, 0x00, 
+0xf1, 0x09, 0xcd, 0x00, 0x95, 0x07, 0xc7, 0x00, 0x1d, 0x13, 0x85, 0x0a, 
+0x8d, 0x06, 0xb5, 0x0b, 0xc1, 0x0b, 0xa5, 0x19, 0xe2, 0x00, 0x7d, 0x00, 
+0xb1, 0x09, 0xff, 0x0e, 0x31, 0x12, 0x04, 0x01, 0x3d, 0x01, 0x54, 0x00, 
+0xaf, 0x10, 0x21, 0x0a, 0x1a, 0x0e, 0x50, 0x06, 0x3f, 0x0a, 0x70, 0x0c, 
+0x62, 0x00, 0x3a, 0x16, 0x24, 0x01, 0x1f, 0x13, 0x43, 0x15, 0x16, 0x0a, 
+0x33, 0x01, 0xd6, 0x0b, 0xa5, 0x0f, 0xd8, 0x0a, 0x2d, 0x01, 0x6b, 0x00, 
+0x39, 0x0c, 0x3e, 0x00, 0xde, 0x0c, 0x2a, 0x01, 0x39, 0x0a, 0xa8, 0x0d, 
+0x19, 0x1a, 0xdf, 0x16, 0x0f, 0x01, 0xc3, 0x00, 0x20, 0x0d, 0xd5, 0x0a, 
+0x23, 0x00, 0xce, 0x0a, 0x79, 0x06, 0xb8, 0x12, 0xcc, 0x0b, 0xa7, 0x0c, 
+0x12, 0x01, 0x48, 0x00, 0xeb, 0x0b, 0xa7, 0x00, 0x2e, 0x01, 0x93, 0x0b, 
+0xac, 0x00, 0xc9, 0x0e, 0xf3, 0x0a, 0x09, 0x01, 0xe6, 0x09, 0x7b, 0x00, 
+0xdc, 0x06, 0x65, 0x00, 0x8d, 0x06, 0x24, 0x01, 0xcb, 0x0c, 0xed, 0x00, 
+0x3d, 0x0c, 0xd5, 0x00, 0xb2, 0x06, 0xdd, 0x07, 0x2d, 0x01, 0x0b, 0x00, 
+0x9c, 0x0f, 0x30, 0x0c, 0xf3, 0x14, 0xb2, 0x0c, 0x3f, 0x0d, 0x2a, 0x18, 
+0xaf, 0x09, 0x53, 0x0b, 0x91, 0x00, 0x39, 0x0c, 0x98, 0x15, 0xc9, 0x09, 
+0x22, 0x01, 0x7a, 0x00, 0x34, 0x01, 0xa4, 0x09, 0x98, 0x06, 0x79, 0x07, 
+0x28, 0x07, 0x93, 0x0b, 0x59, 0x0c, 0xb2, 0x17, 0x38, 0x0c, 0xbc, 0x0b, 
+0xe7, 0x00, 0x4d, 0x06, 0x39, 0x01, 0x3e, 0x0e, 0x1c, 0x0b, 0x9f, 0x0b, 
+0x1f, 0x14, 0x11, 0x0b, 0x57, 0x11, 0x26, 0x07, 0x1e, 0x07, 0x37, 0x09, 
+0x90, 0x0a, 0xfb, 0x0a, 0x9a, 0x15, 0xa7, 0x0c, 0xcf, 0x06, 0x37, 0x01, 
+0xf4, 0x00, 0x63, 0x00, 0xcc, 0x0c, 0x4c, 0x13, 0xee, 0x0b, 0x6f, 0x0c, 
+0xed, 0x0c, 0xa9, 0x09, 0x3b, 0x00, 0x90, 0x09, 0xf6, 0x0b, 0x7b, 0x00, 
+0x48, 0x06, 0x80, 0x0a, 0x7f, 0x07, 0xd2, 0x00, 0xfd, 0x00, 0x5c, 0x0c, 
+0xc9, 0x00, 0x90, 0x1a, 0x77, 0x13, 0xab, 0x00, 0xfc, 0x09, 0xda, 0x0f, 
+0x11, 0x00, 0xe7, 0x09, 0x18, 0x00, 0x7e, 0x0b, 0x20, 0x01, 0xea, 0x00, 
+0x47, 0x0b, 0x7d, 0x00, 0xb1, 0x00, 0xf1, 0x00, 0x61, 0x18, 0xf4, 0x06, 
+0xee, 0x0d, 0xeb, 0x15, 0x4a, 0x0b, 0xd6, 0x09, 0xe9, 0x00, 0xfb, 0x00, 
+0x64, 0x0e, 0x2a, 0x01, 0x3d, 0x01, 0x44, 0x06, 0xec, 0x00, 0x76, 0x16, 
+0x63, 0x0d, 0xee, 0x0a, 0x65, 0x0c, 0x19, 0x1a, 0x12, 0x0b, 0x7d, 0x0b, 
+0x09, 0x0d, 0x3c, 0x01, 0x9c, 0x00, 0x1e, 0x0c, 0x1f, 0x07, 0xa2, 0x0b, 
+0x42, 0x14, 0x18, 0x01, 0xe6, 0x0d, 0x38, 0x01, 0x98, 0x07, 0xd9, 0x16, 
+0x23, 0x00, 0x79, 0x09, 0xa5, 0x09, 0x40, 0x0e, 0xff, 0x09, 0xa0, 0x00, 
+0xf2, 0x09, 0xde, 0x08, 0x46, 0x06, 0x41, 0x0b, 0x6f, 0x08, 0xd0, 0x06, 
+0xc9, 0x0a, 0x2c, 0x01, 0x13, 0x0a, 0x2a, 0x0e, 0x9e, 0x00, 0xd4, 0x11, 
+0xf5, 0x00, 0xf3, 0x0c, 0x2f, 0x00, 0xe7, 0x09, 0x0a, 0x0d, 0xa9, 0x00, 
+0x30, 0x0b, 0xa8, 0x00, 0xab, 0x07, 0x92, 0x00, 0xca, 0x0b, 0x1a, 0x0e, 
+0xab, 0x06, 0xe0, 0x13, 0xb7, 0x07, 0x3b, 0x17, 0x1d, 0x07, 0x7b, 0x0c, 
+0xc4, 0x0b, 0xdc, 0x09, 0x2b, 0x01, 0xb3, 0x09, 0x94, 0x07, 0x0e, 0x00, 
+0x3b, 0x01, 0x84, 0x0a, 0x1d, 0x01, 0x25, 0x01, 0x54, 0x0a, 0xdb, 0x00, 
+0x24, 0x0c, 0xf7, 0x00, 0xdc, 0x0d, 0x43, 0x0c, 0xe6, 0x0a, 0x36, 0x10, 
+0x7e, 0x00, 0xc3, 0x00, 0x5a, 0x08, 0x18, 0x00, 0x10, 0x07, 0xb5, 0x00, 
+0xaa, 0x15, 0xd3, 0x10, 0x6c, 0x0b, 0x45, 0x0f, 0x6d, 0x0a, 0x9c, 0x00, 
+0x8b, 0x0a, 0x1d, 0x0b, 0x14, 0x01, 0x9b, 0x00, 0xff, 0x00, 0x23, 0x01, 
+0x78, 0x0b, 0xb2, 0x16, 0xc8, 0x00, 0xf8, 0x0c, 0x65, 0x08, 0x51, 0x0b, 
+0x1b, 0x01, 0xc8, 0x00, 0x58, 0x00, 0xd9, 0x00, 0x81, 0x09, 0x64, 0x0b, 
+0x66, 0x09, 0x7b, 0x00, 0xbf, 0x00, 0x45, 0x06, 0xd2, 0x00, 0xb4, 0x00, 
+0xae, 0x10, 0x48, 0x06, 0xa9, 0x00, 0x17, 0x00, 0x4c, 0x0b, 0xcf, 0x06, 
+0xba, 0x16, 0x7c, 0x0c, 0xf2, 0x09, 0x00, 0x14, 0x42, 0x06, 0x12, 0x0b, 
+0x69, 0x19, 0xfc, 0x09, 0x2f, 0x00, 0xa0, 0x00, 0xaa, 0x00, 0x0c, 0x13, 
+0xe3, 0x0a, 0x8b, 0x0b, 0xbe, 0x0a, 0x4a, 0x0a, 0x3f, 0x01, 0x8c, 0x06, 
+0xcd, 0x09, 0x76, 0x16, 0x33, 0x01, 0x6e, 0x00, 0x00, 0x01, 0xff, 0x00, 
+0xdf, 0x00, 0x5f, 0x11, 0xdd, 0x00, 0x31, 0x0b, 0xd3, 0x06, 0xd5, 0x09, 
+0x07, 0x0a, 0xbc, 0x09, 0x85, 0x17, 0xf9, 0x13, 0x20, 0x09, 0xed, 0x07, 
+0x7b, 0x0c, 0xb5, 0x00, 0x19, 0x00, 0xf7, 0x00, 0x93, 0x00, 0xdd, 0x0c, 
+0x3b, 0x0b, 0x73, 0x09, 0x98, 0x06, 0x7a, 0x0c, 0x4d, 0x0a, 0xea, 0x13, 
+0x89, 0x10, 0x23, 0x0c, 0x42, 0x0b, 0xf5, 0x09, 0xd3, 0x00, 0x15, 0x01, 
+0x0a, 0x01, 0xc6, 0x09, 0xc5, 0x0e, 0x0c, 0x01, 0x39, 0x12, 0xda, 0x00, 
+0x2e, 0x01, 0xe1, 0x0b, 0x26, 0x01, 0xf4, 0x00, 0x6b, 0x00, 0x90, 0x00, 
+0xc2, 0x0a, 0xba, 0x09, 0x22, 0x0c, 0x45, 0x06, 0xb8, 0x0a, 0x78, 0x00, 
+0x72, 0x16, 0x1b, 0x00, 0x43, 0x14, 0xb9, 0x00, 0xc6, 0x09, 0xb4, 0x0b, 
+0x63, 0x06, 0x81, 0x11, 0xad, 0x0a, 0x2b, 0x00, 0xc7, 0x00, 0x35, 0x09, 
+0x49, 0x00, 0x10, 0x0b, 0x73, 0x0b, 0xd7, 0x14, 0x83, 0x00, 0x37, 0x0b, 
+0x10, 0x07, 0x1e, 0x14, 0xbb, 0x0a, 0xdb, 0x09, 0x2c, 0x0a, 0xb2, 0x09, 
+0x2c, 0x18, 0x4d, 0x13, 0xde, 0x00, 0x4c, 0x06, 0x77, 0x00, 0xef, 0x0e, 
+0xfb, 0x00, 0xa9, 0x07, 0xe5, 0x00, 0xf9, 0x0a, 0x71, 0x07, 0x8c, 0x00, 
+0xce, 0x0f, 0x38, 0x08, 0xf8, 0x0e, 0x9d, 0x17, 0xb7, 0x0a, 0x83, 0x09, 
+0xac, 0x0a, 0x20, 0x0c, 0x1a, 0x01, 0xe3, 0x00, 0xf5, 0x09, 0xd4, 0x00, 
+0x1a, 0x0b, 0xb7, 0x00, 0x4f, 0x0a, 0x6b, 0x1a, 0x0e, 0x00, 0x31, 0x0b, 
+0x47, 0x06, 0xf5, 0x08, 0x30, 0x0c, 0x31, 0x0c, 0xe9, 0x00, 0xc7, 0x00, 
+0x84, 0x0a, 0x37, 0x0a, 0xe4, 0x06, 0x9e, 0x08, 0xf7, 0x00, 0x69, 0x08, 
+0x57, 0x18, 0x3f, 0x1c, 0xd5, 0x0b, 0xe8, 0x00, 0xd9, 0x00, 0xa2, 0x07, 
+0x6e, 0x13, 0x2c, 0x01, 0x35, 0x15, 0xde, 0x00, 0xc3, 0x00, 0x6a, 0x13, 
+0x90, 0x0c, 0xdc, 0x0d, 0x63, 0x00, 0x8a, 0x11, 0x3b, 0x0c, 0xef, 0x0a, 
+0xa1, 0x00, 0xdd, 0x0a, 0xcd, 0x0b, 0x39, 0x09, 0x85, 0x00, 0xe0, 0x0b, 
+0xa3, 0x0a, 0xc6, 0x00, 0x73, 0x14, 0x29, 0x01, 0x21, 0x0b, 0x2f, 0x09, 
+0x66, 0x12, 0xbc, 0x0d, 0x44, 0x0a, 0xde, 0x08, 0x5e, 0x18, 0x8f, 0x0b, 
+0xef, 0x00, 0xfa, 0x15, 0xfb, 0x06, 0xa5, 0x0f, 0x53, 0x00, 0x55, 0x00, 
+0x34, 0x0c, 0x12, 0x0b, 0x3a, 0x18, 0x91, 0x0c, 0x18, 0x0c, 0x5e, 0x12, 
+0xf5, 0x00, 0xa8, 0x00, 0x34, 0x0e, 0x19, 0x14, 0xeb, 0x09, 0x7b, 0x00, 
+0x59, 0x0a, 0x49, 0x00, 0xd4, 0x0a, 0x62, 0x09, 0xea, 0x08, 0xf9, 0x00, 
+0x1b, 0x01, 0xa9, 0x09, 0xa7, 0x00, 0xdb, 0x0d, 0x20, 0x01, 0xb9, 0x0b, 
+0x40, 0x0b, 0xbc, 0x14, 0x82, 0x0b, 0x17, 0x00, 0x68, 0x06, 0x6f, 0x0c, 
+0x31, 0x0b, 0xe8, 0x0c, 0xd3, 0x0f, 0x4c, 0x0a, 0xf6, 0x07, 0xa4, 0x06, 
+0x76, 0x0b, 0x2e, 0x01, 0x23, 0x0c, 0x13, 0x01, 0xd4, 0x00, 0xfc, 0x00, 
+0x4c, 0x06, 0xc3, 0x00, 0x03, 0x01, 0xd9, 0x16, 0x08, 0x12, 0x75, 0x0a, 
+0xec, 0x0b, 0xc7, 0x0c, 0x79, 0x07, 0x02, 0x15, 0xe1, 0x07, 0x66, 0x0c, 
+0xec, 0x00, 0xde, 0x07, 0xeb, 0x07, 0x27, 0x12, 0x11, 0x01, 0xda, 0x06, 
+0x29, 0x0b, 0x4c, 0x06, 0xb2, 0x16, 0x36, 0x0c, 0x6c, 0x00, 0xc7, 0x00, 
+0x35, 0x0a, 0x0b, 0x08, 0xbc, 0x14, 0xdd, 0x0b, 0xa2, 0x0b, 0xe5, 0x0b, 
+0xb5, 0x00, 0xe9, 0x09, 0x7c, 0x00, 0xc9, 0x0f, 0x54, 0x11, 0xf9, 0x0e, 
+0x78, 0x08, 0x3a, 0x01, 0x10, 0x07, 0xb1, 0x0a, 0xea, 0x09, 0x93, 0x00, 
+0x5d, 0x11, 0xc5, 0x00, 0x64, 0x09, 0xee, 0x0a, 0xce, 0x06, 0xf5, 0x00, 
+0x94, 0x0b, 0xb3, 0x00, 0xad, 0x1a, 0x63, 0x06, 0xa0, 0x07, 0x98, 0x13, 
+0x93, 0x0b, 0x78, 0x0c, 0x28, 0x01, 0x15, 0x07, 0xeb, 0x07, 0xa8, 0x08, 
+0xbc, 0x00, 0x80, 0x16, 0xb1, 0x00, 0xf3, 0x00, 0xe5, 0x00, 0x3d, 0x01, 
+0x3a, 0x13, 0xa1, 0x15, 0x14, 0x01, 0x60, 0x00, 0xec, 0x00, 0xc2, 0x0e, 
+0x27, 0x00, 0xc3, 0x08, 0x04, 0x01, 0x1f, 0x01, 0xc7, 0x0a, 0x39, 0x0a, 
+0x6e, 0x09, 0x03, 0x01, 0x96, 0x0b, 0xc9, 0x00, 0xdb, 0x09, 0x77, 0x17, 
+0x91, 0x0c, 0x57, 0x1a, 0xe5, 0x0d, 0x03, 0x11, 0x57, 0x07, 0x83, 0x00, 
+0x46, 0x0f, 0x58, 0x00, 0x30, 0x0b, 0x8d, 0x0a, 0x3a, 0x01, 0xba, 0x00, 
+0x02, 0x01, 0x77, 0x0b, 0x21, 0x01, 0xbb, 0x00, 0x5e, 0x07, 0xa0, 0x0f, 
+0xc1, 0x00, 0x0e, 0x08, 0xd9, 0x00, 0x6f, 0x0c, 0x2a, 0x0b, 0xd1, 0x0a, 
+0x44, 0x06, 0xfc, 0x0d, 0xd6, 0x07, 0x37, 0x08, 0xfe, 0x0c, 0x25, 0x01, 
+0x6b, 0x0b, 0x01, 0x01, 0x68, 0x12, 0x81, 0x0a, 0x1b, 0x0f, 0x29, 0x12, 
+0xe9, 0x00, 0xd6, 0x16, 0xf9, 0x13, 0x73, 0x0b, 0xdd, 0x0c, 0x28, 0x11, 
+0xc9, 0x09, 0xeb, 0x0b, 0x0b, 0x00, 0xe5, 0x15, 0xd9, 0x00, 0xd2, 0x06, 
+0x9d, 0x0c, 0xca, 0x00, 0x73, 0x0b, 0x26, 0x15, 0xca, 0x0b, 0x96, 0x0f, 
+0x26, 0x0b, 0x28, 0x00, 0xc9, 0x0a, 0xba, 0x00, 0xae, 0x0a, 0x05, 0x13, 
+0x8a, 0x11, 0x41, 0x06, 0xd5, 0x0b, 0xc7, 0x09, 0x47, 0x00, 0xbb, 0x07, 
+0xba, 0x07, 0x92, 0x0f, 0xfb, 0x00, 0xef, 0x00, 0x6e, 0x00, 0xda, 0x0e, 
+0x6f, 0x09, 0xd2, 0x0c, 0x98, 0x0a, 0xf2, 0x0a, 0x58, 0x06, 0x7c, 0x0c, 
+0x44, 0x06, 0x2e, 0x0a, 0xc2, 0x08, 0xbb, 0x00, 0x0d, 0x00, 0x53, 0x07, 
+0x4b, 0x0f, 0x77, 0x09, 0x33, 0x01, 0xb7, 0x0e, 0xc1, 0x00, 0x61, 0x00, 
+0xb7, 0x17, 0xc5, 0x09, 0xf1, 0x0b, 0x41, 0x01, 0xfc, 0x00, 0x98, 0x13, 
+0xc2, 0x0a, 0xdb, 0x0a, 0x68, 0x12, 0x26, 0x01, 0x95, 0x1a, 0xeb, 0x00, 
+0x80, 0x0a, 0x3d, 0x07, 0x03, 0x13, 0x7e, 0x10, 0x9f, 0x08, 0x38, 0x0c, 
+0x8e, 0x0b, 0xb8, 0x0e, 0x61, 0x06, 0x2f, 0x0a, 0x06, 0x13, 0x43, 0x13, 
+0x3c, 0x18, 0x27, 0x01, 0x8c, 0x12, 0xe4, 0x00, 0x04, 0x01, 0x2c, 0x01, 
+0xff, 0x0c, 0x00, 0x1b, 0x87, 0x00, 0xac, 0x00, 0xdd, 0x0c, 0x9f, 0x0c, 
+0x5b, 0x08, 0x0c, 0x0c, 0x41, 0x06, 0xe2, 0x00, 0x2b, 0x09, 0xd4, 0x0f, 
+0x62, 0x0d, 0x49, 0x00, 0xa2, 0x00, 0x00, 0x01, 0x1a, 0x00, 0xe3, 0x07, 
+0xd9, 0x0a, 0x94, 0x0c, 0x13, 0x01, 0x3b, 0x0b, 0x36, 0x0a, 0xbb, 0x09, 
+0x73, 0x00, 0x86, 0x0d, 0x6f, 0x0f, 0x50, 0x08, 0x12, 0x07, 0x48, 0x12, 
+0x93, 0x12, 0xda, 0x00, 0x1d, 0x01, 0x3d, 0x1a, 0x06, 0x01, 0x46, 0x0c, 
+0x08, 0x01, 0x92, 0x12, 0x09, 0x01, 0xd8, 0x0b, 0xcb, 0x0b, 0x3b, 0x0b, 
+0xae, 0x0a, 0xdb, 0x00, 0x2d, 0x0c, 0x42, 0x01, 0x5d, 0x11, 0x15, 0x0d, 
+0x18, 0x13, 0x2f, 0x08, 0xcf, 0x15, 0x51, 0x17, 0x68, 0x06, 0xaa, 0x00, 
+0x4b, 0x00, 0x90, 0x00, 0xfc, 0x0d, 0x1f, 0x07, 0x6d, 0x1b, 0xb8, 0x0e, 
+0x27, 0x0c, 0x5e, 0x07, 0x70, 0x12, 0x91, 0x00, 0xff, 0x00, 0xd7, 0x00, 
+0x15, 0x01, 0xeb, 0x0a, 0x54, 0x0b, 0xfd, 0x00, 0xf4, 0x00, 0x99, 0x0a, 
+0xf5, 0x00, 0xa1, 0x08, 0x48, 0x00, 0x73, 0x16, 0xba, 0x14, 0xb4, 0x16, 
+0x6f, 0x08, 0x3c, 0x01, 0x2f, 0x00, 0xba, 0x0f, 0x3f, 0x0a, 0xff, 0x0c, 
+0x9b, 0x16, 0xd5, 0x0b, 0x47, 0x00, 0x93, 0x00, 0x2c, 0x01, 0x56, 0x11, 
+0x13, 0x01, 0xbc, 0x08, 0x21, 0x09, 0x01, 0x0a, 0x31, 0x1c, 0xb0, 0x00, 
+0x20, 0x09, 0xf4, 0x06, 0xaf, 0x09, 0xd2, 0x09, 0xe5, 0x0d, 0x79, 0x09, 
+0xc6, 0x0a, 0xd6, 0x0b, 0xd2, 0x0e, 0x20, 0x01, 0xc2, 0x00, 0xe8, 0x09, 
+0x42, 0x06, 0x96, 0x0a, 0x96, 0x0f, 0xbd, 0x10, 0xdf, 0x13, 0x8d, 0x0a, 
+0x41, 0x1b, 0xed, 0x10, 0x0e, 0x19, 0x2b, 0x00, 0xd9, 0x06, 0xab, 0x00, 
+0xe0, 0x13, 0x75, 0x0a, 0x59, 0x0c, 0x22, 0x01, 0x3c, 0x01, 0xd5, 0x11, 
+0x25, 0x01, 0xf2, 0x09, 0x71, 0x12, 0xf1, 0x14, 0xcd, 0x0a, 0x91, 0x0c, 
+0xe6, 0x0d, 0xf3, 0x00, 0xd5, 0x10, 0xc1, 0x00, 0x0c, 0x00, 0x14, 0x0a, 
+0xc7, 0x00, 0x38, 0x0c, 0x1a, 0x08, 0xe1, 0x10, 0xe4, 0x0f, 0x25, 0x0c, 
+0x39, 0x01, 0x50, 0x0d, 0x49, 0x19, 0xc1, 0x00, 0x1c, 0x0b, 0xbb, 0x07, 
+0x02, 0x13, 0x09, 0x0a, 0x2e, 0x01, 0xad, 0x07, 0xaf, 0x0b, 0xe5, 0x00, 
+0x27, 0x14, 0x62, 0x00, 0xa1, 0x00, 0xb4, 0x09, 0x60, 0x07, 0xa3, 0x15, 
+0x20, 0x09, 0xb3, 0x00, 0x43, 0x0b, 0x41, 0x01, 0xd0, 0x12, 0xba, 0x16, 
+0xba, 0x09, 0x17, 0x00, 0xd5, 0x00, 0x39, 0x0d, 0xb5, 0x00, 0x1a, 0x0e, 
+0xd5, 0x00, 0x24, 0x13, 0x51, 0x0b, 0xcd, 0x0b, 0xcc, 0x00, 0xf3, 0x00, 
+0x6d, 0x0a, 0xc5, 0x09, 0x24, 0x00, 0x47, 0x00, 0xaa, 0x0c, 0x31, 0x0b, 
+0x4e, 0x06, 0x1c, 0x0c, 0xbb, 0x00, 0x5b, 0x0c, 0xb5, 0x0b, 0x94, 0x00, 
+0x26, 0x0b, 0xe5, 0x0a, 0x21, 0x16, 0x32, 0x01, 0xb4, 0x0b, 0x87, 0x0d, 
+0x91, 0x00, 0xbf, 0x09, 0x25, 0x1a, 0xa8, 0x08, 0x62, 0x00, 0x19, 0x0c, 
+0xae, 0x11, 0x87, 0x00, 0x59, 0x00, 0x7e, 0x0b, 0x98, 0x0a, 0xad, 0x0a, 
+0x6f, 0x10, 0x68, 0x0b, 0xc7, 0x00, 0x58, 0x06, 0xdb, 0x0a, 0x1b, 0x01, 
+0x33, 0x00, 0x02, 0x0c, 0xfe, 0x00, 0xfb, 0x00, 0xa6, 0x0a, 0xb7, 0x0e, 
+0x0f, 0x0b, 0xcb, 0x0b, 0x8e, 0x16, 0xd2, 0x0f, 0xf0, 0x0b, 0x22, 0x09, 
+0x43, 0x06, 0x34, 0x0a, 0xbe, 0x00, 0xa2, 0x0a, 0xe0, 0x00, 0xe0, 0x0b, 
+0xe4, 0x0a, 0xce, 0x0a, 0x19, 0x0c, 0xaf, 0x00, 0x48, 0x06, 0xdf, 0x00, 
+0x1b, 0x00, 0x27, 0x01, 0xe8, 0x09, 0x55, 0x06, 0xe5, 0x0a, 0x59, 0x00, 
+0x5e, 0x0b, 0x75, 0x12, 0xa9, 0x0c, 0xd1, 0x00, 0x93, 0x0f, 0x45, 0x06, 
+0xba, 0x00, 0x25, 0x00, 0xf5, 0x14, 0x1c, 0x0d, 0x32, 0x18, 0x73, 0x0c, 
+0xb9, 0x0a, 0xc5, 0x09, 0x8b, 0x0a, 0x3d, 0x18, 0xcd, 0x00, 0x1f, 0x01, 
+0x53, 0x00, 0x20, 0x09, 0x46, 0x0e, 0xed, 0x0e, 0xed, 0x08, 0x7c, 0x00, 
+0xd0, 0x06, 0x1d, 0x01, 0x89, 0x16, 0xbd, 0x09, 0xb1, 0x19, 0x53, 0x0d, 
+0x56, 0x0a, 0x6f, 0x14, 0xd8, 0x0b, 0x93, 0x0b, 0x89, 0x10, 0xc6, 0x00, 
+0x57, 0x00, 0x37, 0x0a, 0x0d, 0x00, 0xb2, 0x09, 0xc2, 0x16, 0xed, 0x00, 
+0xb5, 0x00, 0xfa, 0x00, 0x6a, 0x07, 0x42, 0x01, 0xa5, 0x0f, 0xb2, 0x15, 
+0xef, 0x00, 0xda, 0x0a, 0xc9, 0x14, 0x60, 0x0a, 0xcb, 0x09, 0xf5, 0x0c, 
+0xa3, 0x0d, 0x56, 0x11, 0x77, 0x17, 0x53, 0x09, 0xb4, 0x0b, 0xa5, 0x0b, 
+0x0a, 0x01, 0xce, 0x00, 0xed, 0x07, 0xb6, 0x00, 0x75, 0x0a, 0x1d, 0x00, 
+0x31, 0x08, 0xa3, 0x06, 0x93, 0x0c, 0x0f, 0x01, 0x02, 0x0a, 0xec, 0x0b, 
+0x51, 0x17, 0x61, 0x1b, 0xf6, 0x00, 0xcb, 0x0b, 0x48, 0x06, 0x6d, 0x09, 
+0x40, 0x06, 0x85, 0x07, 0x0c, 0x00, 0x78, 0x10, 0xe0, 0x0c, 0xa1, 0x0a, 
+0x59, 0x17, 0x0f, 0x00, 0x71, 0x10, 0xa5, 0x0f, 0xd8, 0x17, 0x63, 0x00, 
+0xe8, 0x0c, 0x47, 0x00, 0xbc, 0x13, 0x20, 0x0a, 0xcf, 0x06, 0x43, 0x06, 
+0x5c, 0x0b, 0xfa, 0x00, 0xe7, 0x09, 0x49, 0x00, 0x6e, 0x0a, 0xed, 0x0b, 
+0x82, 0x18, 0xc8, 0x09, 0x1d, 0x0c, 0x4e, 0x0c, 0x9b, 0x00, 0xaa, 0x0b, 
+0xa9, 0x00, 0xba, 0x08, 0x0d, 0x01, 0xb6, 0x00, 0x40, 0x16, 0x21, 0x01, 
+0x3e, 0x0d, 0xde, 0x0c, 0xb1, 0x08, 0x1f, 0x00, 0xe6, 0x00, 0xca, 0x08, 
+0x0c, 0x0a, 0xce, 0x06, 0x13, 0x07, 0xca, 0x00, 0xbd, 0x0a, 0xcb, 0x0c, 
+0x80, 0x0a, 0xe7, 0x00, 0xa3, 0x17, 0x05, 0x09, 0xbc, 0x09, 0x6b, 0x00, 
+0xd3, 0x00, 0xad, 0x0f, 0xeb, 0x09, 0x9d, 0x0f, 0xb0, 0x00, 0x3a, 0x13, 
+0x3a, 0x18, 0x93, 0x11, 0xf8, 0x0b, 0xcf, 0x06, 0x97, 0x07, 0x8e, 0x12, 
+0xbf, 0x00, 0x51, 0x0c, 0x41, 0x06, 0x46, 0x06, 0x8c, 0x0a, 0x5d, 0x0c, 
+0xcd, 0x1b, 0xdf, 0x08, 0x90, 0x0c, 0xed, 0x00, 0xe1, 0x0c, 0xaa, 0x07, 
+0x78, 0x07, 0x50, 0x0c, 0xd0, 0x06, 0x3f, 0x01, 0x62, 0x00, 0x3a, 0x01, 
+0xb5, 0x16, 0x56, 0x11, 0x05, 0x0b, 0xdd, 0x07, 0xf1, 0x14, 0x24, 0x0c, 
+0xda, 0x07, 0x72, 0x0a, 0xe2, 0x00, 0x11, 0x0d, 0x40, 0x06, 0x0f, 0x01, 
+0x23, 0x0c, 0x04, 0x17, 0x56, 0x00, 0xdc, 0x09, 0x5a, 0x0a, 0xbc, 0x0c, 
+0x90, 0x0c, 0x4a, 0x12, 0xbf, 0x0c, 0x29, 0x01, 0x2e, 0x0c, 0xa5, 0x09, 
+0x59, 0x06, 0x78, 0x06, 0xf2, 0x17, 0xfe, 0x07, 0x7e, 0x0b, 0xef, 0x00, 
+0x59, 0x0d, 0xc0, 0x0b, 0xac, 0x06, 0xb0, 0x12, 0x75, 0x0a, 0x6e, 0x10, 
+0xf4, 0x06, 0x5e, 0x08, 0x0b, 0x0a, 0xe5, 0x0d, 0x6e, 0x09, 0x9e, 0x00, 
+0x60, 0x06, 0x6a, 0x0a, 0x39, 0x0a, 0x32, 0x01, 0x8c, 0x06, 0xf1, 0x0a, 
+0x59, 0x0c, 0xd9, 0x00, 0xdf, 0x00, 0xa9, 0x09, 0x33, 0x0b, 0x01, 0x0c, 
+0x37, 0x01, 0x64, 0x09, 0x48, 0x0c, 0x87, 0x0c, 0xe5, 0x00, 0xde, 0x00, 
+0x35, 0x14, 0x17, 0x0c, 0x07, 0x0a, 0x1e, 0x0a, 0x6f, 0x08, 0x1b, 0x00, 
+0xbb, 0x00, 0x6e, 0x0a, 0xa6, 0x0b, 0x18, 0x1a, 0xa9, 0x08, 0x45, 0x0c, 
+0x60, 0x00, 0x6f, 0x09, 0x88, 0x0c, 0x6d, 0x0a, 0x38, 0x0d, 0x85, 0x11, 
+0x5f, 0x15, 0xb0, 0x0b, 0x1b, 0x0c, 0xea, 0x0c, 0xa8, 0x09, 0x90, 0x0a, 
+0x7d, 0x0b, 0x2e, 0x18, 0x12, 0x0a, 0x42, 0x00, 0xb5, 0x0b, 0x23, 0x0c, 
+0x2f, 0x00, 0xa2, 0x15, 0x5d, 0x12, 0xa9, 0x0a, 0xa7, 0x13, 0xee, 0x0d, 
+0x11, 0x07, 0x18, 0x01, 0xba, 0x0a, 0x6f, 0x08, 0xcc, 0x00, 0xbc, 0x0a, 
+0x77, 0x00, 0x5e, 0x18, 0xff, 0x00, 0xad, 0x0b, 0x86, 0x0d, 0xb7, 0x00, 
+0x17, 0x00, 0xb7, 0x0a, 0xff, 0x09, 0x75, 0x0a, 0x31, 0x01, 0xd1, 0x00, 
+0x4d, 0x09, 0x2a, 0x0a, 0x81, 0x0b, 0x72, 0x09, 0x3e, 0x0b, 0x73, 0x16, 
+0x73, 0x0c, 0x0d, 0x00, 0x40, 0x06, 0x2d, 0x09, 0xa7, 0x09, 0x07, 0x0a, 
+0x8e, 0x08, 0xf8, 0x00, 0xa6, 0x0c, 0xdb, 0x06, 0x4a, 0x0a, 0x2f, 0x00, 
+0xe8, 0x0b, 0x33, 0x01, 0xde, 0x0f, 0x8c, 0x06, 0x6b, 0x0a, 0x12, 0x0e, 
+0x6d, 0x0a, 0xe3, 0x0a, 0x60, 0x0a, 0xe2, 0x10, 0x54, 0x00, 0x5e, 0x15, 
+0xce, 0x00, 0x90, 0x0b, 0x11, 0x07, 0x0a, 0x01, 0x62, 0x00, 0x27, 0x15, 
+0xd3, 0x10, 0x31, 0x12, 0x10, 0x01, 0x1e, 0x0a, 0x3a, 0x12, 0xa6, 0x10, 
+0xea, 0x00, 0xf4, 0x07, 0xce, 0x0a, 0x61, 0x17, 0xe9, 0x00, 0xf5, 0x11, 
+0x57, 0x06, 0xe8, 0x00, 0x2b, 0x0b, 0x73, 0x00, 0xc9, 0x00, 0xeb, 0x0b, 
+0x80, 0x08, 0x69, 0x0c, 0xbc, 0x0a, 0x14, 0x0b, 0xef, 0x00, 0xed, 0x1a, 
+0x8d, 0x11, 0xf1, 0x00, 0x4b, 0x00, 0x5d, 0x08, 0x9c, 0x0f, 0x3a, 0x0c, 
+0x77, 0x09, 0x16, 0x13, 0x70, 0x0c, 0x59, 0x0c, 0xad, 0x0b, 0x40, 0x01, 
+0x01, 0x01, 0xc0, 0x0e, 0xf4, 0x00, 0xe4, 0x11, 0x28, 0x0b, 0xd7, 0x00, 
+0x9d, 0x00, 0x19, 0x13, 0x0d, 0x0c, 0x9d, 0x00, 0x4f, 0x11, 0x2d, 0x01, 
+0x17, 0x01, 0x90, 0x0c, 0x17, 0x01, 0x13, 0x0a, 0x53, 0x00, 0xf3, 0x00, 
+0xf6, 0x0e, 0x91, 0x00, 0xfc, 0x0a, 0x17, 0x0c, 0xbd, 0x0a, 0xb8, 0x0e, 
+0xa4, 0x09, 0xf6, 0x09, 0x7d, 0x16, 0x17, 0x01, 0xdb, 0x0d, 0xd0, 0x00, 
+0x46, 0x06, 0x36, 0x0a, 0xfb, 0x06, 0x36, 0x0a, 0xaf, 0x09, 0x98, 0x06, 
+0x99, 0x0a, 0xb0, 0x00, 0xa5, 0x10, 0x73, 0x08, 0xd8, 0x07, 0xff, 0x00, 
+0x4e, 0x0c, 0x17, 0x00, 0xfe, 0x0f, 0xac, 0x0a, 0x40, 0x06, 0x74, 0x0b, 
+0x2e, 0x01, 0x43, 0x0a, 0x3f, 0x0e, 0x48, 0x06, 0x52, 0x07, 0x72, 0x0b, 
+0x67, 0x00, 0xaa, 0x0b, 0x60, 0x00, 0x43, 0x0a, 0x4a, 0x0a, 0xab, 0x0b, 
+0xe6, 0x00, 0x6f, 0x08, 0x05, 0x09, 0x6f, 0x0f, 0x36, 0x0d, 0x2f, 0x00, 
+0xf5, 0x14, 0xba, 0x06, 0x1d, 0x01, 0x2e, 0x15, 0x78, 0x07, 0x6b, 0x0a, 
+0x0b, 0x00, 0x26, 0x12, 0x29, 0x0a, 0x54, 0x00, 0x29, 0x0a, 0x23, 0x00, 
+0x49, 0x0a, 0xb7, 0x10, 0xfe, 0x00, 0x67, 0x0a, 0xc9, 0x09, 0xdd, 0x00, 
+0x93, 0x0f, 0xb2, 0x00, 0x67, 0x0c, 0xc9, 0x00, 0x7d, 0x0c, 0x1b, 0x07, 
+0x35, 0x09, 0xdd, 0x0c, 0x03, 0x13, 0x79, 0x08, 0x72, 0x0b, 0x11, 0x01, 
+0x14, 0x07, 0x29, 0x0b, 0xa8, 0x00, 0xc1, 0x00, 0x02, 0x01, 0x1a, 0x00, 
+0x24, 0x00, 0x4b, 0x06, 0xaa, 0x0b, 0x3c, 0x0b, 0xc4, 0x0b, 0x43, 0x06, 
+0x35, 0x01, 0x27, 0x14, 0xf3, 0x08, 0xa6, 0x09, 0xe7, 0x00, 0x57, 0x0b, 
+0x36, 0x09, 0x42, 0x12, 0x6e, 0x09, 0x1f, 0x01, 0xf9, 0x00, 0x1e, 0x00, 
+0xc5, 0x00, 0xf5, 0x07, 0xf8, 0x00, 0x18, 0x08, 0x25, 0x00, 0x37, 0x07, 
+0xb7, 0x0a, 0xf2, 0x00, 0x90, 0x0c, 0x44, 0x0a, 0x6b, 0x0a, 0xbd, 0x0a, 
+0x5f, 0x00, 0xc6, 0x09, 0xbe, 0x00, 0x4b, 0x0a, 0xa2, 0x0e, 0x46, 0x0c, 
+0x6b, 0x08, 0x21, 0x07, 0x49, 0x06, 0xc2, 0x0a, 0x93, 0x0b, 0xc8, 0x09, 
+0x47, 0x06, 0xba, 0x09, 0xc8, 0x00, 0xdf, 0x13, 0x1d, 0x07, 0x37, 0x0d, 
+0xb4, 0x0b, 0xbd, 0x00, 0x92, 0x00, 0xdd, 0x0c, 0x23, 0x0c, 0xa4, 0x0a, 
+0xcc, 0x00, 0xc2, 0x16, 0x0f, 0x0b, 0xde, 0x09, 0x61, 0x1b, 0x6b, 0x0d, 
+0x52, 0x0b, 0x72, 0x0c, 0x62, 0x07, 0x0d, 0x01, 0xd3, 0x00, 0x9c, 0x08, 
+0xdc, 0x00, 0x96, 0x00, 0xcf, 0x00, 0xf0, 0x00, 0xd6, 0x14, 0xf1, 0x0b, 
+0xf8, 0x00, 0xe8, 0x00, 0xcd, 0x00, 0xd8, 0x16, 0xe0, 0x0c, 0x2a, 0x07, 
+0x3a, 0x12, 0x49, 0x15, 0x6b, 0x00, 0xbf, 0x00, 0xd0, 0x00, 0x96, 0x0c, 
+0xb2, 0x15, 0x67, 0x08, 0x12, 0x0b, 0x11, 0x01, 0x8b, 0x17, 0x3a, 0x01, 
+0x49, 0x06, 0x62, 0x19, 0x20, 0x14, 0x11, 0x0b, 0x58, 0x19, 0xe1, 0x10, 
+0xe2, 0x08, 0xff, 0x18, 0x27, 0x00, 0x9d, 0x08, 0xc9, 0x00, 0xaf, 0x0a, 
+0xa7, 0x10, 0xd8, 0x00, 0x45, 0x06, 0xec, 0x00, 0xdc, 0x0b, 0x38, 0x0c, 
+0x4b, 0x10, 0x58, 0x14, 0xdd, 0x09, 0x9e, 0x0b, 0xd5, 0x09, 0x64, 0x19, 
+0xda, 0x00, 0x6d, 0x09, 0x40, 0x06, 0xd9, 0x00, 0xc7, 0x00, 0x6e, 0x00, 
+0x3e, 0x01, 0x4b, 0x06, 0xc7, 0x14, 0x4b, 0x13, 0xe1, 0x0c, 0x5f, 0x0a, 
+0x68, 0x1b, 0x02, 0x0d, 0xf2, 0x17, 0x27, 0x12, 0x0e, 0x01, 0x1e, 0x0f, 
+0xb8, 0x0e, 0x5a, 0x17, 0x8d, 0x06, 0x36, 0x01, 0x2e, 0x0c, 0x0b, 0x00, 
+0xb1, 0x00, 0x40, 0x0e, 0x5b, 0x00, 0x30, 0x08, 0x74, 0x0f, 0x4d, 0x00, 
+0xe7, 0x0a, 0xbd, 0x00, 0x2d, 0x01, 0xaa, 0x09, 0xaf, 0x09, 0x2d, 0x0a, 
+0x40, 0x0b, 0x2d, 0x01, 0xbf, 0x00, 0x11, 0x0c, 0xe5, 0x00, 0xe9, 0x00, 
+0x6e, 0x00, 0x55, 0x06, 0x4a, 0x00, 0x57, 0x00, 0x95, 0x11, 0x1c, 0x01, 
+0x93, 0x0c, 0x28, 0x07, 0x61, 0x07, 0xe5, 0x00, 0x42, 0x18, 0x6c, 0x00, 
+0x1c, 0x0d, 0x26, 0x0b, 0x3d, 0x01, 0x73, 0x08, 0x73, 0x0b, 0xeb, 0x16, 
+0xff, 0x10, 0xe1, 0x0b, 0x06, 0x01, 0xc2, 0x0c, 0xe2, 0x0b, 0xf7, 0x0c, 
+0x8d, 0x06, 0x5f, 0x00, 0x2f, 0x01, 0xc0, 0x00, 0xd0, 0x15, 0x38, 0x09, 
+0x2a, 0x01, 0x73, 0x0b, 0x67, 0x0b, 0x66, 0x09, 0x3e, 0x01, 0x90, 0x00, 
+0xa1, 0x06, 0x02, 0x16, 0xfc, 0x09, 0x9d, 0x0c, 0xd6, 0x0b, 0xcd, 0x14, 
+0xed, 0x0c, 0x93, 0x08, 0xcc, 0x09, 0x30, 0x01, 0x79, 0x10, 0x1a, 0x0f, 
+0x3a, 0x13, 0x3f, 0x00, 0xdd, 0x00, 0xf5, 0x00, 0x6a, 0x0d, 0x4b, 0x11, 
+0xff, 0x00, 0x20, 0x15, 0xa6, 0x10, 0xb6, 0x00, 0xc9, 0x0c, 0x56, 0x11, 
+0x98, 0x15, 0xe4, 0x13, 0xd2, 0x00, 0x13, 0x0b, 0xcc, 0x07, 0x12, 0x07, 
+0x65, 0x0d, 0xd9, 0x06, 0x60, 0x0a, 0x39, 0x0c, 0x44, 0x14, 0x5f, 0x00, 
+0x5f, 0x00, 0xb4, 0x0f, 0xcf, 0x00, 0x41, 0x06, 0x31, 0x01, 0x96, 0x0c, 
+0x9f, 0x08, 0xb5, 0x17, 0xc0, 0x00, 0xcd, 0x00, 0xdf, 0x0c, 0xc7, 0x09, 
+0xf5, 0x00, 0xd0, 0x00, 0xe0, 0x00, 0x39, 0x13, 0xda, 0x00, 0xf8, 0x0c, 
+0xa7, 0x0c, 0xee, 0x0e, 0x36, 0x11, 0xad, 0x06, 0xd1, 0x09, 0x8e, 0x06, 
+0x5d, 0x08, 0xe1, 0x0b, 0xed, 0x00, 0xfe, 0x00, 0xd0, 0x00, 0x56, 0x11, 
+0xab, 0x00, 0x20, 0x07, 0x10, 0x01, 0x70, 0x1a, 0x7d, 0x00, 0xf0, 0x0b, 
+0x47, 0x0e, 0xd9, 0x12, 0x9c, 0x00, 0x3b, 0x0c, 0x87, 0x17, 0xa3, 0x07, 
+0xc4, 0x08, 0x7a, 0x0c, 0xd8, 0x07, 0xff, 0x09, 0xdc, 0x00, 0x4f, 0x06, 
+0xfc, 0x00, 0x1b, 0x00, 0xf1, 0x00, 0x00, 0x01, 0xfc, 0x00, 0x3e, 0x13, 
+0x43, 0x06, 0xdd, 0x17, 0xbb, 0x17, 0x59, 0x00, 0xb1, 0x0c, 0x63, 0x07, 
+0xce, 0x0b, 0x93, 0x0c, 0xe0, 0x0b, 0x39, 0x13, 0xd4, 0x11, 0xd3, 0x0a, 
+0x0b, 0x01, 0x5f, 0x18, 0x40, 0x0a, 0xc2, 0x00, 0xd4, 0x10, 0xfb, 0x0f, 
+0xb4, 0x0c, 0x2a, 0x01, 0x36, 0x01, 0xdc, 0x14, 0x09, 0x16, 0x9c, 0x0c, 
+0x30, 0x0e, 0xbc, 0x0c, 0x9c, 0x08, 0xc2, 0x08, 0x5c, 0x0c, 0xc2, 0x0a, 
+0xbc, 0x08, 0xc4, 0x00, 0xf1, 0x00, 0x1e, 0x01, 0x53, 0x00, 0x46, 0x14, 
+0xbb, 0x07, 0x82, 0x0b, 0x9d, 0x00, 0x3f, 0x0b, 0x55, 0x15, 0x3e, 0x09, 
+0x9b, 0x0c, 0x32, 0x00, 0x34, 0x01, 0x03, 0x15, 0x0f, 0x01, 0x1f, 0x0a, 
+0x2a, 0x00, 0xa3, 0x06, 0xea, 0x08, 0xe8, 0x13, 0x23, 0x01, 0x03, 0x01, 
+0xbc, 0x1c, 0x85, 0x17, 0x6b, 0x00, 0xfd, 0x10, 0x87, 0x17, 0x45, 0x0c, 
+0x3b, 0x0b, 0xfa, 0x16, 0x13, 0x07, 0x53, 0x00, 0x47, 0x00, 0x85, 0x17, 
+0x41, 0x06, 0xc2, 0x00, 0xd0, 0x0a, 0x3d, 0x00, 0xab, 0x16, 0xc6, 0x0d, 
+0xeb, 0x07, 0xe9, 0x00, 0x03, 0x01, 0x07, 0x0b, 0x39, 0x01, 0x81, 0x09, 
+0x26, 0x0b, 0x03, 0x08, 0xc1, 0x17, 0x3b, 0x0b, 0xe3, 0x08, 0xc0, 0x10, 
+0xdf, 0x00, 0x34, 0x0a, 0x1b, 0x01, 0x46, 0x06, 0xb0, 0x0a, 0xbd, 0x00, 
+0xb9, 0x00, 0x67, 0x08, 0x33, 0x0b, 0xf2, 0x18, 0x81, 0x0c, 0x17, 0x00, 
+0x88, 0x0b, 0xdc, 0x00, 0x5c, 0x08, 0xce, 0x06, 0x2b, 0x0a, 0x07, 0x18, 
+0x37, 0x07, 0xa7, 0x10, 0x8c, 0x0b, 0x83, 0x0a, 0xbd, 0x0b, 0x87, 0x00, 
+0x0e, 0x13, 0xe9, 0x09, 0x71, 0x0c, 0xbb, 0x17, 0x52, 0x0b, 0xd6, 0x0b, 
+0x4a, 0x06, 0x7a, 0x18, 0x86, 0x17, 0xd0, 0x0a, 0x97, 0x0b, 0x0f, 0x00, 
+0xc0, 0x1c, 0x25, 0x07, 0x6c, 0x00, 0x58, 0x0a, 0x04, 0x01, 0x95, 0x07, 
+0xe7, 0x10, 0x93, 0x0b, 0x60, 0x00, 0xf2, 0x0a, 0x2f, 0x0e, 0xb8, 0x15, 
+0xf7, 0x09, 0xe9, 0x00, 0x60, 0x0a, 0x19, 0x0c, 0x03, 0x0c, 0x89, 0x0c, 
+0xcb, 0x00, 0xbc, 0x08, 0x7d, 0x0b, 0x83, 0x00, 0x0e, 0x0c, 0xc1, 0x16, 
+0x47, 0x00, 0x80, 0x0a, 0xa8, 0x00, 0x8c, 0x06, 0x3f, 0x0e, 0xec, 0x00, 
+0xf6, 0x0e, 0xc0, 0x00, 0xc9, 0x0c, 0x03, 0x13, 0x5b, 0x08, 0x6f, 0x08, 
+0xcd, 0x00, 0x70, 0x00, 0xac, 0x0b, 0x8b, 0x0b, 0x70, 0x07, 0xa6, 0x0f, 
+0xfc, 0x00, 0x1a, 0x0b, 0xe5, 0x0d, 0x25, 0x01, 0xd6, 0x16, 0xb2, 0x00, 
+0xd6, 0x0b, 0x49, 0x0b, 0xef, 0x0d, 0x26, 0x08, 0xfd, 0x00, 0xc5, 0x0c, 
+0x38, 0x01, 0x66, 0x12, 0x1e, 0x16, 0x28, 0x01, 0xac, 0x0a, 0x62, 0x19, 
+0x89, 0x0b, 0x57, 0x18, 0xdf, 0x08, 0x42, 0x0b, 0x9a, 0x11, 0x5a, 0x08, 
+0x07, 0x01, 0xa7, 0x0c, 0x94, 0x0b, 0x04, 0x09, 0x93, 0x0f, 0xf8, 0x0b, 
+0x5b, 0x08, 0xb0, 0x0a, 0x42, 0x01, 0x9f, 0x11, 0x54, 0x0a, 0x0c, 0x01, 
+0x25, 0x01, 0x24, 0x0c, 0x11, 0x15, 0x15, 0x14, 0xb9, 0x0a, 0xd8, 0x0a, 
+0xc2, 0x0a, 0x09, 0x01, 0x37, 0x01, 0xd0, 0x06, 0xd1, 0x06, 0xd9, 0x00, 
+0xe3, 0x13, 0x69, 0x06, 0xbc, 0x00, 0x71, 0x0c, 0x57, 0x0a, 0xa0, 0x07, 
+0x12, 0x0e, 0x3a, 0x01, 0xfb, 0x0b, 0x90, 0x16, 0x55, 0x00, 0x25, 0x00, 
+0x01, 0x07, 0x08, 0x0c, 0xe4, 0x07, 0x3e, 0x0a, 0xe3, 0x00, 0xa7, 0x0a, 
+0xd3, 0x00, 0xca, 0x0c, 0xf0, 0x0e, 0x22, 0x09, 0x9a, 0x0a, 0xd4, 0x09, 
+0x24, 0x00, 0x0d, 0x01, 0xb5, 0x00, 0x43, 0x06, 0x85, 0x11, 0x47, 0x06, 
+0xf3, 0x0c, 0x2d, 0x15, 0x1a, 0x0b, 0xf4, 0x08, 0x1d, 0x0e, 0xf2, 0x09, 
+0x0e, 0x0c, 0x1e, 0x0a, 0x01, 0x0a, 0x7b, 0x18, 0xad, 0x0a, 0x37, 0x0b, 
+0x30, 0x01, 0x67, 0x0c, 0x15, 0x01, 0x2f, 0x00, 0xfd, 0x09, 0x5f, 0x0c, 
+0x94, 0x00, 0xc9, 0x0a, 0x3a, 0x0c, 0xec, 0x06, 0x02, 0x01, 0x46, 0x0b, 
+0x0c, 0x00, 0xba, 0x00, 0x80, 0x0a, 0x61, 0x00, 0x21, 0x01, 0x32, 0x01, 
+0xc8, 0x00, 0x82, 0x11, 0x18, 0x00, 0x8d, 0x06, 0x88, 0x0b, 0x61, 0x08, 
+0xa9, 0x0c, 0x46, 0x0c, 0x1c, 0x01, 0x12, 0x0a, 0x03, 0x17, 0x9e, 0x00, 
+0x1e, 0x07, 0x72, 0x06, 0xc0, 0x0b, 0xe6, 0x0a, 0x2e, 0x0a, 0xb5, 0x00, 
+0x66, 0x12, 0x23, 0x01, 0x39, 0x01, 0x51, 0x13, 0xa5, 0x00, 0x2e, 0x18, 
+0x3a, 0x01, 0x7a, 0x18, 0xfd, 0x00, 0x86, 0x19, 0x41, 0x06, 0x91, 0x06, 
+0xb5, 0x0c, 0xdb, 0x0f, 0xb0, 0x0a, 0x6a, 0x0b, 0x18, 0x00, 0xd1, 0x06, 
+0xe4, 0x06, 0x91, 0x09, 0xdb, 0x0a, 0xe6, 0x00, 0xbc, 0x00, 0x70, 0x07, 
+0x3d, 0x01, 0x3e, 0x00, 0x5b, 0x00, 0x34, 0x0a, 0x06, 0x0f, 0x9d, 0x0c, 
+0x04, 0x13, 0x56, 0x11, 0x15, 0x0a, 0x85, 0x0c, 0x5a, 0x0c, 0x40, 0x06, 
+0xe5, 0x00, 0xdf, 0x0c, 0xae, 0x10, 0x17, 0x00, 0xb0, 0x00, 0xc6, 0x00, 
+0xab, 0x00, 0x53, 0x00, 0x2d, 0x01, 0x0f, 0x0b, 0x96, 0x15, 0x05, 0x0f, 
+0x4e, 0x17, 0xcf, 0x06, 0x17, 0x0c, 0x6b, 0x00, 0x81, 0x18, 0xee, 0x0b, 
+0x62, 0x06, 0x88, 0x0d, 0x13, 0x01, 0xe9, 0x0a, 0xf1, 0x09, 0xde, 0x08, 
+0x08, 0x01, 0x62, 0x09, 0xb7, 0x0b, 0xe1, 0x00, 0x42, 0x14, 0x37, 0x01, 
+0xd9, 0x0b, 0xc2, 0x0b, 0x8b, 0x0c, 0x15, 0x13, 0x75, 0x0b, 0xad, 0x0a, 
+0x66, 0x08, 0xe4, 0x0b, 0x31, 0x00, 0x48, 0x0c, 0x21, 0x0b, 0x5b, 0x08, 
+0x1e, 0x14, 0x17, 0x00, 0x24, 0x00, 0xc7, 0x00, 0xf7, 0x1a, 0xae, 0x0f, 
+0xc1, 0x00, 0x98, 0x06, 0x8a, 0x12, 0x7c, 0x16, 0xc8, 0x11, 0xab, 0x00, 
+0xb5, 0x08, 0xa4, 0x09, 0x73, 0x07, 0xb1, 0x0c, 0x87, 0x17, 0xf6, 0x0b, 
+0x73, 0x14, 0xeb, 0x09, 0xea, 0x09, 0x39, 0x12, 0x11, 0x01, 0x60, 0x0f, 
+0x4d, 0x0b, 0xe3, 0x00, 0x04, 0x0c, 0x46, 0x0d, 0xed, 0x0f, 0x47, 0x00, 
+0xb4, 0x0b, 0xcf, 0x14, 0xf9, 0x00, 0xd4, 0x09, 0xd3, 0x0a, 0xb5, 0x00, 
+0xa3, 0x0a, 0xd2, 0x00, 0x63, 0x09, 0xb4, 0x17, 0xea, 0x00, 0xc1, 0x00, 
+0xe6, 0x06, 0x0e, 0x01, 0x65, 0x09, 0xe3, 0x00, 0xe2, 0x0c, 0x87, 0x08, 
+0xd7, 0x00, 0x25, 0x0b, 0x07, 0x01, 0x73, 0x0c, 0xa9, 0x00, 0x5f, 0x00, 
+0xef, 0x00, 0x28, 0x0a, 0x17, 0x00, 0x80, 0x0a, 0xd3, 0x10, 0x15, 0x14, 
+0xd7, 0x00, 0xb7, 0x00, 0x41, 0x06, 0x69, 0x00, 0x24, 0x00, 0xd6, 0x00, 
+0xb7, 0x11, 0xe9, 0x08, 0x74, 0x0f, 0x62, 0x09, 0x05, 0x0c, 0xa7, 0x0c, 
+0xbb, 0x14, 0x41, 0x09, 0xd7, 0x15, 0xa7, 0x00, 0xcd, 0x15, 0x22, 0x0c, 
+0x23, 0x0c, 0x1c, 0x00, 0x3e, 0x09, 0x67, 0x0b, 0xb0, 0x1a, 0xaa, 0x00, 
+0x0f, 0x0b, 0xc7, 0x14, 0x8f, 0x06, 0x3e, 0x00, 0x21, 0x01, 0x9f, 0x00, 
+0xa7, 0x00, 0xb5, 0x0b, 0xa9, 0x16, 0x18, 0x0c, 0xb1, 0x00, 0xad, 0x0a, 
+0x9b, 0x06, 0xeb, 0x0c, 0x9f, 0x07, 0x1d, 0x00, 0xd1, 0x09, 0x9c, 0x00, 
+0x00, 0x01, 0x15, 0x13, 0xd1, 0x0a, 0xd1, 0x0a, 0x42, 0x06, 0x4b, 0x00, 
+0x0b, 0x00, 0xf7, 0x0f, 0xa1, 0x06, 0x33, 0x01, 0x91, 0x00, 0x16, 0x13, 
+0xed, 0x00, 0xda, 0x10, 0x39, 0x01, 0x8e, 0x16, 0x9b, 0x00, 0x78, 0x0a, 
+0xb0, 0x00, 0x40, 0x01, 0x33, 0x0a, 0x25, 0x0b, 0x66, 0x08, 0x9e, 0x0b, 
+0x25, 0x09, 0x56, 0x00, 0xe0, 0x00, 0x5b, 0x0a, 0x10, 0x07, 0xee, 0x07, 
+0x93, 0x0b, 0x2c, 0x0b, 0xf6, 0x09, 0x63, 0x17, 0xd7, 0x0c, 0x95, 0x0c, 
+0x8b, 0x10, 0xf5, 0x0d, 0xd6, 0x17, 0x99, 0x07, 0xdf, 0x13, 0x91, 0x00, 
+0x6b, 0x00, 0x1a, 0x0d, 0x1f, 0x1a, 0x46, 0x06, 0x57, 0x06, 0x37, 0x0a, 
+0x4d, 0x11, 0x0d, 0x10, 0xe3, 0x0a, 0xb4, 0x0f, 0x30, 0x01, 0x1a, 0x0e, 
+0x4d, 0x00, 0x92, 0x00, 0x36, 0x08, 0x1d, 0x0a, 0xde, 0x08, 0x9d, 0x0c, 
+0x1a, 0x08, 0xaf, 0x09, 0x48, 0x0e, 0x2d, 0x0c, 0xac, 0x0c, 0x76, 0x0a, 
+0x23, 0x00, 0xce, 0x06, 0x77, 0x00, 0x5e, 0x18, 0xcb, 0x0b, 0xdf, 0x00, 
+0x02, 0x01, 0xf7, 0x10, 0x20, 0x15, 0x20, 0x09, 0xe0, 0x08, 0xa7, 0x09, 
+0xba, 0x1b, 0x9b, 0x00, 0x3f, 0x09, 0x63, 0x0d, 0xc7, 0x00, 0xe8, 0x0c, 
+0x94, 0x0f, 0xff, 0x0c, 0x09, 0x01, 0x10, 0x00, 0x35, 0x0d, 0x1d, 0x0a, 
+0x5d, 0x0b, 0x03, 0x0a, 0x0d, 0x00, 0x29, 0x01, 0xdd, 0x0c, 0xf2, 0x09, 
+0x45, 0x06, 0x02, 0x15, 0x8f, 0x06, 0x55, 0x00, 0x9e, 0x08, 0x70, 0x08, 
+0x1b, 0x08, 0x7a, 0x0c, 0x40, 0x06, 0xdf, 0x00, 0xc0, 0x0b, 0xe6, 0x09, 
+0xdb, 0x06, 0x70, 0x08, 0x04, 0x01, 0xa4, 0x09, 0x61, 0x06, 0x9d, 0x0c, 
+0x9b, 0x0c, 0x30, 0x01, 0x91, 0x0a, 0x30, 0x00, 0x34, 0x0b, 0xd2, 0x00, 
+0xdd, 0x0d, 0x5d, 0x17, 0x09, 0x01, 0xcf, 0x00, 0x93, 0x00, 0x1d, 0x07, 
+0x81, 0x00, 0xa7, 0x00, 0x9b, 0x0c, 0x62, 0x00, 0x05, 0x01, 0xbf, 0x00, 
+0x40, 0x0b, 0x9f, 0x0b, 0x47, 0x06, 0x77, 0x00, 0xe3, 0x06, 0xee, 0x0a, 
+0xa9, 0x00, 0xbc, 0x0c, 0x49, 0x00, 0x32, 0x00, 0x1d, 0x01, 0x2c, 0x01, 
+0xd1, 0x09, 0xd2, 0x0a, 0x09, 0x01, 0xa7, 0x0f, 0x0d, 0x13, 0x46, 0x1c, 
+0xeb, 0x07, 0xbf, 0x0c, 0x6b, 0x00, 0xed, 0x0e, 0x90, 0x09, 0x31, 0x00, 
+0x15, 0x0a, 0x0c, 0x00, 0x6f, 0x0c, 0xd5, 0x00, 0x52, 0x07, 0xf8, 0x00, 
+0xee, 0x0a, 0x4a, 0x0a, 0xfd, 0x00, 0xe3, 0x1a, 0xbf, 0x0b, 0xbb, 0x16, 
+0x6f, 0x08, 0xf0, 0x00, 0x54, 0x00, 0xff, 0x00, 0xd8, 0x0b, 0xb6, 0x00, 
+0x9d, 0x0c, 0xae, 0x0a, 0xe3, 0x18, 0xa6, 0x09, 0xa1, 0x15, 0x32, 0x01, 
+0xf4, 0x0c, 0x4c, 0x0a, 0xd3, 0x06, 0xd1, 0x00, 0xe0, 0x00, 0xbb, 0x09, 
+0x17, 0x0c, 0x16, 0x0e, 0x8f, 0x00, 0xb2, 0x0c, 0x67, 0x09, 0xa4, 0x09, 
+0xbd, 0x10, 0x00, 0x09, 0x97, 0x06, 0x7a, 0x0c, 0x4e, 0x17, 0x51, 0x17, 
+0xd0, 0x00, 0x30, 0x0e, 0x86, 0x0c, 0xed, 0x00, 0xb5, 0x07, 0xa7, 0x0c, 
+0xe9, 0x0d, 0x9b, 0x0c, 0x38, 0x01, 0xf4, 0x18, 0x46, 0x0b, 0x65, 0x0c, 
+0x3a, 0x1a, 0x54, 0x00, 0x3c, 0x0b, 0x67, 0x12, 0xa0, 0x00, 0x4a, 0x0a, 
+0xd1, 0x06, 0x26, 0x00, 0x5b, 0x17, 0x2d, 0x09, 0x90, 0x00, 0xa0, 0x00, 
+0x32, 0x00, 0xb3, 0x0c, 0x38, 0x10, 0xe5, 0x13, 0x4f, 0x00, 0xa0, 0x11, 
+0x94, 0x0b, 0x49, 0x0c, 0x4e, 0x0c, 0x6d, 0x00, 0x9e, 0x0b, 0xb9, 0x0e, 
+0x8d, 0x16, 0x48, 0x00, 0xa0, 0x12, 0xfc, 0x0b, 0x70, 0x13, 0xa2, 0x00, 
+0x17, 0x00, 0xa7, 0x0c, 0x34, 0x14, 0xf3, 0x0a, 0x82, 0x0a, 0xee, 0x06, 
+0xd0, 0x18, 0x94, 0x07, 0x5e, 0x0b, 0x06, 0x0b, 0xa9, 0x00, 0xb0, 0x00, 
+0x9c, 0x08, 0xbf, 0x14, 0x94, 0x0f, 0x1c, 0x0e, 0x00, 0x0d, 0xaf, 0x0a, 
+0x39, 0x01, 0xa7, 0x09, 0xf7, 0x00, 0x85, 0x00, 0x5d, 0x11, 0x88, 0x00, 
+0x16, 0x14, 0xd5, 0x0b, 0xb2, 0x0c, 0x92, 0x00, 0x49, 0x12, 0x45, 0x00, 
+0x7a, 0x0a, 0xf7, 0x08, 0x1a, 0x00, 0xab, 0x0c, 0x2d, 0x09, 0x29, 0x12, 
+0xe2, 0x00, 0x12, 0x0a, 0xf1, 0x00, 0x46, 0x0d, 0x3c, 0x00, 0x32, 0x01, 
+0x64, 0x17, 0x33, 0x0a, 0x88, 0x0c, 0x5e, 0x18, 0xc1, 0x0b, 0x0c, 0x0c, 
+0x57, 0x0a, 0x28, 0x00, 0x05, 0x0d, 0x8b, 0x13, 0x78, 0x08, 0x3a, 0x01, 
+0x99, 0x11, 0x12, 0x0b, 0xfa, 0x16, 0xef, 0x0e, 0x46, 0x09, 0xc1, 0x00, 
+0xc0, 0x0b, 0xdf, 0x17, 0x13, 0x0d, 0x39, 0x01, 0x79, 0x10, 0xf7, 0x0f, 
+0xad, 0x00, 0x0c, 0x0c, 0x72, 0x0b, 0xcb, 0x0b, 0xfb, 0x00, 0xf4, 0x06, 
+0x4d, 0x0e, 0x26, 0x01, 0x03, 0x01, 0xa0, 0x0b, 0x8c, 0x0b, 0x31, 0x07, 
+0x25, 0x07, 0x96, 0x0a, 0x84, 0x00, 0xaa, 0x16, 0xa1, 0x0a, 0xce, 0x00, 
+0x70, 0x0a, 0xe1, 0x10, 0xf3, 0x17, 0x08, 0x01, 0x55, 0x00, 0xd9, 0x00, 
+0xaf, 0x19, 0x28, 0x0a, 0x2f, 0x07, 0xe6, 0x0a, 0xd8, 0x0a, 0xba, 0x0f, 
+0xc0, 0x0b, 0x2a, 0x0a, 0x98, 0x0b, 0x43, 0x0c, 0x08, 0x0a, 0xc3, 0x0b, 
+0x5a, 0x0a, 0x4a, 0x0c, 0xf8, 0x00, 0xe4, 0x0a, 0x30, 0x0c, 0xb0, 0x0a, 
+0x7e, 0x0b, 0x30, 0x01, 0x13, 0x01, 0xcb, 0x00, 0xef, 0x07, 0x68, 0x0b, 
+0x17, 0x13, 0xde, 0x00, 0x94, 0x16, 0x41, 0x06, 0x25, 0x09, 0xbd, 0x0c, 
+0xb1, 0x00, 0x5c, 0x0b, 0xd9, 0x00, 0x2e, 0x08, 0xab, 0x00, 0xa9, 0x00, 
+0x42, 0x12, 0x45, 0x0c, 0x39, 0x01, 0xe9, 0x0c, 0xfa, 0x15, 0x7a, 0x18, 
+0x26, 0x00, 0xe4, 0x00, 0xfb, 0x06, 0x4b, 0x11, 0xa1, 0x0b, 0x28, 0x0c, 
+0x3c, 0x01, 0x10, 0x00, 0xa9, 0x0b, 0x4e, 0x00, 0xaf, 0x00, 0x96, 0x00, 
+0x30, 0x0c, 0x30, 0x0b, 0x78, 0x08, 0xb7, 0x0b, 0xe4, 0x00, 0x59, 0x07, 
+0xc3, 0x0b, 0x5d, 0x0a, 0x18, 0x00, 0x68, 0x0b, 0x5f, 0x0a, 0xed, 0x08, 
+0xe3, 0x00, 0xeb, 0x0b, 0x6e, 0x10, 0x20, 0x09, 0x64, 0x17, 0x35, 0x09, 
+0xe1, 0x07, 0x0d, 0x0c, 0x93, 0x11, 0xf9, 0x0b, 0x97, 0x0b, 0x07, 0x01, 
+0x1f, 0x14, 0xca, 0x09, 0xf6, 0x0e, 0x51, 0x0c, 0xc1, 0x0c, 0xe8, 0x0c, 
+0x2f, 0x01, 0x5e, 0x08, 0x4d, 0x00, 0xbe, 0x0c, 0x1a, 0x08, 0xa8, 0x00, 
+0x94, 0x0f, 0xf9, 0x00, 0x7b, 0x17, 0x63, 0x19, 0xb0, 0x00, 0x41, 0x01, 
+0xfc, 0x15, 0x0f, 0x16, 0xb4, 0x00, 0xc3, 0x0a, 0xf2, 0x13, 0xcd, 0x0b, 
+0xcf, 0x00, 0xb6, 0x0e, 0x87, 0x0a, 0xea, 0x0a, 0xe8, 0x0c, 0x93, 0x00, 
+0x48, 0x06, 0xcf, 0x06, 0x55, 0x06, 0x03, 0x16, 0x37, 0x00, 0xec, 0x10, 
+0xdd, 0x00, 0x4b, 0x14, 0xbd, 0x0c, 0x14, 0x01, 0x7d, 0x0b, 0x01, 0x0c, 
+0xa4, 0x12, 0x72, 0x0b, 0x35, 0x0a, 0xbc, 0x09, 0x5f, 0x06, 0xf3, 0x0a, 
+0x0d, 0x0c, 0x2c, 0x01, 0x64, 0x00, 0xdb, 0x0b, 0x23, 0x09, 0x7a, 0x08, 
+0xa8, 0x00, 0x36, 0x0a, 0x55, 0x06, 0x07, 0x01, 0x24, 0x0f, 0xef, 0x00, 
+0xe1, 0x0b, 0xe1, 0x0f, 0xee, 0x00, 0xc7, 0x09, 0x00, 0x01, 0xcf, 0x06, 
+0x1d, 0x0a, 0x2a, 0x00, 0xc7, 0x00, 0x6a, 0x07, 0x0d, 0x00, 0x41, 0x01, 
+0x3b, 0x0b, 0x63, 0x0a, 0x98, 0x0f, 0xda, 0x0d, 0x09, 0x01, 0xb9, 0x00, 
+0x43, 0x06, 0x36, 0x09, 0x22, 0x01, 0x1b, 0x08, 0xd2, 0x00, 0xec, 0x00, 
+0xb1, 0x00, 0x56, 0x18, 0x55, 0x07, 0xc7, 0x09, 0x8b, 0x12, 0xdd, 0x00, 
+0x53, 0x0b, 0xf3, 0x14, 0x40, 0x0e, 0x1e, 0x07, 0xa3, 0x0c, 0xd1, 0x0e, 
+0x8e, 0x06, 0x3f, 0x00, 0xef, 0x00, 0x23, 0x0c, 0xc5, 0x00, 0x29, 0x08, 
+0xd4, 0x00, 0x78, 0x09, 0x9c, 0x0c, 0x1c, 0x0b, 0x4d, 0x0e, 0x3b, 0x0a, 
+0x22, 0x09, 0x32, 0x00, 0x03, 0x16, 0x2e, 0x0a, 0x21, 0x09, 0x94, 0x0b, 
+0x6a, 0x0a, 0x3f, 0x01, 0x5d, 0x07, 0x01, 0x14, 0xec, 0x00, 0x8f, 0x12, 
+0x6d, 0x00, 0x84, 0x00, 0x72, 0x08, 0x09, 0x01, 0x36, 0x01, 0xdd, 0x0c, 
+0x8d, 0x0d, 0x4a, 0x0a, 0xa0, 0x00, 0xbd, 0x16, 0x3e, 0x0d, 0xce, 0x0a, 
+0x18, 0x08, 0xb7, 0x0e, 0x79, 0x08, 0x42, 0x13, 0x4d, 0x11, 0xc7, 0x00, 
+0x31, 0x01, 0xd6, 0x0b, 0xa9, 0x08, 0x20, 0x15, 0x64, 0x00, 0xab, 0x00, 
+0x1c, 0x08, 0x31, 0x00, 0x49, 0x00, 0x8f, 0x0a, 0xad, 0x0a, 0xeb, 0x0b, 
+0x86, 0x0a, 0x3d, 0x01, 0xe4, 0x0d, 0x5d, 0x07, 0xb6, 0x0e, 0x2d, 0x01, 
+0x71, 0x0a, 0xdc, 0x0d, 0xd8, 0x16, 0x75, 0x0a, 0xbe, 0x0c, 0xfb, 0x0a, 
+0xc3, 0x08, 0xe9, 0x00, 0x10, 0x01, 0x1d, 0x0c, 0x1d, 0x08, 0xef, 0x00, 
+0x09, 0x01, 0xd8, 0x09, 0x5f, 0x0b, 0xf3, 0x0a, 0xfc, 0x15, 0x63, 0x00, 
+0xe8, 0x00, 0xc8, 0x0c, 0xe7, 0x00, 0x01, 0x0c, 0x32, 0x12, 0xd4, 0x09, 
+0xd6, 0x17, 0x4b, 0x10, 0x78, 0x00, 0x91, 0x00, 0xad, 0x0a, 0xd0, 0x09, 
+0x1a, 0x00, 0x58, 0x06, 0x5d, 0x1a, 0xd3, 0x0c, 0x60, 0x00, 0xa2, 0x15, 
+0x9c, 0x08, 0xa9, 0x16, 0x6e, 0x13, 0xc9, 0x00, 0x7e, 0x0b, 0x76, 0x0b, 
+0xa4, 0x0a, 0x9e, 0x0b, 0x4a, 0x15, 0xbf, 0x0b, 0xf7, 0x0e, 0x24, 0x0c, 
+0xe2, 0x0b, 0x56, 0x11, 0xdb, 0x00, 0x31, 0x0c, 0x34, 0x00, 0x71, 0x0c, 
+0x5f, 0x18, 0x86, 0x17, 0xb0, 0x10, 0x60, 0x15, 0x2c, 0x18, 0x8c, 0x13, 
+0xed, 0x00, 0x07, 0x0b, 0xca, 0x09, 0x23, 0x01, 0xe9, 0x00, 0xaa, 0x08, 
+0x81, 0x1b, 0x8e, 0x11, 0x6d, 0x0a, 0xe8, 0x00, 0x60, 0x00, 0xee, 0x0e, 
+0xd7, 0x09, 0xcd, 0x15, 0x17, 0x01, 0xee, 0x00, 0x40, 0x06, 0x48, 0x00, 
+0x18, 0x14, 0x3c, 0x0b, 0x58, 0x17, 0xbc, 0x00, 0xbc, 0x06, 0x32, 0x18, 
+0xcf, 0x0b, 0x36, 0x01, 0x13, 0x01, 0x1f, 0x07, 0x20, 0x01, 0xef, 0x0b, 
+0x61, 0x00, 0x61, 0x00, 0x7c, 0x16, 0xdd, 0x09, 0x6f, 0x10, 0xc3, 0x08, 
+0x20, 0x01, 0x5e, 0x0b, 0x9b, 0x15, 0x50, 0x11, 0x01, 0x01, 0xab, 0x1a, 
+0x04, 0x0b, 0x06, 0x09, 0x06, 0x0b, 0xb3, 0x0a, 0x76, 0x0c, 0x0f, 0x19, 
+0x89, 0x00, 0xaa, 0x0b, 0x0e, 0x01, 0x62, 0x0a, 0x60, 0x0a, 0xca, 0x09, 
+0x56, 0x07, 0x13, 0x0e, 0x03, 0x17, 0xb7, 0x00, 0xd9, 0x06, 0xfd, 0x14, 
+0x94, 0x07, 0x44, 0x0c, 0x41, 0x0b, 0xae, 0x0b, 0x4e, 0x0a, 0x17, 0x0e, 
+0xe4, 0x0d, 0x3b, 0x00, 0xd0, 0x00, 0x23, 0x0c, 0xd1, 0x18, 0xf5, 0x07, 
+0x1b, 0x0e, 0x6c, 0x1b, 0x46, 0x0b, 0x58, 0x11, 0x0b, 0x01, 0xe5, 0x00, 
+0xe6, 0x00, 0x06, 0x01, 0xf6, 0x0b, 0xd8, 0x07, 0x20, 0x01, 0x69, 0x17, 
+0xed, 0x00, 0x30, 0x12, 0x9d, 0x00, 0xd6, 0x09, 0xf4, 0x00, 0x71, 0x08, 
+0xfe, 0x00, 0xe6, 0x00, 0x6e, 0x0d, 0x3e, 0x01, 0xa9, 0x00, 0xb9, 0x1b, 
+0x22, 0x01, 0x54, 0x00, 0xe5, 0x0d, 0x12, 0x0b, 0x1e, 0x16, 0xb7, 0x0d, 
+0x29, 0x01, 0x46, 0x08, 0x44, 0x0c, 0xcc, 0x0b, 0xfa, 0x00, 0x1c, 0x08, 
+0x47, 0x00, 0xdb, 0x0d, 0xdd, 0x00, 0x85, 0x17, 0xdb, 0x0b, 0x0a, 0x01, 
+0x37, 0x0d, 0xc3, 0x10, 0x17, 0x00, 0x28, 0x01, 0xbc, 0x09, 0xf7, 0x0b, 
+0x9e, 0x0b, 0x4b, 0x00, 0x26, 0x14, 0xb3, 0x00, 0x55, 0x0b, 0xec, 0x00, 
+0x4a, 0x1c, 0xaa, 0x00, 0x6d, 0x11, 0xc4, 0x00, 0x13, 0x0e, 0xcc, 0x00, 
+0x66, 0x0a, 0xf9, 0x0b, 0x23, 0x09, 0x59, 0x0b, 0x1e, 0x01, 0xb6, 0x09, 
+0xd0, 0x00, 0x2c, 0x16, 0xe6, 0x09, 0xd8, 0x00, 0x1f, 0x16, 0x4f, 0x0c, 
+0x39, 0x13, 0xa6, 0x13, 0xb1, 0x09, 0xb1, 0x0c, 0x34, 0x0f, 0xa9, 0x16, 
+0x95, 0x00, 0x97, 0x15, 0xc0, 0x0e, 0x04, 0x13, 0x22, 0x0e, 0xd3, 0x0c, 
+0xce, 0x00, 0xe2, 0x00, 0xe3, 0x00, 0xee, 0x00, 0xc6, 0x14, 0xfb, 0x16, 
+0x1c, 0x00, 0x0e, 0x01, 0x0c, 0x00, 0x3e, 0x01, 0x1f, 0x01, 0x9d, 0x00, 
+0xda, 0x0d, 0x7c, 0x16, 0x58, 0x17, 0x6e, 0x10, 0xcd, 0x07, 0x59, 0x17, 
+0x5a, 0x08, 0x4c, 0x18, 0xce, 0x06, 0x30, 0x08, 0x09, 0x0a, 0xea, 0x00, 
+0x1a, 0x0b, 0x43, 0x0c, 0xfd, 0x00, 0x50, 0x15, 0x84, 0x00, 0xdf, 0x08, 
+0x27, 0x00, 0xeb, 0x00, 0xd5, 0x0b, 0xbd, 0x00, 0x34, 0x01, 0x32, 0x01, 
+0x47, 0x0d, 0x4f, 0x06, 0xd0, 0x15, 0x2f, 0x0c, 0xb8, 0x0b, 0x05, 0x16, 
+0xbf, 0x0f, 0x3e, 0x00, 0x0b, 0x00, 0x43, 0x06, 0x60, 0x12, 0xdc, 0x0f, 
+0xe1, 0x0b, 0xbc, 0x00, 0x30, 0x07, 0xd1, 0x00, 0x03, 0x0c, 0x28, 0x0b, 
+0x5a, 0x00, 0xf3, 0x17, 0x1e, 0x1a, 0x5d, 0x07, 0x4a, 0x14, 0x66, 0x18, 
+0xb0, 0x1a, 0x65, 0x00, 0x86, 0x16, 0x67, 0x11, 0xb5, 0x00, 0x1a, 0x01, 
+0xcf, 0x00, 0xf1, 0x14, 0xc5, 0x14, 0x51, 0x17, 0xfc, 0x00, 0x02, 0x0c, 
+0x73, 0x0b, 0x8c, 0x0b, 0xe2, 0x07, 0x83, 0x10, 0xcc, 0x00, 0x62, 0x0e, 
+0x60, 0x0a, 0xe9, 0x08, 0x3e, 0x09, 0xeb, 0x00, 0x6f, 0x00, 0xba, 0x00, 
+0x24, 0x08, 0xaa, 0x15, 0xc9, 0x0f, 0xbf, 0x00, 0xd1, 0x00, 0x54, 0x00, 
+0xb8, 0x00, 0x9c, 0x08, 0x54, 0x0a, 0xad, 0x0b, 0x00, 0x0d, 0xf4, 0x00, 
+0x26, 0x0b, 0xd8, 0x18, 0xfc, 0x00, 0x42, 0x0a, 0x9c, 0x08, 0xd3, 0x11, 
+0x55, 0x07, 0x6d, 0x00, 0xb3, 0x16, 0x4b, 0x00, 0xba, 0x09, 0xc0, 0x0b, 
+0x4d, 0x09, 0x99, 0x15, 0xff, 0x0c, 0x74, 0x0c, 0xba, 0x0b, 0x30, 0x12, 
+0x28, 0x00, 0xc4, 0x00, 0x38, 0x01, 0xfa, 0x00, 0x23, 0x01, 0xca, 0x00, 
+0x90, 0x00, 0xa8, 0x00, 0x80, 0x0a, 0xa7, 0x08, 0x51, 0x17, 0xde, 0x13, 
+0xb7, 0x0e, 0xdb, 0x09, 0x40, 0x0b, 0xd2, 0x09, 0xc3, 0x0a, 0xa7, 0x14, 
+0xbc, 0x0c, 0xd9, 0x0a, 0xd4, 0x09, 0x5d, 0x08, 0xaf, 0x19, 0x9c, 0x00, 
+0x3a, 0x01, 0xfa, 0x00, 0xed, 0x08, 0x0c, 0x0c, 0x40, 0x06, 0x34, 0x0a, 
+0x2e, 0x09, 0x3b, 0x08, 0xba, 0x0a, 0x5e, 0x15, 0xa0, 0x15, 0xae, 0x1c, 
+0xa3, 0x0b, 0x39, 0x0c, 0xb8, 0x0e, 0x1d, 0x01, 0x4b, 0x11, 0x0f, 0x00, 
+0x9c, 0x18, 0xb3, 0x00, 0xf3, 0x09, 0xb5, 0x0b, 0x30, 0x00, 0x29, 0x0a, 
+0xad, 0x0f, 0x7d, 0x0b, 0xab, 0x00, 0xaf, 0x00, 0x96, 0x0a, 0x39, 0x0a, 
+0xb1, 0x12, 0xdf, 0x00, 0x1c, 0x17, 0x69, 0x0b, 0x23, 0x00, 0x4f, 0x17, 
+0x32, 0x18, 0x9c, 0x0f, 0x96, 0x00, 0xef, 0x0a, 0xac, 0x0a, 0x97, 0x00, 
+0xce, 0x15, 0x47, 0x00, 0x1e, 0x07, 0x6f, 0x09, 0xe2, 0x00, 0xe0, 0x00, 
+0xf1, 0x0e, 0xae, 0x0a, 0x2d, 0x01, 0xa7, 0x0b, 0x1a, 0x01, 0xd2, 0x0e, 
+0xb7, 0x00, 0x03, 0x15, 0x5d, 0x12, 0x9c, 0x08, 0xe9, 0x08, 0x96, 0x16, 
+0xdb, 0x10, 0x51, 0x0c, 0x8b, 0x10, 0xc1, 0x00, 0xc7, 0x00, 0x80, 0x0b, 
+0xae, 0x00, 0xc1, 0x0b, 0x49, 0x06, 0xb1, 0x08, 0xf4, 0x0c, 0x46, 0x08, 
+0x03, 0x0c, 0x25, 0x0c, 0x41, 0x06, 0x65, 0x0a, 0xc6, 0x09, 0xfb, 0x00, 
+0x99, 0x06, 0x20, 0x09, 0x85, 0x0b, 0x12, 0x01, 0x0d, 0x00, 0x0c, 0x01, 
+0xaa, 0x06, 0x87, 0x00, 0x1f, 0x1b, 0xde, 0x14, 0x67, 0x0c, 0x47, 0x0a, 
+0x1b, 0x0b, 0x8c, 0x0a, 0x8b, 0x0b, 0x30, 0x00, 0x7f, 0x00, 0x96, 0x0a, 
+0xba, 0x0e, 0x1e, 0x15, 0x3b, 0x12, 0x54, 0x00, 0x33, 0x01, 0xf9, 0x0a, 
+0x24, 0x13, 0xd0, 0x00, 0x66, 0x1b, 0xb1, 0x08, 0x0d, 0x01, 0xed, 0x07, 
+0x09, 0x0b, 0xc8, 0x00, 0xcd, 0x00, 0x54, 0x09, 0xff, 0x00, 0xdb, 0x0b, 
+0xf6, 0x0d, 0x8c, 0x0b, 0x59, 0x0c, 0xef, 0x0b, 0x5e, 0x11, 0x11, 0x01, 
+0xff, 0x09, 0xbc, 0x0c, 0x36, 0x0b, 0x60, 0x07, 0xf8, 0x0b, 0x66, 0x18, 
+0x39, 0x08, 0xd1, 0x14, 0x9b, 0x00, 0x25, 0x00, 0xda, 0x07, 0x90, 0x00, 
+0xc9, 0x09, 0xfe, 0x09, 0xe8, 0x0c, 0x3d, 0x07, 0xd9, 0x00, 0x4e, 0x0c, 
+0xa4, 0x0a, 0x27, 0x0d, 0xf1, 0x00, 0xa7, 0x13, 0xfd, 0x08, 0x4c, 0x14, 
+0x9f, 0x0c, 0x85, 0x0c, 0x6d, 0x00, 0xeb, 0x0b, 0xdd, 0x09, 0x8f, 0x06, 
+0x23, 0x0e, 0x6f, 0x0c, 0x13, 0x0a, 0x9b, 0x19, 0xdd, 0x06, 0xe6, 0x00, 
+0xca, 0x0c, 0x29, 0x0e, 0x61, 0x0a, 0xba, 0x14, 0xdd, 0x0c, 0x0e, 0x13, 
+0x2e, 0x0c, 0x61, 0x0b, 0xf0, 0x0a, 0xa7, 0x08, 0xf0, 0x0b, 0x33, 0x00, 
+0xdb, 0x06, 0x73, 0x0b, 0x2f, 0x01, 0x7a, 0x0c, 0x41, 0x12, 0xe3, 0x09, 
+0x3a, 0x0c, 0x30, 0x0b, 0x05, 0x01, 0x31, 0x00, 0x4e, 0x19, 0x2c, 0x0b, 
+0xd0, 0x09, 0x4a, 0x06, 0xc0, 0x0c, 0x01, 0x17, 0xf4, 0x13, 0x23, 0x01, 
+0x0c, 0x01, 0x77, 0x09, 0xd8, 0x00, 0x11, 0x01, 0x9d, 0x00, 0x26, 0x13, 
+0xde, 0x0c, 0x7b, 0x00, 0x49, 0x0a, 0x05, 0x01, 0x1f, 0x08, 0x03, 0x01, 
+0x4d, 0x0b, 0x99, 0x13, 0x3f, 0x0b, 0xe3, 0x10, 0xf9, 0x13, 0xe1, 0x0b, 
+0x4e, 0x06, 0xd5, 0x00, 0x98, 0x0a, 0xec, 0x00, 0x30, 0x0c, 0x47, 0x00, 
+0xfb, 0x00, 0x8f, 0x06, 0x35, 0x00, 0x04, 0x01, 0x42, 0x01, 0x11, 0x00, 
+0x1a, 0x01, 0x7a, 0x1a, 0x49, 0x06, 0xce, 0x06, 0xf8, 0x09, 0xbb, 0x00, 
+0x24, 0x0f, 0xf1, 0x00, 0xcc, 0x0f, 0x42, 0x12, 0x37, 0x0a, 0xf6, 0x0d, 
+0xfc, 0x15, 0x93, 0x0c, 0x08, 0x01, 0x2f, 0x0c, 0xf0, 0x0a, 0x90, 0x00, 
+0xad, 0x0a, 0xbc, 0x0f, 0xcf, 0x18, 0xa3, 0x0a, 0x9e, 0x08, 0x76, 0x00, 
+0x2b, 0x01, 0xe8, 0x0a, 0x1e, 0x01, 0x60, 0x07, 0x4e, 0x17, 0xac, 0x07, 
+0x3d, 0x00, 0x06, 0x0b, 0xb6, 0x11, 0xd9, 0x0a, 0x94, 0x07, 0xe4, 0x00, 
+0xf4, 0x08, 0x14, 0x0b, 0x04, 0x01, 0x6d, 0x00, 0x40, 0x16, 0xdb, 0x0d, 
+0x49, 0x0b, 0x1f, 0x01, 0x96, 0x10, 0x93, 0x0b, 0x53, 0x0b, 0x96, 0x1a, 
+0x16, 0x01, 0xb9, 0x0e, 0xb1, 0x00, 0x36, 0x08, 0x3c, 0x00, 0xaf, 0x00, 
+0x70, 0x09, 0x64, 0x0a, 0xf4, 0x0c, 0xd1, 0x06, 0x37, 0x07, 0xb6, 0x00, 
+0x5a, 0x0c, 0x20, 0x01, 0xde, 0x0a, 0x2d, 0x14, 0xa0, 0x0f, 0xea, 0x13, 
+0xc2, 0x0e, 0x30, 0x00, 0xf9, 0x00, 0xbe, 0x00, 0xe8, 0x0c, 0xee, 0x0b, 
+0x11, 0x0b, 0x97, 0x06, 0xfd, 0x09, 0xe0, 0x00, 0x5f, 0x00, 0x64, 0x00, 
+0x10, 0x13, 0x28, 0x00, 0x1c, 0x0b, 0x81, 0x0a, 0xf4, 0x00, 0x18, 0x01, 
+0xbf, 0x0b, 0x39, 0x0c, 0x62, 0x0a, 0x1f, 0x16, 0x27, 0x01, 0x31, 0x01, 
+0xf2, 0x09, 0xb7, 0x11, 0x11, 0x07, 0x3c, 0x0b, 0x29, 0x00, 0xd6, 0x0f, 
+0xd2, 0x0c, 0xe9, 0x00, 0xec, 0x0b, 0xf6, 0x00, 0x65, 0x09, 0xb1, 0x0c, 
+0x81, 0x09, 0x52, 0x17, 0xe7, 0x00, 0xae, 0x11, 0xdc, 0x09, 0xa9, 0x00, 
+0x25, 0x07, 0xe4, 0x07, 0x47, 0x09, 0xc8, 0x00, 0xc4, 0x0b, 0x78, 0x08, 
+0x98, 0x0b, 0x7a, 0x10, 0xc2, 0x0a, 0x70, 0x06, 0xa7, 0x00, 0x2a, 0x0f, 
+0x43, 0x06, 0xf2, 0x14, 0x0b, 0x01, 0xaf, 0x09, 0xf7, 0x0f, 0x54, 0x00, 
+0x42, 0x06, 0x54, 0x00, 0xd6, 0x18, 0x3b, 0x0b, 0x4a, 0x00, 0xd5, 0x00, 
+0x41, 0x0c, 0x15, 0x01, 0x41, 0x00, 0x1f, 0x13, 0xcd, 0x15, 0xad, 0x0a, 
+0xf2, 0x00, 0x24, 0x0c, 0xbb, 0x17, 0xbf, 0x0b, 0xc2, 0x0c, 0x39, 0x01, 
+0x6e, 0x00, 0x18, 0x00, 0xea, 0x13, 0x04, 0x13, 0x3b, 0x1a, 0x0f, 0x0b, 
+0xde, 0x16, 0x10, 0x07, 0xfe, 0x15, 0x3e, 0x01, 0xed, 0x00, 0x44, 0x14, 
+0x3e, 0x0b, 0xbc, 0x0e, 0x0c, 0x0c, 0x13, 0x00, 0xbd, 0x00, 0x2d, 0x08, 
+0xca, 0x09, 0xe4, 0x0a, 0xc5, 0x00, 0xda, 0x0d, 0x6b, 0x06, 0x4d, 0x0d, 
+0xe5, 0x1a, 0xad, 0x00, 0x8e, 0x13, 0x3d, 0x0b, 0x3e, 0x01, 0xb4, 0x08, 
+0xf1, 0x00, 0xb2, 0x13, 0xef, 0x06, 0x3b, 0x1a, 0xad, 0x0a, 0x26, 0x14, 
+0xac, 0x00, 0x54, 0x07, 0x4b, 0x06, 0xfc, 0x09, 0x8a, 0x13, 0xcc, 0x0b, 
+0x33, 0x00, 0x18, 0x1a, 0x2f, 0x01, 0x15, 0x14, 0xea, 0x09, 0x4e, 0x19, 
+0x2e, 0x0c, 0xe6, 0x09, 0xfc, 0x00, 0xe7, 0x00, 0xc9, 0x09, 0x59, 0x13, 
+0x14, 0x01, 0x90, 0x00, 0x04, 0x16, 0xec, 0x09, 0x9e, 0x0c, 0xfb, 0x14, 
+0x6f, 0x10, 0xf9, 0x00, 0xb5, 0x0c, 0x19, 0x1a, 0xc9, 0x0e, 0x20, 0x15, 
+0xa1, 0x0a, 0xaa, 0x00, 0xcf, 0x0a, 0xf1, 0x0a, 0xa5, 0x10, 0x37, 0x09, 
+0x00, 0x14, 0x1c, 0x01, 0x9d, 0x0c, 0x87, 0x0c, 0x81, 0x0c, 0x1a, 0x10, 
+0xd7, 0x09, 0x20, 0x15, 0xd0, 0x09, 0xd4, 0x11, 0x19, 0x0d, 0x98, 0x00, 
+0xf1, 0x0a, 0xe6, 0x16, 0x74, 0x00, 0xf1, 0x00, 0xda, 0x00, 0x1e, 0x10, 
+0xda, 0x11, 0x1f, 0x01, 0x70, 0x08, 0xa2, 0x00, 0xdc, 0x0d, 0x48, 0x0c, 
+0x90, 0x00, 0x64, 0x06, 0x2b, 0x09, 0xbb, 0x00, 0x92, 0x00, 0x81, 0x0a, 
+0x2c, 0x00, 0x9a, 0x06, 0xbc, 0x09, 0x53, 0x09, 0x20, 0x09, 0x92, 0x0c, 
+0xc4, 0x14, 0x5c, 0x08, 0x15, 0x13, 0x41, 0x00, 0xd9, 0x09, 0x04, 0x01, 
+0x7b, 0x1b, 0xc0, 0x0c, 0x12, 0x0d, 0x80, 0x08, 0xdf, 0x08, 0x39, 0x01, 
+0x07, 0x0a, 0x17, 0x13, 0x08, 0x0a, 0x32, 0x00, 0x2d, 0x09, 0x40, 0x06, 
+0x43, 0x0a, 0xa9, 0x16, 0x3d, 0x00, 0x9c, 0x08, 0xfd, 0x00, 0x20, 0x0a, 
+0x94, 0x0f, 0x3f, 0x0a, 0xb9, 0x0b, 0xeb, 0x15, 0x09, 0x08, 0xdc, 0x00, 
+0x0f, 0x0b, 0xc8, 0x0a, 0x13, 0x01, 0x29, 0x0a, 0xba, 0x09, 0xcd, 0x0e, 
+0x4d, 0x06, 0x9c, 0x1a, 0x4f, 0x17, 0x67, 0x08, 0xda, 0x0f, 0x5b, 0x08, 
+0x28, 0x0a, 0xa5, 0x09, 0xe1, 0x00, 0xc0, 0x00, 0x78, 0x00, 0x8f, 0x0d, 
+0xf8, 0x17, 0xee, 0x08, 0xab, 0x00, 0x9c, 0x0c, 0x12, 0x0c, 0xd7, 0x07, 
+0x9d, 0x08, 0x71, 0x07, 0x88, 0x09, 0x7d, 0x0b, 0x9e, 0x0b, 0xec, 0x08, 
+0xad, 0x00, 0xca, 0x0f, 0xaf, 0x0a, 0x55, 0x0e, 0x6a, 0x0e, 0xc1, 0x00, 
+0xe7, 0x00, 0xcd, 0x15, 0xb2, 0x00, 0x62, 0x06, 0x94, 0x16, 0xac, 0x0b, 
+0x44, 0x0c, 0x29, 0x01, 0xe3, 0x0b, 0xb6, 0x00, 0x67, 0x0b, 0xd1, 0x0a, 
+0x26, 0x0b, 0x90, 0x0a, 0x68, 0x0b, 0xc4, 0x14, 0xeb, 0x08, 0x3f, 0x09, 
+0x24, 0x08, 0x38, 0x01, 0xd6, 0x00, 0x9b, 0x0a, 0xe0, 0x00, 0x09, 0x01, 
+0xb7, 0x16, 0x61, 0x0a, 0xdc, 0x00, 0x96, 0x15, 0xf3, 0x09, 0x6e, 0x10, 
+0x8d, 0x0d, 0xac, 0x00, 0xcf, 0x00, 0x2a, 0x0b, 0x75, 0x13, 0xed, 0x00, 
+0x0b, 0x01, 0xf3, 0x17, 0xa6, 0x0c, 0x25, 0x01, 0xa9, 0x00, 0x42, 0x06, 
+0xbe, 0x0c, 0xba, 0x14, 0xde, 0x16, 0x55, 0x07, 0x46, 0x0e, 0x5a, 0x00, 
+0xbe, 0x00, 0x2c, 0x09, 0x60, 0x00, 0xe1, 0x00, 0xe9, 0x0a, 0x3c, 0x0b, 
+0x49, 0x00, 0x6d, 0x00, 0xb2, 0x07, 0x70, 0x0c, 0x4a, 0x15, 0xd6, 0x00, 
+0x55, 0x11, 0xe8, 0x00, 0x3d, 0x0c, 0x3d, 0x07, 0x1c, 0x0f, 0x35, 0x01, 
+0x7d, 0x0b, 0xfc, 0x0a, 0x92, 0x0c, 0xde, 0x0e, 0x42, 0x06, 0x01, 0x0c, 
+0xdf, 0x00, 0x57, 0x00, 0x46, 0x0e, 0x89, 0x17, 0xe7, 0x00, 0xf7, 0x0e, 
+0x66, 0x12, 0x02, 0x0a, 0x8a, 0x11, 0x96, 0x15, 0x1e, 0x14, 0xe6, 0x00, 
+0xb2, 0x00, 0x65, 0x00, 0x9f, 0x08, 0xa4, 0x09, 0xf1, 0x0a, 0x53, 0x0b, 
+0x1a, 0x0c, 0xa0, 0x0c, 0xf5, 0x08, 0xad, 0x00, 0x3e, 0x0b, 0x26, 0x01, 
+0xf6, 0x00, 0xaf, 0x00, 0x69, 0x07, 0x1e, 0x08, 0x25, 0x00, 0xed, 0x07, 
+0x9f, 0x0b, 0x29, 0x01, 0x65, 0x0a, 0x68, 0x0c, 0x3a, 0x18, 0x51, 0x0b, 
+0xbb, 0x00, 0xa0, 0x0b, 0x27, 0x08, 0xdf, 0x00, 0xad, 0x00, 0xd9, 0x06, 
+0x86, 0x0d, 0x65, 0x0c, 0xbc, 0x17, 0xe8, 0x00, 0xa4, 0x15, 0x03, 0x0c, 
+0xe1, 0x0c, 0x29, 0x0a, 0x78, 0x10, 0x45, 0x06, 0x09, 0x0b, 0x4a, 0x0a, 
+0x27, 0x01, 0xab, 0x0b, 0xe0, 0x08, 0xab, 0x0b, 0xda, 0x0a, 0xc9, 0x08, 
+0x41, 0x00, 0x73, 0x16, 0x7e, 0x0c, 0x48, 0x09, 0x30, 0x08, 0xbb, 0x00, 
+0x3c, 0x01, 0x87, 0x0a, 0x34, 0x01, 0x2e, 0x08, 0xfe, 0x0c, 0x42, 0x14, 
+0xa3, 0x0a, 0x37, 0x01, 0x64, 0x0a, 0xa4, 0x09, 0xcb, 0x00, 0xd2, 0x00, 
+0x1b, 0x00, 0x4c, 0x06, 0xd6, 0x15, 0xee, 0x06, 0x0f, 0x01, 0x90, 0x1b, 
+0x8b, 0x00, 0xfa, 0x14, 0xc0, 0x0e, 0x55, 0x06, 0x41, 0x06, 0xa5, 0x0a, 
+0x85, 0x16, 0x27, 0x12, 0x28, 0x12, 0x79, 0x0a, 0x56, 0x06, 0xd7, 0x00, 
+0x20, 0x0a, 0x30, 0x01, 0xb1, 0x13, 0x0f, 0x0b, 0xcd, 0x0a, 0x03, 0x08, 
+0xe5, 0x00, 0x66, 0x14, 0x1d, 0x01, 0x44, 0x0a, 0xb7, 0x11, 0x29, 0x01, 
+0xe2, 0x15, 0x5a, 0x0c, 0x67, 0x13, 0xdd, 0x00, 0xc6, 0x00, 0xa9, 0x0b, 
+0xf6, 0x00, 0x85, 0x0c, 0xbb, 0x09, 0x46, 0x06, 0xd2, 0x0b, 0x07, 0x0a, 
+0x70, 0x0e, 0x87, 0x18, 0x3c, 0x00, 0x89, 0x09, 0x01, 0x0c, 0x1b, 0x0c, 
+0x19, 0x0c, 0xd7, 0x07, 0x1c, 0x01, 0x93, 0x00, 0xb6, 0x0b, 0xfd, 0x0a, 
+0xb4, 0x07, 0xc3, 0x08, 0xa1, 0x0a, 0xe0, 0x00, 0x59, 0x0c, 0x01, 0x01, 
+0x97, 0x15, 0xda, 0x0d, 0x7d, 0x1b, 0x74, 0x0b, 0xc0, 0x0c, 0x4f, 0x0a, 
+0x13, 0x01, 0x78, 0x00, 0xf1, 0x09, 0x01, 0x01, 0xd6, 0x16, 0x55, 0x00, 
+0xb5, 0x0c, 0xa7, 0x00, 0x80, 0x08, 0x61, 0x0a, 0x97, 0x0b, 0x9a, 0x00, 
+0x2b, 0x17, 0x6f, 0x10, 0xf2, 0x13, 0x38, 0x0c, 0xac, 0x00, 0x3a, 0x09, 
+0x8d, 0x0a, 0x43, 0x14, 0x28, 0x0a, 0x55, 0x11, 0x3b, 0x01, 0x59, 0x0c, 
+0x82, 0x0c, 0xee, 0x08, 0xe8, 0x0c, 0xad, 0x0b, 0x6d, 0x00, 0x1a, 0x00, 
+0xd0, 0x00, 0xd5, 0x10, 0xee, 0x00, 0x36, 0x01, 0xdc, 0x00, 0x44, 0x14, 
+0xd1, 0x00, 0x9f, 0x0b, 0x4b, 0x13, 0x63, 0x09, 0x8f, 0x00, 0x76, 0x0a, 
+0x47, 0x0d, 0xc6, 0x00, 0xcd, 0x0b, 0xfb, 0x00, 0xc0, 0x00, 0xa4, 0x09, 
+0x67, 0x0c, 0xee, 0x0d, 0x4a, 0x00, 0x91, 0x1a, 0x44, 0x06, 0xa4, 0x09, 
+0x6d, 0x09, 0x1a, 0x0f, 0x7b, 0x12, 0xcd, 0x00, 0x03, 0x01, 0xe3, 0x06, 
+0x3c, 0x0b, 0xf8, 0x10, 0x56, 0x18, 0xb6, 0x09, 0xbe, 0x00, 0xbf, 0x00, 
+0x1a, 0x0c, 0xaa, 0x15, 0x4f, 0x0c, 0x58, 0x18, 0x41, 0x0a, 0x2f, 0x0e, 
+0x92, 0x0c, 0x9b, 0x19, 0x1b, 0x0b, 0xf1, 0x00, 0xf3, 0x09, 0x12, 0x01, 
+0xa7, 0x00, 0x29, 0x01, 0x35, 0x0a, 0x1b, 0x0e, 0x9e, 0x00, 0x37, 0x01, 
+0x19, 0x0d, 0xfa, 0x00, 0xf3, 0x0c, 0x2f, 0x00, 0x04, 0x01, 0xcf, 0x0a, 
+0x00, 0x0d, 0x89, 0x18, 0x87, 0x00, 0xb0, 0x10, 0x88, 0x0b, 0x2d, 0x0c, 
+0xa3, 0x00, 0x2a, 0x0b, 0x78, 0x00, 0x97, 0x0a, 0x2e, 0x01, 0x3b, 0x00, 
+0xc2, 0x00, 0x91, 0x00, 0x3e, 0x01, 0x84, 0x00, 0x7b, 0x0a, 0x48, 0x06, 
+0x25, 0x01, 0x35, 0x0a, 0x14, 0x0a, 0x21, 0x10, 0x0d, 0x00, 0xee, 0x00, 
+0x56, 0x18, 0xb8, 0x0a, 0x3a, 0x17, 0x3d, 0x0b, 0x9f, 0x07, 0x01, 0x0c, 
+0x10, 0x07, 0x91, 0x00, 0x2a, 0x01, 0xf6, 0x0b, 0x80, 0x00, 0xad, 0x0f, 
+0x37, 0x07, 0x6a, 0x0a, 0xe4, 0x00, 0xd8, 0x0a, 0x8a, 0x0b, 0x44, 0x06, 
+0x27, 0x12, 0x38, 0x08, 0xfc, 0x07, 0x42, 0x0e, 0x3b, 0x01, 0x30, 0x0c, 
+0x28, 0x00, 0xc1, 0x09, 0x1e, 0x0a, 0xcd, 0x0b, 0x2b, 0x0a, 0x51, 0x0b, 
+0x31, 0x12, 0x1c, 0x0f, 0x56, 0x0a, 0x18, 0x11, 0xb3, 0x00, 0x8a, 0x09, 
+0x54, 0x07, 0xc8, 0x09, 0xf7, 0x00, 0xab, 0x0b, 0x6c, 0x1b, 0xfe, 0x00, 
+0x72, 0x0b, 0x11, 0x0b, 0x1f, 0x01, 0xb9, 0x00, 0x27, 0x0b, 0x4f, 0x16, 
+0x72, 0x07, 0xd5, 0x14, 0xc7, 0x09, 0x80, 0x0b, 0x0c, 0x00, 0xfb, 0x00, 
+0x50, 0x0c, 0x71, 0x09, 0x63, 0x17, 0xf3, 0x0c, 0x81, 0x09, 0x73, 0x0b, 
+0x81, 0x11, 0x4d, 0x0a, 0xb0, 0x09, 0x19, 0x0c, 0xc8, 0x00, 0xf8, 0x10, 
+0x6e, 0x14, 0xb2, 0x15, 0xdb, 0x09, 0x77, 0x00, 0x41, 0x0a, 0x3f, 0x01, 
+0x45, 0x06, 0xfa, 0x00, 0x28, 0x12, 0x32, 0x01, 0x17, 0x01, 0xca, 0x09, 
+0x2a, 0x0b, 0x38, 0x0c, 0x97, 0x15, 0x65, 0x11, 0x3d, 0x01, 0x23, 0x00, 
+0xeb, 0x0b, 0x07, 0x01, 0x5a, 0x0c, 0x3b, 0x0b, 0xcd, 0x0f, 0x96, 0x07, 
+0x2c, 0x09, 0xd3, 0x00, 0xb5, 0x00, 0xaa, 0x08, 0x01, 0x01, 0x6b, 0x06, 
+0xd7, 0x18, 0x56, 0x00, 0xbc, 0x12, 0x23, 0x0c, 0x2f, 0x01, 0x60, 0x00, 
+0x48, 0x0b, 0x90, 0x00, 0x40, 0x01, 0x30, 0x00, 0x87, 0x00, 0xe0, 0x0a, 
+0x3b, 0x1a, 0xa2, 0x0a, 0x66, 0x12, 0x3c, 0x00, 0xa9, 0x00, 0x30, 0x01, 
+0x05, 0x0b, 0x39, 0x13, 0xea, 0x08, 0x06, 0x01, 0x2a, 0x12, 0x3e, 0x0a, 
+0xa7, 0x00, 0x28, 0x0a, 0xee, 0x0e, 0xda, 0x00, 0x9a, 0x11, 0x96, 0x0a, 
+0xa7, 0x00, 0xba, 0x16, 0xb9, 0x00, 0x2e, 0x0c, 0x61, 0x0a, 0x2b, 0x0a, 
+0x82, 0x0b, 0xef, 0x09, 0x1f, 0x1a, 0x3c, 0x00, 0x8c, 0x06, 0x84, 0x0e, 
+0xe3, 0x0a, 0xcb, 0x0a, 0x38, 0x01, 0xbd, 0x14, 0x03, 0x13, 0xbe, 0x1b, 
+0x46, 0x0d, 0x8c, 0x0a, 0xf7, 0x0c, 0xb6, 0x00, 0xbc, 0x0c, 0x17, 0x00, 
+0x0b, 0x0d, 0xbb, 0x00, 0x84, 0x00, 0xf6, 0x0f, 0x4e, 0x0c, 0xd1, 0x00, 
+0x21, 0x09, 0x95, 0x0c, 0x2f, 0x01, 0xc3, 0x0a, 0x46, 0x06, 0x9e, 0x0a, 
+0xd2, 0x0f, 0xc2, 0x0c, 0x94, 0x00, 0xc4, 0x00, 0x04, 0x0b, 0x58, 0x07, 
+0x20, 0x0b, 0x6c, 0x0a, 0x36, 0x01, 0xbe, 0x09, 0x84, 0x00, 0x4f, 0x19, 
+0x3d, 0x01, 0x29, 0x01, 0xbe, 0x0c, 0x4e, 0x0c, 0x01, 0x01, 0x84, 0x00, 
+0x18, 0x0c, 0x29, 0x07, 0xce, 0x15, 0xd7, 0x00, 0xac, 0x15, 0x54, 0x11, 
+0x9c, 0x08, 0xd2, 0x0c, 0x33, 0x00, 0x6c, 0x00, 0xc1, 0x00, 0x75, 0x0a, 
+0xe8, 0x00, 0xbe, 0x00, 0xc4, 0x00, 0x61, 0x0a, 0x17, 0x01, 0x09, 0x12, 
+0x1f, 0x14, 0x17, 0x0c, 0xac, 0x00, 0x43, 0x0c, 0xba, 0x0a, 0xdc, 0x00, 
+0x11, 0x0b, 0xfa, 0x14, 0x8d, 0x06, 0xe0, 0x0b, 0xf6, 0x0b, 0x41, 0x01, 
+0x6c, 0x11, 0x41, 0x06, 0x4c, 0x0a, 0x83, 0x0a, 0x2f, 0x00, 0x9d, 0x0c, 
+0xcf, 0x06, 0xd9, 0x00, 0x71, 0x08, 0x9e, 0x0c, 0x32, 0x01, 0xa9, 0x08, 
+0x65, 0x0f, 0x1d, 0x0a, 0x25, 0x08, 0x37, 0x0a, 0x84, 0x00, 0x81, 0x11, 
+0xa1, 0x0a, 0xc6, 0x00, 0x1b, 0x08, 0xa3, 0x06, 0x26, 0x00, 0x59, 0x17, 
+0xb3, 0x09, 0xed, 0x0b, 0x7c, 0x16, 0x7b, 0x08, 0xa5, 0x09, 0xc4, 0x0e, 
+0xd6, 0x0b, 0xf1, 0x13, 0xb4, 0x00, 0x16, 0x01, 0x37, 0x01, 0xae, 0x0f, 
+0x6a, 0x1c, 0x0f, 0x0c, 0x72, 0x00, 0xbd, 0x00, 0x23, 0x08, 0x85, 0x0c, 
+0x05, 0x0c, 0x43, 0x06, 0xee, 0x11, 0x47, 0x06, 0x75, 0x0c, 0xae, 0x0a, 
+0xaa, 0x0b, 0xba, 0x00, 0xdc, 0x09, 0xf6, 0x0d, 0x66, 0x0c, 0xcd, 0x0a, 
+0x09, 0x15, 0xb7, 0x0a, 0x8d, 0x0a, 0xb6, 0x0e, 0x42, 0x1a, 0x36, 0x08, 
+0x2a, 0x0a, 0x5f, 0x0b, 0x82, 0x11, 0x5a, 0x17, 0x17, 0x01, 0x4a, 0x11, 
+0xb4, 0x17, 0x30, 0x01, 0x1a, 0x0e, 0xea, 0x0c, 0x83, 0x00, 0x4f, 0x0c, 
+0x67, 0x0b, 0x38, 0x07, 0x67, 0x0b, 0xa8, 0x08, 0xd8, 0x0b, 0x02, 0x0c, 
+0xbd, 0x09, 0xb8, 0x11, 0x7c, 0x16, 0xf9, 0x18, 0xc7, 0x14, 0x49, 0x00, 
+0x9c, 0x00, 0xe7, 0x09, 0x0f, 0x0b, 0xd6, 0x0b, 0x4c, 0x11, 0x35, 0x00, 
+0x26, 0x07, 0x11, 0x07, 0x36, 0x01, 0x0e, 0x19, 0x9e, 0x08, 0x5f, 0x07, 
+0x21, 0x13, 0x9e, 0x00, 0x87, 0x16, 0x7d, 0x00, 0x2a, 0x0a, 0x7f, 0x0c, 
+0x07, 0x01, 0xfd, 0x00, 0xea, 0x0c, 0xa9, 0x15, 0x3a, 0x01, 0xa9, 0x00, 
+0x0f, 0x0c, 0x12, 0x0b, 0xd3, 0x10, 0x78, 0x00, 0xe8, 0x09, 0x3d, 0x01, 
+0x35, 0x00, 0x30, 0x01, 0x12, 0x00, 0xd9, 0x07, 0xd4, 0x00, 0x23, 0x00, 
+0x29, 0x0a, 0xb7, 0x00, 0xb8, 0x11, 0x99, 0x06, 0xf3, 0x08, 0x33, 0x12, 
+0xce, 0x00, 0xbd, 0x0a, 0x69, 0x12, 0x43, 0x06, 0x25, 0x0b, 0x72, 0x0b, 
+0xe2, 0x00, 0xab, 0x00, 0x54, 0x0b, 0xc0, 0x0b, 0x20, 0x0d, 0xb2, 0x07, 
+0x33, 0x0a, 0x3e, 0x00, 0x90, 0x09, 0x21, 0x17, 0x6c, 0x11, 0x3c, 0x0a, 
+0xf1, 0x00, 0xec, 0x07, 0x8d, 0x0d, 0xb9, 0x00, 0xfc, 0x00, 0x25, 0x08, 
+0x02, 0x13, 0xf4, 0x00, 0xc8, 0x00, 0x16, 0x14, 0x92, 0x0c, 0xb9, 0x15, 
+0x9a, 0x19, 0xf6, 0x00, 0xf9, 0x00, 0xd0, 0x09, 0xd6, 0x0f, 0x69, 0x08, 
+0xe4, 0x07, 0xe5, 0x17, 0xf4, 0x0c, 0xb9, 0x15, 0xb3, 0x09, 0xbc, 0x00, 
+0xf8, 0x1a, 0x25, 0x0b, 0x67, 0x13, 0xd6, 0x00, 0xb1, 0x0a, 0xe7, 0x0b, 
+0xcb, 0x09, 0xb0, 0x18, 0xd0, 0x12, 0x05, 0x0a, 0x3a, 0x1a, 0x0b, 0x00, 
+0x1d, 0x01, 0x1d, 0x00, 0xf8, 0x0b, 0xec, 0x08, 0x3c, 0x01, 0x1e, 0x15, 
+0x72, 0x16, 0xc9, 0x0e, 0x96, 0x17, 0xc1, 0x17, 0x05, 0x16, 0x25, 0x1a, 
+0xd8, 0x0a, 0xd6, 0x16, 0xa7, 0x00, 0x87, 0x17, 0x02, 0x01, 0xcb, 0x00, 
+0xd6, 0x15, 0x64, 0x0d, 0x0f, 0x0b, 0xe9, 0x00, 0xf1, 0x09, 0x5f, 0x0a, 
+0x5d, 0x08, 0x69, 0x0c, 0x6d, 0x09, 0x49, 0x06, 0xa3, 0x07, 0x2a, 0x01, 
+0x78, 0x09, 0xa6, 0x0a, 0x52, 0x14, 0xdf, 0x00, 0x31, 0x01, 0xe0, 0x15, 
+0x5b, 0x0c, 0x29, 0x01, 0x34, 0x12, 0xfd, 0x08, 0x18, 0x01, 0x8a, 0x17, 
+0xe0, 0x00, 0xb1, 0x09, 0xd1, 0x06, 0x1b, 0x0c, 0x37, 0x01, 0xca, 0x0c, 
+0x6b, 0x00, 0x97, 0x06, 0x89, 0x09, 0x91, 0x00, 0xb4, 0x08, 0xbc, 0x16, 
+0x04, 0x0c, 0xeb, 0x0b, 0x46, 0x06, 0x6a, 0x06, 0xb1, 0x15, 0xc9, 0x0f, 
+0x39, 0x13, 0x55, 0x0a, 0x43, 0x0b, 0x8e, 0x0a, 0xe5, 0x0d, 0x42, 0x06, 
+0x36, 0x08, 0x0a, 0x01, 0x11, 0x0b, 0x63, 0x0a, 0x85, 0x00, 0xcf, 0x06, 
+0xb8, 0x00, 0x46, 0x13, 0xac, 0x00, 0x69, 0x17, 0xf9, 0x0a, 0x8a, 0x0b, 
+0x89, 0x17, 0x60, 0x0f, 0x69, 0x19, 0x72, 0x0d, 0xe3, 0x06, 0x6a, 0x0a, 
+0xb6, 0x00, 0x8f, 0x1a, 0xec, 0x00, 0xba, 0x14, 0xb3, 0x1b, 0x04, 0x0b, 
+0xec, 0x07, 0xbc, 0x0c, 0xd4, 0x09, 0xe5, 0x15, 0x8a, 0x0b, 0x38, 0x01, 
+0xb1, 0x12, 0xae, 0x0a, 0x37, 0x01, 0x13, 0x01, 0x12, 0x0e, 0xb2, 0x00, 
+0xc2, 0x00, 0x13, 0x01, 0xc2, 0x0e, 0x1e, 0x13, 0xcf, 0x06, 0x42, 0x01, 
+0xfa, 0x00, 0x1b, 0x07, 0x42, 0x15, 0x0d, 0x01, 0x91, 0x00, 0x12, 0x01, 
+0x23, 0x0c, 0xbb, 0x09, 0xd8, 0x0e, 0xe9, 0x0c, 0x17, 0x00, 0xcb, 0x0b, 
+0x82, 0x11, 0x6b, 0x0d, 0x38, 0x01, 0x12, 0x01, 0x12, 0x12, 0xf5, 0x0c, 
+0xbd, 0x16, 0xfa, 0x13, 0xdf, 0x12, 0x47, 0x00, 0x9a, 0x0a, 0x80, 0x12, 
+0xfa, 0x00, 0x9f, 0x08, 0x3f, 0x00, 0xd3, 0x09, 0xb0, 0x00, 0x60, 0x0b, 
+0xa2, 0x0a, 0xb4, 0x0c, 0xbf, 0x00, 0x1d, 0x0c, 0xf9, 0x0a, 0x15, 0x01, 
+0xeb, 0x00, 0x2f, 0x00, 0xe0, 0x15, 0x7a, 0x00, 0xfb, 0x14, 0x23, 0x19, 
+0x0f, 0x01, 0xe3, 0x18, 0x30, 0x0c, 0xf7, 0x10, 0xef, 0x00, 0xb9, 0x06, 
+0x40, 0x00, 0x21, 0x09, 0xb8, 0x0a, 0xca, 0x08, 0x45, 0x06, 0xd2, 0x00, 
+0x56, 0x18, 0x27, 0x09, 0x0c, 0x00, 0x84, 0x00, 0xc1, 0x0b, 0xca, 0x0b, 
+0x05, 0x0b, 0xab, 0x16, 0x2b, 0x0b, 0x94, 0x0c, 0xae, 0x0b, 0xfd, 0x07, 
+0x2f, 0x00, 0xf6, 0x00, 0xb8, 0x0b, 0x38, 0x01, 0xb8, 0x00, 0xaa, 0x00, 
+0x97, 0x10, 0x2f, 0x0f, 0x00, 0x0d, 0x23, 0x00, 0x27, 0x00, 0xf7, 0x10, 
+0x9f, 0x07, 0x01, 0x01, 0xe5, 0x0d, 0x42, 0x0a, 0x3c, 0x00, 0x0e, 0x00, 
+0x6f, 0x0c, 0x2f, 0x0e, 0x0f, 0x0b, 0xad, 0x00, 0xaa, 0x0b, 0xe7, 0x09, 
+0xac, 0x0b, 0xdd, 0x0c, 0x9e, 0x0c, 0x2f, 0x00, 0x55, 0x00, 0xe3, 0x00, 
+0x47, 0x06, 0x14, 0x01, 0xfe, 0x00, 0x7f, 0x0c, 0xd0, 0x00, 0x9e, 0x00, 
+0x10, 0x16, 0xc1, 0x00, 0x6d, 0x09, 0xf7, 0x0d, 0x2f, 0x08, 0x60, 0x06, 
+0xab, 0x0c, 0xf5, 0x0c, 0xbe, 0x00, 0x85, 0x0c, 0x40, 0x00, 0x2f, 0x01, 
+0x55, 0x19, 0xd7, 0x00, 0xe1, 0x08, 0xcf, 0x00, 0x52, 0x0b, 0x20, 0x13, 
+0x23, 0x00, 0x20, 0x01, 0xa7, 0x08, 0xf9, 0x00, 0x41, 0x06, 0x1d, 0x0a, 
+0xeb, 0x0a, 0x1f, 0x01, 0x6a, 0x0b, 0x2f, 0x01, 0xbd, 0x00, 0xbf, 0x0f, 
+0xfc, 0x08, 0xc3, 0x00, 0xde, 0x00, 0x38, 0x0a, 0x28, 0x12, 0xc6, 0x14, 
+0x31, 0x0c, 0x54, 0x00, 0x8b, 0x12, 0xfe, 0x0d, 0xc4, 0x00, 0x0a, 0x01, 
+0xad, 0x16, 0xb4, 0x0b, 0x9f, 0x11, 0x28, 0x10, 0x94, 0x0c, 0xcd, 0x00, 
+0xe6, 0x00, 0x70, 0x18, 0xfa, 0x00, 0xa9, 0x09, 0xde, 0x00, 0xfc, 0x14, 
+0x7d, 0x16, 0x72, 0x06, 0x1d, 0x01, 0x3d, 0x01, 0xd8, 0x00, 0xc1, 0x09, 
+0x16, 0x13, 0x8c, 0x0b, 0x34, 0x00, 0x53, 0x07, 0x55, 0x16, 0xdc, 0x10, 
+0xd7, 0x00, 0xed, 0x00, 0xf8, 0x0b, 0xac, 0x08, 0x3a, 0x1a, 0xee, 0x09, 
+0xb3, 0x07, 0x0b, 0x0d, 0x18, 0x1a, 0x05, 0x0b, 0x62, 0x0a, 0x33, 0x0a, 
+0x29, 0x0a, 0x0a, 0x0d, 0xd9, 0x07, 0x1a, 0x01, 0x9f, 0x17, 0x02, 0x01, 
+0xfb, 0x14, 0xdd, 0x00, 0x6d, 0x0a, 0x09, 0x0b, 0x73, 0x0c, 0xf0, 0x00, 
+0xf1, 0x0a, 0xad, 0x0b, 0xe3, 0x10, 0xe3, 0x07, 0x83, 0x0a, 0xe1, 0x00, 
+0xc5, 0x00, 0x14, 0x0b, 0xd2, 0x00, 0xa1, 0x08, 0x94, 0x07, 0xee, 0x00, 
+0xde, 0x0c, 0xc9, 0x09, 0xf3, 0x09, 0xa4, 0x09, 0x87, 0x16, 0x78, 0x0b, 
+0xd6, 0x00, 0x45, 0x12, 0x6b, 0x00, 0xf9, 0x00, 0x42, 0x00, 0x3d, 0x0b, 
+0x9a, 0x12, 0x9c, 0x08, 0x27, 0x08, 0xed, 0x13, 0x73, 0x0c, 0xfc, 0x09, 
+0xcb, 0x0b, 0x25, 0x08, 0xec, 0x07, 0x40, 0x06, 0x37, 0x0d, 0x24, 0x08, 
+0x39, 0x01, 0xc5, 0x00, 0xfb, 0x15, 0x0b, 0x00, 0xfa, 0x00, 0xbf, 0x11, 
+0xd7, 0x07, 0x9a, 0x15, 0x3b, 0x00, 0x3d, 0x0c, 0x42, 0x14, 0x20, 0x01, 
+0xa1, 0x06, 0xea, 0x00, 0xb5, 0x0b, 0xac, 0x00, 0x62, 0x0d, 0x49, 0x00, 
+0x74, 0x16, 0xa0, 0x0b, 0x27, 0x01, 0x98, 0x14, 0x59, 0x00, 0x5d, 0x0b, 
+0xec, 0x0b, 0xc2, 0x0a, 0x67, 0x0b, 0x19, 0x00, 0xbf, 0x00, 0x28, 0x00, 
+0x81, 0x09, 0x17, 0x01, 0x5f, 0x0b, 0x41, 0x01, 0x71, 0x07, 0x37, 0x01, 
+0xdc, 0x00, 0xe1, 0x07, 0x10, 0x00, 0x42, 0x09, 0x59, 0x0a, 0xa2, 0x0a, 
+0x91, 0x0c, 0x3b, 0x0c, 0x45, 0x08, 0x93, 0x0b, 0xeb, 0x00, 0x5a, 0x08, 
+0xcc, 0x00, 0xd5, 0x00, 0x02, 0x01, 0xa7, 0x08, 0x28, 0x0a, 0xa9, 0x16, 
+0x78, 0x00, 0x1a, 0x0c, 0x45, 0x06, 0xf6, 0x00, 0x14, 0x01, 0xce, 0x0b, 
+0x35, 0x01, 0x59, 0x17, 0x03, 0x01, 0x4c, 0x06, 0x62, 0x09, 0xc0, 0x00, 
+0x5c, 0x08, 0xc4, 0x00, 0x8e, 0x06, 0x24, 0x00, 0xce, 0x0a, 0xff, 0x0c, 
+0x55, 0x11, 0xa1, 0x0a, 0xb1, 0x0a, 0x3d, 0x0b, 0x34, 0x12, 0xbe, 0x0c, 
+0xf9, 0x00, 0x35, 0x0d, 0x46, 0x0a, 0x12, 0x0a, 0x6f, 0x00, 0xb0, 0x00, 
+0x87, 0x00, 0xec, 0x06, 0x0b, 0x00, 0x81, 0x0b, 0xfc, 0x14, 0xa3, 0x00, 
+0x48, 0x00, 0x17, 0x01, 0xe0, 0x0b, 0x4e, 0x0b, 0x62, 0x0a, 0xfb, 0x00, 
+0x02, 0x16, 0x1f, 0x0b, 0x41, 0x01, 0xff, 0x08, 0x44, 0x15, 0x3f, 0x0d, 
+0xc9, 0x0c, 0x7a, 0x0c, 0xec, 0x0c, 0xf6, 0x07, 0xb2, 0x08, 0x80, 0x0a, 
+0x87, 0x00, 0xf7, 0x00, 0x31, 0x0b, 0xc0, 0x0b, 0x6f, 0x0c, 0x1f, 0x07, 
+0x1e, 0x01, 0xf6, 0x06, 0x02, 0x14, 0xcf, 0x0b, 0xe0, 0x09, 0xfc, 0x00, 
+0xa6, 0x06, 0x5a, 0x0a, 0xb4, 0x0b, 0x02, 0x16, 0x3c, 0x00, 0x2f, 0x00, 
+0xed, 0x0b, 0x74, 0x0b, 0x55, 0x06, 0xbd, 0x00, 0xb9, 0x0b, 0xf9, 0x0f, 
+0x5c, 0x0a, 0x41, 0x0a, 0xf3, 0x14, 0x56, 0x00, 0xe2, 0x0b, 0x32, 0x00, 
+0x03, 0x0c, 0x76, 0x0c, 0xe7, 0x0d, 0x4c, 0x11, 0x3e, 0x01, 0xc6, 0x00, 
+0xda, 0x00, 0x91, 0x0c, 0xca, 0x00, 0x23, 0x10, 0xd6, 0x16, 0x41, 0x06, 
+0x98, 0x0a, 0x79, 0x0a, 0x90, 0x00, 0xd0, 0x00, 0x6d, 0x0a, 0x17, 0x00, 
+0x06, 0x01, 0xa9, 0x16, 0xee, 0x06, 0x93, 0x00, 0xb5, 0x0b, 0xbb, 0x14, 
+0x12, 0x07, 0x19, 0x0c, 0x28, 0x00, 0xf6, 0x0f, 0x1b, 0x00, 0xc3, 0x00, 
+0xb6, 0x0e, 0x4a, 0x06, 0x5d, 0x0b, 0xb1, 0x09, 0x88, 0x09, 0x49, 0x0b, 
+0x33, 0x0a, 0x9c, 0x0f, 0x0f, 0x0c, 0xf7, 0x0b, 0xb8, 0x0e, 0xb0, 0x00, 
+0xde, 0x13, 0x58, 0x00, 0xab, 0x0b, 0x7e, 0x0c, 0x30, 0x01, 0xb3, 0x15, 
+0x1f, 0x01, 0x8c, 0x0b, 0xd2, 0x0c, 0xc4, 0x00, 0xd9, 0x00, 0x36, 0x09, 
+0xde, 0x0c, 0x94, 0x07, 0x99, 0x15, 0x2e, 0x08, 0xdb, 0x0d, 0x92, 0x0c, 
+0x97, 0x17, 0x12, 0x0e, 0x99, 0x11, 0xaa, 0x00, 0x39, 0x0c, 0x37, 0x01, 
+0x91, 0x00, 0x35, 0x0d, 0xe8, 0x00, 0x4e, 0x17, 0xa2, 0x0a, 0x78, 0x08, 
+0xf9, 0x0b, 0x33, 0x0b, 0x1d, 0x08, 0xf1, 0x11, 0x00, 0x01, 0xe0, 0x0c, 
+0x8f, 0x17, 0x26, 0x07, 0x85, 0x17, 0x42, 0x01, 0x6a, 0x12, 0x97, 0x07, 
+0xde, 0x14, 0xaf, 0x09, 0x79, 0x08, 0x73, 0x00, 0x42, 0x01, 0x59, 0x0c, 
+0x04, 0x13, 0x0c, 0x0c, 0x0e, 0x01, 0xb3, 0x09, 0x14, 0x10, 0x8e, 0x16, 
+0x23, 0x08, 0xf2, 0x00, 0xd9, 0x00, 0x05, 0x0f, 0x66, 0x09, 0x45, 0x13, 
+0xcf, 0x18, 0x53, 0x00, 0x2d, 0x0c, 0x9e, 0x0f, 0x5a, 0x0c, 0x06, 0x01, 
+0x1a, 0x0f, 0xf2, 0x00, 0x27, 0x11, 0xd3, 0x0c, 0x23, 0x0c, 0x48, 0x0b, 
+0x39, 0x13, 0x2e, 0x01, 0x50, 0x16, 0xfa, 0x18, 0xd6, 0x0b, 0x56, 0x00, 
+0x08, 0x0a, 0x87, 0x0c, 0xd4, 0x0a, 0x72, 0x11, 0x44, 0x06, 0x67, 0x0a, 
+0x0c, 0x16, 0x30, 0x09, 0x16, 0x01, 0x90, 0x17, 0xf3, 0x0a, 0x27, 0x16, 
+0xa6, 0x10, 0x6f, 0x00, 0xa8, 0x00, 0xfd, 0x00, 0xc9, 0x0c, 0x42, 0x15, 
+0xd9, 0x06, 0x48, 0x00, 0x0c, 0x00, 0xbb, 0x08, 0xaf, 0x00, 0x65, 0x0c, 
+0x68, 0x19, 0x4c, 0x0a, 0xa3, 0x07, 0x6f, 0x12, 0xdb, 0x00, 0x13, 0x01, 
+0x22, 0x01, 0x5f, 0x0b, 0xfb, 0x00, 0x97, 0x17, 0xcb, 0x0c, 0xa7, 0x09, 
+0xf9, 0x00, 0xcd, 0x14, 0xa9, 0x07, 0x40, 0x09, 0xae, 0x12, 0xa8, 0x10, 
+0x3b, 0x01, 0x16, 0x01, 0xaa, 0x06, 0x3c, 0x0b, 0xa6, 0x0c, 0xde, 0x0c, 
+0x3f, 0x0f, 0x2f, 0x09, 0x1a, 0x0b, 0x8e, 0x0a, 0xb3, 0x00, 0xf3, 0x08, 
+0x53, 0x0b, 0xc4, 0x08, 0xb1, 0x09, 0x9b, 0x0c, 0x64, 0x0a, 0xee, 0x00, 
+0xbb, 0x00, 0x18, 0x08, 0x53, 0x07, 0xd6, 0x00, 0x6b, 0x0b, 0x17, 0x0c, 
+0x14, 0x0a, 0x0b, 0x0b, 0x37, 0x01, 0xf4, 0x14, 0x66, 0x08, 0xba, 0x14, 
+0x5d, 0x0b, 0x9b, 0x0c, 0x53, 0x12, 0xd6, 0x18, 0x34, 0x01, 0x45, 0x06, 
+0x9a, 0x0a, 0x82, 0x10, 0x01, 0x0c, 0x5f, 0x0a, 0xda, 0x00, 0x10, 0x0c, 
+0x40, 0x0a, 0x78, 0x0b, 0x86, 0x0c, 0x6b, 0x0a, 0x05, 0x01, 0x26, 0x00, 
+0x66, 0x0c, 0x7b, 0x07, 0x43, 0x0a, 0x1c, 0x01, 0x54, 0x12, 0x63, 0x00, 
+0x20, 0x01, 0xa9, 0x08, 0xcb, 0x0b, 0xa2, 0x06, 0xbf, 0x06, 0x25, 0x0c, 
+0x10, 0x0c, 0xb7, 0x0b, 0x29, 0x0a, 0xa4, 0x09, 0x0d, 0x00, 0x77, 0x0a, 
+0x3c, 0x0c, 0x1d, 0x01, 0x66, 0x0c, 0xc5, 0x0a, 0x28, 0x0a, 0xa9, 0x07, 
+0xfc, 0x0a, 0xcd, 0x00, 0x2f, 0x00, 0x38, 0x01, 0xfe, 0x0c, 0x5e, 0x0b, 
+0x06, 0x01, 0x3b, 0x0b, 0x5b, 0x0a, 0x36, 0x01, 0xa7, 0x08, 0xd9, 0x0a, 
+0xca, 0x1b, 0xb9, 0x00, 0x9e, 0x0b, 0x3a, 0x18, 0x30, 0x0b, 0x90, 0x16, 
+0xdc, 0x0a, 0x7a, 0x0c, 0xb7, 0x00, 0x49, 0x00, 0xc1, 0x00, 0x28, 0x0c, 
+0xf2, 0x0e, 0x5d, 0x07, 0xbd, 0x00, 0x6f, 0x0c, 0x3f, 0x00, 0x32, 0x0c, 
+0x26, 0x12, 0x7e, 0x0b, 0x22, 0x01, 0x4a, 0x11, 0x08, 0x0a, 0xe2, 0x0b, 
+0x2c, 0x18, 0x85, 0x16, 0x33, 0x0a, 0xa1, 0x0f, 0x24, 0x01, 0x21, 0x01, 
+0x92, 0x00, 0x43, 0x06, 0x2e, 0x01, 0x80, 0x0a, 0x90, 0x0a, 0xdb, 0x00, 
+0x24, 0x00, 0xce, 0x0a, 0x45, 0x06, 0xdb, 0x0d, 0x25, 0x01, 0x88, 0x0b, 
+0xe2, 0x0c, 0x9e, 0x0b, 0xc8, 0x09, 0x4c, 0x00, 0x22, 0x0b, 0x67, 0x08, 
+0xb2, 0x06, 0xb1, 0x00, 0xbc, 0x00, 0xda, 0x0a, 0xf9, 0x18, 0x91, 0x00, 
+0xf4, 0x00, 0x64, 0x0c, 0xbb, 0x14, 0xfd, 0x09, 0x78, 0x08, 0x10, 0x01, 
+0xbd, 0x0c, 0xc8, 0x00, 0x4a, 0x11, 0x1a, 0x0b, 0xc4, 0x08, 0x77, 0x00, 
+0x0e, 0x00, 0x7d, 0x0b, 0xff, 0x07, 0x93, 0x12, 0x8d, 0x16, 0x13, 0x07, 
+0x1c, 0x00, 0xfa, 0x18, 0xcc, 0x12, 0xaf, 0x11, 0x9f, 0x07, 0xea, 0x09, 
+0xa2, 0x0a, 0x0d, 0x13, 0x68, 0x0c, 0x2f, 0x07, 0xee, 0x0a, 0x45, 0x06, 
+0xc1, 0x0b, 0xa1, 0x0b, 0xc1, 0x0c, 0xe5, 0x07, 0xf8, 0x0e, 0x3a, 0x0c, 
+0x0a, 0x01, 0x2e, 0x0c, 0xee, 0x00, 0x1a, 0x0c, 0x91, 0x00, 0xba, 0x00, 
+0xdd, 0x0c, 0x86, 0x11, 0x53, 0x00, 0x36, 0x1c, 0xfb, 0x0a, 0x5e, 0x18, 
+0xba, 0x00, 0xec, 0x0b, 0x3e, 0x0a, 0x86, 0x0c, 0xad, 0x0c, 0xde, 0x06, 
+0xdf, 0x0c, 0x20, 0x09, 0xf3, 0x08, 0x4a, 0x11, 0x3d, 0x0b, 0x89, 0x10, 
+0x15, 0x00, 0x54, 0x0a, 0x05, 0x10, 0x3f, 0x08, 0xbe, 0x14, 0xd9, 0x06, 
+0xe0, 0x0c, 0xac, 0x00, 0x14, 0x01, 0x5e, 0x16, 0x1a, 0x01, 0xc7, 0x09, 
+0x2b, 0x0a, 0xb1, 0x00, 0xe8, 0x13, 0x2c, 0x01, 0x37, 0x01, 0xc3, 0x0a, 
+0xfa, 0x00, 0x1d, 0x01, 0xd6, 0x00, 0xa0, 0x0b, 0x2c, 0x0a, 0x82, 0x10, 
+0x04, 0x16, 0x8b, 0x10, 0x45, 0x0a, 0xdb, 0x00, 0xf2, 0x14, 0x29, 0x0b, 
+0x4e, 0x0a, 0x88, 0x00, 0xc3, 0x00, 0xea, 0x00, 0xa7, 0x0f, 0xcd, 0x0a, 
+0xc4, 0x14, 0x15, 0x14, 0xbb, 0x12, 0xfc, 0x0b, 0xfe, 0x10, 0xed, 0x16, 
+0xd2, 0x0f, 0x57, 0x18, 0xbf, 0x1a, 0x83, 0x11, 0x95, 0x0b, 0xa7, 0x08, 
+0x05, 0x10, 0x48, 0x0b, 0xe6, 0x0d, 0x32, 0x00, 0x80, 0x07, 0x3c, 0x00, 
+0xd6, 0x14, 0xdf, 0x06, 0x42, 0x06, 0xe8, 0x0d, 0x83, 0x00, 0x32, 0x00, 
+0xaa, 0x0b, 0x6e, 0x09, 0xa4, 0x09, 0x42, 0x0a, 0x02, 0x0c, 0x3a, 0x0c, 
+0xba, 0x00, 0xbe, 0x14, 0x30, 0x12, 0x84, 0x00, 0xee, 0x00, 0x9c, 0x08, 
+0x2a, 0x01, 0x5a, 0x08, 0x23, 0x08, 0x3a, 0x13, 0xfa, 0x0a, 0xbf, 0x00, 
+0x4a, 0x06, 0xa9, 0x00, 0xc9, 0x0f, 0x25, 0x0b, 0xa8, 0x0c, 0x80, 0x09, 
+0x75, 0x07, 0x22, 0x07, 0x23, 0x01, 0xef, 0x09, 0x30, 0x00, 0x61, 0x0b, 
+0xfd, 0x00, 0x61, 0x07, 0xdd, 0x00, 0xaa, 0x0b, 0x49, 0x0a, 0x2d, 0x01, 
+0x86, 0x0d, 0xb7, 0x0e, 0x8a, 0x0b, 0x14, 0x07, 0xb5, 0x0b, 0x23, 0x01, 
+0x86, 0x0a, 0x85, 0x0c, 0x27, 0x00, 0x12, 0x07, 0x3e, 0x01, 0xe3, 0x00, 
+0xcb, 0x00, 0x27, 0x00, 0x38, 0x0c, 0x87, 0x17, 0x26, 0x0b, 0x40, 0x01, 
+0x1a, 0x01, 0xf3, 0x0c, 0x9e, 0x17, 0x9c, 0x0f, 0x8c, 0x11, 0xd3, 0x0e, 
+0x80, 0x0b, 0xe8, 0x06, 0x4b, 0x15, 0xa5, 0x10, 0x29, 0x01, 0xd5, 0x0c, 
+0x24, 0x0e, 0x10, 0x00, 0xdd, 0x14, 0x6a, 0x0b, 0xb6, 0x00, 0xbe, 0x15, 
+0xf8, 0x00, 0x62, 0x0a, 0x43, 0x06, 0x07, 0x10, 0x62, 0x00, 0x80, 0x08, 
+0x16, 0x0a, 0x8d, 0x0a, 0xa4, 0x0a, 0xb4, 0x00, 0xd8, 0x0a, 0xe4, 0x0d, 
+0x63, 0x09, 0x32, 0x0c, 0x73, 0x0b, 0x47, 0x00, 0xfe, 0x08, 0x58, 0x00, 
+0x2d, 0x01, 0x40, 0x0a, 0x8b, 0x12, 0x83, 0x00, 0x6a, 0x0b, 0xa9, 0x00, 
+0x34, 0x00, 0xa6, 0x0c, 0xcd, 0x00, 0x95, 0x07, 0x7a, 0x0c, 0x1a, 0x00, 
+0x6e, 0x13, 0xe6, 0x00, 0x48, 0x0c, 0xcf, 0x00, 0x0c, 0x01, 0xd6, 0x07, 
+0x32, 0x18, 0x70, 0x17, 0xd5, 0x0b, 0x72, 0x0b, 0xbb, 0x0a, 0x03, 0x15, 
+0x93, 0x0c, 0xf0, 0x1b, 0x40, 0x06, 0x9b, 0x00, 0x20, 0x09, 0xed, 0x0e, 
+0x9e, 0x08, 0xf7, 0x00, 0xdd, 0x14, 0x08, 0x01, 0x0d, 0x01, 0xf5, 0x00, 
+0x40, 0x01, 0xba, 0x09, 0x95, 0x00, 0x0b, 0x0d, 0x96, 0x00, 0x8c, 0x10, 
+0xca, 0x00, 0x19, 0x00, 0x84, 0x13, 0xee, 0x0a, 0x5f, 0x00, 0x10, 0x01, 
+0x3e, 0x0a, 0x5f, 0x15, 0x19, 0x00, 0x33, 0x18, 0xb2, 0x06, 0x08, 0x0b, 
+0x20, 0x07, 0x90, 0x06, 0xab, 0x16, 0x9d, 0x00, 0x1a, 0x0e, 0x11, 0x01, 
+0x28, 0x12, 0x79, 0x00, 0x56, 0x00, 0xdc, 0x00, 0x26, 0x14, 0x36, 0x01, 
+0xe6, 0x00, 0xcd, 0x0a, 0x6d, 0x0a, 0xd7, 0x07, 0x71, 0x1b, 0x48, 0x06, 
+0x80, 0x0e, 0xdf, 0x13, 0xf7, 0x00, 0xf6, 0x14, 0x8f, 0x0a, 0xdb, 0x09, 
+0x35, 0x0a, 0x47, 0x06, 0xc4, 0x00, 0x13, 0x01, 0x2d, 0x01, 0xf2, 0x14, 
+0x25, 0x0c, 0x67, 0x08, 0xeb, 0x08, 0xb1, 0x09, 0x77, 0x09, 0x57, 0x00, 
+0x61, 0x0a, 0xec, 0x09, 0x48, 0x06, 0xde, 0x16, 0x6a, 0x06, 0xea, 0x08, 
+0x1e, 0x07, 0x3f, 0x08, 0x84, 0x00, 0x51, 0x13, 0xca, 0x00, 0x23, 0x08, 
+0xb5, 0x06, 0xc5, 0x09, 0x94, 0x0b, 0x47, 0x18, 0x1b, 0x01, 0x82, 0x0a, 
+0xd0, 0x09, 0xe2, 0x07, 0x54, 0x0a, 0x25, 0x01, 0x17, 0x01, 0xa9, 0x00, 
+0x09, 0x01, 0x90, 0x0c, 0x22, 0x0e, 0x29, 0x00, 0xa4, 0x00, 0x83, 0x11, 
+0x68, 0x11, 0xde, 0x08, 0x0c, 0x12, 0x1d, 0x0a, 0x42, 0x0b, 0xa1, 0x15, 
+0x4b, 0x0b, 0x27, 0x0b, 0x04, 0x16, 0xbd, 0x08, 0x90, 0x0c, 0x07, 0x01, 
+0x2b, 0x0f, 0xd1, 0x18, 0x53, 0x0c, 0xb6, 0x00, 0x55, 0x00, 0x07, 0x0a, 
+0x94, 0x0c, 0xcb, 0x00, 0x0c, 0x0a, 0x19, 0x0d, 0xb4, 0x0c, 0xb2, 0x17, 
+0xab, 0x00, 0x6d, 0x00, 0x8a, 0x0c, 0x71, 0x07, 0xc5, 0x09, 0x84, 0x00, 
+0x1e, 0x15, 0x93, 0x0c, 0x7c, 0x0c, 0x7e, 0x0b, 0xdd, 0x17, 0xa9, 0x00, 
+0x4a, 0x06, 0xb3, 0x0c, 0xd8, 0x00, 0x49, 0x0a, 0xcb, 0x00, 0x30, 0x07, 
+0x72, 0x06, 0x8f, 0x0a, 0xd3, 0x00, 0xf3, 0x00, 0x02, 0x13, 0xc7, 0x16, 
+0x0e, 0x13, 0x83, 0x00, 0x1b, 0x0e, 0x9e, 0x00, 0x63, 0x09, 0x53, 0x00, 
+0x21, 0x01, 0x52, 0x07, 0xa8, 0x08, 0xad, 0x00, 0x3d, 0x0b, 0x81, 0x09, 
+0x7c, 0x00, 0xd5, 0x14, 0x4d, 0x00, 0x26, 0x0b, 0x9b, 0x00, 0x8e, 0x06, 
+0x8c, 0x12, 0xb2, 0x09, 0x94, 0x07, 0x00, 0x0d, 0x83, 0x00, 0x08, 0x0d, 
+0xae, 0x00, 0x34, 0x0b, 0x12, 0x0d, 0x35, 0x01, 0x36, 0x0a, 0xbc, 0x00, 
+0xad, 0x0b, 0x33, 0x0a, 0x40, 0x01, 0x2a, 0x18, 0xe9, 0x0c, 0x90, 0x0c, 
+0xc2, 0x16, 0x72, 0x0b, 0x28, 0x01, 0x3f, 0x0a, 0xdb, 0x0a, 0x6d, 0x00, 
+0x12, 0x0a, 0x31, 0x12, 0x3c, 0x00, 0x41, 0x0e, 0x0b, 0x00, 0xbc, 0x0c, 
+0xb3, 0x1b, 0x5f, 0x11, 0x9a, 0x07, 0x49, 0x15, 0xe0, 0x08, 0x46, 0x0f, 
+0x10, 0x0b, 0xea, 0x00, 0x0b, 0x00, 0xba, 0x07, 0x9f, 0x00, 0x3d, 0x00, 
+0x44, 0x15, 0xe1, 0x00, 0x33, 0x0c, 0x19, 0x0c, 0x25, 0x07, 0xe0, 0x00, 
+0x8d, 0x06, 0x5e, 0x07, 0x60, 0x00, 0x01, 0x0c, 0xf1, 0x0a, 0x41, 0x01, 
+0x77, 0x00, 0xbb, 0x14, 0xb2, 0x07, 0xba, 0x14, 0xa9, 0x09, 0xd1, 0x15, 
+0xd1, 0x09, 0xdd, 0x09, 0x42, 0x0a, 0x87, 0x00, 0x6d, 0x14, 0x74, 0x16, 
+0xe4, 0x00, 0xbd, 0x09, 0xf2, 0x09, 0x8a, 0x10, 0xae, 0x11, 0x8e, 0x12, 
+0x30, 0x00, 0x70, 0x06, 0x72, 0x0b, 0xcc, 0x12, 0x28, 0x0c, 0x4d, 0x19, 
+0xe5, 0x0a, 0xf1, 0x1b, 0x33, 0x00, 0xa7, 0x00, 0xb7, 0x00, 0x15, 0x0b, 
+0x41, 0x01, 0x6a, 0x08, 0x0b, 0x00, 0x8d, 0x0a, 0x97, 0x06, 0x81, 0x0b, 
+0x0b, 0x09, 0xd0, 0x06, 0x4e, 0x06, 0x76, 0x0a, 0x24, 0x01, 0xd1, 0x00, 
+0x6c, 0x17, 0xd2, 0x00, 0xea, 0x0a, 0x20, 0x01, 0xb0, 0x09, 0x98, 0x0f, 
+0x85, 0x0c, 0xa9, 0x15, 0x42, 0x14, 0xe6, 0x16, 0x26, 0x01, 0xb4, 0x0c, 
+0xb2, 0x17, 0x4a, 0x06, 0x5d, 0x0c, 0x60, 0x0a, 0xe8, 0x00, 0xa1, 0x0a, 
+0x3a, 0x0c, 0xbf, 0x0c, 0x56, 0x07, 0xe4, 0x06, 0x4a, 0x19, 0xdf, 0x15, 
+0x53, 0x0b, 0x79, 0x10, 0x17, 0x01, 0x52, 0x0b, 0x56, 0x18, 0xa1, 0x06, 
+0xe0, 0x00, 0x65, 0x08, 0x1a, 0x08, 0x67, 0x08, 0x8f, 0x08, 0x4a, 0x06, 
+0x2d, 0x0a, 0xd3, 0x09, 0x95, 0x07, 0xf7, 0x09, 0x04, 0x0c, 0x7f, 0x0c, 
+0xde, 0x09, 0x40, 0x0e, 0x43, 0x0c, 0x51, 0x0a, 0x69, 0x11, 0xec, 0x0b, 
+0x92, 0x00, 0x22, 0x01, 0xa6, 0x0c, 0x97, 0x0a, 0xc6, 0x0a, 0xca, 0x00, 
+0xb7, 0x0d, 0x51, 0x0b, 0x37, 0x09, 0x1b, 0x07, 0xb5, 0x00, 0xb5, 0x0f, 
+0x0b, 0x00, 0x34, 0x0a, 0xb5, 0x00, 0x8a, 0x0c, 0xdd, 0x17, 0x92, 0x13, 
+0xbb, 0x00, 0x0b, 0x01, 0x32, 0x0b, 0x42, 0x10, 0x14, 0x0a, 0x72, 0x10, 
+0x8d, 0x11, 0x91, 0x0c, 0x85, 0x17, 0x26, 0x15, 0xd1, 0x00, 0x18, 0x00, 
+0x50, 0x19, 0xf2, 0x09, 0x7b, 0x00, 0x60, 0x0b, 0xc5, 0x09, 0x6d, 0x11, 
+0x5d, 0x07, 0x4e, 0x00, 0x19, 0x01, 0x07, 0x0b, 0x1b, 0x00, 0xad, 0x0b, 
+0x47, 0x0e, 0x48, 0x00, 0x01, 0x07, 0x45, 0x13, 0x23, 0x01, 0xb3, 0x0c, 
+0x8a, 0x10, 0x2c, 0x09, 0x0f, 0x0c, 0x23, 0x0a, 0xb2, 0x00, 0x3a, 0x0a, 
+0x34, 0x15, 0x30, 0x0b, 0x95, 0x07, 0x8b, 0x0a, 0xb4, 0x00, 0xcc, 0x00, 
+0xc2, 0x00, 0x17, 0x00, 0x3c, 0x01, 0x48, 0x00, 0xc5, 0x0a, 0xab, 0x0b, 
+0xce, 0x00, 0x23, 0x0f, 0xb1, 0x0c, 0x6b, 0x0a, 0x80, 0x09, 0x75, 0x0a, 
+0xd3, 0x00, 0x91, 0x0c, 0x54, 0x11, 0xac, 0x00, 0x18, 0x0b, 0x27, 0x14, 
+0x34, 0x0a, 0x3a, 0x01, 0x35, 0x0e, 0xac, 0x0a, 0x8c, 0x0a, 0x12, 0x1c, 
+0xcb, 0x09, 0xb1, 0x00, 0xb1, 0x0a, 0xeb, 0x00, 0xe4, 0x0a, 0x40, 0x06, 
+0xf3, 0x08, 0x9c, 0x07, 0x7c, 0x00, 0xcd, 0x00, 0xb1, 0x00, 0x15, 0x19, 
+0x2a, 0x00, 0xd0, 0x15, 0x70, 0x1a, 0xd3, 0x00, 0xdd, 0x09, 0x83, 0x14, 
+0x6f, 0x0e, 0x23, 0x00, 0x24, 0x0c, 0xbc, 0x09, 0xe0, 0x08, 0x4f, 0x12, 
+0x8f, 0x1a, 0x4a, 0x06, 0x3b, 0x01, 0xb1, 0x08, 0x96, 0x0c, 0xd5, 0x14, 
+0xbf, 0x0b, 0x3c, 0x00, 0xb6, 0x0e, 0xfa, 0x0b, 0xe3, 0x00, 0x1a, 0x08, 
+0xf1, 0x13, 0x3e, 0x0b, 0xbf, 0x0c, 0xdb, 0x0d, 0x75, 0x0b, 0xdd, 0x0c, 
+0x1e, 0x14, 0x40, 0x0d, 0x70, 0x07, 0x9e, 0x0b, 0xd8, 0x0a, 0xac, 0x06, 
+0x6b, 0x0a, 0xea, 0x0c, 0x1a, 0x0e, 0xa0, 0x0b, 0x2f, 0x00, 0x27, 0x00, 
+0xb4, 0x0b, 0xfa, 0x00, 0x05, 0x01, 0x8c, 0x06, 0x63, 0x0e, 0xa1, 0x1a, 
+0xb0, 0x00, 0x58, 0x17, 0x9c, 0x0c, 0xe1, 0x07, 0xc6, 0x14, 0x11, 0x0e, 
+0x03, 0x0e, 0x6f, 0x0a, 0xdf, 0x00, 0x2b, 0x09, 0x58, 0x17, 0xb2, 0x0c, 
+0xcd, 0x00, 0x50, 0x0c, 0xb0, 0x11, 0x41, 0x06, 0x08, 0x01, 0x53, 0x07, 
+0x0c, 0x0c, 0x9c, 0x0f, 0xe5, 0x00, 0x78, 0x00, 0x41, 0x12, 0xfb, 0x14, 
+0x64, 0x0c, 0xa2, 0x06, 0x66, 0x1b, 0x12, 0x07, 0x3b, 0x00, 0x9c, 0x00, 
+0xe1, 0x0b, 0x19, 0x00, 0x92, 0x12, 0x2b, 0x0e, 0x24, 0x01, 0xd1, 0x00, 
+0x1b, 0x00, 0x03, 0x15, 0xa9, 0x0d, 0xe0, 0x0a, 0xa9, 0x08, 0xef, 0x00, 
+0xec, 0x0c, 0x2d, 0x09, 0x2a, 0x14, 0xf9, 0x0a, 0x69, 0x07, 0x38, 0x0c, 
+0x23, 0x19, 0x7a, 0x0c, 0xe5, 0x0a, 0x81, 0x0b, 0xc8, 0x09, 0x33, 0x00, 
+0xe2, 0x00, 0xac, 0x0b, 0x9d, 0x0f, 0x10, 0x01, 0x3e, 0x0a, 0xa8, 0x0c, 
+0xe1, 0x07, 0xae, 0x0a, 0xe3, 0x0c, 0xb8, 0x11, 0x81, 0x0b, 0x3c, 0x00, 
+0x64, 0x0c, 0x05, 0x01, 0x5f, 0x07, 0x15, 0x14, 0xa3, 0x06, 0x18, 0x07, 
+0x6e, 0x10, 0x51, 0x13, 0x1e, 0x0f, 0x22, 0x01, 0x31, 0x01, 0xe2, 0x13, 
+0x29, 0x0a, 0x68, 0x14, 0x10, 0x07, 0xb2, 0x00, 0xca, 0x00, 0xe0, 0x0c, 
+0x8a, 0x10, 0xfa, 0x14, 0x4f, 0x17, 0x6f, 0x09, 0xd8, 0x16, 0xdf, 0x00, 
+0x6d, 0x09, 0x30, 0x00, 0x83, 0x00, 0x6f, 0x10, 0x75, 0x0b, 0x9c, 0x08, 
+0xe4, 0x06, 0xd9, 0x00, 0x37, 0x01, 0xae, 0x10, 0x28, 0x07, 0x97, 0x13, 
+0x3d, 0x0b, 0xc5, 0x00, 0xa9, 0x0b, 0x1b, 0x00, 0x1f, 0x0b, 0x5c, 0x17, 
+0x08, 0x01, 0x0c, 0x01, 0x13, 0x07, 0x53, 0x07, 0xdb, 0x0b, 0xc5, 0x09, 
+0x82, 0x09, 0x68, 0x08, 0x15, 0x0a, 0x95, 0x00, 0x4d, 0x0a, 0xd1, 0x0b, 
+0x6b, 0x0a, 0x36, 0x01, 0xc9, 0x0c, 0xef, 0x0a, 0x03, 0x08, 0xd6, 0x0b, 
+0x34, 0x00, 0x19, 0x0c, 0x16, 0x17, 0x81, 0x18, 0xd8, 0x00, 0x77, 0x09, 
+0x69, 0x0b, 0x14, 0x19, 0xf4, 0x11, 0x6f, 0x00, 0x4f, 0x0c, 0x47, 0x19, 
+0xec, 0x0c, 0xf1, 0x09, 0x3c, 0x0b, 0xbf, 0x0c, 0x30, 0x0b, 0xc7, 0x00, 
+0x80, 0x0a, 0xfa, 0x0a, 0xc2, 0x0a, 0x4d, 0x09, 0xc3, 0x00, 0x28, 0x01, 
+0x18, 0x08, 0x10, 0x01, 0x86, 0x00, 0x1a, 0x0d, 0xcf, 0x18, 0x83, 0x0b, 
+0x28, 0x00, 0x46, 0x0c, 0xb9, 0x00, 0xd3, 0x0c, 0x4c, 0x0b, 0x72, 0x10, 
+0xc3, 0x11, 0x12, 0x0b, 0x28, 0x01, 0x9e, 0x08, 0xe0, 0x0b, 0x02, 0x16, 
+0xe0, 0x11, 0x16, 0x14, 0xfb, 0x0a, 0x64, 0x00, 0x80, 0x0c, 0x2d, 0x14, 
+0x14, 0x0a, 0xc7, 0x07, 0xed, 0x00, 0x07, 0x14, 0xae, 0x0f, 0x23, 0x01, 
+0x9d, 0x08, 0x71, 0x0c, 0xd8, 0x0b, 0x5d, 0x07, 0x35, 0x0b, 0x12, 0x0a, 
+0x78, 0x09, 0x82, 0x0a, 0xda, 0x0a, 0x5a, 0x0c, 0x08, 0x0a, 0x85, 0x11, 
+0x26, 0x0b, 0xc4, 0x00, 0x09, 0x01, 0x6f, 0x13, 0xce, 0x15, 0x21, 0x0b, 
+0x42, 0x13, 0x27, 0x00, 0x1e, 0x07, 0x62, 0x00, 0x0e, 0x01, 0x9d, 0x17, 
+0xee, 0x06, 0x9f, 0x00, 0x4a, 0x0a, 0xc5, 0x14, 0x42, 0x06, 0x09, 0x01, 
+0x88, 0x00, 0xde, 0x0c, 0x65, 0x08, 0x49, 0x00, 0xe1, 0x00, 0xc2, 0x0a, 
+0x31, 0x01, 0x90, 0x0a, 0xee, 0x11, 0x36, 0x07, 0x25, 0x0c, 0x4d, 0x0e, 
+0x57, 0x00, 0x8b, 0x0b, 0x4e, 0x00, 0x51, 0x0b, 0x1a, 0x14, 0xc7, 0x00, 
+0x17, 0x17, 0xb1, 0x09, 0xe3, 0x06, 0x83, 0x0b, 0x39, 0x01, 0x03, 0x01, 
+0xf9, 0x00, 0x62, 0x17, 0x09, 0x01, 0x32, 0x01, 0x54, 0x0b, 0xdf, 0x0a, 
+0x68, 0x1c, 0x64, 0x0c, 0xd9, 0x00, 0x61, 0x18, 0x4b, 0x0b, 0x31, 0x12, 
+0xc1, 0x00, 0x36, 0x09, 0x08, 0x0d, 0x15, 0x07, 0x15, 0x0b, 0xb1, 0x15, 
+0x9e, 0x00, 0x5d, 0x00, 0xfa, 0x0a, 0x0d, 0x13, 0x6c, 0x00, 0xb3, 0x00, 
+0x97, 0x10, 0xe0, 0x08, 0x43, 0x00, 0x9c, 0x13, 0x43, 0x14, 0x47, 0x06, 
+0x85, 0x14, 0x82, 0x0a, 0xeb, 0x07, 0x4f, 0x0d, 0x60, 0x0a, 0x26, 0x0b, 
+0x09, 0x18, 0x1c, 0x0c, 0x60, 0x00, 0xef, 0x0d, 0xf9, 0x10, 0xa8, 0x10, 
+0x50, 0x0f, 0xcd, 0x0b, 0x6e, 0x0a, 0xd3, 0x11, 0xe1, 0x0f, 0x8b, 0x13, 
+0x96, 0x07, 0xfd, 0x00, 0x3f, 0x0a, 0x73, 0x06, 0x1d, 0x0b, 0x73, 0x16, 
+0x13, 0x0a, 0x2d, 0x09, 0x54, 0x0a, 0x68, 0x1c, 0x6c, 0x00, 0x42, 0x1a, 
+0x13, 0x07, 0xdf, 0x09, 0x54, 0x07, 0x73, 0x0b, 0xd9, 0x00, 0x42, 0x06, 
+0x98, 0x15, 0xdf, 0x0c, 0x2b, 0x09, 0x0b, 0x01, 0xd4, 0x00, 0x27, 0x08, 
+0x8c, 0x13, 0x83, 0x00, 0xc9, 0x09, 0x3a, 0x01, 0x98, 0x00, 0xb2, 0x00, 
+0x3a, 0x01, 0x5c, 0x1a, 0x47, 0x18, 0xe0, 0x0a, 0xc9, 0x00, 0x0d, 0x00, 
+0x14, 0x01, 0xb5, 0x00, 0xf6, 0x00, 0xc8, 0x09, 0x91, 0x00, 0x1b, 0x0b, 
+0x2e, 0x0c, 0xc5, 0x00, 0xd6, 0x00, 0x2c, 0x01, 0xfc, 0x0b, 0x83, 0x08, 
+0xa8, 0x00, 0x91, 0x1a, 0xb8, 0x0e, 0x2e, 0x01, 0x42, 0x01, 0xe0, 0x13, 
+0xf7, 0x0f, 0x98, 0x0a, 0x41, 0x06, 0x52, 0x0c, 0xba, 0x00, 0xbb, 0x00, 
+0xda, 0x0a, 0x70, 0x07, 0x94, 0x07, 0xa9, 0x0b, 0xf6, 0x00, 0x2f, 0x00, 
+0xea, 0x08, 0xbf, 0x00, 0x42, 0x14, 0xb1, 0x11, 0xc1, 0x07, 0x13, 0x0a, 
+0x4c, 0x11, 0x45, 0x06, 0xa4, 0x1a, 0x3e, 0x0b, 0x2f, 0x00, 0x21, 0x11, 
+0x59, 0x0a, 0x9e, 0x08, 0xfe, 0x0f, 0x47, 0x0b, 0x1f, 0x1a, 0xf7, 0x09, 
+0xfb, 0x1b, 0x4d, 0x0e, 0x27, 0x01, 0xf5, 0x00, 0x26, 0x0b, 0xee, 0x00, 
+0x14, 0x01, 0xce, 0x0a, 0x9a, 0x06, 0x43, 0x0a, 0xff, 0x00, 0xcd, 0x0a, 
+0x62, 0x0a, 0xe5, 0x0d, 0x42, 0x06, 0xfc, 0x15, 0xd8, 0x00, 0x7d, 0x0b, 
+0x64, 0x0c, 0x5f, 0x0a, 0x6a, 0x0a, 0xec, 0x15, 0x2c, 0x0a, 0xd7, 0x07, 
+0x46, 0x0b, 0xfa, 0x00, 0x07, 0x0a, 0xe3, 0x00, 0xe0, 0x00, 0xd6, 0x00, 
+0x28, 0x0a, 0x0d, 0x00, 0x11, 0x0b, 0xfa, 0x00, 0xa1, 0x15, 0x25, 0x01, 
+0xda, 0x06, 0x8f, 0x11, 0xe4, 0x07, 0x69, 0x13, 0x81, 0x11, 0x68, 0x0b, 
+0x5d, 0x0c, 0xa9, 0x0b, 0x25, 0x09, 0x44, 0x0c, 0xa6, 0x0c, 0x0c, 0x0c, 
+0xeb, 0x00, 0x13, 0x0e, 0x35, 0x01, 0x1e, 0x0c, 0x19, 0x0d, 0xa1, 0x19, 
+0xc5, 0x0a, 0x36, 0x08, 0x50, 0x17, 0x44, 0x06, 0xb8, 0x0e, 0xa4, 0x09, 
+0x50, 0x0c, 0xc4, 0x0a, 0x3e, 0x00, 0xc3, 0x00, 0x41, 0x0e, 0x32, 0x00, 
+0x5f, 0x06, 0x1f, 0x00, 0x3a, 0x01, 0x49, 0x00, 0xec, 0x00, 0x01, 0x0c, 
+0xd4, 0x0c, 0x25, 0x00, 0x82, 0x0a, 0x77, 0x13, 0x97, 0x0c, 0x18, 0x00, 
+0x9d, 0x00, 0x81, 0x11, 0xd5, 0x00, 0x77, 0x0b, 0x55, 0x00, 0xd6, 0x10, 
+0x45, 0x0c, 0x98, 0x0b, 0xdd, 0x00, 0x5b, 0x0c, 0x96, 0x15, 0x10, 0x07, 
+0xdf, 0x00, 0x0d, 0x01, 0xa1, 0x06, 0x1a, 0x00, 0x27, 0x01, 0x5e, 0x0b, 
+0xb4, 0x00, 0xfc, 0x09, 0xb3, 0x16, 0xd5, 0x09, 0x10, 0x01, 0xbd, 0x14, 
+0xca, 0x0b, 0x4d, 0x0a, 0xbf, 0x0a, 0x94, 0x12, 0x13, 0x0a, 0x18, 0x17, 
+0xd2, 0x0e, 0xee, 0x00, 0x0e, 0x0a, 0x3f, 0x09, 0xe8, 0x00, 0x6d, 0x1c, 
+0x17, 0x01, 0x35, 0x01, 0x35, 0x0a, 0x17, 0x00, 0x3d, 0x01, 0xde, 0x0c, 
+0x87, 0x0c, 0x53, 0x00, 0x5b, 0x0c, 0x27, 0x09, 0xde, 0x00, 0xbc, 0x09, 
+0x22, 0x0e, 0xa8, 0x09, 0x24, 0x01, 0xdb, 0x0a, 0xaf, 0x09, 0xc2, 0x0b, 
+0x59, 0x06, 0x04, 0x0e, 0xf8, 0x0c, 0x24, 0x01, 0xbc, 0x00, 0x08, 0x01, 
+0xc9, 0x00, 0x22, 0x01, 0xfd, 0x07, 0x7e, 0x0b, 0x75, 0x16, 0x78, 0x00, 
+0x74, 0x0b, 0x48, 0x00, 0x36, 0x07, 0x3f, 0x0b, 0xd6, 0x00, 0xf4, 0x09, 
+0xdb, 0x0f, 0x0f, 0x0b, 0xf4, 0x00, 0x2a, 0x18, 0xf9, 0x18, 0x32, 0x01, 
+0x1a, 0x01, 0xb7, 0x00, 0xb2, 0x0c, 0x7a, 0x07, 0x84, 0x0e, 0x9f, 0x07, 
+0x4f, 0x0a, 0xe0, 0x09, 0xc9, 0x00, 0x1b, 0x07, 0xe5, 0x0a, 0x9a, 0x15, 
+0x11, 0x01, 0xdf, 0x0d, 0x54, 0x0b, 0xa8, 0x00, 0x79, 0x0d, 0x18, 0x01, 
+0xac, 0x0a, 0x62, 0x09, 0x41, 0x0a, 0xf5, 0x08, 0xba, 0x0a, 0xd2, 0x00, 
+0xde, 0x09, 0x56, 0x11, 0xc6, 0x0a, 0xd0, 0x00, 0x3e, 0x0f, 0x1b, 0x01, 
+0x08, 0x01, 0x18, 0x01, 0x2d, 0x09, 0x7d, 0x0a, 0x45, 0x08, 0xc2, 0x0b, 
+0x23, 0x0c, 0x62, 0x0b, 0xa2, 0x0a, 0x53, 0x0b, 0xde, 0x00, 0x06, 0x01, 
+0xa1, 0x07, 0x1d, 0x13, 0xe1, 0x0f, 0xf4, 0x00, 0xd4, 0x00, 0xd2, 0x0f, 
+0x93, 0x0f, 0x37, 0x09, 0x44, 0x06, 0x9c, 0x08, 0x75, 0x14, 0xb8, 0x0a, 
+0xac, 0x0b, 0xec, 0x00, 0x1e, 0x0b, 0x41, 0x06, 0xbc, 0x00, 0xda, 0x00, 
+0xdb, 0x0d, 0x1f, 0x1a, 0xc2, 0x0a, 0x4d, 0x0b, 0x8f, 0x0b, 0xb4, 0x07, 
+0xaa, 0x09, 0xdc, 0x0a, 0xe8, 0x00, 0x77, 0x00, 0x39, 0x01, 0xee, 0x0b, 
+0xb1, 0x0c, 0xc1, 0x00, 0x8a, 0x0b, 0x99, 0x06, 0xdd, 0x09, 0x0d, 0x00, 
+0x2e, 0x01, 0x4f, 0x0c, 0xde, 0x08, 0x9a, 0x15, 0x8f, 0x00, 0xd9, 0x06, 
+0xa1, 0x0a, 0xf1, 0x14, 0xe0, 0x09, 0x33, 0x0c, 0x84, 0x00, 0xf2, 0x0a, 
+0x9c, 0x0c, 0x76, 0x0a, 0xc2, 0x08, 0xd8, 0x0a, 0x2f, 0x07, 0xcb, 0x0f, 
+0x53, 0x0c, 0x26, 0x00, 0x7a, 0x08, 0x25, 0x00, 0xe2, 0x07, 0x54, 0x07, 
+0x72, 0x16, 0xe0, 0x0b, 0x0d, 0x01, 0xb7, 0x0a, 0xc0, 0x0b, 0x95, 0x0c, 
+0x64, 0x0a, 0xd4, 0x00, 0x4d, 0x19, 0xca, 0x00, 0x2b, 0x0b, 0xfd, 0x07, 
+0x9c, 0x0c, 0x67, 0x0b, 0xb2, 0x07, 0x08, 0x01, 0x25, 0x01, 0x68, 0x12, 
+0x23, 0x00, 0x16, 0x01, 0xd6, 0x14, 0x1e, 0x07, 0x1c, 0x07, 0x34, 0x01, 
+0xcf, 0x0a, 0xd8, 0x00, 0x6a, 0x0a, 0x51, 0x0b, 0x71, 0x0d, 0x91, 0x13, 
+0xdc, 0x00, 0x10, 0x01, 0x14, 0x01, 0xc2, 0x0e, 0xb0, 0x09, 0xe9, 0x13, 
+0x7b, 0x0c, 0x78, 0x00, 0xf9, 0x0a, 0xa4, 0x09, 0xd0, 0x09, 0xae, 0x00, 
+0x0b, 0x00, 0x6a, 0x0b, 0x0d, 0x01, 0x4a, 0x00, 0xb2, 0x07, 0xc7, 0x0c, 
+0xef, 0x07, 0xb2, 0x09, 0x9b, 0x0c, 0xba, 0x17, 0xf2, 0x14, 0xf5, 0x06, 
+0x73, 0x15, 0x51, 0x0b, 0xde, 0x00, 0x18, 0x0c, 0x62, 0x09, 0x31, 0x0b, 
+0x66, 0x0a, 0xa8, 0x08, 0xb7, 0x00, 0xa7, 0x09, 0xa9, 0x09, 0xdc, 0x0d, 
+0xe1, 0x00, 0x34, 0x0a, 0x4a, 0x00, 0x26, 0x0b, 0xf5, 0x11, 0x14, 0x07, 
+0xe1, 0x0b, 0xb1, 0x0a, 0x9c, 0x06, 0xa6, 0x0e, 0xe2, 0x0c, 0xd6, 0x00, 
+0xb5, 0x09, 0xdf, 0x0c, 0xb2, 0x00, 0x06, 0x0f, 0xf0, 0x00, 0x79, 0x10, 
+0xef, 0x00, 0x13, 0x01, 0xfe, 0x0c, 0x28, 0x0b, 0xe9, 0x17, 0x62, 0x0e, 
+0xea, 0x00, 0xc1, 0x07, 0x55, 0x0a, 0xa3, 0x0a, 0x18, 0x08, 0xaf, 0x11, 
+0xf6, 0x00, 0x32, 0x0b, 0x01, 0x01, 0xde, 0x08, 0x6d, 0x00, 0x4e, 0x00, 
+0x1a, 0x0b, 0xc9, 0x0f, 0x00, 0x01, 0x2a, 0x01, 0x2d, 0x09, 0x5f, 0x0a, 
+0x74, 0x16, 0x6b, 0x0b, 0x50, 0x17, 0x7b, 0x00, 0x3e, 0x00, 0x5a, 0x0f, 
+0x72, 0x16, 0xb8, 0x0a, 0xa7, 0x00, 0xe3, 0x09, 0x6f, 0x0c, 0x5b, 0x08, 
+0x29, 0x15, 0x11, 0x0e, 0xa7, 0x0c, 0xff, 0x0e, 0xd2, 0x0c, 0x0d, 0x09, 
+0x20, 0x15, 0xb3, 0x0c, 0xac, 0x0b, 0xb9, 0x0d, 0x83, 0x10, 0x8f, 0x00, 
+0x58, 0x0d, 0xe6, 0x09, 0x77, 0x09, 0x28, 0x0a, 0x5e, 0x07, 0x47, 0x06, 
+0x31, 0x0c, 0x86, 0x00, 0x83, 0x00, 0xd2, 0x0b, 0xf9, 0x09, 0xc3, 0x0a, 
+0x12, 0x0a, 0x62, 0x17, 0x41, 0x06, 0x3b, 0x01, 0xd0, 0x00, 0x70, 0x06, 
+0xb6, 0x10, 0xf8, 0x00, 0xfe, 0x0f, 0x3e, 0x09, 0xf6, 0x19, 0x00, 0x0a, 
+0xf2, 0x09, 0xd3, 0x11, 0xf4, 0x0c, 0xc3, 0x0a, 0xb9, 0x0a, 0x7d, 0x0b, 
+0x43, 0x06, 0xd5, 0x00, 0x98, 0x07, 0xd7, 0x0c, 0x5e, 0x0b, 0xf5, 0x0d, 
+0x0e, 0x0c, 0x60, 0x00, 0x4d, 0x0a, 0x93, 0x00, 0x67, 0x0b, 0xd0, 0x00, 
+0x11, 0x07, 0x6d, 0x0a, 0x15, 0x0a, 0xfd, 0x09, 0xeb, 0x0c, 0x6e, 0x09, 
+0x85, 0x07, 0xdf, 0x00, 0xed, 0x0b, 0xfe, 0x0f, 0x47, 0x0e, 0x77, 0x19, 
+0xc3, 0x0a, 0xae, 0x00, 0x6d, 0x00, 0xde, 0x0e, 0xa8, 0x0c, 0xf6, 0x0b, 
+0xd8, 0x00, 0x12, 0x00, 0xb3, 0x17, 0x10, 0x0b, 0xb6, 0x00, 0xf5, 0x00, 
+0x2d, 0x0a, 0x85, 0x00, 0xfd, 0x08, 0xff, 0x0f, 0x72, 0x16, 0x0c, 0x0c, 
+0x05, 0x0b, 0xac, 0x0a, 0x0c, 0x01, 0x3b, 0x0b, 0x4f, 0x17, 0xc6, 0x00, 
+0xf5, 0x07, 0x5a, 0x08, 0xe8, 0x09, 0x72, 0x00, 0xf2, 0x00, 0xf0, 0x16, 
+0xae, 0x0a, 0x53, 0x00, 0xdb, 0x09, 0x4b, 0x00, 0x2b, 0x07, 0xfe, 0x00, 
+0x91, 0x13, 0x9b, 0x00, 0x57, 0x0b, 0x11, 0x01, 0xe1, 0x09, 0xc5, 0x0a, 
+0xf5, 0x0a, 0x19, 0x01, 0xf6, 0x0f, 0x26, 0x0b, 0x4b, 0x0a, 0xdd, 0x00, 
+0x3a, 0x00, 0xc3, 0x00, 0xf3, 0x00, 0xaf, 0x09, 0x41, 0x0a, 0x00, 0x01, 
+0x7b, 0x1b, 0x42, 0x13, 0x58, 0x0e, 0xa9, 0x0b, 0x9d, 0x00, 0x4a, 0x06, 
+0x21, 0x0a, 0x83, 0x0a, 0x58, 0x11, 0xf9, 0x00, 0x38, 0x09, 0xea, 0x13, 
+0x9c, 0x15, 0x3f, 0x01, 0x3b, 0x18, 0xbb, 0x0a, 0xbb, 0x0b, 0xd0, 0x00, 
+0x80, 0x0c, 0x92, 0x06, 0xce, 0x0a, 0x3d, 0x00, 0xa7, 0x08, 0x59, 0x0c, 
+0xa0, 0x07, 0x61, 0x0c, 0x33, 0x01, 0xbb, 0x09, 0x45, 0x06, 0xdc, 0x00, 
+0xd0, 0x0a, 0xd9, 0x0a, 0x1d, 0x01, 0x8c, 0x13, 0xe3, 0x0c, 0x38, 0x01, 
+0x51, 0x0b, 0xaa, 0x0b, 0xa6, 0x0f, 0xd1, 0x00, 0x40, 0x06, 0x87, 0x0d, 
+0xc2, 0x00, 0x41, 0x1a, 0xef, 0x00, 0x09, 0x08, 0x57, 0x0b, 0x76, 0x0a, 
+0x77, 0x00, 0x5d, 0x0b, 0x65, 0x18, 0x9d, 0x0f, 0xcf, 0x00, 0x2a, 0x0b, 
+0xb7, 0x0a, 0xb5, 0x0b, 0x26, 0x12, 0xdb, 0x07, 0xf5, 0x00, 0x0b, 0x00, 
+0x67, 0x07, 0x6e, 0x0a, 0xe0, 0x0b, 0x3d, 0x0c, 0xf6, 0x10, 0x96, 0x17, 
+0xb5, 0x00, 0xcc, 0x0c, 0x78, 0x0a, 0x25, 0x00, 0x5b, 0x08, 0x83, 0x09, 
+0xd4, 0x09, 0xc3, 0x00, 0x25, 0x0b, 0xfc, 0x07, 0x87, 0x0d, 0xfe, 0x10, 
+0xcd, 0x0c, 0x1d, 0x0a, 0x86, 0x00, 0x47, 0x00, 0x5f, 0x00, 0x3f, 0x00, 
+0xea, 0x00, 0xd7, 0x17, 0x28, 0x0a, 0xa9, 0x17, 0x1c, 0x07, 0x34, 0x01, 
+0x27, 0x00, 0x6f, 0x00, 0x6e, 0x00, 0x0a, 0x0a, 0x04, 0x01, 0xbd, 0x0c, 
+0x1b, 0x08, 0x19, 0x14, 0x51, 0x17, 0xea, 0x09, 0xfc, 0x07, 0x3d, 0x01, 
+0xa2, 0x07, 0x2f, 0x00, 0x38, 0x07, 0x03, 0x01, 0x07, 0x0a, 0x98, 0x06, 
+0x27, 0x07, 0xd6, 0x10, 0x37, 0x01, 0x9c, 0x12, 0xee, 0x00, 0xd8, 0x07, 
+0xeb, 0x09, 0x9c, 0x08, 0xbb, 0x1b, 0xd6, 0x07, 0x3e, 0x0a, 0x5c, 0x0c, 
+0xfa, 0x00, 0x70, 0x10, 0x08, 0x01, 0x47, 0x06, 0x28, 0x0a, 0xa3, 0x15, 
+0x23, 0x00, 0x10, 0x15, 0x8d, 0x0a, 0x3e, 0x0a, 0xd0, 0x0a, 0x83, 0x0b, 
+0xda, 0x10, 0xd8, 0x00, 0x34, 0x00, 0xdd, 0x00, 0x39, 0x01, 0xb6, 0x00, 
+0x90, 0x17, 0x1d, 0x01, 0x58, 0x00, 0x93, 0x00, 0x3f, 0x09, 0x1a, 0x01, 
+0xd5, 0x00, 0xe7, 0x0a, 0x17, 0x00, 0xaf, 0x09, 0x4a, 0x12, 0xec, 0x00, 
+0x53, 0x07, 0xac, 0x00, 0x7b, 0x1b, 0x10, 0x01, 0x6b, 0x0b, 0x3f, 0x0e, 
+0xf6, 0x00, 0x27, 0x07, 0xd6, 0x14, 0x14, 0x01, 0x60, 0x0a, 0x38, 0x00, 
+0x17, 0x01, 0xd0, 0x09, 0x43, 0x06, 0xe0, 0x00, 0x1d, 0x0a, 0xdd, 0x0c, 
+0x31, 0x01, 0xb1, 0x00, 0xe6, 0x00, 0xcb, 0x00, 0x69, 0x06, 0x5f, 0x0b, 
+0xb7, 0x0d, 0xea, 0x00, 0x2a, 0x01, 0xad, 0x00, 0xf8, 0x17, 0x7d, 0x0b, 
+0xee, 0x0a, 0x7a, 0x0c, 0x3e, 0x0f, 0xc2, 0x0a, 0x23, 0x0e, 0xb7, 0x0a, 
+0xb3, 0x09, 0x49, 0x06, 0x2b, 0x0b, 0x44, 0x06, 0x1b, 0x01, 0xf6, 0x08, 
+0xc6, 0x00, 0xe2, 0x13, 0x9f, 0x07, 0x1f, 0x01, 0xc8, 0x0c, 0x2f, 0x0e, 
+0x02, 0x0c, 0x46, 0x06, 0xce, 0x09, 0x36, 0x01, 0xc2, 0x0a, 0x22, 0x0c, 
+0x29, 0x0e, 0xaf, 0x09, 0x5c, 0x0b, 0x4c, 0x0a, 0x93, 0x0b, 0x3c, 0x00, 
+0x59, 0x0c, 0x62, 0x0b, 0xd5, 0x0b, 0x09, 0x01, 0xd2, 0x06, 0x14, 0x01, 
+0xeb, 0x0c, 0xb8, 0x15, 0xa9, 0x16, 0xdc, 0x09, 0x4d, 0x0a, 0x1b, 0x0c, 
+0x1c, 0x01, 0x23, 0x01, 0x6a, 0x0d, 0x0e, 0x01, 0x82, 0x0a, 0xec, 0x00, 
+0x28, 0x0a, 0xff, 0x0f, 0x28, 0x08, 0x02, 0x13, 0xe1, 0x00, 0x07, 0x01, 
+0x77, 0x00, 0xe7, 0x0a, 0xe4, 0x07, 0xdd, 0x0d, 0x34, 0x0a, 0x21, 0x01, 
+0x79, 0x00, 0xd6, 0x0b, 0xab, 0x08, 0x1a, 0x0e, 0x89, 0x09, 0x00, 0x01, 
+0x25, 0x00, 0x82, 0x11, 0xab, 0x1a, 0x3f, 0x00, 0x77, 0x12, 0x10, 0x07, 
+0xae, 0x07, 0xd9, 0x0a, 0xdf, 0x00, 0xc4, 0x00, 0x51, 0x0b, 0x30, 0x0c, 
+0x42, 0x06, 0x49, 0x06, 0x95, 0x0b, 0x2e, 0x07, 0x10, 0x0b, 0x3d, 0x0b, 
+0x61, 0x0a, 0x7e, 0x0c, 0x94, 0x0b, 0xe0, 0x00, 0x40, 0x06, 0xed, 0x00, 
+0x02, 0x01, 0x28, 0x01, 0x0e, 0x01, 0x50, 0x11, 0xb1, 0x08, 0x44, 0x06, 
+0x0c, 0x00, 0xbf, 0x00, 0x00, 0x09, 0xee, 0x0e, 0x90, 0x0c, 0x22, 0x15, 
+0xd1, 0x00, 0xe4, 0x13, 0xa9, 0x09, 0xcf, 0x00, 0xb9, 0x00, 0x4f, 0x11, 
+0x20, 0x09, 0xc7, 0x09, 0xd9, 0x0a, 0x8d, 0x16, 0x25, 0x00, 0x8a, 0x09, 
+0x3c, 0x01, 0xe3, 0x00, 0x99, 0x15, 0x17, 0x0a, 0x5f, 0x0b, 0xd6, 0x00, 
+0x88, 0x00, 0x07, 0x0a, 0xdd, 0x00, 0xcf, 0x00, 0x6b, 0x0a, 0x6e, 0x00, 
+0x9f, 0x0b, 0xac, 0x16, 0x2d, 0x08, 0x54, 0x00, 0x2b, 0x01, 0xaa, 0x00, 
+0x42, 0x0a, 0xd3, 0x00, 0x5a, 0x06, 0xde, 0x00, 0x05, 0x01, 0x74, 0x15, 
+0x9e, 0x06, 0x3b, 0x00, 0x18, 0x0a, 0xb4, 0x0f, 0xdc, 0x11, 0xd0, 0x00, 
+0xf1, 0x14, 0x80, 0x08, 0x99, 0x12, 0xea, 0x09, 0xa2, 0x15, 0x8b, 0x0b, 
+0x9b, 0x1a, 0x89, 0x00, 0x45, 0x0f, 0xc2, 0x0e, 0x5a, 0x0c, 0xf7, 0x07, 
+0x1f, 0x1a, 0x29, 0x12, 0x29, 0x12, 0x54, 0x0d, 0x47, 0x1a, 0x77, 0x00, 
+0x0c, 0x0c, 0xa7, 0x09, 0x36, 0x01, 0xad, 0x00, 0x73, 0x0b, 0xf6, 0x06, 
+0x0f, 0x0b, 0x8f, 0x0b, 0x5a, 0x08, 0xb8, 0x00, 0x80, 0x0a, 0x6d, 0x00, 
+0xe6, 0x00, 0xf5, 0x06, 0x31, 0x0b, 0x2b, 0x0a, 0x84, 0x0a, 0x17, 0x01, 
+0xe0, 0x13, 0x23, 0x0c, 0x0d, 0x01, 0xbc, 0x00, 0xcb, 0x0b, 0xe1, 0x07, 
+0x23, 0x08, 0x1a, 0x08, 0xef, 0x0d, 0x7e, 0x0e, 0x9c, 0x0c, 0xb3, 0x0c, 
+0x38, 0x0c, 0xcc, 0x12, 0xa2, 0x0b, 0xed, 0x1a, 0xb9, 0x00, 0xfb, 0x15, 
+0xbb, 0x16, 0x54, 0x00, 0xcc, 0x00, 0x1e, 0x16, 0xdf, 0x00, 0xe9, 0x08, 
+0x7b, 0x0a, 0x95, 0x07, 0x67, 0x12, 0x43, 0x06, 0x4e, 0x0c, 0x42, 0x0a, 
+0xe9, 0x00, 0x54, 0x0b, 0x4d, 0x0d, 0x7d, 0x1b, 0xb0, 0x11, 0xb8, 0x00, 
+0x4b, 0x06, 0x78, 0x10, 0x6c, 0x00, 0x3e, 0x0a, 0x4b, 0x00, 0xa6, 0x0c, 
+0xb2, 0x0c, 0x47, 0x0b, 0x94, 0x0c, 0xe7, 0x00, 0x46, 0x0b, 0xab, 0x00, 
+0x39, 0x01, 0xa0, 0x08, 0x33, 0x01, 0x31, 0x00, 0xa2, 0x0a, 0x18, 0x0a, 
+0xa2, 0x0c, 0x7b, 0x0c, 0x1e, 0x15, 0xc4, 0x0e, 0xc5, 0x14, 0x40, 0x06, 
+0x6b, 0x00, 0xbd, 0x09, 0x1b, 0x08, 0x12, 0x0d, 0x97, 0x0a, 0xcf, 0x00, 
+0xcd, 0x0a, 0x86, 0x00, 0xd8, 0x00, 0x73, 0x16, 0x1c, 0x00, 0xe0, 0x0c, 
+0xa8, 0x13, 0xd9, 0x07, 0x36, 0x07, 0xc5, 0x11, 0xac, 0x00, 0xcb, 0x00, 
+0xe3, 0x0a, 0x0f, 0x00, 0x17, 0x00, 0x18, 0x00, 0x98, 0x06, 0x09, 0x15, 
+0xff, 0x0c, 0x86, 0x00, 0x02, 0x13, 0xe0, 0x00, 0x9c, 0x08, 0xe3, 0x00, 
+0x75, 0x16, 0x11, 0x07, 0xff, 0x00, 0x31, 0x12, 0x28, 0x0a, 0xa3, 0x0d, 
+0x47, 0x0c, 0x43, 0x0a, 0xb4, 0x00, 0x48, 0x0b, 0x50, 0x06, 0x24, 0x01, 
+0xdc, 0x09, 0x7d, 0x0b, 0xe1, 0x07, 0xcb, 0x0b, 0xd0, 0x00, 0x3e, 0x0d, 
+0x40, 0x00, 0x6b, 0x0a, 0x1a, 0x14, 0x04, 0x13, 0x41, 0x01, 0x35, 0x18, 
+0x21, 0x10, 0x31, 0x17, 0xbf, 0x0c, 0x66, 0x0a, 0xb3, 0x17, 0x28, 0x0a, 
+0xb7, 0x0b, 0x38, 0x01, 0xa6, 0x09, 0xcf, 0x0b, 0x05, 0x09, 0xd1, 0x00, 
+0xd4, 0x09, 0x4b, 0x13, 0x11, 0x01, 0x6b, 0x00, 0xeb, 0x09, 0x96, 0x15, 
+0x67, 0x0b, 0xee, 0x0e, 0x42, 0x01, 0xb4, 0x0c, 0xa3, 0x00, 0x3d, 0x01, 
+0xe7, 0x09, 0x12, 0x0a, 0x25, 0x08, 0x5e, 0x07, 0x79, 0x00, 0xe7, 0x09, 
+0xff, 0x0a, 0x88, 0x16, 0xd5, 0x00, 0x7c, 0x19, 0xbe, 0x0c, 0x74, 0x0b, 
+0x2f, 0x13, 0xd5, 0x0c, 0x69, 0x0b, 0xc4, 0x0b, 0xdf, 0x0c, 0xfb, 0x00, 
+0x0c, 0x13, 0xc7, 0x0c, 0xf5, 0x08, 0x78, 0x08, 0x10, 0x07, 0x53, 0x00, 
+0xbc, 0x14, 0xf3, 0x08, 0xea, 0x00, 0xf0, 0x0b, 0x65, 0x0c, 0x85, 0x00, 
+0xc2, 0x09, 0x30, 0x01, 0x5d, 0x12, 0xb3, 0x00, 0xd5, 0x0c, 0xdc, 0x00, 
+0x06, 0x0b, 0x60, 0x00, 0x2e, 0x07, 0x06, 0x1b, 0x40, 0x16, 0xea, 0x00, 
+0xf4, 0x0c, 0xd3, 0x0f, 0xbc, 0x00, 0xec, 0x00, 0x0f, 0x01, 0xc7, 0x00, 
+0x7e, 0x06, 0x6e, 0x00, 0x68, 0x08, 0x50, 0x06, 0xfb, 0x14, 0x22, 0x0a, 
+0xde, 0x00, 0x6c, 0x00, 0x86, 0x1b, 0x01, 0x0b, 0x40, 0x0a, 
+}
@@ -0,0 +1,158 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	. "github.com/alexclewontin/riverboat/eval"
+)
+
+// EventType identifies what happened in an Event.
+type EventType uint8
+
+const (
+	// HandStarted is emitted when the dealer deals a new hand. PlayerNum is the dealer.
+	HandStarted EventType = iota + 1
+	// BlindPosted is emitted for each blind. PlayerNum is the player posting, and Amount is the amount posted.
+	BlindPosted
+	// HoleCardsDealt is emitted for each player dealt into a hand. PlayerNum is the player, and Cards are their
+	// hole cards. Take care not to forward this to other players.
+	HoleCardsDealt
+	// PlayerChecked is emitted when PlayerNum checks.
+	PlayerChecked
+	// PlayerCalled is emitted when PlayerNum calls. Amount is the amount they put in.
+	PlayerCalled
+	// PlayerBet is emitted when PlayerNum opens the betting. Amount is the amount they put in.
+	PlayerBet
+	// PlayerRaised is emitted when PlayerNum raises. Amount is the amount they put in.
+	PlayerRaised
+	// PlayerFolded is emitted when PlayerNum folds.
+	PlayerFolded
+	// StreetDealt is emitted when the community cards for a new street are dealt. Stage is the new street,
+	// and Cards are the cards dealt on it. When the board is run more than once, Board is the board they were
+	// dealt to (see Pot.Board).
+	StreetDealt
+	// BetReturned is emitted when the part of a bet that no other player called is returned. PlayerNum is the
+	// player, and Amount is the amount returned.
+	BetReturned
+	// PotAwarded is emitted for each player awarded (part of) a pot. PlayerNum is the winner, Amount is the
+	// amount they won, PotNum is the index of the pot, and Cards is their winning hand (nil if the other players
+	// folded, so no cards needed to be shown). Low is true if it was the low half of a hi/lo pot. Board is the
+	// board the pot was played on.
+	PotAwarded
+	// HandEnded is emitted when a hand is over, after all pots have been awarded.
+	HandEnded
+	// AntePosted is emitted for each ante, before the blinds, except for a big blind ante, which comes after the big
+	// blind. PlayerNum is the player posting, and Amount is the amount posted.
+	AntePosted
+	// StraddlePosted is emitted when PlayerNum posts a live straddle, after the blinds. Amount is the amount posted.
+	StraddlePosted
+	// DeadBlindPosted is emitted when PlayerNum makes up a missed small blind, which is dead money. Amount is the
+	// amount posted. A missed big blind is posted live, so it is a BlindPosted.
+	DeadBlindPosted
+	// PlayerSatDown is emitted when a player sits down. PlayerNum is their seat.
+	PlayerSatDown
+	// PlayerStoodUp is emitted when PlayerNum stands up, freeing their seat. Amount is the stack they left with.
+	PlayerStoodUp
+	// PlayerTimedOut is emitted when PlayerNum runs out of time to act, just before they are checked for or folded.
+	PlayerTimedOut
+	// RakeTaken is emitted when the house takes rake from a pot, just before it is awarded. PotNum is the index of
+	// the pot, and Amount is the amount taken.
+	RakeTaken
+	// TimeFeeCollected is emitted when the house takes a time fee from PlayerNum. Amount is the amount taken.
+	TimeFeeCollected
+	// PlayerLeft is emitted when PlayerNum leaves the game (see Leave).
+	PlayerLeft
+)
+
+var eventTypeNames = [...]string{
+	"",
+	"HandStarted",
+	"BlindPosted",
+	"HoleCardsDealt",
+	"PlayerChecked",
+	"PlayerCalled",
+	"PlayerBet",
+	"PlayerRaised",
+	"PlayerFolded",
+	"StreetDealt",
+	"BetReturned",
+	"PotAwarded",
+	"HandEnded",
+	"AntePosted",
+	"StraddlePosted",
+	"DeadBlindPosted",
+	"PlayerSatDown",
+	"PlayerStoodUp",
+	"PlayerTimedOut",
+	"RakeTaken",
+	"TimeFeeCollected",
+	"PlayerLeft",
+}
+
+func (t EventType) String() string {
+	if int(t) < len(eventTypeNames) {
+		return eventTypeNames[t]
+	}
+	return "Unknown"
+}
+
+// Event describes a single state transition of a Game. Which fields are meaningful depends on the Type
+// (see the documentation of each EventType). Stage is always the stage of the game at the time of the event.
+type Event struct {
+	Type      EventType
+	Stage     GameStage
+	PlayerNum uint
+	Amount    uint
+	AllIn     bool
+	Cards     []Card
+	PotNum    uint
+	Low       bool
+	Board     uint
+}
+
+// Listener is a function that is called with every Event a Game emits.
+type Listener func(e Event)
+
+// Subscribe registers l to be called with every Event g emits from then on, in the order they happen.
+//
+// Listeners are called synchronously, while g is locked, by whichever goroutine performed the Action that caused
+// the Event. They must therefore return quickly, and must not call Actions or any other methods of g directly
+// (which would deadlock). A listener that needs to do either should hand the Event off to another goroutine,
+// for example over a buffered channel.
+func (g *Game) Subscribe(l Listener) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	g.listeners = append(g.listeners, l)
+}
+
+func (g *Game) emit(e Event) {
+	e.Stage = g.getStage()
+
+	g.record(e)
+
+	for _, l := range g.listeners {
+		l(e)
+	}
+}
@@ -0,0 +1,110 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGame_Subscribe(t *testing.T) {
+	g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 1000, 1000)
+
+	events := []Event{}
+	g.Subscribe(func(e Event) { events = append(events, e) })
+
+	actions := []struct {
+		action Action
+		pn     uint
+		data   uint
+	}{
+		{Deal, 0, 0},
+		{Bet, 0, 75},
+		{Fold, 1, 0},
+		{Bet, 2, 50},
+		{Bet, 2, 100},
+		{Fold, 0, 0},
+	}
+
+	for _, a := range actions {
+		err := a.action(g, a.pn, a.data)
+
+		if err != nil {
+			t.Fatalf("Test failed - error performing action: %s", err)
+		}
+	}
+
+	// Rejected actions must not emit anything
+	before := len(events)
+	if err := Bet(g, 1, 0); err == nil {
+		t.Errorf("Test failed - Bet must return an error between hands")
+	}
+	if len(events) != before {
+		t.Errorf("Test failed - a rejected action emitted %v", events[before:])
+	}
+
+	type summary struct {
+		Type      EventType
+		Stage     GameStage
+		PlayerNum uint
+		Amount    uint
+	}
+
+	want := []summary{
+		{HandStarted, PreFlop, 0, 0},
+		{HoleCardsDealt, PreFlop, 0, 0},
+		{HoleCardsDealt, PreFlop, 1, 0},
+		{HoleCardsDealt, PreFlop, 2, 0},
+		{BlindPosted, PreFlop, 1, 10},
+		{BlindPosted, PreFlop, 2, 25},
+		{PlayerRaised, PreFlop, 0, 75},
+		{PlayerFolded, PreFlop, 1, 0},
+		{PlayerCalled, PreFlop, 2, 50},
+		{StreetDealt, Flop, 0, 0},
+		{PlayerBet, Flop, 2, 100},
+		{PlayerFolded, Flop, 0, 0},
+		{BetReturned, Flop, 2, 100},
+		{PotAwarded, Flop, 2, 160},
+		{HandEnded, PreDeal, 0, 0},
+	}
+
+	got := []summary{}
+	for _, e := range events {
+		got = append(got, summary{e.Type, e.Stage, e.PlayerNum, e.Amount})
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Test failed - got events\n%v\nwant\n%v", got, want)
+	}
+
+	for _, e := range events {
+		if e.Type == HoleCardsDealt && len(e.Cards) != 2 {
+			t.Errorf("Test failed - got %d hole cards, want 2", len(e.Cards))
+		}
+
+		if e.Type == StreetDealt && len(e.Cards) != 3 {
+			t.Errorf("Test failed - got %d flop cards, want 3", len(e.Cards))
+		}
+	}
+}
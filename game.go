@@ -27,12 +27,16 @@ import (
 	"math"
 	"sort"
 	"sync"
+	"time"
 
 	. "github.com/alexclewontin/riverboat/eval"
 )
 
-// (52 - 5) / 2. I mean, if you really want to...
-const maxPlayers = 23
+// maxPlayers returns the most players a deck can be dealt to in variant v, leaving enough cards for the board.
+// For Hold'em, that's (52 - 5) / 2. I mean, if you really want to...
+func maxPlayers(v Variant) uint {
+	return (52 - 5) / uint(holeCardCount(v))
+}
 
 // Heads up!
 const minPlayers = 2
@@ -74,12 +78,164 @@ type Pot struct {
 	WinningPlayerNums  []uint
 	WinningHand        []Card
 	WinningScore       int
+	// In hi/lo games, the low half of the pot is awarded separately. If no player has a qualifying low,
+	// LowWinningPlayerNums is empty and the high hand wins the whole pot.
+	LowWinningPlayerNums []uint
+	LowWinningHand       []Card
+	LowWinningScore      int
+	// When the board is run more than once, each pot is split between the boards, and Board is the board this
+	// share of it is played on: 0 for the community cards, or b for the b-th of the extra boards (see GameView.Runs)
+	Board uint
+	// Rake is the amount the house took from the pot before it was awarded (see RakeConfig)
+	Rake uint
 }
 
+// BettingStructure selects the rules that bound the size of bets and raises.
+type BettingStructure uint8
+
+const (
+	// NoLimit allows any bet or raise up to the size of the player's stack.
+	NoLimit BettingStructure = iota
+	// PotLimit allows bets and raises up to the size of the pot (after calling).
+	PotLimit
+	// FixedLimit allows bets and raises only in fixed increments: SmallBet during the
+	// pre-flop and flop rounds, and BigBet during the turn and river rounds.
+	FixedLimit
+)
+
+// Variant selects which poker game is played.
+type Variant uint8
+
+const (
+	// Holdem is Texas Hold'em: each player is dealt 2 hole cards, and may use any combination of
+	// hole cards and community cards to make their hand.
+	Holdem Variant = iota
+	// Omaha deals each player 4 hole cards. Hands must be made from exactly two hole cards and exactly
+	// three community cards.
+	Omaha
+	// FiveCardOmaha is the same as Omaha, except each player is dealt 5 hole cards.
+	FiveCardOmaha
+)
+
+// OddChipRule selects who receives the chips left over when a pot (or half of a hi/lo pot) does not split evenly
+// between its winners.
+type OddChipRule uint8
+
+const (
+	// OddChipLeftOfButton hands out the leftover chips one at a time, starting with the first winner to the left of the button.
+	OddChipLeftOfButton OddChipRule = iota
+	// OddChipHighCard hands out the leftover chips one at a time, starting with the winner holding the highest hole card.
+	// Cards are compared by rank, then by suit: spades, hearts, diamonds, clubs.
+	OddChipHighCard
+	// OddChipCarry leaves the leftover chips in the middle, and adds them to the main pot of the next hand.
+	OddChipCarry
+)
+
+// AnteType selects who posts the ante.
+type AnteType uint8
+
+const (
+	// PlayerAnte has every player dealt in post the ante.
+	PlayerAnte AnteType = iota
+	// BigBlindAnte has the big blind post a single ante on behalf of the whole table, after their blind.
+	BigBlindAnte
+	// ButtonAnte has the button post a single ante on behalf of the whole table.
+	ButtonAnte
+)
+
+// StraddleType selects which player, if any, may post a live straddle (see ToggleStraddle).
+type StraddleType uint8
+
+const (
+	// NoStraddle does not allow straddles.
+	NoStraddle StraddleType = iota
+	// UTGStraddle allows the player under the gun to straddle. Pre-flop action then starts to the left of the straddler.
+	UTGStraddle
+	// MississippiStraddle allows the button to straddle. Pre-flop action then starts with the small blind.
+	MississippiStraddle
+)
+
+// ButtonRule selects how the button and the blinds move from one hand to the next when players come and go.
+type ButtonRule uint8
+
+const (
+	// MovingButton moves the button to the next player who is ready, and the blinds to the two players after it.
+	// When players leave or come back, some players may skip a blind that orbit, or pay one twice.
+	MovingButton ButtonRule = iota
+	// DeadButton moves the big blind to the next player who is ready, so that every player pays each blind exactly
+	// once per orbit. The small blind is then last hand's big blind, and the button is last hand's small blind, even
+	// if they are no longer ready. If the small blind is not ready, no small blind is posted (a dead small blind), and
+	// if the button is not ready, the first player after it deals (a dead button). Heads up, and in the first hand
+	// after it, the button moves as it does with MovingButton.
+	DeadButton
+)
+
+// GameConfig holds the configurable parameters of a game. The zero values of Variant and Limit are
+// Holdem and NoLimit, so configurations that do not set them behave as they always have.
+//
+// If HiLo is set, each pot is split between the best high hand and the best ace-to-five low hand that
+// qualifies under the 8-or-better rule (see eval.EightOrBetter). If there is an odd chip, it goes to the high half.
+//
+// OddChip decides what happens to the chips left over when a pot is split between tied hands. The default is
+// OddChipLeftOfButton.
+//
+// SmallBet, BigBet and RaiseCap only apply when Limit is FixedLimit. If SmallBet is 0, it defaults to
+// BigBlind, and if BigBet is 0 it defaults to twice SmallBet. RaiseCap is the maximum number of bets
+// and raises allowed in a single betting round (pre-flop, the big blind counts as the first bet); 0
+// means there is no cap.
+//
+// If Ante is not 0, an ante of that size is posted every hand, as dead money, by the players AnteType selects.
+//
+// Straddle selects the position allowed to post a live straddle of twice the big blind, which players opt into
+// with ToggleStraddle. Straddles are only posted when at least 3 players are dealt in.
+//
+// If PostMissedBlinds is set, players who are not ready when the blinds pass them must make them up when they
+// are next dealt in: a missed big blind is posted live, and a missed small blind is posted dead. Players who
+// are dealt in as one of the blinds do not owe anything. Players who sit down once the game has started owe a big
+// blind, as if they had missed one. Instead of posting, players may wait for the big blind (see SitIn).
+//
+// Seats is the number of seats at the table, numbered from 0. If it is 0, the table grows as players sit down, up to
+// the most players a deck can be dealt to.
+//
+// MaxRuns is the most times players may agree to run the rest of the board, when the betting is over with players
+// all-in (see RunItTimes). If it is 0 or 1, the board is only ever run once.
+//
+// Button selects how the button and the blinds move from hand to hand. The default is MovingButton.
+//
+// If ActionTime is not 0, each player has that long to act, and once it is up, also the time left in their time bank.
+// Each player's time bank starts at TimeBank when they sit down, and whatever they use of it is gone for good. A
+// player who runs out of time is checked for if they can check, or folded if they cannot (see CheckTimer). If
+// MaxTimeouts is not 0, players who run out of time that many times in a row are marked not ready once the hand
+// is over.
+//
+// Rake sets what the house takes from the game, whether from each pot or as a fee for time at the table (see
+// RakeConfig). The zero value takes nothing, as in home games and tournaments.
+//
+// If CommitReveal is set, a commitment to the order of the deck is published with each hand, and the deck
+// is revealed once the hand is over so that players can verify it was not changed (see ShuffleCommitment).
 type GameConfig struct {
-	MaxBuy     uint
-	BigBlind   uint
-	SmallBlind uint
+	MaxBuy           uint
+	BigBlind         uint
+	SmallBlind       uint
+	Variant          Variant
+	HiLo             bool
+	Limit            BettingStructure
+	SmallBet         uint
+	BigBet           uint
+	RaiseCap         uint
+	CommitReveal     bool
+	OddChip          OddChipRule
+	Ante             uint
+	AnteType         AnteType
+	Straddle         StraddleType
+	PostMissedBlinds bool
+	Seats            uint
+	ActionTime       time.Duration
+	TimeBank         time.Duration
+	MaxTimeouts      uint
+	Button           ButtonRule
+	MaxRuns          uint
+	Rake             RakeConfig
 }
 
 // Game represents a game of poker. It internally keeps track of state, can be mutated by actions,
@@ -100,7 +256,35 @@ type Game struct {
 	deck           Deck
 	pots           []Pot
 	minRaise       uint
+	raiseCount     uint
+	carry          uint
 	calledNum      uint
+	listeners      []Listener
+	handCount      uint
+	history        HandHistory
+	lastHistory    *HandHistory
+	rng            RNG
+	shuffle        ShuffleCommitment
+	lastShuffle    *ShuffleCommitment
+	logging        bool
+	actionLog      []LoggedAction
+	waiting        []uint
+	clock          func() time.Time
+	turnStart      time.Time
+	// actionTime is the time of the call being logged, once it has read the clock (see now)
+	actionTime time.Time
+	lastSBNum      uint
+	lastBBNum      uint
+	lastDealt      uint
+	runs           [][]Card
+
+	// dealHook, if set, is called before each hand is dealt, and the hand is only dealt if it returns nil
+	dealHook func() error
+
+	// handRake is the rake taken from the hand in progress, and totalRake is everything the house has taken from
+	// the game, in rake and time fees
+	handRake  uint
+	totalRake uint
 }
 
 func (g *Game) getStage() GameStage {
@@ -166,6 +350,8 @@ func (g *Game) updateBlindNums() {
 		for !g.players[g.bbNum].Ready {
 			g.bbNum = (g.bbNum + 1) % uint(len(g.players))
 		}
+	} else if g.config.Button == DeadButton && g.lastDealt > 2 && g.deadButtonBlinds() {
+		return
 	} else {
 		g.sbNum = (g.dealerNum + 1) % uint(len(g.players))
 		for !g.players[g.sbNum].Ready {
@@ -184,6 +370,149 @@ func (g *Game) updateBlindNums() {
 	}
 }
 
+// nextReady returns the first player after seat pn who is ready
+func (g *Game) nextReady(pn uint) uint {
+	n := uint(len(g.players))
+
+	pn = (pn + 1) % n
+	for !g.players[pn].Ready {
+		pn = (pn + 1) % n
+	}
+
+	return pn
+}
+
+// deadButtonBlinds assigns the button and blinds by the dead button rule, following on from the last hand, and
+// returns true. If they cannot be assigned that way, because the big blind would land on the button, it returns false.
+func (g *Game) deadButtonBlinds() bool {
+	n := uint(len(g.players))
+	if g.lastSBNum >= n || g.lastBBNum >= n {
+		return false
+	}
+
+	bb := g.nextReady(g.lastBBNum)
+	if bb == g.lastSBNum {
+		return false
+	}
+
+	g.dealerNum = g.lastSBNum
+	g.sbNum = g.lastBBNum
+	g.bbNum = bb
+	g.utgNum = g.nextReady(bb)
+
+	return true
+}
+
+// dealingNum returns the player who deals: the player on the button, or if the button is dead, the first player
+// after it who is ready
+func (g *Game) dealingNum() uint {
+	if g.players[g.dealerNum].Ready || g.readyCount() == 0 {
+		return g.dealerNum
+	}
+
+	return g.nextReady(g.dealerNum)
+}
+
+// holeCardCount returns the number of hole cards dealt to each player in variant v
+func holeCardCount(v Variant) int {
+	switch v {
+	case Omaha:
+		return 4
+	case FiveCardOmaha:
+		return 5
+	default:
+		return 2
+	}
+}
+
+// bestHand returns the best 5 card hand player pn can make with the community cards, and its score (lower is better)
+func (g *Game) bestHand(pn uint) ([]Card, int) {
+	return g.bestHandOn(pn, g.communityCards)
+}
+
+// bestHandOn is the same as bestHand, except with the community cards of board
+func (g *Game) bestHandOn(pn uint, board []Card) ([]Card, int) {
+	p := g.players[pn]
+
+	switch g.config.Variant {
+	case Omaha, FiveCardOmaha:
+		return BestOmahaHand(p.Cards, board)
+	default:
+		return BestFiveOfSeven(
+			p.Cards[0],
+			p.Cards[1],
+			board[0],
+			board[1],
+			board[2],
+			board[3],
+			board[4],
+		)
+	}
+}
+
+// bestLowHand returns the best 5 card ace-to-five low hand player pn can make with the community cards of board,
+// and its score (lower is better). Whether that hand qualifies is up to the caller
+func (g *Game) bestLowHand(pn uint, board []Card) ([]Card, int) {
+	p := g.players[pn]
+
+	switch g.config.Variant {
+	case Omaha, FiveCardOmaha:
+		return BestOmahaLowHand(p.Cards, board)
+	default:
+		return BestLowFiveOfSeven(
+			p.Cards[0],
+			p.Cards[1],
+			board[0],
+			board[1],
+			board[2],
+			board[3],
+			board[4],
+		)
+	}
+}
+
+// streetCards returns a copy of the community cards dealt on street s
+func (g *Game) streetCards(s GameStage) []Card {
+	return boardStreet(g.communityCards, s)
+}
+
+// boardStreet returns a copy of the cards of board dealt on street s
+func boardStreet(board []Card, s GameStage) []Card {
+	switch s {
+	case Flop:
+		return append([]Card{}, board[0:3]...)
+	case Turn:
+		return append([]Card{}, board[3:4]...)
+	case River:
+		return append([]Card{}, board[4:5]...)
+	default:
+		return nil
+	}
+}
+
+// boardSize returns the number of community cards dealt by the end of stage s
+func boardSize(s GameStage) int {
+	switch s {
+	case Flop:
+		return 3
+	case Turn:
+		return 4
+	case River:
+		return 5
+	default:
+		return 0
+	}
+}
+
+// board returns the community cards of board b, when the board is run more than once. Board 0 is the
+// community cards.
+func (g *Game) board(b uint) []Card {
+	if b == 0 {
+		return g.communityCards
+	}
+	return g.runs[b-1]
+}
+
 func (g *Game) toCall() uint {
 	var val uint = 0
 
@@ -196,13 +525,374 @@ func (g *Game) toCall() uint {
 	return val
 }
 
-func (g *Game) getLimit() uint {
-	//TODO: implement limits
-	return uint(math.MaxUint64)
+func (g *Game) potTotal() uint {
+	var total uint = g.carry
+
+	for _, q := range g.players {
+		total += q.TotalBet
+	}
+
+	return total
+}
+
+// fixedBetSize returns the size of a single bet or raise in a fixed-limit game, for the current stage
+func (g *Game) fixedBetSize() uint {
+	smallBet := g.config.SmallBet
+	if smallBet == 0 {
+		smallBet = g.config.BigBlind
+	}
+
+	bigBet := g.config.BigBet
+	if bigBet == 0 {
+		bigBet = 2 * smallBet
+	}
+
+	switch g.getStage() {
+	case Turn, River:
+		return bigBet
+	default:
+		return smallBet
+	}
+}
+
+// minOpen returns the minimum size of the opening bet (and so the initial minimum raise) of a betting round
+func (g *Game) minOpen() uint {
+	if g.config.Limit == FixedLimit {
+		return g.fixedBetSize()
+	}
+	return g.config.BigBlind
+}
+
+// getLimit returns the largest amount player pn may put in with a single bet, per the configured betting
+// structure. It does not take the size of the player's stack into account.
+func (g *Game) getLimit(pn uint) uint {
+	callAmt := g.toCall() - g.players[pn].Bet
+
+	if !g.canRaise(pn) {
+		return callAmt
+	}
+
+	switch g.config.Limit {
+	case PotLimit:
+		// A pot-sized raise is a call, followed by a raise of the size of the pot after calling. A minimum
+		// raise is always allowed, even if the pot is smaller than that (e.g. because a blind was short).
+		potRaise := g.potTotal() + callAmt
+		if potRaise < g.minRaise {
+			potRaise = g.minRaise
+		}
+		return callAmt + potRaise
+	case FixedLimit:
+		return callAmt + g.fixedBetSize()
+	default:
+		return uint(math.MaxUint64)
+	}
+}
+
+// postBlinds posts the antes, blinds, straddle and any missed blinds for a new hand, sets up the pre-flop betting
+// to match, and returns the Events describing what was posted, in the order it was posted
+func (g *Game) postBlinds() []Event {
+	var posted []Event
+
+	post := func(t EventType, pn uint, amt uint, dead bool) {
+		p := &g.players[pn]
+		before := p.Stack
+
+		if dead {
+			p.postDead(amt)
+		} else {
+			p.putInChips(amt)
+		}
+
+		posted = append(posted, Event{Type: t, PlayerNum: pn, Amount: before - p.Stack, AllIn: p.allIn()})
+	}
+
+	if g.config.Ante > 0 {
+		switch g.config.AnteType {
+		case BigBlindAnte:
+			// Posted after the big blind (see below)
+		case ButtonAnte:
+			if g.players[g.dealerNum].In {
+				post(AntePosted, g.dealerNum, g.config.Ante, true)
+			}
+		default:
+			for i, p := range g.players {
+				if p.In {
+					post(AntePosted, uint(i), g.config.Ante, true)
+				}
+			}
+		}
+	}
+
+	// With a dead button, the small blind may be dead
+	if g.players[g.sbNum].In {
+		post(BlindPosted, g.sbNum, g.config.SmallBlind, false)
+	}
+	post(BlindPosted, g.bbNum, g.config.BigBlind, false)
+
+	// The big blind comes before the big blind ante, so a short-stacked big blind covers as much of the blind as
+	// they can, and the ante only takes what is left
+	if g.config.Ante > 0 && g.config.AnteType == BigBlindAnte && g.players[g.bbNum].Stack > 0 {
+		post(AntePosted, g.bbNum, g.config.Ante, true)
+	}
+
+	// The big blind counts as the opening bet
+	g.raiseCount = 1
+	g.minRaise = g.minOpen()
+
+	if pn, ok := g.straddler(); ok {
+		post(StraddlePosted, pn, 2*g.config.BigBlind, false)
+
+		// A straddle is a raise, and the minimum raise after it is the size of the straddle
+		g.raiseCount++
+		if g.config.Limit != FixedLimit && g.players[pn].Bet == 2*g.config.BigBlind {
+			g.minRaise = 2 * g.config.BigBlind
+		}
+
+		// The straddler acts last
+		if g.config.Straddle == MississippiStraddle {
+			pn = g.dealerNum
+		}
+		g.actionNum = (pn + 1) % uint(len(g.players))
+		for !g.players[g.actionNum].In {
+			g.actionNum = (g.actionNum + 1) % uint(len(g.players))
+		}
+	}
+
+	if g.config.PostMissedBlinds {
+		g.markMissedBlinds()
+
+		for i := range g.players {
+			p := &g.players[i]
+
+			if !p.In {
+				continue
+			}
+
+			if uint(i) != g.sbNum && uint(i) != g.bbNum {
+				// A straddle already covers a missed big blind
+				if p.MissedBB && p.Bet == 0 {
+					post(BlindPosted, uint(i), g.config.BigBlind, false)
+				}
+
+				if p.MissedSB {
+					post(DeadBlindPosted, uint(i), g.config.SmallBlind, true)
+				}
+			}
+
+			p.MissedSB = false
+			p.MissedBB = false
+		}
+	}
+
+	return posted
+}
+
+// straddler returns the player who straddles this hand, and true, or false if no one does
+func (g *Game) straddler() (uint, bool) {
+	if g.readyCount() < 3 {
+		return 0, false
+	}
+
+	var pn uint
+	switch g.config.Straddle {
+	case UTGStraddle:
+		pn = g.utgNum
+	case MississippiStraddle:
+		pn = g.dealerNum
+	default:
+		return 0, false
+	}
+
+	p := g.players[pn]
+	return pn, p.Straddle && p.In && p.Stack > 0
+}
+
+// admitWaitingForBB deals in the players waiting for the big blind, if it has reached them this hand. The blinds
+// are assigned as if they were not there, and then each one seated after the small blind and before the big blind is
+// dealt in, if that makes them the big blind instead.
+func (g *Game) admitWaitingForBB() {
+	g.updateBlindNums()
+
+	if g.readyCount() < 2 {
+		return
+	}
+
+	n := uint(len(g.players))
+
+	for pn := (g.sbNum + 1) % n; pn != g.bbNum; pn = (pn + 1) % n {
+		p := &g.players[pn]
+
+		if !p.WaitForBB || p.Stack == 0 {
+			continue
+		}
+
+		p.Ready = true
+		g.updateBlindNums()
+
+		if g.bbNum == pn {
+			p.WaitForBB = false
+			return
+		}
+
+		p.Ready = false
+		g.updateBlindNums()
+	}
+}
+
+// admitShortHanded marks the players waiting for the big blind ready right away, once fewer than two players are
+// ready, since there would be no hand for the big blind to reach them in
+func (g *Game) admitShortHanded() {
+	if g.readyCount() >= 2 {
+		return
+	}
+
+	for i := range g.players {
+		p := &g.players[i]
+
+		if p.WaitForBB && p.Stack > 0 {
+			p.Ready = true
+			p.WaitForBB = false
+		}
+	}
+}
+
+// markMissedBlinds records the blinds missed by the players who are not ready, and so were passed over when the
+// blinds were assigned for this hand
+func (g *Game) markMissedBlinds() {
+	n := uint(len(g.players))
+
+	// Heads up, the button is the small blind
+	passedSB := g.sbNum == g.dealerNum
+
+	for pn := (g.dealerNum + 1) % n; pn != g.bbNum; pn = (pn + 1) % n {
+		if g.players[pn].Ready || !g.players[pn].Seated {
+			passedSB = passedSB || pn == g.sbNum
+			continue
+		}
+
+		// With a dead button, the small blind may have been passed over
+		if pn == g.sbNum {
+			g.players[pn].MissedSB = true
+			passedSB = true
+			continue
+		}
+
+		if passedSB {
+			g.players[pn].MissedBB = true
+		} else {
+			g.players[pn].MissedSB = true
+		}
+	}
+}
+
+// canAct returns nil if player pn may bet or fold right now, or an error describing why they may not
+func (g *Game) canAct(pn uint) error {
+	if !g.getBetting() {
+		return ErrNotBetting
+	}
+
+	if g.actionNum != pn {
+		return ErrNotYourTurn
+	}
+
+	return nil
+}
+
+// canDeal returns nil if player pn may deal right now, or an error describing why they may not
+func (g *Game) canDeal(pn uint) error {
+	if pn != g.dealingNum() {
+		return ErrNotDealer
+	}
+
+	if g.getBetting() {
+		return ErrStillBetting
+	}
+
+	if g.readyCount() < 2 {
+		return ErrNotEnoughPlayers
+	}
+
+	if g.dealHook != nil && g.getStage() == PreDeal {
+		return g.dealHook()
+	}
+
+	return nil
+}
+
+// inHand reports whether player pn is playing in a hand that is not over yet. In stays set after the hand is
+// over, so that the views can show the hands that were shown down, but by then the player is free to go.
+func (g *Game) inHand(pn uint) bool {
+	return g.players[pn].In && g.getStage() != PreDeal
+}
+
+// canSitOut returns nil if player pn may sit out right now, or an error describing why they may not
+func (g *Game) canSitOut(pn uint) error {
+	if g.players[pn].SittingOut {
+		return ErrSittingOut
+	}
+
+	return nil
+}
+
+// canSitIn returns nil if player pn may sit in right now, or an error describing why they may not
+func (g *Game) canSitIn(pn uint) error {
+	p := g.players[pn]
+
+	if !p.SittingOut && p.Ready {
+		return ErrNotSittingOut
+	}
+
+	if p.Stack == 0 {
+		return ErrNoChips
+	}
+
+	return nil
+}
+
+// canToggleReady returns nil if player pn may toggle whether they are ready right now, or an error describing
+// why they may not
+func (g *Game) canToggleReady(pn uint) error {
+	p := g.players[pn]
+
+	if g.inHand(pn) {
+		return ErrPlayerInHand
+	}
+
+	if !p.Ready && p.Eliminated {
+		return ErrEliminated
+	}
+
+	if !p.Ready && p.Stack == 0 {
+		return ErrNoChips
+	}
+
+	return nil
+}
+
+// betError builds a BetError wrapping err, describing the bounds on player pn's bet
+func (g *Game) betError(pn uint, err error) *BetError {
+	callAmt := g.toCall() - g.players[pn].Bet
+
+	return &BetError{
+		Err:      err,
+		Call:     callAmt,
+		MinRaise: callAmt + g.minRaise,
+		MaxBet:   g.getLimit(pn),
+		CanRaise: g.canRaise(pn),
+	}
 }
 
-func (g *Game) canOpen(pn uint) bool {
-	//TODO: placeholder stub, as limits on who can open betting will eventually be implemented
+// canRaise reports whether player pn may open or raise the betting, or only check, call, or fold
+func (g *Game) canRaise(pn uint) bool {
+	// A player who has acted since the last full raise only faces more betting because of an all-in that was too
+	// small to be a full raise, and that does not reopen the raising for them
+	if g.players[pn].Acted {
+		return false
+	}
+
+	if g.config.Limit == FixedLimit && g.config.RaiseCap != 0 {
+		return g.raiseCount < g.config.RaiseCap
+	}
 	return true
 }
 
@@ -216,34 +906,236 @@ func (g *Game) resetForNextHand() {
 			g.players[i].Ready = false
 		}
 
+		if g.config.MaxTimeouts != 0 && g.players[i].Timeouts >= g.config.MaxTimeouts {
+			g.players[i].SittingOut = true
+			g.players[i].Timeouts = 0
+		}
+
+		if g.players[i].SittingOut {
+			g.players[i].Ready = false
+		}
+
 	}
 
-	g.dealerNum = (g.dealerNum + 1) % uint(len(g.players))
-	for !g.players[g.dealerNum].Ready {
+	g.admitShortHanded()
+
+	if g.readyCount() > 0 {
 		g.dealerNum = (g.dealerNum + 1) % uint(len(g.players))
+		for !g.players[g.dealerNum].Ready {
+			g.dealerNum = (g.dealerNum + 1) % uint(len(g.players))
+		}
 	}
 
 	g.setStageAndBetting(PreDeal, false)
+
+	if g.shuffle.Hash != nil {
+		g.lastShuffle = g.shuffle.copy()
+	}
+
+	g.emit(Event{Type: HandEnded})
 }
 
-func (g *Game) updateRoundInfo() {
+// showdown determines the winners of each pot, and awards it to them
+func (g *Game) showdown() {
+	// Any chips carried from the last hand are already in the main pot
+	g.carry = 0
 
-	var allCalled = true
-	var allInPlayerNums = []uint{}
-	var inPlayerNums = []uint{}
+	for i := range g.pots {
+		pot := &g.pots[i]
+		pot.WinningScore = 8000
 
-	for i, p := range g.players {
-		if p.In {
-			inPlayerNums = append(inPlayerNums, uint(i))
-			if p.allIn() {
-				allInPlayerNums = append(allInPlayerNums, uint(i))
-			} else if !g.isCalled(uint(i)) {
-				allCalled = false
+		if pot.Amt == 0 {
+			continue
+		}
+
+		board := g.board(pot.Board)
+
+		for _, num := range pot.EligiblePlayerNums {
+
+			hand, score := g.bestHandOn(num, board)
+			// lower is better for the score
+			if score < pot.WinningScore {
+				pot.WinningScore = score
+				pot.WinningPlayerNums = []uint{num}
+				pot.WinningHand = hand
+			} else if score == pot.WinningScore {
+				pot.WinningPlayerNums = append(pot.WinningPlayerNums, num)
 			}
 		}
+
+		amt := pot.Amt - g.rakePot(uint(i), pot.Amt)
+
+		var lowAmt uint = 0
+
+		if g.config.HiLo {
+			for _, num := range pot.EligiblePlayerNums {
+
+				hand, score := g.bestLowHand(num, board)
+				if score > EightOrBetter {
+					continue
+				}
+
+				if len(pot.LowWinningPlayerNums) == 0 || score < pot.LowWinningScore {
+					pot.LowWinningScore = score
+					pot.LowWinningPlayerNums = []uint{num}
+					pot.LowWinningHand = hand
+				} else if score == pot.LowWinningScore {
+					pot.LowWinningPlayerNums = append(pot.LowWinningPlayerNums, num)
+				}
+			}
+
+			if len(pot.LowWinningPlayerNums) > 0 {
+				lowAmt = amt / 2
+			}
+		}
+
+		g.award(amt-lowAmt, pot.WinningPlayerNums, uint(i), pot.WinningHand, false)
+
+		if lowAmt > 0 {
+			g.award(lowAmt, pot.LowWinningPlayerNums, uint(i), pot.LowWinningHand, true)
+		}
+	}
+}
+
+// runCount returns the number of times the board is run, once the betting is over with players all-in: the fewest
+// times any player still in the hand agreed to (see RunItTimes)
+func (g *Game) runCount() uint {
+	if g.config.MaxRuns < 2 {
+		return 1
+	}
+
+	runs := g.config.MaxRuns
+	for _, p := range g.players {
+		if p.In && p.Runs < runs {
+			runs = p.Runs
+		}
 	}
 
-	// Update the pot information
+	if runs == 0 {
+		return 1
+	}
+
+	return runs
+}
+
+// runBoards deals the rest of the board runs times, each from the same cards already dealt, and splits each pot
+// evenly between the boards. If a pot does not split evenly, the first board's share gets the leftover chips.
+func (g *Game) runBoards(runs uint) {
+	start := g.getStage()
+
+	g.runs = nil
+	for b := uint(0); b < runs; b++ {
+		board := g.communityCards
+		if b > 0 {
+			board = append([]Card{}, g.communityCards...)
+			g.runs = append(g.runs, board)
+		}
+
+		for s := start + 1; s <= River; s++ {
+			switch s {
+			case Flop:
+				board[0], board[1], board[2] = g.deck.Pop(), g.deck.Pop(), g.deck.Pop()
+			case Turn:
+				board[3] = g.deck.Pop()
+			case River:
+				board[4] = g.deck.Pop()
+			}
+
+			g.setStage(s)
+			g.emit(Event{Type: StreetDealt, Board: b, Cards: boardStreet(board, s)})
+		}
+	}
+
+	pots := g.pots
+	g.pots = nil
+	for b := uint(0); b < runs; b++ {
+		for _, pot := range pots {
+			amt := pot.Amt / runs
+			if b == 0 {
+				amt += pot.Amt % runs
+			}
+
+			g.pots = append(g.pots, Pot{
+				TopShare:           pot.TopShare,
+				Amt:                amt,
+				EligiblePlayerNums: append([]uint{}, pot.EligiblePlayerNums...),
+				Board:              b,
+			})
+		}
+	}
+}
+
+// award splits amt evenly between winners, and hands out whatever is left over according to the OddChip rule
+func (g *Game) award(amt uint, winners []uint, potNum uint, hand []Card, low bool) {
+	share := amt / uint(len(winners))
+	rem := amt % uint(len(winners))
+
+	if g.config.OddChip == OddChipCarry {
+		g.carry += rem
+		rem = 0
+	}
+
+	extra := map[uint]uint{}
+	for _, num := range g.oddChipOrder(winners)[:rem] {
+		extra[num] = 1
+	}
+
+	for _, num := range winners {
+		g.players[num].Stack += share + extra[num]
+		g.emit(Event{Type: PotAwarded, PlayerNum: num, Amount: share + extra[num], PotNum: potNum, Board: g.pots[potNum].Board, Cards: append([]Card{}, hand...), Low: low})
+	}
+}
+
+// oddChipOrder returns a copy of winners, sorted in the order they receive leftover chips
+func (g *Game) oddChipOrder(winners []uint) []uint {
+	order := append([]uint{}, winners...)
+
+	switch g.config.OddChip {
+	case OddChipHighCard:
+		sort.SliceStable(order, func(i, j int) bool {
+			return highCardKey(g.players[order[i]].Cards) > highCardKey(g.players[order[j]].Cards)
+		})
+	default:
+		n := uint(len(g.players))
+		sort.Slice(order, func(i, j int) bool {
+			return (order[i]+n-g.dealerNum-1)%n < (order[j]+n-g.dealerNum-1)%n
+		})
+	}
+
+	return order
+}
+
+// highCardKey ranks the highest card in cards, so that a higher key means a higher card. Ties in rank are
+// broken by suit: spades, hearts, diamonds, clubs.
+func highCardKey(cards []Card) int32 {
+	var key int32 = -1
+
+	for _, c := range cards {
+		rank := (int32(c) >> 8) & 0x0F
+
+		// The suit bits are, from high to low, clubs, diamonds, hearts, spades
+		var suit int32
+		for b := int32(c) & 0xF000; b < 0x8000; b <<= 1 {
+			suit++
+		}
+
+		if k := rank*4 + suit; k > key {
+			key = k
+		}
+	}
+
+	return key
+}
+
+// updatePots divides the chips bet this hand into the main pot and side pots
+func (g *Game) updatePots() {
+	var allInPlayerNums = []uint{}
+
+	for i, p := range g.players {
+		if p.allIn() {
+			allInPlayerNums = append(allInPlayerNums, uint(i))
+		}
+	}
 
 	sort.Slice(allInPlayerNums, func(i, j int) bool {
 		return g.players[allInPlayerNums[i]].TotalBet < g.players[allInPlayerNums[j]].TotalBet
@@ -285,17 +1177,69 @@ func (g *Game) updateRoundInfo() {
 		}
 	}
 
+	// If every player still in is all-in, no one is eligible for the final pot. Anything left in it (which
+	// should only be uncalled chips, before they are returned) belongs in the last side pot instead of vanishing.
+	if len(finalPot.EligiblePlayerNums) == 0 && len(g.pots) > 0 {
+		g.pots[len(g.pots)-1].Amt += finalPot.Amt
+		finalPot.Amt = 0
+	}
+
 	g.pots = append(g.pots, finalPot)
 
+	// Chips carried from the last hand go to the main pot
+	g.pots[0].Amt += g.carry
+}
+
+func (g *Game) updateRoundInfo() {
+
+	var allCalled = true
+	var allInPlayerNums = []uint{}
+	var inPlayerNums = []uint{}
+
+	for i, p := range g.players {
+		if p.In {
+			inPlayerNums = append(inPlayerNums, uint(i))
+			if p.allIn() {
+				allInPlayerNums = append(allInPlayerNums, uint(i))
+			} else if !g.isCalled(uint(i)) {
+				allCalled = false
+			}
+		}
+	}
+
+	g.updatePots()
+
 	// If less than two players are still in, the hand has been conceded
 	if len(inPlayerNums) < 2 {
 		//the sole number in the array is the winner by default
 		//TODO: Create a pot here to simplify sending result description
 		// But this is special because cards do not need to be shown
-		for _, p := range g.players {
-			g.players[inPlayerNums[0]].Stack += p.TotalBet
+		winner := inPlayerNums[0]
+
+		// Whatever the winner bet beyond what anyone else put in was never called, so it is returned
+		var won uint = g.carry
+		var called uint = 0
+		for i, p := range g.players {
+			won += p.TotalBet
+			if uint(i) != winner && p.TotalBet > called {
+				called = p.TotalBet
+			}
+		}
+		g.players[winner].Stack += won
+
+		if g.players[winner].TotalBet > called {
+			returned := g.players[winner].TotalBet - called
+			won -= returned
+			g.emit(Event{Type: BetReturned, PlayerNum: winner, Amount: returned})
 		}
 
+		rake := g.rakePot(0, won)
+		g.players[winner].Stack -= rake
+		won -= rake
+
+		g.carry = 0
+		g.emit(Event{Type: PotAwarded, PlayerNum: winner, Amount: won})
+
 		g.resetForNextHand()
 
 		return
@@ -308,74 +1252,113 @@ func (g *Game) updateRoundInfo() {
 			g.actionNum = (g.actionNum + 1) % uint(len(g.players))
 		}
 
+		g.startTurn()
+
 		return
 	}
 
+	// If no more than one player can still bet, the betting is over for the rest of the hand
+	closed := (len(inPlayerNums) - len(allInPlayerNums)) < 2
+
 	//If there are two or more players in, and everybody has either called or is all-in, and at this point we determine that only one player is
 	//in but not all in, we take all the money above and beyond the second highest better (who is all in) and return it to the people who bet it
 	//If the only players in are both all in for the exact same amount of money, nothing happens here
 	//(but we can't skip in the "0 not all in" case because technically before this step happens a player who after this step may read as not all in
 	//could return true for the isAllIn method)
-	if (len(inPlayerNums) - len(allInPlayerNums)) < 2 {
+	if closed {
+		// Folded players' bets count towards calling the top bet, even though they are no longer in
 		var topBettor1 uint = 0
-		var topBettor2 uint = 0
-		// TODO: what if everyone is all in?
-		for _, ndx := range inPlayerNums {
-			if g.players[ndx].TotalBet > g.players[topBettor1].TotalBet {
-				topBettor2 = topBettor1
-				topBettor1 = ndx
-			} else if g.players[ndx].TotalBet > g.players[topBettor2].TotalBet {
-				topBettor2 = ndx
+		var topBet2 uint = 0
+		for i, p := range g.players {
+			if p.TotalBet > g.players[topBettor1].TotalBet {
+				topBet2 = g.players[topBettor1].TotalBet
+				topBettor1 = uint(i)
+			} else if uint(i) != topBettor1 && p.TotalBet > topBet2 {
+				topBet2 = p.TotalBet
 			}
 		}
 
-		g.players[topBettor1].returnChips(g.players[topBettor1].TotalBet - g.players[topBettor2].TotalBet)
+		returned := g.players[topBettor1].TotalBet - topBet2
+		g.players[topBettor1].returnChips(returned)
+
+		if returned > 0 {
+			g.emit(Event{Type: BetReturned, PlayerNum: topBettor1, Amount: returned})
+			g.updatePots()
+		}
+	}
+
+	// Once no one is left to bet, the players may have agreed to run the rest of the board more than once
+	if runs := g.runCount(); runs > 1 && closed && g.getStage() < River {
+		g.runBoards(runs)
+
+		g.showdown()
+
+		g.resetForNextHand()
+
+		return
 	}
 
 	//If there are two or more players in, and everybody has called or is all in, then end the hand f we've just finished river betting
 	if g.getStage() == River {
 
-		for i := range g.pots {
-			g.pots[i].WinningScore = 8000
-
-			for _, num := range g.pots[i].EligiblePlayerNums {
-
-				hand, score := BestFiveOfSeven(
-					g.players[num].Cards[0],
-					g.players[num].Cards[1],
-					g.communityCards[0],
-					g.communityCards[1],
-					g.communityCards[2],
-					g.communityCards[3],
-					g.communityCards[4],
-				)
-				// lower is better for the score
-				if score < g.pots[i].WinningScore {
-					g.pots[i].WinningScore = score
-					g.pots[i].WinningPlayerNums = []uint{num}
-					g.pots[i].WinningHand = hand
-				} else if score == g.pots[i].WinningScore {
-					g.pots[i].WinningPlayerNums = append(g.pots[i].WinningPlayerNums, num)
-				}
-			}
-
-			for _, num := range g.pots[i].WinningPlayerNums {
-				g.players[num].Stack += (g.pots[i].Amt / uint(len(g.pots[i].WinningPlayerNums)))
-				//TODO: leave the remainder in the middle! (fractional money will disappear currently)
-			}
-		}
+		g.showdown()
 
 		g.resetForNextHand()
 
+		// if there is no one left to bet, run out the rest of the board, and show down
+	} else if closed {
+		g.runOut()
+
 		// otherwise, just set betting to false so the dealer can deal the next part of the hand
 	} else {
 		g.setBetting(false)
-		deal(g, g.dealerNum, 0)
+		deal(g, g.dealingNum(), 0)
 	}
 	return
 
 }
 
+// runOut deals the rest of the board, one street at a time, once there is no one left to bet, and shows down
+func (g *Game) runOut() {
+	for g.getStage() < River {
+		g.setBetting(false)
+
+		if err := deal(g, g.dealingNum(), 0); err != nil {
+			break
+		}
+	}
+
+	g.showdown()
+
+	g.resetForNextHand()
+}
+
+// firstToAct returns the first player after the button who is in the hand and can still bet, or if everyone in
+// the hand is all-in, the first player after the button who is in it
+func (g *Game) firstToAct() uint {
+	n := uint(len(g.players))
+	first := n
+
+	for i := uint(1); i <= n; i++ {
+		pn := (g.dealerNum + i) % n
+		p := g.players[pn]
+
+		if !p.In {
+			continue
+		}
+
+		if !p.allIn() {
+			return pn
+		}
+
+		if first == n {
+			first = pn
+		}
+	}
+
+	return first
+}
+
 //Exported functions related to game management (not "Actions")
 
 // NewGame is a factory method that returns a pointer to an initialized game.
@@ -387,6 +1370,8 @@ func (g *Game) updateRoundInfo() {
 // 		BigBlind:	25
 // 		SmallBlind:	10
 // 		MaxBuy:		0
+// 		Variant:	Holdem
+// 		Limit:		NoLimit
 // 	}
 func NewGame() *Game {
 	newGame := Game{}
@@ -403,8 +1388,51 @@ func NewGame() *Game {
 	return &newGame
 }
 
-func (g *Game) AddPlayer() uint {
-	g.players = append(g.players, player{})
-	g.players[len(g.players)-1].initialize()
-	return uint(len(g.players) - 1)
+// SetConfig replaces the configuration of g. Since changing the blinds or betting structure in the middle
+// of a hand would leave it in an inconsistent state, SetConfig returns an error unless g is between hands.
+// SetConfig also returns an error if c has more seats than a deck can be dealt to in its variant, or fewer seats
+// than it would take to keep every player in theirs.
+func (g *Game) SetConfig(c GameConfig) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if g.getStage() != PreDeal {
+		return ErrHandInProgress
+	}
+
+	if c.Seats > maxPlayers(c.Variant) {
+		return ErrTooManySeats
+	}
+
+	if c.Rake.Rate != 0 && c.Rake.TimeFee != 0 {
+		return ErrRakeAndTimeFee
+	}
+
+	seats := c.Seats
+	if seats == 0 {
+		seats = maxPlayers(c.Variant)
+	}
+
+	for i := seats; i < uint(len(g.players)); i++ {
+		if g.players[i].Seated {
+			return ErrSeatTaken
+		}
+	}
+
+	g.config = copyConfig(c)
+	g.resizeSeats()
+
+	g.logAction(LoggedAction{Type: SetConfigAction, Config: c}, nil)
+
+	return nil
+}
+
+// SetRNG sets the source of randomness g uses to shuffle the deck. If it is never called, or r is nil,
+// g shuffles with crypto/rand (see eval.CryptoRNG), which cannot be predicted, or seeded. Since the RNG is only ever used while g is locked, r does not need to be
+// safe for concurrent use, unless it is shared with something else.
+func (g *Game) SetRNG(r RNG) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	g.rng = r
 }
@@ -0,0 +1,512 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	. "github.com/alexclewontin/riverboat/eval"
+)
+
+// HandHistory is a complete record of a single hand, from the deal to the awarding of the pots.
+// Games record a HandHistory for every hand they play (see Game.LastHandHistory).
+//
+// HandHistory has only exported fields, and so can be serialized with encoding/json (see WriteJSON) or any
+// other encoder. WriteText exports it in the text format used by PokerStars, which most hand tracking
+// software can import.
+type HandHistory struct {
+	HandNum   uint
+	StartTime time.Time
+	Config    GameConfig
+	DealerNum uint
+	SBNum     uint
+	BBNum     uint
+	Seats     []HandSeat
+	Actions   []HandAction
+	Board     []Card
+	Pots      []Pot
+	Awards    []HandAward
+	// Showdown is true if the hand was decided by comparing hands, rather than by all but one player folding
+	Showdown bool
+	// Runs are the extra boards, if the board was run more than once. The first board is Board.
+	Runs [][]Card
+	// Rake is the total the house took from the hand's pots (see Pot.Rake)
+	Rake uint
+}
+
+// HandSeat records a player dealt into a hand.
+type HandSeat struct {
+	PlayerNum uint
+	// Stack is the player's stack at the start of the hand, before posting any blinds
+	Stack uint
+	// Cards is nil if the cards are hidden (see HandHistory.PlayerView)
+	Cards []Card
+	// Folded is true if the player folded, and FoldStage is the stage at which they did
+	Folded    bool
+	FoldStage GameStage
+}
+
+// HandAction records a single bet, check, fold, or other action taken during a hand. Type is the type of the
+// Event that the action emitted.
+type HandAction struct {
+	Stage     GameStage
+	PlayerNum uint
+	Type      EventType
+	Amount    uint
+	AllIn     bool
+}
+
+// HandAward records (part of) a pot being awarded to a player.
+type HandAward struct {
+	PlayerNum uint
+	PotNum    uint
+	Amount    uint
+	Hand      []Card
+	Low       bool
+	Board     uint
+}
+
+// record updates the history of the hand in progress with e
+func (g *Game) record(e Event) {
+	h := &g.history
+
+	switch e.Type {
+	case HandStarted:
+		g.handCount++
+		*h = HandHistory{
+			HandNum:   g.handCount,
+			StartTime: g.now(),
+			Config:    copyConfig(g.config),
+			DealerNum: g.dealerNum,
+			SBNum:     g.sbNum,
+			BBNum:     g.bbNum,
+		}
+	case HoleCardsDealt:
+		p := g.players[e.PlayerNum]
+		h.Seats = append(h.Seats, HandSeat{
+			PlayerNum: e.PlayerNum,
+			Stack:     p.Stack + p.TotalBet,
+			Cards:     append([]Card{}, e.Cards...),
+		})
+	case AntePosted, BlindPosted, StraddlePosted, DeadBlindPosted, PlayerChecked, PlayerCalled, PlayerBet, PlayerRaised, PlayerFolded, BetReturned:
+		h.Actions = append(h.Actions, HandAction{
+			Stage:     e.Stage,
+			PlayerNum: e.PlayerNum,
+			Type:      e.Type,
+			Amount:    e.Amount,
+			AllIn:     e.AllIn,
+		})
+
+		if e.Type == PlayerFolded {
+			if s := h.seat(e.PlayerNum); s != nil {
+				s.Folded = true
+				s.FoldStage = e.Stage
+			}
+		}
+	case StreetDealt:
+		if e.Board == 0 {
+			h.Board = append(h.Board, e.Cards...)
+		} else {
+			// Each extra board starts with the cards that were dealt before the board was run more than once
+			if uint(len(h.Runs)) < e.Board {
+				h.Runs = append(h.Runs, append([]Card{}, h.Board[:boardSize(e.Stage-1)]...))
+			}
+			h.Runs[e.Board-1] = append(h.Runs[e.Board-1], e.Cards...)
+		}
+	case PotAwarded:
+		h.Awards = append(h.Awards, HandAward{
+			PlayerNum: e.PlayerNum,
+			PotNum:    e.PotNum,
+			Amount:    e.Amount,
+			Hand:      append([]Card{}, e.Cards...),
+			Low:       e.Low,
+			Board:     e.Board,
+		})
+
+		if e.Cards != nil {
+			h.Showdown = true
+		}
+	case RakeTaken:
+		h.Rake += e.Amount
+	case HandEnded:
+		h.Pots = copyPots(g.pots)
+		g.lastHistory = h.copy()
+	}
+}
+
+// LastHandHistory returns the history of the most recently completed hand, or nil if no hand has been completed.
+// Only the most recent hand is kept, so to keep a record of every hand, call LastHandHistory whenever a
+// HandEnded Event is emitted (from another goroutine; see Subscribe).
+func (g *Game) LastHandHistory() *HandHistory {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if g.lastHistory == nil {
+		return nil
+	}
+
+	return g.lastHistory.copy()
+}
+
+func (h *HandHistory) seat(pn uint) *HandSeat {
+	for i := range h.Seats {
+		if h.Seats[i].PlayerNum == pn {
+			return &h.Seats[i]
+		}
+	}
+	return nil
+}
+
+func (h *HandHistory) copy() *HandHistory {
+	ret := *h
+
+	ret.Config = copyConfig(h.Config)
+	ret.Seats = append([]HandSeat{}, h.Seats...)
+	for i := range ret.Seats {
+		ret.Seats[i].Cards = append([]Card(nil), h.Seats[i].Cards...)
+	}
+
+	ret.Actions = append([]HandAction{}, h.Actions...)
+	ret.Board = append([]Card{}, h.Board...)
+	ret.Runs = copyBoards(h.Runs)
+	ret.Pots = copyPots(h.Pots)
+
+	ret.Awards = append([]HandAward{}, h.Awards...)
+	for i := range ret.Awards {
+		ret.Awards[i].Hand = append([]Card(nil), h.Awards[i].Hand...)
+	}
+
+	return &ret
+}
+
+// PlayerView returns a copy of h holding only the information that the player denoted by pn is entitled to see:
+// their own hole cards, and those of the players who showed down. Everything else is the same.
+func (h *HandHistory) PlayerView(pn uint) *HandHistory {
+	ret := h.copy()
+
+	for i, s := range ret.Seats {
+		if s.PlayerNum != pn && !(h.Showdown && !s.Folded) {
+			ret.Seats[i].Cards = nil
+		}
+	}
+
+	return ret
+}
+
+// WriteJSON writes h to w as JSON.
+func (h *HandHistory) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(h)
+}
+
+// WriteText writes h to w in the text format used by PokerStars. names maps player numbers to the names written
+// in the history; if it is nil, players are named "Player 0", "Player 1", etc. Seats are numbered from 1, so
+// player 0 sits in seat 1.
+//
+// A "Dealt to" line is written for every player whose hole cards are known, so to export a history for a
+// particular player, as a tracker would expect, write the history returned by PlayerView instead.
+func (h *HandHistory) WriteText(w io.Writer, names func(pn uint) string) error {
+	if names == nil {
+		names = func(pn uint) string { return fmt.Sprintf("Player %d", pn) }
+	}
+
+	b := &strings.Builder{}
+
+	fmt.Fprintf(b, "PokerStars Hand #%d: %s (%d/%d) - %s\n",
+		h.HandNum,
+		gameName(h.Config),
+		h.Config.SmallBlind,
+		h.Config.BigBlind,
+		h.StartTime.UTC().Format("2006/01/02 15:04:05 MST"),
+	)
+
+	maxSeat := h.DealerNum
+	for _, s := range h.Seats {
+		if s.PlayerNum > maxSeat {
+			maxSeat = s.PlayerNum
+		}
+	}
+	fmt.Fprintf(b, "Table 'Riverboat' %d-max Seat #%d is the button\n", maxSeat+1, h.DealerNum+1)
+
+	for _, s := range h.Seats {
+		fmt.Fprintf(b, "Seat %d: %s (%d in chips)\n", s.PlayerNum+1, names(s.PlayerNum), s.Stack)
+	}
+
+	stage := PreFlop
+	streetBets := map[uint]uint{}
+	var toCall uint = 0
+
+	// The antes and blinds are always the first actions: antes, then the blinds, then everything else
+	ndx := 0
+	for ; ndx < len(h.Actions) && isPosting(h.Actions[ndx].Type); ndx++ {
+		a := h.Actions[ndx]
+		name := names(a.PlayerNum)
+
+		switch a.Type {
+		case AntePosted:
+			fmt.Fprintf(b, "%s: posts the ante %d%s\n", name, a.Amount, allInStr(a.AllIn))
+			continue
+		case DeadBlindPosted:
+			fmt.Fprintf(b, "%s: posts dead small blind %d%s\n", name, a.Amount, allInStr(a.AllIn))
+			continue
+		case StraddlePosted:
+			fmt.Fprintf(b, "%s: posts straddle %d%s\n", name, a.Amount, allInStr(a.AllIn))
+		default:
+			// With a dead small blind, the first blind posted is the big blind
+			blind := "big"
+			if a.PlayerNum == h.SBNum && h.SBNum != h.BBNum {
+				blind = "small"
+			}
+
+			fmt.Fprintf(b, "%s: posts %s blind %d%s\n", name, blind, a.Amount, allInStr(a.AllIn))
+		}
+
+		streetBets[a.PlayerNum] += a.Amount
+		if streetBets[a.PlayerNum] > toCall {
+			toCall = streetBets[a.PlayerNum]
+		}
+	}
+
+	b.WriteString("*** HOLE CARDS ***\n")
+	for _, s := range h.Seats {
+		if s.Cards != nil {
+			fmt.Fprintf(b, "Dealt to %s [%s]\n", names(s.PlayerNum), cardsStr(s.Cards))
+		}
+	}
+
+	for _, a := range h.Actions[ndx:] {
+		for stage < a.Stage {
+			stage++
+			writeStreet(b, stage, h.Board, "")
+			streetBets = map[uint]uint{}
+			toCall = 0
+		}
+
+		name := names(a.PlayerNum)
+
+		switch a.Type {
+		case PlayerChecked:
+			fmt.Fprintf(b, "%s: checks\n", name)
+		case PlayerCalled:
+			fmt.Fprintf(b, "%s: calls %d%s\n", name, a.Amount, allInStr(a.AllIn))
+		case PlayerBet:
+			fmt.Fprintf(b, "%s: bets %d%s\n", name, a.Amount, allInStr(a.AllIn))
+		case PlayerRaised:
+			to := streetBets[a.PlayerNum] + a.Amount
+			fmt.Fprintf(b, "%s: raises %d to %d%s\n", name, to-toCall, to, allInStr(a.AllIn))
+		case PlayerFolded:
+			fmt.Fprintf(b, "%s: folds\n", name)
+		case BetReturned:
+			fmt.Fprintf(b, "Uncalled bet (%d) returned to %s\n", a.Amount, name)
+		}
+
+		streetBets[a.PlayerNum] += a.Amount
+		if streetBets[a.PlayerNum] > toCall {
+			toCall = streetBets[a.PlayerNum]
+		}
+	}
+
+	// If everyone was all-in, the remaining streets were dealt without any actions, once for each board
+	runFrom := stage
+	for h.Showdown && stage < River {
+		stage++
+		writeStreet(b, stage, h.Board, runName(0, len(h.Runs)))
+	}
+
+	for i, run := range h.Runs {
+		for s := runFrom + 1; s <= River; s++ {
+			writeStreet(b, s, run, runName(i+1, len(h.Runs)))
+		}
+	}
+
+	// When the board was run more than once, each pot was split between the boards, one board after another
+	potsPerBoard := uint(len(h.Pots) / (len(h.Runs) + 1))
+	potIndex := func(potNum uint) uint {
+		if len(h.Runs) == 0 || potsPerBoard == 0 {
+			return potNum
+		}
+		return potNum % potsPerBoard
+	}
+
+	// The first pot is the one every player is eligible for
+	sidePots := 0
+	for _, a := range h.Awards {
+		if potIndex(a.PotNum) > 0 {
+			sidePots++
+		}
+	}
+
+	potName := func(potNum uint) string {
+		potNum = potIndex(potNum)
+		if sidePots == 0 {
+			return "pot"
+		} else if potNum == 0 {
+			return "main pot"
+		}
+		return fmt.Sprintf("side pot-%d", potNum)
+	}
+
+	if h.Showdown {
+		b.WriteString("*** SHOW DOWN ***\n")
+		for _, s := range h.Seats {
+			if !s.Folded {
+				fmt.Fprintf(b, "%s: shows [%s]\n", names(s.PlayerNum), cardsStr(s.Cards))
+			}
+		}
+	}
+
+	var total uint = 0
+	won := map[uint]uint{}
+	for _, a := range h.Awards {
+		fmt.Fprintf(b, "%s collected %d from %s\n", names(a.PlayerNum), a.Amount, potName(a.PotNum))
+		total += a.Amount
+		won[a.PlayerNum] += a.Amount
+	}
+
+	b.WriteString("*** SUMMARY ***\n")
+	fmt.Fprintf(b, "Total pot %d | Rake %d\n", total+h.Rake, h.Rake)
+	if len(h.Board) > 0 {
+		fmt.Fprintf(b, "%sBoard [%s]\n", runName(0, len(h.Runs)), cardsStr(h.Board))
+	}
+
+	for i, run := range h.Runs {
+		fmt.Fprintf(b, "%sBoard [%s]\n", runName(i+1, len(h.Runs)), cardsStr(run))
+	}
+
+	for _, s := range h.Seats {
+		fmt.Fprintf(b, "Seat %d: %s", s.PlayerNum+1, names(s.PlayerNum))
+
+		if s.PlayerNum == h.DealerNum {
+			b.WriteString(" (button)")
+		}
+		if s.PlayerNum == h.SBNum && h.SBNum != h.BBNum {
+			b.WriteString(" (small blind)")
+		} else if s.PlayerNum == h.BBNum {
+			b.WriteString(" (big blind)")
+		}
+
+		if s.Folded {
+			if s.FoldStage == PreFlop {
+				b.WriteString(" folded before Flop\n")
+			} else {
+				fmt.Fprintf(b, " folded on the %s\n", stageName(s.FoldStage))
+			}
+		} else if !h.Showdown {
+			fmt.Fprintf(b, " collected (%d)\n", won[s.PlayerNum])
+		} else if won[s.PlayerNum] > 0 {
+			fmt.Fprintf(b, " showed [%s] and won (%d)\n", cardsStr(s.Cards), won[s.PlayerNum])
+		} else {
+			fmt.Fprintf(b, " showed [%s] and lost\n", cardsStr(s.Cards))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// isPosting reports whether t is the type of a forced bet posted before the cards are dealt
+func isPosting(t EventType) bool {
+	return t == AntePosted || t == BlindPosted || t == StraddlePosted || t == DeadBlindPosted
+}
+
+// writeStreet writes the header of a street. run names the board, e.g. "FIRST ", when it was run more than once.
+func writeStreet(b *strings.Builder, stage GameStage, board []Card, run string) {
+	switch stage {
+	case Flop:
+		fmt.Fprintf(b, "*** %sFLOP *** [%s]\n", run, cardsStr(board[0:3]))
+	case Turn:
+		fmt.Fprintf(b, "*** %sTURN *** [%s] [%s]\n", run, cardsStr(board[0:3]), cardsStr(board[3:4]))
+	case River:
+		fmt.Fprintf(b, "*** %sRIVER *** [%s] [%s]\n", run, cardsStr(board[0:4]), cardsStr(board[4:5]))
+	}
+}
+
+// runName names board b of a hand with runs extra boards the way PokerStars does, e.g. "SECOND ", or returns "" if
+// the board was only run once
+func runName(b int, runs int) string {
+	ordinals := []string{"FIRST", "SECOND", "THIRD", "FOURTH", "FIFTH"}
+
+	if runs == 0 {
+		return ""
+	} else if b < len(ordinals) {
+		return ordinals[b] + " "
+	}
+	return fmt.Sprintf("RUN %d ", b+1)
+}
+
+func gameName(c GameConfig) string {
+	name := "Hold'em"
+	switch c.Variant {
+	case Omaha:
+		name = "Omaha"
+	case FiveCardOmaha:
+		name = "5 Card Omaha"
+	}
+
+	if c.HiLo {
+		name += " Hi/Lo"
+	}
+
+	switch c.Limit {
+	case PotLimit:
+		return name + " Pot Limit"
+	case FixedLimit:
+		return name + " Limit"
+	default:
+		return name + " No Limit"
+	}
+}
+
+func stageName(s GameStage) string {
+	switch s {
+	case Flop:
+		return "Flop"
+	case Turn:
+		return "Turn"
+	case River:
+		return "River"
+	default:
+		return "Pre-flop"
+	}
+}
+
+func allInStr(allIn bool) string {
+	if allIn {
+		return " and is all-in"
+	}
+	return ""
+}
+
+// cardsStr formats cards the way PokerStars does, e.g. "Ah Td 2c"
+func cardsStr(cards []Card) string {
+	strs := make([]string, len(cards))
+	for i, c := range cards {
+		s := c.String()
+		strs[i] = s[:1] + strings.ToLower(s[1:])
+	}
+	return strings.Join(strs, " ")
+}
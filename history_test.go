@@ -0,0 +1,227 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGame_LastHandHistory(t *testing.T) {
+	g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 1000, 1000)
+
+	if g.LastHandHistory() != nil {
+		t.Errorf("Test failed - LastHandHistory must be nil before any hand is played")
+	}
+
+	actions := []struct {
+		action Action
+		pn     uint
+		data   uint
+	}{
+		{Deal, 0, 0},
+		{Bet, 0, 75},
+		{Fold, 1, 0},
+		{Bet, 2, 50},
+		{Bet, 2, 100},
+		{Fold, 0, 0},
+	}
+
+	for _, a := range actions {
+		err := a.action(g, a.pn, a.data)
+
+		if err != nil {
+			t.Fatalf("Test failed - error performing action: %s", err)
+		}
+	}
+
+	h := g.LastHandHistory()
+	if h == nil {
+		t.Fatalf("Test failed - LastHandHistory must not be nil after a hand is played")
+	}
+
+	h.StartTime = time.Date(2020, 7, 12, 23, 47, 11, 0, time.UTC)
+
+	t.Run("Text", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		err := h.WriteText(buf, nil)
+		if err != nil {
+			t.Fatalf("Test failed - error writing text: %s", err)
+		}
+
+		want := strings.Join([]string{
+			"PokerStars Hand #1: Hold'em No Limit (10/25) - 2020/07/12 23:47:11 UTC",
+			"Table 'Riverboat' 3-max Seat #1 is the button",
+			"Seat 1: Player 0 (1000 in chips)",
+			"Seat 2: Player 1 (1000 in chips)",
+			"Seat 3: Player 2 (1000 in chips)",
+			"Player 1: posts small blind 10",
+			"Player 2: posts big blind 25",
+			"*** HOLE CARDS ***",
+			fmt.Sprintf("Dealt to Player 0 [%s]", cardsStr(h.Seats[0].Cards)),
+			fmt.Sprintf("Dealt to Player 1 [%s]", cardsStr(h.Seats[1].Cards)),
+			fmt.Sprintf("Dealt to Player 2 [%s]", cardsStr(h.Seats[2].Cards)),
+			"Player 0: raises 50 to 75",
+			"Player 1: folds",
+			"Player 2: calls 50",
+			fmt.Sprintf("*** FLOP *** [%s]", cardsStr(h.Board)),
+			"Player 2: bets 100",
+			"Player 0: folds",
+			"Uncalled bet (100) returned to Player 2",
+			"Player 2 collected 160 from pot",
+			"*** SUMMARY ***",
+			"Total pot 160 | Rake 0",
+			fmt.Sprintf("Board [%s]", cardsStr(h.Board)),
+			"Seat 1: Player 0 (button) folded on the Flop",
+			"Seat 2: Player 1 (small blind) folded before Flop",
+			"Seat 3: Player 2 (big blind) collected (160)",
+			"",
+		}, "\n")
+
+		if got := buf.String(); got != want {
+			t.Errorf("Test failed - got\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("PlayerView", func(t *testing.T) {
+		view := h.PlayerView(1)
+
+		for _, s := range view.Seats {
+			if (s.PlayerNum == 1) != (s.Cards != nil) {
+				t.Errorf("Test failed - player 1 must only see their own cards, got %v for player %d", s.Cards, s.PlayerNum)
+			}
+		}
+
+		if h.Seats[0].Cards == nil {
+			t.Errorf("Test failed - PlayerView must not modify the original history")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+
+		err := h.WriteJSON(buf)
+		if err != nil {
+			t.Fatalf("Test failed - error writing JSON: %s", err)
+		}
+
+		got := &HandHistory{}
+		err = json.Unmarshal(buf.Bytes(), got)
+		if err != nil {
+			t.Fatalf("Test failed - error reading JSON: %s", err)
+		}
+
+		if !reflect.DeepEqual(got.Actions, h.Actions) || !reflect.DeepEqual(got.Seats, h.Seats) {
+			t.Errorf("Test failed - got %+v\nwant %+v", got, h)
+		}
+	})
+}
+
+func TestHandHistory_WriteTextForcedBets(t *testing.T) {
+	g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Ante: 5, Straddle: UTGStraddle}, 1000, 1000, 1000, 1000)
+
+	err := ToggleStraddle(g, 3, 0)
+	if err != nil {
+		t.Fatalf("Test failed - error toggling straddle: %s", err)
+	}
+
+	for _, a := range []struct {
+		action Action
+		pn     uint
+		data   uint
+	}{{Deal, 0, 0}, {Fold, 0, 0}, {Fold, 1, 0}, {Fold, 2, 0}} {
+		err = a.action(g, a.pn, a.data)
+		if err != nil {
+			t.Fatalf("Test failed - error performing action: %s", err)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	err = g.LastHandHistory().WriteText(buf, nil)
+	if err != nil {
+		t.Fatalf("Test failed - error writing text: %s", err)
+	}
+
+	want := strings.Join([]string{
+		"Player 0: posts the ante 5",
+		"Player 1: posts the ante 5",
+		"Player 2: posts the ante 5",
+		"Player 3: posts the ante 5",
+		"Player 1: posts small blind 10",
+		"Player 2: posts big blind 25",
+		"Player 3: posts straddle 50",
+		"*** HOLE CARDS ***",
+	}, "\n")
+
+	if got := buf.String(); !strings.Contains(got, want) {
+		t.Errorf("Test failed - got\n%s\nwant it to contain\n%s", got, want)
+	}
+}
+
+func TestHandHistory_DeadSmallBlind(t *testing.T) {
+	g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Button: DeadButton}, 1000, 1000, 1000, 1000, 1000)
+
+	start := time.Date(2020, 7, 12, 23, 47, 11, 0, time.UTC)
+	g.SetClock(func() time.Time { return start })
+
+	for hand := 0; hand < 2; hand++ {
+		if err := Deal(g, g.dealingNum(), 0); err != nil {
+			t.Fatalf("Test failed - hand %d: error dealing: %s", hand, err)
+		}
+
+		for g.getBetting() {
+			if err := Fold(g, g.actionNum, 0); err != nil {
+				t.Fatalf("Test failed - hand %d: error folding: %s", hand, err)
+			}
+		}
+
+		// Player 2 was the big blind, so the small blind is dead next hand
+		if hand == 0 {
+			if err := StandUp(g, 2, 0); err != nil {
+				t.Fatalf("Test failed - error standing up: %s", err)
+			}
+		}
+	}
+
+	h := g.LastHandHistory()
+	if !h.StartTime.Equal(start) {
+		t.Errorf("Test failed - the history must be timed by the game's clock, got %s, want %s", h.StartTime, start)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := h.WriteText(buf, nil); err != nil {
+		t.Fatalf("Test failed - error writing text: %s", err)
+	}
+
+	want := "Player 3: posts big blind 25\n*** HOLE CARDS ***"
+	if got := buf.String(); !strings.Contains(got, want) || strings.Contains(got, "small blind 25") {
+		t.Errorf("Test failed - got\n%s\nwant it to contain\n%s", got, want)
+	}
+}
@@ -0,0 +1,602 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// MTTConfig holds the parameters of a multi-table tournament. Tournament is used the same way as by a single-table
+// tournament, except that rebuys and add-ons are not supported, and the Hands of each BlindLevel count the hands
+// dealt at every table combined. TableSize is the number of seats at each table; if it is 0, it is 9.
+type MTTConfig struct {
+	Tournament TournamentConfig
+	TableSize  uint
+}
+
+// MTT coordinates a multi-table tournament. Players register with Register, and once the tournament is started with
+// Start, they are seated at tables (see Seat), and the hands at each table are played on the Game returned by Table
+// with Actions as usual, except that hands must be dealt with the Deal method of the MTT: the Deal Action returns
+// ErrDealtByMTT at the start of each hand.
+//
+// As it deals each hand, the MTT raises the blinds on schedule, eliminates players who have run out of chips, breaks
+// up tables that are no longer needed, and moves players from the table being dealt to balance the tables. Players
+// move with their whole stack, and the player moved is the one who would have been the next big blind, so that no
+// one skips the blinds. They take the empty seat closest before the next big blind of their new table, so that it
+// reaches them as soon as it can. A table is only broken up once there are seats for all of its players at the
+// others. Once one elimination will put the remaining players in the money, play is hand-for-hand:
+// every table waits for the others to finish each hand before dealing the next.
+//
+// MTTs are safe for concurrent use.
+type MTT struct {
+	mtx    sync.Mutex
+	config MTTConfig
+	now    func() time.Time
+
+	started    bool
+	level      int
+	levelHands uint
+	levelStart time.Time
+
+	entrants  uint
+	remaining uint
+	tables    []*mttTable
+	seats     map[uint]mttSeat
+	handHand  bool
+
+	// standings holds the eliminated players, and the winner once there is one, in the order they finished
+	standings []Standing
+}
+
+type mttTable struct {
+	g *Game
+	// entrants maps player numbers at the table to entrant numbers
+	entrants map[uint]uint
+	broken   bool
+	// dealing is set while the MTT deals a hand at the table, which is the only time the game allows one to be dealt
+	dealing bool
+	// hands is the number of hands dealt since play went hand-for-hand
+	hands uint
+}
+
+type mttSeat struct {
+	table int
+	pn    uint
+}
+
+// NewMTT is a factory method that returns a pointer to a multi-table tournament that has not yet started, with no players.
+func NewMTT(c MTTConfig) *MTT {
+	if c.TableSize == 0 {
+		c.TableSize = 9
+	}
+
+	if max := maxPlayers(c.Tournament.Game.Variant); c.TableSize > max {
+		c.TableSize = max
+	}
+
+	return &MTT{
+		config: c,
+		now:    time.Now,
+		seats:  map[uint]mttSeat{},
+	}
+}
+
+// Register registers a player for the tournament, and returns their entrant number, which identifies them for the
+// rest of the tournament. Register returns an error if the tournament has already started.
+func (m *MTT) Register() (uint, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.started {
+		return 0, ErrTournamentStarted
+	}
+
+	m.entrants++
+
+	return m.entrants - 1, nil
+}
+
+// Start starts the tournament: it seats the entrants at as few tables as possible, as evenly as possible, and
+// starts the clock of the first level. Start returns an error if the tournament has already started, fewer than
+// 2 players have registered, the game configuration is not valid (see SetConfig), or the payouts add up to
+// more than 100 percent.
+func (m *MTT) Start() error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.started {
+		return ErrTournamentStarted
+	}
+
+	if m.entrants < 2 {
+		return ErrNotEnoughPlayers
+	}
+
+	if err := checkPayouts(m.config.Tournament.Payouts); err != nil {
+		return err
+	}
+
+	c := m.config.Tournament.Game
+	c.Seats = m.config.TableSize
+
+	var tables []*mttTable
+	numTables := (m.entrants + m.config.TableSize - 1) / m.config.TableSize
+	for i := uint(0); i < numTables; i++ {
+		g := NewGame()
+		if err := g.SetConfig(c); err != nil {
+			return err
+		}
+		t := &mttTable{g: g, entrants: map[uint]uint{}}
+		g.dealHook = func() error {
+			if !t.dealing {
+				return ErrDealtByMTT
+			}
+			return nil
+		}
+		tables = append(tables, t)
+	}
+	m.tables = tables
+
+	for e := uint(0); e < m.entrants; e++ {
+		if err := m.seat(e, int(e%numTables), e/numTables, m.config.Tournament.StartingStack); err != nil {
+			return err
+		}
+	}
+
+	m.started = true
+	m.remaining = m.entrants
+	m.levelStart = m.now()
+
+	return nil
+}
+
+// Seat returns the table entrant e is seated at, and their player number at it. If e has been eliminated, or
+// the tournament has not started, ok is false. Players may be moved between hands, so the seat of each player
+// should be checked after every hand.
+func (m *MTT) Seat(e uint) (table int, pn uint, ok bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	s, ok := m.seats[e]
+	return s.table, s.pn, ok
+}
+
+// NumTables returns the number of tables the tournament has used, including those that have been broken up.
+func (m *MTT) NumTables() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return len(m.tables)
+}
+
+// Table returns the game played at table.
+func (m *MTT) Table(table int) *Game {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.tables[table].g
+}
+
+// Deal deals the next hand at table, after bringing the tournament up to date (see MTT). Deal returns
+// ErrTableBroken if the table has been broken up, and ErrTableWaiting if it must wait for other tables to finish
+// their hands first, as well as any error the Deal Action would return.
+func (m *MTT) Deal(table int) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if !m.started {
+		return ErrTournamentNotStarted
+	}
+
+	t := m.tables[table]
+	if t.broken {
+		return ErrTableBroken
+	}
+
+	if !m.idle(table) {
+		return ErrStillBetting
+	}
+
+	m.update()
+
+	if err := m.rebalance(table); err != nil {
+		return err
+	}
+
+	if m.handHand {
+		for i, o := range m.tables {
+			if i == table || o.broken {
+				continue
+			}
+
+			if o.hands < t.hands || (o.hands == t.hands && !m.idle(i)) {
+				return ErrTableWaiting
+			}
+		}
+	}
+
+	t.g.mtx.Lock()
+	defer t.g.mtx.Unlock()
+
+	if len(m.config.Tournament.Levels) > 0 {
+		l := m.config.Tournament.Levels[m.level]
+		t.g.config.SmallBlind = l.SmallBlind
+		t.g.config.BigBlind = l.BigBlind
+		t.g.config.Ante = l.Ante
+	}
+
+	t.dealing = true
+	err := deal(t.g, t.g.dealingNum(), 0)
+	t.dealing = false
+
+	if err != nil {
+		return err
+	}
+
+	m.levelHands++
+	t.hands++
+
+	return nil
+}
+
+// Level returns the number of the current level, counting from 0, and the level itself. If the schedule is
+// empty, it returns the zero BlindLevel.
+func (m *MTT) Level() (int, BlindLevel) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if len(m.config.Tournament.Levels) == 0 {
+		return 0, BlindLevel{}
+	}
+
+	return m.level, m.config.Tournament.Levels[m.level]
+}
+
+// HandForHand returns true if play is currently hand-for-hand.
+func (m *MTT) HandForHand() bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.update()
+	return m.handHand
+}
+
+// Finished returns true once a winner has been determined.
+func (m *MTT) Finished() bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.update()
+	return m.finished()
+}
+
+// Standings returns the results of every player who has been eliminated, and of the winner once the tournament
+// is finished, in order of place (best first). The PlayerNum of each Standing is an entrant number.
+func (m *MTT) Standings() []Standing {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.update()
+
+	pool := m.entrants * m.config.Tournament.BuyIn
+
+	ret := make([]Standing, len(m.standings))
+	for i, s := range m.standings {
+		s.Prize = prize(m.config.Tournament.Payouts, s.Place, pool)
+		ret[len(ret)-1-i] = s
+	}
+
+	return ret
+}
+
+func (m *MTT) finished() bool {
+	return len(m.standings) > 0 && m.standings[len(m.standings)-1].Place == 1
+}
+
+// idle reports whether table is between hands
+func (m *MTT) idle(table int) bool {
+	g := m.tables[table].g
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	return g.getStage() == PreDeal
+}
+
+// players returns the number of players still seated at table
+func (m *MTT) players(table int) uint {
+	return uint(len(m.tables[table].entrants))
+}
+
+// seat seats entrant e at table in seat pn, with stack chips
+func (m *MTT) seat(e uint, table int, pn uint, stack uint) error {
+	t := m.tables[table]
+
+	t.g.mtx.Lock()
+	defer t.g.mtx.Unlock()
+
+	if err := t.g.sitDown(pn); err != nil {
+		return err
+	}
+
+	if err := buyIn(t.g, pn, stack); err != nil {
+		return err
+	}
+
+	if err := toggleReady(t.g, pn, 0); err != nil {
+		return err
+	}
+
+	t.entrants[pn] = e
+	m.seats[e] = mttSeat{table: table, pn: pn}
+
+	return nil
+}
+
+// seatBeforeBB returns the empty seat at table closest before the player who would be its next big blind, or false
+// if every seat is taken
+func (m *MTT) seatBeforeBB(table int) (uint, bool) {
+	g := m.tables[table].g
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	g.updateBlindNums()
+
+	n := uint(len(g.players))
+	for i := uint(1); i <= n; i++ {
+		pn := (g.bbNum + n - i) % n
+		if !g.players[pn].Seated {
+			return pn, true
+		}
+	}
+
+	return 0, false
+}
+
+// unseat removes player pn from table, and returns the chips they had
+func (m *MTT) unseat(table int, pn uint) uint {
+	t := m.tables[table]
+
+	t.g.mtx.Lock()
+	defer t.g.mtx.Unlock()
+
+	stack := t.g.players[pn].Stack
+	_ = standUp(t.g, pn, 0)
+
+	delete(m.seats, t.entrants[pn])
+	delete(t.entrants, pn)
+
+	return stack
+}
+
+// move moves player pn from table src to table dst, with their stack, and seats them before dst's next big blind.
+// move returns an error, without moving the player, if dst has no empty seat.
+func (m *MTT) move(src int, pn uint, dst int) error {
+	seat, ok := m.seatBeforeBB(dst)
+	if !ok {
+		return ErrTableFull
+	}
+
+	e := m.tables[src].entrants[pn]
+	stack := m.unseat(src, pn)
+
+	return m.seat(e, dst, seat, stack)
+}
+
+// update advances the level if it is time to, and eliminates the players who have run out of chips. While play is
+// hand-for-hand, players are only eliminated once every table has finished its hand, so that those eliminated in
+// the same round of hands are placed by the chips they started the hand with.
+func (m *MTT) update() {
+	if !m.started || m.finished() {
+		return
+	}
+
+	levels := m.config.Tournament.Levels
+	if m.level+1 < len(levels) {
+		l := levels[m.level]
+		now := m.now()
+
+		if (l.Hands > 0 && m.levelHands >= l.Hands) || (l.Duration > 0 && now.Sub(m.levelStart) >= l.Duration) {
+			m.level++
+			m.levelHands = 0
+			m.levelStart = now
+		}
+	}
+
+	idle := map[int]bool{}
+	for i, t := range m.tables {
+		if !t.broken {
+			idle[i] = m.idle(i)
+			if m.handHand && !idle[i] {
+				return
+			}
+		}
+	}
+
+	type bust struct {
+		e          uint
+		startStack uint
+	}
+	var busted []bust
+
+	for i, t := range m.tables {
+		if t.broken || !idle[i] {
+			continue
+		}
+
+		t.g.mtx.Lock()
+		var pns []uint
+		for pn := range t.entrants {
+			if t.g.players[pn].Stack == 0 {
+				b := bust{e: t.entrants[pn]}
+				if h := t.g.lastHistory; h != nil {
+					if s := h.seat(pn); s != nil {
+						b.startStack = s.Stack
+					}
+				}
+				busted = append(busted, b)
+				pns = append(pns, pn)
+			}
+		}
+		t.g.mtx.Unlock()
+
+		for _, pn := range pns {
+			m.unseat(i, pn)
+		}
+	}
+
+	// Of the players eliminated at the same time, the one who started the hand with more chips finishes higher
+	sort.Slice(busted, func(i, j int) bool {
+		if busted[i].startStack != busted[j].startStack {
+			return busted[i].startStack < busted[j].startStack
+		}
+		return busted[i].e < busted[j].e
+	})
+
+	for _, b := range busted {
+		m.standings = append(m.standings, Standing{PlayerNum: b.e, Place: m.remaining})
+		m.remaining--
+	}
+
+	if m.remaining == 1 {
+		for e := range m.seats {
+			m.standings = append(m.standings, Standing{PlayerNum: e, Place: 1})
+		}
+	}
+
+	active := 0
+	for _, t := range m.tables {
+		if !t.broken {
+			active++
+		}
+	}
+
+	handHand := active > 1 && m.remaining == uint(len(m.config.Tournament.Payouts))+1
+	if handHand && !m.handHand {
+		for _, t := range m.tables {
+			t.hands = 0
+		}
+	}
+	m.handHand = handHand
+}
+
+// rebalance breaks up table if there are more tables than needed and it is the smallest, or otherwise moves players
+// from table to the smallest tables until the tables are balanced. Players can only be moved to tables between
+// hands, and a table is only broken up if all of its players can be moved at once. rebalance returns an error if
+// table must not deal.
+func (m *MTT) rebalance(table int) error {
+	var active []int
+	for i, t := range m.tables {
+		if !t.broken {
+			active = append(active, i)
+		}
+	}
+
+	// planned counts the players each table is about to receive
+	planned := map[int]uint{}
+
+	// smallest returns the idle table (other than table) with the fewest players, and room for another
+	smallest := func() (int, bool) {
+		dst, found := 0, false
+		for _, i := range active {
+			if i == table || m.players(i)+planned[i] >= m.config.TableSize || !m.idle(i) {
+				continue
+			}
+			if !found || m.players(i)+planned[i] < m.players(dst)+planned[dst] {
+				dst, found = i, true
+			}
+		}
+		return dst, found
+	}
+
+	needed := (m.remaining + m.config.TableSize - 1) / m.config.TableSize
+
+	if uint(len(active)) > needed {
+		isSmallest := true
+		for _, i := range active {
+			if m.players(i) < m.players(table) {
+				isSmallest = false
+			}
+		}
+
+		if isSmallest {
+			// Every player must have somewhere to go before any of them are moved, or the table would be left
+			// half broken
+			order := m.tableOrder(table)
+			dsts := make([]int, len(order))
+			for k := range order {
+				dst, ok := smallest()
+				if !ok {
+					return ErrTableWaiting
+				}
+				dsts[k] = dst
+				planned[dst]++
+			}
+
+			for k, pn := range order {
+				if err := m.move(table, pn, dsts[k]); err != nil {
+					return err
+				}
+			}
+
+			m.tables[table].broken = true
+			return ErrTableBroken
+		}
+	}
+
+	for {
+		dst, ok := smallest()
+		if !ok || m.players(table) <= m.players(dst)+1 {
+			return nil
+		}
+
+		if err := m.move(table, m.tableOrder(table)[0], dst); err != nil {
+			return err
+		}
+	}
+}
+
+// tableOrder returns the players seated at table, starting with the next big blind
+func (m *MTT) tableOrder(table int) []uint {
+	g := m.tables[table].g
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	g.updateBlindNums()
+
+	n := uint(len(g.players))
+	var ret []uint
+	for i := uint(0); i < n; i++ {
+		pn := (g.bbNum + i) % n
+		if _, ok := m.tables[table].entrants[pn]; ok {
+			ret = append(ret, pn)
+		}
+	}
+
+	return ret
+}
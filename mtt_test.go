@@ -0,0 +1,252 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestMTT(t *testing.T) {
+	m := NewMTT(MTTConfig{
+		Tournament: TournamentConfig{
+			Levels: []BlindLevel{
+				{SmallBlind: 10, BigBlind: 20, Hands: 30},
+				{SmallBlind: 25, BigBlind: 50, Hands: 30},
+				{SmallBlind: 50, BigBlind: 100, Ante: 10, Hands: 30},
+				{SmallBlind: 100, BigBlind: 200, Ante: 25},
+			},
+			StartingStack: 1000,
+			BuyIn:         10,
+			Payouts:       []uint{40, 25, 15, 10, 10},
+		},
+		TableSize: 4,
+	})
+
+	const entrants = 18
+
+	for i := 0; i < entrants; i++ {
+		_, err := m.Register()
+		if err != nil {
+			t.Fatalf("Test failed - error registering: %s", err)
+		}
+	}
+
+	if err := m.Deal(0); !errors.Is(err, ErrTournamentNotStarted) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrTournamentNotStarted)
+	}
+
+	err := m.Start()
+	if err != nil {
+		t.Fatalf("Test failed - error starting: %s", err)
+	}
+
+	if _, err := m.Register(); !errors.Is(err, ErrTournamentStarted) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrTournamentStarted)
+	}
+
+	// 18 players at tables of 4 need 5 tables, of 4, 4, 4, 3, and 3
+	wantSizes := []uint{4, 4, 4, 3, 3}
+	if m.NumTables() != len(wantSizes) {
+		t.Fatalf("Test failed - got %d tables, want %d", m.NumTables(), len(wantSizes))
+	}
+
+	for i, want := range wantSizes {
+		if got := m.players(i); got != want {
+			t.Errorf("Test failed - table %d has %d players, want %d", i, got, want)
+		}
+	}
+
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < m.NumTables(); i++ {
+		m.Table(i).SetRNG(r)
+	}
+
+	sawHandForHand := false
+	sawWaiting := false
+
+	for step := 0; !m.Finished(); step++ {
+		if step > 200000 {
+			t.Fatalf("Test failed - tournament did not finish")
+		}
+
+		table := r.Intn(m.NumTables())
+		g := m.Table(table)
+
+		if g.GenerateOmniView().Stage == PreDeal {
+			err = m.Deal(table)
+
+			if err == nil {
+				// A table that deals has no more than one player more than any table it could have moved a player to
+				for i := 0; i < m.NumTables(); i++ {
+					if !m.tables[i].broken && m.idle(i) && m.players(table) > m.players(i)+1 {
+						t.Errorf("Test failed - table %d dealt with %d players, while table %d has %d", table, m.players(table), i, m.players(i))
+					}
+				}
+			} else if errors.Is(err, ErrTableWaiting) {
+				sawWaiting = sawWaiting || m.HandForHand()
+			} else if err != nil && !errors.Is(err, ErrTableBroken) && !errors.Is(err, ErrNotEnoughPlayers) {
+				t.Fatalf("Test failed - error dealing: %s", err)
+			}
+		} else {
+			pn := g.actionNum
+			legal := g.LegalActions(pn)
+
+			switch choice := r.Intn(10); {
+			case choice < 2 && legal.Fold:
+				err = Fold(g, pn, 0)
+			case choice < 4 && (legal.Bet || legal.Raise):
+				err = Bet(g, pn, legal.MaxBet)
+			default:
+				err = Bet(g, pn, legal.CallAmt)
+			}
+
+			if err != nil {
+				t.Fatalf("Test failed - error acting: %s", err)
+			}
+		}
+
+		sawHandForHand = sawHandForHand || m.HandForHand()
+
+		// Chips only ever move between tables with the players who own them
+		var total uint = 0
+		for i := 0; i < m.NumTables(); i++ {
+			gv := m.Table(i).GenerateOmniView()
+			total += gv.CarriedChips
+			for _, p := range gv.Players {
+				total += p.Stack + p.TotalBet
+			}
+		}
+
+		if total != entrants*1000 {
+			t.Fatalf("Test failed - %d chips in play after step %d, want %d", total, step, entrants*1000)
+		}
+	}
+
+	if !sawHandForHand || !sawWaiting {
+		t.Errorf("Test failed - play must go hand-for-hand on the bubble (went hand-for-hand: %v, waited: %v)", sawHandForHand, sawWaiting)
+	}
+
+	standings := m.Standings()
+	if len(standings) != entrants {
+		t.Fatalf("Test failed - got %d standings, want %d", len(standings), entrants)
+	}
+
+	seen := map[uint]bool{}
+	var paid uint = 0
+	for i, s := range standings {
+		if s.Place != uint(i+1) || seen[s.PlayerNum] {
+			t.Errorf("Test failed - standing %d is %+v", i, s)
+		}
+		seen[s.PlayerNum] = true
+		paid += s.Prize
+	}
+
+	if paid != entrants*10 {
+		t.Errorf("Test failed - paid out %d, want %d", paid, entrants*10)
+	}
+
+	if _, _, ok := m.Seat(standings[len(standings)-1].PlayerNum); ok {
+		t.Errorf("Test failed - eliminated players must not have a seat")
+	}
+}
+
+func TestMTT_BreakTable(t *testing.T) {
+	m := NewMTT(MTTConfig{
+		Tournament: TournamentConfig{
+			Game:          GameConfig{SmallBlind: 5, BigBlind: 10},
+			StartingStack: 1000,
+			Payouts:       []uint{100},
+		},
+		TableSize: 4,
+	})
+
+	for i := 0; i < 10; i++ {
+		if _, err := m.Register(); err != nil {
+			t.Fatalf("Test failed - error registering: %s", err)
+		}
+	}
+
+	if err := m.Start(); err != nil {
+		t.Fatalf("Test failed - error starting: %s", err)
+	}
+
+	// Hands can only be dealt through the MTT, so that it can keep the tables up to date
+	if err := Deal(m.Table(0), m.Table(0).dealingNum(), 0); !errors.Is(err, ErrDealtByMTT) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrDealtByMTT)
+	}
+
+	// Tables of 4, 3 and 3 lose a player each from the first and last, which leaves 8 players, who only need 2 tables
+	m.tables[0].g.players[3].Stack = 0
+	m.tables[2].g.players[2].Stack = 0
+	m.update()
+
+	if err := m.Deal(0); err != nil {
+		t.Fatalf("Test failed - error dealing: %s", err)
+	}
+
+	// Table 1 only has room for one of table 2's players, and table 0 is in a hand, so no one moves yet
+	if err := m.Deal(2); !errors.Is(err, ErrTableWaiting) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrTableWaiting)
+	}
+
+	if m.players(1) != 3 || m.players(2) != 2 {
+		t.Errorf("Test failed - table 2 must not be partly broken up, got tables of %d and %d", m.players(1), m.players(2))
+	}
+
+	g := m.Table(0)
+	for g.GenerateOmniView().Betting {
+		if err := Fold(g, g.GenerateOmniView().ActionNum, 0); err != nil {
+			t.Fatalf("Test failed - error folding: %s", err)
+		}
+	}
+
+	// Each moved player takes the empty seat closest before the big blind of their new table
+	var wantSeats []uint
+	for _, table := range []int{0, 1} {
+		seat, ok := m.seatBeforeBB(table)
+		if !ok {
+			t.Fatalf("Test failed - table %d must have an empty seat", table)
+		}
+		wantSeats = append(wantSeats, seat)
+	}
+
+	moved := []uint{m.tables[2].entrants[0], m.tables[2].entrants[1]}
+
+	if err := m.Deal(2); !errors.Is(err, ErrTableBroken) {
+		t.Fatalf("Test failed - got %v, want %v", err, ErrTableBroken)
+	}
+
+	if m.players(0) != 4 || m.players(1) != 4 || m.players(2) != 0 {
+		t.Errorf("Test failed - got tables of %d, %d and %d, want 4, 4 and 0", m.players(0), m.players(1), m.players(2))
+	}
+
+	for _, e := range moved {
+		table, pn, ok := m.Seat(e)
+		if !ok || table == 2 || pn != wantSeats[table] {
+			t.Errorf("Test failed - entrant %d is at table %d seat %d, want seat %v", e, table, pn, wantSeats)
+		}
+	}
+}
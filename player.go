@@ -24,19 +24,32 @@
 package riverboat
 
 import (
+	"time"
+
 	. "github.com/alexclewontin/riverboat/eval"
 )
 
 type player struct {
+	Seated     bool
 	Ready      bool
 	In         bool
 	Called     bool
+	Acted      bool
 	Left       bool
+	Eliminated bool
+	SittingOut bool
+	WaitForBB  bool
+	Straddle   bool
+	MissedSB   bool
+	MissedBB   bool
+	Timeouts   uint
+	Runs       uint
+	TimeBank   time.Duration
 	TotalBuyIn uint
 	Stack      uint
 	Bet        uint
 	TotalBet   uint
-	Cards      [2]Card
+	Cards      []Card
 }
 
 func (p *player) allIn() bool {
@@ -65,6 +78,17 @@ func (p *player) putInChips(amt uint) {
 	}
 }
 
+//postDead is the same as putInChips, except the chips do not count towards the player's bet (e.g. an ante)
+func (p *player) postDead(amt uint) {
+	if p.Stack > amt {
+		p.TotalBet += amt
+		p.Stack -= amt
+	} else {
+		p.TotalBet += p.Stack
+		p.Stack = 0
+	}
+}
+
 func (p *player) returnChips(amt uint) {
 	if p.TotalBet > amt {
 		p.TotalBet -= amt
@@ -0,0 +1,136 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+// RakeConfig sets what the house takes from a cash game. The zero value takes nothing.
+//
+// Games either rake pots, or charge players for their time at the table, but not both. When pots are raked, the
+// rake is taken from each pot before it is awarded, until the cap for the hand has been reached.
+type RakeConfig struct {
+	// Rate is the share of each pot taken, in hundredths of a percent, so 500 takes 5%. Fractions of a chip are not
+	// taken.
+	Rate uint
+	// Cap is the most taken from a single hand. If it is 0, there is no cap.
+	Cap uint
+	// Caps sets different caps depending on how many players are dealt into the hand. The entry with the most
+	// Players, no more than the number dealt in, applies instead of Cap. If there is no such entry, Cap applies.
+	Caps []RakeCap
+	// NoFlopNoDrop takes nothing from hands that are over before the flop.
+	NoFlopNoDrop bool
+	// TimeFee is taken from each player whenever the host calls CollectTimeFees.
+	TimeFee uint
+}
+
+// RakeCap caps the rake of hands dealt to at least Players players (see RakeConfig.Caps). If Cap is 0, there is no
+// cap.
+type RakeCap struct {
+	Players uint
+	Cap     uint
+}
+
+// rakeCap returns the most rake that may be taken from the hand in progress, or 0 if there is no cap
+func (g *Game) rakeCap() uint {
+	max := g.config.Rake.Cap
+
+	var players uint = 0
+	for _, c := range g.config.Rake.Caps {
+		if c.Players <= g.lastDealt && c.Players >= players {
+			players = c.Players
+			max = c.Cap
+		}
+	}
+
+	return max
+}
+
+// rakePot takes the rake from amt chips won from pot potNum, and returns the amount taken
+func (g *Game) rakePot(potNum uint, amt uint) uint {
+	r := g.config.Rake
+	if r.Rate == 0 || (r.NoFlopNoDrop && g.getStage() < Flop) {
+		return 0
+	}
+
+	rake := amt * r.Rate / 10000
+	if max := g.rakeCap(); max != 0 && g.handRake+rake > max {
+		rake = max - g.handRake
+	}
+
+	if rake == 0 {
+		return 0
+	}
+
+	g.handRake += rake
+	g.totalRake += rake
+
+	if potNum < uint(len(g.pots)) {
+		g.pots[potNum].Rake = rake
+	}
+
+	g.emit(Event{Type: RakeTaken, PotNum: potNum, Amount: rake})
+
+	return rake
+}
+
+// CollectTimeFees takes RakeConfig.TimeFee from every player who is ready to be dealt in, or everything they have
+// left if it is less, and returns the total taken. Players who are seated but not ready, including those sitting
+// out, are not playing, so they are not charged. Players left with no chips are marked not ready. Each call collects a single fee, so the period it pays for (for example, half an hour at the table) is up
+// to how often the host calls it. CollectTimeFees returns an error if a hand is in progress.
+func (g *Game) CollectTimeFees() (uint, error) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if g.getStage() != PreDeal {
+		return 0, ErrHandInProgress
+	}
+
+	var total uint = 0
+	for i := range g.players {
+		p := &g.players[i]
+		if !p.Seated || !p.Ready || p.Stack == 0 {
+			continue
+		}
+
+		fee := g.config.Rake.TimeFee
+		if fee > p.Stack {
+			fee = p.Stack
+		}
+
+		if fee == 0 {
+			continue
+		}
+
+		p.Stack -= fee
+		total += fee
+		g.totalRake += fee
+		g.emit(Event{Type: TimeFeeCollected, PlayerNum: uint(i), Amount: fee})
+
+		if p.Stack == 0 {
+			if err := toggleReady(g, uint(i), 0); err != nil {
+				return total, err
+			}
+		}
+	}
+
+	return total, g.logAction(LoggedAction{Type: TimeFeeAction}, nil)
+}
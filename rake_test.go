@@ -0,0 +1,192 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRake(t *testing.T) {
+	tests := []struct {
+		name     string
+		rake     RakeConfig
+		stacks   []uint
+		fold     bool
+		wantRake uint
+	}{
+		{name: "No rake", stacks: []uint{1000, 1000}},
+		{name: "Percentage", rake: RakeConfig{Rate: 500}, stacks: []uint{1000, 1000}, wantRake: 100},
+		{name: "Capped", rake: RakeConfig{Rate: 500, Cap: 30}, stacks: []uint{1000, 1000}, wantRake: 30},
+		{
+			name:     "Capped by players dealt in",
+			rake:     RakeConfig{Rate: 500, Cap: 30, Caps: []RakeCap{{Players: 2, Cap: 10}, {Players: 3, Cap: 20}}},
+			stacks:   []uint{1000, 1000},
+			wantRake: 10,
+		},
+		{
+			name:     "Capped by more players dealt in",
+			rake:     RakeConfig{Rate: 500, Cap: 30, Caps: []RakeCap{{Players: 2, Cap: 10}, {Players: 3, Cap: 20}}},
+			stacks:   []uint{1000, 1000, 1000},
+			wantRake: 20,
+		},
+		{
+			name:     "Side pots share the cap",
+			rake:     RakeConfig{Rate: 1000, Cap: 150},
+			stacks:   []uint{1000, 500, 1000},
+			wantRake: 150,
+		},
+		// The big blind wins the small blind's 10, with the uncalled 15 returned, so 5% of 20 is 1
+		{name: "Folded before the flop", rake: RakeConfig{Rate: 500}, stacks: []uint{1000, 1000}, fold: true, wantRake: 1},
+		{name: "No flop, no drop", rake: RakeConfig{Rate: 500, NoFlopNoDrop: true}, stacks: []uint{1000, 1000}, fold: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Rake: tt.rake}, tt.stacks...)
+
+			var taken uint = 0
+			g.Subscribe(func(e Event) {
+				if e.Type == RakeTaken {
+					taken += e.Amount
+				}
+			})
+
+			if err := Deal(g, 0, 0); err != nil {
+				t.Fatalf("Test failed - error dealing: %s", err)
+			}
+
+			if tt.fold {
+				if err := Fold(g, g.actionNum, 0); err != nil {
+					t.Fatalf("Test failed - error folding: %s", err)
+				}
+			}
+
+			// Everyone goes all-in
+			for g.getStage() != PreDeal {
+				pn := g.actionNum
+				if err := Bet(g, pn, g.players[pn].Stack); err != nil {
+					t.Fatalf("Test failed - error going all-in: %s", err)
+				}
+			}
+
+			view := g.GenerateOmniView()
+
+			var total, start uint = 0, 0
+			for i, p := range view.Players {
+				total += p.Stack
+				start += tt.stacks[i]
+			}
+
+			if total+tt.wantRake != start {
+				t.Errorf("Test failed - expected %d chips left after a rake of %d, got %d", start-tt.wantRake, tt.wantRake, total)
+			}
+
+			if taken != tt.wantRake || view.HandRake != tt.wantRake || view.TotalRake != tt.wantRake {
+				t.Errorf("Test failed - expected a rake of %d, got %d in events, %d for the hand and %d in total", tt.wantRake, taken, view.HandRake, view.TotalRake)
+			}
+
+			var potRake uint = 0
+			for _, pot := range view.Pots {
+				potRake += pot.Rake
+			}
+
+			if potRake != tt.wantRake {
+				t.Errorf("Test failed - expected the pots to record a rake of %d, got %d", tt.wantRake, potRake)
+			}
+
+			h := g.LastHandHistory()
+			if h.Rake != tt.wantRake {
+				t.Errorf("Test failed - expected the history to record a rake of %d, got %d", tt.wantRake, h.Rake)
+			}
+
+			var text strings.Builder
+			if err := h.WriteText(&text, nil); err != nil {
+				t.Fatalf("Test failed - error writing history: %s", err)
+			}
+
+			if !strings.Contains(text.String(), fmt.Sprintf("| Rake %d\n", tt.wantRake)) {
+				t.Errorf("Test failed - expected a rake of %d in the history:\n%s", tt.wantRake, text.String())
+			}
+		})
+	}
+}
+
+func TestTimeFees(t *testing.T) {
+	g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, Rake: RakeConfig{TimeFee: 30}}, 1000, 20, 1000, 1000)
+
+	if err := g.SetConfig(GameConfig{BigBlind: 25, SmallBlind: 10, Rake: RakeConfig{Rate: 500, TimeFee: 30}}); !errors.Is(err, ErrRakeAndTimeFee) {
+		t.Errorf("Test failed - expected ErrRakeAndTimeFee, got %v", err)
+	}
+
+	if err := SitOut(g, 3, 0); err != nil {
+		t.Fatalf("Test failed - error sitting out: %s", err)
+	}
+
+	// Player 4 sits down and buys in, but is never marked ready
+	pn, err := g.AddPlayer()
+	if err != nil {
+		t.Fatalf("Test failed - error adding player: %s", err)
+	}
+
+	if err := BuyIn(g, pn, 1000); err != nil {
+		t.Fatalf("Test failed - error buying in: %s", err)
+	}
+
+	fees := map[uint]uint{}
+	g.Subscribe(func(e Event) {
+		if e.Type == TimeFeeCollected {
+			fees[e.PlayerNum] += e.Amount
+		}
+	})
+
+	total, err := g.CollectTimeFees()
+	if err != nil {
+		t.Fatalf("Test failed - error collecting time fees: %s", err)
+	}
+
+	// Player 1 cannot pay the whole fee, player 3 is sitting out, and player 4 is not ready
+	if total != 80 || fees[0] != 30 || fees[1] != 20 || fees[2] != 30 || fees[3] != 0 || fees[4] != 0 {
+		t.Errorf("Test failed - expected fees of 30, 20, 30, 0 and 0 (80 in total), got %v (%d in total)", fees, total)
+	}
+
+	view := g.GenerateOmniView()
+	if view.TotalRake != 80 {
+		t.Errorf("Test failed - expected 80 taken in total, got %d", view.TotalRake)
+	}
+
+	if view.Players[1].Ready {
+		t.Errorf("Test failed - expected a player with no chips left not to be ready")
+	}
+
+	if err := Deal(g, g.dealerNum, 0); err != nil {
+		t.Fatalf("Test failed - error dealing: %s", err)
+	}
+
+	if _, err := g.CollectTimeFees(); !errors.Is(err, ErrHandInProgress) {
+		t.Errorf("Test failed - expected ErrHandInProgress, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// ActionType identifies which Action (or game management function) a LoggedAction records.
+type ActionType uint8
+
+// These are the kinds of calls recorded in the action log.
+const (
+	BetAction ActionType = iota + 1
+	BuyInAction
+	DealAction
+	FoldAction
+	LeaveAction
+	ToggleReadyAction
+	AddPlayerAction
+	SetConfigAction
+	ToggleStraddleAction
+	StandUpAction
+	TimeoutAction
+	SitOutAction
+	SitInAction
+	RunItTimesAction
+	TimeFeeAction
+)
+
+// LoggedAction is a single successful call that changed the state of a Game. Config is only used by SetConfigAction,
+// and Data is unused by AddPlayerAction and SetConfigAction. For AddPlayerAction, PlayerNum is the seat the player
+// sat down in, whether by AddPlayer, SitDown or SeatFromWaitingList. Time is the time on the game's clock when the
+// call was made, or the zero Time if the call did not depend on it.
+type LoggedAction struct {
+	Type      ActionType
+	PlayerNum uint
+	Data      uint
+	Config    GameConfig
+	Time      time.Time
+}
+
+// NewSeededGame is the same as NewGame, except the returned game shuffles with a deterministic RNG seeded with seed,
+// and records every successful call that changes its state in its action log (see ActionLog). Together, the seed and
+// the log are enough to reconstruct the game exactly with Replay.
+func NewSeededGame(seed int64) *Game {
+	g := NewGame()
+	g.rng = rand.New(rand.NewSource(seed))
+	g.logging = true
+
+	return g
+}
+
+// ActionLog returns a copy of the calls recorded since g was created by NewSeededGame, in the order they were made.
+// Calls that returned an error are not recorded, since they do not change the state of g.
+// If g was not created by NewSeededGame, ActionLog returns nil.
+func (g *Game) ActionLog() []LoggedAction {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if g.actionLog == nil {
+		return nil
+	}
+
+	return append([]LoggedAction{}, g.actionLog...)
+}
+
+// logAction appends a to the action log, along with the time the call read from the clock, if logging is enabled
+// and err is nil. It returns err unchanged, so that Actions can log and return in a single statement.
+func (g *Game) logAction(a LoggedAction, err error) error {
+	if !g.logging {
+		return err
+	}
+
+	if err == nil {
+		a.Time = g.actionTime
+		g.actionLog = append(g.actionLog, a)
+	}
+
+	g.actionTime = time.Time{}
+
+	return err
+}
+
+// Replay creates a game with NewSeededGame(seed) and performs each call in log on it, in order, with the game's clock
+// set to the Time of the call. If the seed and log came from the same game, the result is in exactly the same state
+// as that game was when the log was taken, time banks and histories included. The returned game then tells the
+// time with time.Now, as if SetClock had never been called.
+// If any call fails, Replay returns the game as it was just before that call, along with the error.
+func Replay(seed int64, log []LoggedAction) (*Game, error) {
+	g := NewSeededGame(seed)
+
+	var now time.Time
+	g.SetClock(func() time.Time { return now })
+	defer g.SetClock(nil)
+
+	actions := map[ActionType]Action{
+		BetAction:            Bet,
+		BuyInAction:          BuyIn,
+		DealAction:           Deal,
+		FoldAction:           Fold,
+		LeaveAction:          Leave,
+		ToggleReadyAction:    ToggleReady,
+		ToggleStraddleAction: ToggleStraddle,
+		StandUpAction:        StandUp,
+		SitOutAction:         SitOut,
+		SitInAction:          SitIn,
+		RunItTimesAction:     RunItTimes,
+	}
+
+	for i, a := range log {
+		var err error
+		now = a.Time
+
+		switch a.Type {
+		case AddPlayerAction:
+			err = g.SitDown(a.PlayerNum)
+		case SetConfigAction:
+			err = g.SetConfig(a.Config)
+		case TimeFeeAction:
+			_, err = g.CollectTimeFees()
+		case TimeoutAction:
+			g.mtx.Lock()
+			err = g.logAction(a, timeout(g, a.PlayerNum, a.Data))
+			g.mtx.Unlock()
+		default:
+			action, ok := actions[a.Type]
+			if !ok {
+				err = fmt.Errorf("unknown action type %d", a.Type)
+				break
+			}
+			err = action(g, a.PlayerNum, a.Data)
+		}
+
+		if err != nil {
+			return g, fmt.Errorf("replaying action %d: %w", i, err)
+		}
+	}
+
+	return g, nil
+}
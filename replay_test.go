@@ -0,0 +1,234 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReplay(t *testing.T) {
+	g := NewSeededGame(42)
+
+	for i := 0; i < 3; i++ {
+		pn, _ := g.AddPlayer()
+
+		err := BuyIn(g, pn, 1000)
+		if err != nil {
+			t.Fatalf("Test failed - Error buying in: %s", err)
+		}
+
+		err = ToggleReady(g, pn, 0)
+		if err != nil {
+			t.Fatalf("Test failed - Error marking ready: %s", err)
+		}
+	}
+
+	actions := []struct {
+		action Action
+		pn     uint
+		data   uint
+	}{
+		{Deal, 0, 0},
+		{Bet, 0, 25},
+		{Bet, 1, 15},
+		{Bet, 2, 0},
+		{Bet, 1, 50},
+		{Bet, 2, 50},
+		{Bet, 0, 50},
+		{Bet, 1, 0},
+		{Bet, 2, 0},
+		{Bet, 0, 0},
+		{Bet, 1, 0},
+		{Bet, 2, 0},
+		{Bet, 0, 0},
+		{Deal, 1, 0},
+		{Bet, 1, 25},
+		{Bet, 2, 15},
+		{Fold, 0, 0},
+	}
+
+	for i, a := range actions {
+		err := a.action(g, a.pn, a.data)
+
+		if err != nil {
+			t.Fatalf("Test failed - error performing action %d: %s", i, err)
+		}
+	}
+
+	// A failed action must not be logged
+	if err := Bet(g, 1, 10000); err == nil {
+		t.Fatalf("Test failed - betting out of turn must return an error")
+	}
+
+	log := g.ActionLog()
+
+	if want := 3*3 + len(actions); len(log) != want {
+		t.Errorf("Test failed - got %d logged actions, want %d", len(log), want)
+	}
+
+	t.Run("Same seed", func(t *testing.T) {
+		replayed, err := Replay(42, log)
+		if err != nil {
+			t.Fatalf("Test failed - error replaying: %s", err)
+		}
+
+		if got, want := replayed.GenerateOmniView(), g.GenerateOmniView(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Test failed - replayed game differs\ngot  %+v\nwant %+v", got, want)
+		}
+	})
+
+	t.Run("Different seed", func(t *testing.T) {
+		replayed, err := Replay(43, log[:10])
+		if err != nil {
+			t.Fatalf("Test failed - error replaying: %s", err)
+		}
+
+		if reflect.DeepEqual(replayed.GenerateOmniView().Deck, g.GenerateOmniView().Deck) {
+			t.Errorf("Test failed - games with different seeds must not shuffle the same way")
+		}
+	})
+
+	t.Run("Illegal action", func(t *testing.T) {
+		bad := append(append([]LoggedAction{}, log[:10]...), LoggedAction{Type: FoldAction, PlayerNum: 2})
+
+		_, err := Replay(42, bad)
+		if !errors.Is(err, ErrNotYourTurn) {
+			t.Errorf("Test failed - got %v, want %v", err, ErrNotYourTurn)
+		}
+	})
+
+	t.Run("Unseeded game", func(t *testing.T) {
+		if NewGame().ActionLog() != nil {
+			t.Errorf("Test failed - a game not created by NewSeededGame must not log actions")
+		}
+	})
+}
+
+func TestReplay_Seats(t *testing.T) {
+	g := NewSeededGame(42)
+
+	calls := []func() error{
+		func() error { return g.SetConfig(GameConfig{BigBlind: 25, SmallBlind: 10, Seats: 6}) },
+		func() error { return g.SitDown(4) },
+		func() error { _, err := g.AddPlayer(); return err },
+		func() error { return BuyIn(g, 4, 500) },
+		func() error { return StandUp(g, 4, 0) },
+		func() error { _, err := g.AddPlayer(); return err },
+	}
+
+	for i, call := range calls {
+		if err := call(); err != nil {
+			t.Fatalf("Test failed - error performing call %d: %s", i, err)
+		}
+	}
+
+	replayed, err := Replay(42, g.ActionLog())
+	if err != nil {
+		t.Fatalf("Test failed - error replaying: %s", err)
+	}
+
+	if got, want := replayed.GenerateOmniView(), g.GenerateOmniView(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Test failed - replayed game differs\ngot  %+v\nwant %+v", got, want)
+	}
+}
+
+func TestReplay_TimeBank(t *testing.T) {
+	g := NewSeededGame(42)
+
+	now := time.Unix(1000, 0)
+	g.SetClock(func() time.Time { return now })
+
+	if err := g.SetConfig(GameConfig{BigBlind: 25, SmallBlind: 10, ActionTime: 30 * time.Second, TimeBank: 60 * time.Second}); err != nil {
+		t.Fatalf("Test failed - error setting config: %s", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		pn, _ := g.AddPlayer()
+
+		if err := BuyIn(g, pn, 1000); err != nil {
+			t.Fatalf("Test failed - error buying in: %s", err)
+		}
+
+		if err := ToggleReady(g, pn, 0); err != nil {
+			t.Fatalf("Test failed - error marking ready: %s", err)
+		}
+	}
+
+	// Player 1 dips into their time bank, player 2 times out with a check, and the next hand is dealt later on
+	steps := []struct {
+		wait time.Duration
+		call func() error
+	}{
+		{5 * time.Second, func() error { return Deal(g, 0, 0) }},
+		{10 * time.Second, func() error { return Bet(g, 0, 25) }},
+		{50 * time.Second, func() error { return Bet(g, 1, 15) }},
+		{95 * time.Second, func() error {
+			if !g.CheckTimer() {
+				return errors.New("player 2 did not time out")
+			}
+			return nil
+		}},
+		{time.Second, func() error { return Fold(g, 1, 0) }},
+		{time.Second, func() error { return Fold(g, 2, 0) }},
+		{time.Minute, func() error { return Deal(g, 1, 0) }},
+		{20 * time.Second, func() error { return Bet(g, 1, 25) }},
+	}
+
+	for i, s := range steps {
+		now = now.Add(s.wait)
+
+		if err := s.call(); err != nil {
+			t.Fatalf("Test failed - error performing step %d: %s", i, err)
+		}
+	}
+
+	// The game's clock has moved on since, which must not change the replay
+	now = now.Add(time.Hour)
+
+	replayed, err := Replay(42, g.ActionLog())
+	if err != nil {
+		t.Fatalf("Test failed - error replaying: %s", err)
+	}
+
+	if got, want := replayed.GenerateOmniView(), g.GenerateOmniView(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Test failed - replayed game differs\ngot  %+v\nwant %+v", got, want)
+	}
+
+	if got := replayed.GenerateOmniView().Players[1].TimeBank; got != 40*time.Second {
+		t.Errorf("Test failed - expected 40s left in player 1's time bank, got %v", got)
+	}
+
+	if got, want := replayed.LastHandHistory().StartTime, g.LastHandHistory().StartTime; !got.Equal(want) {
+		t.Errorf("Test failed - replayed hand started at %v, want %v", got, want)
+	}
+
+	got, _ := replayed.ActionDeadline()
+	want, _ := g.ActionDeadline()
+	if !got.Equal(want) {
+		t.Errorf("Test failed - replayed deadline is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,216 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+// seatCount returns the number of seats at g's table
+func (g *Game) seatCount() uint {
+	if g.config.Seats == 0 {
+		return maxPlayers(g.config.Variant)
+	}
+	return g.config.Seats
+}
+
+// resizeSeats lays out every seat of a table with a fixed number of seats, so that the empty ones show up in
+// views, and removes any seats beyond it, or beyond the most players the variant can be dealt to on a table that
+// grows as players sit down. The seats removed must be empty.
+func (g *Game) resizeSeats() {
+	if g.config.Seats == 0 {
+		if n := maxPlayers(g.config.Variant); uint(len(g.players)) > n {
+			g.players = g.players[:n]
+		}
+	} else {
+		for uint(len(g.players)) < g.config.Seats {
+			g.players = append(g.players, player{})
+		}
+
+		g.players = g.players[:g.config.Seats]
+	}
+
+	if g.dealerNum >= uint(len(g.players)) {
+		g.dealerNum = 0
+	}
+}
+
+// emptySeat returns the lowest-numbered empty seat, or false if every seat is taken
+func (g *Game) emptySeat() (uint, bool) {
+	for i := uint(0); i < g.seatCount(); i++ {
+		if i >= uint(len(g.players)) || !g.players[i].Seated {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// AddPlayer seats a new player in the lowest-numbered empty seat, and returns their player number, which is the
+// same as their seat number. AddPlayer returns an error if every seat is taken.
+func (g *Game) AddPlayer() (uint, error) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	return g.addPlayer()
+}
+
+func (g *Game) addPlayer() (uint, error) {
+	seat, ok := g.emptySeat()
+	if !ok {
+		return 0, ErrTableFull
+	}
+
+	return seat, g.sitDown(seat)
+}
+
+// SitDown seats a new player in seat, which becomes their player number. Seats are numbered from 0, in order
+// clockwise around the table. SitDown returns an error if the seat is taken, or the table does not have it.
+func (g *Game) SitDown(seat uint) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	return g.sitDown(seat)
+}
+
+func (g *Game) sitDown(seat uint) error {
+	if seat >= g.seatCount() {
+		return ErrNoSuchSeat
+	}
+
+	for uint(len(g.players)) <= seat {
+		g.players = append(g.players, player{})
+	}
+
+	p := g.getPlayer(seat)
+
+	if p.Seated {
+		return ErrSeatTaken
+	}
+
+	p.initialize()
+	p.Seated = true
+	p.TimeBank = g.config.TimeBank
+
+	// Joining a game that has already started is the same as having missed the big blind
+	if g.config.PostMissedBlinds && g.handCount > 0 {
+		p.MissedBB = true
+	}
+
+	g.emit(Event{Type: PlayerSatDown, PlayerNum: seat})
+
+	return g.logAction(LoggedAction{Type: AddPlayerAction, PlayerNum: seat}, nil)
+}
+
+// StandUp removes a player from the table, freeing their seat for someone else. The player leaves with their
+// stack, which is the Amount of the PlayerStoodUp event emitted. Their player number is no longer valid until a new
+// player sits down in the seat. StandUp returns an error if the player is in the current hand.
+// StandUp ignores the value passed in as data.
+func StandUp(g *Game, pn uint, data uint) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.logAction(LoggedAction{Type: StandUpAction, PlayerNum: pn, Data: data}, standUp(g, pn, data))
+}
+
+func standUp(g *Game, pn uint, data uint) error {
+	p := g.getPlayer(pn)
+
+	if !p.Seated {
+		return ErrNotSeated
+	}
+
+	if g.inHand(pn) {
+		return ErrPlayerInHand
+	}
+
+	if p.Ready {
+		if err := toggleReady(g, pn, data); err != nil {
+			return err
+		}
+	}
+
+	stack := p.Stack
+	p.initialize()
+
+	g.emit(Event{Type: PlayerStoodUp, PlayerNum: pn, Amount: stack})
+
+	return nil
+}
+
+// JoinWaitingList adds id to the end of g's waiting list. Players on the waiting list do not have a player number
+// yet, so id is whatever the caller uses to identify them (e.g. a user id). JoinWaitingList returns an error if id
+// is already on the waiting list.
+func (g *Game) JoinWaitingList(id uint) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	for _, w := range g.waiting {
+		if w == id {
+			return ErrAlreadyWaiting
+		}
+	}
+
+	g.waiting = append(g.waiting, id)
+
+	return nil
+}
+
+// LeaveWaitingList removes id from g's waiting list. If id is not on it, LeaveWaitingList does nothing.
+func (g *Game) LeaveWaitingList(id uint) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	for i, w := range g.waiting {
+		if w == id {
+			g.waiting = append(g.waiting[:i], g.waiting[i+1:]...)
+			return
+		}
+	}
+}
+
+// WaitingList returns the ids on g's waiting list, first in line first.
+func (g *Game) WaitingList() []uint {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	return append([]uint(nil), g.waiting...)
+}
+
+// SeatFromWaitingList seats the first player on the waiting list in the lowest-numbered empty seat, and removes
+// them from the waiting list. It returns their id, and their new player number. SeatFromWaitingList returns an
+// error if the waiting list is empty, or every seat is taken.
+func (g *Game) SeatFromWaitingList() (id uint, pn uint, err error) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if len(g.waiting) == 0 {
+		return 0, 0, ErrWaitingListEmpty
+	}
+
+	pn, err = g.addPlayer()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	id = g.waiting[0]
+	g.waiting = g.waiting[1:]
+
+	return id, pn, nil
+}
@@ -0,0 +1,249 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSeats(t *testing.T) {
+	g := NewGame()
+
+	err := g.SetConfig(GameConfig{BigBlind: 25, SmallBlind: 10, Seats: 6})
+	if err != nil {
+		t.Fatalf("Test failed - Error setting config: %s", err)
+	}
+
+	if n := len(g.GenerateOmniView().Players); n != 6 {
+		t.Errorf("Test failed - expected 6 seats in the view, got %d", n)
+	}
+
+	if err := g.SitDown(3); err != nil {
+		t.Fatalf("Test failed - Error sitting down: %s", err)
+	}
+
+	if err := g.SitDown(3); !errors.Is(err, ErrSeatTaken) {
+		t.Errorf("Test failed - expected ErrSeatTaken, got %v", err)
+	}
+
+	if err := g.SitDown(6); !errors.Is(err, ErrNoSuchSeat) {
+		t.Errorf("Test failed - expected ErrNoSuchSeat, got %v", err)
+	}
+
+	// AddPlayer fills the empty seats in order, skipping the taken one
+	for _, want := range []uint{0, 1, 2, 4, 5} {
+		pn, err := g.AddPlayer()
+		if err != nil {
+			t.Fatalf("Test failed - Error adding player: %s", err)
+		}
+
+		if pn != want {
+			t.Errorf("Test failed - expected seat %d, got %d", want, pn)
+		}
+	}
+
+	if _, err := g.AddPlayer(); !errors.Is(err, ErrTableFull) {
+		t.Errorf("Test failed - expected ErrTableFull, got %v", err)
+	}
+
+	if err := g.SetConfig(GameConfig{Seats: 5}); !errors.Is(err, ErrSeatTaken) {
+		t.Errorf("Test failed - expected ErrSeatTaken when removing a taken seat, got %v", err)
+	}
+
+	if err := g.SetConfig(GameConfig{Seats: maxPlayers(Holdem) + 1}); !errors.Is(err, ErrTooManySeats) {
+		t.Errorf("Test failed - expected ErrTooManySeats, got %v", err)
+	}
+
+	for pn := uint(0); pn < 3; pn++ {
+		if err := BuyIn(g, pn, 1000); err != nil {
+			t.Fatalf("Test failed - Error buying in: %s", err)
+		}
+		if err := ToggleReady(g, pn, 0); err != nil {
+			t.Fatalf("Test failed - Error marking ready: %s", err)
+		}
+	}
+
+	var stoodUp []Event
+	g.Subscribe(func(e Event) {
+		if e.Type == PlayerStoodUp {
+			stoodUp = append(stoodUp, e)
+		}
+	})
+
+	if err := Deal(g, 0, 0); err != nil {
+		t.Fatalf("Test failed - Error dealing: %s", err)
+	}
+
+	if err := StandUp(g, 1, 0); !errors.Is(err, ErrPlayerInHand) {
+		t.Errorf("Test failed - expected ErrPlayerInHand, got %v", err)
+	}
+
+	// Players who are not in the hand may come and go
+	if err := StandUp(g, 4, 0); err != nil {
+		t.Errorf("Test failed - Error standing up: %s", err)
+	}
+
+	if err := StandUp(g, 4, 0); !errors.Is(err, ErrNotSeated) {
+		t.Errorf("Test failed - expected ErrNotSeated, got %v", err)
+	}
+
+	for _, pn := range []uint{0, 1} {
+		if err := Fold(g, pn, 0); err != nil {
+			t.Fatalf("Test failed - Error folding: %s", err)
+		}
+	}
+
+	// The button moves on to the next player who is still seated
+	if err := StandUp(g, 1, 0); err != nil {
+		t.Fatalf("Test failed - Error standing up: %s", err)
+	}
+
+	if len(stoodUp) != 2 || stoodUp[1].PlayerNum != 1 || stoodUp[1].Amount != 990 {
+		t.Errorf("Test failed - expected player 1 to stand up with 990, got %+v", stoodUp)
+	}
+
+	view := g.GenerateOmniView()
+	if view.Players[1].Seated || view.Players[1].Stack != 0 {
+		t.Errorf("Test failed - expected seat 1 to be empty, got %+v", view.Players[1])
+	}
+
+	if view.DealerNum != 2 {
+		t.Errorf("Test failed - expected the button to move to seat 2, got %d", view.DealerNum)
+	}
+
+	if err := Deal(g, 2, 0); err != nil {
+		t.Errorf("Test failed - Error dealing: %s", err)
+	}
+}
+
+func TestSeatLimits(t *testing.T) {
+	tables := []struct {
+		variant Variant
+		max     uint
+	}{
+		{Holdem, 23},
+		{Omaha, 11},
+		{FiveCardOmaha, 9},
+	}
+
+	for _, table := range tables {
+		g := NewGame()
+
+		if err := g.SetConfig(GameConfig{Variant: table.variant, Seats: table.max + 1}); !errors.Is(err, ErrTooManySeats) {
+			t.Errorf("Test failed - expected ErrTooManySeats for %d seats, got %v", table.max+1, err)
+		}
+
+		if err := g.SetConfig(GameConfig{Variant: table.variant, Seats: table.max}); err != nil {
+			t.Errorf("Test failed - Error setting config: %s", err)
+		}
+
+		if err := g.SetConfig(GameConfig{Variant: table.variant}); err != nil {
+			t.Fatalf("Test failed - Error setting config: %s", err)
+		}
+
+		for i := uint(0); i < table.max; i++ {
+			if _, err := g.AddPlayer(); err != nil {
+				t.Fatalf("Test failed - Error adding player: %s", err)
+			}
+		}
+
+		if _, err := g.AddPlayer(); !errors.Is(err, ErrTableFull) {
+			t.Errorf("Test failed - expected ErrTableFull after %d players, got %v", table.max, err)
+		}
+
+		if err := g.SitDown(table.max); !errors.Is(err, ErrNoSuchSeat) {
+			t.Errorf("Test failed - expected ErrNoSuchSeat, got %v", err)
+		}
+	}
+
+	// A table that has grown past what Omaha can be dealt to cannot switch to it
+	g := NewGame()
+	for i := 0; i < 12; i++ {
+		if _, err := g.AddPlayer(); err != nil {
+			t.Fatalf("Test failed - Error adding player: %s", err)
+		}
+	}
+
+	if err := g.SetConfig(GameConfig{Variant: Omaha}); !errors.Is(err, ErrSeatTaken) {
+		t.Errorf("Test failed - expected ErrSeatTaken, got %v", err)
+	}
+}
+
+func TestWaitingList(t *testing.T) {
+	g := NewGame()
+
+	err := g.SetConfig(GameConfig{BigBlind: 25, SmallBlind: 10, Seats: 2})
+	if err != nil {
+		t.Fatalf("Test failed - Error setting config: %s", err)
+	}
+
+	if _, _, err := g.SeatFromWaitingList(); !errors.Is(err, ErrWaitingListEmpty) {
+		t.Errorf("Test failed - expected ErrWaitingListEmpty, got %v", err)
+	}
+
+	for _, id := range []uint{100, 200, 300} {
+		if err := g.JoinWaitingList(id); err != nil {
+			t.Fatalf("Test failed - Error joining the waiting list: %s", err)
+		}
+	}
+
+	if err := g.JoinWaitingList(200); !errors.Is(err, ErrAlreadyWaiting) {
+		t.Errorf("Test failed - expected ErrAlreadyWaiting, got %v", err)
+	}
+
+	g.LeaveWaitingList(200)
+
+	for _, want := range []uint{100, 300} {
+		id, pn, err := g.SeatFromWaitingList()
+		if err != nil {
+			t.Fatalf("Test failed - Error seating from the waiting list: %s", err)
+		}
+
+		if id != want {
+			t.Errorf("Test failed - expected %d to be seated, got %d in seat %d", want, id, pn)
+		}
+	}
+
+	if err := g.JoinWaitingList(400); err != nil {
+		t.Fatalf("Test failed - Error joining the waiting list: %s", err)
+	}
+
+	if _, _, err := g.SeatFromWaitingList(); !errors.Is(err, ErrTableFull) {
+		t.Errorf("Test failed - expected ErrTableFull, got %v", err)
+	}
+
+	if err := StandUp(g, 0, 0); err != nil {
+		t.Fatalf("Test failed - Error standing up: %s", err)
+	}
+
+	id, pn, err := g.SeatFromWaitingList()
+	if err != nil || id != 400 || pn != 0 {
+		t.Errorf("Test failed - expected 400 to be seated in seat 0, got %d in seat %d (%v)", id, pn, err)
+	}
+
+	if w := g.WaitingList(); len(w) != 0 {
+		t.Errorf("Test failed - expected the waiting list to be empty, got %v", w)
+	}
+}
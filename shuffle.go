@@ -0,0 +1,130 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	. "github.com/alexclewontin/riverboat/eval"
+)
+
+// ShuffleCommitment allows players to verify that the deck used in a hand was fixed before the hand began.
+// Hash is the SHA-256 digest of Salt followed by the string form of each card in Deck (e.g. "2C", "TD"), in the order
+// they are stored. Cards are dealt from the end of Deck, so the last card is the first one dealt.
+//
+// When GameConfig.CommitReveal is set, Hash is published in every GameView as soon as the hand is dealt, while Salt and
+// Deck are only included in the omniscient view. Once the hand is over, the whole commitment is available from
+// LastShuffleCommitment, and anyone can check it with Verify.
+type ShuffleCommitment struct {
+	Hash []byte
+	Salt []byte
+	Deck Deck
+}
+
+const saltLen = 32
+
+// Verify returns true if Hash is the correct digest of Salt and Deck, and Deck holds each of the 52 cards exactly once.
+func (sc *ShuffleCommitment) Verify() bool {
+	if len(sc.Deck) != len(DefaultDeck) {
+		return false
+	}
+
+	seen := make(map[Card]bool, len(DefaultDeck))
+	for _, c := range sc.Deck {
+		seen[c] = true
+	}
+
+	for _, c := range DefaultDeck {
+		if !seen[c] {
+			return false
+		}
+	}
+
+	return bytes.Equal(sc.Hash, shuffleHash(sc.Salt, sc.Deck))
+}
+
+func shuffleHash(salt []byte, deck Deck) []byte {
+	h := sha256.New()
+	h.Write(salt)
+	for _, c := range deck {
+		h.Write([]byte(c.String()))
+	}
+
+	return h.Sum(nil)
+}
+
+func (sc *ShuffleCommitment) copy() *ShuffleCommitment {
+	return &ShuffleCommitment{
+		Hash: append([]byte(nil), sc.Hash...),
+		Salt: append([]byte(nil), sc.Salt...),
+		Deck: append(Deck(nil), sc.Deck...),
+	}
+}
+
+// shuffleRNG returns the RNG g shuffles with: the one set with SetRNG if there is one, or crypto/rand otherwise.
+func (g *Game) shuffleRNG() RNG {
+	if g.rng != nil {
+		return g.rng
+	}
+
+	return CryptoRNG{}
+}
+
+// shuffleDeck shuffles the deck for a new hand, and if the config calls for it, commits to the result.
+// The salt is drawn from the same RNG as the shuffle, so that a seeded game is reproducible.
+func (g *Game) shuffleDeck() {
+	r := g.shuffleRNG()
+
+	g.deck.ShuffleWith(r)
+	g.shuffle = ShuffleCommitment{}
+
+	if !g.config.CommitReveal {
+		return
+	}
+
+	salt := make([]byte, saltLen)
+	for i := range salt {
+		salt[i] = byte(r.Intn(256))
+	}
+
+	g.shuffle = ShuffleCommitment{
+		Hash: shuffleHash(salt, g.deck),
+		Salt: salt,
+		Deck: append(Deck(nil), g.deck...),
+	}
+}
+
+// LastShuffleCommitment returns a copy of the shuffle commitment of the last hand that was completed, including the
+// revealed salt and deck. If no hand played with GameConfig.CommitReveal set has been completed yet, it returns nil.
+func (g *Game) LastShuffleCommitment() *ShuffleCommitment {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if g.lastShuffle == nil {
+		return nil
+	}
+
+	return g.lastShuffle.copy()
+}
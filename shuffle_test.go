@@ -0,0 +1,98 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestShuffleCommitment(t *testing.T) {
+	t.Run("Commit and reveal", func(t *testing.T) {
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10, CommitReveal: true}, 1000, 1000, 1000)
+
+		err := Deal(g, 0, 0)
+		if err != nil {
+			t.Fatalf("Test failed - error dealing: %s", err)
+		}
+
+		pv := g.GeneratePlayerView(1)
+		if len(pv.Shuffle.Hash) == 0 || pv.Shuffle.Salt != nil || pv.Shuffle.Deck != nil {
+			t.Errorf("Test failed - players must see only the hash before the hand is over, got %+v", pv.Shuffle)
+		}
+
+		ov := g.GenerateOmniView()
+		if !ov.Shuffle.Verify() {
+			t.Errorf("Test failed - commitment does not verify: %+v", ov.Shuffle)
+		}
+
+		// The cards not yet dealt are the bottom of the committed deck
+		if !reflect.DeepEqual(ov.Deck, ov.Shuffle.Deck[:len(ov.Deck)]) {
+			t.Errorf("Test failed - committed deck %v is not the deck being dealt from %v", ov.Shuffle.Deck, ov.Deck)
+		}
+
+		if g.LastShuffleCommitment() != nil {
+			t.Errorf("Test failed - the commitment must not be revealed before the hand is over")
+		}
+
+		for _, pn := range []uint{0, 1} {
+			err = Fold(g, pn, 0)
+			if err != nil {
+				t.Fatalf("Test failed - error folding: %s", err)
+			}
+		}
+
+		sc := g.LastShuffleCommitment()
+		if sc == nil {
+			t.Fatalf("Test failed - the commitment must be revealed once the hand is over")
+		}
+
+		if !bytes.Equal(sc.Hash, pv.Shuffle.Hash) || !sc.Verify() {
+			t.Errorf("Test failed - revealed commitment %+v does not match published hash %x", sc, pv.Shuffle.Hash)
+		}
+
+		sc.Deck[0], sc.Deck[1] = sc.Deck[1], sc.Deck[0]
+		if sc.Verify() {
+			t.Errorf("Test failed - a tampered deck must not verify")
+		}
+
+		if !g.LastShuffleCommitment().Verify() {
+			t.Errorf("Test failed - LastShuffleCommitment must return a copy")
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 1000)
+
+		err := Deal(g, 0, 0)
+		if err != nil {
+			t.Fatalf("Test failed - error dealing: %s", err)
+		}
+
+		if sc := g.GenerateOmniView().Shuffle; sc.Hash != nil || sc.Salt != nil || sc.Deck != nil {
+			t.Errorf("Test failed - got commitment %+v without CommitReveal", sc)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"time"
+)
+
+// SetClock sets the function g uses to tell the time, for the time limits set by GameConfig.ActionTime and the start
+// times of hand histories. If it is never called, or now is nil, g uses time.Now. A clock that only moves when it is
+// told to (e.g. in tests) makes timeouts, time banks and histories reproducible.
+func (g *Game) SetClock(now func() time.Time) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	g.clock = now
+}
+
+// now returns the time on g's clock. While g is logging, every read made by the same call returns the same time,
+// which is logged with the call (see logAction), so that Replay can reproduce it.
+func (g *Game) now() time.Time {
+	if !g.logging {
+		return g.readClock()
+	}
+
+	if g.actionTime.IsZero() {
+		g.actionTime = g.readClock()
+	}
+
+	return g.actionTime
+}
+
+func (g *Game) readClock() time.Time {
+	if g.clock == nil {
+		return time.Now()
+	}
+	return g.clock()
+}
+
+// startTurn starts the clock of the player to act, if g has a time limit
+func (g *Game) startTurn() {
+	if g.config.ActionTime != 0 {
+		g.turnStart = g.now()
+	}
+}
+
+// endTurn stops the clock of player pn, who has just acted, and takes the time they used beyond the time limit
+// out of their time bank
+func (g *Game) endTurn(pn uint) {
+	p := g.getPlayer(pn)
+	p.Timeouts = 0
+
+	if g.config.ActionTime == 0 {
+		return
+	}
+
+	if over := g.now().Sub(g.turnStart) - g.config.ActionTime; over > 0 {
+		if over > p.TimeBank {
+			over = p.TimeBank
+		}
+		p.TimeBank -= over
+	}
+}
+
+// deadline returns the time by which the player to act must act, or false if there is no one to act or no time limit
+func (g *Game) deadline() (time.Time, bool) {
+	if g.config.ActionTime == 0 || !g.getBetting() {
+		return time.Time{}, false
+	}
+
+	return g.turnStart.Add(g.config.ActionTime + g.players[g.actionNum].TimeBank), true
+}
+
+// ActionDeadline returns the time by which the player to act must act, including the time left in their time bank.
+// If no one is to act, or g has no time limit, ok is false.
+func (g *Game) ActionDeadline() (deadline time.Time, ok bool) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	return g.deadline()
+}
+
+// CheckTimer enforces the time limit on the player to act. If they have run out of time, CheckTimer checks for them
+// if they can check, or folds their hand if they cannot, and returns true. Otherwise, it returns false.
+//
+// Nothing advances a hand on its own, so hosts of games with a time limit must call CheckTimer periodically (or
+// at the ActionDeadline). Until it is called, a player who is out of time may still act, but it uses up all of
+// their time bank.
+func (g *Game) CheckTimer() bool {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	// The check itself is not logged, so it reads the clock directly
+	deadline, ok := g.deadline()
+	if !ok || g.readClock().Before(deadline) {
+		return false
+	}
+
+	pn := g.actionNum
+
+	return g.logAction(LoggedAction{Type: TimeoutAction, PlayerNum: pn}, timeout(g, pn, 0)) == nil
+}
+
+func timeout(g *Game, pn uint, data uint) error {
+	if err := g.canAct(pn); err != nil {
+		return err
+	}
+
+	p := g.getPlayer(pn)
+	p.TimeBank = 0
+	p.Timeouts++
+
+	g.emit(Event{Type: PlayerTimedOut, PlayerNum: pn})
+
+	if g.toCall() == p.Bet {
+		p.Called = true
+		p.Acted = true
+		g.emit(Event{Type: PlayerChecked, PlayerNum: pn})
+	} else {
+		p.In = false
+		g.emit(Event{Type: PlayerFolded, PlayerNum: pn})
+	}
+
+	g.updateRoundInfo()
+
+	return nil
+}
@@ -0,0 +1,137 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"testing"
+	"time"
+)
+
+func TestActionTimer(t *testing.T) {
+	config := GameConfig{
+		BigBlind:    25,
+		SmallBlind:  10,
+		ActionTime:  30 * time.Second,
+		TimeBank:    60 * time.Second,
+		MaxTimeouts: 2,
+	}
+
+	g := setupReadyGame(t, config, 1000, 1000, 1000)
+
+	now := time.Unix(0, 0)
+	g.SetClock(func() time.Time { return now })
+
+	timedOut := []uint{}
+	g.Subscribe(func(e Event) {
+		if e.Type == PlayerTimedOut {
+			timedOut = append(timedOut, e.PlayerNum)
+		}
+	})
+
+	if _, ok := g.ActionDeadline(); ok {
+		t.Errorf("Test failed - there must be no deadline before the hand is dealt")
+	}
+
+	if err := Deal(g, 0, 0); err != nil {
+		t.Fatalf("Test failed - error dealing: %s", err)
+	}
+
+	if deadline, ok := g.ActionDeadline(); !ok || !deadline.Equal(now.Add(90*time.Second)) {
+		t.Errorf("Test failed - expected a deadline 90s from now, got %v (%v)", deadline, ok)
+	}
+
+	steps := []struct {
+		name    string
+		wait    time.Duration
+		pn      uint
+		bet     int
+		expired bool
+		bank    time.Duration
+	}{
+		{name: "Calling in time", wait: 20 * time.Second, pn: 0, bet: 25, bank: 60 * time.Second},
+		{name: "Calling using the time bank", wait: 50 * time.Second, pn: 1, bet: 15, bank: 40 * time.Second},
+		{name: "Still in the time bank", wait: 89 * time.Second, pn: 2, bet: -1, bank: 60 * time.Second},
+		{name: "Timing out with a check", wait: 2 * time.Second, pn: 2, bet: -1, expired: true},
+		{name: "Timing out on the flop", wait: 100 * time.Second, pn: 1, bet: -1, expired: true},
+		{name: "Betting the flop", wait: 0, pn: 2, bet: 50},
+		{name: "Timing out with a fold", wait: 90 * time.Second, pn: 0, bet: -1, expired: true},
+		{name: "Timing out twice in a row", wait: 30 * time.Second, pn: 1, bet: -1, expired: true},
+	}
+
+	for _, s := range steps {
+		now = now.Add(s.wait)
+
+		if s.bet >= 0 {
+			if err := Bet(g, s.pn, uint(s.bet)); err != nil {
+				t.Fatalf("Test failed - %s: error betting: %s", s.name, err)
+			}
+		} else if got := g.CheckTimer(); got != s.expired {
+			t.Fatalf("Test failed - %s: expected CheckTimer to return %v", s.name, s.expired)
+		}
+
+		if got := g.GenerateOmniView().Players[s.pn].TimeBank; got != s.bank {
+			t.Errorf("Test failed - %s: expected %v left in the time bank, got %v", s.name, s.bank, got)
+		}
+	}
+
+	view := g.GenerateOmniView()
+
+	if len(timedOut) != 4 || timedOut[0] != 2 || timedOut[1] != 1 || timedOut[2] != 0 || timedOut[3] != 1 {
+		t.Errorf("Test failed - expected players 2, 1, 0, 1 to time out, got %v", timedOut)
+	}
+
+	if view.Stage != PreDeal || view.Players[2].Stack != 1050 {
+		t.Errorf("Test failed - expected player 2 to win the hand, got %+v", view)
+	}
+
+	// Player 1 timed out twice in a row, so they are sat out. Player 0 only timed out once.
+	if view.Players[1].Ready || !view.Players[0].Ready {
+		t.Errorf("Test failed - expected only player 1 to be sat out, got %+v", view.Players)
+	}
+
+	if _, ok := g.ActionDeadline(); ok {
+		t.Errorf("Test failed - there must be no deadline between hands")
+	}
+}
+
+func TestActionTimer_NoLimit(t *testing.T) {
+	g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 1000)
+
+	now := time.Unix(0, 0)
+	g.SetClock(func() time.Time { return now })
+
+	if err := Deal(g, 0, 0); err != nil {
+		t.Fatalf("Test failed - error dealing: %s", err)
+	}
+
+	now = now.Add(24 * time.Hour)
+
+	if g.CheckTimer() {
+		t.Errorf("Test failed - a game with no time limit must never time out")
+	}
+
+	if _, ok := g.ActionDeadline(); ok {
+		t.Errorf("Test failed - a game with no time limit must have no deadline")
+	}
+}
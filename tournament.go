@@ -0,0 +1,460 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"sort"
+	"time"
+)
+
+// BlindLevel is one level of a tournament's blind schedule. A level lasts until Hands hands have been played at
+// it, or Duration has passed since it started, whichever comes first; 0 means there is no limit of that kind.
+// A level only ever changes between hands, and the last level lasts until the end of the tournament.
+type BlindLevel struct {
+	SmallBlind uint
+	BigBlind   uint
+	Ante       uint
+	Hands      uint
+	Duration   time.Duration
+}
+
+// TournamentConfig holds the parameters of a tournament.
+//
+// Game is the configuration of the underlying game, except that its blinds and ante are replaced by those of
+// the current level of Levels. If Levels is empty, the blinds never change.
+//
+// Each entrant starts with StartingStack chips, and adds BuyIn to the prize pool. Payouts is the percentage of
+// the prize pool paid to each place, starting with first; whatever is left over from rounding goes to first.
+//
+// Rebuys and add-ons are allowed during the first RebuyLevels levels. A rebuy of RebuyStack chips, for RebuyCost,
+// may be taken any number of times by a player with no more than StartingStack chips, and an add-on of AddOnStack
+// chips, for AddOnCost, may be taken once by any player. Either is disabled if its stack is 0.
+type TournamentConfig struct {
+	Game          GameConfig
+	Levels        []BlindLevel
+	StartingStack uint
+	BuyIn         uint
+	Payouts       []uint
+	RebuyLevels   uint
+	RebuyStack    uint
+	RebuyCost     uint
+	AddOnStack    uint
+	AddOnCost     uint
+}
+
+// Standing is the result of a single player in a tournament.
+type Standing struct {
+	PlayerNum uint
+	Place     uint
+	Prize     uint
+}
+
+// Tournament runs a single-table tournament (e.g. a sit-and-go) on top of a Game. Players register with
+// Register, and once the tournament is started with Start, the hands are played on the Game returned by Game
+// with Actions as usual. No hand can be dealt before then. Between hands, the Tournament raises the blinds on
+// schedule and eliminates players who run out of chips, or Leave. Eliminated players cannot buy back in.
+//
+// Players should rebuy and add on with Rebuy and AddOn, rather than BuyIn, so that the prize pool is kept up to date.
+//
+// Tournaments are safe for concurrent use, under the same lock as their Game.
+type Tournament struct {
+	g      *Game
+	config TournamentConfig
+	now    func() time.Time
+
+	started    bool
+	level      int
+	levelHands uint
+	levelStart time.Time
+
+	entrants   uint
+	rebuys     uint
+	addOns     uint
+	addOnTaken map[uint]bool
+
+	// standings holds the eliminated players, and the winner once there is one, in the order they finished
+	standings []Standing
+}
+
+// NewTournament is a factory method that returns a pointer to a tournament that has not yet started, with no players.
+// NewTournament returns an error if c.Game is not a valid configuration (see SetConfig), or c.Payouts add up to
+// more than 100 percent.
+func NewTournament(c TournamentConfig) (*Tournament, error) {
+	t := &Tournament{
+		g:          NewGame(),
+		config:     c,
+		now:        time.Now,
+		addOnTaken: map[uint]bool{},
+	}
+
+	if err := checkPayouts(c.Payouts); err != nil {
+		return nil, err
+	}
+
+	if err := t.g.SetConfig(c.Game); err != nil {
+		return nil, err
+	}
+	t.applyLevel()
+
+	t.g.dealHook = func() error {
+		if !t.started {
+			return ErrTournamentNotStarted
+		}
+		return nil
+	}
+
+	t.g.Subscribe(func(e Event) {
+		switch e.Type {
+		case HandEnded:
+			t.afterHand()
+		case PlayerLeft:
+			t.update()
+		}
+	})
+
+	return t, nil
+}
+
+// Game returns the game the tournament is played on.
+func (t *Tournament) Game() *Game {
+	return t.g
+}
+
+// Register adds a player to the tournament with the starting stack, marks them as ready, and returns their player
+// number. Register returns an error if the tournament has already started.
+func (t *Tournament) Register() (uint, error) {
+	t.g.mtx.Lock()
+	defer t.g.mtx.Unlock()
+
+	if t.started {
+		return 0, ErrTournamentStarted
+	}
+
+	pn, err := t.g.addPlayer()
+	if err != nil {
+		return pn, err
+	}
+
+	err = buyIn(t.g, pn, t.config.StartingStack)
+	if err != nil {
+		return pn, err
+	}
+
+	err = toggleReady(t.g, pn, 0)
+	if err != nil {
+		return pn, err
+	}
+
+	t.entrants++
+
+	return pn, nil
+}
+
+// Start starts the tournament, and the clock of the first level. Start returns an error if the tournament has
+// already started, or fewer than 2 players have registered.
+func (t *Tournament) Start() error {
+	t.g.mtx.Lock()
+	defer t.g.mtx.Unlock()
+
+	if t.started {
+		return ErrTournamentStarted
+	}
+
+	if t.entrants < 2 {
+		return ErrNotEnoughPlayers
+	}
+
+	t.started = true
+	t.levelStart = t.now()
+	t.update()
+
+	return nil
+}
+
+// Rebuy adds RebuyStack chips to player pn's stack, and marks them as ready if they are not. Rebuy returns an
+// error if the rebuy period is over, the player has more than the starting stack, or the player is in a hand.
+func (t *Tournament) Rebuy(pn uint) error {
+	t.g.mtx.Lock()
+	defer t.g.mtx.Unlock()
+
+	if err := t.canRebuy(pn, t.config.RebuyStack); err != nil {
+		return err
+	}
+
+	if t.g.players[pn].Stack > t.config.StartingStack {
+		return ErrRebuyTooBig
+	}
+
+	if err := t.buyIn(pn, t.config.RebuyStack); err != nil {
+		return err
+	}
+
+	t.rebuys++
+
+	return nil
+}
+
+// AddOn adds AddOnStack chips to player pn's stack, and marks them as ready if they are not. AddOn returns an
+// error if the rebuy period is over, the player has already taken an add-on, or the player is in a hand.
+func (t *Tournament) AddOn(pn uint) error {
+	t.g.mtx.Lock()
+	defer t.g.mtx.Unlock()
+
+	if err := t.canRebuy(pn, t.config.AddOnStack); err != nil {
+		return err
+	}
+
+	if t.addOnTaken[pn] {
+		return ErrAddOnTaken
+	}
+
+	if err := t.buyIn(pn, t.config.AddOnStack); err != nil {
+		return err
+	}
+
+	t.addOns++
+	t.addOnTaken[pn] = true
+
+	return nil
+}
+
+// Level returns the number of the current level, counting from 0, and the level itself. If the schedule is
+// empty, it returns the blinds and ante of the game.
+func (t *Tournament) Level() (int, BlindLevel) {
+	t.g.mtx.Lock()
+	defer t.g.mtx.Unlock()
+
+	if len(t.config.Levels) == 0 {
+		return 0, BlindLevel{SmallBlind: t.g.config.SmallBlind, BigBlind: t.g.config.BigBlind, Ante: t.g.config.Ante}
+	}
+
+	return t.level, t.config.Levels[t.level]
+}
+
+// PrizePool returns the total of all buy-ins, rebuys and add-ons.
+func (t *Tournament) PrizePool() uint {
+	t.g.mtx.Lock()
+	defer t.g.mtx.Unlock()
+
+	return t.prizePool()
+}
+
+// Finished returns true once a winner has been determined.
+func (t *Tournament) Finished() bool {
+	t.g.mtx.Lock()
+	defer t.g.mtx.Unlock()
+
+	return t.finished()
+}
+
+// Standings returns the results of every player who has been eliminated, and of the winner once the tournament
+// is finished, in order of place (best first).
+func (t *Tournament) Standings() []Standing {
+	t.g.mtx.Lock()
+	defer t.g.mtx.Unlock()
+
+	pool := t.prizePool()
+
+	ret := make([]Standing, len(t.standings))
+	for i, s := range t.standings {
+		s.Prize = prize(t.config.Payouts, s.Place, pool)
+		ret[len(ret)-1-i] = s
+	}
+
+	return ret
+}
+
+func (t *Tournament) prizePool() uint {
+	return t.entrants*t.config.BuyIn + t.rebuys*t.config.RebuyCost + t.addOns*t.config.AddOnCost
+}
+
+// prize returns the prize for place, out of pool, paid according to payouts (see TournamentConfig)
+func prize(payouts []uint, place uint, pool uint) uint {
+	if place == 0 || place > uint(len(payouts)) {
+		return 0
+	}
+
+	ret := pool * payouts[place-1] / 100
+
+	if place == 1 {
+		var paid uint = 0
+		for _, pct := range payouts {
+			paid += pool * pct / 100
+		}
+
+		if paid < pool {
+			ret += pool - paid
+		}
+	}
+
+	return ret
+}
+
+// checkPayouts returns ErrPayoutsTooHigh if payouts add up to more than the whole prize pool
+func checkPayouts(payouts []uint) error {
+	var total uint = 0
+	for _, pct := range payouts {
+		total += pct
+	}
+
+	if total > 100 {
+		return ErrPayoutsTooHigh
+	}
+
+	return nil
+}
+
+func (t *Tournament) finished() bool {
+	return len(t.standings) > 0 && t.standings[len(t.standings)-1].Place == 1
+}
+
+func (t *Tournament) rebuyOpen() bool {
+	return t.started && !t.finished() && uint(t.level) < t.config.RebuyLevels
+}
+
+// canRebuy returns nil if player pn may buy stack more chips right now, or an error describing why they may not
+func (t *Tournament) canRebuy(pn uint, stack uint) error {
+	if !t.rebuyOpen() || stack == 0 {
+		return ErrRebuyClosed
+	}
+
+	if t.g.players[pn].Eliminated {
+		return ErrEliminated
+	}
+
+	return nil
+}
+
+// buyIn adds stack chips to player pn's stack, and marks them ready if they are not
+func (t *Tournament) buyIn(pn uint, stack uint) error {
+	if err := buyIn(t.g, pn, stack); err != nil {
+		return err
+	}
+
+	if !t.g.players[pn].Ready {
+		return toggleReady(t.g, pn, 0)
+	}
+
+	return nil
+}
+
+// applyLevel sets the blinds and ante of the game to those of the current level
+func (t *Tournament) applyLevel() {
+	if len(t.config.Levels) == 0 {
+		return
+	}
+
+	l := t.config.Levels[t.level]
+
+	t.g.config.SmallBlind = l.SmallBlind
+	t.g.config.BigBlind = l.BigBlind
+	t.g.config.Ante = l.Ante
+}
+
+// update eliminates the players who have left since the last hand, if the tournament is between hands. Players
+// who leave during a hand are eliminated by afterHand once it is over.
+func (t *Tournament) update() {
+	if t.started && !t.finished() && t.g.getStage() == PreDeal {
+		t.eliminate()
+	}
+}
+
+// afterHand is called (with g locked) whenever a hand ends
+func (t *Tournament) afterHand() {
+	if !t.started || t.finished() {
+		return
+	}
+
+	t.levelHands++
+
+	if t.level+1 < len(t.config.Levels) {
+		l := t.config.Levels[t.level]
+		now := t.now()
+
+		if (l.Hands > 0 && t.levelHands >= l.Hands) || (l.Duration > 0 && now.Sub(t.levelStart) >= l.Duration) {
+			t.level++
+			t.levelHands = 0
+			t.levelStart = now
+			t.applyLevel()
+		}
+	}
+
+	t.eliminate()
+}
+
+// eliminate removes the players who have run out of chips, or left, from the tournament, and records their places.
+// While rebuys are open, players who run out of chips may rebuy instead, unless too few players have chips to continue.
+func (t *Tournament) eliminate() {
+	var remaining uint = 0
+	var withChips uint = 0
+	var left []uint
+	var busted []uint
+
+	for i, p := range t.g.players {
+		if !p.Seated || p.Eliminated {
+			continue
+		}
+
+		remaining++
+
+		if p.Left {
+			left = append(left, uint(i))
+		} else if p.Stack > 0 {
+			withChips++
+		} else {
+			busted = append(busted, uint(i))
+		}
+	}
+
+	if t.rebuyOpen() && withChips >= 2 {
+		busted = nil
+	}
+
+	// Of the players eliminated in the same hand, the one who started it with more chips finishes higher
+	startStack := func(pn uint) uint {
+		if s := t.g.history.seat(pn); s != nil {
+			return s.Stack
+		}
+		return 0
+	}
+
+	sort.SliceStable(busted, func(i, j int) bool {
+		return startStack(busted[i]) < startStack(busted[j])
+	})
+
+	// Players who left finish behind everyone still playing, whatever chips they left with
+	for _, pn := range append(left, busted...) {
+		t.g.players[pn].Eliminated = true
+		t.standings = append(t.standings, Standing{PlayerNum: pn, Place: remaining})
+		remaining--
+	}
+
+	if remaining == 1 {
+		for i, p := range t.g.players {
+			if p.Seated && !p.Eliminated {
+				t.standings = append(t.standings, Standing{PlayerNum: uint(i), Place: 1})
+			}
+		}
+	}
+}
@@ -0,0 +1,355 @@
+//* Copyright (c) 2020, Alex Lewontin
+//* All rights reserved.
+//*
+//* Redistribution and use in source and binary forms, with or without
+//* modification, are permitted provided that the following conditions are met:
+//*
+//* - Redistributions of source code must retain the above copyright notice, this
+//* list of conditions and the following disclaimer.
+//* - Redistributions in binary form must reproduce the above copyright notice,
+//* this list of conditions and the following disclaimer in the documentation
+//* and/or other materials provided with the distribution.
+//*
+//* THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+//* ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+//* WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//* DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+//* FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+//* DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+//* SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+//* CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+//* OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+//* OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package riverboat
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/alexclewontin/riverboat/eval"
+)
+
+func setupTournament(t *testing.T, c TournamentConfig, players int) *Tournament {
+	t.Helper()
+
+	tr, err := NewTournament(c)
+	if err != nil {
+		t.Fatalf("Test failed - error creating tournament: %s", err)
+	}
+
+	for i := 0; i < players; i++ {
+		_, err := tr.Register()
+		if err != nil {
+			t.Fatalf("Test failed - error registering: %s", err)
+		}
+	}
+
+	err = tr.Start()
+	if err != nil {
+		t.Fatalf("Test failed - error starting: %s", err)
+	}
+
+	return tr
+}
+
+// playHand deals a hand and plays it out. Each player in allIn goes all-in (or calls all-in) when it is their
+// turn, and everybody else folds, or checks if they can. If hands is not nil, it holds each player's hole cards,
+// and the last 5 elements are the board.
+func playHand(t *testing.T, g *Game, allIn []uint, hands [][]string) {
+	t.Helper()
+
+	err := Deal(g, g.dealerNum, 0)
+	if err != nil {
+		t.Fatalf("Test failed - error dealing: %s", err)
+	}
+
+	rig := func() {
+		for i, hand := range hands[:len(hands)-1] {
+			for j, c := range hand {
+				if g.players[i].Cards != nil {
+					g.players[i].Cards[j] = MustParseCardString(c)
+				}
+			}
+		}
+		// The board may run out without anyone acting, so the undealt community cards are stacked on the deck,
+		// which is dealt from the end
+		dealt := boardSize(g.getStage())
+		for j, c := range hands[len(hands)-1] {
+			if j < dealt {
+				g.communityCards[j] = MustParseCardString(c)
+			} else {
+				g.deck[len(g.deck)-1-(j-dealt)] = MustParseCardString(c)
+			}
+		}
+	}
+
+	for g.getStage() != PreDeal {
+		if hands != nil {
+			rig()
+		}
+
+		pn := g.actionNum
+		legal := g.LegalActions(pn)
+
+		shoves := false
+		for _, a := range allIn {
+			shoves = shoves || a == pn
+		}
+
+		switch {
+		case shoves:
+			err = Bet(g, pn, g.players[pn].Stack)
+		case legal.Check:
+			err = Bet(g, pn, 0)
+		default:
+			err = Fold(g, pn, 0)
+		}
+
+		if err != nil {
+			t.Fatalf("Test failed - error acting: %s", err)
+		}
+	}
+}
+
+func TestTournament_Levels(t *testing.T) {
+	levels := []BlindLevel{
+		{SmallBlind: 5, BigBlind: 10, Hands: 2},
+		{SmallBlind: 10, BigBlind: 20, Ante: 5, Duration: time.Hour, Hands: 3},
+		{SmallBlind: 25, BigBlind: 50, Ante: 5},
+	}
+
+	tr := setupTournament(t, TournamentConfig{Levels: levels, StartingStack: 1000}, 3)
+	g := tr.Game()
+
+	clock := time.Date(2020, 7, 12, 20, 0, 0, 0, time.UTC)
+	tr.now = func() time.Time { return clock }
+	tr.levelStart = clock
+
+	// Level 0 lasts 2 hands. Level 1 would last 3, but the clock runs out after 1.
+	wantLevels := []int{0, 0, 1, 2}
+	for hand, want := range wantLevels {
+		if got, l := tr.Level(); got != want || l != levels[want] {
+			t.Errorf("Test failed - hand %d is at level %d, want %d", hand, got, want)
+		}
+
+		if g.config.BigBlind != levels[want].BigBlind || g.config.Ante != levels[want].Ante {
+			t.Errorf("Test failed - hand %d has blinds %+v, want %+v", hand, g.config, levels[want])
+		}
+
+		playHand(t, g, nil, nil)
+
+		if hand == 1 {
+			clock = clock.Add(time.Hour)
+		}
+	}
+}
+
+func TestTournament_Eliminations(t *testing.T) {
+	tr := setupTournament(t, TournamentConfig{
+		Game:          GameConfig{SmallBlind: 5, BigBlind: 10},
+		StartingStack: 100,
+		BuyIn:         10,
+		Payouts:       []uint{70, 30},
+	}, 3)
+	g := tr.Game()
+
+	if err := tr.Rebuy(0); !errors.Is(err, ErrRebuyClosed) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrRebuyClosed)
+	}
+
+	if _, err := tr.Register(); !errors.Is(err, ErrTournamentStarted) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrTournamentStarted)
+	}
+
+	// Player 0 is under the gun and shoves, and the small blind calls and loses
+	playHand(t, g, []uint{0, 1}, [][]string{
+		{"AS", "AC"},
+		{"KS", "KC"},
+		{"2D", "7H"},
+		{"AH", "9D", "5C", "4S", "JH"},
+	})
+
+	if tr.Finished() {
+		t.Fatalf("Test failed - tournament must not be finished with 2 players left")
+	}
+
+	if got, want := tr.Standings(), []Standing{{PlayerNum: 1, Place: 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Test failed - got standings %+v, want %+v", got, want)
+	}
+
+	// Once eliminated, a player cannot buy their way back in
+	if err := BuyIn(g, 1, 100); !errors.Is(err, ErrEliminated) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrEliminated)
+	}
+
+	if err := ToggleReady(g, 1, 0); !errors.Is(err, ErrEliminated) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrEliminated)
+	}
+
+	// Heads up, player 2 is on the button and shoves, and player 0 calls and wins
+	playHand(t, g, []uint{0, 2}, [][]string{
+		{"AS", "AC"},
+		{"KS", "KC"},
+		{"2D", "7H"},
+		{"AH", "9D", "5C", "4S", "JH"},
+	})
+
+	if !tr.Finished() {
+		t.Fatalf("Test failed - tournament must be finished with 1 player left")
+	}
+
+	want := []Standing{{PlayerNum: 0, Place: 1, Prize: 21}, {PlayerNum: 2, Place: 2, Prize: 9}, {PlayerNum: 1, Place: 3}}
+	if got := tr.Standings(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Test failed - got standings %+v, want %+v", got, want)
+	}
+}
+
+func TestTournament_Rebuys(t *testing.T) {
+	tr := setupTournament(t, TournamentConfig{
+		Game:          GameConfig{SmallBlind: 5, BigBlind: 10},
+		Levels:        []BlindLevel{{SmallBlind: 5, BigBlind: 10, Hands: 2}, {SmallBlind: 10, BigBlind: 20}},
+		StartingStack: 100,
+		BuyIn:         10,
+		Payouts:       []uint{100},
+		RebuyLevels:   1,
+		RebuyStack:    100,
+		RebuyCost:     10,
+		AddOnStack:    200,
+		AddOnCost:     15,
+	}, 3)
+	g := tr.Game()
+
+	// A player may rebuy with exactly the starting stack
+	if err := tr.Rebuy(0); err != nil {
+		t.Errorf("Test failed - error rebuying: %s", err)
+	}
+
+	// Player 1 busts during the rebuy period, so they are not eliminated
+	playHand(t, g, []uint{0, 1}, [][]string{
+		{"AS", "AC"},
+		{"KS", "KC"},
+		{"2D", "7H"},
+		{"AH", "9D", "5C", "4S", "JH"},
+	})
+
+	if len(tr.Standings()) != 0 || g.players[1].Stack != 0 {
+		t.Fatalf("Test failed - got standings %+v, want none", tr.Standings())
+	}
+
+	if err := tr.Rebuy(1); err != nil {
+		t.Errorf("Test failed - error rebuying: %s", err)
+	}
+
+	if err := tr.Rebuy(0); !errors.Is(err, ErrRebuyTooBig) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrRebuyTooBig)
+	}
+
+	if err := tr.AddOn(0); err != nil {
+		t.Errorf("Test failed - error adding on: %s", err)
+	}
+
+	if err := tr.AddOn(0); !errors.Is(err, ErrAddOnTaken) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrAddOnTaken)
+	}
+
+	if p := g.players[1]; p.Stack != 100 || !p.Ready {
+		t.Errorf("Test failed - player 1 must be ready with 100 chips after rebuying, got %+v", p)
+	}
+
+	// 3 buy-ins, 2 rebuys (player 0 rebought before the first hand) and an add-on
+	if got := tr.PrizePool(); got != 3*10+2*10+15 {
+		t.Errorf("Test failed - prize pool is %d, want %d", got, 3*10+2*10+15)
+	}
+
+	// After the second hand, the rebuy period is over
+	playHand(t, g, nil, nil)
+
+	if err := tr.Rebuy(1); !errors.Is(err, ErrRebuyClosed) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrRebuyClosed)
+	}
+}
+
+func TestTournament_NotStarted(t *testing.T) {
+	tr, err := NewTournament(TournamentConfig{StartingStack: 1000})
+	if err != nil {
+		t.Fatalf("Test failed - error creating tournament: %s", err)
+	}
+
+	g := tr.Game()
+
+	for i := 0; i < 2; i++ {
+		if _, err := tr.Register(); err != nil {
+			t.Fatalf("Test failed - error registering: %s", err)
+		}
+	}
+
+	if err := Deal(g, g.dealingNum(), 0); !errors.Is(err, ErrTournamentNotStarted) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrTournamentNotStarted)
+	}
+
+	if g.LegalActions(g.dealingNum()).Deal {
+		t.Errorf("Test failed - no one may deal before the tournament starts")
+	}
+
+	if err := tr.Start(); err != nil {
+		t.Fatalf("Test failed - error starting: %s", err)
+	}
+
+	if err := Deal(g, g.dealingNum(), 0); err != nil {
+		t.Errorf("Test failed - error dealing: %s", err)
+	}
+
+	if _, err := NewTournament(TournamentConfig{Game: GameConfig{Seats: 24}}); !errors.Is(err, ErrTooManySeats) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrTooManySeats)
+	}
+
+	if _, err := NewTournament(TournamentConfig{Payouts: []uint{70, 30, 10}}); !errors.Is(err, ErrPayoutsTooHigh) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrPayoutsTooHigh)
+	}
+}
+
+func TestTournament_Leaving(t *testing.T) {
+	tr := setupTournament(t, TournamentConfig{
+		Game:          GameConfig{SmallBlind: 5, BigBlind: 10, Seats: 6},
+		StartingStack: 100,
+		BuyIn:         10,
+		Payouts:       []uint{70, 30},
+	}, 3)
+	g := tr.Game()
+
+	// Player 2 leaves with their chips, and finishes last
+	if err := Leave(g, 2, 0); err != nil {
+		t.Fatalf("Test failed - error leaving: %s", err)
+	}
+
+	if got, want := tr.Standings(), []Standing{{PlayerNum: 2, Place: 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Test failed - got standings %+v, want %+v", got, want)
+	}
+
+	if err := ToggleReady(g, 2, 0); !errors.Is(err, ErrEliminated) {
+		t.Errorf("Test failed - got %v, want %v", err, ErrEliminated)
+	}
+
+	playHand(t, g, nil, nil)
+
+	if tr.Finished() {
+		t.Fatalf("Test failed - tournament must not be finished with 2 players left")
+	}
+
+	// Heads up, the player who leaves finishes second, and the other player wins
+	if err := Leave(g, 1, 0); err != nil {
+		t.Fatalf("Test failed - error leaving: %s", err)
+	}
+
+	if !tr.Finished() {
+		t.Fatalf("Test failed - tournament must be finished with 1 player left")
+	}
+
+	want := []Standing{{PlayerNum: 0, Place: 1, Prize: 21}, {PlayerNum: 1, Place: 2, Prize: 9}, {PlayerNum: 2, Place: 3}}
+	if got := tr.Standings(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Test failed - got standings %+v, want %+v", got, want)
+	}
+}
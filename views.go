@@ -24,6 +24,9 @@
 package riverboat
 
 import (
+	"math"
+	"time"
+
 	. "github.com/alexclewontin/riverboat/eval"
 )
 
@@ -42,7 +45,21 @@ type GameView struct {
 	Deck           Deck
 	Pots           []Pot
 	MinRaise       uint
+	RaiseCount     uint
+	CarriedChips   uint
 	ReadyCount     uint
+	Shuffle        ShuffleCommitment
+	WaitingList    []uint
+	TurnStart      time.Time
+	LastSBNum      uint
+	LastBBNum      uint
+	LastDealt      uint
+	// Runs are the extra boards, when the board is run more than once. The first board is CommunityCards.
+	Runs [][]Card
+	// HandRake is the rake taken from the hand in progress (or the last hand, between hands), and TotalRake is
+	// everything the house has taken from the game, in rake and time fees
+	HandRake  uint
+	TotalRake uint
 }
 
 func (g *Game) copyToView() *GameView {
@@ -63,17 +80,57 @@ func (g *Game) copyToView() *GameView {
 		CommunityCards: append([]Card{}, g.communityCards...),
 		Stage:          g.getStage(),
 		Betting:        g.getBetting(),
-		Config:         g.config,
-		Players:        append([]player{}, g.players...),
+		Config:         copyConfig(g.config),
+		Players:        copyPlayers(g.players),
 		Deck:           append([]Card{}, g.deck...),
 		Pots:           copyPots(g.pots),
 		MinRaise:       g.minRaise,
+		RaiseCount:     g.raiseCount,
+		CarriedChips:   g.carry,
 		ReadyCount:     g.readyCount(),
+		Shuffle:        *g.shuffle.copy(),
+		WaitingList:    append([]uint(nil), g.waiting...),
+		TurnStart:      g.turnStart,
+		LastSBNum:      g.lastSBNum,
+		LastBBNum:      g.lastBBNum,
+		LastDealt:      g.lastDealt,
+		Runs:           copyBoards(g.runs),
+		HandRake:       g.handRake,
+		TotalRake:      g.totalRake,
 	}
 
 	return view
 }
 
+func copyPlayers(src []player) []player {
+	ret := append([]player{}, src...)
+	for i := range src {
+		if src[i].Cards != nil {
+			ret[i].Cards = append([]Card{}, src[i].Cards...)
+		}
+	}
+
+	return ret
+}
+
+func copyBoards(src [][]Card) [][]Card {
+	if src == nil {
+		return nil
+	}
+
+	ret := make([][]Card, len(src))
+	for i := range src {
+		ret[i] = append([]Card{}, src[i]...)
+	}
+
+	return ret
+}
+
+func copyConfig(c GameConfig) GameConfig {
+	c.Rake.Caps = append([]RakeCap(nil), c.Rake.Caps...)
+	return c
+}
+
 func copyPots(src []Pot) []Pot {
 	ret := make([]Pot, len(src))
 	for i := range src {
@@ -83,6 +140,11 @@ func copyPots(src []Pot) []Pot {
 		ret[i].EligiblePlayerNums = append([]uint{}, src[i].EligiblePlayerNums...)
 		ret[i].WinningPlayerNums = append([]uint{}, src[i].WinningPlayerNums...)
 		ret[i].WinningHand = append([]Card{}, src[i].WinningHand...)
+		ret[i].LowWinningScore = src[i].LowWinningScore
+		ret[i].LowWinningPlayerNums = append([]uint{}, src[i].LowWinningPlayerNums...)
+		ret[i].LowWinningHand = append([]Card{}, src[i].LowWinningHand...)
+		ret[i].Board = src[i].Board
+		ret[i].Rake = src[i].Rake
 	}
 
 	return ret
@@ -100,11 +162,22 @@ func (g *Game) FillFromView(gv *GameView) {
 	g.sbNum = gv.SBNum
 	g.communityCards = append([]Card{}, gv.CommunityCards...)
 	g.setStageAndBetting(gv.Stage, gv.Betting)
-	g.config = gv.Config
-	g.players = append([]player{}, gv.Players...)
+	g.config = copyConfig(gv.Config)
+	g.players = copyPlayers(gv.Players)
 	g.deck = append([]Card{}, gv.Deck...)
 	g.pots = copyPots(gv.Pots)
 	g.minRaise = gv.MinRaise
+	g.raiseCount = gv.RaiseCount
+	g.carry = gv.CarriedChips
+	g.shuffle = *gv.Shuffle.copy()
+	g.waiting = append([]uint(nil), gv.WaitingList...)
+	g.turnStart = gv.TurnStart
+	g.lastSBNum = gv.LastSBNum
+	g.lastBBNum = gv.LastBBNum
+	g.lastDealt = gv.LastDealt
+	g.runs = copyBoards(gv.Runs)
+	g.handRake = gv.HandRake
+	g.totalRake = gv.TotalRake
 }
 
 // GeneratePlayerView is primarily for creating a view that can be serialized for delivery to a specific player
@@ -115,10 +188,12 @@ func (g *Game) GeneratePlayerView(pn uint) *GameView {
 
 	gv := g.copyToView()
 	gv.Deck = nil
+	gv.Shuffle.Salt = nil
+	gv.Shuffle.Deck = nil
 
 	// D. R. Y.!
-	hideCards := func(pn2 uint) { gv.Players[pn2].Cards = [2]Card{0, 0} }
-	showCards := func(pn2 uint) { gv.Players[pn2].Cards = [2]Card{g.players[pn2].Cards[0], g.players[pn2].Cards[1]} }
+	hideCards := func(pn2 uint) { gv.Players[pn2].Cards = make([]Card, len(g.players[pn2].Cards)) }
+	showCards := func(pn2 uint) { gv.Players[pn2].Cards = append([]Card{}, g.players[pn2].Cards...) }
 
 	allInCount := 0
 	inCount := 0
@@ -153,30 +228,23 @@ func (g *Game) GeneratePlayerView(pn uint) *GameView {
 
 	if g.getStage() == PreDeal && inCount > 1 {
 
-		showCards(g.calledNum)
-		_, scoreToBeat := BestFiveOfSeven(
-			g.players[g.calledNum].Cards[0],
-			g.players[g.calledNum].Cards[1],
-			g.communityCards[0],
-			g.communityCards[1],
-			g.communityCards[2],
-			g.communityCards[3],
-			g.communityCards[4],
-		)
+		// Players who were in the hand may have stopped playing since it ended, and given up their cards
+		scoreToBeat := math.MaxInt32
+		if g.players[g.calledNum].Cards != nil {
+			showCards(g.calledNum)
+			_, scoreToBeat = g.bestHand(g.calledNum)
+		}
 
 		for i := range g.players {
 			pni := (g.calledNum + uint(i)) % uint(len(g.players))
-			_, iScore := BestFiveOfSeven(
-				g.players[pni].Cards[0],
-				g.players[pni].Cards[1],
-				g.communityCards[0],
-				g.communityCards[1],
-				g.communityCards[2],
-				g.communityCards[3],
-				g.communityCards[4],
-			)
-
-			if (iScore <= scoreToBeat) && g.players[pni].In {
+
+			if !g.players[pni].In || g.players[pni].Cards == nil {
+				continue
+			}
+
+			_, iScore := g.bestHand(pni)
+
+			if iScore <= scoreToBeat {
 				showCards(pni)
 				scoreToBeat = iScore
 			}
@@ -187,6 +255,10 @@ func (g *Game) GeneratePlayerView(pn uint) *GameView {
 			for _, j := range pot.WinningPlayerNums {
 				showCards(j)
 			}
+
+			for _, j := range pot.LowWinningPlayerNums {
+				showCards(j)
+			}
 		}
 	}
 
@@ -202,3 +274,75 @@ func (g *Game) GenerateOmniView() *GameView {
 	return g.copyToView()
 
 }
+
+// LegalActionSet describes which Actions a player may legally take at a given moment, and with what amounts.
+// All amounts are in the same terms as the data passed to Bet (i.e. the chips the player would put in with
+// that action), and already take the size of the player's stack into account.
+type LegalActionSet struct {
+	Fold  bool
+	Check bool
+	Call  bool
+	// Bet is true if the player may open the betting (no one has bet yet this round)
+	Bet bool
+	// Raise is true if the player may raise a bet that has already been made
+	Raise bool
+	// CallAmt is the amount needed to call. If the player does not have enough, it is their whole stack.
+	CallAmt uint
+	// MinRaise is the smallest legal bet or raise. If the player does not have enough, it is their whole stack.
+	MinRaise uint
+	// MaxBet is the largest legal bet or raise.
+	MaxBet uint
+
+	Deal           bool
+	ToggleReady    bool
+	ToggleStraddle bool
+	SitOut         bool
+	SitIn          bool
+}
+
+// LegalActions returns the set of Actions the player denoted by pn may legally take at the moment it is called,
+// determined by the same rules the Actions themselves use. If the player may not bet, Check, Call, Bet and Raise
+// are false and the amounts are all 0.
+func (g *Game) LegalActions(pn uint) LegalActionSet {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	p := g.players[pn]
+
+	ret := LegalActionSet{
+		Deal:           g.canDeal(pn) == nil,
+		ToggleReady:    g.canToggleReady(pn) == nil,
+		ToggleStraddle: g.config.Straddle != NoStraddle,
+		SitOut:         g.canSitOut(pn) == nil,
+		SitIn:          g.canSitIn(pn) == nil,
+	}
+
+	if g.canAct(pn) != nil {
+		return ret
+	}
+
+	ret.Fold = true
+
+	callAmt := g.toCall() - p.Bet
+	ret.Check = callAmt == 0
+	ret.Call = callAmt > 0
+	ret.CallAmt = min(callAmt, p.Stack)
+
+	if !g.canRaise(pn) || p.Stack <= callAmt {
+		return ret
+	}
+
+	ret.Bet = g.toCall() == 0
+	ret.Raise = !ret.Bet
+	ret.MinRaise = min(callAmt+g.minRaise, p.Stack)
+	ret.MaxBet = min(g.getLimit(pn), p.Stack)
+
+	return ret
+}
+
+func min(a uint, b uint) uint {
+	if a < b {
+		return a
+	}
+	return b
+}
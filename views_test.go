@@ -167,7 +167,7 @@ func TestGame_GenerateOmniViewChangedVals(t *testing.T) {
 						Stack:      105,
 						Bet:        10,
 						TotalBet:   20,
-						Cards: [2]Card{
+						Cards: []Card{
 							33564957,
 							67115551,
 						},
@@ -218,7 +218,7 @@ func TestGame_GenerateOmniViewChangedVals(t *testing.T) {
 						Stack:      105,
 						Bet:        10,
 						TotalBet:   20,
-						Cards: [2]Card{
+						Cards: []Card{
 							33564957,
 							67115551,
 						},
@@ -298,3 +298,124 @@ func (g *Game) String() string {
 		g.minRaise,
 	)
 }
+
+func TestGame_LegalActions(t *testing.T) {
+	g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 1000, 1000)
+
+	if got := g.LegalActions(0); !got.Deal || !got.ToggleReady || got.Fold {
+		t.Errorf("Before the deal: got %+v", got)
+	}
+
+	err := Deal(g, 0, 0)
+
+	if err != nil {
+		t.Errorf("Test failed - error dealing: %s", err)
+	}
+
+	tests := []struct {
+		name   string
+		pn     uint
+		action uint
+		want   LegalActionSet
+	}{
+		{
+			name:   "UTG facing the big blind",
+			pn:     0,
+			action: 25,
+			want:   LegalActionSet{Fold: true, Call: true, Raise: true, CallAmt: 25, MinRaise: 50, MaxBet: 1000, SitOut: true},
+		},
+		{
+			name:   "Small blind completing",
+			pn:     1,
+			action: 15,
+			want:   LegalActionSet{Fold: true, Call: true, Raise: true, CallAmt: 15, MinRaise: 40, MaxBet: 990, SitOut: true},
+		},
+		{
+			name:   "Big blind option",
+			pn:     2,
+			action: 0,
+			want:   LegalActionSet{Fold: true, Check: true, Raise: true, CallAmt: 0, MinRaise: 25, MaxBet: 975, SitOut: true},
+		},
+		{
+			name:   "Opening the flop",
+			pn:     1,
+			action: 100,
+			want:   LegalActionSet{Fold: true, Check: true, Bet: true, CallAmt: 0, MinRaise: 25, MaxBet: 975, SitOut: true},
+		},
+		{
+			name:   "Facing a flop bet",
+			pn:     2,
+			action: 975,
+			want:   LegalActionSet{Fold: true, Call: true, Raise: true, CallAmt: 100, MinRaise: 200, MaxBet: 975, SitOut: true},
+		},
+		{
+			name:   "Facing an all-in",
+			pn:     0,
+			action: 975,
+			want:   LegalActionSet{Fold: true, Call: true, CallAmt: 975, SitOut: true},
+		},
+	}
+
+	for _, tt := range tests {
+		got := g.LegalActions(tt.pn)
+		if got != tt.want {
+			t.Errorf("%s: got %+v\nwant %+v", tt.name, got, tt.want)
+		}
+
+		for pn := range g.players {
+			if other := g.LegalActions(uint(pn)); uint(pn) != tt.pn && (other.Fold || other.Deal || other.ToggleReady) {
+				t.Errorf("%s: player %d got %+v, want no legal actions", tt.name, pn, other)
+			}
+		}
+
+		err = Bet(g, tt.pn, tt.action)
+
+		if err != nil {
+			t.Errorf("%s: error betting: %s", tt.name, err)
+		}
+	}
+}
+
+func TestGame_GeneratePlayerViewAfterLeaving(t *testing.T) {
+	g := setupReadyGame(t, GameConfig{BigBlind: 25, SmallBlind: 10}, 1000, 1000, 1000)
+
+	actions := []struct {
+		action Action
+		pn     uint
+		data   uint
+	}{
+		{Deal, 0, 0},
+		{Bet, 0, 25},
+		{Bet, 1, 15},
+		{Bet, 2, 0},
+	}
+
+	for _, a := range actions {
+		if err := a.action(g, a.pn, a.data); err != nil {
+			t.Fatalf("Test failed - error performing action: %s", err)
+		}
+	}
+
+	for g.getBetting() {
+		if err := Bet(g, g.actionNum, 0); err != nil {
+			t.Fatalf("Test failed - error checking: %s", err)
+		}
+	}
+
+	if g.getStage() != PreDeal {
+		t.Fatalf("Test failed - the hand must be over")
+	}
+
+	// Both the player who showed first and one who showed after them stop playing once the hand is over
+	for _, pn := range []uint{g.calledNum, (g.calledNum + 1) % 3} {
+		if err := ToggleReady(g, pn, 0); err != nil {
+			t.Fatalf("Test failed - error marking not ready: %s", err)
+		}
+
+		view := g.GeneratePlayerView(0)
+
+		if len(view.Players[pn].Cards) != 0 {
+			t.Errorf("Test failed - player %d is no longer ready, so they must not have cards, got %v", pn, view.Players[pn].Cards)
+		}
+	}
+}